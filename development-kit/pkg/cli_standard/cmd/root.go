@@ -15,7 +15,10 @@
 package cmd
 
 import (
+	"strings"
+
 	"github.com/ZupIT/horusec/development-kit/pkg/cli_standard/config"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/env"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	"github.com/spf13/cobra"
 )
@@ -31,8 +34,35 @@ func InitFlags(configs *config.Config, rootCmd *cobra.Command) {
 	rootCmd.PersistentFlags().StringVarP(&configs.ProjectPath, "project-path", "p",
 		configs.GetProjectPath(),
 		"You can configure the path to run analysis. Example: -p=\"/home/user/my-project\"")
+	rootCmd.PersistentFlags().Int64Var(&configs.MaxFileSizeInMB, "max-file-size-mb",
+		env.GetEnvOrDefaultInt64(config.EnvMaxFileSizeInMB, configs.GetMaxFileSizeInMB()),
+		"Files greater than this size in megabytes are skipped, zero disables the limit. Example: --max-file-size-mb=10")
+	rootCmd.PersistentFlags().StringSliceVar(&configs.RulePacks, "rule-packs", getEnvRulePacks(),
+		"Rule packs used in the analysis, the builtin rules are used when no pack of the engine is informed. "+
+			"Example: --rule-packs=\"java-core@1.4, leaks@2.0\"")
+	rootCmd.PersistentFlags().StringVar(&configs.RulePacksPath, "rule-packs-path",
+		env.GetEnvOrDefault(config.EnvRulePacksPath, ""),
+		"Directory of the rule packs not builtin. Example: --rule-packs-path=\"/rule-packs\"")
+	rootCmd.PersistentFlags().IntVar(&configs.EngineWorkers, "engine-workers",
+		env.GetEnvOrDefaultInt(config.EnvEngineWorkers, configs.GetEngineWorkers()),
+		"Files analyzed at the same time, zero uses the number of CPUs. Example: --engine-workers=4")
+	rootCmd.PersistentFlags().Int64Var(&configs.EngineMemoryLimitInMB, "engine-memory-limit-mb",
+		env.GetEnvOrDefaultInt64(config.EnvEngineMemoryLimitInMB, configs.GetEngineMemoryLimitInMB()),
+		"Soft limit of the heap in megabytes, above it only one worker analyzes files until the memory is released, "+
+			"zero disables the limit. Example: --engine-memory-limit-mb=512")
+	rootCmd.PersistentFlags().Int64Var(&configs.EngineRuleTimeoutInMs, "engine-rule-timeout-ms",
+		env.GetEnvOrDefaultInt64(config.EnvEngineRuleTimeoutInMs, configs.GetEngineRuleTimeoutInMs()),
+		"Max time in milliseconds of each rule in each file, the rule is skipped in the file when it takes longer, "+
+			"zero disables the timeout. Example: --engine-rule-timeout-ms=5000")
 
 	cobra.OnInitialize(func() {
 		logger.SetLogLevel(configs.LogLevel)
 	})
 }
+
+func getEnvRulePacks() []string {
+	if rulePacks := env.GetEnvOrDefault(config.EnvRulePacks, ""); rulePacks != "" {
+		return strings.Split(rulePacks, ",")
+	}
+	return []string{}
+}
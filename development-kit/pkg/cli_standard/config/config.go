@@ -14,17 +14,44 @@
 
 package config
 
+// Files greater than this size are skipped by the engine, a value lower or equal to zero disables the limit
+const DefaultMaxFileSizeInMB = 5
+
+// Envs used by the horusec cli to select the rule packs of the engines, the separator of the packs is the comma
+const (
+	EnvRulePacks     = "HORUSEC_RULE_PACKS"
+	EnvRulePacksPath = "HORUSEC_RULE_PACKS_PATH"
+)
+
+// Envs used by the horusec cli to limit the workers of the engines, the memory used by them and the files analyzed
+const (
+	EnvEngineWorkers         = "HORUSEC_ENGINE_WORKERS"
+	EnvEngineMemoryLimitInMB = "HORUSEC_ENGINE_MEMORY_LIMIT_MB"
+	EnvEngineRuleTimeoutInMs = "HORUSEC_ENGINE_RULE_TIMEOUT_MS"
+	EnvMaxFileSizeInMB       = "HORUSEC_MAX_FILE_SIZE_MB"
+)
+
 type Config struct {
-	LogLevel       string
-	ProjectPath    string
-	OutputFilePath string
+	LogLevel        string
+	ProjectPath     string
+	OutputFilePath  string
+	MaxFileSizeInMB int64
+	RulePacks       []string
+	RulePacksPath   string
+	// EngineWorkers lower or equal to zero uses the number of CPUs
+	EngineWorkers int
+	// EngineMemoryLimitInMB lower or equal to zero disables the throttle of the workers
+	EngineMemoryLimitInMB int64
+	// EngineRuleTimeoutInMs lower or equal to zero disables the timeout of the rules
+	EngineRuleTimeoutInMs int64
 }
 
 func NewConfig() *Config {
 	c := &Config{
-		LogLevel:       "info",
-		ProjectPath:    "./",
-		OutputFilePath: "output.json",
+		LogLevel:        "info",
+		ProjectPath:     "./",
+		OutputFilePath:  "output.json",
+		MaxFileSizeInMB: DefaultMaxFileSizeInMB,
 	}
 	return c
 }
@@ -52,3 +79,51 @@ func (c *Config) GetProjectPath() string {
 func (c *Config) SetProjectPath(projectPath string) {
 	c.ProjectPath = projectPath
 }
+
+func (c *Config) GetMaxFileSizeInMB() int64 {
+	return c.MaxFileSizeInMB
+}
+
+func (c *Config) SetMaxFileSizeInMB(maxFileSizeInMB int64) {
+	c.MaxFileSizeInMB = maxFileSizeInMB
+}
+
+func (c *Config) GetRulePacks() []string {
+	return c.RulePacks
+}
+
+func (c *Config) SetRulePacks(rulePacks []string) {
+	c.RulePacks = rulePacks
+}
+
+func (c *Config) GetRulePacksPath() string {
+	return c.RulePacksPath
+}
+
+func (c *Config) SetRulePacksPath(rulePacksPath string) {
+	c.RulePacksPath = rulePacksPath
+}
+
+func (c *Config) GetEngineWorkers() int {
+	return c.EngineWorkers
+}
+
+func (c *Config) SetEngineWorkers(engineWorkers int) {
+	c.EngineWorkers = engineWorkers
+}
+
+func (c *Config) GetEngineMemoryLimitInMB() int64 {
+	return c.EngineMemoryLimitInMB
+}
+
+func (c *Config) SetEngineMemoryLimitInMB(engineMemoryLimitInMB int64) {
+	c.EngineMemoryLimitInMB = engineMemoryLimitInMB
+}
+
+func (c *Config) GetEngineRuleTimeoutInMs() int64 {
+	return c.EngineRuleTimeoutInMs
+}
+
+func (c *Config) SetEngineRuleTimeoutInMs(engineRuleTimeoutInMs int64) {
+	c.EngineRuleTimeoutInMs = engineRuleTimeoutInMs
+}
@@ -25,14 +25,23 @@ func TestNewConfig(t *testing.T) {
 		assert.Equal(t, configs.GetOutputFilePath(), "output.json")
 		assert.Equal(t, configs.GetProjectPath(), "./")
 		assert.Equal(t, configs.GetLogLevel(), "info")
+		assert.Equal(t, configs.GetMaxFileSizeInMB(), int64(DefaultMaxFileSizeInMB))
 	})
 	t.Run("Should return new values", func(t *testing.T) {
 		configs := NewConfig()
 		configs.SetOutputFilePath("tmp.json")
 		configs.SetProjectPath("../")
 		configs.SetLogLevel("error")
+		configs.SetMaxFileSizeInMB(1)
+		configs.SetEngineWorkers(2)
+		configs.SetEngineMemoryLimitInMB(512)
+		configs.SetEngineRuleTimeoutInMs(5000)
 		assert.NotEqual(t, configs.GetOutputFilePath(), "output.json")
 		assert.NotEqual(t, configs.GetProjectPath(), "./")
 		assert.NotEqual(t, configs.GetLogLevel(), "info")
+		assert.Equal(t, configs.GetMaxFileSizeInMB(), int64(1))
+		assert.Equal(t, configs.GetEngineWorkers(), 2)
+		assert.Equal(t, configs.GetEngineMemoryLimitInMB(), int64(512))
+		assert.Equal(t, configs.GetEngineRuleTimeoutInMs(), int64(5000))
 	})
 }
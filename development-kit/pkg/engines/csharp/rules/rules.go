@@ -30,14 +30,17 @@ func NewRules() Interface {
 }
 
 func (r *Rules) GetAllRules() (rules []engine.Rule) {
-	for index := range csharp.AllRulesCsharpAnd() {
-		rules = append(rules, csharp.AllRulesCsharpAnd()[index])
+	rulesCsharpAnd := csharp.AllRulesCsharpAnd()
+	for index := range rulesCsharpAnd {
+		rules = append(rules, rulesCsharpAnd[index])
 	}
-	for index := range csharp.AllRulesCsharpOr() {
-		rules = append(rules, csharp.AllRulesCsharpOr()[index])
+	rulesCsharpOr := csharp.AllRulesCsharpOr()
+	for index := range rulesCsharpOr {
+		rules = append(rules, rulesCsharpOr[index])
 	}
-	for index := range csharp.AllRulesCsharpRegular() {
-		rules = append(rules, csharp.AllRulesCsharpRegular()[index])
+	rulesCsharpRegular := csharp.AllRulesCsharpRegular()
+	for index := range rulesCsharpRegular {
+		rules = append(rules, rulesCsharpRegular[index])
 	}
 	return rules
 }
@@ -37,27 +37,33 @@ func (r *Rules) GetAllRules() (rules []engine.Rule) {
 }
 
 func (r *Rules) addJavaRules(rules []engine.Rule) []engine.Rule {
-	for index := range java.AllRulesJavaAnd() {
-		rules = append(rules, java.AllRulesJavaAnd()[index])
+	rulesJavaAnd := java.AllRulesJavaAnd()
+	for index := range rulesJavaAnd {
+		rules = append(rules, rulesJavaAnd[index])
 	}
-	for index := range java.AllRulesJavaOr() {
-		rules = append(rules, java.AllRulesJavaOr()[index])
+	rulesJavaOr := java.AllRulesJavaOr()
+	for index := range rulesJavaOr {
+		rules = append(rules, rulesJavaOr[index])
 	}
-	for index := range java.AllRulesJavaRegular() {
-		rules = append(rules, java.AllRulesJavaRegular()[index])
+	rulesJavaRegular := java.AllRulesJavaRegular()
+	for index := range rulesJavaRegular {
+		rules = append(rules, rulesJavaRegular[index])
 	}
 	return rules
 }
 
 func (r *Rules) addJvmRules(rules []engine.Rule) []engine.Rule {
-	for index := range jvm.AllRulesJvmAnd() {
-		rules = append(rules, jvm.AllRulesJvmAnd()[index])
+	rulesJvmAnd := jvm.AllRulesJvmAnd()
+	for index := range rulesJvmAnd {
+		rules = append(rules, rulesJvmAnd[index])
 	}
-	for index := range jvm.AllRulesJvmOr() {
-		rules = append(rules, jvm.AllRulesJvmOr()[index])
+	rulesJvmOr := jvm.AllRulesJvmOr()
+	for index := range rulesJvmOr {
+		rules = append(rules, rulesJvmOr[index])
 	}
-	for index := range jvm.AllRulesJvmRegular() {
-		rules = append(rules, jvm.AllRulesJvmRegular()[index])
+	rulesJvmRegular := jvm.AllRulesJvmRegular()
+	for index := range rulesJvmRegular {
+		rules = append(rules, rulesJvmRegular[index])
 	}
 	return rules
 }
@@ -30,14 +30,17 @@ func NewRules() Interface {
 }
 
 func (r *Rules) GetAllRules() (rules []engine.Rule) {
-	for index := range jvm.AllRulesJvmAnd() {
-		rules = append(rules, jvm.AllRulesJvmAnd()[index])
+	rulesJvmAnd := jvm.AllRulesJvmAnd()
+	for index := range rulesJvmAnd {
+		rules = append(rules, rulesJvmAnd[index])
 	}
-	for index := range jvm.AllRulesJvmOr() {
-		rules = append(rules, jvm.AllRulesJvmOr()[index])
+	rulesJvmOr := jvm.AllRulesJvmOr()
+	for index := range rulesJvmOr {
+		rules = append(rules, rulesJvmOr[index])
 	}
-	for index := range jvm.AllRulesJvmRegular() {
-		rules = append(rules, jvm.AllRulesJvmRegular()[index])
+	rulesJvmRegular := jvm.AllRulesJvmRegular()
+	for index := range rulesJvmRegular {
+		rules = append(rules, rulesJvmRegular[index])
 	}
 	return rules
 }
@@ -18,9 +18,10 @@ import (
 	"encoding/json"
 	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/rules"
 
-	engine "github.com/ZupIT/horusec-engine"
-	"github.com/ZupIT/horusec-engine/text"
 	"github.com/ZupIT/horusec/development-kit/pkg/cli_standard/config"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rulepack"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/runner"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 )
 
@@ -41,19 +42,18 @@ func NewAnalysis(configs *config.Config) Interface {
 }
 
 func (a *Analysis) StartAnalysis() error {
-	textUnit, err := text.LoadDirIntoSingleUnit(a.configs.GetProjectPath(), []string{".yaml", ".yml"})
+	allRules, err := rulepack.GetRules(tools.HorusecKubernetes, a.configs.GetRulePacks(), a.configs.GetRulePacksPath(),
+		a.serviceRules.GetAllRules())
 	if err != nil {
 		return err
 	}
-	a.logJSON("Text Unit selected is: ", textUnit)
-
-	allRules := a.serviceRules.GetAllRules()
 	a.logJSON("All rules selected are: ", allRules)
 
 	outputFilePath := a.configs.GetOutputFilePath()
-	logger.LogDebugWithLevel("Sending units and rules to engine "+
+	logger.LogDebugWithLevel("Sending files and rules to engine "+
 		" and expected response in path: ", logger.DebugLevel, outputFilePath)
-	return engine.RunOutputInJSON([]engine.Unit{textUnit}, allRules, outputFilePath)
+	return runner.NewRunner(a.configs).RunOutputInJSON(a.configs.GetProjectPath(), []string{".yaml", ".yml"}, allRules,
+		outputFilePath)
 }
 
 func (a *Analysis) logJSON(message string, content interface{}) {
@@ -30,14 +30,17 @@ func NewRules() Interface {
 }
 
 func (r *Rules) GetAllRules() (rules []engine.Rule) {
-	for index := range kubernetes.AllRulesKubernetesAnd() {
-		rules = append(rules, kubernetes.AllRulesKubernetesAnd()[index])
+	rulesKubernetesAnd := kubernetes.AllRulesKubernetesAnd()
+	for index := range rulesKubernetesAnd {
+		rules = append(rules, rulesKubernetesAnd[index])
 	}
-	for index := range kubernetes.AllRulesKubernetesOr() {
-		rules = append(rules, kubernetes.AllRulesKubernetesOr()[index])
+	rulesKubernetesOr := kubernetes.AllRulesKubernetesOr()
+	for index := range rulesKubernetesOr {
+		rules = append(rules, rulesKubernetesOr[index])
 	}
-	for index := range kubernetes.AllRulesKubernetesRegular() {
-		rules = append(rules, kubernetes.AllRulesKubernetesRegular()[index])
+	rulesKubernetesRegular := kubernetes.AllRulesKubernetesRegular()
+	for index := range rulesKubernetesRegular {
+		rules = append(rules, rulesKubernetesRegular[index])
 	}
 	return rules
 }
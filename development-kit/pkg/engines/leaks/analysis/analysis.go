@@ -17,40 +17,50 @@ package analysis
 import (
 	"encoding/json"
 
-	engine "github.com/ZupIT/horusec-engine"
-	"github.com/ZupIT/horusec-engine/text"
 	"github.com/ZupIT/horusec/development-kit/pkg/cli_standard/config"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/leaks/entropy"
 	"github.com/ZupIT/horusec/development-kit/pkg/engines/leaks/rules"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rulepack"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/runner"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 )
 
 type Analysis struct {
-	configs      *config.Config
-	serviceRules rules.Interface
+	configs        *config.Config
+	entropyConfigs *entropy.Config
+	serviceRules   rules.Interface
 }
 
 func NewAnalysis(configs *config.Config) *Analysis {
 	return &Analysis{
-		configs:      configs,
-		serviceRules: rules.NewRules(),
+		configs:        configs,
+		entropyConfigs: entropy.NewConfig(),
+		serviceRules:   rules.NewRules(),
 	}
 }
 
+func (a *Analysis) SetEntropyConfigs(entropyConfigs *entropy.Config) {
+	a.entropyConfigs = entropyConfigs
+}
+
 func (a *Analysis) StartAnalysis() error {
-	textUnits, err := text.LoadDirIntoMultiUnit(a.configs.GetProjectPath(), 5, []string{"**"})
+	allRules, err := rulepack.GetRules(tools.HorusecLeaks, a.configs.GetRulePacks(), a.configs.GetRulePacksPath(),
+		a.serviceRules.GetAllRules())
 	if err != nil {
 		return err
 	}
-	units := a.parseTextUnitsToUnits(textUnits)
-	a.logJSON("Texts Units selected are: ", textUnits)
-
-	allRules := a.serviceRules.GetAllRules()
 	a.logJSON("All rules selected are: ", allRules)
 
 	outputFilePath := a.configs.GetOutputFilePath()
-	logger.LogDebugWithLevel("Sending units and rules to engine "+
+	logger.LogDebugWithLevel("Sending files and rules to engine "+
 		" and expected response in path: ", logger.DebugLevel, outputFilePath)
-	return engine.RunOutputInJSON(units, allRules, outputFilePath)
+	var analyzers []runner.FileAnalyzer
+	if a.entropyConfigs.Enabled {
+		analyzers = append(analyzers, entropy.NewDetector(a.entropyConfigs).AnalyzeFile)
+	}
+	return runner.NewRunner(a.configs).RunOutputInJSON(a.configs.GetProjectPath(), []string{"**"}, allRules,
+		outputFilePath, analyzers...)
 }
 
 func (a *Analysis) logJSON(message string, content interface{}) {
@@ -59,10 +69,3 @@ func (a *Analysis) logJSON(message string, content interface{}) {
 		logger.LogTraceWithLevel(message, logger.DebugLevel, string(b))
 	}
 }
-
-func (a *Analysis) parseTextUnitsToUnits(textUnits []text.TextUnit) (units []engine.Unit) {
-	for index := range textUnits {
-		units = append(units, textUnits[index])
-	}
-	return units
-}
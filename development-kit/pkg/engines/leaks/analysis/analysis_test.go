@@ -22,6 +22,7 @@ import (
 
 	engine "github.com/ZupIT/horusec-engine"
 	"github.com/ZupIT/horusec/development-kit/pkg/cli_standard/config"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/leaks/entropy"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/engine/advisories/leaks/regular"
 	"github.com/stretchr/testify/assert"
 )
@@ -120,3 +121,22 @@ func TestAnalysis_StartRegularAnalysis(t *testing.T) {
 		assert.Equal(t, 12, vulnCounter)
 	})
 }
+
+func TestAnalysis_StartEntropyAnalysis(t *testing.T) {
+	t.Run("Should return same vulnerabilities with entropy enabled when not exists random tokens", func(t *testing.T) {
+		configs := config.NewConfig()
+		configs.SetOutputFilePath("./leaks-tmp6.output.json")
+		configs.SetProjectPath("../../examples/leaks-hardcodedpass")
+		entropyConfigs := entropy.NewConfig()
+		entropyConfigs.Enabled = true
+		controller := NewAnalysis(configs)
+		controller.SetEntropyConfigs(entropyConfigs)
+		err := controller.StartAnalysis()
+		assert.NoError(t, err)
+		fileBytes, err := ioutil.ReadFile("./leaks-tmp6.output.json")
+		data := []engine.Finding{}
+		_ = json.Unmarshal(fileBytes, &data)
+		assert.NoError(t, os.RemoveAll(configs.GetOutputFilePath()))
+		assert.Equal(t, len(data), 2)
+	})
+}
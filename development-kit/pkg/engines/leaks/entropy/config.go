@@ -0,0 +1,60 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entropy
+
+import (
+	"github.com/spf13/cobra"
+)
+
+const (
+	DefaultMinLength       = 20
+	DefaultBase64Threshold = 4.5
+	DefaultHexThreshold    = 3.0
+)
+
+type Config struct {
+	Enabled           bool
+	MinLength         int
+	Base64Threshold   float64
+	HexThreshold      float64
+	AllowlistPatterns []string
+	AllowlistPaths    []string
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Enabled:           false,
+		MinLength:         DefaultMinLength,
+		Base64Threshold:   DefaultBase64Threshold,
+		HexThreshold:      DefaultHexThreshold,
+		AllowlistPatterns: []string{},
+		AllowlistPaths:    []string{},
+	}
+}
+
+func InitFlags(configs *Config, rootCmd *cobra.Command) {
+	rootCmd.PersistentFlags().BoolVar(&configs.Enabled, "entropy", configs.Enabled,
+		"Enable detection of random tokens by entropy. Example: --entropy=\"true\"")
+	rootCmd.PersistentFlags().IntVar(&configs.MinLength, "entropy-min-length", configs.MinLength,
+		"Minimum length of a token to be checked by entropy. Example: --entropy-min-length=20")
+	rootCmd.PersistentFlags().Float64Var(&configs.Base64Threshold, "entropy-base64-threshold",
+		configs.Base64Threshold, "Minimum entropy to report a base64 token. Example: --entropy-base64-threshold=4.5")
+	rootCmd.PersistentFlags().Float64Var(&configs.HexThreshold, "entropy-hex-threshold",
+		configs.HexThreshold, "Minimum entropy to report a hex token. Example: --entropy-hex-threshold=3.0")
+	rootCmd.PersistentFlags().StringSliceVar(&configs.AllowlistPatterns, "entropy-allowlist-pattern",
+		configs.AllowlistPatterns, "Regular expressions of tokens to not report. Example: --entropy-allowlist-pattern=\"^EXAMPLE\"")
+	rootCmd.PersistentFlags().StringSliceVar(&configs.AllowlistPaths, "entropy-allowlist-path",
+		configs.AllowlistPaths, "Glob of files to not check by entropy. Example: --entropy-allowlist-path=\"**/testdata/**\"")
+}
@@ -0,0 +1,219 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entropy
+
+import (
+	"math"
+	"regexp"
+	"strings"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/bmatcuk/doublestar/v2"
+)
+
+const (
+	Base64Charset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/=-_"
+	HexCharset    = "0123456789abcdefABCDEF"
+
+	Base64RuleID = "3b8a0a4f-6c0e-4f0c-9a53-3e1f7d1f5b10"
+	HexRuleID    = "8f2c5d9e-2a47-4b1e-8c6d-5a0b9e3f7c21"
+)
+
+var tokenFinder = regexp.MustCompile(`[A-Za-z0-9+/=_\-]+`)
+
+type Interface interface {
+	Analyze(textUnits []text.TextUnit) []engine.Finding
+	AnalyzeFile(file *text.TextFile) []engine.Finding
+}
+
+type Detector struct {
+	configs           *Config
+	allowlistPatterns []*regexp.Regexp
+}
+
+func NewDetector(configs *Config) Interface {
+	return &Detector{
+		configs:           configs,
+		allowlistPatterns: compileAllowlistPatterns(configs.AllowlistPatterns),
+	}
+}
+
+func compileAllowlistPatterns(patterns []string) (compiled []*regexp.Regexp) {
+	for _, pattern := range patterns {
+		expression, err := regexp.Compile(strings.TrimSpace(pattern))
+		if err != nil {
+			logger.LogErrorWithLevel("Invalid entropy allowlist pattern: "+pattern, err, logger.ErrorLevel)
+			continue
+		}
+		compiled = append(compiled, expression)
+	}
+	return compiled
+}
+
+func (d *Detector) Analyze(textUnits []text.TextUnit) (findings []engine.Finding) {
+	for _, unit := range textUnits {
+		for index := range unit.Files {
+			findings = append(findings, d.AnalyzeFile(&unit.Files[index])...)
+		}
+	}
+	return findings
+}
+
+// AnalyzeFile returns the high entropy tokens of the file, none when the path is allowlisted
+func (d *Detector) AnalyzeFile(file *text.TextFile) (findings []engine.Finding) {
+	if d.isAllowlistedPath(file.DisplayName) {
+		return findings
+	}
+	for lineIndex, line := range strings.Split(file.Content(), "\n") {
+		for _, position := range tokenFinder.FindAllStringIndex(line, -1) {
+			token := line[position[0]:position[1]]
+			if rule, isSecret := d.classify(token); isSecret {
+				findings = append(findings, d.newFinding(rule, file.DisplayName, line, lineIndex+1, position[0]))
+			}
+		}
+	}
+	return findings
+}
+
+func (d *Detector) classify(token string) (rule engine.Metadata, isSecret bool) {
+	if len(token) < d.configs.MinLength || d.isAllowlistedToken(token) || !PassQualityFilter(token) {
+		return rule, false
+	}
+	if isOnlyCharset(token, HexCharset) {
+		return NewHexRule(), ShannonEntropy(token, HexCharset) >= d.configs.HexThreshold
+	}
+	return NewBase64Rule(), ShannonEntropy(token, Base64Charset) >= d.configs.Base64Threshold
+}
+
+func (d *Detector) isAllowlistedToken(token string) bool {
+	for _, expression := range d.allowlistPatterns {
+		if expression.MatchString(token) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Detector) isAllowlistedPath(path string) bool {
+	for _, pattern := range d.configs.AllowlistPaths {
+		if matched, _ := doublestar.Match(strings.TrimSpace(pattern), path); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Detector) newFinding(rule engine.Metadata, filename, line string, lineNumber, column int) engine.Finding {
+	return engine.Finding{
+		ID:          rule.ID,
+		Name:        rule.Name,
+		Severity:    rule.Severity,
+		Confidence:  rule.Confidence,
+		Description: rule.Description,
+		CodeSample:  strings.TrimSpace(line),
+		SourceLocation: engine.Location{
+			Filename: filename,
+			Line:     lineNumber,
+			Column:   column,
+		},
+	}
+}
+
+// ShannonEntropy returns the entropy in bits of the characters of data that belong to charset
+func ShannonEntropy(data, charset string) (entropy float64) {
+	if data == "" {
+		return 0
+	}
+	for _, char := range charset {
+		probability := float64(strings.Count(data, string(char))) / float64(len(data))
+		if probability > 0 {
+			entropy -= probability * math.Log2(probability)
+		}
+	}
+	return entropy
+}
+
+// PassQualityFilter discards tokens that are random only in appearance, such as
+// identifiers, paths, repeated characters and sequences
+func PassQualityFilter(token string) bool {
+	return hasMixedCharacterClasses(token) &&
+		!hasLowCharacterVariety(token) &&
+		!isSequence(token) &&
+		strings.Count(token, "/") < 3 &&
+		strings.Count(token, "_")+strings.Count(token, "-") < 3
+}
+
+func hasMixedCharacterClasses(token string) bool {
+	hasLetter := strings.IndexFunc(token, func(r rune) bool {
+		return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+	}) >= 0
+	hasDigit := strings.IndexFunc(token, func(r rune) bool { return r >= '0' && r <= '9' }) >= 0
+	return hasLetter && hasDigit
+}
+
+func hasLowCharacterVariety(token string) bool {
+	unique := map[rune]bool{}
+	for _, char := range token {
+		unique[char] = true
+	}
+	return len(unique) < len(token)/4
+}
+
+func isSequence(token string) bool {
+	lower := strings.ToLower(token)
+	for _, sequence := range []string{"abcdefghij", "0123456789", "qwertyuiop", "xxxxxxxx"} {
+		if strings.Contains(lower, sequence) {
+			return true
+		}
+	}
+	return false
+}
+
+func isOnlyCharset(token, charset string) bool {
+	for _, char := range token {
+		if !strings.ContainsRune(charset, char) {
+			return false
+		}
+	}
+	return true
+}
+
+func NewBase64Rule() engine.Metadata {
+	return engine.Metadata{
+		ID:   Base64RuleID,
+		Name: "High Entropy Base64 String",
+		Description: "A random base64 token with high entropy was found, it may be a hardcoded secret. " +
+			"Secrets should be stored in a vault or encrypted environment variables. " +
+			"For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+		Severity:   severity.Medium.ToString(),
+		Confidence: confidence.Low.ToString(),
+	}
+}
+
+func NewHexRule() engine.Metadata {
+	return engine.Metadata{
+		ID:   HexRuleID,
+		Name: "High Entropy Hex String",
+		Description: "A random hexadecimal token with high entropy was found, it may be a hardcoded secret. " +
+			"Secrets should be stored in a vault or encrypted environment variables. " +
+			"For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+		Severity:   severity.Medium.ToString(),
+		Confidence: confidence.Low.ToString(),
+	}
+}
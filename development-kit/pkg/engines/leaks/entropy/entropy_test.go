@@ -0,0 +1,97 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entropy
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTextUnits(t *testing.T, filename, content string) []text.TextUnit {
+	file, err := text.NewTextFile(filename, []byte(content))
+	assert.NoError(t, err)
+	return []text.TextUnit{{Files: []text.TextFile{file}}}
+}
+
+func TestShannonEntropy(t *testing.T) {
+	t.Run("Should return zero for empty and repeated strings", func(t *testing.T) {
+		assert.Equal(t, float64(0), ShannonEntropy("", Base64Charset))
+		assert.Equal(t, float64(0), ShannonEntropy("aaaaaaaa", Base64Charset))
+	})
+	t.Run("Should return higher entropy for random strings", func(t *testing.T) {
+		assert.Greater(t, ShannonEntropy("Zx9pQ2mL7vB4nK8rT1wY6cJ3hF5dS0aG", Base64Charset),
+			ShannonEntropy("passwordpasswordpassword", Base64Charset))
+	})
+}
+
+func TestPassQualityFilter(t *testing.T) {
+	t.Run("Should reject tokens without digits", func(t *testing.T) {
+		assert.False(t, PassQualityFilter("ThisIsAVeryLongIdentifierName"))
+	})
+	t.Run("Should reject sequences and paths", func(t *testing.T) {
+		assert.False(t, PassQualityFilter("abcdefghij0123456789ABCD"))
+		assert.False(t, PassQualityFilter("src/main/java/com/App1"))
+	})
+	t.Run("Should accept random tokens", func(t *testing.T) {
+		assert.True(t, PassQualityFilter("Zx9pQ2mL7vB4nK8rT1wY6cJ3hF5dS0aG"))
+	})
+}
+
+func TestDetector_Analyze(t *testing.T) {
+	content := "package main\n\nconst token = \"Zx9pQ2mL7vB4nK8rT1wY6cJ3hF5dS0aG\"\n" +
+		"const hash = \"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b\"\nconst name = \"horusec\"\n"
+
+	t.Run("Should find base64 and hex tokens", func(t *testing.T) {
+		findings := NewDetector(NewConfig()).Analyze(newTextUnits(t, "main.go", content))
+		assert.Len(t, findings, 2)
+		assert.Equal(t, Base64RuleID, findings[0].ID)
+		assert.Equal(t, 3, findings[0].SourceLocation.Line)
+		assert.Equal(t, HexRuleID, findings[1].ID)
+		assert.Equal(t, 4, findings[1].SourceLocation.Line)
+	})
+	t.Run("Should not find tokens with threshold greater than entropy", func(t *testing.T) {
+		configs := NewConfig()
+		configs.Base64Threshold = 6
+		configs.HexThreshold = 4.1
+		assert.Len(t, NewDetector(configs).Analyze(newTextUnits(t, "main.go", content)), 0)
+	})
+	t.Run("Should not find tokens in allowlist", func(t *testing.T) {
+		configs := NewConfig()
+		configs.AllowlistPatterns = []string{"^Zx9p", "[invalid"}
+		findings := NewDetector(configs).Analyze(newTextUnits(t, "main.go", content))
+		assert.Len(t, findings, 1)
+		assert.Equal(t, HexRuleID, findings[0].ID)
+	})
+	t.Run("Should not analyze files in allowlist paths", func(t *testing.T) {
+		configs := NewConfig()
+		configs.AllowlistPaths = []string{"**/testdata/**"}
+		units := newTextUnits(t, "/project/testdata/main.go", content)
+		assert.Len(t, NewDetector(configs).Analyze(units), 0)
+	})
+}
+
+func TestInitFlags(t *testing.T) {
+	t.Run("Should parse entropy flags to config", func(t *testing.T) {
+		configs := NewConfig()
+		cmd := &cobra.Command{}
+		InitFlags(configs, cmd)
+		assert.NoError(t, cmd.PersistentFlags().Parse([]string{"--entropy", "--entropy-min-length=30"}))
+		assert.True(t, configs.Enabled)
+		assert.Equal(t, 30, configs.MinLength)
+	})
+}
@@ -35,14 +35,17 @@ func (r *Rules) GetAllRules() (rules []engine.Rule) {
 }
 
 func (r *Rules) addLeaksRules(rules []engine.Rule) []engine.Rule {
-	for index := range leaks.AllRulesLeaksAnd() {
-		rules = append(rules, leaks.AllRulesLeaksAnd()[index])
+	rulesLeaksAnd := leaks.AllRulesLeaksAnd()
+	for index := range rulesLeaksAnd {
+		rules = append(rules, rulesLeaksAnd[index])
 	}
-	for index := range leaks.AllRulesLeaksOr() {
-		rules = append(rules, leaks.AllRulesLeaksOr()[index])
+	rulesLeaksOr := leaks.AllRulesLeaksOr()
+	for index := range rulesLeaksOr {
+		rules = append(rules, rulesLeaksOr[index])
 	}
-	for index := range leaks.AllRulesLeaksRegular() {
-		rules = append(rules, leaks.AllRulesLeaksRegular()[index])
+	rulesLeaksRegular := leaks.AllRulesLeaksRegular()
+	for index := range rulesLeaksRegular {
+		rules = append(rules, rulesLeaksRegular[index])
 	}
 	return rules
 }
@@ -0,0 +1,158 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/cli_standard/config"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+)
+
+const (
+	// Same amount of bytes used by git to decide if a file is binary
+	binaryCheckBytes = 8000
+	bytesInMB        = 1024 * 1024
+
+	ReasonMaxFileSize = "file size greater than the limit"
+	ReasonBinaryFile  = "binary file"
+)
+
+type SkippedFile struct {
+	Path   string
+	Reason string
+}
+
+// Loader loads files into text units like the horusec engine, but checks the size and the first bytes of each
+// file before reading it, so huge and binary files don't stall the regex matching
+type Loader struct {
+	maxFileSize  int64
+	skippedFiles []SkippedFile
+}
+
+func NewLoader(configs *config.Config) *Loader {
+	return &Loader{
+		maxFileSize: configs.GetMaxFileSizeInMB() * bytesInMB,
+	}
+}
+
+// LoadDirIntoSingleUnit has the same behavior of text.LoadDirIntoSingleUnit
+func (l *Loader) LoadDirIntoSingleUnit(path string, extensionsAccept []string) (text.TextUnit, error) {
+	unit := text.TextUnit{}
+	err := l.walk(path, extensionsAccept, func(textFile text.TextFile) {
+		unit.Files = append(unit.Files, textFile)
+	})
+	return unit, err
+}
+
+// LoadDirIntoMultiUnit has the same behavior of text.LoadDirIntoMultiUnit
+func (l *Loader) LoadDirIntoMultiUnit(
+	path string, maxFilesPerTextUnit int, extensionsAccept []string) ([]text.TextUnit, error) {
+	units := []text.TextUnit{{}}
+	err := l.walk(path, extensionsAccept, func(textFile text.TextFile) {
+		lastIndex := len(units) - 1
+		units[lastIndex].Files = append(units[lastIndex].Files, textFile)
+		if len(units[lastIndex].Files) >= maxFilesPerTextUnit {
+			units = append(units, text.TextUnit{})
+		}
+	})
+	return units, err
+}
+
+// Walk reads each accepted file and sends it to the callback, without keeping the files loaded
+func (l *Loader) Walk(path string, extensionsAccept []string, addFile func(textFile text.TextFile)) error {
+	return l.walk(path, extensionsAccept, addFile)
+}
+
+func (l *Loader) GetSkippedFiles() []SkippedFile {
+	return l.skippedFiles
+}
+
+func (l *Loader) LogSkippedFiles() {
+	if len(l.skippedFiles) == 0 {
+		return
+	}
+	logger.LogDebugWithLevel(fmt.Sprintf("Skipped files (%d):", len(l.skippedFiles)), logger.DebugLevel)
+	for _, skipped := range l.skippedFiles {
+		logger.LogDebugWithLevel(fmt.Sprintf("  %s: %s", skipped.Path, skipped.Reason), logger.DebugLevel)
+	}
+}
+
+func (l *Loader) walk(path string, extensionsAccept []string, addFile func(textFile text.TextFile)) error {
+	l.skippedFiles = []SkippedFile{}
+	return filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !l.isAcceptedExtension(filePath, extensionsAccept) {
+			return err
+		}
+		if isToSkip, err := l.isToSkip(filePath, info); err != nil || isToSkip {
+			return err
+		}
+		textFile, err := text.ReadAndCreateTextFile(filePath)
+		if err != nil {
+			return err
+		}
+		addFile(textFile)
+		return nil
+	})
+}
+
+func (l *Loader) isAcceptedExtension(path string, extensionsAccept []string) bool {
+	ext := filepath.Ext(path)
+	for _, extAccept := range extensionsAccept {
+		if ext == extAccept || extAccept == text.AcceptAllExtensions {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *Loader) isToSkip(path string, info os.FileInfo) (bool, error) {
+	if l.maxFileSize > 0 && info.Size() > l.maxFileSize {
+		l.skippedFiles = append(l.skippedFiles, SkippedFile{Path: path, Reason: ReasonMaxFileSize})
+		return true, nil
+	}
+	isBinary, err := l.isBinaryFile(path)
+	if isBinary {
+		l.skippedFiles = append(l.skippedFiles, SkippedFile{Path: path, Reason: ReasonBinaryFile})
+	}
+	return isBinary, err
+}
+
+// isBinaryFile checks for a NUL byte in the start of the file, UTF-16 files with BOM are kept
+// because the horusec engine converts them to UTF-8
+func (l *Loader) isBinaryFile(path string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		logger.LogError("Error defer file close", file.Close())
+	}()
+	content := make([]byte, binaryCheckBytes)
+	size, err := io.ReadFull(file, content)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return false, err
+	}
+	content = content[:size]
+	if bytes.HasPrefix(content, []byte{0xFF, 0xFE}) || bytes.HasPrefix(content, []byte{0xFE, 0xFF}) {
+		return false, nil
+	}
+	return bytes.IndexByte(content, 0) >= 0, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loader
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/cli_standard/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newProject(t *testing.T) string {
+	projectPath, err := ioutil.TempDir("", "loader")
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "Main.java"), []byte("class Main {}"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "lib.jar"), []byte("PK\x03\x04\x00\x00binary"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "big.java"),
+		[]byte(strings.Repeat("a", bytesInMB+1)), 0600))
+	return projectPath
+}
+
+func TestLoadDirIntoSingleUnit(t *testing.T) {
+	t.Run("Should skip binary files and files greater than max file size", func(t *testing.T) {
+		projectPath := newProject(t)
+		defer os.RemoveAll(projectPath)
+		configs := config.NewConfig()
+		configs.SetMaxFileSizeInMB(1)
+		textLoader := NewLoader(configs)
+
+		unit, err := textLoader.LoadDirIntoSingleUnit(projectPath, []string{"**"})
+
+		assert.NoError(t, err)
+		assert.Len(t, unit.Files, 1)
+		assert.Equal(t, []SkippedFile{
+			{Path: filepath.Join(projectPath, "big.java"), Reason: ReasonMaxFileSize},
+			{Path: filepath.Join(projectPath, "lib.jar"), Reason: ReasonBinaryFile},
+		}, textLoader.GetSkippedFiles())
+	})
+	t.Run("Should not skip by size when max file size is disabled", func(t *testing.T) {
+		projectPath := newProject(t)
+		defer os.RemoveAll(projectPath)
+		configs := config.NewConfig()
+		configs.SetMaxFileSizeInMB(0)
+
+		unit, err := NewLoader(configs).LoadDirIntoSingleUnit(projectPath, []string{".java"})
+
+		assert.NoError(t, err)
+		assert.Len(t, unit.Files, 2)
+	})
+}
+
+func TestLoadDirIntoMultiUnit(t *testing.T) {
+	t.Run("Should split files in units", func(t *testing.T) {
+		projectPath := newProject(t)
+		defer os.RemoveAll(projectPath)
+		configs := config.NewConfig()
+		configs.SetMaxFileSizeInMB(0)
+
+		units, err := NewLoader(configs).LoadDirIntoMultiUnit(projectPath, 1, []string{"**"})
+
+		assert.NoError(t, err)
+		assert.Len(t, units, 3)
+		assert.Len(t, units[2].Files, 0)
+	})
+}
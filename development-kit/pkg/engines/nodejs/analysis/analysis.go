@@ -17,10 +17,11 @@ package analysis
 import (
 	"encoding/json"
 
-	engine "github.com/ZupIT/horusec-engine"
-	"github.com/ZupIT/horusec-engine/text"
 	"github.com/ZupIT/horusec/development-kit/pkg/cli_standard/config"
 	"github.com/ZupIT/horusec/development-kit/pkg/engines/nodejs/rules"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rulepack"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/runner"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 )
 
@@ -37,21 +38,19 @@ func NewAnalysis(configs *config.Config) *Analysis {
 }
 
 func (a *Analysis) StartAnalysis() error {
-	textUnits, err := text.LoadDirIntoMultiUnit(a.configs.GetProjectPath(), 5, []string{
-		".js", ".ts", ".jsx", ".tsx"})
+	allRules, err := rulepack.GetRules(tools.HorusecNodejs, a.configs.GetRulePacks(), a.configs.GetRulePacksPath(),
+		a.serviceRules.GetAllRules())
 	if err != nil {
 		return err
 	}
-	units := a.parseTextUnitsToUnits(textUnits)
-	a.logJSON("Texts Units selected are: ", textUnits)
-
-	allRules := a.serviceRules.GetAllRules()
 	a.logJSON("All rules selected are: ", allRules)
 
 	outputFilePath := a.configs.GetOutputFilePath()
-	logger.LogDebugWithLevel("Sending units and rules to engine "+
+	logger.LogDebugWithLevel("Sending files and rules to engine "+
 		" and expected response in path: ", logger.DebugLevel, outputFilePath)
-	return engine.RunOutputInJSON(units, allRules, outputFilePath)
+	return runner.NewRunner(a.configs).RunOutputInJSON(a.configs.GetProjectPath(), []string{
+		".js", ".ts", ".jsx", ".tsx"}, allRules,
+		outputFilePath)
 }
 
 func (a *Analysis) logJSON(message string, content interface{}) {
@@ -60,10 +59,3 @@ func (a *Analysis) logJSON(message string, content interface{}) {
 		logger.LogTraceWithLevel(message, logger.DebugLevel, string(b))
 	}
 }
-
-func (a *Analysis) parseTextUnitsToUnits(textUnits []text.TextUnit) (units []engine.Unit) {
-	for index := range textUnits {
-		units = append(units, textUnits[index])
-	}
-	return units
-}
@@ -35,14 +35,17 @@ func (r *Rules) GetAllRules() (rules []engine.Rule) {
 }
 
 func (r *Rules) addRules(rules []engine.Rule) []engine.Rule {
-	for index := range nodejs.AllRulesNodeJSAnd() {
-		rules = append(rules, nodejs.AllRulesNodeJSAnd()[index])
+	rulesNodeJSAnd := nodejs.AllRulesNodeJSAnd()
+	for index := range rulesNodeJSAnd {
+		rules = append(rules, rulesNodeJSAnd[index])
 	}
-	for index := range nodejs.AllRulesNodeJSOr() {
-		rules = append(rules, nodejs.AllRulesNodeJSOr()[index])
+	rulesNodeJSOr := nodejs.AllRulesNodeJSOr()
+	for index := range rulesNodeJSOr {
+		rules = append(rules, rulesNodeJSOr[index])
 	}
-	for index := range nodejs.AllRulesNodeJSRegular() {
-		rules = append(rules, nodejs.AllRulesNodeJSRegular()[index])
+	rulesNodeJSRegular := nodejs.AllRulesNodeJSRegular()
+	for index := range rulesNodeJSRegular {
+		rules = append(rules, rulesNodeJSRegular[index])
 	}
 	return rules
 }
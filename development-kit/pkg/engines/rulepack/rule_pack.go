@@ -0,0 +1,286 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rulepack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/structural"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+)
+
+const (
+	// Separator of the name and of the version of the pack, like java-core@1.4
+	versionSeparator = "@"
+	fileExtension    = ".json"
+
+	// BuiltinVersion is the version of the packs built in the images of the engines
+	BuiltinVersion = "1.0"
+
+	TypeRegular  = "regular"
+	TypeNotMatch = "not"
+	TypeOrMatch  = "or"
+	TypeAndMatch = "and"
+	// TypeStructural rules use patterns of code instead of expressions, see the structural package
+	TypeStructural = "structural"
+	// TypeTaint rules find the last expression only after the other expressions in order, like a source and a sink
+	TypeTaint = "taint"
+)
+
+var (
+	ErrInvalidReference = errors.New("{RULE_PACK} the rule pack must be informed as name@version")
+	ErrInvalidRuleType  = errors.New("{RULE_PACK} the type of the rule must be regular, not, or, and")
+)
+
+// Rule is the text rule of the engine with the expressions as strings, so it can be distributed in json files,
+// the structural rules use the patterns instead of the expressions and the taint rules use the scope and max lines.
+// The text rules can ignore the matches in the comments and in the content of the strings
+type Rule struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Severity    string   `json:"severity"`
+	Confidence  string   `json:"confidence"`
+	Type        string   `json:"type"`
+	Expressions []string `json:"expressions"`
+	Patterns    []string `json:"patterns,omitempty"`
+	PatternsNot []string `json:"patternsNot,omitempty"`
+	Scope       string   `json:"scope,omitempty"`
+	MaxLines    int      `json:"maxLines,omitempty"`
+
+	IgnoreComments bool `json:"ignoreComments,omitempty"`
+	IgnoreStrings  bool `json:"ignoreStrings,omitempty"`
+}
+
+// Pack is a versioned set of rules of an engine, the engine is the name of its tool, like HorusecJava
+type Pack struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Engine  string `json:"engine"`
+	Rules   []Rule `json:"rules"`
+}
+
+// RuleError is a rule of a pack that could not be compiled, like a rule with an invalid expression
+type RuleError struct {
+	Pack   string
+	RuleID string
+	Err    error
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("{RULE_PACK} %s rule %s: %v", e.Pack, e.RuleID, e.Err)
+}
+
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
+
+type Reference struct {
+	Name    string
+	Version string
+}
+
+// ParseReference accepts the name without version to the builtin packs, that uses the builtin version
+func ParseReference(value string) (Reference, error) {
+	values := strings.SplitN(strings.TrimSpace(value), versionSeparator, 2)
+	reference := Reference{Name: values[0], Version: BuiltinVersion}
+	if len(values) == 2 {
+		reference.Version = values[1]
+	}
+	if reference.Name == "" || reference.Version == "" || strings.ContainsAny(value, `/\`) {
+		return Reference{}, ErrInvalidReference
+	}
+	return reference, nil
+}
+
+func (r Reference) String() string {
+	return r.Name + versionSeparator + r.Version
+}
+
+func (r Reference) GetFileName() string {
+	return r.String() + fileExtension
+}
+
+// IsBuiltin returns if the reference is the version of the pack built in the images of the engines
+func (r Reference) IsBuiltin() bool {
+	_, ok := GetBuiltinPackEngine(r.Name)
+	return ok && r.Version == BuiltinVersion
+}
+
+// GetBuiltinPacks returns the name of the pack built in the image of each engine
+func GetBuiltinPacks() map[tools.Tool]string {
+	return map[tools.Tool]string{
+		tools.HorusecJava:       "java-core",
+		tools.HorusecKotlin:     "kotlin-core",
+		tools.HorusecCsharp:     "csharp-core",
+		tools.HorusecNodejs:     "nodejs-core",
+		tools.HorusecKubernetes: "kubernetes-core",
+		tools.HorusecLeaks:      "leaks",
+	}
+}
+
+func GetBuiltinPackEngine(name string) (tools.Tool, bool) {
+	for tool, packName := range GetBuiltinPacks() {
+		if packName == name {
+			return tool, true
+		}
+	}
+	return "", false
+}
+
+func ReadPack(directory string, reference Reference) (*Pack, error) {
+	content, err := ioutil.ReadFile(filepath.Join(directory, reference.GetFileName()))
+	if err != nil {
+		return nil, err
+	}
+	pack := &Pack{}
+	if err := json.Unmarshal(content, pack); err != nil {
+		return nil, err
+	}
+	return pack, nil
+}
+
+// GetEngineRules compiles the expressions of the rules, returning error in the first invalid expression
+func (p *Pack) GetEngineRules() ([]engine.Rule, error) {
+	rules, ruleErrors := p.CompileRules()
+	if len(ruleErrors) > 0 {
+		return nil, ruleErrors[0]
+	}
+	return rules, nil
+}
+
+// CompileRules compiles each rule once, returning the rules compiled and the errors of the others
+func (p *Pack) CompileRules() (rules []engine.Rule, ruleErrors []*RuleError) {
+	for index := range p.Rules {
+		rule, err := p.Rules[index].toEngineRule()
+		if err != nil {
+			ruleErrors = append(ruleErrors, &RuleError{Pack: p.Name, RuleID: p.Rules[index].ID, Err: err})
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules, ruleErrors
+}
+
+func (r *Rule) toEngineRule() (engine.Rule, error) {
+	switch r.Type {
+	case TypeStructural:
+		return structural.NewRule(r.getMetadata(), r.Patterns, r.PatternsNot)
+	case TypeTaint:
+		return structural.NewTaintRule(r.getMetadata(), r.Expressions, structural.Scope(r.Scope), r.MaxLines)
+	}
+	rule, err := r.toTextRule()
+	if err != nil || (!r.IgnoreComments && !r.IgnoreStrings) {
+		return rule, err
+	}
+	return structural.NewCodeRule(rule, r.IgnoreComments, r.IgnoreStrings), nil
+}
+
+func (r *Rule) getMetadata() engine.Metadata {
+	return engine.Metadata{ID: r.ID, Name: r.Name, Description: r.Description, Severity: r.Severity,
+		Confidence: r.Confidence}
+}
+
+func (r *Rule) toTextRule() (rule text.TextRule, err error) {
+	rule = text.TextRule{Metadata: r.getMetadata()}
+	if rule.Type, err = r.getMatchType(); err != nil {
+		return rule, err
+	}
+	for _, expression := range r.Expressions {
+		compiled, err := regexp.Compile(expression)
+		if err != nil {
+			return rule, err
+		}
+		rule.Expressions = append(rule.Expressions, compiled)
+	}
+	return rule, nil
+}
+
+func (r *Rule) getMatchType() (text.MatchType, error) {
+	switch r.Type {
+	case TypeRegular, "":
+		return text.Regular, nil
+	case TypeNotMatch:
+		return text.NotMatch, nil
+	case TypeOrMatch:
+		return text.OrMatch, nil
+	case TypeAndMatch:
+		return text.AndMatch, nil
+	}
+	return text.Regular, ErrInvalidRuleType
+}
+
+// GetRules returns the rules of the packs of the engine selected in the references, when no pack of the engine is
+// selected all the builtin rules are returned, the packs not builtin are read from the directory and their rules not
+// compiled are reported and skipped
+func GetRules(tool tools.Tool, references []string, directory string,
+	builtinRules []engine.Rule) ([]engine.Rule, error) {
+	var rules []engine.Rule
+	isSelected := false
+	for _, value := range references {
+		reference, err := ParseReference(value)
+		if err != nil {
+			return nil, err
+		}
+		packRules, ok, err := getPackRules(tool, reference, directory, builtinRules)
+		if err != nil {
+			return nil, err
+		}
+		isSelected = isSelected || ok
+		rules = append(rules, packRules...)
+	}
+	if !isSelected {
+		return builtinRules, nil
+	}
+	return rules, nil
+}
+
+func getPackRules(tool tools.Tool, reference Reference, directory string,
+	builtinRules []engine.Rule) ([]engine.Rule, bool, error) {
+	if reference.IsBuiltin() {
+		if GetBuiltinPacks()[tool] != reference.Name {
+			return nil, false, nil
+		}
+		return builtinRules, true, nil
+	}
+	pack, err := ReadPack(directory, reference)
+	if err != nil || pack.Engine != tool.ToString() {
+		return nil, false, err
+	}
+	rules, ruleErrors := pack.CompileRules()
+	logRuleErrors(reference, ruleErrors)
+	return rules, true, nil
+}
+
+// logRuleErrors reports in the start of the analysis the rules of the pack skipped because they were not compiled
+func logRuleErrors(reference Reference, ruleErrors []*RuleError) {
+	if len(ruleErrors) == 0 {
+		return
+	}
+	logger.LogWarnWithLevel(fmt.Sprintf("{RULE_PACK} Rules of the pack %s not compiled and skipped (%d):",
+		reference.String(), len(ruleErrors)), logger.WarnLevel)
+	for _, ruleError := range ruleErrors {
+		logger.LogWarnWithLevel("  "+ruleError.Error(), logger.WarnLevel)
+	}
+}
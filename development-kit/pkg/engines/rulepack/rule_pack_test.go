@@ -0,0 +1,190 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rulepack
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/structural"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+)
+
+func newPackToTest() *Pack {
+	return &Pack{Name: "java-core", Version: "1.4", Engine: tools.HorusecJava.ToString(), Rules: []Rule{
+		{ID: "HS-JAVA-1000", Name: "Hardcoded password", Severity: "HIGH", Confidence: "MEDIUM", Type: TypeOrMatch,
+			Expressions: []string{`password\s*=\s*".+"`, `pwd\s*=\s*".+"`}},
+	}}
+}
+
+func writePack(t *testing.T, directory string, pack *Pack) {
+	content, err := json.Marshal(pack)
+	assert.NoError(t, err)
+	reference := Reference{Name: pack.Name, Version: pack.Version}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, reference.GetFileName()), content, 0600))
+}
+
+func TestParseReference(t *testing.T) {
+	t.Run("should parse the name and the version", func(t *testing.T) {
+		reference, err := ParseReference(" java-core@1.4 ")
+		assert.NoError(t, err)
+		assert.Equal(t, Reference{Name: "java-core", Version: "1.4"}, reference)
+		assert.Equal(t, "java-core@1.4.json", reference.GetFileName())
+		assert.False(t, reference.IsBuiltin())
+	})
+
+	t.Run("should use the builtin version without version", func(t *testing.T) {
+		reference, err := ParseReference("leaks")
+		assert.NoError(t, err)
+		assert.Equal(t, "leaks@"+BuiltinVersion, reference.String())
+		assert.True(t, reference.IsBuiltin())
+	})
+
+	t.Run("should return error when the reference is invalid", func(t *testing.T) {
+		for _, value := range []string{"", "@1.0", "leaks@", "../leaks@1.0"} {
+			_, err := ParseReference(value)
+			assert.Equal(t, ErrInvalidReference, err, value)
+		}
+	})
+}
+
+func TestGetEngineRules(t *testing.T) {
+	t.Run("should compile the rules of the pack", func(t *testing.T) {
+		rules, err := newPackToTest().GetEngineRules()
+		assert.NoError(t, err)
+		assert.Len(t, rules, 1)
+		rule := rules[0].(text.TextRule)
+		assert.Equal(t, "HS-JAVA-1000", rule.ID)
+		assert.Equal(t, text.OrMatch, rule.Type)
+		assert.Len(t, rule.Expressions, 2)
+	})
+
+	t.Run("should compile the structural rules of the pack", func(t *testing.T) {
+		pack := newPackToTest()
+		pack.Rules[0] = Rule{ID: "HS-JAVA-1001", Severity: "HIGH", Type: TypeStructural,
+			Patterns: []string{"$Y = request.getParameter(...); ... statement.executeQuery($Y)"}}
+		rules, err := pack.GetEngineRules()
+		assert.NoError(t, err)
+		assert.Len(t, rules, 1)
+		rule := rules[0].(structural.Rule)
+		assert.Equal(t, "HS-JAVA-1001", rule.ID)
+		assert.Len(t, rule.Patterns, 1)
+
+		pack.Rules[0].Patterns = []string{"executeQuery("}
+		_, err = pack.GetEngineRules()
+		assert.Equal(t, structural.ErrUnbalancedPattern, errors.Unwrap(err))
+	})
+
+	t.Run("should compile the taint rules of the pack", func(t *testing.T) {
+		pack := newPackToTest()
+		pack.Rules[0] = Rule{ID: "HS-JAVA-1002", Severity: "HIGH", Type: TypeTaint, Scope: "file", MaxLines: 10,
+			Expressions: []string{`request\.getParameter\(`, `\.executeQuery\(`}}
+		rules, err := pack.GetEngineRules()
+		assert.NoError(t, err)
+		rule := rules[0].(structural.TaintRule)
+		assert.Equal(t, structural.ScopeFile, rule.Scope)
+		assert.Equal(t, 10, rule.MaxLines)
+
+		pack.Rules[0].Expressions = pack.Rules[0].Expressions[:1]
+		_, err = pack.GetEngineRules()
+		assert.Equal(t, structural.ErrTaintWithoutSink, errors.Unwrap(err))
+	})
+
+	t.Run("should compile the text rules that ignore the comments and the strings", func(t *testing.T) {
+		pack := newPackToTest()
+		pack.Rules[0].IgnoreComments = true
+		rules, err := pack.GetEngineRules()
+		assert.NoError(t, err)
+		rule := rules[0].(structural.CodeRule)
+		assert.True(t, rule.IgnoreComments)
+		assert.False(t, rule.IgnoreStrings)
+		assert.Len(t, rule.Expressions, len(pack.Rules[0].Expressions))
+	})
+
+	t.Run("should return error when the rule is invalid", func(t *testing.T) {
+		pack := newPackToTest()
+		pack.Rules[0].Expressions = []string{"("}
+		_, err := pack.GetEngineRules()
+		assert.Error(t, err)
+
+		pack.Rules[0].Type = "xor"
+		_, err = pack.GetEngineRules()
+		assert.Error(t, err)
+	})
+
+	t.Run("should return the errors of all the rules not compiled", func(t *testing.T) {
+		pack := newPackToTest()
+		pack.Rules = append(pack.Rules, Rule{ID: "HS-JAVA-1001", Expressions: []string{"("}},
+			Rule{ID: "HS-JAVA-1002", Type: "xor"})
+		rules, ruleErrors := pack.CompileRules()
+		assert.Len(t, rules, 1)
+		assert.Len(t, ruleErrors, 2)
+		assert.Equal(t, "HS-JAVA-1001", ruleErrors[0].RuleID)
+		assert.Equal(t, ErrInvalidRuleType, errors.Unwrap(ruleErrors[1]))
+	})
+}
+
+func TestGetRules(t *testing.T) {
+	builtinRules := []engine.Rule{text.TextRule{Metadata: engine.Metadata{ID: "HS-JAVA-1"}}}
+
+	t.Run("should return the builtin rules when no pack of the engine is selected", func(t *testing.T) {
+		rules, err := GetRules(tools.HorusecJava, []string{"leaks"}, "", builtinRules)
+		assert.NoError(t, err)
+		assert.Equal(t, builtinRules, rules)
+	})
+
+	t.Run("should return the rules of the packs of the engine", func(t *testing.T) {
+		directory, err := ioutil.TempDir("", "horusec-rule-packs")
+		assert.NoError(t, err)
+		defer os.RemoveAll(directory)
+		writePack(t, directory, newPackToTest())
+
+		rules, err := GetRules(tools.HorusecJava, []string{"java-core@1.4"}, directory, builtinRules)
+		assert.NoError(t, err)
+		assert.Len(t, rules, 1)
+		assert.Equal(t, "HS-JAVA-1000", rules[0].(text.TextRule).ID)
+
+		rules, err = GetRules(tools.HorusecKotlin, []string{"java-core@1.4"}, directory, builtinRules)
+		assert.NoError(t, err)
+		assert.Equal(t, builtinRules, rules)
+	})
+
+	t.Run("should skip the rules of the pack not compiled", func(t *testing.T) {
+		directory, err := ioutil.TempDir("", "horusec-rule-packs")
+		assert.NoError(t, err)
+		defer os.RemoveAll(directory)
+		pack := newPackToTest()
+		pack.Rules = append(pack.Rules, Rule{ID: "HS-JAVA-1001", Type: TypeRegular, Expressions: []string{`(`}})
+		writePack(t, directory, pack)
+
+		rules, err := GetRules(tools.HorusecJava, []string{"java-core@1.4"}, directory, builtinRules)
+		assert.NoError(t, err)
+		assert.Len(t, rules, 1)
+		assert.Equal(t, "HS-JAVA-1000", rules[0].(text.TextRule).ID)
+	})
+
+	t.Run("should return error when the pack is not found", func(t *testing.T) {
+		_, err := GetRules(tools.HorusecJava, []string{"java-core@9.9"}, os.TempDir(), builtinRules)
+		assert.Error(t, err)
+	})
+}
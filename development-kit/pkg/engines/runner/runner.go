@@ -0,0 +1,255 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runner
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/cli_standard/config"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/loader"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/structural"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+)
+
+const (
+	bytesInMB        = 1024 * 1024
+	throttleInterval = 50 * time.Millisecond
+)
+
+// FileAnalyzer finds vulnerabilities in a file without rules, like the entropy of the leaks
+type FileAnalyzer func(file *text.TextFile) []engine.Finding
+
+// fileJob is a file sent to the workers, the rules are not evaluated again in the duplicates of a file already sent
+type fileJob struct {
+	file      text.TextFile
+	duplicate bool
+}
+
+type fileResult struct {
+	fileName         string
+	ruleFindings     []engine.Finding
+	analyzerFindings []engine.Finding
+}
+
+// Runner replaces the run of the horusec engine, that starts a goroutine to each file and rule with all the files
+// loaded. The files are read while the project is walked and sent to a bounded pool of workers, each worker
+// evaluates all the rules in one file at a time, so only the files being analyzed are kept in memory.
+// The files with the same extension and content, like vendored copies and generated bundles, are matched once and
+// the findings of the rules are attributed to all the duplicated paths
+type Runner struct {
+	loader      *loader.Loader
+	workers     int
+	memoryLimit uint64
+	ruleTimeout time.Duration
+	active      int32
+}
+
+func NewRunner(configs *config.Config) *Runner {
+	workers := configs.GetEngineWorkers()
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	runner := &Runner{loader: loader.NewLoader(configs), workers: workers,
+		ruleTimeout: time.Duration(configs.GetEngineRuleTimeoutInMs()) * time.Millisecond}
+	if configs.GetEngineMemoryLimitInMB() > 0 {
+		runner.memoryLimit = uint64(configs.GetEngineMemoryLimitInMB()) * bytesInMB
+	}
+	return runner
+}
+
+// Run returns the findings of the rules and of the file analyzers in the files of the path with the extensions
+func (r *Runner) Run(path string, extensionsAccept []string, rules []engine.Rule,
+	analyzers ...FileAnalyzer) ([]engine.Finding, error) {
+	files := make(chan fileJob, r.workers)
+	results := make(chan fileResult, r.workers)
+	wg := &sync.WaitGroup{}
+	for worker := 0; worker < r.workers; worker++ {
+		wg.Add(1)
+		go r.startWorker(files, results, wg, rules, analyzers)
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	duplicates := map[string][]string{}
+	walkErr := make(chan error, 1)
+	go func() {
+		walkErr <- r.walk(path, extensionsAccept, files, duplicates)
+		close(files)
+	}()
+	findings := r.collectFindings(results, duplicates)
+	r.loader.LogSkippedFiles()
+	return findings, <-walkErr
+}
+
+// walk sends the files to the workers and fills the duplicates with the paths of the files with the same content of
+// each file sent first
+func (r *Runner) walk(path string, extensionsAccept []string, files chan<- fileJob,
+	duplicates map[string][]string) error {
+	firstFiles := map[string]string{}
+	return r.loader.Walk(path, extensionsAccept, func(textFile text.TextFile) {
+		key := getContentKey(textFile)
+		firstFile, isDuplicate := firstFiles[key]
+		if isDuplicate {
+			duplicates[firstFile] = append(duplicates[firstFile], textFile.DisplayName)
+		} else {
+			firstFiles[key] = textFile.DisplayName
+		}
+		files <- fileJob{file: textFile, duplicate: isDuplicate}
+	})
+}
+
+// collectFindings is called with the walk still running, the duplicates are only read after all the results
+func (r *Runner) collectFindings(results <-chan fileResult, duplicates map[string][]string) []engine.Finding {
+	findings := []engine.Finding{}
+	ruleFindingsByFile := map[string][]engine.Finding{}
+	for result := range results {
+		findings = append(findings, result.ruleFindings...)
+		findings = append(findings, result.analyzerFindings...)
+		if len(result.ruleFindings) > 0 {
+			ruleFindingsByFile[result.fileName] = result.ruleFindings
+		}
+	}
+	for fileName, duplicatedFiles := range duplicates {
+		logger.LogDebugWithLevel(fmt.Sprintf("{HORUSEC_ENGINE} Rules not evaluated again in the duplicates of %s: %s",
+			fileName, strings.Join(duplicatedFiles, ", ")), logger.DebugLevel)
+		for _, duplicatedFile := range duplicatedFiles {
+			findings = append(findings, copyFindingsToFile(ruleFindingsByFile[fileName], duplicatedFile)...)
+		}
+	}
+	return findings
+}
+
+func copyFindingsToFile(findings []engine.Finding, fileName string) (copies []engine.Finding) {
+	for _, finding := range findings {
+		finding.SourceLocation.Filename = fileName
+		copies = append(copies, finding)
+	}
+	return copies
+}
+
+// getContentKey uses the extension with the content because the structural rules tokenize the comments by it
+func getContentKey(file text.TextFile) string {
+	hash := sha256.Sum256([]byte(file.RawString))
+	return strings.ToLower(filepath.Ext(file.Name)) + ":" + hex.EncodeToString(hash[:])
+}
+
+// RunOutputInJSON writes the findings in the output file like the horusec engine
+func (r *Runner) RunOutputInJSON(path string, extensionsAccept []string, rules []engine.Rule, outputFilePath string,
+	analyzers ...FileAnalyzer) error {
+	findings, err := r.Run(path, extensionsAccept, rules, analyzers...)
+	if err != nil {
+		return err
+	}
+	bytesToWrite, err := json.MarshalIndent(findings, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(outputFilePath, bytesToWrite, 0600)
+}
+
+func (r *Runner) startWorker(files <-chan fileJob, results chan<- fileResult,
+	wg *sync.WaitGroup, rules []engine.Rule, analyzers []FileAnalyzer) {
+	defer wg.Done()
+	for job := range files {
+		r.throttle()
+		atomic.AddInt32(&r.active, 1)
+		result := fileResult{fileName: job.file.DisplayName}
+		if !job.duplicate {
+			result.ruleFindings = r.evalRules(job.file, rules)
+		}
+		result.analyzerFindings = r.evalAnalyzers(job.file, analyzers)
+		atomic.AddInt32(&r.active, -1)
+		results <- result
+	}
+}
+
+func (r *Runner) evalRules(file text.TextFile, rules []engine.Rule) (findings []engine.Finding) {
+	unit := structural.NewUnit(text.TextUnit{Files: []text.TextFile{file}})
+	for _, rule := range rules {
+		if rule.IsFor(unit.Type()) {
+			findings = append(findings, r.evalRule(unit, rule, file.DisplayName)...)
+		}
+	}
+	return findings
+}
+
+// evalAnalyzers runs even in the duplicated files because the analyzers can depend on the path, like the allowlist
+// of the entropy
+func (r *Runner) evalAnalyzers(file text.TextFile, analyzers []FileAnalyzer) (findings []engine.Finding) {
+	for _, analyzer := range analyzers {
+		findings = append(findings, analyzer(&file)...)
+	}
+	return findings
+}
+
+// evalRule stops the rule in the file after the rule timeout, so a pathological rule of a custom pack can't hang
+// the analysis. The deadline is checked in the loops of the matchers, in the same goroutine of the worker
+func (r *Runner) evalRule(unit structural.Unit, rule engine.Rule, fileName string) []engine.Finding {
+	if r.ruleTimeout <= 0 {
+		return unit.Eval(rule)
+	}
+
+	findings, err := unit.EvalUntil(rule, time.Now().Add(r.ruleTimeout))
+	if err != nil {
+		logger.LogWarnWithLevel(fmt.Sprintf("{HORUSEC_ENGINE} Rule %s skipped in the file %s, it took more than %s",
+			getRuleID(rule), fileName, r.ruleTimeout), logger.WarnLevel)
+		return nil
+	}
+	return findings
+}
+
+func getRuleID(rule engine.Rule) string {
+	switch current := rule.(type) {
+	case text.TextRule:
+		return current.ID
+	case structural.Rule:
+		return current.ID
+	case structural.TaintRule:
+		return current.ID
+	case structural.CodeRule:
+		return current.ID
+	}
+	return fmt.Sprintf("%T", rule)
+}
+
+// throttle waits while the heap is above the memory limit and other worker is analyzing a file, so the analysis
+// goes on with a single worker even when the limit is lower than the memory of a single file
+func (r *Runner) throttle() {
+	if r.memoryLimit == 0 {
+		return
+	}
+	for atomic.LoadInt32(&r.active) > 0 && r.getHeapInUse() > r.memoryLimit {
+		time.Sleep(throttleInterval)
+	}
+}
+
+func (r *Runner) getHeapInUse() uint64 {
+	stats := &runtime.MemStats{}
+	runtime.ReadMemStats(stats)
+	return stats.HeapInuse
+}
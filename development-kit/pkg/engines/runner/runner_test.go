@@ -0,0 +1,220 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runner
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/cli_standard/config"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/structural"
+	"github.com/stretchr/testify/assert"
+)
+
+func newProject(t *testing.T, totalFiles int) string {
+	projectPath, err := ioutil.TempDir("", "runner")
+	assert.NoError(t, err)
+	for index := 0; index < totalFiles; index++ {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, fmt.Sprintf("file-%d.js", index)),
+			[]byte("const a = eval(input)"), 0600))
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "README.md"), []byte("eval(input)"), 0600))
+	return projectPath
+}
+
+func newRules() []engine.Rule {
+	return []engine.Rule{text.TextRule{Metadata: engine.Metadata{ID: "HS-JS-1"}, Type: text.Regular,
+		Expressions: []*regexp.Regexp{regexp.MustCompile(`eval\(`)}}}
+}
+
+func TestNewRunner(t *testing.T) {
+	t.Run("Should use the number of CPUs when the workers are not informed", func(t *testing.T) {
+		runner := NewRunner(config.NewConfig())
+		assert.Equal(t, runtime.NumCPU(), runner.workers)
+		assert.Equal(t, uint64(0), runner.memoryLimit)
+	})
+	t.Run("Should use the workers and the memory limit informed", func(t *testing.T) {
+		configs := config.NewConfig()
+		configs.SetEngineWorkers(2)
+		configs.SetEngineMemoryLimitInMB(512)
+		runner := NewRunner(configs)
+		assert.Equal(t, 2, runner.workers)
+		assert.Equal(t, uint64(512*bytesInMB), runner.memoryLimit)
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Should return the findings of all the files with the extensions", func(t *testing.T) {
+		projectPath := newProject(t, 20)
+		defer os.RemoveAll(projectPath)
+		configs := config.NewConfig()
+		configs.SetEngineWorkers(3)
+
+		findings, err := NewRunner(configs).Run(projectPath, []string{".js"}, newRules())
+
+		assert.NoError(t, err)
+		assert.Len(t, findings, 20)
+	})
+	t.Run("Should finish with a memory limit lower than the memory used", func(t *testing.T) {
+		projectPath := newProject(t, 10)
+		defer os.RemoveAll(projectPath)
+		configs := config.NewConfig()
+		configs.SetEngineWorkers(4)
+		configs.SetEngineMemoryLimitInMB(1)
+		runner := NewRunner(configs)
+		runner.memoryLimit = 1
+
+		findings, err := runner.Run(projectPath, []string{"**"}, newRules())
+
+		assert.NoError(t, err)
+		assert.Len(t, findings, 11)
+	})
+	t.Run("Should return the findings of the file analyzers", func(t *testing.T) {
+		projectPath := newProject(t, 2)
+		defer os.RemoveAll(projectPath)
+		analyzer := func(file *text.TextFile) []engine.Finding {
+			return []engine.Finding{{ID: "analyzer", SourceLocation: engine.Location{Filename: file.DisplayName}}}
+		}
+
+		findings, err := NewRunner(config.NewConfig()).Run(projectPath, []string{".md"}, newRules(), analyzer)
+
+		assert.NoError(t, err)
+		assert.Len(t, findings, 2)
+	})
+	t.Run("Should attribute the findings of a file to its duplicates", func(t *testing.T) {
+		projectPath := newProject(t, 3)
+		defer os.RemoveAll(projectPath)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "other.js"),
+			[]byte("const b = eval(input)"), 0600))
+
+		findings, err := NewRunner(config.NewConfig()).Run(projectPath, []string{".js"}, newRules())
+
+		assert.NoError(t, err)
+		var fileNames []string
+		for _, finding := range findings {
+			fileNames = append(fileNames, filepath.Base(finding.SourceLocation.Filename))
+		}
+		assert.ElementsMatch(t, []string{"file-0.js", "file-1.js", "file-2.js", "other.js"}, fileNames)
+	})
+	t.Run("Should run the file analyzers in the duplicated files", func(t *testing.T) {
+		projectPath := newProject(t, 3)
+		defer os.RemoveAll(projectPath)
+		analyzer := func(file *text.TextFile) []engine.Finding {
+			return []engine.Finding{{ID: "analyzer", SourceLocation: engine.Location{Filename: file.DisplayName}}}
+		}
+
+		findings, err := NewRunner(config.NewConfig()).Run(projectPath, []string{".js"}, nil, analyzer)
+
+		assert.NoError(t, err)
+		assert.Len(t, findings, 3)
+	})
+	t.Run("Should return error when the path doesn't exist", func(t *testing.T) {
+		_, err := NewRunner(config.NewConfig()).Run("./not-exists", []string{"**"}, newRules())
+		assert.Error(t, err)
+	})
+}
+
+// newSlowRule returns a structural rule that backtracks for seconds in the files of newSlowFileContent
+func newSlowRule(t *testing.T) engine.Rule {
+	rule, err := structural.NewRule(engine.Metadata{ID: "HS-JS-2"},
+		[]string{"f(...) ... f(...) ... f(...) ... g()"}, nil)
+	assert.NoError(t, err)
+	return rule
+}
+
+func newSlowFileContent() []byte {
+	return []byte(strings.Repeat("f(a); ", 2000) + "eval(input)")
+}
+
+func newUnit(t *testing.T, content []byte) structural.Unit {
+	file, err := text.NewTextFile("main.js", content)
+	assert.NoError(t, err)
+	return structural.Unit{TextUnit: text.TextUnit{Files: []text.TextFile{file}}}
+}
+
+func TestGetContentKey(t *testing.T) {
+	t.Run("Should return the same key only to the files with the same extension and content", func(t *testing.T) {
+		key := getContentKey(text.TextFile{Name: "main.js", RawString: "eval(input)"})
+
+		assert.Equal(t, key, getContentKey(text.TextFile{Name: "copy.JS", RawString: "eval(input)"}))
+		assert.NotEqual(t, key, getContentKey(text.TextFile{Name: "main.py", RawString: "eval(input)"}))
+		assert.NotEqual(t, key, getContentKey(text.TextFile{Name: "main.js", RawString: "eval(other)"}))
+	})
+}
+
+func TestEvalRule(t *testing.T) {
+	t.Run("Should skip the rule in the file when it takes more than the timeout", func(t *testing.T) {
+		configs := config.NewConfig()
+		configs.SetEngineRuleTimeoutInMs(10)
+		runner := NewRunner(configs)
+		unit := newUnit(t, newSlowFileContent())
+
+		assert.Empty(t, runner.evalRule(unit, newSlowRule(t), "main.js"))
+		assert.Len(t, runner.evalRule(unit, newRules()[0], "main.js"), 1)
+	})
+	t.Run("Should end the run when more evaluations than workers take more than the timeout", func(t *testing.T) {
+		projectPath, err := ioutil.TempDir("", "runner")
+		assert.NoError(t, err)
+		defer os.RemoveAll(projectPath)
+		for index := 0; index < 6; index++ {
+			assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, fmt.Sprintf("file-%d.js", index)),
+				append(newSlowFileContent(), byte('0'+index)), 0600))
+		}
+		configs := config.NewConfig()
+		configs.SetEngineRuleTimeoutInMs(20)
+		configs.SetEngineWorkers(2)
+		goroutines := runtime.NumGoroutine()
+
+		start := time.Now()
+		findings, err := NewRunner(configs).Run(projectPath, []string{"**"}, append(newRules(), newSlowRule(t)))
+		assert.NoError(t, err)
+		assert.Len(t, findings, 6)
+		assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+		// the evaluations stop in the workers, no goroutine is left running the slow rule
+		for wait := 0; runtime.NumGoroutine() > goroutines && wait < 100; wait++ {
+			time.Sleep(10 * time.Millisecond)
+		}
+		assert.LessOrEqual(t, runtime.NumGoroutine(), goroutines)
+	})
+	t.Run("Should wait the rule when the timeout is disabled", func(t *testing.T) {
+		runner := NewRunner(config.NewConfig())
+
+		assert.Len(t, runner.evalRule(newUnit(t, []byte("eval(input)")), newRules()[0], "main.js"), 1)
+	})
+}
+
+func TestRunOutputInJSON(t *testing.T) {
+	t.Run("Should write an empty list when there are no findings", func(t *testing.T) {
+		projectPath := newProject(t, 0)
+		defer os.RemoveAll(projectPath)
+		outputFilePath := filepath.Join(projectPath, "output.json")
+
+		err := NewRunner(config.NewConfig()).RunOutputInJSON(projectPath, []string{".js"}, newRules(), outputFilePath)
+
+		assert.NoError(t, err)
+		content, err := ioutil.ReadFile(outputFilePath)
+		assert.NoError(t, err)
+		assert.Equal(t, "[]", string(content))
+	})
+}
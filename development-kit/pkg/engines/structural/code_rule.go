@@ -0,0 +1,93 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structural
+
+import (
+	"regexp"
+	"strings"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+)
+
+// CodeRule is the text rule that ignores the matches in the comments or in the content of the strings, like the
+// example keys in the comments, the code is split by the same tokenizer of the structural rules
+type CodeRule struct {
+	text.TextRule
+	IgnoreComments bool
+	IgnoreStrings  bool
+}
+
+func NewCodeRule(rule text.TextRule, ignoreComments, ignoreStrings bool) CodeRule {
+	return CodeRule{TextRule: rule, IgnoreComments: ignoreComments, IgnoreStrings: ignoreStrings}
+}
+
+// evalFile evaluates the text rule in the masked code, that keeps the lines and the columns of the file, so only the
+// code samples are taken again from the original lines
+func (r CodeRule) evalFile(file *sourceFile, current *evaluation) []engine.Finding {
+	masked, err := text.NewTextFile(file.DisplayName, []byte(maskCode(file.getTokenizer(), r.IgnoreComments,
+		r.IgnoreStrings)))
+	if err != nil {
+		return nil
+	}
+
+	findings := evalTextRule(masked, r.TextRule, current)
+	lines := strings.Split(file.Content(), "\n")
+	for index := range findings {
+		if line := findings[index].SourceLocation.Line; line > 0 && line <= len(lines) {
+			findings[index].CodeSample = strings.TrimSpace(lines[line-1])
+		}
+	}
+
+	return findings
+}
+
+// evalTextRule evaluates the expressions of the text rule one at a time and checks the deadline between them. The
+// expressions use the linear time regex of go, without backtracking, so each one ends in a time bounded by the file
+func evalTextRule(file text.TextFile, rule text.TextRule, current *evaluation) (findings []engine.Finding) {
+	unit := text.TextUnit{Files: []text.TextFile{file}}
+	if current.deadline.IsZero() || len(rule.Expressions) <= 1 {
+		return unit.Eval(rule)
+	}
+
+	for _, expression := range rule.Expressions {
+		if current.checkDeadline() {
+			return nil
+		}
+
+		expressionFindings := unit.Eval(getExpressionRule(rule, expression))
+		if rule.Type == text.AndMatch && len(expressionFindings) == 0 {
+			return nil
+		}
+
+		findings = append(findings, expressionFindings...)
+	}
+
+	if rule.Type == text.AndMatch {
+		return findings[:1]
+	}
+
+	return findings
+}
+
+// getExpressionRule returns the rule with only the expression, the and rules find the first match of all of them
+func getExpressionRule(rule text.TextRule, expression *regexp.Regexp) text.TextRule {
+	rule.Expressions = []*regexp.Regexp{expression}
+	if rule.Type == text.AndMatch {
+		rule.Type = text.Regular
+	}
+
+	return rule
+}
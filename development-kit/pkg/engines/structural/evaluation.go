@@ -0,0 +1,51 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structural
+
+import (
+	"errors"
+	"time"
+)
+
+// deadlineCheckSteps is the number of steps of the matchers between the reads of the clock
+const deadlineCheckSteps = 1024
+
+var ErrDeadlineExceeded = errors.New("{HORUSEC_ENGINE} rule evaluation exceeded the deadline")
+
+// evaluation is the deadline of a rule in a file, checked in the loops of the matchers, so a pathological rule stops
+// in the same goroutine. The zero deadline never expires
+type evaluation struct {
+	deadline time.Time
+	steps    int
+	expired  bool
+}
+
+// isExpired is called in each step of the matchers, so it reads the clock only after some steps
+func (e *evaluation) isExpired() bool {
+	if e.steps++; e.steps%deadlineCheckSteps == 0 {
+		return e.checkDeadline()
+	}
+
+	return e.expired
+}
+
+// checkDeadline reads the clock, it is called before the expensive steps, like the regular expressions
+func (e *evaluation) checkDeadline() bool {
+	if !e.expired && !e.deadline.IsZero() && time.Now().After(e.deadline) {
+		e.expired = true
+	}
+
+	return e.expired
+}
@@ -0,0 +1,150 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+// +build cgo
+
+package structural
+
+import (
+	"path/filepath"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/c"
+	"github.com/smacker/go-tree-sitter/csharp"
+	"github.com/smacker/go-tree-sitter/golang"
+	"github.com/smacker/go-tree-sitter/java"
+	"github.com/smacker/go-tree-sitter/javascript"
+	"github.com/smacker/go-tree-sitter/php"
+	"github.com/smacker/go-tree-sitter/python"
+	"github.com/smacker/go-tree-sitter/ruby"
+	"github.com/smacker/go-tree-sitter/typescript/tsx"
+	"github.com/smacker/go-tree-sitter/typescript/typescript"
+)
+
+// grammars are the tree-sitter languages of the extensions, the files of the other extensions, like kotlin that has
+// no grammar in the bindings, are split by the lexer
+var grammars = map[string]func() *sitter.Language{
+	".java": java.GetLanguage,
+	".js":   javascript.GetLanguage,
+	".jsx":  javascript.GetLanguage,
+	".mjs":  javascript.GetLanguage,
+	".cjs":  javascript.GetLanguage,
+	".ts":   typescript.GetLanguage,
+	".tsx":  tsx.GetLanguage,
+	".py":   python.GetLanguage,
+	".go":   golang.GetLanguage,
+	".cs":   csharp.GetLanguage,
+	".rb":   ruby.GetLanguage,
+	".php":  php.GetLanguage,
+	".c":    c.GetLanguage,
+	".h":    c.GetLanguage,
+}
+
+// Nodes of the grammars that are a single token, like the strings with their escapes and interpolations
+var stringNodes = map[string]bool{
+	"string_literal":                 true,
+	"character_literal":              true,
+	"verbatim_string_literal":        true,
+	"interpolated_string_expression": true,
+	"interpreted_string_literal":     true,
+	"raw_string_literal":             true,
+	"rune_literal":                   true,
+	"char_literal":                   true,
+	"string":                         true,
+	"template_string":                true,
+	"regex":                          true,
+	"character":                      true,
+	"heredoc":                        true,
+}
+
+// parseWithGrammar splits the code by the parse tree of the grammar of the file. The leaves are the tokens, the
+// comments are the extra nodes and the named nodes are the expressions that the metavariables bind. Tree-sitter
+// recovers from the syntax errors, so the files with errors keep the tokens of the rest of the code
+func parseWithGrammar(content, filename string) (*tokenizer, bool) {
+	language, ok := grammars[strings.ToLower(filepath.Ext(filename))]
+	if !ok {
+		return nil, false
+	}
+
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(language())
+	return parseTree(parser, content)
+}
+
+func parseTree(parser *sitter.Parser, content string) (*tokenizer, bool) {
+	tree := parser.Parse(nil, []byte(content))
+	if tree == nil {
+		return nil, false
+	}
+
+	cursor := sitter.NewTreeCursor(tree.RootNode())
+	defer cursor.Close()
+	t := &tokenizer{content: content, grammar: true}
+	t.addNode(cursor)
+	return t, true
+}
+
+func (t *tokenizer) addNode(cursor *sitter.TreeCursor) {
+	node := cursor.CurrentNode()
+	if strings.HasSuffix(node.Type(), "comment") {
+		t.comments = append(t.comments, []int{int(node.StartByte()), int(node.EndByte())})
+		return
+	}
+
+	start := len(t.tokens)
+	if stringNodes[node.Type()] || node.ChildCount() == 0 {
+		t.addLeaf(node)
+	} else {
+		t.addChildren(cursor)
+	}
+
+	if node.IsNamed() && len(t.tokens) > start {
+		t.tokens[start].ends = appendEnd(t.tokens[start].ends, len(t.tokens))
+	}
+}
+
+func (t *tokenizer) addChildren(cursor *sitter.TreeCursor) {
+	for ok := cursor.GoToFirstChild(); ok; ok = cursor.GoToNextSibling() {
+		t.addNode(cursor)
+	}
+
+	cursor.GoToParent()
+}
+
+// addLeaf adds the strings as a single token, the other leaves are split by the lexer, like the patterns, so the
+// operators of the grammar are the same tokens of the patterns
+func (t *tokenizer) addLeaf(node *sitter.Node) {
+	start, end := int(node.StartByte()), int(node.EndByte())
+	if stringNodes[node.Type()] {
+		t.tokens = append(t.tokens, token{value: t.content[start:end], kind: kindString, offset: start, match: -1})
+		return
+	}
+
+	for _, current := range newLexer(t.content[start:end], "").tokens {
+		current.offset += start
+		t.tokens = append(t.tokens, current)
+	}
+}
+
+// appendEnd keeps the ends from the shortest expression, the inner nodes end first and can end with the outer ones
+func appendEnd(ends []int, end int) []int {
+	if len(ends) > 0 && ends[len(ends)-1] == end {
+		return ends
+	}
+
+	return append(ends, end)
+}
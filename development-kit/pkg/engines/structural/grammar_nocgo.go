@@ -12,9 +12,12 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package semgrep
+//go:build !cgo
+// +build !cgo
 
-type Analysis struct {
-	Results []Result `json:"results"`
-	Errors  []string `json:"errors"`
+package structural
+
+// parseWithGrammar needs cgo to run the parsers of tree-sitter, without it all the files are split by the lexer
+func parseWithGrammar(_, _ string) (*tokenizer, bool) {
+	return nil, false
 }
@@ -0,0 +1,87 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build cgo
+// +build cgo
+
+package structural
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWithGrammar(t *testing.T) {
+	t.Run("should split the java code by the parse tree without the comments", func(t *testing.T) {
+		content := "class A { /* b */ void c() { d(\"e // f\", 'g'); } // h\n}"
+		code, ok := parseWithGrammar(content, "A.java")
+		assert.True(t, ok)
+		assert.True(t, code.grammar)
+
+		var values []string
+		for _, current := range code.tokens {
+			values = append(values, current.value)
+		}
+
+		assert.Equal(t, []string{"class", "A", "{", "void", "c", "(", ")", "{", "d", "(", `"e // f"`, ",", "'g'", ")",
+			";", "}", "}"}, values)
+		assert.Len(t, code.comments, 2)
+		assert.Equal(t, "class A {         void c() { d(\"e // f\", 'g'); }     \n}", maskCode(code, true, false))
+	})
+
+	t.Run("should split the code of the other grammars with the strings as a single token", func(t *testing.T) {
+		files := map[string]string{
+			"main.js":   "a(`b // secret ${c}`, /d/); // note",
+			"main.ts":   "const a: string = b('c // secret'); // note",
+			"main.py":   "a = b(\"c # secret\")  # note",
+			"main.go":   "package a\n\nvar b = c(`d // secret`) // note",
+			"Main.cs":   "class A { string b = @\"c // secret\"; } // note",
+			"main.rb":   "a = b(\"c # secret\") # note",
+			"index.php": "<?php $a = b('c // secret'); // note",
+			"main.c":    "int a = b(\"c // secret\"); // note",
+		}
+
+		for filename, content := range files {
+			code, ok := parseWithGrammar(content, filename)
+			assert.True(t, ok, filename)
+			assert.Len(t, code.comments, 1, filename)
+			assert.NotContains(t, maskCode(code, true, false), "note", filename)
+			assert.Contains(t, maskCode(code, true, false), "secret", filename)
+			assert.NotContains(t, maskCode(code, false, true), "secret", filename)
+		}
+	})
+
+	t.Run("should return false when there is no grammar to the extension", func(t *testing.T) {
+		_, ok := parseWithGrammar("val a = 1", "Main.kt")
+		assert.False(t, ok)
+	})
+}
+
+func TestPatternFindAllWithGrammar(t *testing.T) {
+	t.Run("should bind the metavariables to the expressions of the grammar", func(t *testing.T) {
+		content := "class A { void b() { int c = d + e * 2; int f = (d + e) * 2; } }"
+		assert.Equal(t, []string{"e * 2", "(d + e) * 2"}, findAllToTest(t, "$X * 2", content, "A.java"))
+	})
+
+	t.Run("should bind the metavariables to the expressions of the other grammars", func(t *testing.T) {
+		content := "function b() { c = d + e * 2; }"
+		assert.Equal(t, []string{"e * 2"}, findAllToTest(t, "$X * 2", content, "main.js"))
+	})
+
+	t.Run("should bind the metavariables to any tokens without grammar", func(t *testing.T) {
+		content := "fun b() { c = d + e * 2 }"
+		assert.Equal(t, []string{"c = d + e * 2"}, findAllToTest(t, "$X * 2", content, "Main.kt"))
+	})
+}
@@ -0,0 +1,253 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structural
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+const (
+	ellipsis = "..."
+	// maxSteps limits the backtracking of each start position, so a bad pattern can't hang the analysis
+	maxSteps = 100000
+)
+
+var (
+	ErrEmptyPattern      = errors.New("{HORUSEC_ENGINE} structural pattern without tokens")
+	ErrUnbalancedPattern = errors.New("{HORUSEC_ENGINE} structural pattern with unbalanced brackets")
+)
+
+var metavariableRegex = regexp.MustCompile(`^\$[A-Z][A-Z0-9_]*$`)
+
+// Pattern is a snippet of code where $X matches any expression, the same metavariable must match the same
+// expression, and ... matches any sequence of code, "..." matches any string
+type Pattern struct {
+	source string
+	tokens []token
+}
+
+type match struct {
+	start int
+	end   int
+}
+
+type matcher struct {
+	pattern    []token
+	source     []token
+	grammar    bool
+	bindings   map[string]match
+	steps      int
+	evaluation *evaluation
+}
+
+func CompilePattern(source string) (*Pattern, error) {
+	tokens := tokenize(source, "")
+	if len(tokens) == 0 {
+		return nil, ErrEmptyPattern
+	}
+
+	if !isBalanced(tokens) {
+		return nil, ErrUnbalancedPattern
+	}
+
+	return &Pattern{source: source, tokens: tokens}, nil
+}
+
+func (p *Pattern) String() string {
+	return p.source
+}
+
+// findAll returns the matches without overlapping in the tokens of the source, or none after the deadline
+func (p *Pattern) findAll(code *tokenizer, current *evaluation) (matches []match) {
+	for start := 0; start < len(code.tokens); start++ {
+		m := newMatcher(p, code, current)
+		end, ok := m.match(0, start)
+		if current.expired {
+			return nil
+		}
+
+		if ok && end > start {
+			matches = append(matches, match{start: start, end: end})
+			start = end - 1
+		}
+	}
+
+	return matches
+}
+
+func newMatcher(pattern *Pattern, code *tokenizer, current *evaluation) *matcher {
+	return &matcher{pattern: pattern.tokens, source: code.tokens, grammar: code.grammar, bindings: map[string]match{},
+		evaluation: current}
+}
+
+func (m *matcher) match(patternIndex, sourceIndex int) (int, bool) {
+	if m.steps++; m.steps > maxSteps || m.evaluation.isExpired() {
+		return 0, false
+	}
+
+	if patternIndex == len(m.pattern) {
+		return sourceIndex, true
+	}
+
+	switch current := m.pattern[patternIndex]; {
+	case current.value == ellipsis:
+		return m.matchEllipsis(patternIndex, sourceIndex)
+	case metavariableRegex.MatchString(current.value):
+		return m.matchMetavariable(patternIndex, sourceIndex)
+	case sourceIndex < len(m.source) && isSameToken(current, m.source[sourceIndex]):
+		return m.match(patternIndex+1, sourceIndex+1)
+	}
+
+	return 0, false
+}
+
+// matchEllipsis tries the shortest sequences first, it enters in the blocks but skips the other brackets
+// and never leaves the block where it started
+func (m *matcher) matchEllipsis(patternIndex, sourceIndex int) (int, bool) {
+	depth := 0
+	for index := sourceIndex; ; {
+		if end, ok := m.match(patternIndex+1, index); ok {
+			return end, true
+		}
+
+		if index >= len(m.source) || m.source[index].kind == kindClose && depth == 0 {
+			return 0, false
+		}
+
+		index, depth = m.nextEllipsisIndex(index, depth)
+	}
+}
+
+func (m *matcher) nextEllipsisIndex(index, depth int) (int, int) {
+	current := m.source[index]
+	switch {
+	case current.kind == kindOpen && current.value == "{":
+		return index + 1, depth + 1
+	case current.kind == kindClose:
+		return index + 1, depth - 1
+	case current.kind == kindOpen && current.match > 0:
+		return current.match + 1, depth
+	}
+
+	return index + 1, depth
+}
+
+// matchMetavariable binds an expression that doesn't cross commas, semicolons or blocks
+func (m *matcher) matchMetavariable(patternIndex, sourceIndex int) (int, bool) {
+	name := m.pattern[patternIndex].value
+	if bound, ok := m.bindings[name]; ok {
+		return m.matchBound(bound, patternIndex, sourceIndex)
+	}
+
+	for _, index := range m.getExpressionEnds(sourceIndex) {
+		m.bindings[name] = match{start: sourceIndex, end: index}
+		if end, ok := m.match(patternIndex+1, index); ok {
+			return end, true
+		}
+	}
+
+	delete(m.bindings, name)
+	return 0, false
+}
+
+// getExpressionEnds returns the ends of the nodes of the grammar that start in the token, so $X in $X * 2 binds
+// b * 2 of a + b * 2 and never a + b. Without a grammar each prefix of the code that closes its brackets is an
+// expression
+func (m *matcher) getExpressionEnds(sourceIndex int) (ends []int) {
+	for index := sourceIndex; index < len(m.source) && !isExpressionEnd(m.source[index]); {
+		index = m.nextExpressionIndex(index)
+		if !m.grammar || isNodeEnd(m.source[sourceIndex], index) {
+			ends = append(ends, index)
+		}
+	}
+
+	return ends
+}
+
+func (m *matcher) matchBound(bound match, patternIndex, sourceIndex int) (int, bool) {
+	size := bound.end - bound.start
+	if sourceIndex+size > len(m.source) {
+		return 0, false
+	}
+
+	for index := 0; index < size; index++ {
+		if m.source[bound.start+index].value != m.source[sourceIndex+index].value {
+			return 0, false
+		}
+	}
+
+	return m.match(patternIndex+1, sourceIndex+size)
+}
+
+func (m *matcher) nextExpressionIndex(index int) int {
+	if current := m.source[index]; current.kind == kindOpen && current.match > 0 {
+		return current.match + 1
+	}
+
+	return index + 1
+}
+
+func isNodeEnd(start token, index int) bool {
+	for _, end := range start.ends {
+		if end == index {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isExpressionEnd(current token) bool {
+	return current.kind == kindClose || current.value == "," || current.value == ";" || current.value == "{" ||
+		current.kind == kindOpen && current.match < 0
+}
+
+func isSameToken(pattern, source token) bool {
+	if pattern.kind == kindString && source.kind == kindString {
+		return getStringContent(pattern.value) == ellipsis ||
+			getStringContent(pattern.value) == getStringContent(source.value)
+	}
+
+	return pattern.value == source.value
+}
+
+// getStringContent removes the quotes, so 'value' and "value" are the same string
+func getStringContent(value string) string {
+	for _, quote := range []string{`"""`, `'''`, `"`, `'`, "`"} {
+		if len(value) >= 2*len(quote) && strings.HasPrefix(value, quote) && strings.HasSuffix(value, quote) {
+			return value[len(quote) : len(value)-len(quote)]
+		}
+	}
+
+	return value
+}
+
+func isBalanced(tokens []token) bool {
+	closed := 0
+	for index := range tokens {
+		switch {
+		case tokens[index].kind == kindOpen && tokens[index].match < 0:
+			return false
+		case tokens[index].kind == kindClose:
+			closed++
+		case tokens[index].kind == kindOpen:
+			closed--
+		}
+	}
+
+	return closed == 0
+}
@@ -0,0 +1,116 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structural
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func findAllToTest(t *testing.T, pattern, content, filename string) []string {
+	compiled, err := CompilePattern(pattern)
+	assert.NoError(t, err)
+
+	code := newTokenizer(content, filename)
+	var matches []string
+	for _, found := range compiled.findAll(code, &evaluation{}) {
+		last := code.tokens[found.end-1]
+		matches = append(matches, content[code.tokens[found.start].offset:last.offset+len(last.value)])
+	}
+
+	return matches
+}
+
+func TestTokenize(t *testing.T) {
+	t.Run("should ignore the comments and keep the strings as one token", func(t *testing.T) {
+		tokens := tokenize("a := \"b // c\" // d\n/* e */ f('g')", "main.go")
+
+		var values []string
+		for _, current := range tokens {
+			values = append(values, current.value)
+		}
+
+		assert.Equal(t, []string{"a", ":=", `"b // c"`, "f", "(", "'g'", ")"}, values)
+		assert.Equal(t, 6, tokens[4].match)
+	})
+
+	t.Run("should ignore the hash comments of python", func(t *testing.T) {
+		tokens := tokenize("# os.system(a)\nprint(\"\"\"# b\"\"\")", "main.py")
+		assert.Len(t, tokens, 4)
+		assert.Equal(t, `"""# b"""`, tokens[2].value)
+	})
+}
+
+func TestCompilePattern(t *testing.T) {
+	t.Run("should return error when empty", func(t *testing.T) {
+		_, err := CompilePattern(" // comment")
+		assert.Equal(t, ErrEmptyPattern, err)
+	})
+
+	t.Run("should return error when unbalanced", func(t *testing.T) {
+		_, err := CompilePattern("exec($X")
+		assert.Equal(t, ErrUnbalancedPattern, err)
+
+		_, err = CompilePattern("exec($X))")
+		assert.Equal(t, ErrUnbalancedPattern, err)
+	})
+}
+
+func TestPatternFindAll(t *testing.T) {
+	t.Run("should match any expression in the metavariable", func(t *testing.T) {
+		matches := findAllToTest(t, "exec($X)", "exec(a + b(c, d)); exec(e, f); exec()", "main.js")
+		assert.Equal(t, []string{"exec(a + b(c, d))"}, matches)
+	})
+
+	t.Run("should match the same expression in the same metavariable", func(t *testing.T) {
+		matches := findAllToTest(t, "$X == $X", "if a.b == a.b {} if a == b {}", "main.go")
+		assert.Equal(t, []string{"a.b == a.b"}, matches)
+	})
+
+	t.Run("should match any arguments with ellipsis", func(t *testing.T) {
+		matches := findAllToTest(t, "exec(..., shell=True)", "exec(cmd, shell=True)\nexec(cmd)", "main.py")
+		assert.Equal(t, []string{"exec(cmd, shell=True)"}, matches)
+	})
+
+	t.Run("should match the call with argument from the source", func(t *testing.T) {
+		pattern := "$Y = request.getParameter(...); ... statement.executeQuery($Y)"
+		content := `
+String id = request.getParameter("id");
+if (id != null) {
+	statement.executeQuery(id);
+}
+String name = "fixed";
+statement.executeQuery(name);
+`
+		matches := findAllToTest(t, pattern, content, "Main.java")
+		assert.Len(t, matches, 1)
+		assert.Contains(t, matches[0], `id = request.getParameter("id")`)
+	})
+
+	t.Run("should not leave the block with ellipsis", func(t *testing.T) {
+		matches := findAllToTest(t, "open(...); ... close()", "{ open(a); } close()", "main.js")
+		assert.Empty(t, matches)
+	})
+
+	t.Run("should match any string and ignore the quotes", func(t *testing.T) {
+		assert.Len(t, findAllToTest(t, `password = "..."`, `password = 'secret'`, "main.js"), 1)
+		assert.Len(t, findAllToTest(t, `md5("a")`, `md5('a'); md5('b')`, "main.js"), 1)
+	})
+
+	t.Run("should not match in the comments", func(t *testing.T) {
+		assert.Empty(t, findAllToTest(t, "eval($X)", "// eval(input)\n/* eval(input) */", "main.js"))
+	})
+}
@@ -0,0 +1,192 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structural
+
+import (
+	"sync"
+	"time"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+)
+
+// Rule finds the code that matches any of the patterns and none of the patterns not at the same position
+type Rule struct {
+	engine.Metadata
+	Patterns    []*Pattern
+	PatternsNot []*Pattern
+}
+
+// Unit evaluates the structural and taint rules in the files of the text unit, the other rules are evaluated by
+// the text unit
+type Unit struct {
+	text.TextUnit
+	sources []*sourceFile
+}
+
+// sourceFile keeps the tokens of the file, so the code is parsed once for all the rules of the unit
+type sourceFile struct {
+	text.TextFile
+	once      sync.Once
+	tokenizer *tokenizer
+}
+
+func NewRule(metadata engine.Metadata, patterns, patternsNot []string) (rule Rule, err error) {
+	rule = Rule{Metadata: metadata}
+	if rule.Patterns, err = compilePatterns(patterns); err != nil || len(rule.Patterns) == 0 {
+		return rule, getErrorOrEmpty(err)
+	}
+
+	rule.PatternsNot, err = compilePatterns(patternsNot)
+	return rule, err
+}
+
+func compilePatterns(sources []string) (patterns []*Pattern, err error) {
+	for _, source := range sources {
+		pattern, err := CompilePattern(source)
+		if err != nil {
+			return nil, err
+		}
+
+		patterns = append(patterns, pattern)
+	}
+
+	return patterns, nil
+}
+
+func getErrorOrEmpty(err error) error {
+	if err != nil {
+		return err
+	}
+
+	return ErrEmptyPattern
+}
+
+func (r Rule) IsFor(unitType engine.UnitType) bool {
+	return unitType == engine.ProgramTextUnit
+}
+
+func (r Rule) evalFile(file *sourceFile, current *evaluation) (findings []engine.Finding) {
+	code := file.getTokenizer()
+	for _, pattern := range r.Patterns {
+		for _, found := range pattern.findAll(code, current) {
+			if r.isExcluded(code, found, current) {
+				continue
+			}
+
+			findings = append(findings, r.newFinding(file.TextFile, code.tokens[found.start].offset))
+		}
+	}
+
+	return findings
+}
+
+func (r Rule) isExcluded(code *tokenizer, found match, current *evaluation) bool {
+	for _, pattern := range r.PatternsNot {
+		if _, ok := newMatcher(pattern, code, current).match(0, found.start); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Rule) newFinding(file text.TextFile, offset int) engine.Finding {
+	line, column := file.FindLineAndColumn(offset)
+	return engine.Finding{
+		ID:          r.ID,
+		Name:        r.Name,
+		Severity:    r.Severity,
+		Confidence:  r.Confidence,
+		Description: r.Description,
+		CodeSample:  file.ExtractSample(offset),
+		SourceLocation: engine.Location{
+			Filename: file.DisplayName,
+			Line:     line,
+			Column:   column,
+		},
+	}
+}
+
+// NewUnits wraps the text units, so the engine can run the structural rules with the text rules
+func NewUnits(textUnits ...text.TextUnit) (units []engine.Unit) {
+	for index := range textUnits {
+		units = append(units, NewUnit(textUnits[index]))
+	}
+
+	return units
+}
+
+// NewUnit wraps the text unit, the files are parsed once for all the rules evaluated in the unit
+func NewUnit(textUnit text.TextUnit) Unit {
+	unit := Unit{TextUnit: textUnit}
+	for index := range textUnit.Files {
+		unit.sources = append(unit.sources, &sourceFile{TextFile: textUnit.Files[index]})
+	}
+
+	return unit
+}
+
+// getSources returns the files of the unit, the units not created by NewUnit parse the files again in each rule
+func (u Unit) getSources() []*sourceFile {
+	if len(u.sources) == len(u.Files) {
+		return u.sources
+	}
+
+	return NewUnit(u.TextUnit).sources
+}
+
+func (f *sourceFile) getTokenizer() *tokenizer {
+	f.once.Do(func() {
+		f.tokenizer = newTokenizer(f.Content(), f.Name)
+	})
+
+	return f.tokenizer
+}
+
+func (u Unit) Eval(rule engine.Rule) []engine.Finding {
+	findings, _ := u.EvalUntil(rule, time.Time{})
+	return findings
+}
+
+// EvalUntil stops the evaluation of the rule after the deadline and returns ErrDeadlineExceeded, the zero deadline
+// is without limit
+func (u Unit) EvalUntil(rule engine.Rule, deadline time.Time) (findings []engine.Finding, err error) {
+	var evalFile func(file *sourceFile, current *evaluation) []engine.Finding
+	switch current := rule.(type) {
+	case Rule:
+		evalFile = current.evalFile
+	case TaintRule:
+		evalFile = current.evalFile
+	case CodeRule:
+		evalFile = current.evalFile
+	case text.TextRule:
+		evalFile = func(file *sourceFile, evaluation *evaluation) []engine.Finding {
+			return evalTextRule(file.TextFile, current, evaluation)
+		}
+	default:
+		return u.TextUnit.Eval(rule), nil
+	}
+
+	evaluation := &evaluation{deadline: deadline}
+	for _, file := range u.getSources() {
+		findings = append(findings, evalFile(file, evaluation)...)
+		if evaluation.expired {
+			return nil, ErrDeadlineExceeded
+		}
+	}
+
+	return findings, nil
+}
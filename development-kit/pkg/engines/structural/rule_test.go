@@ -0,0 +1,109 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structural
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func newUnitToTest(t *testing.T, filename, content string) text.TextUnit {
+	file, err := text.NewTextFile(filename, []byte(content))
+	assert.NoError(t, err)
+	return text.TextUnit{Files: []text.TextFile{file}}
+}
+
+func TestNewRule(t *testing.T) {
+	t.Run("should compile the patterns", func(t *testing.T) {
+		rule, err := NewRule(engine.Metadata{ID: "HS-JS-1"}, []string{"eval($X)"}, []string{`eval("...")`})
+		assert.NoError(t, err)
+		assert.Len(t, rule.Patterns, 1)
+		assert.Len(t, rule.PatternsNot, 1)
+		assert.True(t, rule.IsFor(engine.ProgramTextUnit))
+	})
+
+	t.Run("should return error without patterns", func(t *testing.T) {
+		_, err := NewRule(engine.Metadata{}, nil, nil)
+		assert.Equal(t, ErrEmptyPattern, err)
+	})
+
+	t.Run("should return error when invalid pattern", func(t *testing.T) {
+		_, err := NewRule(engine.Metadata{}, []string{"eval("}, nil)
+		assert.Equal(t, ErrUnbalancedPattern, err)
+
+		_, err = NewRule(engine.Metadata{}, []string{"eval($X)"}, []string{"eval)"})
+		assert.Equal(t, ErrUnbalancedPattern, err)
+	})
+}
+
+func TestUnitEval(t *testing.T) {
+	content := "const a = 1;\neval(input);\neval(\"1 + 1\");\n"
+
+	t.Run("should return the findings of the structural rule", func(t *testing.T) {
+		rule, err := NewRule(engine.Metadata{ID: "HS-JS-1", Name: "Eval", Severity: "HIGH", Confidence: "MEDIUM"},
+			[]string{"eval($X)"}, []string{`eval("...")`})
+		assert.NoError(t, err)
+
+		units := NewUnits(newUnitToTest(t, "main.js", content))
+		assert.Len(t, units, 1)
+		assert.Equal(t, engine.ProgramTextUnit, units[0].Type())
+
+		findings := units[0].Eval(rule)
+		assert.Len(t, findings, 1)
+		assert.Equal(t, "HS-JS-1", findings[0].ID)
+		assert.Equal(t, "HIGH", findings[0].Severity)
+		assert.Equal(t, "main.js", findings[0].SourceLocation.Filename)
+		assert.Equal(t, 2, findings[0].SourceLocation.Line)
+		assert.Equal(t, "eval(input);", findings[0].CodeSample)
+	})
+
+	t.Run("should run the structural and the text rules in the engine", func(t *testing.T) {
+		structuralRule, err := NewRule(engine.Metadata{ID: "HS-JS-1"}, []string{"eval($X)"}, nil)
+		assert.NoError(t, err)
+
+		textRule := text.TextRule{Metadata: engine.Metadata{ID: "HS-JS-2"}, Type: text.Regular,
+			Expressions: []*regexp.Regexp{regexp.MustCompile(`const\s+a`)}}
+
+		findings := engine.Run(NewUnits(newUnitToTest(t, "main.js", content)), []engine.Rule{structuralRule, textRule})
+		assert.Len(t, findings, 3)
+	})
+	t.Run("should keep the findings of the text rules evaluated until the deadline", func(t *testing.T) {
+		unit := Unit{TextUnit: newUnitToTest(t, "main.js", content)}
+		for _, matchType := range []text.MatchType{text.Regular, text.OrMatch, text.NotMatch, text.AndMatch} {
+			rule := text.TextRule{Metadata: engine.Metadata{ID: "HS-JS-2"}, Type: matchType,
+				Expressions: []*regexp.Regexp{regexp.MustCompile(`eval\(`), regexp.MustCompile(`const\s+b`)}}
+
+			findings, err := unit.EvalUntil(rule, time.Now().Add(time.Minute))
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, unit.TextUnit.Eval(rule), findings)
+		}
+	})
+
+	t.Run("should stop the rule after the deadline", func(t *testing.T) {
+		rule, err := NewRule(engine.Metadata{ID: "HS-JS-1"}, []string{"f(...) ... f(...) ... f(...) ... g()"}, nil)
+		assert.NoError(t, err)
+		unit := Unit{TextUnit: newUnitToTest(t, "main.js", strings.Repeat("f(a); ", 2000))}
+
+		findings, err := unit.EvalUntil(rule, time.Now().Add(10*time.Millisecond))
+		assert.Equal(t, ErrDeadlineExceeded, err)
+		assert.Empty(t, findings)
+	})
+}
@@ -0,0 +1,187 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structural
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+
+	engine "github.com/ZupIT/horusec-engine"
+)
+
+type Scope string
+
+const (
+	// ScopeFunction requires the next expressions in the block of the first expression, or in one of its inner blocks
+	ScopeFunction Scope = "function"
+	ScopeFile     Scope = "file"
+)
+
+var (
+	ErrTaintWithoutSink = errors.New("{HORUSEC_ENGINE} taint rule requires two or more expressions")
+	ErrInvalidScope     = errors.New("{HORUSEC_ENGINE} taint rule scope must be function or file")
+)
+
+// TaintRule finds the last expression, like a sink, only when each expression is found after the previous one,
+// like a source, in the same scope and with at most MaxLines between them, zero is without limit
+type TaintRule struct {
+	engine.Metadata
+	Expressions []*regexp.Regexp
+	Scope       Scope
+	MaxLines    int
+}
+
+func NewTaintRule(metadata engine.Metadata, expressions []string, scope Scope, maxLines int) (TaintRule, error) {
+	rule := TaintRule{Metadata: metadata, Scope: scope, MaxLines: maxLines}
+	if rule.Scope == "" {
+		rule.Scope = ScopeFunction
+	}
+
+	if rule.Scope != ScopeFunction && rule.Scope != ScopeFile {
+		return rule, ErrInvalidScope
+	}
+
+	if len(expressions) < 2 {
+		return rule, ErrTaintWithoutSink
+	}
+
+	for _, expression := range expressions {
+		compiled, err := regexp.Compile(expression)
+		if err != nil {
+			return rule, err
+		}
+
+		rule.Expressions = append(rule.Expressions, compiled)
+	}
+
+	return rule, nil
+}
+
+func (r TaintRule) IsFor(unitType engine.UnitType) bool {
+	return unitType == engine.ProgramTextUnit
+}
+
+func (r TaintRule) evalFile(file *sourceFile, evaluation *evaluation) (findings []engine.Finding) {
+	current := newTaintFile(file, evaluation)
+	found := map[int]bool{}
+	for _, source := range r.findAll(current, current.content, 0, 0) {
+		for _, sink := range r.findSinks(current, source, 1) {
+			if !found[sink] {
+				found[sink] = true
+				findings = append(findings, Rule{Metadata: r.Metadata}.newFinding(file.TextFile, sink))
+			}
+		}
+	}
+
+	return findings
+}
+
+// findSinks returns the offsets of the last expression of the chains started in the offset of the previous one
+func (r TaintRule) findSinks(current *taintFile, previous, index int) (sinks []int) {
+	if index == len(r.Expressions) {
+		return []int{previous}
+	}
+
+	key := [2]int{previous, index}
+	if cached, ok := current.sinks[key]; ok {
+		return cached
+	}
+
+	scope := block{start: 0, end: len(current.content)}
+	if r.Scope == ScopeFunction {
+		scope = current.getBlock(previous)
+	}
+
+	for _, offset := range r.findAll(current, current.content[:scope.end], index, previous+1) {
+		if !r.isNear(current, previous, offset) {
+			break
+		}
+
+		sinks = append(sinks, r.findSinks(current, offset, index+1)...)
+	}
+
+	current.sinks[key] = sinks
+	return sinks
+}
+
+// findAll returns none after the deadline, so the chains already started end without searching the expressions
+func (r TaintRule) findAll(current *taintFile, content string, index, start int) (offsets []int) {
+	if start > len(content) || current.evaluation.checkDeadline() {
+		return nil
+	}
+
+	for _, found := range r.Expressions[index].FindAllStringIndex(content[start:], -1) {
+		offsets = append(offsets, start+found[0])
+	}
+
+	return offsets
+}
+
+func (r TaintRule) isNear(current *taintFile, previous, offset int) bool {
+	return r.MaxLines <= 0 || current.getLine(offset)-current.getLine(previous) <= r.MaxLines
+}
+
+type block struct {
+	start int
+	end   int
+}
+
+type taintFile struct {
+	content  string
+	blocks   []block
+	newLines []int
+	// sinks of each offset and index of the expressions, so the chains are searched once
+	sinks      map[[2]int][]int
+	evaluation *evaluation
+}
+
+func newTaintFile(file *sourceFile, evaluation *evaluation) *taintFile {
+	current := &taintFile{content: file.Content(), sinks: map[[2]int][]int{}, evaluation: evaluation}
+	tokens := file.getTokenizer().tokens
+	for _, found := range tokens {
+		if found.value == "{" && found.match > 0 {
+			current.blocks = append(current.blocks, block{start: found.offset, end: tokens[found.match].offset})
+		}
+	}
+
+	for index := range current.content {
+		if current.content[index] == '\n' {
+			current.newLines = append(current.newLines, index)
+		}
+	}
+
+	return current
+}
+
+// getBlock returns the innermost block with the offset, or the whole file outside of the blocks
+func (f *taintFile) getBlock(offset int) block {
+	scope := block{start: 0, end: len(f.content)}
+	for _, current := range f.blocks {
+		if current.start > offset {
+			break
+		}
+
+		if current.end >= offset {
+			scope = current
+		}
+	}
+
+	return scope
+}
+
+func (f *taintFile) getLine(offset int) int {
+	return sort.SearchInts(f.newLines, offset)
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structural
+
+import (
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/stretchr/testify/assert"
+)
+
+const taintContentToTest = `
+public class Main {
+	public void unsafe(HttpServletRequest request) {
+		String id = request.getParameter("id");
+		if (id != null) {
+			statement.executeQuery("SELECT * FROM users WHERE id = " + id);
+		}
+	}
+
+	public void safe() {
+		statement.executeQuery("SELECT * FROM users");
+	}
+}
+`
+
+func newTaintRuleToTest(t *testing.T, scope Scope, maxLines int) TaintRule {
+	rule, err := NewTaintRule(engine.Metadata{ID: "HS-JAVA-1", Severity: "HIGH"},
+		[]string{`request\.getParameter\(`, `\.executeQuery\(`}, scope, maxLines)
+	assert.NoError(t, err)
+	return rule
+}
+
+func TestNewTaintRule(t *testing.T) {
+	t.Run("should use the function scope by default", func(t *testing.T) {
+		rule := newTaintRuleToTest(t, "", 0)
+		assert.Equal(t, ScopeFunction, rule.Scope)
+		assert.Len(t, rule.Expressions, 2)
+		assert.True(t, rule.IsFor(engine.ProgramTextUnit))
+	})
+
+	t.Run("should return error without sink", func(t *testing.T) {
+		_, err := NewTaintRule(engine.Metadata{}, []string{`getParameter`}, ScopeFile, 0)
+		assert.Equal(t, ErrTaintWithoutSink, err)
+	})
+
+	t.Run("should return error when invalid scope or expression", func(t *testing.T) {
+		_, err := NewTaintRule(engine.Metadata{}, []string{`a`, `b`}, "class", 0)
+		assert.Equal(t, ErrInvalidScope, err)
+
+		_, err = NewTaintRule(engine.Metadata{}, []string{`a`, `(`}, ScopeFile, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestTaintRuleEval(t *testing.T) {
+	t.Run("should find only the sink in the function of the source", func(t *testing.T) {
+		findings := NewUnits(newUnitToTest(t, "Main.java", taintContentToTest))[0].Eval(
+			newTaintRuleToTest(t, ScopeFunction, 0))
+		assert.Len(t, findings, 1)
+		assert.Equal(t, "HS-JAVA-1", findings[0].ID)
+		assert.Equal(t, 6, findings[0].SourceLocation.Line)
+	})
+
+	t.Run("should find the sinks after the source in the file", func(t *testing.T) {
+		findings := NewUnits(newUnitToTest(t, "Main.java", taintContentToTest))[0].Eval(
+			newTaintRuleToTest(t, ScopeFile, 0))
+		assert.Len(t, findings, 2)
+	})
+
+	t.Run("should not find the sink far from the source", func(t *testing.T) {
+		unit := NewUnits(newUnitToTest(t, "Main.java", taintContentToTest))[0]
+		assert.Empty(t, unit.Eval(newTaintRuleToTest(t, ScopeFunction, 1)))
+		assert.Len(t, unit.Eval(newTaintRuleToTest(t, ScopeFunction, 2)), 1)
+	})
+
+	t.Run("should not find the sink before the source", func(t *testing.T) {
+		content := "statement.executeQuery(query);\nid = request.getParameter(\"id\");\n"
+		findings := NewUnits(newUnitToTest(t, "Main.java", content))[0].Eval(newTaintRuleToTest(t, ScopeFile, 0))
+		assert.Empty(t, findings)
+	})
+}
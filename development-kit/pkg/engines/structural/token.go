@@ -0,0 +1,242 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structural
+
+import (
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	kindIdentifier tokenKind = iota
+	kindString
+	kindNumber
+	kindPunctuation
+	kindOpen
+	kindClose
+)
+
+// Operators with more than one char, the longer ones first, so ... is read before ..
+var operators = []string{
+	"...", "===", "!==", "==", "!=", "<=", ">=", "&&", "||", "->", "=>", "::", ":=", "+=", "-=", "*=", "/=",
+	"++", "--", "<<", ">>",
+}
+
+var closingBrackets = map[string]string{"(": ")", "[": "]", "{": "}"}
+
+// Extensions of the languages with comments started by #, the other languages use // and /* */
+var hashCommentExtensions = map[string]bool{
+	".py": true, ".rb": true, ".yaml": true, ".yml": true, ".sh": true, ".r": true, ".pl": true,
+}
+
+type token struct {
+	value  string
+	kind   tokenKind
+	offset int
+	// match is the index of the closing bracket of the opening brackets, -1 when it isn't closed
+	match int
+	// ends are the indexes after the expressions of the grammar that start in the token, the shortest first
+	ends []int
+}
+
+type tokenizer struct {
+	content      string
+	offset       int
+	hashComments bool
+	tokens       []token
+	// comments holds the start and the end of each comment
+	comments [][]int
+	// grammar is true when the code was split by the parse tree of a grammar, see parseWithGrammar
+	grammar bool
+}
+
+// tokenize splits the code in tokens without the comments and the spaces, the strings are a single token
+func tokenize(content, filename string) []token {
+	return newTokenizer(content, filename).tokens
+}
+
+// newTokenizer splits the code by the grammar of the file, or by the lexer when there is no grammar to it
+func newTokenizer(content, filename string) *tokenizer {
+	t, ok := parseWithGrammar(content, filename)
+	if !ok {
+		t = newLexer(content, filename)
+	}
+	matchBrackets(t.tokens)
+	return t
+}
+
+// newLexer splits the code by the chars, with the comments of the extension of the file
+func newLexer(content, filename string) *tokenizer {
+	t := &tokenizer{content: content, hashComments: hashCommentExtensions[strings.ToLower(filepath.Ext(filename))]}
+	for t.offset < len(t.content) {
+		t.next()
+	}
+	return t
+}
+
+// maskCode replaces the comments and the content of the strings with spaces, keeping the quotes, the line breaks
+// and the offsets of the rest of the code
+func maskCode(t *tokenizer, ignoreComments, ignoreStrings bool) string {
+	masked := []byte(t.content)
+	if ignoreComments {
+		for _, comment := range t.comments {
+			maskRange(masked, comment[0], comment[1])
+		}
+	}
+	if ignoreStrings {
+		for _, current := range t.tokens {
+			if current.kind == kindString && len(current.value) > 1 {
+				maskRange(masked, current.offset+1, current.offset+len(current.value)-1)
+			}
+		}
+	}
+	return string(masked)
+}
+
+func maskRange(content []byte, start, end int) {
+	for index := start; index < end; index++ {
+		if content[index] != '\n' && content[index] != '\r' {
+			content[index] = ' '
+		}
+	}
+}
+
+func (t *tokenizer) next() {
+	char, size := utf8.DecodeRuneInString(t.content[t.offset:])
+	switch {
+	case unicode.IsSpace(char):
+		t.offset += size
+	case t.isComment():
+		t.skipComment()
+	case char == '"' || char == '\'' || char == '`':
+		t.addToken(kindString, t.getStringEnd(char))
+	case isIdentifierStart(char):
+		t.addToken(kindIdentifier, t.getEnd(t.offset+size, isIdentifierPart))
+	case unicode.IsDigit(char):
+		t.addToken(kindNumber, t.getEnd(t.offset+size, isIdentifierPart))
+	default:
+		t.addToken(getPunctuationKind(t.content[t.offset:t.offset+size]), t.getPunctuationEnd(size))
+	}
+}
+
+func (t *tokenizer) addToken(kind tokenKind, end int) {
+	t.tokens = append(t.tokens, token{value: t.content[t.offset:end], kind: kind, offset: t.offset, match: -1})
+	t.offset = end
+}
+
+func (t *tokenizer) isComment() bool {
+	rest := t.content[t.offset:]
+	if t.hashComments {
+		return strings.HasPrefix(rest, "#")
+	}
+	return strings.HasPrefix(rest, "//") || strings.HasPrefix(rest, "/*")
+}
+
+func (t *tokenizer) skipComment() {
+	start := t.offset
+	if strings.HasPrefix(t.content[t.offset:], "/*") {
+		end := strings.Index(t.content[t.offset+2:], "*/")
+		t.offset = t.getIndexOrEnd(end, t.offset+2, len("*/"))
+	} else {
+		end := strings.IndexByte(t.content[t.offset:], '\n')
+		t.offset = t.getIndexOrEnd(end, t.offset, 0)
+	}
+	t.comments = append(t.comments, []int{start, t.offset})
+}
+
+// getStringEnd supports the escaped quotes and the triple quotes of python
+func (t *tokenizer) getStringEnd(quote rune) int {
+	if triple := strings.Repeat(string(quote), 3); strings.HasPrefix(t.content[t.offset:], triple) {
+		end := strings.Index(t.content[t.offset+3:], triple)
+		return t.getIndexOrEnd(end, t.offset+3, len(triple))
+	}
+	for index := t.offset + 1; index < len(t.content); index++ {
+		switch t.content[index] {
+		case '\\':
+			index++
+		case byte(quote):
+			return index + 1
+		case '\n':
+			if quote != '`' {
+				return index
+			}
+		}
+	}
+	return len(t.content)
+}
+
+func (t *tokenizer) getEnd(start int, accept func(char rune) bool) int {
+	for start < len(t.content) {
+		char, size := utf8.DecodeRuneInString(t.content[start:])
+		if !accept(char) {
+			break
+		}
+		start += size
+	}
+	return start
+}
+
+func (t *tokenizer) getPunctuationEnd(size int) int {
+	for _, operator := range operators {
+		if strings.HasPrefix(t.content[t.offset:], operator) {
+			return t.offset + len(operator)
+		}
+	}
+	return t.offset + size
+}
+
+func (t *tokenizer) getIndexOrEnd(index, start, size int) int {
+	if index < 0 {
+		return len(t.content)
+	}
+	return start + index + size
+}
+
+func matchBrackets(tokens []token) {
+	var stack []int
+	for index := range tokens {
+		switch tokens[index].kind {
+		case kindOpen:
+			stack = append(stack, index)
+		case kindClose:
+			if last := len(stack) - 1; last >= 0 && closingBrackets[tokens[stack[last]].value] == tokens[index].value {
+				tokens[stack[last]].match = index
+				stack = stack[:last]
+			}
+		}
+	}
+}
+
+func getPunctuationKind(value string) tokenKind {
+	switch value {
+	case "(", "[", "{":
+		return kindOpen
+	case ")", "]", "}":
+		return kindClose
+	}
+	return kindPunctuation
+}
+
+func isIdentifierStart(char rune) bool {
+	return unicode.IsLetter(char) || char == '_' || char == '$'
+}
+
+func isIdentifierPart(char rune) bool {
+	return isIdentifierStart(char) || unicode.IsDigit(char)
+}
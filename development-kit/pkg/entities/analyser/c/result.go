@@ -16,8 +16,6 @@ package c
 
 import (
 	"fmt"
-	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
-	"strconv"
 	"strings"
 )
 
@@ -36,19 +34,6 @@ func (r *Result) GetDetails() string {
 	return fmt.Sprintf("%s %s %s", r.Warning, r.Suggestion, r.Note)
 }
 
-func (r *Result) GetSeverity() severity.Severity {
-	level, _ := strconv.Atoi(r.Level)
-	if level <= 2 {
-		return severity.Low
-	}
-
-	if level >= 3 && level <= 4 {
-		return severity.Medium
-	}
-
-	return severity.High
-}
-
 func (r *Result) GetFilename() string {
 	return strings.ReplaceAll(r.File, "./", "")
 }
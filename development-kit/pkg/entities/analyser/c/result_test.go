@@ -1,7 +1,6 @@
 package c
 
 import (
-	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -22,50 +21,6 @@ func TestGetDetails(t *testing.T) {
 
 }
 
-func TestGetSeverity(t *testing.T) {
-	result := &Result{
-		Level: "0",
-	}
-
-	t.Run("should get severity low", func(t *testing.T) {
-		assert.Equal(t, severity.Low, result.GetSeverity())
-
-		result.Level = "1"
-		assert.Equal(t, severity.Low, result.GetSeverity())
-
-		result.Level = "2"
-		assert.Equal(t, severity.Low, result.GetSeverity())
-	})
-
-	t.Run("should get severity medium", func(t *testing.T) {
-		result.Level = "3"
-		assert.Equal(t, severity.Medium, result.GetSeverity())
-
-		result.Level = "4"
-		assert.Equal(t, severity.Medium, result.GetSeverity())
-
-		result.Level = "2"
-		assert.NotEqual(t, severity.Medium, result.GetSeverity())
-
-		result.Level = "5"
-		assert.NotEqual(t, severity.Medium, result.GetSeverity())
-	})
-
-	t.Run("should get severity high", func(t *testing.T) {
-		result.Level = "5"
-		assert.Equal(t, severity.High, result.GetSeverity())
-
-		result.Level = "6"
-		assert.Equal(t, severity.High, result.GetSeverity())
-
-		result.Level = "1"
-		assert.NotEqual(t, severity.High, result.GetSeverity())
-
-		result.Level = "4"
-		assert.NotEqual(t, severity.High, result.GetSeverity())
-	})
-}
-
 func TestGetFilename(t *testing.T) {
 	result := &Result{
 		File: "./test.c",
@@ -16,9 +16,6 @@ package dotnet
 
 import (
 	"strings"
-
-	"github.com/ZupIT/horusec/development-kit/pkg/entities/analyser/dotnet/severities"
-	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 )
 
 type Output struct {
@@ -75,19 +72,3 @@ func (o *Output) GetFilename() string {
 
 	return o.Filename[0:index]
 }
-
-func (o *Output) GetSeverity() severity.Severity {
-	if severities.IsLowSeverity(o.ErrorID) {
-		return severity.Low
-	}
-
-	if severities.IsMediumSeverity(o.ErrorID) {
-		return severity.Medium
-	}
-
-	if severities.IsHighSeverity(o.ErrorID) {
-		return severity.High
-	}
-
-	return severity.NoSec
-}
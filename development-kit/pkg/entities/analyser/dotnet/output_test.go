@@ -17,7 +17,6 @@ package dotnet
 import (
 	"testing"
 
-	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -119,30 +118,6 @@ func TestIsValid(t *testing.T) {
 	})
 }
 
-func TestGetDotNetSeverityByCode(t *testing.T) {
-	output := Output{}
-
-	t.Run("should return a low severity", func(t *testing.T) {
-		output.ErrorID = "SCS0021"
-		assert.Equal(t, severity.Low, output.GetSeverity())
-	})
-
-	t.Run("should return a medium severity", func(t *testing.T) {
-		output.ErrorID = "SCS0012"
-		assert.Equal(t, severity.Medium, output.GetSeverity())
-	})
-
-	t.Run("should return a high severity", func(t *testing.T) {
-		output.ErrorID = "SCS0014"
-		assert.Equal(t, severity.High, output.GetSeverity())
-	})
-
-	t.Run("should return a no sec severity", func(t *testing.T) {
-		output.ErrorID = ""
-		assert.Equal(t, severity.NoSec, output.GetSeverity())
-	})
-}
-
 func TestGetLine(t *testing.T) {
 	t.Run("should return filename line", func(t *testing.T) {
 		output := Output{Filename: "Vulnerabilities.cs(23,26)"}
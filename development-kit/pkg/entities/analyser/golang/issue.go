@@ -20,6 +20,7 @@ type Issue struct {
 	Severity   severity.Severity `json:"severity"`
 	Confidence string            `json:"confidence"`
 	Details    string            `json:"details"`
+	RuleID     string            `json:"rule_id"`
 	File       string            `json:"file"`
 	Code       string            `json:"code"`
 	Line       string            `json:"line"`
@@ -0,0 +1,166 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvscanner
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Output is the json report of osv-scanner, with the packages of each go.mod and their vulnerabilities
+type Output struct {
+	Results []Result `json:"results"`
+}
+
+type Result struct {
+	Source   Source    `json:"source"`
+	Packages []Package `json:"packages"`
+}
+
+type Source struct {
+	Path string `json:"path"`
+	Type string `json:"type"`
+}
+
+type Package struct {
+	Package         PackageInfo     `json:"package"`
+	Vulnerabilities []Vulnerability `json:"vulnerabilities"`
+	Groups          []Group         `json:"groups"`
+}
+
+type PackageInfo struct {
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+type Vulnerability struct {
+	ID       string     `json:"id"`
+	Summary  string     `json:"summary"`
+	Details  string     `json:"details"`
+	Aliases  []string   `json:"aliases"`
+	Affected []Affected `json:"affected"`
+}
+
+type Affected struct {
+	Package PackageInfo `json:"package"`
+	Ranges  []Range     `json:"ranges"`
+}
+
+type Range struct {
+	Events []Event `json:"events"`
+}
+
+type Event struct {
+	Introduced string `json:"introduced"`
+	Fixed      string `json:"fixed"`
+}
+
+// Group is the ids of the vulnerabilities of a package that are aliases of each other, like the GO, GHSA and CVE
+// ids of the same advisory, with the highest cvss score between them
+type Group struct {
+	IDs         []string `json:"ids"`
+	MaxSeverity string   `json:"max_severity"`
+}
+
+// Finding is a vulnerability of a package of the go.mod, one by group of aliases
+type Finding struct {
+	Source        Source
+	Package       PackageInfo
+	Vulnerability Vulnerability
+	Group         Group
+}
+
+func (o *Output) GetFindings() (findings []Finding) {
+	for _, result := range o.Results {
+		for _, pkg := range result.Packages {
+			for _, group := range pkg.getGroups() {
+				findings = append(findings, Finding{Source: result.Source, Package: pkg.Package,
+					Vulnerability: pkg.getVulnerability(group), Group: group})
+			}
+		}
+	}
+	return findings
+}
+
+// getGroups returns a group by vulnerability when the version of osv-scanner doesn't group them
+func (p *Package) getGroups() []Group {
+	if len(p.Groups) > 0 {
+		return p.Groups
+	}
+	groups := make([]Group, 0, len(p.Vulnerabilities))
+	for index := range p.Vulnerabilities {
+		groups = append(groups, Group{IDs: []string{p.Vulnerabilities[index].ID}})
+	}
+	return groups
+}
+
+// getVulnerability returns the first vulnerability of the group, the GO id comes first in the go modules
+func (p *Package) getVulnerability(group Group) Vulnerability {
+	for _, id := range group.IDs {
+		for index := range p.Vulnerabilities {
+			if p.Vulnerabilities[index].ID == id {
+				return p.Vulnerabilities[index]
+			}
+		}
+	}
+	return Vulnerability{ID: strings.Join(group.IDs, ", ")}
+}
+
+// GetSeverity returns the qualitative rating of the cvss score of the group, empty when the advisory has no score
+func (f *Finding) GetSeverity() string {
+	score, err := strconv.ParseFloat(f.Group.MaxSeverity, 64)
+	switch {
+	case err != nil || score <= 0:
+		return ""
+	case score >= 9:
+		return "CRITICAL"
+	case score >= 7:
+		return "HIGH"
+	case score >= 4:
+		return "MEDIUM"
+	default:
+		return "LOW"
+	}
+}
+
+func (f *Finding) GetDetails() string {
+	details := strings.Join(f.Group.IDs, ", ")
+	if f.Vulnerability.Summary != "" {
+		details += ": " + f.Vulnerability.Summary
+	}
+	if f.Vulnerability.Details != "" {
+		details += "\n" + f.Vulnerability.Details
+	}
+	return details
+}
+
+// GetFixedVersion returns the last version that fixes the vulnerability in the package, the events of the ranges are
+// sorted, so it fixes all the versions affected. Empty when there is no fix
+func (f *Finding) GetFixedVersion() (fixedVersion string) {
+	for _, affected := range f.Vulnerability.Affected {
+		if affected.Package.Name != f.Package.Name {
+			continue
+		}
+		for _, affectedRange := range affected.Ranges {
+			for _, event := range affectedRange.Events {
+				if event.Fixed != "" {
+					fixedVersion = event.Fixed
+				}
+			}
+		}
+	}
+	return fixedVersion
+}
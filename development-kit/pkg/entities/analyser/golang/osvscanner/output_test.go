@@ -0,0 +1,77 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvscanner
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const outputToTest = `{"results": [{"source": {"path": "/src/code/go.mod", "type": "lockfile"}, "packages": [{
+	"package": {"name": "golang.org/x/text", "version": "0.3.5", "ecosystem": "Go"},
+	"vulnerabilities": [
+		{"id": "GHSA-ppp9-7jff-5vj2", "summary": "Out-of-bounds Read in golang.org/x/text"},
+		{"id": "GO-2021-0113", "summary": "Out-of-bounds read in golang.org/x/text/language",
+			"details": "Due to improper index calculation, an incorrectly formatted language tag can cause a panic.",
+			"affected": [{"package": {"name": "golang.org/x/text"}, "ranges": [{"events": [{"introduced": "0"},
+				{"fixed": "0.3.6"}]}]}]}
+	],
+	"groups": [{"ids": ["GO-2021-0113", "GHSA-ppp9-7jff-5vj2", "CVE-2021-38561"], "max_severity": "7.5"}]
+}]}]}`
+
+func TestOutput(t *testing.T) {
+	t.Run("Should return a finding by group of aliases", func(t *testing.T) {
+		output := Output{}
+		assert.NoError(t, json.Unmarshal([]byte(outputToTest), &output))
+
+		findings := output.GetFindings()
+		assert.Len(t, findings, 1)
+		assert.Equal(t, "GO-2021-0113", findings[0].Vulnerability.ID)
+		assert.Equal(t, "/src/code/go.mod", findings[0].Source.Path)
+		assert.Equal(t, "golang.org/x/text", findings[0].Package.Name)
+	})
+
+	t.Run("Should return a finding by vulnerability without groups", func(t *testing.T) {
+		output := Output{Results: []Result{{Packages: []Package{{Vulnerabilities: []Vulnerability{
+			{ID: "GO-2021-0113"}, {ID: "GO-2022-0969"}}}}}}}
+
+		findings := output.GetFindings()
+		assert.Len(t, findings, 2)
+		assert.Equal(t, "GO-2022-0969", findings[1].Vulnerability.ID)
+	})
+}
+
+func TestFinding(t *testing.T) {
+	t.Run("Should return the details, the severity and the fixed version", func(t *testing.T) {
+		output := Output{}
+		assert.NoError(t, json.Unmarshal([]byte(outputToTest), &output))
+		finding := output.GetFindings()[0]
+
+		assert.Equal(t, "GO-2021-0113, GHSA-ppp9-7jff-5vj2, CVE-2021-38561: Out-of-bounds read in "+
+			"golang.org/x/text/language\nDue to improper index calculation, an incorrectly formatted language tag can "+
+			"cause a panic.", finding.GetDetails())
+		assert.Equal(t, "HIGH", finding.GetSeverity())
+		assert.Equal(t, "0.3.6", finding.GetFixedVersion())
+	})
+
+	t.Run("Should return the rating of the cvss score", func(t *testing.T) {
+		for score, rating := range map[string]string{"9.8": "CRITICAL", "5.3": "MEDIUM", "3.1": "LOW", "": ""} {
+			finding := Finding{Group: Group{MaxSeverity: score}}
+			assert.Equal(t, rating, finding.GetSeverity())
+		}
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkov
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Output accepts the report of one framework and the list of reports printed when checkov runs more frameworks
+type Output struct {
+	Reports []Report
+}
+
+func (o *Output) UnmarshalJSON(data []byte) error {
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+		return json.Unmarshal(data, &o.Reports)
+	}
+	report := Report{}
+	if err := json.Unmarshal(data, &report); err != nil {
+		return err
+	}
+	o.Reports = []Report{report}
+	return nil
+}
+
+func (o *Output) GetFailedChecks() (checks []Check) {
+	for index := range o.Reports {
+		checks = append(checks, o.Reports[index].Results.FailedChecks...)
+	}
+	return checks
+}
+
+type Report struct {
+	CheckType string  `json:"check_type"`
+	Results   Results `json:"results"`
+}
+
+type Results struct {
+	FailedChecks []Check `json:"failed_checks"`
+}
+
+type Check struct {
+	CheckID       string `json:"check_id"`
+	CheckName     string `json:"check_name"`
+	FilePath      string `json:"file_path"`
+	FileLineRange []int  `json:"file_line_range"`
+	Resource      string `json:"resource"`
+	Guideline     string `json:"guideline"`
+	Severity      string `json:"severity"`
+}
+
+func (c *Check) GetDetails() string {
+	details := c.CheckID + ": " + c.CheckName + "\nResource: " + c.Resource
+	if c.Guideline != "" {
+		details += "\n" + c.Guideline
+	}
+	return details
+}
+
+func (c *Check) GetLine() string {
+	if len(c.FileLineRange) == 0 {
+		return "0"
+	}
+	return strconv.Itoa(c.FileLineRange[0])
+}
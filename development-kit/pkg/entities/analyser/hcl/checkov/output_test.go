@@ -0,0 +1,53 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkov
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutput(t *testing.T) {
+	t.Run("Should parse the report of one framework and the list of reports", func(t *testing.T) {
+		report := `{"check_type": "terraform_plan", "results": {"failed_checks": [{"check_id": "CKV_AWS_20"}]}}`
+		for _, content := range []string{report, "[" + report + "]"} {
+			output := Output{}
+			assert.NoError(t, json.Unmarshal([]byte(content), &output))
+			assert.Len(t, output.GetFailedChecks(), 1)
+			assert.Equal(t, "CKV_AWS_20", output.GetFailedChecks()[0].CheckID)
+		}
+	})
+
+	t.Run("Should return error when the output is invalid", func(t *testing.T) {
+		assert.Error(t, json.Unmarshal([]byte(`{"results": []}`), &Output{}))
+	})
+}
+
+func TestCheck(t *testing.T) {
+	t.Run("Should return the details with the resource and the guideline", func(t *testing.T) {
+		check := &Check{CheckID: "CKV_AWS_20", CheckName: "S3 Bucket allows public READ access",
+			Resource: "module.storage.aws_s3_bucket.data", Guideline: "https://docs.bridgecrew.io/docs/s3_1-acl-read"}
+
+		assert.Equal(t, "CKV_AWS_20: S3 Bucket allows public READ access\nResource: module.storage.aws_s3_bucket.data\n"+
+			"https://docs.bridgecrew.io/docs/s3_1-acl-read", check.GetDetails())
+	})
+
+	t.Run("Should return the first line of the range", func(t *testing.T) {
+		assert.Equal(t, "0", (&Check{}).GetLine())
+		assert.Equal(t, "12", (&Check{FileLineRange: []int{12, 20}}).GetLine())
+	})
+}
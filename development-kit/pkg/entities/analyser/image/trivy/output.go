@@ -0,0 +1,67 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trivy
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Output accepts the list of results of the old versions of trivy and the object with the results of the new ones
+type Output struct {
+	Results []Result `json:"Results"`
+}
+
+func (o *Output) UnmarshalJSON(data []byte) error {
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "[") {
+		return json.Unmarshal(data, &o.Results)
+	}
+	type output Output
+	return json.Unmarshal(data, (*output)(o))
+}
+
+type Result struct {
+	Target          string          `json:"Target"`
+	Type            string          `json:"Type"`
+	Vulnerabilities []Vulnerability `json:"Vulnerabilities"`
+}
+
+type Vulnerability struct {
+	VulnerabilityID  string `json:"VulnerabilityID"`
+	PkgName          string `json:"PkgName"`
+	InstalledVersion string `json:"InstalledVersion"`
+	FixedVersion     string `json:"FixedVersion"`
+	Title            string `json:"Title"`
+	Description      string `json:"Description"`
+	Severity         string `json:"Severity"`
+	PrimaryURL       string `json:"PrimaryURL"`
+}
+
+func (v *Vulnerability) GetPackage() string {
+	return v.PkgName + "@" + v.InstalledVersion
+}
+
+func (v *Vulnerability) GetDetails() string {
+	details := v.VulnerabilityID + " in " + v.GetPackage()
+	if v.FixedVersion != "" {
+		details += ", fixed in " + v.FixedVersion
+	}
+	for _, value := range []string{v.Title, v.Description, v.PrimaryURL} {
+		if value != "" {
+			details += "\n" + value
+		}
+	}
+	return details
+}
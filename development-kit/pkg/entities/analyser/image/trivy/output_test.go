@@ -0,0 +1,44 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trivy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutput(t *testing.T) {
+	t.Run("Should parse the output of the old and the new versions of trivy", func(t *testing.T) {
+		results := `[{"Target": "alpine:3.10 (alpine 3.10.9)", "Type": "alpine", "Vulnerabilities": [{"PkgName": "zlib"}]}]`
+		for _, content := range []string{results, `{"SchemaVersion": 2, "Results": ` + results + `}`} {
+			output := Output{}
+			assert.NoError(t, json.Unmarshal([]byte(content), &output))
+			assert.Len(t, output.Results, 1)
+			assert.Equal(t, "zlib", output.Results[0].Vulnerabilities[0].PkgName)
+		}
+	})
+}
+
+func TestVulnerability(t *testing.T) {
+	t.Run("Should return the details with the fixed version", func(t *testing.T) {
+		vulnerability := &Vulnerability{VulnerabilityID: "CVE-2018-25032", PkgName: "zlib", InstalledVersion: "1.2.11",
+			FixedVersion: "1.2.12", Title: "memory corruption when compressing"}
+
+		assert.Equal(t, "CVE-2018-25032 in zlib@1.2.11, fixed in 1.2.12\nmemory corruption when compressing",
+			vulnerability.GetDetails())
+	})
+}
@@ -14,45 +14,24 @@
 
 package npm
 
-import "github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+import (
+	"regexp"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/semver"
+)
+
+var patchedVersionRegex = regexp.MustCompile(`>=\s*v?(\d[\w.+-]*)`)
 
 type Issue struct {
 	Findings           []Finding `json:"findings"`
 	ID                 int       `json:"id"`
 	ModuleName         string    `json:"module_name"`
 	VulnerableVersions string    `json:"vulnerable_versions"`
+	PatchedVersions    string    `json:"patched_versions"`
 	Severity           string    `json:"severity"`
 	Overview           string    `json:"overview"`
 }
 
-func (i *Issue) GetSeverity() severity.Severity {
-	if i.IsLowSeverity() {
-		return severity.Low
-	}
-
-	if i.IsMediumSeverity() {
-		return severity.Medium
-	}
-
-	if i.IsHighSeverity() {
-		return severity.High
-	}
-
-	return severity.NoSec
-}
-
-func (i *Issue) IsLowSeverity() bool {
-	return i.Severity == "low" || i.Severity == "info"
-}
-
-func (i *Issue) IsMediumSeverity() bool {
-	return i.Severity == "moderate"
-}
-
-func (i *Issue) IsHighSeverity() bool {
-	return i.Severity == "high" || i.Severity == "critical"
-}
-
 func (i *Issue) GetVersion() string {
 	if len(i.Findings) > 0 {
 		return i.Findings[0].Version
@@ -60,3 +39,13 @@ func (i *Issue) GetVersion() string {
 
 	return ""
 }
+
+// GetFixedVersion returns the lowest patched version greater than the version found, the patched versions are ranges
+// like >=6.0.4 <6.1.0 || >=6.1.2
+func (i *Issue) GetFixedVersion() string {
+	var versions []string
+	for _, match := range patchedVersionRegex.FindAllStringSubmatch(i.PatchedVersions, -1) {
+		versions = append(versions, match[1])
+	}
+	return semver.MinGreaterThan(versions, i.GetVersion())
+}
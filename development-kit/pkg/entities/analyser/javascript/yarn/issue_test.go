@@ -17,7 +17,6 @@ package yarn
 import (
 	"testing"
 
-	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,36 +39,22 @@ func TestGetVersion(t *testing.T) {
 	})
 }
 
-func TestGetSeverity(t *testing.T) {
-	t.Run("should return a low severity", func(t *testing.T) {
+func TestGetFixedVersion(t *testing.T) {
+	t.Run("should return the lowest patched version greater than the version found", func(t *testing.T) {
 		issue := Issue{
-			Severity: "low",
+			Findings:        []Finding{{Version: "6.1.0"}},
+			PatchedVersions: ">=6.0.4 <6.1.0 || >=6.1.2 <6.2.0 || >=6.2.3",
 		}
 
-		assert.Equal(t, severity.Low, issue.GetSeverity())
+		assert.Equal(t, "6.1.2", issue.GetFixedVersion())
 	})
 
-	t.Run("should return a medium severity", func(t *testing.T) {
+	t.Run("should return empty when there is no patched version", func(t *testing.T) {
 		issue := Issue{
-			Severity: "moderate",
+			Findings:        []Finding{{Version: "1.0.0"}},
+			PatchedVersions: "<0.0.0",
 		}
 
-		assert.Equal(t, severity.Medium, issue.GetSeverity())
-	})
-
-	t.Run("should return a high severity", func(t *testing.T) {
-		issue := Issue{
-			Severity: "critical",
-		}
-
-		assert.Equal(t, severity.High, issue.GetSeverity())
-	})
-
-	t.Run("should return a no sec severity", func(t *testing.T) {
-		issue := Issue{
-			Severity: "sec",
-		}
-
-		assert.Equal(t, severity.NoSec, issue.GetSeverity())
+		assert.Empty(t, issue.GetFixedVersion())
 	})
 }
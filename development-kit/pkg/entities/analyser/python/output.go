@@ -15,7 +15,10 @@
 package python
 
 import (
+	"strings"
+
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/semver"
 )
 
 type BanditOutput struct {
@@ -54,3 +57,12 @@ func (b *BanditResult) GetFile() string {
 
 	return b.FileName
 }
+
+// GetFixedVersion returns the version that the vulnerability is below, when it is greater than the installed version
+func (s *SafetyIssues) GetFixedVersion() string {
+	fixedVersion := strings.TrimSpace(strings.TrimPrefix(s.VulnerableBelow, "<"))
+	if strings.HasPrefix(fixedVersion, "=") || semver.Compare(fixedVersion, s.InstalledVersion) <= 0 {
+		return ""
+	}
+	return fixedVersion
+}
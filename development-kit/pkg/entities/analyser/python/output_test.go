@@ -0,0 +1,35 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package python
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafetyIssuesGetFixedVersion(t *testing.T) {
+	t.Run("should return the version that the vulnerability is below", func(t *testing.T) {
+		issue := SafetyIssues{VulnerableBelow: "<2.7.2", InstalledVersion: "2.7.1"}
+
+		assert.Equal(t, "2.7.2", issue.GetFixedVersion())
+	})
+
+	t.Run("should return empty when the version isn't greater than the installed version", func(t *testing.T) {
+		issue := SafetyIssues{VulnerableBelow: "2.7.2", InstalledVersion: "2.7.2"}
+
+		assert.Empty(t, issue.GetFixedVersion())
+	})
+}
@@ -18,7 +18,7 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
 )
 
 type Warning struct {
@@ -35,23 +35,24 @@ const (
 	confidenceHigh   = "High"
 	confidenceMedium = "Medium"
 	confidenceLow    = "Low"
+	confidenceWeak   = "Weak"
 )
 
 func (o *Warning) GetDetails() string {
 	return fmt.Sprintf("%s %s", o.Details, o.Message)
 }
 
-func (o *Warning) GetSeverity() severity.Severity {
-	if o.Confidence == confidenceHigh {
-		return severity.High
+// GetConfidence keeps empty the unknown confidences, brakeman informs Weak to its lowest confidence
+func (o *Warning) GetConfidence() string {
+	switch o.Confidence {
+	case confidenceHigh:
+		return confidence.High.ToString()
+	case confidenceMedium:
+		return confidence.Medium.ToString()
+	case confidenceLow, confidenceWeak:
+		return confidence.Low.ToString()
 	}
-	if o.Confidence == confidenceMedium {
-		return severity.Medium
-	}
-	if o.Confidence == confidenceLow {
-		return severity.Low
-	}
-	return severity.NoSec
+	return ""
 }
 
 func (o *Warning) GetLine() string {
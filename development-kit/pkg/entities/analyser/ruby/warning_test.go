@@ -17,7 +17,6 @@ package ruby
 import (
 	"testing"
 
-	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,25 +31,20 @@ func TestGetDetails(t *testing.T) {
 	})
 }
 
-func TestGetSeverity(t *testing.T) {
-	t.Run("Should return output high severity", func(t *testing.T) {
-		output := Warning{Confidence: "High"}
-		assert.Equal(t, severity.High, output.GetSeverity())
-	})
-
-	t.Run("Should return output medium severity", func(t *testing.T) {
-		output := Warning{Confidence: "Medium"}
-		assert.Equal(t, severity.Medium, output.GetSeverity())
+func TestGetConfidence(t *testing.T) {
+	t.Run("Should return low confidence to weak warnings", func(t *testing.T) {
+		output := Warning{Confidence: "Weak"}
+		assert.Equal(t, "LOW", output.GetConfidence())
 	})
 
-	t.Run("Should return output low severity", func(t *testing.T) {
-		output := Warning{Confidence: "Low"}
-		assert.Equal(t, severity.Low, output.GetSeverity())
+	t.Run("Should return high confidence", func(t *testing.T) {
+		output := Warning{Confidence: "High"}
+		assert.Equal(t, "HIGH", output.GetConfidence())
 	})
 
-	t.Run("Should return output no sec severity", func(t *testing.T) {
+	t.Run("Should return empty confidence when unknown", func(t *testing.T) {
 		output := Warning{}
-		assert.Equal(t, severity.NoSec, output.GetSeverity())
+		assert.Equal(t, "", output.GetConfidence())
 	})
 }
 
@@ -0,0 +1,109 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarif
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
+)
+
+// Decode streams the results of the runs of the sarif log, calling addResult with the run of each result. The results
+// of a run are decoded one by one, as the outputs of big projects can have hundreds of megabytes, and are kept in memory
+// only when they come before the tool of the run, that has the rules of the results
+func Decode(reader io.Reader, addResult func(run *Run, result *Result) error) error {
+	return jsonUtils.DecodeArray(reader, "runs", func(decoder *json.Decoder) error {
+		return decodeRun(decoder, addResult)
+	})
+}
+
+func decodeRun(decoder *json.Decoder, addResult func(run *Run, result *Result) error) error {
+	if err := expectObjectStart(decoder); err != nil {
+		return err
+	}
+	run, hasTool := &Run{}, false
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "tool":
+			err = decoder.Decode(&run.Tool)
+			hasTool = true
+		case "results":
+			err = decodeResults(decoder, run, hasTool, addResult)
+		default:
+			var skip json.RawMessage
+			err = decoder.Decode(&skip)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+	return addPendingResults(run, addResult)
+}
+
+func decodeResults(decoder *json.Decoder, run *Run, hasTool bool,
+	addResult func(run *Run, result *Result) error) error {
+	token, err := decoder.Token()
+	if err != nil || token == nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected json array but found %v", token)
+	}
+	for decoder.More() {
+		result := Result{}
+		if err := decoder.Decode(&result); err != nil {
+			return err
+		}
+		if !hasTool {
+			run.Results = append(run.Results, result)
+			continue
+		}
+		if err := addResult(run, &result); err != nil {
+			return err
+		}
+	}
+	_, err = decoder.Token()
+	return err
+}
+
+func addPendingResults(run *Run, addResult func(run *Run, result *Result) error) error {
+	for index := range run.Results {
+		if err := addResult(run, &run.Results[index]); err != nil {
+			return err
+		}
+	}
+	run.Results = nil
+	return nil
+}
+
+func expectObjectStart(decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("expected json object but found %v", token)
+	}
+	return nil
+}
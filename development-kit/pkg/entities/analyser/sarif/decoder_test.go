@@ -0,0 +1,62 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarif
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func decode(t *testing.T, output string) (ruleNames, ruleIDs []string) {
+	err := Decode(strings.NewReader(output), func(run *Run, result *Result) error {
+		ruleNames = append(ruleNames, run.Tool.Driver.GetRule(result).Name)
+		ruleIDs = append(ruleIDs, result.RuleID)
+		return nil
+	})
+	assert.NoError(t, err)
+	return ruleNames, ruleIDs
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("Should decode the results of all the runs with their rules", func(t *testing.T) {
+		names, ids := decode(t, `{"version":"2.1.0","runs":[
+			{"tool":{"driver":{"name":"a","rules":[{"id":"r1","name":"Rule 1"}]}},"results":[{"ruleId":"r1"}]},
+			{"tool":{"driver":{"name":"b","rules":[{"id":"r2","name":"Rule 2"}]}},"results":[{"ruleId":"r2"},
+				{"ruleId":"r3"}]}]}`)
+
+		assert.Equal(t, []string{"Rule 1", "Rule 2", ""}, names)
+		assert.Equal(t, []string{"r1", "r2", "r3"}, ids)
+	})
+	t.Run("Should decode the results that come before the tool of the run", func(t *testing.T) {
+		names, _ := decode(t, `{"runs":[{"results":[{"ruleId":"r1"}],"tool":{"driver":{"rules":[{"id":"r1",
+			"name":"Rule 1"}]}}}]}`)
+
+		assert.Equal(t, []string{"Rule 1"}, names)
+	})
+	t.Run("Should decode runs without results", func(t *testing.T) {
+		names, _ := decode(t, `{"runs":[{"tool":{"driver":{}},"results":null},{"tool":{"driver":{}}}]}`)
+
+		assert.Empty(t, names)
+	})
+	t.Run("Should return error when the output is invalid", func(t *testing.T) {
+		err := Decode(strings.NewReader(`{"runs":[{"results":{}}]}`), func(run *Run, result *Result) error {
+			return nil
+		})
+
+		assert.Error(t, err)
+	})
+}
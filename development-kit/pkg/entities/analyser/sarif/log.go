@@ -0,0 +1,196 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sarif has the subset of the SARIF 2.1.0 used to read the results of the tools that output it
+package sarif
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var cweRegex = regexp.MustCompile(`(?i)\bCWE-(\d+)\b`)
+
+// Run is a run of a tool, the rules of its driver are the metadata of the rules of the results
+type Run struct {
+	Tool    Tool     `json:"tool"`
+	Results []Result `json:"results"`
+}
+
+type Tool struct {
+	Driver Driver `json:"driver"`
+}
+
+type Driver struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Rules   []Rule `json:"rules"`
+}
+
+// GetRule returns the rule of the result by its index, when informed, or by its id
+func (d *Driver) GetRule(result *Result) *Rule {
+	if result.RuleIndex != nil && *result.RuleIndex >= 0 && *result.RuleIndex < len(d.Rules) {
+		return &d.Rules[*result.RuleIndex]
+	}
+	for index := range d.Rules {
+		if d.Rules[index].ID == result.RuleID {
+			return &d.Rules[index]
+		}
+	}
+	return &Rule{}
+}
+
+type Rule struct {
+	ID                   string         `json:"id"`
+	Name                 string         `json:"name"`
+	ShortDescription     Message        `json:"shortDescription"`
+	FullDescription      Message        `json:"fullDescription"`
+	Help                 Message        `json:"help"`
+	HelpURI              string         `json:"helpUri"`
+	DefaultConfiguration Configuration  `json:"defaultConfiguration"`
+	Properties           RuleProperties `json:"properties"`
+}
+
+// GetCWEs returns the CWEs of the tags of the rule, like CWE-89, without repeating them
+func (r *Rule) GetCWEs() (cwes []string) {
+	for _, tag := range r.Properties.Tags {
+		for _, match := range cweRegex.FindAllStringSubmatch(tag, -1) {
+			cwe := "CWE-" + match[1]
+			if !contains(cwes, cwe) {
+				cwes = append(cwes, cwe)
+			}
+		}
+	}
+	return cwes
+}
+
+// GetConfidence returns the precision of the rule as the confidence of horusec, very-high is also HIGH
+func (r *Rule) GetConfidence() string {
+	switch strings.ToLower(r.Properties.Precision) {
+	case "very-high", "high":
+		return "HIGH"
+	case "medium":
+		return "MEDIUM"
+	case "low":
+		return "LOW"
+	}
+	return ""
+}
+
+type Configuration struct {
+	Level string `json:"level"`
+}
+
+type RuleProperties struct {
+	Tags             []string `json:"tags"`
+	Precision        string   `json:"precision"`
+	SecuritySeverity string   `json:"security-severity"`
+}
+
+type Message struct {
+	Text string `json:"text"`
+}
+
+type Result struct {
+	RuleID              string            `json:"ruleId"`
+	RuleIndex           *int              `json:"ruleIndex"`
+	Level               string            `json:"level"`
+	Message             Message           `json:"message"`
+	Locations           []Location        `json:"locations"`
+	Fingerprints        map[string]string `json:"fingerprints"`
+	PartialFingerprints map[string]string `json:"partialFingerprints"`
+}
+
+// GetLevel returns the level of the result, or the default level of its rule, or warning that is the default of SARIF
+func (r *Result) GetLevel(rule *Rule) string {
+	if r.Level != "" {
+		return r.Level
+	}
+	if rule.DefaultConfiguration.Level != "" {
+		return rule.DefaultConfiguration.Level
+	}
+	return "warning"
+}
+
+// GetFingerprint returns the first fingerprint of the result by the name of its version, then the first partial
+// fingerprint, so the same fingerprint is returned in all the analyses
+func (r *Result) GetFingerprint() string {
+	for _, fingerprints := range []map[string]string{r.Fingerprints, r.PartialFingerprints} {
+		keys := make([]string, 0, len(fingerprints))
+		for key := range fingerprints {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		if len(keys) > 0 {
+			return fingerprints[keys[0]]
+		}
+	}
+	return ""
+}
+
+// GetFile returns the path of the first location, without the file scheme
+func (r *Result) GetFile() string {
+	return strings.TrimPrefix(r.getPhysicalLocation().ArtifactLocation.URI, "file://")
+}
+
+func (r *Result) GetLine() string {
+	return strconv.Itoa(r.getPhysicalLocation().Region.StartLine)
+}
+
+func (r *Result) GetColumn() string {
+	return strconv.Itoa(r.getPhysicalLocation().Region.StartColumn)
+}
+
+func (r *Result) GetCode() string {
+	return r.getPhysicalLocation().Region.Snippet.Text
+}
+
+func (r *Result) getPhysicalLocation() *PhysicalLocation {
+	if len(r.Locations) == 0 {
+		return &PhysicalLocation{}
+	}
+	return &r.Locations[0].PhysicalLocation
+}
+
+type Location struct {
+	PhysicalLocation PhysicalLocation `json:"physicalLocation"`
+}
+
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Region           Region           `json:"region"`
+}
+
+type ArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type Region struct {
+	StartLine   int     `json:"startLine"`
+	StartColumn int     `json:"startColumn"`
+	EndLine     int     `json:"endLine"`
+	EndColumn   int     `json:"endColumn"`
+	Snippet     Message `json:"snippet"`
+}
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarif
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriver(t *testing.T) {
+	driver := &Driver{Rules: []Rule{{ID: "rule-1"}, {ID: "rule-2"}}}
+
+	t.Run("Should return the rule by the index of the result", func(t *testing.T) {
+		index := 1
+		assert.Equal(t, "rule-2", driver.GetRule(&Result{RuleID: "rule-1", RuleIndex: &index}).ID)
+	})
+	t.Run("Should return the rule by the id of the result", func(t *testing.T) {
+		assert.Equal(t, "rule-1", driver.GetRule(&Result{RuleID: "rule-1"}).ID)
+	})
+	t.Run("Should return an empty rule when the rule doesn't exist", func(t *testing.T) {
+		assert.Equal(t, &Rule{}, driver.GetRule(&Result{RuleID: "rule-3"}))
+	})
+}
+
+func TestRule(t *testing.T) {
+	t.Run("Should return the cwes of the tags without repeating them", func(t *testing.T) {
+		rule := &Rule{Properties: RuleProperties{Tags: []string{"CWE-89: SQL Injection", "security", "cwe-89", "CWE-79"}}}
+
+		assert.Equal(t, []string{"CWE-89", "CWE-79"}, rule.GetCWEs())
+	})
+	t.Run("Should return the precision as the confidence", func(t *testing.T) {
+		for precision, expected := range map[string]string{"very-high": "HIGH", "high": "HIGH", "medium": "MEDIUM",
+			"low": "LOW", "": ""} {
+			rule := &Rule{Properties: RuleProperties{Precision: precision}}
+			assert.Equal(t, expected, rule.GetConfidence())
+		}
+	})
+}
+
+func TestResult(t *testing.T) {
+	t.Run("Should return the level of the result, then of the rule and then warning", func(t *testing.T) {
+		rule := &Rule{DefaultConfiguration: Configuration{Level: "error"}}
+
+		assert.Equal(t, "note", (&Result{Level: "note"}).GetLevel(rule))
+		assert.Equal(t, "error", (&Result{}).GetLevel(rule))
+		assert.Equal(t, "warning", (&Result{}).GetLevel(&Rule{}))
+	})
+	t.Run("Should return the first fingerprint and then the first partial fingerprint", func(t *testing.T) {
+		result := &Result{Fingerprints: map[string]string{"b/v1": "2", "a/v1": "1"},
+			PartialFingerprints: map[string]string{"c/v1": "3"}}
+
+		assert.Equal(t, "1", result.GetFingerprint())
+		assert.Equal(t, "3", (&Result{PartialFingerprints: result.PartialFingerprints}).GetFingerprint())
+		assert.Equal(t, "", (&Result{}).GetFingerprint())
+	})
+	t.Run("Should return the location of the first location", func(t *testing.T) {
+		result := &Result{Locations: []Location{{PhysicalLocation: PhysicalLocation{
+			ArtifactLocation: ArtifactLocation{URI: "file://src/main.py"},
+			Region:           Region{StartLine: 10, StartColumn: 5, Snippet: Message{Text: "eval(value)"}},
+		}}}}
+
+		assert.Equal(t, "src/main.py", result.GetFile())
+		assert.Equal(t, "10", result.GetLine())
+		assert.Equal(t, "5", result.GetColumn())
+		assert.Equal(t, "eval(value)", result.GetCode())
+	})
+	t.Run("Should return empty location when the result has no location", func(t *testing.T) {
+		result := &Result{}
+
+		assert.Equal(t, "", result.GetFile())
+		assert.Equal(t, "0", result.GetLine())
+	})
+}
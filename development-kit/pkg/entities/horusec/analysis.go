@@ -16,6 +16,7 @@ package horusec
 
 import (
 	"encoding/json"
+	"sort"
 	"strings"
 	"time"
 
@@ -36,6 +37,18 @@ type Analysis struct {
 	CreatedAt               time.Time                 `json:"createdAt" gorm:"Column:created_at"`
 	FinishedAt              time.Time                 `json:"finishedAt" gorm:"Column:finished_at"`
 	AnalysisVulnerabilities []AnalysisVulnerabilities `json:"analysisVulnerabilities" gorm:"foreignkey:AnalysisID;association_foreignkey:ID"` //nolint:lll gorm usage
+	// PolicyDenials are the reasons returned by the policy of the cli that denied the analysis
+	PolicyDenials []string   `json:"policyDenials,omitempty" gorm:"-"`
+	RiskScore     *RiskScore `json:"riskScore,omitempty" gorm:"-"`
+	// Tags are the metadata informed by the user in the cli, like branch or team
+	Tags   map[string]string `json:"tags,omitempty" gorm:"-"`
+	Source *SourceContext    `json:"source,omitempty" gorm:"-"`
+	// ScanManifest lists the tools executed and skipped by the cli, to show why a tool didn't run
+	ScanManifest *ScanManifest `json:"scanManifest,omitempty" gorm:"-"`
+	// SkippedFiles are the vendored or generated files skipped by the language detection of the cli
+	SkippedFiles []string `json:"skippedFiles,omitempty" gorm:"-"`
+	// IsPartial is true when the timeout of the cli was reached, the analysis has only the tools finished before it
+	IsPartial bool `json:"isPartial,omitempty" gorm:"-"`
 }
 
 func (a *Analysis) GetTable() string {
@@ -153,17 +166,19 @@ func (a *Analysis) getDefaultTotalVulnerabilitiesBySeverity() map[horusec.Vulner
 
 func (a *Analysis) getDefaultCountBySeverity() map[severity.Severity]int {
 	return map[severity.Severity]int{
-		severity.High:   0,
-		severity.Medium: 0,
-		severity.Low:    0,
-		severity.Audit:  0,
-		severity.Info:   0,
-		severity.NoSec:  0,
+		severity.Critical: 0,
+		severity.High:     0,
+		severity.Medium:   0,
+		severity.Low:      0,
+		severity.Audit:    0,
+		severity.Info:     0,
+		severity.NoSec:    0,
 	}
 }
 
 func (a *Analysis) SortVulnerabilitiesByCriticality() *Analysis {
-	analysisVulnerabilities := a.getVulnerabilitiesBySeverity(severity.High)
+	analysisVulnerabilities := a.getVulnerabilitiesBySeverity(severity.Critical)
+	analysisVulnerabilities = append(analysisVulnerabilities, a.getVulnerabilitiesBySeverity(severity.High)...)
 	analysisVulnerabilities = append(analysisVulnerabilities, a.getVulnerabilitiesBySeverity(severity.Medium)...)
 	analysisVulnerabilities = append(analysisVulnerabilities, a.getVulnerabilitiesBySeverity(severity.Low)...)
 	analysisVulnerabilities = append(analysisVulnerabilities, a.getVulnerabilitiesBySeverity(severity.Info)...)
@@ -182,6 +197,40 @@ func (a *Analysis) SortVulnerabilitiesByType() *Analysis {
 	return a
 }
 
+// SortVulnerabilitiesByLocation keeps the order of the vulnerabilities independent of the order the tools finished,
+// the sorts by criticality and by type keep the order of the vulnerabilities of the same group
+func (a *Analysis) SortVulnerabilitiesByLocation() *Analysis {
+	sort.SliceStable(a.AnalysisVulnerabilities, func(i, j int) bool {
+		return a.AnalysisVulnerabilities[i].Vulnerability.isBefore(&a.AnalysisVulnerabilities[j].Vulnerability)
+	})
+	return a
+}
+
+// RemoveRandomData clears the ids, dates and durations, that change in each analysis of the same code, and sorts
+// the errors, that are added in the order the tools finished
+func (a *Analysis) RemoveRandomData() *Analysis {
+	a.ID = uuid.Nil
+	a.CreatedAt = time.Time{}
+	a.FinishedAt = time.Time{}
+	for index := range a.AnalysisVulnerabilities {
+		a.AnalysisVulnerabilities[index].SetVulnerabilityID(uuid.Nil)
+		a.AnalysisVulnerabilities[index].SetAnalysisID(uuid.Nil)
+		a.AnalysisVulnerabilities[index].CreatedAt = time.Time{}
+	}
+	if a.ScanManifest != nil {
+		a.ScanManifest.CopyDurationInSeconds = 0
+		for index := range a.ScanManifest.ToolsExecuted {
+			a.ScanManifest.ToolsExecuted[index].DurationInSeconds = 0
+		}
+	}
+	if a.HasErrors() {
+		errs := strings.Split(a.Errors, "; ")
+		sort.Strings(errs)
+		a.Errors = strings.Join(errs, "; ")
+	}
+	return a
+}
+
 func (a *Analysis) GetAnalysisWithoutAnalysisVulnerabilities() *Analysis {
 	return &Analysis{
 		ID:             a.ID,
@@ -19,9 +19,11 @@ import (
 	"errors"
 	horusecEnum "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestTableName(t *testing.T) {
@@ -257,6 +259,48 @@ func TestSortVulnerabilitiesByType(t *testing.T) {
 	})
 }
 
+func TestSortVulnerabilitiesByLocation(t *testing.T) {
+	t.Run("should sort by file, line, column, rule and hash", func(t *testing.T) {
+		analysis := &Analysis{
+			AnalysisVulnerabilities: []AnalysisVulnerabilities{
+				{Vulnerability: Vulnerability{File: "main.go", Line: "10", RuleID: "G401"}},
+				{Vulnerability: Vulnerability{File: "main.go", Line: "9", RuleID: "G401"}},
+				{Vulnerability: Vulnerability{File: "api.go", Line: "20", RuleID: "G401"}},
+				{Vulnerability: Vulnerability{File: "main.go", Line: "10", RuleID: "G101"}},
+			},
+		}
+
+		result := analysis.SortVulnerabilitiesByLocation()
+		assert.Equal(t, "api.go", result.AnalysisVulnerabilities[0].Vulnerability.File)
+		assert.Equal(t, "9", result.AnalysisVulnerabilities[1].Vulnerability.Line)
+		assert.Equal(t, "G101", result.AnalysisVulnerabilities[2].Vulnerability.RuleID)
+		assert.Equal(t, "G401", result.AnalysisVulnerabilities[3].Vulnerability.RuleID)
+	})
+}
+
+func TestRemoveRandomData(t *testing.T) {
+	t.Run("should clear the ids and dates and sort the errors", func(t *testing.T) {
+		analysis := &Analysis{ID: uuid.New(), CreatedAt: time.Now(), FinishedAt: time.Now(), Errors: "tfsec; bandit",
+			AnalysisVulnerabilities: []AnalysisVulnerabilities{{Vulnerability: Vulnerability{File: "main.go"}}}}
+		analysis.SetupIDInAnalysisContents()
+
+		result := analysis.RemoveRandomData()
+		assert.Equal(t, uuid.Nil, result.ID)
+		assert.True(t, result.CreatedAt.IsZero())
+		assert.True(t, result.FinishedAt.IsZero())
+		assert.Equal(t, "bandit; tfsec", result.Errors)
+		assert.Equal(t, AnalysisVulnerabilities{Vulnerability: Vulnerability{File: "main.go"}},
+			result.AnalysisVulnerabilities[0])
+	})
+	t.Run("should clear the durations of the tools executed", func(t *testing.T) {
+		analysis := &Analysis{ScanManifest: &ScanManifest{ToolsExecuted: []ToolExecution{
+			{Tool: tools.GoSec, DurationInSeconds: 1.5}}}}
+
+		result := analysis.RemoveRandomData()
+		assert.Equal(t, float64(0), result.ScanManifest.ToolsExecuted[0].DurationInSeconds)
+	})
+}
+
 func TestGetAnalysisWithoutAnalysisVulnerabilities(t *testing.T) {
 	t.Run("should success get analysis without vulnerabilities", func(t *testing.T) {
 		analysis := &Analysis{
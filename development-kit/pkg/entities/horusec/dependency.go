@@ -0,0 +1,23 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusec
+
+// Dependency is the vulnerable dependency reported by the tools of dependencies, with the lowest version that fixes
+// the vulnerability when the tool knows it
+type Dependency struct {
+	Name         string `json:"name"`
+	Version      string `json:"version"`
+	FixedVersion string `json:"fixedVersion,omitempty"`
+}
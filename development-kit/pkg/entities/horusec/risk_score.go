@@ -0,0 +1,22 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusec
+
+// RiskScore summarizes the vulnerabilities of the analysis, the score is the sum of the weights of the
+// vulnerabilities and the grade goes from A, without vulnerabilities, to F
+type RiskScore struct {
+	Score int    `json:"score"`
+	Grade string `json:"grade"`
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusec
+
+import (
+	"sort"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+)
+
+const (
+	ToolStatusSuccess = "success"
+	ToolStatusFailed  = "failed"
+)
+
+// ScanManifest is the evidence of the tools executed in the analysis and of the tools skipped with the reason
+type ScanManifest struct {
+	HorusecVersion string          `json:"horusecVersion"`
+	ToolsExecuted  []ToolExecution `json:"toolsExecuted"`
+	ToolsSkipped   []ToolSkipped   `json:"toolsSkipped"`
+	// FilesScanned is the number of files of the project found by the language detection
+	FilesScanned int `json:"filesScanned"`
+	// CopyDurationInSeconds is the time of the copy of the project to the .horusec folder, zero when not copied
+	CopyDurationInSeconds float64 `json:"copyDurationInSeconds"`
+	// Filters are the filters run in order with how many vulnerabilities each one removed of the report
+	Filters []FilterExecution `json:"filters,omitempty"`
+}
+
+// ToolExecution is one execution of the tool, the tools run once for each project sub path of its language
+type ToolExecution struct {
+	Tool              tools.Tool `json:"tool"`
+	ProjectSubPath    string     `json:"projectSubPath,omitempty"`
+	Image             string     `json:"image,omitempty"`
+	ImageDigest       string     `json:"imageDigest,omitempty"`
+	RulesVersion      string     `json:"rulesVersion,omitempty"`
+	DurationInSeconds float64    `json:"durationInSeconds"`
+	Status            string     `json:"status"`
+	// RawOutputPath is the file with the output of the container when it is saved in the artifacts dir
+	RawOutputPath string `json:"rawOutputPath,omitempty"`
+}
+
+type FilterExecution struct {
+	Name    string `json:"name"`
+	Removed int    `json:"removed"`
+}
+
+type ToolSkipped struct {
+	Tool   tools.Tool `json:"tool"`
+	Reason string     `json:"reason"`
+}
+
+// Sort keeps the same order in the manifests of the analyses of the same code, because the tools run in parallel
+func (s *ScanManifest) Sort() *ScanManifest {
+	sort.SliceStable(s.ToolsExecuted, func(i, j int) bool {
+		if s.ToolsExecuted[i].Tool != s.ToolsExecuted[j].Tool {
+			return s.ToolsExecuted[i].Tool < s.ToolsExecuted[j].Tool
+		}
+		return s.ToolsExecuted[i].ProjectSubPath < s.ToolsExecuted[j].ProjectSubPath
+	})
+	sort.SliceStable(s.ToolsSkipped, func(i, j int) bool {
+		return s.ToolsSkipped[i].Tool < s.ToolsSkipped[j].Tool
+	})
+	return s
+}
+
+// GetDurationByTool sums the durations of the executions of each tool in the project sub paths
+func (s *ScanManifest) GetDurationByTool() map[tools.Tool]float64 {
+	durations := map[tools.Tool]float64{}
+	for index := range s.ToolsExecuted {
+		durations[s.ToolsExecuted[index].Tool] += s.ToolsExecuted[index].DurationInSeconds
+	}
+	return durations
+}
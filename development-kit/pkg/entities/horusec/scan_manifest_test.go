@@ -0,0 +1,58 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+)
+
+func TestScanManifestSort(t *testing.T) {
+	t.Run("should sort the tools executed by tool and project sub path and the tools skipped by tool", func(t *testing.T) {
+		manifest := &ScanManifest{
+			ToolsExecuted: []ToolExecution{
+				{Tool: tools.GoSec, ProjectSubPath: "b"},
+				{Tool: tools.Bandit},
+				{Tool: tools.GoSec, ProjectSubPath: "a"},
+			},
+			ToolsSkipped: []ToolSkipped{{Tool: tools.Trivy}, {Tool: tools.Checkov}},
+		}
+
+		result := manifest.Sort()
+		assert.Equal(t, []ToolExecution{
+			{Tool: tools.Bandit},
+			{Tool: tools.GoSec, ProjectSubPath: "a"},
+			{Tool: tools.GoSec, ProjectSubPath: "b"},
+		}, result.ToolsExecuted)
+		assert.Equal(t, []ToolSkipped{{Tool: tools.Checkov}, {Tool: tools.Trivy}}, result.ToolsSkipped)
+	})
+}
+
+func TestScanManifestGetDurationByTool(t *testing.T) {
+	t.Run("should sum the durations of the project sub paths of the tool", func(t *testing.T) {
+		manifest := &ScanManifest{
+			ToolsExecuted: []ToolExecution{
+				{Tool: tools.GoSec, ProjectSubPath: "a", DurationInSeconds: 1.5},
+				{Tool: tools.GoSec, ProjectSubPath: "b", DurationInSeconds: 2},
+				{Tool: tools.Bandit, DurationInSeconds: 3},
+			},
+		}
+
+		assert.Equal(t, map[tools.Tool]float64{tools.GoSec: 3.5, tools.Bandit: 3}, manifest.GetDurationByTool())
+	})
+}
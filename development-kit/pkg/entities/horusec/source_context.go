@@ -0,0 +1,28 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusec
+
+// SourceContext is where the code analyzed came from, detected from the CI environment or from git
+type SourceContext struct {
+	CIProvider    string `json:"ciProvider,omitempty"`
+	RepositoryURL string `json:"repositoryURL,omitempty"`
+	Branch        string `json:"branch,omitempty"`
+	CommitSHA     string `json:"commitSHA,omitempty"`
+	PullRequest   string `json:"pullRequest,omitempty"`
+}
+
+func (s *SourceContext) IsEmpty() bool {
+	return s.RepositoryURL == "" && s.Branch == "" && s.CommitSHA == "" && s.PullRequest == ""
+}
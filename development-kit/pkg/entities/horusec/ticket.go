@@ -0,0 +1,38 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusec
+
+import "strings"
+
+// closedTicketStatuses are the status of the trackers that mean the ticket is done, the other status are open
+var closedTicketStatuses = map[string]bool{"closed": true, "done": true, "resolved": true}
+
+// Ticket is the ticket of the tracker where the vulnerability is triaged, only filled by the CLI
+type Ticket struct {
+	URL    string `json:"url"`
+	Status string `json:"status,omitempty"`
+}
+
+// IsOpen returns if the status isn't closed, done or resolved, the ticket without status is open
+func (t *Ticket) IsOpen() bool {
+	return !closedTicketStatuses[strings.ToLower(strings.TrimSpace(t.Status))]
+}
+
+func (t *Ticket) ToString() string {
+	if t.Status == "" {
+		return "Ticket: " + t.URL
+	}
+	return "Ticket: " + t.URL + " (" + t.Status + ")"
+}
@@ -15,6 +15,8 @@
 package horusec
 
 import (
+	"strconv"
+
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
@@ -40,6 +42,40 @@ type Vulnerability struct {
 	CommitHash      string                    `json:"commitHash" gorm:"Column:commit_hash"`
 	CommitMessage   string                    `json:"commitMessage" gorm:"Column:commit_message"`
 	CommitDate      string                    `json:"commitDate" gorm:"Column:commit_date"`
+
+	// VerificationStatus is only filled by the CLI when secret verification is enabled
+	VerificationStatus horusec.VerificationStatus `json:"verificationStatus,omitempty" gorm:"-"`
+
+	// RuleID is the id of the rule of the tool, only filled by the CLI to find the remediation of the vulnerability
+	RuleID string `json:"ruleID,omitempty" gorm:"-"`
+
+	// ToolSeverity is the severity or score informed by the tool, only filled by the CLI, the Severity is normalized
+	// from it
+	ToolSeverity string `json:"toolSeverity,omitempty" gorm:"-"`
+
+	// Fingerprint is the fingerprint informed by the tools that output sarif, only filled by the CLI
+	Fingerprint string `json:"fingerprint,omitempty" gorm:"-"`
+
+	// Dependency is only filled by the CLI to the vulnerabilities of the tools of dependencies
+	Dependency *Dependency `json:"dependency,omitempty" gorm:"-"`
+
+	// CodeContext is only filled by the CLI when the lines of code context are informed
+	CodeContext []CodeLine `json:"codeContext,omitempty" gorm:"-"`
+
+	// Triage is only filled by the CLI when the triage url is informed
+	Triage *Triage `json:"triage,omitempty" gorm:"-"`
+
+	// IsTestCode is only filled by the CLI when the file of the vulnerability is a test, example or fixture
+	IsTestCode bool `json:"isTestCode,omitempty" gorm:"-"`
+	// IsWarnOnly is only filled by the CLI when the tool of the vulnerability is warn only in the tools config
+	IsWarnOnly bool `json:"isWarnOnly,omitempty" gorm:"-"`
+	// Owners is only filled by the CLI with the owners of the file of the vulnerability in the CODEOWNERS
+	Owners []string `json:"owners,omitempty" gorm:"-"`
+	// Ticket is only filled by the CLI when the hash of the vulnerability is in the tickets file
+	Ticket *Ticket `json:"ticket,omitempty" gorm:"-"`
+
+	// RawOutputPath is only filled by the CLI when the raw output of the tool is saved with saveRawOutput
+	RawOutputPath string `json:"rawOutputPath,omitempty" gorm:"-"`
 }
 
 func (v *Vulnerability) GetTable() string {
@@ -57,3 +93,27 @@ func (v *Vulnerability) SetType(vulnType horusec.VulnerabilityType) {
 		v.Type = horusec.Vulnerability
 	}
 }
+
+func (v *Vulnerability) isBefore(other *Vulnerability) bool {
+	if v.File != other.File {
+		return v.File < other.File
+	}
+	if line, otherLine := v.getNumber(v.Line), v.getNumber(other.Line); line != otherLine {
+		return line < otherLine
+	}
+	if column, otherColumn := v.getNumber(v.Column), v.getNumber(other.Column); column != otherColumn {
+		return column < otherColumn
+	}
+	if v.RuleID != other.RuleID {
+		return v.RuleID < other.RuleID
+	}
+	if v.SecurityTool != other.SecurityTool {
+		return v.SecurityTool < other.SecurityTool
+	}
+	return v.VulnHash < other.VulnHash
+}
+
+func (v *Vulnerability) getNumber(value string) int {
+	number, _ := strconv.Atoi(value)
+	return number
+}
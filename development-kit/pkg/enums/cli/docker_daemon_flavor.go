@@ -0,0 +1,35 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+// DockerDaemonFlavor is how the docker daemon expects the paths of the host in the bind mounts
+type DockerDaemonFlavor string
+
+const (
+	// DockerDaemonAuto translates the windows paths like DockerDaemonDesktopHyperV and keeps the other paths
+	DockerDaemonAuto DockerDaemonFlavor = "auto"
+	// DockerDaemonLinux keeps the paths as they are, like in linux and macOS
+	DockerDaemonLinux DockerDaemonFlavor = "linux"
+	// DockerDaemonDesktopHyperV is the Docker Desktop with Hyper-V and the Docker Toolbox, C:\src is //c//src
+	DockerDaemonDesktopHyperV DockerDaemonFlavor = "desktop-hyperv"
+	// DockerDaemonDesktopWSL2 is the docker daemon running in the WSL2, C:\src is /mnt/c/src
+	DockerDaemonDesktopWSL2 DockerDaemonFlavor = "desktop-wsl2"
+	// DockerDaemonWindows is the docker daemon of windows containers, the paths are windows paths
+	DockerDaemonWindows DockerDaemonFlavor = "windows"
+)
+
+func (d DockerDaemonFlavor) ToString() string {
+	return string(d)
+}
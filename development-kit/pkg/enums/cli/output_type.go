@@ -17,9 +17,13 @@ package cli
 type OutputType string
 
 const (
-	Text      OutputType = "text"
-	JSON      OutputType = "json"
-	SonarQube OutputType = "sonarqube"
+	Text         OutputType = "text"
+	JSON         OutputType = "json"
+	SonarQube    OutputType = "sonarqube"
+	ThreadFix    OutputType = "threadfix"
+	CycloneDXVDR OutputType = "cyclonedx-vdr"
+	SPDX3        OutputType = "spdx3"
+	Jenkins      OutputType = "jenkins"
 )
 
 func (o OutputType) ToString() string {
@@ -0,0 +1,30 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+type PrintStyle string
+
+const (
+	// PrintStyleFull prints the block with all fields of each vulnerability
+	PrintStyleFull PrintStyle = "full"
+	// PrintStyleCompact prints one line for each vulnerability
+	PrintStyleCompact PrintStyle = "compact"
+	// PrintStyleGrouped prints the vulnerabilities grouped by file with the total of each file
+	PrintStyleGrouped PrintStyle = "grouped"
+)
+
+func (p PrintStyle) ToString() string {
+	return string(p)
+}
@@ -0,0 +1,28 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+type RemoteCacheMode string
+
+const (
+	// RemoteCacheReadOnly only reads the analysis results cached by other runners
+	RemoteCacheReadOnly RemoteCacheMode = "read-only"
+	// RemoteCacheReadWrite also writes the analysis results, so other runners can use them
+	RemoteCacheReadWrite RemoteCacheMode = "read-write"
+)
+
+func (r RemoteCacheMode) ToString() string {
+	return string(r)
+}
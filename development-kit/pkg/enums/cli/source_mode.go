@@ -0,0 +1,31 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+type SourceMode string
+
+const (
+	// SourceCopy copies the project into the analysis folder
+	SourceCopy SourceMode = "copy"
+	// SourceHardlink creates hardlinks of the project files in the analysis folder, copying only when the
+	// filesystem doesn't support it
+	SourceHardlink SourceMode = "hardlink"
+	// SourceReadOnly mounts the project itself read-only in the tools containers, when it is safe
+	SourceReadOnly SourceMode = "read-only"
+)
+
+func (s SourceMode) ToString() string {
+	return string(s)
+}
@@ -0,0 +1,30 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+type SymlinkMode string
+
+const (
+	// SymlinkSkip ignores all symbolic links of the project
+	SymlinkSkip SymlinkMode = "skip"
+	// SymlinkPreserve copies the links as links, only when they point inside of the project
+	SymlinkPreserve SymlinkMode = "preserve"
+	// SymlinkFollowWithinRoot copies the content of the links, only when they point inside of the project
+	SymlinkFollowWithinRoot SymlinkMode = "follow-within-root"
+)
+
+func (s SymlinkMode) ToString() string {
+	return string(s)
+}
@@ -0,0 +1,39 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+type TestCodeMode string
+
+const (
+	// TestCodeFlag only marks the vulnerabilities found in test code
+	TestCodeFlag TestCodeMode = "flag"
+	// TestCodeDowngrade marks the vulnerabilities found in test code and changes its severity to LOW
+	TestCodeDowngrade TestCodeMode = "downgrade"
+	// TestCodeExcludeFromGates marks the vulnerabilities found in test code and doesn't count them in the exit code,
+	// risk score and summary of the policy, they are kept in the report
+	TestCodeExcludeFromGates TestCodeMode = "exclude-from-gates"
+)
+
+func (t TestCodeMode) ToString() string {
+	return string(t)
+}
+
+// GetDefaultTestCodePatterns are the globs of the test files, examples and fixtures, relative to the project
+func GetDefaultTestCodePatterns() []string {
+	return []string{
+		"**/*_test.go", "**/*.{test,spec}.{js,jsx,ts,tsx}", "**/{test_*,*_test}.py", "**/*{Test,Tests}.{java,kt,cs,php}",
+		"**/*_spec.rb", "**/{test,tests,__tests__,spec,testdata}/**", "**/{fixtures,__fixtures__,example,examples}/**",
+	}
+}
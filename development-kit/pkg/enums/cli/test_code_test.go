@@ -0,0 +1,33 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDefaultTestCodePatterns(t *testing.T) {
+	t.Run("should success get 7 test code patterns", func(t *testing.T) {
+		assert.Len(t, GetDefaultTestCodePatterns(), 7)
+	})
+}
+
+func TestTestCodeModeToString(t *testing.T) {
+	t.Run("should return the mode as string", func(t *testing.T) {
+		assert.Equal(t, "exclude-from-gates", TestCodeExcludeFromGates.ToString())
+	})
+}
@@ -15,8 +15,21 @@
 package cli
 
 func GetDefaultFoldersToIgnore() []string {
-	return []string{"/.horusec/", "/.idea/", "/.vscode/", "/tmp/", "/bin/", "/node_modules/", "/vendor/",
-		"go.mod", "go.sum"}
+	return []string{"/.horusec/", "/.idea/", "/.vscode/", "/tmp/", "/bin/", "go.mod", "go.sum"}
+}
+
+// GetDefaultVendoredFoldersToIgnore are folders of dependencies, skipped unless vendored code analysis is enabled
+func GetDefaultVendoredFoldersToIgnore() []string {
+	return []string{"/node_modules/", "/vendor/", "/bower_components/"}
+}
+
+// GetDefaultGeneratedFileSuffixesToIgnore are suffixes of generated files, skipped unless generated code analysis
+// is enabled
+func GetDefaultGeneratedFileSuffixesToIgnore() []string {
+	return []string{
+		"_pb.go", ".pb.go", ".pb.gw.go", "_pb2.py", "_pb2_grpc.py", ".designer.cs",
+		".min.js", ".min.css", ".js.map", ".css.map",
+	}
 }
 
 func GetDefaultExtensionsToIgnore() []string {
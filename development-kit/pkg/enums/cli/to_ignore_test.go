@@ -21,7 +21,19 @@ import (
 
 func TestGetDefaultFoldersToIgnore(t *testing.T) {
 	t.Run("should success get 7 default files to ignore", func(t *testing.T) {
-		assert.Equal(t, len(GetDefaultFoldersToIgnore()), 9)
+		assert.Equal(t, len(GetDefaultFoldersToIgnore()), 7)
+	})
+}
+
+func TestGetDefaultVendoredFoldersToIgnore(t *testing.T) {
+	t.Run("should success get 3 vendored folders to ignore", func(t *testing.T) {
+		assert.Len(t, GetDefaultVendoredFoldersToIgnore(), 3)
+	})
+}
+
+func TestGetDefaultGeneratedFileSuffixesToIgnore(t *testing.T) {
+	t.Run("should success get 10 generated file suffixes to ignore", func(t *testing.T) {
+		assert.Len(t, GetDefaultGeneratedFileSuffixesToIgnore(), 10)
 	})
 }
 
@@ -14,6 +14,8 @@
 
 package confidence
 
+import "strings"
+
 type Confidence string
 
 const (
@@ -25,3 +27,18 @@ const (
 func (s Confidence) ToString() string {
 	return string(s)
 }
+
+// IsBelow is false to the unknown confidences, like the tools that don't inform it
+func (s Confidence) IsBelow(minConfidence Confidence) bool {
+	level, ok := levels()[Confidence(strings.ToUpper(string(s)))]
+	return ok && level < levels()[minConfidence]
+}
+
+func (s Confidence) IsValid() bool {
+	_, ok := levels()[s]
+	return ok
+}
+
+func levels() map[Confidence]int {
+	return map[Confidence]int{Low: 1, Medium: 2, High: 3}
+}
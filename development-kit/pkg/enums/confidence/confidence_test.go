@@ -0,0 +1,41 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confidence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBelow(t *testing.T) {
+	t.Run("Should compare the levels of confidence", func(t *testing.T) {
+		assert.True(t, Low.IsBelow(Medium))
+		assert.True(t, Confidence("medium").IsBelow(High))
+		assert.False(t, High.IsBelow(Medium))
+		assert.False(t, Medium.IsBelow(Medium))
+	})
+	t.Run("Should not be below when the confidence is unknown", func(t *testing.T) {
+		assert.False(t, Confidence("-").IsBelow(High))
+		assert.False(t, Confidence("").IsBelow(High))
+	})
+}
+
+func TestIsValid(t *testing.T) {
+	t.Run("Should be valid only to the levels of confidence", func(t *testing.T) {
+		assert.True(t, High.IsValid())
+		assert.False(t, Confidence("VERY_HIGH").IsValid())
+	})
+}
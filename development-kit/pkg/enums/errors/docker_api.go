@@ -21,3 +21,5 @@ const (
 )
 
 var ErrImageTagCmdRequired = errors.New("{ERROR_DOCKER_API} required exists Image, Tag and CMD not empty")
+
+var ErrContainerOutputTooLarge = errors.New("{ERROR_DOCKER_API} container output was truncated because it is too large")
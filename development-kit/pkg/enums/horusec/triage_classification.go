@@ -0,0 +1,31 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusec
+
+type TriageClassification string
+
+const (
+	TriageTruePositive  TriageClassification = "true-positive"
+	TriageFalsePositive TriageClassification = "false-positive"
+	TriageUncertain     TriageClassification = "uncertain"
+)
+
+func (t TriageClassification) ToString() string {
+	return string(t)
+}
+
+func (t TriageClassification) IsValid() bool {
+	return t == TriageTruePositive || t == TriageFalsePositive || t == TriageUncertain
+}
@@ -14,6 +14,8 @@
 
 package severity
 
+import "strings"
+
 type Severity string
 
 const (
@@ -22,7 +24,10 @@ const (
 	Low    Severity = "LOW"
 	Medium Severity = "MEDIUM"
 	High   Severity = "HIGH"
-	Audit  Severity = "AUDIT"
+	// Critical is only used by the cli, like in the severity tables and the thresholds, horusec platform has no
+	// severity above HIGH and receives it as HIGH
+	Critical Severity = "CRITICAL"
+	Audit    Severity = "AUDIT"
 )
 
 func (s Severity) ToString() string {
@@ -31,15 +36,32 @@ func (s Severity) ToString() string {
 
 func Map() map[string]Severity {
 	return map[string]Severity{
-		NoSec.ToString():  NoSec,
-		Info.ToString():   Info,
-		Low.ToString():    Low,
-		Medium.ToString(): Medium,
-		High.ToString():   High,
-		Audit.ToString():  Audit,
+		NoSec.ToString():    NoSec,
+		Info.ToString():     Info,
+		Low.ToString():      Low,
+		Medium.ToString():   Medium,
+		High.ToString():     High,
+		Critical.ToString(): Critical,
+		Audit.ToString():    Audit,
 	}
 }
 
 func ParseStringToSeverity(content string) Severity {
 	return Map()[content]
 }
+
+// IsBelow is false to the severities without level, like NOSEC and AUDIT
+func (s Severity) IsBelow(minSeverity Severity) bool {
+	level, ok := levels()[Severity(strings.ToUpper(string(s)))]
+	return ok && level < levels()[minSeverity]
+}
+
+// HasLevel returns if the severity can be used as a threshold
+func (s Severity) HasLevel() bool {
+	_, ok := levels()[s]
+	return ok
+}
+
+func levels() map[Severity]int {
+	return map[Severity]int{Info: 1, Low: 2, Medium: 3, High: 4, Critical: 5}
+}
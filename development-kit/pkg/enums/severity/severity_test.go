@@ -37,3 +37,25 @@ func TestParseStringToSeverity(t *testing.T) {
 		assert.Equal(t, Low, ParseStringToSeverity("LOW"))
 	})
 }
+
+func TestIsBelow(t *testing.T) {
+	t.Run("Should return true when the severity is below the min severity", func(t *testing.T) {
+		assert.True(t, Low.IsBelow(Medium))
+		assert.True(t, Severity("info").IsBelow(Low))
+	})
+
+	t.Run("Should return false when the severity is not below or has no level", func(t *testing.T) {
+		assert.False(t, High.IsBelow(Medium))
+		assert.False(t, Medium.IsBelow(Medium))
+		assert.False(t, Audit.IsBelow(Critical))
+		assert.False(t, NoSec.IsBelow(Critical))
+	})
+}
+
+func TestHasLevel(t *testing.T) {
+	t.Run("Should return if the severity can be used as threshold", func(t *testing.T) {
+		assert.True(t, Critical.HasLevel())
+		assert.False(t, Audit.HasLevel())
+		assert.False(t, Severity("URGENT").HasLevel())
+	})
+}
@@ -39,8 +39,42 @@ const (
 	HorusecNodejs     Tool = "HorusecNodeJS"
 	Flawfinder        Tool = "Flawfinder"
 	PhpCS             Tool = "PhpCS"
+	Trivy             Tool = "Trivy"
+	HorusecDockerfile Tool = "HorusecDockerfile"
+	Checkov           Tool = "Checkov"
+	OsvScanner        Tool = "OsvScanner"
 )
 
+//nolint
+func Values() []Tool {
+	return []Tool{
+		GoSec,
+		SecurityCodeScan,
+		Brakeman,
+		Safety,
+		Bandit,
+		NpmAudit,
+		YarnAudit,
+		SpotBugs,
+		HorusecKotlin,
+		HorusecJava,
+		HorusecLeaks,
+		GitLeaks,
+		TfSec,
+		Semgrep,
+		HorusecCsharp,
+		HorusecKubernetes,
+		Eslint,
+		HorusecNodejs,
+		Flawfinder,
+		PhpCS,
+		Trivy,
+		HorusecDockerfile,
+		Checkov,
+		OsvScanner,
+	}
+}
+
 func (t Tool) ToString() string {
 	return string(t)
 }
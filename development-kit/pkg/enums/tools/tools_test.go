@@ -24,3 +24,9 @@ func TestToString(t *testing.T) {
 		assert.Equal(t, "GoSec", GoSec.ToString())
 	})
 }
+
+func TestValues(t *testing.T) {
+	t.Run("Should return all tools", func(t *testing.T) {
+		assert.Len(t, Values(), 24)
+	})
+}
@@ -181,6 +181,10 @@ func (au *UseCases) sliceTools() []interface{} {
 		tools.HorusecKubernetes,
 		tools.Flawfinder,
 		tools.PhpCS,
+		tools.Trivy,
+		tools.HorusecDockerfile,
+		tools.Checkov,
+		tools.OsvScanner,
 	}
 }
 
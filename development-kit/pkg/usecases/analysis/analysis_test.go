@@ -281,4 +281,10 @@ func TestUseCases_DecodeAnalysisFromBytes(t *testing.T) {
 		assert.Error(t, err)
 		assert.Empty(t, newAnalysis)
 	})
+	t.Run("Should return error with the critical severity of the cli", func(t *testing.T) {
+		analysis := test.CreateAnalysisMock()
+		analysis.AnalysisVulnerabilities[0].Vulnerability.Severity = severity.Critical
+		_, err := NewAnalysisUseCases().DecodeAnalysisFromBytes(analysis.ToBytes())
+		assert.Error(t, err)
+	})
 }
@@ -16,86 +16,232 @@ package copy
 
 import (
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 )
 
+type copier struct {
+	root     string
+	skip     func(src string) bool
+	mode     cli.SymlinkMode
+	hardlink bool
+	estimate bool
+	size     int64
+	visiting map[string]bool
+}
+
 func Copy(src, dst string, skip func(src string) bool) error {
-	if err := os.MkdirAll(dst, os.ModePerm); err != nil {
+	return CopyWithSymlinkMode(src, dst, skip, cli.SymlinkFollowWithinRoot)
+}
+
+// CopyWithSymlinkMode copies src into dst handling symbolic links by mode. Links pointing outside of src
+// are never copied and cyclic links are copied only once
+func CopyWithSymlinkMode(src, dst string, skip func(src string) bool, mode cli.SymlinkMode) error {
+	c, err := newCopier(src, skip, mode)
+	if err != nil {
 		return err
 	}
-	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
+	return c.copyDir(src, dst)
+}
+
+// HardlinkWithSymlinkMode works like CopyWithSymlinkMode, but files are hardlinked instead of copied.
+// Files are copied when the link fails, like when src and dst are in different filesystems
+func HardlinkWithSymlinkMode(src, dst string, skip func(src string) bool, mode cli.SymlinkMode) error {
+	c, err := newCopier(src, skip, mode)
+	if err != nil {
+		return err
+	}
+	c.hardlink = true
+	return c.copyDir(src, dst)
+}
+
+// EstimateSizeWithSymlinkMode returns the amount of bytes that CopyWithSymlinkMode would copy from src,
+// following the same files to skip and symbolic links handling, without copying anything
+func EstimateSizeWithSymlinkMode(src string, skip func(src string) bool, mode cli.SymlinkMode) (int64, error) {
+	c, err := newCopier(src, skip, mode)
+	if err != nil {
+		return 0, err
+	}
+	c.estimate = true
+	err = c.copyDir(src, "")
+	return c.size, err
+}
+
+func newCopier(src string, skip func(src string) bool, mode cli.SymlinkMode) (*copier, error) {
+	root, err := filepath.EvalSymlinks(src)
+	if err != nil {
+		return nil, err
+	}
+	root, err = filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	return &copier{root: root, skip: skip, mode: mode, visiting: map[string]bool{}}, nil
+}
+
+func (c *copier) copyDir(srcDir, dstDir string) error {
+	realDir, err := filepath.EvalSymlinks(srcDir)
+	if err != nil {
+		return err
+	}
+	if c.visiting[realDir] {
+		logger.LogDebugWithLevel("Cyclic symlink was not copied: ", logger.DebugLevel, srcDir)
+		return nil
+	}
+	c.visiting[realDir] = true
+	defer delete(c.visiting, realDir)
+
+	if err := c.mkdir(dstDir); err != nil {
+		return err
+	}
+	entries, err := ioutil.ReadDir(srcDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := c.copyEntry(filepath.Join(srcDir, entry.Name()), filepath.Join(dstDir, entry.Name()), entry); err != nil {
 			return err
 		}
-		if isToSkip := skip(path); !isToSkip {
-			return copyByType(src, dst, path, info)
-		}
+	}
+	return nil
+}
+
+func (c *copier) mkdir(dstDir string) error {
+	if c.estimate {
 		return nil
-	})
+	}
+	return os.MkdirAll(dstDir, os.ModePerm)
 }
 
-func copyByType(src, dst, path string, info os.FileInfo) error {
+func (c *copier) copyEntry(srcPath, dstPath string, info os.FileInfo) error {
+	if c.skip(srcPath) {
+		return nil
+	}
 	switch {
-	case info.IsDir():
-		return copyDir(src, dst, path)
 	case info.Mode()&os.ModeSymlink != 0:
-		return copyLink(src, dst, path)
+		return c.copyLink(srcPath, dstPath)
+	case info.IsDir():
+		return c.copyDir(srcPath, dstPath)
+	case info.Mode().IsRegular():
+		return c.copyFile(srcPath, dstPath)
 	default:
-		return copyFile(src, dst, path)
+		return nil
 	}
 }
 
-func copyFile(src, dst, path string) error {
-	file, err := os.Create(replacePathSrcToDst(path, src, dst))
-	if file != nil {
-		defer func() {
-			logger.LogError("Error defer file close", file.Close())
-		}()
+func (c *copier) copyLink(srcPath, dstPath string) error {
+	if c.mode == cli.SymlinkSkip {
+		return nil
 	}
+	target, err := filepath.EvalSymlinks(srcPath)
 	if err != nil {
-		return err
+		logger.LogDebugWithLevel("Broken symlink was not copied: ", logger.DebugLevel, srcPath)
+		return nil
 	}
-	return copyContentSrcFileToDstFile(path, file)
+	if !c.isWithinRoot(target) {
+		logger.LogWarnWithLevel("Symlink pointing outside of the project was not copied: ",
+			logger.WarnLevel, srcPath, target)
+		return nil
+	}
+	if c.mode == cli.SymlinkPreserve {
+		if c.estimate {
+			return nil
+		}
+		return c.preserveLink(srcPath, dstPath)
+	}
+	return c.followLink(target, dstPath)
 }
 
-func replacePathSrcToDst(path, src, dst string) string {
-	return strings.ReplaceAll(path, src, dst)
+func (c *copier) followLink(target, dstPath string) error {
+	info, err := os.Stat(target)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return c.copyDir(target, dstPath)
+	}
+	return c.copyFile(target, dstPath)
 }
 
-func copyContentSrcFileToDstFile(srcPath string, dstFile *os.File) error {
-	srcFile, err := os.Open(srcPath)
-	if srcFile != nil {
-		defer func() {
-			logger.LogError("Error defer file close", srcFile.Close())
-		}()
-	}
+// preserveLink keeps relative links as they are, absolute links are rewritten to be relative
+// so they keep pointing inside of the copy
+func (c *copier) preserveLink(srcPath, dstPath string) error {
+	link, err := os.Readlink(srcPath)
 	if err != nil {
 		return err
 	}
+	if filepath.IsAbs(link) {
+		realSrcDir, err := filepath.EvalSymlinks(filepath.Dir(srcPath))
+		if err != nil {
+			return err
+		}
+		realLink, err := filepath.EvalSymlinks(link)
+		if err != nil {
+			return err
+		}
+		if link, err = filepath.Rel(realSrcDir, realLink); err != nil {
+			return err
+		}
+	}
+	return os.Symlink(link, dstPath)
+}
 
-	_, err = io.Copy(dstFile, srcFile)
-	return err
+func (c *copier) isWithinRoot(target string) bool {
+	absTarget, err := filepath.Abs(target)
+	if err != nil {
+		return false
+	}
+	return absTarget == c.root || strings.HasPrefix(absTarget, c.root+string(os.PathSeparator))
 }
 
-func copyDir(src, dst, path string) error {
-	newPath := replacePathSrcToDst(path, src, dst)
-	return os.MkdirAll(newPath, os.ModePerm)
+func (c *copier) copyFile(srcPath, dstPath string) error {
+	if c.estimate {
+		return c.addSize(srcPath)
+	}
+	if c.hardlink && os.Link(srcPath, dstPath) == nil {
+		return nil
+	}
+	return copyFile(srcPath, dstPath)
 }
 
-func copyLink(src, dst, path string) error {
-	orig, err := filepath.EvalSymlinks(src)
+func (c *copier) addSize(srcPath string) error {
+	info, err := os.Stat(srcPath)
 	if err != nil {
 		return err
 	}
+	c.size += info.Size()
+	return nil
+}
 
-	info, err := os.Lstat(orig)
+func copyFile(srcPath, dstPath string) error {
+	file, err := os.Create(dstPath)
+	if file != nil {
+		defer func() {
+			logger.LogError("Error defer file close", file.Close())
+		}()
+	}
 	if err != nil {
 		return err
 	}
+	return copyContentSrcFileToDstFile(srcPath, file)
+}
 
-	return copyByType(orig, dst, path, info)
+func copyContentSrcFileToDstFile(srcPath string, dstFile *os.File) error {
+	srcFile, err := os.Open(srcPath)
+	if srcFile != nil {
+		defer func() {
+			logger.LogError("Error defer file close", srcFile.Close())
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(dstFile, srcFile)
+	return err
 }
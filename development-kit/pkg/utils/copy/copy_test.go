@@ -16,10 +16,13 @@ package copy
 
 import (
 	"fmt"
-	"github.com/stretchr/testify/assert"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCopy(t *testing.T) {
@@ -39,3 +42,113 @@ func TestCopy(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func newProjectWithSymlinks(t *testing.T) (srcPath, outsidePath string) {
+	basePath, err := ioutil.TempDir("", "copy")
+	assert.NoError(t, err)
+	srcPath = filepath.Join(basePath, "project")
+	outsidePath = filepath.Join(basePath, "outside")
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(srcPath, "src"), os.ModePerm))
+	assert.NoError(t, os.MkdirAll(outsidePath, os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(srcPath, "src", "main.go"), []byte("package main"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(outsidePath, "passwd"), []byte("secret"), 0600))
+
+	assert.NoError(t, os.Symlink(filepath.Join(srcPath, "src", "main.go"), filepath.Join(srcPath, "main.go")))
+	assert.NoError(t, os.Symlink("..", filepath.Join(srcPath, "src", "loop")))
+	assert.NoError(t, os.Symlink(filepath.Join(outsidePath, "passwd"), filepath.Join(srcPath, "passwd")))
+	assert.NoError(t, os.Symlink(outsidePath, filepath.Join(srcPath, "outside")))
+	return srcPath, outsidePath
+}
+
+func TestCopyWithSymlinkMode(t *testing.T) {
+	noSkip := func(src string) bool { return false }
+
+	t.Run("Should follow links within root and refuse links outside of root", func(t *testing.T) {
+		srcPath, outsidePath := newProjectWithSymlinks(t)
+		defer os.RemoveAll(filepath.Dir(srcPath))
+		dstPath := filepath.Join(filepath.Dir(outsidePath), "dst")
+
+		assert.NoError(t, CopyWithSymlinkMode(srcPath, dstPath, noSkip, cli.SymlinkFollowWithinRoot))
+
+		content, err := ioutil.ReadFile(filepath.Join(dstPath, "main.go"))
+		assert.NoError(t, err)
+		assert.Equal(t, "package main", string(content))
+		assert.NoFileExists(t, filepath.Join(dstPath, "passwd"))
+		assert.NoDirExists(t, filepath.Join(dstPath, "outside"))
+		assert.NoDirExists(t, filepath.Join(dstPath, "src", "loop"))
+	})
+
+	t.Run("Should preserve links within root", func(t *testing.T) {
+		srcPath, outsidePath := newProjectWithSymlinks(t)
+		defer os.RemoveAll(filepath.Dir(srcPath))
+		dstPath := filepath.Join(filepath.Dir(outsidePath), "dst")
+
+		assert.NoError(t, CopyWithSymlinkMode(srcPath, dstPath, noSkip, cli.SymlinkPreserve))
+
+		link, err := os.Readlink(filepath.Join(dstPath, "main.go"))
+		assert.NoError(t, err)
+		assert.Equal(t, filepath.Join("src", "main.go"), link)
+		link, err = os.Readlink(filepath.Join(dstPath, "src", "loop"))
+		assert.NoError(t, err)
+		assert.Equal(t, "..", link)
+		assert.NoFileExists(t, filepath.Join(dstPath, "passwd"))
+	})
+
+	t.Run("Should skip all links", func(t *testing.T) {
+		srcPath, outsidePath := newProjectWithSymlinks(t)
+		defer os.RemoveAll(filepath.Dir(srcPath))
+		dstPath := filepath.Join(filepath.Dir(outsidePath), "dst")
+
+		assert.NoError(t, CopyWithSymlinkMode(srcPath, dstPath, noSkip, cli.SymlinkSkip))
+
+		assert.FileExists(t, filepath.Join(dstPath, "src", "main.go"))
+		_, err := os.Lstat(filepath.Join(dstPath, "main.go"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestHardlinkWithSymlinkMode(t *testing.T) {
+	t.Run("Should hardlink files of the project", func(t *testing.T) {
+		srcPath, outsidePath := newProjectWithSymlinks(t)
+		defer os.RemoveAll(filepath.Dir(srcPath))
+		dstPath := filepath.Join(filepath.Dir(outsidePath), "dst")
+
+		assert.NoError(t, HardlinkWithSymlinkMode(srcPath, dstPath,
+			func(src string) bool { return false }, cli.SymlinkFollowWithinRoot))
+
+		srcInfo, err := os.Stat(filepath.Join(srcPath, "src", "main.go"))
+		assert.NoError(t, err)
+		dstInfo, err := os.Stat(filepath.Join(dstPath, "src", "main.go"))
+		assert.NoError(t, err)
+		assert.True(t, os.SameFile(srcInfo, dstInfo))
+		assert.NoFileExists(t, filepath.Join(dstPath, "passwd"))
+	})
+}
+
+func TestEstimateSizeWithSymlinkMode(t *testing.T) {
+	noSkip := func(src string) bool { return false }
+
+	t.Run("Should return the size of the files that would be copied without copying them", func(t *testing.T) {
+		srcPath, _ := newProjectWithSymlinks(t)
+		defer os.RemoveAll(filepath.Dir(srcPath))
+
+		size, err := EstimateSizeWithSymlinkMode(srcPath, noSkip, cli.SymlinkFollowWithinRoot)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len("package main")*2), size)
+
+		size, err = EstimateSizeWithSymlinkMode(srcPath, noSkip, cli.SymlinkSkip)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len("package main")), size)
+	})
+	t.Run("Should not count the files to skip", func(t *testing.T) {
+		srcPath, _ := newProjectWithSymlinks(t)
+		defer os.RemoveAll(filepath.Dir(srcPath))
+
+		size, err := EstimateSizeWithSymlinkMode(srcPath, func(src string) bool {
+			return filepath.Base(src) == "src"
+		}, cli.SymlinkSkip)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), size)
+	})
+}
@@ -0,0 +1,39 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disk
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFreeSpace(t *testing.T) {
+	t.Run("Should return the free space of the volume of the path", func(t *testing.T) {
+		path, err := ioutil.TempDir("", "disk")
+		assert.NoError(t, err)
+		defer os.RemoveAll(path)
+
+		freeSpace, err := GetFreeSpace(path)
+		assert.NoError(t, err)
+		assert.Greater(t, freeSpace, uint64(0))
+	})
+	t.Run("Should return error when the path doesn't exist", func(t *testing.T) {
+		_, err := GetFreeSpace("./not-exists")
+		assert.Error(t, err)
+	})
+}
@@ -46,6 +46,15 @@ func GetEnvOrDefaultInt64(env string, defaultValue int64) int64 {
 	return int64(value)
 }
 
+func GetEnvOrDefaultFloat64(env string, defaultValue float64) float64 {
+	value, err := strconv.ParseFloat(os.Getenv(env), 64)
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
 func GetEnvOrDefaultBool(env string, defaultValue bool) bool {
 	value := os.Getenv(env)
 	if value == "" {
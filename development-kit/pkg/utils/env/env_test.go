@@ -63,6 +63,20 @@ func TestGetEnvOrDefaultInt64(t *testing.T) {
 	})
 }
 
+func TestGetEnvOrDefaultFloat64(t *testing.T) {
+	_ = os.Setenv("TEST_ENV_VAR", "4.5")
+
+	t.Run("should return the value of the env variable", func(t *testing.T) {
+		response := GetEnvOrDefaultFloat64("TEST_ENV_VAR", 1.5)
+		assert.Equal(t, 4.5, response)
+	})
+
+	t.Run("should return default value", func(t *testing.T) {
+		response := GetEnvOrDefaultFloat64("TEST_DEFAULT_VALUE", 1.5)
+		assert.Equal(t, 1.5, response)
+	})
+}
+
 func TestGetEnvOrDefaultAndParseToBool(t *testing.T) {
 	t.Run("should return the value of the env variable with value true", func(t *testing.T) {
 		_ = os.Setenv("TEST_ENV_VAR", "true")
@@ -16,8 +16,14 @@ package json
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
 )
 
+var ErrInvalidAfterTopLevelValue = errors.New("invalid content after top-level json value")
+
 func ConvertInterfaceToOutput(input, output interface{}) error {
 	bytes, err := json.Marshal(input)
 	if err != nil {
@@ -27,8 +33,78 @@ func ConvertInterfaceToOutput(input, output interface{}) error {
 	return json.Unmarshal(bytes, &output)
 }
 
+// ConvertStringToOutput decodes the input without copying it to a byte slice, what doubles the memory used
+// by huge outputs
 func ConvertStringToOutput(input string, output interface{}) error {
-	return json.Unmarshal([]byte(input), &output)
+	decoder := json.NewDecoder(strings.NewReader(input))
+	if err := decoder.Decode(&output); err != nil {
+		return err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return ErrInvalidAfterTopLevelValue
+	}
+	return nil
+}
+
+// DecodeArray streams the items of a json array, calling decodeItem for each one of them, so the whole array
+// is never loaded in memory. When key is empty the array must be the top-level value, otherwise it is the
+// value of the key in the top-level object. Null and missing arrays have no items
+func DecodeArray(reader io.Reader, key string, decodeItem func(decoder *json.Decoder) error) error {
+	decoder := json.NewDecoder(reader)
+	if key != "" {
+		found, err := seekKey(decoder, key)
+		if err != nil || !found {
+			return err
+		}
+	}
+	if isNull, err := expectArrayStart(decoder); err != nil || isNull {
+		return err
+	}
+	for decoder.More() {
+		if err := decodeItem(decoder); err != nil {
+			return err
+		}
+	}
+	_, err := decoder.Token()
+	return err
+}
+
+func seekKey(decoder *json.Decoder, key string) (bool, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return false, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return false, fmt.Errorf("expected json object but found %v", token)
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return false, err
+		}
+		if token == key {
+			return true, nil
+		}
+		var skip json.RawMessage
+		if err := decoder.Decode(&skip); err != nil {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+func expectArrayStart(decoder *json.Decoder) (isNull bool, err error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return false, err
+	}
+	if token == nil {
+		return true, nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return false, fmt.Errorf("expected json array but found %v", token)
+	}
+	return false, nil
 }
 
 func ConvertInterfaceToString(input interface{}) (string, error) {
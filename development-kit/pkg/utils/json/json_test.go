@@ -15,6 +15,8 @@
 package json
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
 	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
@@ -51,4 +53,55 @@ func TestConvertStringToOutput(t *testing.T) {
 		err := ConvertStringToOutput(analysis.ToString(), &analysis)
 		assert.NoError(t, err)
 	})
+
+	t.Run("should return error when there is content after the json value", func(t *testing.T) {
+		output := map[string]interface{}{}
+
+		err := ConvertStringToOutput(`{"test": 1} chmod: permission denied`, &output)
+		assert.Equal(t, ErrInvalidAfterTopLevelValue, err)
+	})
+}
+
+func TestDecodeArray(t *testing.T) {
+	decodeNames := func(input, key string) (names []string, err error) {
+		err = DecodeArray(strings.NewReader(input), key, func(decoder *json.Decoder) error {
+			item := struct {
+				Name string `json:"name"`
+			}{}
+			if err := decoder.Decode(&item); err != nil {
+				return err
+			}
+			names = append(names, item.Name)
+			return nil
+		})
+		return names, err
+	}
+
+	t.Run("should stream items of top-level array", func(t *testing.T) {
+		names, err := decodeNames(`[{"name": "a"}, {"name": "b"}]`, "")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, names)
+	})
+
+	t.Run("should stream items of array in key skipping other values", func(t *testing.T) {
+		names, err := decodeNames(`{"errors": [{"name": "x"}], "version": {"v": 1}, "results": [{"name": "a"}]}`,
+			"results")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a"}, names)
+	})
+
+	t.Run("should return no items when array is null or key is missing", func(t *testing.T) {
+		names, err := decodeNames(`{"results": null}`, "results")
+		assert.NoError(t, err)
+		assert.Empty(t, names)
+
+		names, err = decodeNames(`{"errors": []}`, "results")
+		assert.NoError(t, err)
+		assert.Empty(t, names)
+	})
+
+	t.Run("should return error when value is not an array", func(t *testing.T) {
+		_, err := decodeNames(`{"name": "a"}`, "")
+		assert.Error(t, err)
+	})
 }
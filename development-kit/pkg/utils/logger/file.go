@@ -0,0 +1,206 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus" // nolint
+)
+
+const rotatedFileTimeFormat = "20060102150405.000"
+
+var logFile *rotatingFile
+
+// SetLogFile writes all logs until the debug level in the file of the path, independent of the log level used in the
+// console. The file is rotated when exceeds the max size and the rotated files older than the max age are removed.
+// The returned function closes the file and restores the logs only in the console
+func SetLogFile(path string, maxSizeInMB, maxAgeInDays int64) (func(), error) {
+	file, err := newRotatingFile(path, maxSizeInMB*1024*1024, time.Duration(maxAgeInDays)*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	logFile = file
+	logrus.AddHook(&fileHook{file: file, formatter: &logrus.TextFormatter{DisableColors: true, FullTimestamp: true}})
+	setFormatter(logrus.StandardLogger().Formatter)
+	logrus.SetLevel(getEnabledLevel())
+	return closeLogFile, nil
+}
+
+func closeLogFile() {
+	logrus.StandardLogger().ReplaceHooks(make(logrus.LevelHooks))
+	_ = logFile.Close()
+	logFile = nil
+	setFormatter(logrus.StandardLogger().Formatter)
+	logrus.SetLevel(getEnabledLevel())
+}
+
+// getEnabledLevel returns the most verbose level between the level of the console, the levels of the modules and
+// the debug level when the logs are also written in the file
+func getEnabledLevel() logrus.Level {
+	enabledLevel := CurrentLevel
+	if logFile != nil && enabledLevel < DebugLevel {
+		enabledLevel = DebugLevel
+	}
+
+	for _, level := range moduleLevels {
+		if level > enabledLevel {
+			enabledLevel = level
+		}
+	}
+
+	return enabledLevel
+}
+
+// setFormatter filters the logs of the console by the levels of the modules and by the log level when the logs are
+// also written in the file, because in these cases the logs more verbose than the log level are enabled
+func setFormatter(formatter logrus.Formatter) {
+	if console, ok := formatter.(*consoleFormatter); ok {
+		formatter = console.formatter
+	}
+
+	if logFile != nil || len(moduleLevels) > 0 {
+		formatter = &consoleFormatter{formatter: formatter}
+	}
+
+	logrus.SetFormatter(formatter)
+}
+
+// consoleFormatter formats only the logs enabled in the level of the console, the other logs are written only
+// in the log file
+type consoleFormatter struct {
+	formatter logrus.Formatter
+}
+
+func (c *consoleFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	if entry.Level > getConsoleLevel() {
+		return nil, nil
+	}
+
+	return c.formatter.Format(entry)
+}
+
+type fileHook struct {
+	file      *rotatingFile
+	formatter logrus.Formatter
+}
+
+func (f *fileHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (f *fileHook) Fire(entry *logrus.Entry) error {
+	content, err := f.formatter.Format(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.file.Write(content)
+	return err
+}
+
+// rotatingFile renames the file with the time of the rotation as suffix when the next write exceeds the max size
+type rotatingFile struct {
+	mutex   sync.Mutex
+	path    string
+	maxSize int64
+	maxAge  time.Duration
+	file    *os.File
+	size    int64
+}
+
+func newRotatingFile(path string, maxSize int64, maxAge time.Duration) (*rotatingFile, error) {
+	file := &rotatingFile{path: path, maxSize: maxSize, maxAge: maxAge}
+	if err := file.open(); err != nil {
+		return nil, err
+	}
+
+	file.removeExpired()
+	return file, nil
+}
+
+func (r *rotatingFile) open() error {
+	file, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	r.file = file
+	r.size = info.Size()
+	return nil
+}
+
+func (r *rotatingFile) Write(content []byte) (int, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.size > 0 && r.size+int64(len(content)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	written, err := r.file.Write(content)
+	r.size += int64(written)
+	return written, err
+}
+
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+
+	rotatedPath := fmt.Sprintf("%s.%s", r.path, time.Now().Format(rotatedFileTimeFormat))
+	if err := os.Rename(r.path, rotatedPath); err != nil {
+		return err
+	}
+
+	r.removeExpired()
+	return r.open()
+}
+
+// removeExpired removes the rotated files modified before the max age
+func (r *rotatingFile) removeExpired() {
+	rotatedPaths, _ := filepath.Glob(r.path + ".*")
+	for _, rotatedPath := range rotatedPaths {
+		if _, err := time.Parse(rotatedFileTimeFormat, strings.TrimPrefix(rotatedPath, r.path+".")); err != nil {
+			continue
+		}
+
+		info, err := os.Stat(rotatedPath)
+		if err == nil && time.Since(info.ModTime()) > r.maxAge {
+			_ = os.Remove(rotatedPath)
+		}
+	}
+}
+
+func (r *rotatingFile) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.file.Close()
+}
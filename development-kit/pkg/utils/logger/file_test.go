@@ -0,0 +1,101 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLogFile(t *testing.T) {
+	t.Run("should write the debug logs only in the file when the console level is info", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "horusec-log")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		console := bytes.NewBufferString("")
+		SetOutput(console, false)
+		defer SetOutput(os.Stderr, false)
+		SetLogLevel(InfoLevel.String())
+
+		closeFile, err := SetLogFile(filepath.Join(dir, "horusec.log"), 10, 7)
+		assert.NoError(t, err)
+		LogDebugWithLevel("debug message", DebugLevel)
+		LogInfo("info message")
+		closeFile()
+
+		content, err := ioutil.ReadFile(filepath.Join(dir, "horusec.log"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "debug message")
+		assert.Contains(t, string(content), "info message")
+		assert.NotContains(t, console.String(), "debug message")
+		assert.Contains(t, console.String(), "info message")
+		assert.Equal(t, InfoLevel, logrus.GetLevel())
+	})
+
+	t.Run("should return error when the directory of the file not exists", func(t *testing.T) {
+		_, err := SetLogFile("/not/exists/horusec.log", 10, 7)
+		assert.Error(t, err)
+	})
+}
+
+func TestRotatingFile(t *testing.T) {
+	t.Run("should rotate the file when exceeds the max size", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "horusec-log")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		file, err := newRotatingFile(filepath.Join(dir, "horusec.log"), 10, time.Hour)
+		assert.NoError(t, err)
+		_, err = file.Write([]byte("first log\n"))
+		assert.NoError(t, err)
+		_, err = file.Write([]byte("second log\n"))
+		assert.NoError(t, err)
+		assert.NoError(t, file.Close())
+
+		content, err := ioutil.ReadFile(filepath.Join(dir, "horusec.log"))
+		assert.NoError(t, err)
+		assert.Equal(t, "second log\n", string(content))
+		rotatedPaths, _ := filepath.Glob(filepath.Join(dir, "horusec.log.*"))
+		assert.Len(t, rotatedPaths, 1)
+	})
+
+	t.Run("should remove only the rotated files older than the max age", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "horusec-log")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		expired := filepath.Join(dir, "horusec.log.20200101000000.000")
+		other := filepath.Join(dir, "horusec.log.bak")
+		for _, path := range []string{expired, other} {
+			assert.NoError(t, ioutil.WriteFile(path, []byte("log"), 0600))
+			assert.NoError(t, os.Chtimes(path, time.Now().Add(-48*time.Hour), time.Now().Add(-48*time.Hour)))
+		}
+
+		file, err := newRotatingFile(filepath.Join(dir, "horusec.log"), 10, 24*time.Hour)
+		assert.NoError(t, err)
+		assert.NoError(t, file.Close())
+
+		assert.NoFileExists(t, expired)
+		assert.FileExists(t, other)
+	})
+}
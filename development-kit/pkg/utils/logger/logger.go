@@ -17,6 +17,7 @@ package logger
 import (
 	"fmt"
 	"github.com/sirupsen/logrus" // nolint
+	"io"
 	"log"
 )
 
@@ -86,8 +87,20 @@ func SetLogLevel(level string) {
 		logrus.Error(msg)
 		logLevel = InfoLevel
 	}
-	logrus.SetLevel(logLevel)
 	CurrentLevel = logLevel
+	logrus.SetLevel(getEnabledLevel())
+}
+
+func IsValidLevel(level string) bool {
+	_, err := logrus.ParseLevel(level)
+	return err == nil
+}
+
+// SetOutput changes where the logs are written. When the output is a writer in front of the terminal,
+// isTerminal keeps the same format used when the logs are written directly in the terminal
+func SetOutput(output io.Writer, isTerminal bool) {
+	logrus.SetOutput(output)
+	setFormatter(&logrus.TextFormatter{ForceColors: isTerminal})
 }
 
 func LogPanicWithLevel(msg string, err error, level logrus.Level, args ...map[string]interface{}) {
@@ -15,7 +15,9 @@
 package logger
 
 import (
+	"bytes"
 	"errors"
+	"os"
 	"testing"
 
 	EnumErrors "github.com/ZupIT/horusec/development-kit/pkg/enums/errors"
@@ -142,3 +144,15 @@ func TestLogStringAsError(t *testing.T) {
 		assert.NotPanics(t, func() { LogStringAsError("test") })
 	})
 }
+
+func TestSetOutput(t *testing.T) {
+	t.Run("should write logs in the output", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		SetOutput(output, false)
+		defer SetOutput(os.Stderr, false)
+
+		LogInfoWithLevel("test info", InfoLevel)
+
+		assert.Contains(t, output.String(), "test info")
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"github.com/sirupsen/logrus" // nolint
+)
+
+const maxCallerDepth = 25
+
+var (
+	moduleLevels  = map[string]logrus.Level{}
+	loggerPackage = getLoggerPackage()
+)
+
+// SetModuleLevels changes the log level of the modules, like {"docker": "debug", "engine": "warn"}. The module of a
+// log is found by the directories of the package that wrote it, so "docker" is the level of the logs of any package
+// with docker in its path. The logs of the other modules keep using the log level
+func SetModuleLevels(levels map[string]string) {
+	moduleLevels = map[string]logrus.Level{}
+	for module, level := range levels {
+		logLevel, err := logrus.ParseLevel(level)
+		if err != nil {
+			logrus.Error(fmt.Sprintf("Log level of type %s of the module %s is wrong. Ignoring it", level, module))
+			continue
+		}
+
+		moduleLevels[strings.ToLower(module)] = logLevel
+	}
+
+	setFormatter(logrus.StandardLogger().Formatter)
+	logrus.SetLevel(getEnabledLevel())
+}
+
+// getConsoleLevel returns the level of the module of the package that wrote the log, or the log level
+func getConsoleLevel() logrus.Level {
+	if len(moduleLevels) == 0 {
+		return CurrentLevel
+	}
+
+	packagePath := getCallerPackage()
+	for module, level := range moduleLevels {
+		if isPackageOfModule(packagePath, module) {
+			return level
+		}
+	}
+
+	return CurrentLevel
+}
+
+func isPackageOfModule(packagePath, module string) bool {
+	for _, directory := range strings.Split(strings.ToLower(packagePath), "/") {
+		if strings.Contains(directory, module) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getCallerPackage returns the package of the first function of the stack outside of logrus and of this package
+func getCallerPackage() string {
+	pcs := make([]uintptr, maxCallerDepth)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(1, pcs)])
+	for {
+		frame, more := frames.Next()
+		packagePath := getPackageOfFunction(frame.Function)
+		if !strings.HasPrefix(packagePath, "github.com/sirupsen/logrus") && packagePath != loggerPackage {
+			return packagePath
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+// getPackageOfFunction removes the function name of the full name, like github.com/user/repo/pkg.(*Type).Method
+func getPackageOfFunction(function string) string {
+	lastSlash := strings.LastIndex(function, "/")
+	if dot := strings.Index(function[lastSlash+1:], "."); dot >= 0 {
+		return function[:lastSlash+1+dot]
+	}
+
+	return function
+}
+
+func getLoggerPackage() string {
+	pc, _, _, _ := runtime.Caller(0)
+	return getPackageOfFunction(runtime.FuncForPC(pc).Name())
+}
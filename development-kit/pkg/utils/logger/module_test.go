@@ -0,0 +1,84 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logger
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetModuleLevels(t *testing.T) {
+	console := bytes.NewBufferString("")
+	SetOutput(console, false)
+	defer SetOutput(os.Stderr, false)
+	SetLogLevel(InfoLevel.String())
+	defer SetModuleLevels(map[string]string{})
+
+	t.Run("should write the debug logs of the module with debug level", func(t *testing.T) {
+		console.Reset()
+		// the logs of the tests are written by the functions of the package testing
+		SetModuleLevels(map[string]string{"testing": "debug"})
+		LogDebugWithLevel("debug message", DebugLevel)
+
+		assert.Contains(t, console.String(), "debug message")
+		assert.Equal(t, DebugLevel, logrus.GetLevel())
+	})
+
+	t.Run("should not write the info logs of the module with warn level", func(t *testing.T) {
+		console.Reset()
+		SetModuleLevels(map[string]string{"testing": "warn"})
+		LogInfo("info message")
+		LogWarnWithLevel("warn message", WarnLevel)
+
+		assert.NotContains(t, console.String(), "info message")
+		assert.Contains(t, console.String(), "warn message")
+	})
+
+	t.Run("should keep the log level for the other modules and ignore wrong levels", func(t *testing.T) {
+		console.Reset()
+		SetModuleLevels(map[string]string{"docker": "debug", "engine": "wrong"})
+		LogDebugWithLevel("debug message", DebugLevel)
+		LogInfo("info message")
+
+		assert.NotContains(t, console.String(), "debug message")
+		assert.Contains(t, console.String(), "info message")
+		assert.NotContains(t, moduleLevels, "engine")
+	})
+}
+
+func TestIsPackageOfModule(t *testing.T) {
+	t.Run("should return true when a directory of the package contains the module", func(t *testing.T) {
+		assert.True(t, isPackageOfModule("github.com/ZupIT/horusec/horusec-cli/internal/services/docker", "docker"))
+		assert.True(t, isPackageOfModule("github.com/ZupIT/horusec/development-kit/pkg/engines/java", "engine"))
+		assert.True(t, isPackageOfModule("github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/go/gosec",
+			"formatters"))
+	})
+
+	t.Run("should return false when no directory of the package contains the module", func(t *testing.T) {
+		assert.False(t, isPackageOfModule("github.com/ZupIT/horusec/horusec-cli/internal/services/git", "docker"))
+	})
+}
+
+func TestGetPackageOfFunction(t *testing.T) {
+	t.Run("should remove the function and the type of the full name", func(t *testing.T) {
+		assert.Equal(t, "github.com/ZupIT/horusec/horusec-cli/internal/services/docker",
+			getPackageOfFunction("github.com/ZupIT/horusec/horusec-cli/internal/services/docker.(*API).PullImage"))
+		assert.Equal(t, "testing", getPackageOfFunction("testing.tRunner"))
+	})
+}
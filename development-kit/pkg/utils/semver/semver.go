@@ -0,0 +1,93 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Compare returns -1, 0 or 1 comparing the numeric parts of the versions, so 1.10.0 is greater than 1.9.2. The prefix
+// v is ignored and a version with pre-release, like 1.0.0-beta, is lower than the same version without it
+func Compare(first, second string) int {
+	firstParts, firstPreRelease := split(first)
+	secondParts, secondPreRelease := split(second)
+	for index := 0; index < len(firstParts) || index < len(secondParts); index++ {
+		if result := compareParts(getPart(firstParts, index), getPart(secondParts, index)); result != 0 {
+			return result
+		}
+	}
+	return comparePreReleases(firstPreRelease, secondPreRelease)
+}
+
+// MinGreaterThan returns the lowest of the versions greater than the version informed, or empty when there is none
+func MinGreaterThan(versions []string, version string) (minVersion string) {
+	for _, candidate := range versions {
+		if Compare(candidate, version) <= 0 {
+			continue
+		}
+		if minVersion == "" || Compare(candidate, minVersion) < 0 {
+			minVersion = candidate
+		}
+	}
+	return minVersion
+}
+
+func split(version string) (parts []string, preRelease string) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if index := strings.Index(version, "+"); index >= 0 {
+		version = version[:index]
+	}
+	if index := strings.Index(version, "-"); index >= 0 {
+		version, preRelease = version[:index], version[index+1:]
+	}
+	return strings.Split(version, "."), preRelease
+}
+
+func getPart(parts []string, index int) string {
+	if index < len(parts) {
+		return parts[index]
+	}
+	return "0"
+}
+
+func compareParts(first, second string) int {
+	firstNumber, firstErr := strconv.Atoi(first)
+	secondNumber, secondErr := strconv.Atoi(second)
+	if firstErr != nil || secondErr != nil {
+		return strings.Compare(first, second)
+	}
+	switch {
+	case firstNumber < secondNumber:
+		return -1
+	case firstNumber > secondNumber:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func comparePreReleases(first, second string) int {
+	switch {
+	case first == second:
+		return 0
+	case first == "":
+		return 1
+	case second == "":
+		return -1
+	default:
+		return strings.Compare(first, second)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	t.Run("Should compare the numeric parts of the versions", func(t *testing.T) {
+		assert.Equal(t, 1, Compare("1.10.0", "1.9.2"))
+		assert.Equal(t, -1, Compare("v0.0.9", "0.1"))
+		assert.Equal(t, 0, Compare("v1.2", "1.2.0"))
+	})
+	t.Run("Should compare the pre-releases after the numeric parts", func(t *testing.T) {
+		assert.Equal(t, -1, Compare("1.0.0-beta", "1.0.0"))
+		assert.Equal(t, 1, Compare("1.0.0-rc.1", "1.0.0-beta"))
+		assert.Equal(t, 0, Compare("1.0.0+build", "1.0.0"))
+	})
+}
+
+func TestMinGreaterThan(t *testing.T) {
+	t.Run("Should return the lowest version greater than the version informed", func(t *testing.T) {
+		assert.Equal(t, "4.17.19", MinGreaterThan([]string{"5.0.0", "4.17.19", "3.0.0"}, "4.17.15"))
+	})
+	t.Run("Should return empty when there is no version greater", func(t *testing.T) {
+		assert.Empty(t, MinGreaterThan([]string{"1.0.0"}, "1.0.0"))
+	})
+}
@@ -0,0 +1,139 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sigv4
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// SignRequest adds the AWS signature version 4 headers to the request. The payload must be the same body sent
+// in the request, the services used by horusec always need it signed
+func SignRequest(req *http.Request, payload []byte, credentials *Credentials, region, service string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := hashHex(payload)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if credentials.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", credentials.SessionToken)
+	}
+
+	canonicalHeaders, signedHeaders := getCanonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method, escapePath(req.URL.Path), getCanonicalQuery(req),
+		canonicalHeaders, signedHeaders, payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope,
+		hashHex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+credentials.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, region)
+	signingKey = hmacSHA256(signingKey, service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		credentials.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func getCanonicalHeaders(req *http.Request) (canonicalHeaders, signedHeaders string) {
+	headers := map[string]string{"host": req.URL.Host}
+	for key, values := range req.Header {
+		lowerKey := strings.ToLower(key)
+		if lowerKey == "content-type" || strings.HasPrefix(lowerKey, "x-amz-") {
+			headers[lowerKey] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		canonicalHeaders += key + ":" + headers[key] + "\n"
+	}
+	return canonicalHeaders, strings.Join(keys, ";")
+}
+
+func getCanonicalQuery(req *http.Request) string {
+	query := req.URL.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var params []string
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, escape(key, true)+"="+escape(value, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+func escapePath(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return escape(path, false)
+}
+
+// escape encodes everything except the unreserved characters of the RFC 3986, as required by AWS
+func escape(value string, encodeSlash bool) string {
+	var builder strings.Builder
+	for _, char := range []byte(value) {
+		if isUnreserved(char) || (char == '/' && !encodeSlash) {
+			builder.WriteByte(char)
+		} else {
+			builder.WriteString(fmt.Sprintf("%%%02X", char))
+		}
+	}
+	return builder.String()
+}
+
+func isUnreserved(char byte) bool {
+	return (char >= 'A' && char <= 'Z') || (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') ||
+		char == '-' || char == '_' || char == '.' || char == '~'
+}
+
+func hashHex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,118 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bench
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/completion"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	benchService "github.com/ZupIT/horusec/horusec-cli/internal/services/bench"
+	"github.com/spf13/cobra"
+)
+
+const defaultRuns = 3
+
+var ErrInvalidRuns = errors.New("{HORUSEC_CLI} the runs of the bench must be greater than zero")
+
+type IBench interface {
+	SetGlobalCmd(globalCmd *cobra.Command)
+	CreateCobraCmd() *cobra.Command
+}
+
+type Bench struct {
+	configs   config.IConfig
+	globalCmd *cobra.Command
+	runner    benchService.Runner
+}
+
+func NewBenchCommand(configs config.IConfig) IBench {
+	return &Bench{
+		configs:   configs,
+		globalCmd: &cobra.Command{},
+	}
+}
+
+func (b *Bench) SetGlobalCmd(globalCmd *cobra.Command) {
+	b.globalCmd = globalCmd
+}
+
+func (b *Bench) CreateCobraCmd() *cobra.Command {
+	benchCmd := &cobra.Command{
+		Use:   "bench [flags] [-- flags of the start]",
+		Short: "Run the analysis many times and print the time of each stage",
+		Long: "Run the analysis of the project many times, without the cache, and print a table with the min, average " +
+			"and max time of the whole analysis, of the copy of the project, of the horusec engines and of each tool, " +
+			"and the max memory of the cli in each run. The stage runs only the copy, only the engines or only a tool, " +
+			"ignoring the other tools. The args after -- are sent to the command start, so the impact of its settings, " +
+			"like --max-parallel and --engine-workers, can be compared",
+		Example: "horusec bench -p=\"./\" --runs=5 --stage=engine -- --engine-workers=4",
+		RunE:    b.runE,
+	}
+	_ = benchCmd.PersistentFlags().StringP("project-path", "p", ".", "Path of the project analyzed in the runs")
+	_ = benchCmd.PersistentFlags().IntP("runs", "n", defaultRuns, "Number of runs of the analysis")
+	_ = benchCmd.PersistentFlags().String("stage", benchService.StageAll,
+		"Stage of the analysis to benchmark: all, copy, engine or the name of a tool, like GoSec")
+	_ = benchCmd.RegisterFlagCompletionFunc("stage", completion.CompleteValues(benchService.GetStages()...))
+	return benchCmd
+}
+
+func (b *Bench) runE(cmd *cobra.Command, args []string) error {
+	b.configs = b.configs.NewConfigsFromCobraAndLoadsCmdGlobalFlags(b.globalCmd)
+	projectPath, _ := cmd.PersistentFlags().GetString("project-path")
+	runs, _ := cmd.PersistentFlags().GetInt("runs")
+	stage, _ := cmd.PersistentFlags().GetString("stage")
+	if runs <= 0 {
+		return ErrInvalidRuns
+	}
+	stageArgs, err := benchService.GetStageArgs(stage)
+	if err != nil {
+		return err
+	}
+	if err := b.setRunner(); err != nil {
+		return err
+	}
+	results, err := b.run(projectPath, runs, append(args, stageArgs...))
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorBenchRun, err, logger.ErrorLevel)
+		return err
+	}
+	return benchService.Print(cmd.OutOrStdout(), results)
+}
+
+func (b *Bench) setRunner() (err error) {
+	if b.runner == nil {
+		b.runner, err = benchService.NewRunner(b.configs.GetLogLevel())
+	}
+	return err
+}
+
+func (b *Bench) run(projectPath string, runs int, args []string) ([]*benchService.Result, error) {
+	var results []*benchService.Result
+	for run := 1; run <= runs; run++ {
+		message := strings.ReplaceAll(messages.MsgInfoBenchRun, "{{0}}", strconv.Itoa(run))
+		logger.LogInfoWithLevel(strings.ReplaceAll(message, "{{1}}", strconv.Itoa(runs)), logger.InfoLevel)
+		result, err := b.runner.Run(projectPath, args)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
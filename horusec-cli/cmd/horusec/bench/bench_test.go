@@ -0,0 +1,89 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bench
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	benchService "github.com/ZupIT/horusec/horusec-cli/internal/services/bench"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+type runnerToTest struct {
+	args []string
+	runs int
+	err  error
+}
+
+func (r *runnerToTest) Run(_ string, args []string) (*benchService.Result, error) {
+	r.args = args
+	r.runs++
+	return &benchService.Result{Duration: time.Second, Stages: map[string]time.Duration{"total": time.Second}}, r.err
+}
+
+func newGlobalCmd() *cobra.Command {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "", "")
+	_ = globalCmd.PersistentFlags().String("config-file-path", "", "")
+	return globalCmd
+}
+
+func TestNewBenchCommand(t *testing.T) {
+	t.Run("Should run NewBenchCommand and return type correctly", func(t *testing.T) {
+		assert.IsType(t, &Bench{}, NewBenchCommand(&config.Config{}))
+	})
+}
+
+func TestBench_Execute(t *testing.T) {
+	t.Run("Should run the analysis of each run and print the stages", func(t *testing.T) {
+		runner := &runnerToTest{}
+		bench := &Bench{configs: config.NewConfig(), globalCmd: newGlobalCmd(), runner: runner}
+		cmd := bench.CreateCobraCmd()
+		output := &bytes.Buffer{}
+		cmd.SetOut(output)
+		cmd.SetArgs([]string{"--runs", "2", "--stage", "GoSec", "--", "--max-parallel=2"})
+
+		assert.NoError(t, cmd.Execute())
+		assert.Equal(t, 2, runner.runs)
+		assert.Equal(t, "--max-parallel=2", runner.args[0])
+		assert.Equal(t, "--tools-ignore", runner.args[1])
+		assert.Contains(t, output.String(), "total")
+	})
+
+	t.Run("Should return error when the runs or the stage are invalid", func(t *testing.T) {
+		bench := &Bench{configs: config.NewConfig(), globalCmd: newGlobalCmd(), runner: &runnerToTest{}}
+		cmd := bench.CreateCobraCmd()
+		cmd.SetArgs([]string{"--runs", "0"})
+		assert.Equal(t, ErrInvalidRuns, cmd.Execute())
+
+		cmd = bench.CreateCobraCmd()
+		cmd.SetArgs([]string{"--stage", "build"})
+		assert.Equal(t, benchService.ErrInvalidStage, cmd.Execute())
+	})
+
+	t.Run("Should return error when a run fails", func(t *testing.T) {
+		runner := &runnerToTest{err: errors.New("test")}
+		bench := &Bench{configs: config.NewConfig(), globalCmd: newGlobalCmd(), runner: runner}
+		cmd := bench.CreateCobraCmd()
+
+		assert.Error(t, cmd.Execute())
+		assert.Equal(t, 1, runner.runs)
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clean
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/workdirs"
+	"github.com/spf13/cobra"
+)
+
+// defaultOlderThan keeps the folders of the analyses running in other machines, that are not in the state file
+const defaultOlderThan = 24 * time.Hour
+
+type IClean interface {
+	SetGlobalCmd(globalCmd *cobra.Command)
+	CreateCobraCmd() *cobra.Command
+}
+
+type Clean struct {
+	configs   config.IConfig
+	globalCmd *cobra.Command
+	workDirs  workdirs.Interface
+}
+
+func NewCleanCommand(configs config.IConfig) IClean {
+	return &Clean{
+		configs:   configs,
+		globalCmd: &cobra.Command{},
+	}
+}
+
+func (c *Clean) SetGlobalCmd(globalCmd *cobra.Command) {
+	c.globalCmd = globalCmd
+}
+
+func (c *Clean) CreateCobraCmd() *cobra.Command {
+	cleanCmd := &cobra.Command{
+		Use:   "clean",
+		Short: "Remove the analysis folders left inside of .horusec by analyses that crashed",
+		Long: "Remove the analysis folders inside of all .horusec folders of the project path older than the age " +
+			"informed, tracked or not. The folders tracked by analyses still running are kept, the folders of " +
+			"analyses running in other machines are excluded only by the flag older-than",
+		Example: "horusec clean -p=\"/home/user/projects\" --older-than=\"24h\"",
+		Args:    cobra.NoArgs,
+		RunE:    c.runE,
+	}
+	_ = cleanCmd.PersistentFlags().
+		StringP("project-path", "p", c.configs.GetProjectPath(), "Path of the project or of the folder of the projects")
+	_ = cleanCmd.PersistentFlags().
+		Duration("older-than", defaultOlderThan, "Age of the analysis folders removed, 0 removes all of them")
+	_ = cleanCmd.PersistentFlags().
+		String("work-dirs-state-file", c.configs.GetWorkDirsStateFile(), "File where the analysis folders are tracked")
+	return cleanCmd
+}
+
+func (c *Clean) runE(cmd *cobra.Command, _ []string) error {
+	c.setConfig(cmd)
+	if c.workDirs == nil {
+		c.workDirs = workdirs.NewWorkDirs(c.configs)
+	}
+	olderThan, _ := cmd.PersistentFlags().GetDuration("older-than")
+	removed, err := c.workDirs.Clean(c.configs.GetProjectPath(), olderThan)
+	msg := strings.ReplaceAll(messages.MsgInfoWorkDirsCleaned, "{{0}}", strconv.Itoa(len(removed)))
+	logger.LogInfoWithLevel(msg+c.configs.GetProjectPath(), logger.InfoLevel)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorClean, err, logger.ErrorLevel)
+	}
+	return err
+}
+
+func (c *Clean) setConfig(cmd *cobra.Command) {
+	c.configs = c.configs.NewConfigsFromCobraAndLoadsCmdGlobalFlags(c.globalCmd)
+	c.configs = c.configs.NewConfigsFromViper()
+	c.configs = c.configs.NewConfigsFromEnvironments()
+	if cmd.PersistentFlags().Changed("project-path") {
+		projectPath, _ := cmd.PersistentFlags().GetString("project-path")
+		c.configs.SetProjectPath(projectPath)
+	}
+	if cmd.PersistentFlags().Changed("work-dirs-state-file") {
+		workDirsStateFile, _ := cmd.PersistentFlags().GetString("work-dirs-state-file")
+		c.configs.SetWorkDirsStateFile(workDirsStateFile)
+	}
+	c.configs.NormalizeConfigs()
+}
@@ -0,0 +1,74 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clean
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/workdirs"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGlobalCmd(configFilePath string) *cobra.Command {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "", "")
+	_ = globalCmd.PersistentFlags().String("config-file-path", configFilePath, "")
+	return globalCmd
+}
+
+func TestNewCleanCommand(t *testing.T) {
+	t.Run("Should run NewCleanCommand and return type correctly", func(t *testing.T) {
+		assert.IsType(t, &Clean{}, NewCleanCommand(&config.Config{}))
+	})
+}
+
+func TestClean_Execute(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clean")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFilePath := filepath.Join(dir, "horusec-config.json")
+	stateFile := filepath.Join(dir, "work-dirs.json")
+
+	t.Run("Should clean the analysis folders of the project path older than the age", func(t *testing.T) {
+		workDirsMock := &workdirs.Mock{}
+		workDirsMock.On("Clean", dir, 2*time.Hour).Return([]string{filepath.Join(dir, ".horusec", "id")}, nil)
+
+		clean := &Clean{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), workDirs: workDirsMock}
+		cmd := clean.CreateCobraCmd()
+		cmd.SetArgs([]string{"-p", dir, "--older-than", "2h", "--work-dirs-state-file", stateFile})
+
+		assert.NoError(t, cmd.Execute())
+		workDirsMock.AssertCalled(t, "Clean", dir, 2*time.Hour)
+		assert.Equal(t, stateFile, clean.configs.GetWorkDirsStateFile())
+	})
+
+	t.Run("Should return error when clean fails", func(t *testing.T) {
+		workDirsMock := &workdirs.Mock{}
+		workDirsMock.On("Clean", dir, 24*time.Hour).Return([]string{}, errors.New("test"))
+
+		clean := &Clean{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), workDirs: workDirsMock}
+		cmd := clean.CreateCobraCmd()
+		cmd.SetArgs([]string{"-p", dir})
+
+		assert.Error(t, cmd.Execute())
+	})
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package completion
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	Bash       = "bash"
+	Zsh        = "zsh"
+	Fish       = "fish"
+	PowerShell = "powershell"
+)
+
+type ICompletion interface {
+	CreateCobraCmd() *cobra.Command
+}
+
+type Completion struct {
+}
+
+func NewCompletionCommand() ICompletion {
+	return &Completion{}
+}
+
+func (c *Completion) CreateCobraCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "completion [bash|zsh|fish|powershell]",
+		Short: "Generate the autocompletion script for the specified shell",
+		Long: `Generate the autocompletion script of horusec for the specified shell.
+
+Bash:
+  $ source <(horusec completion bash)
+  # To load completions for each session, execute once:
+  $ horusec completion bash > /etc/bash_completion.d/horusec
+
+Zsh:
+  # To load completions for each session, execute once:
+  $ horusec completion zsh > "${fpath[1]}/_horusec"
+
+Fish:
+  $ horusec completion fish | source
+  # To load completions for each session, execute once:
+  $ horusec completion fish > ~/.config/fish/completions/horusec.fish
+
+PowerShell:
+  PS> horusec completion powershell | Out-String | Invoke-Expression
+`,
+		Example:               "horusec completion bash",
+		ValidArgs:             []string{Bash, Zsh, Fish, PowerShell},
+		Args:                  cobra.ExactValidArgs(1),
+		DisableFlagsInUseLine: true,
+		RunE:                  c.runE,
+	}
+}
+
+func (c *Completion) runE(cmd *cobra.Command, args []string) error {
+	switch args[0] {
+	case Bash:
+		return cmd.Root().GenBashCompletion(cmd.OutOrStdout())
+	case Zsh:
+		return cmd.Root().GenZshCompletion(cmd.OutOrStdout())
+	case Fish:
+		return cmd.Root().GenFishCompletion(cmd.OutOrStdout(), true)
+	default:
+		return cmd.Root().GenPowerShellCompletion(cmd.OutOrStdout())
+	}
+}
+
+// CompleteValues returns a completion func of flags accepting only the values informed. Flags with a list of
+// values separated by comma are completed by the last value typed
+func CompleteValues(values ...string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		typed := ""
+		if index := strings.LastIndex(toComplete, ","); index >= 0 {
+			typed, toComplete = toComplete[:index+1], toComplete[index+1:]
+		}
+		var suggestions []string
+		for _, value := range values {
+			if strings.HasPrefix(strings.ToLower(value), strings.ToLower(strings.TrimSpace(toComplete))) {
+				suggestions = append(suggestions, typed+value)
+			}
+		}
+		return suggestions, cobra.ShellCompDirectiveNoFileComp
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package completion
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompletion_CreateCobraCmd(t *testing.T) {
+	for _, shell := range []string{Bash, Zsh, Fish, PowerShell} {
+		t.Run("Should generate the completion script of "+shell, func(t *testing.T) {
+			root := &cobra.Command{Use: "horusec"}
+			root.AddCommand(NewCompletionCommand().CreateCobraCmd())
+			output := &bytes.Buffer{}
+			root.SetOut(output)
+			root.SetArgs([]string{"completion", shell})
+
+			assert.NoError(t, root.Execute())
+			assert.Contains(t, output.String(), "horusec")
+		})
+	}
+
+	t.Run("Should return error when the shell is not supported", func(t *testing.T) {
+		root := &cobra.Command{Use: "horusec"}
+		root.AddCommand(NewCompletionCommand().CreateCobraCmd())
+		root.SetOut(&bytes.Buffer{})
+		root.SetErr(&bytes.Buffer{})
+		root.SetArgs([]string{"completion", "cmd"})
+
+		assert.Error(t, root.Execute())
+	})
+}
+
+func TestCompleteValues(t *testing.T) {
+	t.Run("Should return the values starting with the text typed", func(t *testing.T) {
+		suggestions, directive := CompleteValues("GoSec", "Bandit", "Brakeman")(nil, nil, "b")
+
+		assert.Equal(t, []string{"Bandit", "Brakeman"}, suggestions)
+		assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	})
+
+	t.Run("Should complete the last value of a list", func(t *testing.T) {
+		suggestions, _ := CompleteValues("GoSec", "Bandit")(nil, nil, "GoSec,Ban")
+
+		assert.Equal(t, []string{"GoSec,Bandit"}, suggestions)
+	})
+}
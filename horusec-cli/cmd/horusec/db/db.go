@@ -0,0 +1,158 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/completion"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/localdb"
+	"github.com/spf13/cobra"
+)
+
+const defaultHistoryLimit = 20
+
+type IDB interface {
+	SetGlobalCmd(globalCmd *cobra.Command)
+	CreateCobraCmd() *cobra.Command
+}
+
+type DB struct {
+	configs   config.IConfig
+	globalCmd *cobra.Command
+	localDB   localdb.Interface
+}
+
+func NewDBCommand(configs config.IConfig) IDB {
+	return &DB{
+		configs:   configs,
+		globalCmd: &cobra.Command{},
+	}
+}
+
+func (d *DB) SetGlobalCmd(globalCmd *cobra.Command) {
+	d.globalCmd = globalCmd
+}
+
+func (d *DB) CreateCobraCmd() *cobra.Command {
+	dbCmd := &cobra.Command{
+		Use:     "db",
+		Short:   "Read the analyses stored in the local database",
+		Long:    "Read the analyses stored by the flag --store-local-db of the command start in a SQLite database",
+		Example: "horusec db history --store-local-db=\"./horusec.db\"",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+	_ = dbCmd.PersistentFlags().
+		String("store-local-db", d.configs.GetLocalDBPath(), "Path of the SQLite database of the analyses")
+	_ = dbCmd.PersistentFlags().
+		String("format", localdb.FormatTable, "The format of the result: "+strings.Join(localdb.Formats(), ", "))
+	_ = dbCmd.RegisterFlagCompletionFunc("format", completion.CompleteValues(localdb.Formats()...))
+	dbCmd.AddCommand(d.createHistoryCmd(), d.createQueryCmd())
+	return dbCmd
+}
+
+func (d *DB) createHistoryCmd() *cobra.Command {
+	historyCmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show the last analyses with the total of vulnerabilities by severity",
+		Long: "Show the last analyses stored, the newest first, with the total of vulnerabilities by severity. " +
+			"The false positives, risk accepted and corrected are not counted",
+		Example: "horusec db history --store-local-db=\"./horusec.db\" --repository-name=\"my-project\" --limit=10",
+		Args:    cobra.NoArgs,
+		RunE:    d.runHistoryE,
+	}
+	_ = historyCmd.Flags().Int("limit", defaultHistoryLimit, "The max of analyses shown")
+	_ = historyCmd.Flags().String("repository-name", "", "Show only the analyses of the repository")
+	return historyCmd
+}
+
+func (d *DB) createQueryCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "query [sql]",
+		Short: "Run a sql query in the local database",
+		Long: "Run a sql query in the local database, opened as read only. The tables are analyses, " +
+			"vulnerabilities and tool_executions",
+		Example: "horusec db query \"SELECT severity, COUNT(*) FROM vulnerabilities GROUP BY severity\"",
+		Args:    cobra.ExactArgs(1),
+		RunE:    d.runQueryE,
+	}
+}
+
+func (d *DB) runHistoryE(cmd *cobra.Command, _ []string) error {
+	format, err := d.setConfig(cmd)
+	if err != nil {
+		return err
+	}
+	limit, _ := cmd.Flags().GetInt("limit")
+	repositoryName, _ := cmd.Flags().GetString("repository-name")
+	output, err := d.localDB.History(repositoryName, format, limit)
+	return d.printOutput(cmd, output, err)
+}
+
+func (d *DB) runQueryE(cmd *cobra.Command, args []string) error {
+	format, err := d.setConfig(cmd)
+	if err != nil {
+		return err
+	}
+	output, err := d.localDB.Query(args[0], format)
+	return d.printOutput(cmd, output, err)
+}
+
+func (d *DB) printOutput(cmd *cobra.Command, output []byte, err error) error {
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorQueryLocalDB, err, logger.ErrorLevel)
+		return err
+	}
+	_, err = cmd.OutOrStdout().Write(output)
+	return err
+}
+
+func (d *DB) setConfig(cmd *cobra.Command) (format string, err error) {
+	format, _ = cmd.Flags().GetString("format")
+	if !d.isValidFormat(format) {
+		return "", fmt.Errorf("%s%s", messages.MsgErrorInvalidLocalDBFormat, strings.Join(localdb.Formats(), ", "))
+	}
+	d.configs = d.configs.NewConfigsFromCobraAndLoadsCmdGlobalFlags(d.globalCmd)
+	d.configs = d.configs.NewConfigsFromViper()
+	d.configs = d.configs.NewConfigsFromEnvironments()
+	if cmd.Flags().Changed("store-local-db") {
+		localDBPath, _ := cmd.Flags().GetString("store-local-db")
+		d.configs.SetLocalDBPath(localDBPath)
+	}
+	d.configs.NormalizeConfigs()
+	if d.configs.GetLocalDBPath() == "" {
+		return "", errors.New(messages.MsgErrorLocalDBPathNotInformed)
+	}
+	if d.localDB == nil {
+		d.localDB = localdb.NewLocalDB(d.configs)
+	}
+	return format, nil
+}
+
+func (d *DB) isValidFormat(format string) bool {
+	for _, validFormat := range localdb.Formats() {
+		if validFormat == format {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/localdb"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGlobalCmd(configFilePath string) *cobra.Command {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "", "")
+	_ = globalCmd.PersistentFlags().String("config-file-path", configFilePath, "")
+	return globalCmd
+}
+
+func TestNewDBCommand(t *testing.T) {
+	t.Run("Should run NewDBCommand and return type correctly", func(t *testing.T) {
+		assert.IsType(t, &DB{}, NewDBCommand(&config.Config{}))
+	})
+}
+
+func TestDB_Commands(t *testing.T) {
+	dir, err := ioutil.TempDir("", "db")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFilePath := filepath.Join(dir, "horusec-config.json")
+
+	t.Run("Should print the history of the analyses", func(t *testing.T) {
+		localDBMock := &localdb.Mock{}
+		localDBMock.On("History").Return([]byte("history"), nil)
+		db := &DB{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), localDB: localDBMock}
+		cmd := db.CreateCobraCmd()
+		output := &bytes.Buffer{}
+		cmd.SetOut(output)
+		cmd.SetArgs([]string{"history", "--store-local-db", "./horusec.db", "--limit", "5"})
+
+		assert.NoError(t, cmd.Execute())
+		assert.Equal(t, "history", output.String())
+		assert.Equal(t, "./horusec.db", db.configs.GetLocalDBPath())
+	})
+
+	t.Run("Should print the result of the query", func(t *testing.T) {
+		localDBMock := &localdb.Mock{}
+		localDBMock.On("Query").Return([]byte("[]"), nil)
+		db := &DB{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), localDB: localDBMock}
+		cmd := db.CreateCobraCmd()
+		output := &bytes.Buffer{}
+		cmd.SetOut(output)
+		cmd.SetArgs([]string{"query", "SELECT 1", "--store-local-db", "./horusec.db", "--format", "json"})
+
+		assert.NoError(t, cmd.Execute())
+		assert.Equal(t, "[]", output.String())
+	})
+
+	t.Run("Should return error when the path of the database is not informed", func(t *testing.T) {
+		db := &DB{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), localDB: &localdb.Mock{}}
+		cmd := db.CreateCobraCmd()
+		cmd.SetArgs([]string{"history"})
+
+		assert.Error(t, cmd.Execute())
+	})
+
+	t.Run("Should return error when the format is not supported", func(t *testing.T) {
+		db := &DB{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), localDB: &localdb.Mock{}}
+		cmd := db.CreateCobraCmd()
+		cmd.SetArgs([]string{"query", "SELECT 1", "--store-local-db", "./horusec.db", "--format", "xml"})
+
+		assert.Error(t, cmd.Execute())
+	})
+}
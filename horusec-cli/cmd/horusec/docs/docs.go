@@ -0,0 +1,76 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docs
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/spf13/cobra"
+)
+
+type IDocs interface {
+	CreateCobraCmd() *cobra.Command
+}
+
+type Docs struct {
+}
+
+func NewDocsCommand() IDocs {
+	return &Docs{}
+}
+
+func (d *Docs) CreateCobraCmd() *cobra.Command {
+	docsCmd := &cobra.Command{
+		Use:     "docs",
+		Short:   "Generate the documentation of the horusec commands",
+		Example: "horusec docs man --dir=\"./man\"",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+	docsCmd.AddCommand(d.createManCmd())
+	return docsCmd
+}
+
+func (d *Docs) createManCmd() *cobra.Command {
+	manCmd := &cobra.Command{
+		Use:     "man",
+		Short:   "Generate the man pages of the horusec commands",
+		Long:    "Generate one man page for each horusec command in the directory informed",
+		Example: "horusec docs man --dir=\"/usr/local/share/man/man1\"",
+		Args:    cobra.NoArgs,
+		RunE:    d.runManE,
+	}
+	_ = manCmd.Flags().String("dir", ".", "Directory where the man pages are written")
+	return manCmd
+}
+
+func (d *Docs) runManE(cmd *cobra.Command, _ []string) error {
+	dir, _ := cmd.Flags().GetString("dir")
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateManPages, err, logger.ErrorLevel)
+		return err
+	}
+	if err := GenerateManPages(cmd.Root(), dir); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateManPages, err, logger.ErrorLevel)
+		return err
+	}
+	absDir, _ := filepath.Abs(dir)
+	logger.LogInfoWithLevel(messages.MsgInfoManPagesGenerated+absDir, logger.InfoLevel)
+	return nil
+}
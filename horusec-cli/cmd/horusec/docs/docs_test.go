@@ -0,0 +1,50 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocs_CreateCobraCmd(t *testing.T) {
+	t.Run("Should generate the man pages of all commands", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "horusec-man")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		root := &cobra.Command{Use: "horusec", Short: "Horusec CLI"}
+		startCmd := &cobra.Command{Use: "start", Short: "Start horusec-cli", Example: "horusec start",
+			Run: func(*cobra.Command, []string) {}}
+		_ = startCmd.Flags().StringP("project-path", "p", "./", "Path to run an analysis in your project")
+		root.AddCommand(startCmd)
+		root.AddCommand(NewDocsCommand().CreateCobraCmd())
+		root.SetArgs([]string{"docs", "man", "--dir", dir})
+
+		assert.NoError(t, root.Execute())
+		assert.FileExists(t, filepath.Join(dir, "horusec.1"))
+		assert.FileExists(t, filepath.Join(dir, "horusec-docs-man.1"))
+		content, err := ioutil.ReadFile(filepath.Join(dir, "horusec-start.1"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), `.TH "HORUSEC-START" "1"`)
+		assert.Contains(t, string(content), `\fB\-p\fP, \fB\-\-project\-path\fP=./`)
+		assert.Contains(t, string(content), `\fBhorusec(1)\fP`)
+	})
+}
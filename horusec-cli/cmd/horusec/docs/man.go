@@ -0,0 +1,120 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docs
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const manSection = "1"
+
+// GenerateManPages writes a man page in roff format for the command and each one of its available subcommands
+func GenerateManPages(cmd *cobra.Command, dir string) error {
+	for _, subCmd := range cmd.Commands() {
+		if !subCmd.IsAvailableCommand() || subCmd.IsAdditionalHelpTopicCommand() {
+			continue
+		}
+		if err := GenerateManPages(subCmd, dir); err != nil {
+			return err
+		}
+	}
+	fileName := fmt.Sprintf("%s.%s", manPageName(cmd), manSection)
+	return ioutil.WriteFile(filepath.Join(dir, fileName), generateManPage(cmd), 0600)
+}
+
+func manPageName(cmd *cobra.Command) string {
+	return strings.ReplaceAll(cmd.CommandPath(), " ", "-")
+}
+
+func generateManPage(cmd *cobra.Command) []byte {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, ".TH \"%s\" \"%s\" \"\" \"Horusec\" \"Horusec Manual\"\n",
+		strings.ToUpper(manPageName(cmd)), manSection)
+	fmt.Fprintf(buf, ".SH NAME\n%s \\- %s\n", escapeRoff(manPageName(cmd)), escapeRoff(cmd.Short))
+	fmt.Fprintf(buf, ".SH SYNOPSIS\n\\fB%s\\fP\n", escapeRoff(cmd.UseLine()))
+	fmt.Fprintf(buf, ".SH DESCRIPTION\n%s\n", escapeRoffText(getDescription(cmd)))
+	writeFlags(buf, "OPTIONS", cmd.NonInheritedFlags())
+	writeFlags(buf, "OPTIONS INHERITED FROM PARENT COMMANDS", cmd.InheritedFlags())
+	if cmd.Example != "" {
+		fmt.Fprintf(buf, ".SH EXAMPLE\n.PP\n.RS\n.nf\n%s\n.fi\n.RE\n", escapeRoffText(strings.TrimSpace(cmd.Example)))
+	}
+	writeSeeAlso(buf, cmd)
+	return buf.Bytes()
+}
+
+func getDescription(cmd *cobra.Command) string {
+	if cmd.Long != "" {
+		return strings.TrimSpace(cmd.Long)
+	}
+	return cmd.Short
+}
+
+func writeFlags(buf *bytes.Buffer, title string, flags *pflag.FlagSet) {
+	if !flags.HasAvailableFlags() {
+		return
+	}
+	fmt.Fprintf(buf, ".SH %s\n", title)
+	flags.VisitAll(func(flag *pflag.Flag) {
+		if flag.Hidden {
+			return
+		}
+		fmt.Fprint(buf, ".TP\n")
+		if flag.Shorthand != "" {
+			fmt.Fprintf(buf, "\\fB\\-%s\\fP, ", flag.Shorthand)
+		}
+		fmt.Fprintf(buf, "\\fB\\-\\-%s\\fP", escapeRoff(flag.Name))
+		if flag.Value.Type() != "bool" {
+			fmt.Fprintf(buf, "=%s", escapeRoff(flag.DefValue))
+		}
+		fmt.Fprintf(buf, "\n%s\n", escapeRoffText(flag.Usage))
+	})
+}
+
+func writeSeeAlso(buf *bytes.Buffer, cmd *cobra.Command) {
+	var related []string
+	if cmd.HasParent() {
+		related = append(related, fmt.Sprintf("\\fB%s(%s)\\fP", escapeRoff(manPageName(cmd.Parent())), manSection))
+	}
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.IsAvailableCommand() && !subCmd.IsAdditionalHelpTopicCommand() {
+			related = append(related, fmt.Sprintf("\\fB%s(%s)\\fP", escapeRoff(manPageName(subCmd)), manSection))
+		}
+	}
+	if len(related) > 0 {
+		fmt.Fprintf(buf, ".SH SEE ALSO\n%s\n", strings.Join(related, ", "))
+	}
+}
+
+func escapeRoff(value string) string {
+	return strings.NewReplacer(`\`, `\e`, "-", `\-`).Replace(value)
+}
+
+// escapeRoffText escapes the lines starting with the roff control characters, that would be read as requests
+func escapeRoffText(value string) string {
+	lines := strings.Split(escapeRoff(value), "\n")
+	for index, line := range lines {
+		if strings.HasPrefix(line, ".") || strings.HasPrefix(line, "'") {
+			lines[index] = `\&` + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,164 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fix
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/dependencyfix"
+	"github.com/spf13/cobra"
+)
+
+var ErrNothingToFix = errors.New("{HORUSEC_CLI} inform what must be fixed, like the flag --dependencies")
+
+type IFix interface {
+	SetGlobalCmd(globalCmd *cobra.Command)
+	CreateCobraCmd() *cobra.Command
+}
+
+type Fix struct {
+	configs       config.IConfig
+	globalCmd     *cobra.Command
+	dependencyFix dependencyfix.Interface
+	dependencies  bool
+	patchFile     string
+}
+
+func NewFixCommand(configs config.IConfig) IFix {
+	return &Fix{
+		configs:   configs,
+		globalCmd: &cobra.Command{},
+	}
+}
+
+func (f *Fix) SetGlobalCmd(globalCmd *cobra.Command) {
+	f.globalCmd = globalCmd
+}
+
+func (f *Fix) CreateCobraCmd() *cobra.Command {
+	fixCmd := &cobra.Command{
+		Use:   "fix [json report]",
+		Short: "Fix the vulnerabilities of a json report",
+		Long: "Upgrade the vulnerable dependencies of a report generated with the output format json to the lowest " +
+			"versions that fix their vulnerabilities, changing the package.json, requirements.txt and go.mod of the " +
+			"project or writing the changes in a patch file to review",
+		Example: "horusec fix --dependencies ./horusec-report.json\n" +
+			"horusec fix --dependencies --patch-file=\"./dependencies.patch\" ./horusec-report.json",
+		Args: cobra.ExactArgs(1),
+		RunE: f.runE,
+	}
+	_ = fixCmd.PersistentFlags().
+		StringP("project-path", "p", f.configs.GetProjectPath(), "Path of the project analyzed, where the manifests are changed")
+	fixCmd.PersistentFlags().
+		BoolVar(&f.dependencies, "dependencies", false, "Upgrade the dependencies with vulnerabilities fixed in newer versions")
+	fixCmd.PersistentFlags().
+		StringVar(&f.patchFile, "patch-file", "", "Write the changes in a unified diff instead of changing the manifests")
+	return fixCmd
+}
+
+func (f *Fix) runE(cmd *cobra.Command, args []string) error {
+	if !f.dependencies {
+		return ErrNothingToFix
+	}
+	f.setConfig(cmd)
+	analysis, err := f.readReport(args[0])
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorFixDependencies, err, logger.ErrorLevel)
+		return err
+	}
+	if err := f.fixDependencies(analysis); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorFixDependencies, err, logger.ErrorLevel)
+		return err
+	}
+	return nil
+}
+
+func (f *Fix) fixDependencies(analysis *horusec.Analysis) error {
+	if f.dependencyFix == nil {
+		f.dependencyFix = dependencyfix.NewDependencyFix(f.configs)
+	}
+	changes, notFound, err := f.dependencyFix.GetChanges(analysis)
+	if err != nil {
+		return err
+	}
+	f.logUpgrades(changes, notFound)
+	if len(changes) == 0 {
+		logger.LogInfoWithLevel(messages.MsgInfoNoDependencyToFix, logger.InfoLevel)
+		return nil
+	}
+	if f.patchFile != "" {
+		return f.savePatch(changes)
+	}
+	if err := f.dependencyFix.Apply(changes); err != nil {
+		return err
+	}
+	logger.LogInfoWithLevel(messages.MsgInfoDependenciesFixed, logger.InfoLevel)
+	return nil
+}
+
+func (f *Fix) savePatch(changes []dependencyfix.Change) error {
+	patch, err := f.dependencyFix.Patch(changes)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(f.patchFile, []byte(patch), 0600); err != nil {
+		return err
+	}
+	logger.LogInfoWithLevel(messages.MsgInfoDependenciesPatchSaved+f.patchFile, logger.InfoLevel)
+	return nil
+}
+
+func (f *Fix) logUpgrades(changes []dependencyfix.Change, notFound []dependencyfix.Upgrade) {
+	for _, change := range changes {
+		for _, upgrade := range change.Upgrades {
+			logger.LogInfoWithLevel(f.replaceUpgrade(messages.MsgInfoDependencyUpgraded, upgrade)+
+				upgrade.FixedVersion, logger.InfoLevel)
+		}
+	}
+	for _, upgrade := range notFound {
+		logger.LogWarnWithLevel(f.replaceUpgrade(messages.MsgWarnDependencyNotInManifest, upgrade)+
+			upgrade.FixedVersion, logger.WarnLevel)
+	}
+}
+
+func (f *Fix) replaceUpgrade(message string, upgrade dependencyfix.Upgrade) string {
+	return strings.NewReplacer("{{0}}", upgrade.Manifest, "{{1}}", upgrade.Name).Replace(message)
+}
+
+func (f *Fix) setConfig(cmd *cobra.Command) {
+	f.configs = f.configs.NewConfigsFromCobraAndLoadsCmdGlobalFlags(f.globalCmd)
+	f.configs = f.configs.NewConfigsFromViper()
+	f.configs = f.configs.NewConfigsFromEnvironments()
+	if projectPath, err := cmd.PersistentFlags().GetString("project-path"); err == nil && projectPath != "" {
+		f.configs.SetProjectPath(projectPath)
+	}
+	f.configs.NormalizeConfigs()
+}
+
+func (f *Fix) readReport(reportPath string) (*horusec.Analysis, error) {
+	content, err := ioutil.ReadFile(reportPath)
+	if err != nil {
+		return nil, err
+	}
+	analysis := &horusec.Analysis{}
+	return analysis, json.Unmarshal(content, analysis)
+}
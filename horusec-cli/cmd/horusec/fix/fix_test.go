@@ -0,0 +1,97 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fix
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+const reportWithDependency = `{"analysisVulnerabilities": [{"vulnerabilities": {"file": "requirements.txt",
+"dependency": {"name": "jinja2", "version": "2.7.1", "fixedVersion": "2.7.2"}}}]}`
+
+func newGlobalCmd(configFilePath string) *cobra.Command {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "", "")
+	_ = globalCmd.PersistentFlags().String("config-file-path", configFilePath, "")
+	return globalCmd
+}
+
+func TestNewFixCommand(t *testing.T) {
+	t.Run("Should run NewFixCommand and return type correctly", func(t *testing.T) {
+		assert.IsType(t, &Fix{}, NewFixCommand(&config.Config{}))
+	})
+}
+
+func TestFix_Execute(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fix")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFilePath := filepath.Join(dir, "horusec-config.json")
+	reportPath := filepath.Join(dir, "report.json")
+	assert.NoError(t, ioutil.WriteFile(reportPath, []byte(reportWithDependency), 0600))
+	requirementsPath := filepath.Join(dir, "requirements.txt")
+
+	t.Run("Should write the patch of the dependencies without changing the manifests", func(t *testing.T) {
+		assert.NoError(t, ioutil.WriteFile(requirementsPath, []byte("jinja2==2.7.1\n"), 0600))
+		patchPath := filepath.Join(dir, "dependencies.patch")
+
+		cmd := (&Fix{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath)}).CreateCobraCmd()
+		cmd.SetArgs([]string{reportPath, "-p", dir, "--dependencies", "--patch-file", patchPath})
+
+		assert.NoError(t, cmd.Execute())
+		patch, err := ioutil.ReadFile(patchPath)
+		assert.NoError(t, err)
+		assert.Contains(t, string(patch), "+jinja2==2.7.2")
+		requirements, err := ioutil.ReadFile(requirementsPath)
+		assert.NoError(t, err)
+		assert.Equal(t, "jinja2==2.7.1\n", string(requirements))
+	})
+
+	t.Run("Should upgrade the dependencies in the manifests", func(t *testing.T) {
+		assert.NoError(t, ioutil.WriteFile(requirementsPath, []byte("jinja2==2.7.1\n"), 0600))
+
+		cmd := (&Fix{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath)}).CreateCobraCmd()
+		cmd.SetArgs([]string{reportPath, "-p", dir, "--dependencies"})
+
+		assert.NoError(t, cmd.Execute())
+		requirements, err := ioutil.ReadFile(requirementsPath)
+		assert.NoError(t, err)
+		assert.Equal(t, "jinja2==2.7.2\n", string(requirements))
+	})
+
+	t.Run("Should return error when nothing to fix is informed", func(t *testing.T) {
+		cmd := (&Fix{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath)}).CreateCobraCmd()
+		cmd.SetArgs([]string{reportPath, "-p", dir})
+
+		assert.Equal(t, ErrNothingToFix, cmd.Execute())
+	})
+
+	t.Run("Should return error when the report is not a json", func(t *testing.T) {
+		invalidReportPath := filepath.Join(dir, "invalid.json")
+		assert.NoError(t, ioutil.WriteFile(invalidReportPath, []byte("invalid"), 0600))
+
+		cmd := (&Fix{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath)}).CreateCobraCmd()
+		cmd.SetArgs([]string{invalidReportPath, "--dependencies"})
+
+		assert.Error(t, cmd.Execute())
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flushqueue
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	horusecAPI "github.com/ZupIT/horusec/horusec-cli/internal/services/horusapi"
+	"github.com/spf13/cobra"
+)
+
+type IFlushQueue interface {
+	SetGlobalCmd(globalCmd *cobra.Command)
+	CreateCobraCmd() *cobra.Command
+}
+
+type FlushQueue struct {
+	configs           config.IConfig
+	globalCmd         *cobra.Command
+	horusecAPIService horusecAPI.IService
+}
+
+func NewFlushQueueCommand(configs config.IConfig) IFlushQueue {
+	return &FlushQueue{
+		configs:   configs,
+		globalCmd: &cobra.Command{},
+	}
+}
+
+func (f *FlushQueue) SetGlobalCmd(globalCmd *cobra.Command) {
+	f.globalCmd = globalCmd
+}
+
+func (f *FlushQueue) CreateCobraCmd() *cobra.Command {
+	flushQueueCmd := &cobra.Command{
+		Use:   "flush-queue",
+		Short: "Send the analyses of the offline queue to horusec platform",
+		Long: "Send to horusec platform the analyses kept in the offline queue because the platform was unreachable. " +
+			"Only the analyses queued with the same authorization token are sent",
+		Example: "horusec flush-queue -a=\"REPOSITORY_TOKEN\"",
+		Args:    cobra.NoArgs,
+		RunE:    f.runE,
+	}
+	_ = flushQueueCmd.PersistentFlags().
+		StringP("authorization", "a", f.configs.GetRepositoryAuthorization(), "The authorization token used in the analyses")
+	_ = flushQueueCmd.PersistentFlags().
+		StringP("horusec-url", "u", f.configs.GetHorusecAPIUri(), "The url of horusec platform")
+	_ = flushQueueCmd.PersistentFlags().
+		String("queue-dir", f.configs.GetQueueDir(), "Directory of the offline queue")
+	return flushQueueCmd
+}
+
+func (f *FlushQueue) runE(cmd *cobra.Command, _ []string) error {
+	f.setConfig(cmd)
+	if f.configs.IsEmptyRepositoryAuthorization() {
+		return errors.New(messages.MsgErrorFlushQueueWithoutAuthorization)
+	}
+	if f.horusecAPIService == nil {
+		f.horusecAPIService = horusecAPI.NewHorusecAPIService(f.configs)
+	}
+	totalSent, err := f.horusecAPIService.FlushQueue()
+	logger.LogInfoWithLevel(messages.MsgInfoAnalysesSentFromQueue+strconv.Itoa(totalSent), logger.InfoLevel)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorFlushQueue, err, logger.ErrorLevel)
+	}
+	return err
+}
+
+func (f *FlushQueue) setConfig(cmd *cobra.Command) {
+	f.configs = f.configs.NewConfigsFromCobraAndLoadsCmdGlobalFlags(f.globalCmd)
+	f.configs = f.configs.NewConfigsFromViper()
+	f.configs = f.configs.NewConfigsFromEnvironments()
+	if cmd.PersistentFlags().Changed("authorization") {
+		authorization, _ := cmd.PersistentFlags().GetString("authorization")
+		f.configs.SetRepositoryAuthorization(authorization)
+	}
+	if cmd.PersistentFlags().Changed("horusec-url") {
+		horusecURL, _ := cmd.PersistentFlags().GetString("horusec-url")
+		f.configs.SetHorusecAPIURI(horusecURL)
+	}
+	if cmd.PersistentFlags().Changed("queue-dir") {
+		queueDir, _ := cmd.PersistentFlags().GetString("queue-dir")
+		f.configs.SetQueueDir(queueDir)
+	}
+	f.configs.NormalizeConfigs()
+}
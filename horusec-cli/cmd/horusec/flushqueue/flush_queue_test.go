@@ -0,0 +1,88 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flushqueue
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	horusecAPI "github.com/ZupIT/horusec/horusec-cli/internal/services/horusapi"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGlobalCmd(configFilePath string) *cobra.Command {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "", "")
+	_ = globalCmd.PersistentFlags().String("config-file-path", configFilePath, "")
+	return globalCmd
+}
+
+func TestNewFlushQueueCommand(t *testing.T) {
+	t.Run("Should run NewFlushQueueCommand and return type correctly", func(t *testing.T) {
+		assert.IsType(t, &FlushQueue{}, NewFlushQueueCommand(&config.Config{}))
+	})
+}
+
+func TestFlushQueue_Execute(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flush-queue")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFilePath := filepath.Join(dir, "horusec-config.json")
+
+	t.Run("Should send the analyses of the queue", func(t *testing.T) {
+		serviceMock := &horusecAPI.Mock{}
+		serviceMock.On("FlushQueue").Return(2, nil)
+
+		flushQueue := &FlushQueue{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath),
+			horusecAPIService: serviceMock}
+		cmd := flushQueue.CreateCobraCmd()
+		cmd.SetArgs([]string{"-a", "token", "-u", "http://localhost:8000", "--queue-dir", dir})
+
+		assert.NoError(t, cmd.Execute())
+		serviceMock.AssertCalled(t, "FlushQueue")
+		assert.Equal(t, "token", flushQueue.configs.GetRepositoryAuthorization())
+		assert.Equal(t, "http://localhost:8000", flushQueue.configs.GetHorusecAPIUri())
+		assert.Equal(t, dir, flushQueue.configs.GetQueueDir())
+	})
+
+	t.Run("Should return error when send fails", func(t *testing.T) {
+		serviceMock := &horusecAPI.Mock{}
+		serviceMock.On("FlushQueue").Return(0, errors.New("test"))
+
+		flushQueue := &FlushQueue{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath),
+			horusecAPIService: serviceMock}
+		cmd := flushQueue.CreateCobraCmd()
+		cmd.SetArgs([]string{"-a", "token"})
+
+		assert.Error(t, cmd.Execute())
+	})
+
+	t.Run("Should return error without authorization", func(t *testing.T) {
+		serviceMock := &horusecAPI.Mock{}
+
+		flushQueue := &FlushQueue{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath),
+			horusecAPIService: serviceMock}
+		cmd := flushQueue.CreateCobraCmd()
+		cmd.SetArgs([]string{})
+
+		assert.Error(t, cmd.Execute())
+		serviceMock.AssertNotCalled(t, "FlushQueue")
+	})
+}
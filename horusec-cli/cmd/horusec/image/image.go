@@ -0,0 +1,128 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"errors"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/imagescan"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/spf13/cobra"
+)
+
+type IImage interface {
+	SetGlobalCmd(globalCmd *cobra.Command)
+	CreateCobraCmd() *cobra.Command
+}
+
+type Image struct {
+	configs   config.IConfig
+	globalCmd *cobra.Command
+	imageScan imagescan.Interface
+}
+
+func NewImageCommand(configs config.IConfig) IImage {
+	return &Image{
+		configs:   configs,
+		globalCmd: &cobra.Command{},
+	}
+}
+
+func (i *Image) SetGlobalCmd(globalCmd *cobra.Command) {
+	i.globalCmd = globalCmd
+}
+
+func (i *Image) CreateCobraCmd() *cobra.Command {
+	imageCmd := &cobra.Command{
+		Use:     "image",
+		Short:   "Analyze container images",
+		Example: "horusec image scan alpine:3.10",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+	imageCmd.AddCommand(i.createScanCmd())
+	return imageCmd
+}
+
+func (i *Image) createScanCmd() *cobra.Command {
+	scanCmd := &cobra.Command{
+		Use:   "scan <image>",
+		Short: "Scan the OS packages and the application dependencies of a container image",
+		Long: "Scan the OS packages and the application dependencies of a container image with Trivy, running in a " +
+			"container like the other tools. The image is pulled from its registry by Trivy, to scan a local image " +
+			"save it with \"docker save\" and inform the path of the tar file",
+		Example: "horusec image scan alpine:3.10\nhorusec image scan ./alpine.tar -o=\"json\" -O=\"./report.json\"",
+		Args:    cobra.ExactArgs(1),
+		RunE:    i.runScanE,
+	}
+	_ = scanCmd.PersistentFlags().
+		StringP("output-format", "o", i.configs.GetPrintOutputType(), "The format for the output to be shown. "+
+			"Options are: text (stdout), json, sonarqube")
+	_ = scanCmd.PersistentFlags().
+		StringP("json-output-file", "O", i.configs.GetJSONOutputFilePath(), "The path of the output file "+
+			"when the output format is json or sonarqube")
+	_ = scanCmd.PersistentFlags().
+		StringSliceP("ignore-severity", "s", i.configs.GetSeveritiesToIgnore(), "The level of vulnerabilities to "+
+			"ignore in the output. Example: -s=\"LOW, MEDIUM\"")
+	_ = scanCmd.PersistentFlags().
+		BoolP("return-error", "e", i.configs.GetReturnErrorIfFoundVulnerability(), "Return \"exit(1)\" "+
+			"if found vulnerabilities")
+	return scanCmd
+}
+
+func (i *Image) runScanE(cmd *cobra.Command, args []string) error {
+	i.setConfig(cmd)
+	if i.imageScan == nil {
+		i.imageScan = imagescan.NewImageScan(i.configs)
+	}
+	totalVulns, err := i.imageScan.Scan(args[0])
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorScanImage, err, logger.ErrorLevel)
+		return err
+	}
+	if totalVulns > 0 && i.configs.GetReturnErrorIfFoundVulnerability() {
+		cmd.SetUsageFunc(func(command *cobra.Command) error {
+			return nil
+		})
+		return errors.New("analysis finished with blocking vulnerabilities")
+	}
+	return nil
+}
+
+func (i *Image) setConfig(cmd *cobra.Command) {
+	i.configs = i.configs.NewConfigsFromCobraAndLoadsCmdGlobalFlags(i.globalCmd)
+	i.configs = i.configs.NewConfigsFromViper()
+	i.configs = i.configs.NewConfigsFromEnvironments()
+	if cmd.PersistentFlags().Changed("output-format") {
+		outputFormat, _ := cmd.PersistentFlags().GetString("output-format")
+		i.configs.SetPrintOutputType(outputFormat)
+	}
+	if cmd.PersistentFlags().Changed("json-output-file") {
+		jsonOutputFile, _ := cmd.PersistentFlags().GetString("json-output-file")
+		i.configs.SetJSONOutputFilePath(jsonOutputFile)
+	}
+	if cmd.PersistentFlags().Changed("ignore-severity") {
+		severitiesToIgnore, _ := cmd.PersistentFlags().GetStringSlice("ignore-severity")
+		i.configs.SetSeveritiesToIgnore(severitiesToIgnore)
+	}
+	if cmd.PersistentFlags().Changed("return-error") {
+		returnError, _ := cmd.PersistentFlags().GetBool("return-error")
+		i.configs.SetReturnErrorIfFoundVulnerability(returnError)
+	}
+	i.configs.NormalizeConfigs()
+}
@@ -0,0 +1,84 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/imagescan"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGlobalCmd() *cobra.Command {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "", "")
+	_ = globalCmd.PersistentFlags().String("config-file-path", "", "")
+	return globalCmd
+}
+
+func TestNewImageCommand(t *testing.T) {
+	t.Run("Should run NewImageCommand and return type correctly", func(t *testing.T) {
+		assert.IsType(t, &Image{}, NewImageCommand(&config.Config{}))
+	})
+}
+
+func TestImage_Execute(t *testing.T) {
+	t.Run("Should scan the image with the flags informed", func(t *testing.T) {
+		imageScanMock := &imagescan.Mock{}
+		imageScanMock.On("Scan").Return(0, nil)
+
+		image := &Image{configs: config.NewConfig(), globalCmd: newGlobalCmd(), imageScan: imageScanMock}
+		cmd := image.CreateCobraCmd()
+		cmd.SetArgs([]string{"scan", "alpine:3.10", "-o", "json", "-s", "LOW"})
+
+		assert.NoError(t, cmd.Execute())
+		imageScanMock.AssertCalled(t, "Scan")
+		assert.Equal(t, "json", image.configs.GetPrintOutputType())
+		assert.Equal(t, []string{"LOW"}, image.configs.GetSeveritiesToIgnore())
+	})
+
+	t.Run("Should return error when found vulnerabilities with return error", func(t *testing.T) {
+		imageScanMock := &imagescan.Mock{}
+		imageScanMock.On("Scan").Return(2, nil)
+
+		image := &Image{configs: config.NewConfig(), globalCmd: newGlobalCmd(), imageScan: imageScanMock}
+		cmd := image.CreateCobraCmd()
+		cmd.SetArgs([]string{"scan", "alpine:3.10", "-e"})
+
+		assert.Error(t, cmd.Execute())
+	})
+
+	t.Run("Should return error when the scan fails", func(t *testing.T) {
+		imageScanMock := &imagescan.Mock{}
+		imageScanMock.On("Scan").Return(0, errors.New("test"))
+
+		image := &Image{configs: config.NewConfig(), globalCmd: newGlobalCmd(), imageScan: imageScanMock}
+		cmd := image.CreateCobraCmd()
+		cmd.SetArgs([]string{"scan", "alpine:3.10"})
+
+		assert.Error(t, cmd.Execute())
+	})
+
+	t.Run("Should return error without the image", func(t *testing.T) {
+		image := &Image{configs: config.NewConfig(), globalCmd: newGlobalCmd(), imageScan: &imagescan.Mock{}}
+		cmd := image.CreateCobraCmd()
+		cmd.SetArgs([]string{"scan"})
+
+		assert.Error(t, cmd.Execute())
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/completion"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	formattersImages "github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/images"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+var ErrPullImages = errors.New("{HORUSEC_CLI} some images could not be pulled, check the logs above")
+
+type IImages interface {
+	SetGlobalCmd(globalCmd *cobra.Command)
+	CreateCobraCmd() *cobra.Command
+}
+
+type Images struct {
+	configs   config.IConfig
+	globalCmd *cobra.Command
+	dockerAPI docker.Interface
+}
+
+func NewImagesCommand(configs config.IConfig) IImages {
+	return &Images{
+		configs:   configs,
+		globalCmd: &cobra.Command{},
+	}
+}
+
+func (i *Images) SetGlobalCmd(globalCmd *cobra.Command) {
+	i.globalCmd = globalCmd
+}
+
+func (i *Images) CreateCobraCmd() *cobra.Command {
+	imagesCmd := &cobra.Command{
+		Use:     "images",
+		Short:   "Manage the images of the tools run by horusec",
+		Example: "horusec images pull --languages=\"Go,Python\"",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+	imagesCmd.AddCommand(i.createPullCmd())
+	return imagesCmd
+}
+
+func (i *Images) createPullCmd() *cobra.Command {
+	pullCmd := &cobra.Command{
+		Use:   "pull",
+		Short: "Pull the images of the tools before the analyses",
+		Long: "Pull the images of the tools of the languages informed, or of all tools, so the analyses don't wait " +
+			"for the pulls. The images changed in the tools config are pulled instead of the default images, the " +
+			"images already present are not pulled again. Useful to prepare the images of the CI runners",
+		Example: "horusec images pull\nhorusec images pull --languages=\"Go,Python\"",
+		Args:    cobra.NoArgs,
+		RunE:    i.runPullE,
+	}
+	_ = pullCmd.PersistentFlags().
+		StringSliceP("languages", "l", []string{}, "Languages of the images pulled, by default the images of all "+
+			"languages are pulled. Example: --languages=\"Go,Python\"")
+	languagesNames := []string{}
+	for _, lang := range languages.SupportedLanguages() {
+		if lang != languages.Unknown {
+			languagesNames = append(languagesNames, lang.ToString())
+		}
+	}
+	_ = pullCmd.RegisterFlagCompletionFunc("languages", completion.CompleteValues(languagesNames...))
+	return pullCmd
+}
+
+func (i *Images) runPullE(cmd *cobra.Command, _ []string) error {
+	i.setConfig()
+	langs, err := i.getLanguages(cmd)
+	if err != nil {
+		return err
+	}
+	if i.dockerAPI == nil {
+		i.dockerAPI = docker.NewDockerAPI(dockerClient.NewDockerClient(), i.configs, uuid.New())
+	}
+	imagePaths := i.getImagePaths(langs)
+	pulled := 0
+	for _, imagePath := range imagePaths {
+		logger.LogInfoWithLevel(messages.MsgInfoPullingImage+imagePath, logger.InfoLevel)
+		if err := i.dockerAPI.PullImage(imagePath); err == nil {
+			pulled++
+		}
+	}
+	msg := strings.ReplaceAll(messages.MsgInfoImagesPulled, "{{0}}", strconv.Itoa(pulled))
+	logger.LogInfoWithLevel(strings.ReplaceAll(msg, "{{1}}", strconv.Itoa(len(imagePaths))), logger.InfoLevel)
+	if pulled < len(imagePaths) {
+		return ErrPullImages
+	}
+	return nil
+}
+
+func (i *Images) getLanguages(cmd *cobra.Command) ([]languages.Language, error) {
+	values, _ := cmd.PersistentFlags().GetStringSlice("languages")
+	langs := []languages.Language{}
+	for _, value := range values {
+		lang := languages.ParseStringToLanguage(strings.TrimSpace(value))
+		if lang == languages.Unknown {
+			return nil, errors.New(messages.MsgErrorImagesUnknownLanguage + value)
+		}
+		langs = append(langs, lang)
+	}
+	return langs, nil
+}
+
+// getImagePaths returns each image once, yarn audit and npm audit run in the same image
+func (i *Images) getImagePaths(langs []languages.Language) (imagePaths []string) {
+	alreadyAdded := map[string]bool{}
+	for _, image := range formattersImages.ValuesOfLanguages(langs) {
+		imagePath := image.GetImagePath(i.configs.GetToolsConfig())
+		if !alreadyAdded[imagePath] {
+			alreadyAdded[imagePath] = true
+			imagePaths = append(imagePaths, imagePath)
+		}
+	}
+	return imagePaths
+}
+
+func (i *Images) setConfig() {
+	i.configs = i.configs.NewConfigsFromCobraAndLoadsCmdGlobalFlags(i.globalCmd)
+	i.configs = i.configs.NewConfigsFromViper()
+	i.configs = i.configs.NewConfigsFromEnvironments()
+	i.configs.NormalizeConfigs()
+}
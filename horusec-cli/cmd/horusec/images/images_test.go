@@ -0,0 +1,108 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/python/bandit"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/python/safety"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newGlobalCmd(configFilePath string) *cobra.Command {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "", "")
+	_ = globalCmd.PersistentFlags().String("config-file-path", configFilePath, "")
+	return globalCmd
+}
+
+func TestNewImagesCommand(t *testing.T) {
+	t.Run("Should run NewImagesCommand and return type correctly", func(t *testing.T) {
+		assert.IsType(t, &Images{}, NewImagesCommand(&config.Config{}))
+	})
+}
+
+func TestImages_Pull(t *testing.T) {
+	dir, err := ioutil.TempDir("", "images")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFilePath := filepath.Join(dir, "horusec-config.json")
+	banditImage := "docker.io/" + bandit.ImageName + ":" + bandit.ImageTag
+	safetyImage := "docker.io/" + safety.ImageName + ":" + safety.ImageTag
+
+	t.Run("Should pull the images of the languages informed", func(t *testing.T) {
+		dockerMock := &docker.Mock{}
+		dockerMock.On("PullImage", mock.Anything).Return(nil)
+
+		images := &Images{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), dockerAPI: dockerMock}
+		cmd := images.CreateCobraCmd()
+		cmd.SetArgs([]string{"pull", "--languages", "python"})
+
+		assert.NoError(t, cmd.Execute())
+		dockerMock.AssertNumberOfCalls(t, "PullImage", 2)
+		dockerMock.AssertCalled(t, "PullImage", safetyImage)
+		dockerMock.AssertCalled(t, "PullImage", banditImage)
+	})
+
+	t.Run("Should pull each image once when no language is informed", func(t *testing.T) {
+		dockerMock := &docker.Mock{}
+		dockerMock.On("PullImage", mock.Anything).Return(nil)
+
+		images := &Images{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), dockerAPI: dockerMock}
+		cmd := images.CreateCobraCmd()
+		cmd.SetArgs([]string{"pull"})
+
+		assert.NoError(t, cmd.Execute())
+		pulled := map[string]bool{}
+		for _, call := range dockerMock.Calls {
+			imagePath := call.Arguments.String(0)
+			assert.False(t, pulled[imagePath], imagePath)
+			pulled[imagePath] = true
+		}
+	})
+
+	t.Run("Should return error when some image is not pulled", func(t *testing.T) {
+		dockerMock := &docker.Mock{}
+		dockerMock.On("PullImage", banditImage).Return(errors.New("test"))
+		dockerMock.On("PullImage", safetyImage).Return(nil)
+
+		images := &Images{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), dockerAPI: dockerMock}
+		cmd := images.CreateCobraCmd()
+		cmd.SetArgs([]string{"pull", "-l", "Python"})
+
+		assert.Equal(t, ErrPullImages, cmd.Execute())
+		dockerMock.AssertCalled(t, "PullImage", safetyImage)
+	})
+
+	t.Run("Should return error when the language is not supported", func(t *testing.T) {
+		dockerMock := &docker.Mock{}
+
+		images := &Images{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), dockerAPI: dockerMock}
+		cmd := images.CreateCobraCmd()
+		cmd.SetArgs([]string{"pull", "--languages", "Cobol"})
+
+		assert.Error(t, cmd.Execute())
+		dockerMock.AssertNotCalled(t, "PullImage", mock.Anything)
+	})
+}
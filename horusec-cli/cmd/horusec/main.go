@@ -15,15 +15,36 @@
 package main
 
 import (
+	"errors"
+
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/bench"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/clean"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/completion"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/db"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/docs"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/fix"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/flushqueue"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/image"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/images"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/report"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/review"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/rules"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/server"
 	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/start"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/tools"
 	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/version"
 	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/analyser"
 	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/requirements"
+	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
 	"github.com/spf13/cobra"
 	"os"
 )
 
+// exitCodeTimeout tells the pipelines that the analysis timed out, but the partial report was written
+const exitCodeTimeout = 3
+
 var configs = config.NewConfig()
 var rootCmd = &cobra.Command{
 	Use:   "horusec",
@@ -36,30 +57,106 @@ var rootCmd = &cobra.Command{
 	Example: `
 horusec start
 horusec start -p="/home/user/projects/my-project"
+horusec review ./horusec-report.json
+horusec fix --dependencies ./horusec-report.json
+horusec report diff ./old.json ./new.json
+horusec flush-queue -a="REPOSITORY_TOKEN"
+horusec clean -p="/home/user/projects"
+horusec server --schedules-file="./schedules.json"
+horusec image scan alpine:3.10
+horusec images pull --languages="Go,Python"
+horusec tools export --format="cyclonedx"
+horusec db history --store-local-db="./horusec.db"
+horusec bench -p="./" --runs=5
+horusec completion bash
+horusec docs man --dir="./man"
 `,
 }
 
 // nolint
 func init() {
 	startCmd := start.NewStartCommand(configs)
+	reviewCmd := review.NewReviewCommand(configs)
+	flushQueueCmd := flushqueue.NewFlushQueueCommand(configs)
+	serverCmd := server.NewServerCommand(configs)
+	imageCmd := image.NewImageCommand(configs)
+	fixCmd := fix.NewFixCommand(configs)
+	rulesCmd := rules.NewRulesCommand(configs)
+	cleanCmd := clean.NewCleanCommand(configs)
+	imagesCmd := images.NewImagesCommand(configs)
+	toolsCmd := tools.NewToolsCommand(configs)
+	dbCmd := db.NewDBCommand(configs)
+	benchCmd := bench.NewBenchCommand(configs)
 	_ = rootCmd.PersistentFlags().String("log-level", configs.GetLogLevel(), "Set verbose level of the CLI. Log Level enable is: \"panic\",\"fatal\",\"error\",\"warn\",\"info\",\"debug\",\"trace\"")
 	_ = rootCmd.PersistentFlags().String("config-file-path", configs.GetConfigFilePath(), "Path of the file horusec-config.json to setup content of horusec")
 	rootCmd.AddCommand(version.NewVersionCommand().CreateCobraCmd())
 	rootCmd.AddCommand(startCmd.CreateStartCommand())
+	rootCmd.AddCommand(reviewCmd.CreateCobraCmd())
+	rootCmd.AddCommand(completion.NewCompletionCommand().CreateCobraCmd())
+	rootCmd.AddCommand(docs.NewDocsCommand().CreateCobraCmd())
+	rootCmd.AddCommand(report.NewReportCommand().CreateCobraCmd())
+	rootCmd.AddCommand(flushQueueCmd.CreateCobraCmd())
+	rootCmd.AddCommand(serverCmd.CreateCobraCmd())
+	rootCmd.AddCommand(imageCmd.CreateCobraCmd())
+	rootCmd.AddCommand(fixCmd.CreateCobraCmd())
+	rootCmd.AddCommand(rulesCmd.CreateCobraCmd())
+	rootCmd.AddCommand(cleanCmd.CreateCobraCmd())
+	rootCmd.AddCommand(imagesCmd.CreateCobraCmd())
+	rootCmd.AddCommand(toolsCmd.CreateCobraCmd())
+	rootCmd.AddCommand(dbCmd.CreateCobraCmd())
+	rootCmd.AddCommand(benchCmd.CreateCobraCmd())
+	_ = rootCmd.RegisterFlagCompletionFunc("log-level",
+		completion.CompleteValues("panic", "fatal", "error", "warn", "info", "debug", "trace"))
 	cobra.OnInitialize(func() {
 		startCmd.SetGlobalCmd(rootCmd)
+		reviewCmd.SetGlobalCmd(rootCmd)
+		flushQueueCmd.SetGlobalCmd(rootCmd)
+		serverCmd.SetGlobalCmd(rootCmd)
+		imageCmd.SetGlobalCmd(rootCmd)
+		fixCmd.SetGlobalCmd(rootCmd)
+		rulesCmd.SetGlobalCmd(rootCmd)
+		cleanCmd.SetGlobalCmd(rootCmd)
+		imagesCmd.SetGlobalCmd(rootCmd)
+		toolsCmd.SetGlobalCmd(rootCmd)
+		dbCmd.SetGlobalCmd(rootCmd)
+		benchCmd.SetGlobalCmd(rootCmd)
 	})
 }
 
+// Commands that don't run containers, the completion commands run on each tab pressed in the shell
+var commandsWithoutDocker = []string{
+	"clean", "completion", "db", "docs", "fix", "flush-queue", "help", "report", "rules", "tools", "version",
+	cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd,
+}
+
 func main() {
-	requirements.NewRequirements().ValidateDocker()
+	if isToValidateDocker(os.Args) {
+		dockerClient.DiscoverDockerHost()
+		requirements.NewRequirements().ValidateDocker()
+	}
 	ExecuteCobra()
 }
 
+func isToValidateDocker(args []string) bool {
+	if len(args) < 2 {
+		return true
+	}
+	for _, command := range commandsWithoutDocker {
+		if args[1] == command {
+			return false
+		}
+	}
+	return true
+}
+
 func ExecuteCobra() {
-	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
-	} else {
+	err := rootCmd.Execute()
+	switch {
+	case err == nil:
 		os.Exit(0)
+	case errors.Is(err, analyser.ErrAnalysisTimeout):
+		os.Exit(exitCodeTimeout)
+	default:
+		os.Exit(1)
 	}
 }
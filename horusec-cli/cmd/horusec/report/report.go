@@ -0,0 +1,129 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/completion"
+	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/reportdiff"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/spf13/cobra"
+)
+
+type IReport interface {
+	CreateCobraCmd() *cobra.Command
+}
+
+type Report struct {
+}
+
+func NewReportCommand() IReport {
+	return &Report{}
+}
+
+func (r *Report) CreateCobraCmd() *cobra.Command {
+	reportCmd := &cobra.Command{
+		Use:     "report",
+		Short:   "Work with the json reports generated by horusec",
+		Example: "horusec report diff ./old.json ./new.json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+	reportCmd.AddCommand(r.createDiffCmd())
+	return reportCmd
+}
+
+func (r *Report) createDiffCmd() *cobra.Command {
+	diffCmd := &cobra.Command{
+		Use:   "diff [old json report] [new json report]",
+		Short: "Compare the vulnerabilities of two json reports",
+		Long: "Compare the vulnerabilities of two reports generated with the output format json, showing the new, " +
+			"fixed and persistent vulnerabilities and the changes of the totals by severity and by tool",
+		Example: "horusec report diff ./v1.json ./v2.json -o=\"markdown\" -O=\"./diff.md\"",
+		Args:    cobra.ExactArgs(2),
+		RunE:    r.runDiffE,
+	}
+	_ = diffCmd.Flags().StringP("output-format", "o", reportdiff.Text,
+		"The output format of the diff: "+strings.Join(reportdiff.OutputFormats(), ", "))
+	_ = diffCmd.Flags().StringP("output", "O", "", "Path of the file where the diff is written, by default it is printed")
+	_ = diffCmd.RegisterFlagCompletionFunc("output-format", completion.CompleteValues(reportdiff.OutputFormats()...))
+	return diffCmd
+}
+
+func (r *Report) runDiffE(cmd *cobra.Command, args []string) error {
+	outputFormat, _ := cmd.Flags().GetString("output-format")
+	if !r.isValidOutputFormat(outputFormat) {
+		return fmt.Errorf("%s%s", messages.MsgErrorInvalidReportDiffFormat, strings.Join(reportdiff.OutputFormats(), ", "))
+	}
+	oldAnalysis, err := r.readReport(args[0])
+	if err != nil {
+		return err
+	}
+	newAnalysis, err := r.readReport(args[1])
+	if err != nil {
+		return err
+	}
+	output, _ := cmd.Flags().GetString("output")
+	return r.writeDiff(cmd.OutOrStdout(), output, outputFormat, reportdiff.NewDiff(oldAnalysis, newAnalysis))
+}
+
+func (r *Report) writeDiff(stdout io.Writer, output, outputFormat string, diff *reportdiff.Diff) error {
+	if output == "" {
+		return diff.Write(stdout, outputFormat)
+	}
+	file, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWriteReportDiff, err, logger.ErrorLevel)
+		return err
+	}
+	defer file.Close()
+	if err := diff.Write(file, outputFormat); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWriteReportDiff, err, logger.ErrorLevel)
+		return err
+	}
+	return nil
+}
+
+func (r *Report) isValidOutputFormat(outputFormat string) bool {
+	for _, format := range reportdiff.OutputFormats() {
+		if format == outputFormat {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Report) readReport(reportPath string) (*horusec.Analysis, error) {
+	content, err := ioutil.ReadFile(reportPath)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadReportToDiff+reportPath, err, logger.ErrorLevel)
+		return nil, err
+	}
+	analysis := &horusec.Analysis{}
+	if err := json.Unmarshal(content, analysis); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadReportToDiff+reportPath, err, logger.ErrorLevel)
+		return nil, err
+	}
+	return analysis, nil
+}
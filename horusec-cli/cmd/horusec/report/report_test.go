@@ -0,0 +1,76 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReportCommand(t *testing.T) {
+	t.Run("Should run NewReportCommand and return type correctly", func(t *testing.T) {
+		assert.IsType(t, &Report{}, NewReportCommand())
+	})
+}
+
+func TestReport_Diff(t *testing.T) {
+	dir, err := ioutil.TempDir("", "report")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	oldReport := filepath.Join(dir, "old.json")
+	newReport := filepath.Join(dir, "new.json")
+	assert.NoError(t, ioutil.WriteFile(oldReport,
+		[]byte(`{"analysisVulnerabilities": [{"vulnerabilities": {"vulnHash": "fixed"}}]}`), 0600))
+	assert.NoError(t, ioutil.WriteFile(newReport, []byte(`{"analysisVulnerabilities": []}`), 0600))
+
+	t.Run("Should print the diff of the reports", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		cmd := NewReportCommand().CreateCobraCmd()
+		cmd.SetOut(output)
+		cmd.SetArgs([]string{"diff", oldReport, newReport})
+
+		assert.NoError(t, cmd.Execute())
+		assert.Contains(t, output.String(), "Security posture: improved")
+	})
+
+	t.Run("Should write the diff in the output file", func(t *testing.T) {
+		cmd := NewReportCommand().CreateCobraCmd()
+		cmd.SetArgs([]string{"diff", oldReport, newReport, "-o", "markdown", "-O", filepath.Join(dir, "diff.md")})
+
+		assert.NoError(t, cmd.Execute())
+		content, err := ioutil.ReadFile(filepath.Join(dir, "diff.md"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "# Horusec report diff")
+	})
+
+	t.Run("Should return error when the output format is not valid", func(t *testing.T) {
+		cmd := NewReportCommand().CreateCobraCmd()
+		cmd.SetArgs([]string{"diff", oldReport, newReport, "-o", "xml"})
+
+		assert.Error(t, cmd.Execute())
+	})
+
+	t.Run("Should return error when the report not exists", func(t *testing.T) {
+		cmd := NewReportCommand().CreateCobraCmd()
+		cmd.SetArgs([]string{"diff", oldReport, filepath.Join(dir, "not-exists.json")})
+
+		assert.Error(t, cmd.Execute())
+	})
+}
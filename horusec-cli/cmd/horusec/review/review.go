@@ -0,0 +1,97 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package review
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/triage"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/spf13/cobra"
+)
+
+type IReview interface {
+	SetGlobalCmd(globalCmd *cobra.Command)
+	CreateCobraCmd() *cobra.Command
+}
+
+type Review struct {
+	configs   config.IConfig
+	globalCmd *cobra.Command
+	triage    triage.Interface
+}
+
+func NewReviewCommand(configs config.IConfig) IReview {
+	return &Review{
+		configs:   configs,
+		globalCmd: &cobra.Command{},
+	}
+}
+
+func (r *Review) SetGlobalCmd(globalCmd *cobra.Command) {
+	r.globalCmd = globalCmd
+}
+
+func (r *Review) CreateCobraCmd() *cobra.Command {
+	reviewCmd := &cobra.Command{
+		Use:   "review [json report]",
+		Short: "Review the vulnerabilities of a json report",
+		Long: "Browse the vulnerabilities of a report generated with the output format json and mark them " +
+			"as false positive or risk accepted, saving the hashes in the config file",
+		Example: "horusec review ./horusec-report.json",
+		Args:    cobra.ExactArgs(1),
+		RunE:    r.runE,
+	}
+	_ = reviewCmd.PersistentFlags().
+		StringP("project-path", "p", r.configs.GetProjectPath(),
+			"Path of the project analyzed, used to show the code around the vulnerabilities")
+	return reviewCmd
+}
+
+func (r *Review) runE(cmd *cobra.Command, args []string) error {
+	r.setConfig(cmd)
+	analysis, err := r.readReport(args[0])
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadReportToReview, err, logger.ErrorLevel)
+		return err
+	}
+	if r.triage == nil {
+		r.triage = triage.NewTriage(r.configs)
+	}
+	return r.triage.StartTriage(analysis)
+}
+
+func (r *Review) setConfig(cmd *cobra.Command) {
+	r.configs = r.configs.NewConfigsFromCobraAndLoadsCmdGlobalFlags(r.globalCmd)
+	r.configs = r.configs.NewConfigsFromViper()
+	r.configs = r.configs.NewConfigsFromEnvironments()
+	if projectPath, err := cmd.PersistentFlags().GetString("project-path"); err == nil && projectPath != "" {
+		r.configs.SetProjectPath(projectPath)
+	}
+	r.configs.NormalizeConfigs()
+}
+
+func (r *Review) readReport(reportPath string) (*horusec.Analysis, error) {
+	content, err := ioutil.ReadFile(reportPath)
+	if err != nil {
+		return nil, err
+	}
+	analysis := &horusec.Analysis{}
+	return analysis, json.Unmarshal(content, analysis)
+}
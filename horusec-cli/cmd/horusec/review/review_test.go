@@ -0,0 +1,74 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package review
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/triage"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGlobalCmd(configFilePath string) *cobra.Command {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "", "")
+	_ = globalCmd.PersistentFlags().String("config-file-path", configFilePath, "")
+	return globalCmd
+}
+
+func TestNewReviewCommand(t *testing.T) {
+	t.Run("Should run NewReviewCommand and return type correctly", func(t *testing.T) {
+		assert.IsType(t, &Review{}, NewReviewCommand(&config.Config{}))
+	})
+}
+
+func TestReview_Execute(t *testing.T) {
+	dir, err := ioutil.TempDir("", "review")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFilePath := filepath.Join(dir, "horusec-config.json")
+
+	t.Run("Should start the triage with the vulnerabilities of the report", func(t *testing.T) {
+		reportPath := filepath.Join(dir, "report.json")
+		assert.NoError(t, ioutil.WriteFile(reportPath,
+			[]byte(`{"analysisVulnerabilities": [{"vulnerabilities": {"vulnHash": "hash"}}]}`), 0600))
+		triageMock := &triage.Mock{}
+		triageMock.On("StartTriage").Return(nil)
+
+		review := &Review{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), triage: triageMock}
+		cmd := review.CreateCobraCmd()
+		cmd.SetArgs([]string{reportPath, "-p", dir})
+
+		assert.NoError(t, cmd.Execute())
+		triageMock.AssertCalled(t, "StartTriage")
+		assert.Equal(t, dir, review.configs.GetProjectPath())
+	})
+
+	t.Run("Should return error when the report is not a json", func(t *testing.T) {
+		reportPath := filepath.Join(dir, "invalid.json")
+		assert.NoError(t, ioutil.WriteFile(reportPath, []byte("invalid"), 0600))
+
+		review := &Review{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), triage: &triage.Mock{}}
+		cmd := review.CreateCobraCmd()
+		cmd.SetArgs([]string{reportPath})
+
+		assert.Error(t, cmd.Execute())
+	})
+}
@@ -0,0 +1,165 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/rulepacks"
+	"github.com/spf13/cobra"
+)
+
+var ErrRulesTestFailed = errors.New("{HORUSEC_CLI} the findings of some rules are different of the fixtures")
+
+type IRules interface {
+	SetGlobalCmd(globalCmd *cobra.Command)
+	CreateCobraCmd() *cobra.Command
+}
+
+type Rules struct {
+	configs          config.IConfig
+	globalCmd        *cobra.Command
+	rulePacksService rulepacks.Interface
+}
+
+func NewRulesCommand(configs config.IConfig) IRules {
+	return &Rules{
+		configs:   configs,
+		globalCmd: &cobra.Command{},
+	}
+}
+
+func (r *Rules) SetGlobalCmd(globalCmd *cobra.Command) {
+	r.globalCmd = globalCmd
+}
+
+func (r *Rules) CreateCobraCmd() *cobra.Command {
+	rulesCmd := &cobra.Command{
+		Use:   "rules",
+		Short: "Manage and test the rule packs of the horusec engines",
+		Long: "Manage the versioned rule packs of the horusec engines, that are selected and pinned in the analysis " +
+			"with the flag rule-packs, and test the custom rule packs with fixtures",
+		Example: "horusec rules update --index-url=\"https://example.com/rule-packs/index.json\" " +
+			"--public-key=\"PUBLIC_KEY\"",
+	}
+	rulesCmd.AddCommand(r.createUpdateCmd())
+	rulesCmd.AddCommand(r.createTestCmd())
+	return rulesCmd
+}
+
+func (r *Rules) createUpdateCmd() *cobra.Command {
+	updateCmd := &cobra.Command{
+		Use:   "update",
+		Short: "Download the newer rule packs of the remote index",
+		Long: "Download the rule packs of the remote index that aren't in the rule packs dir. The index is only " +
+			"used when its signature, read from the url of the index with the suffix .sig, is valid to the public key",
+		Example: "horusec rules update --index-url=\"https://example.com/rule-packs/index.json\" " +
+			"--public-key=\"PUBLIC_KEY\"",
+		Args: cobra.NoArgs,
+		RunE: r.runUpdate,
+	}
+	_ = updateCmd.PersistentFlags().
+		String("index-url", r.configs.GetRulePacksIndexURL(), "The url of the index of the rule packs")
+	_ = updateCmd.PersistentFlags().
+		String("public-key", r.configs.GetRulePacksPublicKey(), "The ed25519 public key in base64 of the index")
+	_ = updateCmd.PersistentFlags().
+		String("rule-packs-dir", r.configs.GetRulePacksDir(), "Directory where the rule packs are downloaded")
+	return updateCmd
+}
+
+func (r *Rules) runUpdate(cmd *cobra.Command, _ []string) error {
+	r.setConfig(cmd)
+	if r.rulePacksService == nil {
+		r.rulePacksService = rulepacks.NewRulePacks(r.configs)
+	}
+	downloaded, err := r.rulePacksService.Update()
+	message := strings.ReplaceAll(messages.MsgInfoRulePacksUpdated, "{{0}}", strconv.Itoa(len(downloaded)))
+	logger.LogInfoWithLevel(strings.ReplaceAll(message, "{{1}}", r.configs.GetRulePacksDir())+
+		strings.Join(downloaded, ", "), logger.InfoLevel)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorUpdateRulePacks, err, logger.ErrorLevel)
+	}
+	return err
+}
+
+func (r *Rules) createTestCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "test <dir>",
+		Short: "Test the custom rule packs with the fixtures of the directory",
+		Long: "Run the rules of the rule packs of the directory against the other files of the directory, reporting " +
+			"each rule that found different lines of the annotations of the fixtures, like // expect: HS-CUSTOM-1 " +
+			"in the line of the finding or in the line before it",
+		Example: "horusec rules test ./rules",
+		Args:    cobra.ExactArgs(1),
+		RunE:    r.runTest,
+	}
+}
+
+func (r *Rules) runTest(_ *cobra.Command, args []string) error {
+	if r.rulePacksService == nil {
+		r.rulePacksService = rulepacks.NewRulePacks(r.configs)
+	}
+	results, err := r.rulePacksService.Test(args[0])
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorRulesTest, err, logger.ErrorLevel)
+		return err
+	}
+	passed := true
+	for index := range results {
+		passed = r.logRuleResult(&results[index]) && passed
+	}
+	if !passed {
+		return ErrRulesTestFailed
+	}
+	return nil
+}
+
+func (r *Rules) logRuleResult(result *rulepacks.RuleResult) bool {
+	if result.Passed() {
+		message := strings.ReplaceAll(messages.MsgInfoRuleTestPassed, "{{0}}", result.ID)
+		logger.LogInfoWithLevel(strings.ReplaceAll(message, "{{1}}", strconv.Itoa(len(result.Expected))),
+			logger.InfoLevel)
+		return true
+	}
+	message := strings.ReplaceAll(messages.MsgWarnRuleTestFailed, "{{0}}", result.ID)
+	message = strings.ReplaceAll(message, "{{1}}", strings.Join(result.Missing, ", "))
+	logger.LogWarnWithLevel(strings.ReplaceAll(message, "{{2}}", strings.Join(result.Unexpected, ", ")),
+		logger.WarnLevel)
+	return false
+}
+
+func (r *Rules) setConfig(cmd *cobra.Command) {
+	r.configs = r.configs.NewConfigsFromCobraAndLoadsCmdGlobalFlags(r.globalCmd)
+	r.configs = r.configs.NewConfigsFromViper()
+	r.configs = r.configs.NewConfigsFromEnvironments()
+	if cmd.PersistentFlags().Changed("index-url") {
+		indexURL, _ := cmd.PersistentFlags().GetString("index-url")
+		r.configs.SetRulePacksIndexURL(indexURL)
+	}
+	if cmd.PersistentFlags().Changed("public-key") {
+		publicKey, _ := cmd.PersistentFlags().GetString("public-key")
+		r.configs.SetRulePacksPublicKey(publicKey)
+	}
+	if cmd.PersistentFlags().Changed("rule-packs-dir") {
+		rulePacksDir, _ := cmd.PersistentFlags().GetString("rule-packs-dir")
+		r.configs.SetRulePacksDir(rulePacksDir)
+	}
+	r.configs.NormalizeConfigs()
+}
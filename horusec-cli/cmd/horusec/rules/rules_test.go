@@ -0,0 +1,126 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/rulepacks"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGlobalCmd(configFilePath string) *cobra.Command {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "", "")
+	_ = globalCmd.PersistentFlags().String("config-file-path", configFilePath, "")
+	return globalCmd
+}
+
+func TestNewRulesCommand(t *testing.T) {
+	t.Run("Should run NewRulesCommand and return type correctly", func(t *testing.T) {
+		assert.IsType(t, &Rules{}, NewRulesCommand(&config.Config{}))
+	})
+}
+
+func TestRules_Update(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rules")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFilePath := filepath.Join(dir, "horusec-config.json")
+
+	t.Run("Should download the rule packs of the index", func(t *testing.T) {
+		serviceMock := &rulepacks.Mock{}
+		serviceMock.On("Update").Return([]string{"java-core@1.4"}, nil)
+
+		rules := &Rules{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath),
+			rulePacksService: serviceMock}
+		cmd := rules.CreateCobraCmd()
+		cmd.SetArgs([]string{"update", "--index-url", "http://localhost/index.json", "--public-key", "key",
+			"--rule-packs-dir", dir})
+
+		assert.NoError(t, cmd.Execute())
+		serviceMock.AssertCalled(t, "Update")
+		assert.Equal(t, "http://localhost/index.json", rules.configs.GetRulePacksIndexURL())
+		assert.Equal(t, "key", rules.configs.GetRulePacksPublicKey())
+		assert.Equal(t, dir, rules.configs.GetRulePacksDir())
+	})
+
+	t.Run("Should return error when update fails", func(t *testing.T) {
+		serviceMock := &rulepacks.Mock{}
+		serviceMock.On("Update").Return([]string{}, errors.New("test"))
+
+		rules := &Rules{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath),
+			rulePacksService: serviceMock}
+		cmd := rules.CreateCobraCmd()
+		cmd.SetArgs([]string{"update"})
+
+		assert.Error(t, cmd.Execute())
+	})
+}
+
+func TestRules_Test(t *testing.T) {
+	t.Run("Should return no error when all rules passed", func(t *testing.T) {
+		serviceMock := &rulepacks.Mock{}
+		serviceMock.On("Test", "./rules").Return([]rulepacks.RuleResult{
+			{ID: "HS-CUSTOM-1", Expected: []string{"main.js:2"}},
+		}, nil)
+
+		rules := &Rules{configs: config.NewConfig(), rulePacksService: serviceMock}
+		cmd := rules.CreateCobraCmd()
+		cmd.SetArgs([]string{"test", "./rules"})
+
+		assert.NoError(t, cmd.Execute())
+		serviceMock.AssertCalled(t, "Test", "./rules")
+	})
+
+	t.Run("Should return error when some rule failed", func(t *testing.T) {
+		serviceMock := &rulepacks.Mock{}
+		serviceMock.On("Test", "./rules").Return([]rulepacks.RuleResult{
+			{ID: "HS-CUSTOM-1", Expected: []string{"main.js:2"}},
+			{ID: "HS-CUSTOM-2", Unexpected: []string{"main.js:4"}},
+		}, nil)
+
+		rules := &Rules{configs: config.NewConfig(), rulePacksService: serviceMock}
+		cmd := rules.CreateCobraCmd()
+		cmd.SetArgs([]string{"test", "./rules"})
+
+		assert.Equal(t, ErrRulesTestFailed, cmd.Execute())
+	})
+
+	t.Run("Should return error when test fails", func(t *testing.T) {
+		serviceMock := &rulepacks.Mock{}
+		serviceMock.On("Test", "./rules").Return([]rulepacks.RuleResult{}, errors.New("test"))
+
+		rules := &Rules{configs: config.NewConfig(), rulePacksService: serviceMock}
+		cmd := rules.CreateCobraCmd()
+		cmd.SetArgs([]string{"test", "./rules"})
+
+		assert.Error(t, cmd.Execute())
+	})
+
+	t.Run("Should return error without directory", func(t *testing.T) {
+		rules := &Rules{configs: config.NewConfig(), rulePacksService: &rulepacks.Mock{}}
+		cmd := rules.CreateCobraCmd()
+		cmd.SetArgs([]string{"test"})
+
+		assert.Error(t, cmd.Execute())
+	})
+}
@@ -0,0 +1,171 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/env"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	serverController "github.com/ZupIT/horusec/horusec-cli/internal/controllers/server"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/scheduler"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultHost      = "127.0.0.1"
+	defaultPort      = 8005
+	defaultRetention = 10
+	EnvServerToken   = "HORUSEC_CLI_SERVER_TOKEN"
+)
+
+var errServerWithoutToken = errors.New(messages.MsgErrorServerWithoutToken)
+
+type IServer interface {
+	SetGlobalCmd(globalCmd *cobra.Command)
+	CreateCobraCmd() *cobra.Command
+}
+
+type Server struct {
+	configs   config.IConfig
+	globalCmd *cobra.Command
+	scanner   scheduler.Scanner
+	interrupt chan os.Signal
+}
+
+func NewServerCommand(configs config.IConfig) IServer {
+	return &Server{
+		configs:   configs,
+		globalCmd: &cobra.Command{},
+	}
+}
+
+func (s *Server) SetGlobalCmd(globalCmd *cobra.Command) {
+	s.globalCmd = globalCmd
+}
+
+func (s *Server) CreateCobraCmd() *cobra.Command {
+	serverCmd := &cobra.Command{
+		Use:   "server",
+		Short: "Run horusec in server mode, analyzing the projects periodically",
+		Long: "Run horusec in server mode, analyzing the projects of the schedules file on each time matched by their " +
+			"cron expressions. Each project is a path or a git repository, cloned before each analysis. The last reports " +
+			"of each project are kept in the reports directory and served by the endpoint " +
+			"/api/projects/{projectName}/reports, authorized by the token of the server in the header X-Horusec-Authorization",
+		Example: "horusec server --schedules-file=\"./schedules.json\" --port=8005",
+		Args:    cobra.NoArgs,
+		RunE:    s.runE,
+	}
+	_ = serverCmd.PersistentFlags().
+		String("schedules-file", "", "Path of the json file with the list of projects scheduled, "+
+			"like [{\"name\": \"api\", \"path\": \"/src/api\", \"cron\": \"0 2 * * *\"}]")
+	_ = serverCmd.PersistentFlags().
+		String("host", defaultHost, "Host of the http server with the history of reports, "+
+			"use 0.0.0.0 to listen on all interfaces")
+	_ = serverCmd.PersistentFlags().
+		Int("port", defaultPort, "Port of the http server with the history of reports")
+	_ = serverCmd.PersistentFlags().
+		String("token", "", "Token required in the header X-Horusec-Authorization of the requests to the projects "+
+			"and their reports, it can also be informed by the environment variable "+EnvServerToken)
+	_ = serverCmd.PersistentFlags().
+		String("reports-dir", s.getDefaultReportsDir(), "Directory where the reports of the analyses are kept")
+	_ = serverCmd.PersistentFlags().
+		Int("retention", defaultRetention, "Number of reports kept for each project, zero keeps all reports")
+	_ = serverCmd.MarkPersistentFlagRequired("schedules-file")
+	return serverCmd
+}
+
+func (s *Server) runE(cmd *cobra.Command, _ []string) error {
+	s.configs = s.configs.NewConfigsFromCobraAndLoadsCmdGlobalFlags(s.globalCmd)
+	token, _ := cmd.PersistentFlags().GetString("token")
+	if token = env.GetEnvOrDefault(EnvServerToken, token); token == "" {
+		logger.LogErrorWithLevel(messages.MsgErrorStartServer, errServerWithoutToken, logger.ErrorLevel)
+		return errServerWithoutToken
+	}
+	schedulesFilePath, _ := cmd.PersistentFlags().GetString("schedules-file")
+	projects, err := scheduler.LoadProjects(schedulesFilePath)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorLoadSchedules, err, logger.ErrorLevel)
+		return err
+	}
+	if err := s.setScanner(); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorStartServer, err, logger.ErrorLevel)
+		return err
+	}
+	reportsDir, _ := cmd.PersistentFlags().GetString("reports-dir")
+	retention, _ := cmd.PersistentFlags().GetInt("retention")
+	history := scheduler.NewHistory(reportsDir, retention)
+	host, _ := cmd.PersistentFlags().GetString("host")
+	port, _ := cmd.PersistentFlags().GetInt("port")
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	return s.serve(scheduler.NewScheduler(projects, history, s.scanner), history, address, token)
+}
+
+func (s *Server) setScanner() (err error) {
+	if s.scanner == nil {
+		s.scanner, err = scheduler.NewScanner(s.configs.GetLogLevel())
+	}
+	return err
+}
+
+// serve runs the scheduler and the http server until interrupted, waiting the analyses running to finish
+func (s *Server) serve(schedulerService *scheduler.Scheduler, history *scheduler.History,
+	address, token string) error {
+	httpServer := &http.Server{
+		Addr:    address,
+		Handler: serverController.NewRouter(schedulerService, history, token),
+	}
+	stop := make(chan struct{})
+	go schedulerService.Start(stop)
+	go s.shutdownOnInterrupt(httpServer)
+	logger.LogInfoWithLevel(messages.MsgInfoServerListening+address, logger.InfoLevel)
+	err := httpServer.ListenAndServe()
+	close(stop)
+	schedulerService.Wait()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	logger.LogErrorWithLevel(messages.MsgErrorStartServer, err, logger.ErrorLevel)
+	return err
+}
+
+func (s *Server) shutdownOnInterrupt(httpServer *http.Server) {
+	if s.interrupt == nil {
+		s.interrupt = make(chan os.Signal, 1)
+		signal.Notify(s.interrupt, os.Interrupt)
+	}
+	<-s.interrupt
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_ = httpServer.Shutdown(ctx)
+}
+
+func (s *Server) getDefaultReportsDir() string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return filepath.Join(".horusec", "reports")
+	}
+	return filepath.Join(cacheDir, "horusec", "reports")
+}
@@ -0,0 +1,95 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/scheduler"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+type scannerToTest struct{}
+
+func (s *scannerToTest) Scan(_ *scheduler.Project, _ string) error {
+	return nil
+}
+
+func newGlobalCmd() *cobra.Command {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "", "")
+	_ = globalCmd.PersistentFlags().String("config-file-path", "", "")
+	return globalCmd
+}
+
+func TestNewServerCommand(t *testing.T) {
+	t.Run("Should run NewServerCommand and return type correctly", func(t *testing.T) {
+		assert.IsType(t, &Server{}, NewServerCommand(&config.Config{}))
+	})
+}
+
+func TestServer_Execute(t *testing.T) {
+	dir, err := ioutil.TempDir("", "server")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	schedulesFilePath := filepath.Join(dir, "schedules.json")
+	assert.NoError(t, ioutil.WriteFile(schedulesFilePath,
+		[]byte(`[{"name": "api", "path": "/src/api", "cron": "@daily"}]`), 0600))
+
+	t.Run("Should serve until interrupted", func(t *testing.T) {
+		server := &Server{configs: config.NewConfig(), globalCmd: newGlobalCmd(), scanner: &scannerToTest{},
+			interrupt: make(chan os.Signal, 1)}
+		cmd := server.CreateCobraCmd()
+		cmd.SetArgs([]string{"--schedules-file", schedulesFilePath, "--port", "0", "--reports-dir", dir,
+			"--token", "token"})
+
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			server.interrupt <- os.Interrupt
+		}()
+		assert.NoError(t, cmd.Execute())
+	})
+
+	t.Run("Should return error when the schedules file is invalid", func(t *testing.T) {
+		server := &Server{configs: config.NewConfig(), globalCmd: newGlobalCmd(), scanner: &scannerToTest{}}
+		cmd := server.CreateCobraCmd()
+		cmd.SetArgs([]string{"--schedules-file", filepath.Join(dir, "not-exists.json"), "--token", "token"})
+
+		assert.Error(t, cmd.Execute())
+	})
+
+	t.Run("Should return error without the token", func(t *testing.T) {
+		server := &Server{configs: config.NewConfig(), globalCmd: newGlobalCmd(), scanner: &scannerToTest{}}
+		cmd := server.CreateCobraCmd()
+		cmd.SetArgs([]string{"--schedules-file", schedulesFilePath})
+
+		assert.EqualError(t, cmd.Execute(), messages.MsgErrorServerWithoutToken)
+	})
+
+	t.Run("Should return error without the schedules file", func(t *testing.T) {
+		server := &Server{configs: config.NewConfig(), globalCmd: newGlobalCmd()}
+		cmd := server.CreateCobraCmd()
+		cmd.SetArgs([]string{})
+
+		assert.Error(t, cmd.Execute())
+	})
+}
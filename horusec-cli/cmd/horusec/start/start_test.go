@@ -20,6 +20,8 @@ import (
 	"github.com/spf13/cobra"
 	"io/ioutil"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/zip"
@@ -464,3 +466,59 @@ func TestStartCommand_Execute(t *testing.T) {
 		assert.NoError(t, os.RemoveAll(dstZip))
 	})
 }
+
+func TestStartCommand_ExecuteWithRepoURL(t *testing.T) {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "", "")
+	_ = globalCmd.PersistentFlags().String("config-file-path", "", "")
+	repositoryPath, err := ioutil.TempDir("", "repository")
+	assert.NoError(t, err)
+	defer os.RemoveAll(repositoryPath)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(repositoryPath, "main.go"), []byte("package main"), 0600))
+	for _, args := range [][]string{{"init", "-q"}, {"add", "."},
+		{"-c", "user.name=horusec", "-c", "user.email=horusec@zup.com.br", "commit", "-q", "-m", "first commit"}} {
+		gitCmd := exec.Command("git", args...)
+		gitCmd.Dir = repositoryPath
+		assert.NoError(t, gitCmd.Run())
+	}
+
+	t.Run("Should analyze the clone of the repo url without ask and remove it", func(t *testing.T) {
+		promptMock := &prompt.Mock{}
+		configs := config.NewConfig()
+		analyserControllerMock := &analyser.Mock{}
+		analyserControllerMock.On("AnalysisDirectory").Return(0, nil)
+
+		cmd := &Start{
+			useCases:           cli.NewCLIUseCases(),
+			configs:            configs,
+			startPrompt:        promptMock,
+			globalCmd:          globalCmd,
+			analyserController: analyserControllerMock,
+		}
+		cobraCmd := cmd.CreateStartCommand()
+		cobraCmd.SetArgs([]string{"--repo-url", "file://" + repositoryPath})
+
+		assert.NoError(t, cobraCmd.Execute())
+		promptMock.AssertNotCalled(t, "Ask")
+		analyserControllerMock.AssertCalled(t, "AnalysisDirectory")
+		assert.NotEqual(t, repositoryPath, cmd.configs.GetProjectPath())
+		assert.NoDirExists(t, cmd.configs.GetProjectPath())
+	})
+
+	t.Run("Should return error when the clone of the repo url fails", func(t *testing.T) {
+		analyserControllerMock := &analyser.Mock{}
+
+		cmd := &Start{
+			useCases:           cli.NewCLIUseCases(),
+			configs:            config.NewConfig(),
+			startPrompt:        &prompt.Mock{},
+			globalCmd:          globalCmd,
+			analyserController: analyserControllerMock,
+		}
+		cobraCmd := cmd.CreateStartCommand()
+		cobraCmd.SetArgs([]string{"--repo-url", "file://" + repositoryPath, "--branch", "not-exists"})
+
+		assert.Error(t, cobraCmd.Execute())
+		analyserControllerMock.AssertNotCalled(t, "AnalysisDirectory")
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/completion"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/toolsbom"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/spf13/cobra"
+)
+
+type ITools interface {
+	SetGlobalCmd(globalCmd *cobra.Command)
+	CreateCobraCmd() *cobra.Command
+}
+
+type Tools struct {
+	configs      config.IConfig
+	globalCmd    *cobra.Command
+	dockerClient dockerClient.Interface
+}
+
+func NewToolsCommand(configs config.IConfig) ITools {
+	return &Tools{
+		configs:   configs,
+		globalCmd: &cobra.Command{},
+	}
+}
+
+func (t *Tools) SetGlobalCmd(globalCmd *cobra.Command) {
+	t.globalCmd = globalCmd
+}
+
+func (t *Tools) CreateCobraCmd() *cobra.Command {
+	toolsCmd := &cobra.Command{
+		Use:     "tools",
+		Short:   "Work with the tools run by horusec",
+		Example: "horusec tools export --format=\"cyclonedx\"",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+	toolsCmd.AddCommand(t.createExportCmd())
+	return toolsCmd
+}
+
+func (t *Tools) createExportCmd() *cobra.Command {
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the bill of the tools run by horusec",
+		Long: "Export the tools run by horusec with the image of each one, the digest of the image when it is " +
+			"present in the local docker, and the license and the version of the third-party analyzer installed " +
+			"in it. The tools ignored in the tools config are not exported",
+		Example: "horusec tools export\nhorusec tools export --format=\"cyclonedx\" -O=\"./horusec-tools.cdx.json\"",
+		Args:    cobra.NoArgs,
+		RunE:    t.runExportE,
+	}
+	_ = exportCmd.Flags().String("format", toolsbom.JSON,
+		"The format of the export: "+strings.Join(toolsbom.OutputFormats(), ", "))
+	_ = exportCmd.Flags().StringP("output", "O", "", "Path of the file where the export is written, by default "+
+		"it is printed")
+	_ = exportCmd.RegisterFlagCompletionFunc("format", completion.CompleteValues(toolsbom.OutputFormats()...))
+	return exportCmd
+}
+
+func (t *Tools) runExportE(cmd *cobra.Command, _ []string) error {
+	format, _ := cmd.Flags().GetString("format")
+	if !t.isValidFormat(format) {
+		return fmt.Errorf("%s%s", messages.MsgErrorInvalidToolsExportFormat, strings.Join(toolsbom.OutputFormats(), ", "))
+	}
+	t.setConfig()
+	if t.dockerClient == nil {
+		t.dockerClient = dockerClient.NewDockerClient()
+	}
+	output, _ := cmd.Flags().GetString("output")
+	return t.writeBOM(cmd.OutOrStdout(), output, format, toolsbom.NewBOM(t.configs, t.dockerClient))
+}
+
+func (t *Tools) writeBOM(stdout io.Writer, output, format string, bom *toolsbom.BOM) error {
+	if output == "" {
+		return bom.Write(stdout, format)
+	}
+	file, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWriteToolsExport, err, logger.ErrorLevel)
+		return err
+	}
+	defer file.Close()
+	if err := bom.Write(file, format); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWriteToolsExport, err, logger.ErrorLevel)
+		return err
+	}
+	return nil
+}
+
+func (t *Tools) isValidFormat(format string) bool {
+	for _, validFormat := range toolsbom.OutputFormats() {
+		if validFormat == format {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Tools) setConfig() {
+	t.configs = t.configs.NewConfigsFromCobraAndLoadsCmdGlobalFlags(t.globalCmd)
+	t.configs = t.configs.NewConfigsFromViper()
+	t.configs = t.configs.NewConfigsFromEnvironments()
+	t.configs.NormalizeConfigs()
+}
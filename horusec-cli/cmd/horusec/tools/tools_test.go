@@ -0,0 +1,87 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/toolsbom"
+	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/docker/docker/api/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGlobalCmd(configFilePath string) *cobra.Command {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "", "")
+	_ = globalCmd.PersistentFlags().String("config-file-path", configFilePath, "")
+	return globalCmd
+}
+
+func TestNewToolsCommand(t *testing.T) {
+	t.Run("Should run NewToolsCommand and return type correctly", func(t *testing.T) {
+		assert.IsType(t, &Tools{}, NewToolsCommand(&config.Config{}))
+	})
+}
+
+func TestTools_Export(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tools")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	configFilePath := filepath.Join(dir, "horusec-config.json")
+	dockerMock := &dockerClient.Mock{}
+	dockerMock.On("ImageList").Return([]types.ImageSummary{}, errors.New("test"))
+
+	t.Run("Should print the tools in json", func(t *testing.T) {
+		tools := &Tools{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), dockerClient: dockerMock}
+		cmd := tools.CreateCobraCmd()
+		output := &bytes.Buffer{}
+		cmd.SetOut(output)
+		cmd.SetArgs([]string{"export"})
+
+		assert.NoError(t, cmd.Execute())
+		bom := &toolsbom.BOM{}
+		assert.NoError(t, json.Unmarshal(output.Bytes(), bom))
+		assert.NotEmpty(t, bom.Tools)
+	})
+
+	t.Run("Should write the tools in cyclonedx in the output file", func(t *testing.T) {
+		outputPath := filepath.Join(dir, "tools.cdx.json")
+		tools := &Tools{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), dockerClient: dockerMock}
+		cmd := tools.CreateCobraCmd()
+		cmd.SetArgs([]string{"export", "--format", "cyclonedx", "-O", outputPath})
+
+		assert.NoError(t, cmd.Execute())
+		content, err := ioutil.ReadFile(outputPath)
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), `"bomFormat": "CycloneDX"`)
+	})
+
+	t.Run("Should return error when the format is not supported", func(t *testing.T) {
+		tools := &Tools{configs: config.NewConfig(), globalCmd: newGlobalCmd(configFilePath), dockerClient: dockerMock}
+		cmd := tools.CreateCobraCmd()
+		cmd.SetArgs([]string{"export", "--format", "spdx"})
+
+		assert.Error(t, cmd.Execute())
+	})
+}
@@ -15,7 +15,14 @@
 package version
 
 import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/version"
+	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/images"
 	"github.com/spf13/cobra"
 )
 
@@ -24,6 +31,7 @@ type IVersion interface {
 }
 
 type Version struct {
+	dockerClient dockerClient.Interface
 }
 
 func NewVersionCommand() IVersion {
@@ -34,10 +42,38 @@ func (v *Version) CreateCobraCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:     "version",
 		Short:   "Actual version installed of the horusec",
+		Long:    "Actual version installed of the horusec and the images of the tools used in the analysis",
 		Example: "horusec version",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			logger.LogPrint(cmd.Short + " is: {{VERSION_NOT_FOUND}}")
+			logger.LogPrint(cmd.Short + " is: " + version.Version)
+			v.printToolsImages(cmd.OutOrStdout())
 			return nil
 		},
 	}
 }
+
+// printToolsImages shows the image of each tool and its digest when it is present locally, so the scanners
+// versions that produced a result can be reported
+func (v *Version) printToolsImages(output io.Writer) {
+	writer := tabwriter.NewWriter(output, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "\nTOOL\tIMAGE\tLOCAL DIGEST")
+	for _, image := range images.Values() {
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\n", image.Tool.ToString(), image.GetFullImagePath(),
+			v.getLocalDigest(image.GetFullImagePath()))
+	}
+	_ = writer.Flush()
+}
+
+func (v *Version) getLocalDigest(imagePath string) string {
+	if v.dockerClient == nil {
+		v.dockerClient = dockerClient.NewDockerClient()
+	}
+	digest, err := dockerClient.GetLocalDigest(v.dockerClient, imagePath)
+	if err != nil {
+		return "unknown, docker is not available"
+	}
+	if digest == "" {
+		return "not present"
+	}
+	return digest
+}
@@ -15,9 +15,14 @@
 package version
 
 import (
+	"bytes"
+	"errors"
+	"testing"
+
+	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/docker/docker/api/types"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
-	"testing"
 )
 
 func TestVersionCommand_Execute(t *testing.T) {
@@ -35,3 +40,29 @@ func TestVersionCommand_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestVersion_printToolsImages(t *testing.T) {
+	t.Run("Should print the images of the tools with the local digest", func(t *testing.T) {
+		dockerMock := &dockerClient.Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{
+			{ID: "sha256:123", RepoDigests: []string{"horuszup/gosec@sha256:456"}}}, nil)
+		output := &bytes.Buffer{}
+
+		(&Version{dockerClient: dockerMock}).printToolsImages(output)
+
+		assert.Contains(t, output.String(), "docker.io/horuszup/gosec:v1.0.0")
+		assert.Contains(t, output.String(), "sha256:456")
+	})
+
+	t.Run("Should print when the images are not present or docker is not available", func(t *testing.T) {
+		dockerMock := &dockerClient.Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{}, nil).Once()
+		dockerMock.On("ImageList").Return([]types.ImageSummary{}, errors.New("test"))
+		output := &bytes.Buffer{}
+
+		(&Version{dockerClient: dockerMock}).printToolsImages(output)
+
+		assert.Contains(t, output.String(), "not present")
+		assert.Contains(t, output.String(), "unknown, docker is not available")
+	})
+}
@@ -16,15 +16,20 @@ package config
 
 import (
 	"encoding/json"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	utilsJson "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/valueordefault"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/lifecyclehooks"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/spf13/cobra"
+	"io/ioutil"
 	"os"
 	"path"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"time"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
@@ -74,6 +79,109 @@ func (c *Config) NewConfigsFromCobraAndLoadsCmdStartFlags(cmd *cobra.Command) IC
 	c.SetRiskAcceptHashes(c.extractFlagValueStringSlice(cmd, "risk-accept", c.GetRiskAcceptHashes()))
 	c.SetToolsToIgnore(c.extractFlagValueStringSlice(cmd, "tools-ignore", c.GetToolsToIgnore()))
 	c.SetContainerBindProjectPath(c.extractFlagValueString(cmd, "container-bind-project-path", c.GetContainerBindProjectPath()))
+	c.SetEnableSecretVerification(c.extractFlagValueBool(cmd, "enable-secret-verification", c.GetEnableSecretVerification()))
+	c.SetEnableVendoredAndGeneratedCode(c.extractFlagValueBool(cmd, "enable-vendored-and-generated-code", c.GetEnableVendoredAndGeneratedCode()))
+	c.SetSymlinkMode(c.extractFlagValueString(cmd, "symlink-mode", c.GetSymlinkMode()))
+	c.SetMaxParallel(c.extractFlagValueInt64(cmd, "max-parallel", c.GetMaxParallel()))
+	c.SetSourceMode(c.extractFlagValueString(cmd, "source-mode", c.GetSourceMode()))
+	c.SetMaxOutputSizeInMB(c.extractFlagValueInt64(cmd, "max-output-size-mb", c.GetMaxOutputSizeInMB()))
+	c.SetEngineWorkers(c.extractFlagValueInt64(cmd, "engine-workers", c.GetEngineWorkers()))
+	c.SetEngineMemoryLimitInMB(c.extractFlagValueInt64(cmd, "engine-memory-limit-mb", c.GetEngineMemoryLimitInMB()))
+	c.SetEngineRuleTimeoutInMs(c.extractFlagValueInt64(cmd, "engine-rule-timeout-ms", c.GetEngineRuleTimeoutInMs()))
+	c.SetMaxFileSizeInMB(c.extractFlagValueInt64(cmd, "max-file-size-mb", c.GetMaxFileSizeInMB()))
+	c.SetNoCache(c.extractFlagValueBool(cmd, "no-cache", c.GetNoCache()))
+	c.SetCacheDir(c.extractFlagValueString(cmd, "cache-dir", c.GetCacheDir()))
+	c.SetRemoteCacheURL(c.extractFlagValueString(cmd, "remote-cache-url", c.GetRemoteCacheURL()))
+	c.SetRemoteCacheMode(c.extractFlagValueString(cmd, "remote-cache-mode", c.GetRemoteCacheMode()))
+	c.SetInteractive(c.extractFlagValueBool(cmd, "interactive", c.GetInteractive()))
+	c.SetDryRun(c.extractFlagValueBool(cmd, "dry-run", c.GetDryRun()))
+	c.SetEnableTelemetry(c.extractFlagValueBool(cmd, "telemetry", c.GetEnableTelemetry()))
+	c.SetSubmitDependencies(c.extractFlagValueBool(cmd, "submit-dependencies", c.GetSubmitDependencies()))
+	c.SetElasticsearchURL(c.extractFlagValueString(cmd, "elasticsearch-url", c.GetElasticsearchURL()))
+	c.SetElasticsearchIndex(c.extractFlagValueString(cmd, "elasticsearch-index", c.GetElasticsearchIndex()))
+	c.SetElasticsearchAPIKey(c.extractFlagValueString(cmd, "elasticsearch-api-key", c.GetElasticsearchAPIKey()))
+	c.SetLocalDBPath(c.extractFlagValueString(cmd, "store-local-db", c.GetLocalDBPath()))
+	c.SetPostgresURI(c.extractFlagValueString(cmd, "postgres-uri", c.GetPostgresURI()))
+	c.SetPolicyPath(c.extractFlagValueString(cmd, "policy", c.GetPolicyPath()))
+	c.SetPolicyBaselinePath(c.extractFlagValueString(cmd, "policy-baseline", c.GetPolicyBaselinePath()))
+	c.SetRiskWeights(c.extractFlagValueStringToString(cmd, "risk-weights", c.GetRiskWeights()))
+	c.SetMinGrade(c.extractFlagValueString(cmd, "min-grade", c.GetMinGrade()))
+	c.SetMaxFindings(c.extractFlagValueStringToString(cmd, "max-findings", c.GetMaxFindings()))
+	c.SetQueueDir(c.extractFlagValueString(cmd, "queue-dir", c.GetQueueDir()))
+	c.SetTags(c.extractFlagValueStringToString(cmd, "tag", c.GetTags()))
+	c.SetSourceRepositoryURL(c.extractFlagValueString(cmd, "source-repository-url", c.GetSourceRepositoryURL()))
+	c.SetSourceBranch(c.extractFlagValueString(cmd, "source-branch", c.GetSourceBranch()))
+	c.SetSourceCommit(c.extractFlagValueString(cmd, "source-commit", c.GetSourceCommit()))
+	c.SetSourcePullRequest(c.extractFlagValueString(cmd, "source-pull-request", c.GetSourcePullRequest()))
+	c.SetRepoURL(c.extractFlagValueString(cmd, "repo-url", c.GetRepoURL()))
+	c.SetRepoBranch(c.extractFlagValueString(cmd, "branch", c.GetRepoBranch()))
+	c.SetRepoToken(c.extractFlagValueString(cmd, "repo-token", c.GetRepoToken()))
+	c.SetRepoSSHKeyPath(c.extractFlagValueString(cmd, "repo-ssh-key", c.GetRepoSSHKeyPath()))
+	c.SetBaseImageAdvisoriesPath(
+		c.extractFlagValueString(cmd, "base-image-advisories-path", c.GetBaseImageAdvisoriesPath()))
+	c.SetTfPlanPath(c.extractFlagValueString(cmd, "tf-plan", c.GetTfPlanPath()))
+	c.SetRevealSecrets(c.extractFlagValueBool(cmd, "reveal-secrets", c.GetRevealSecrets()))
+	c.SetCodeContextLines(c.extractFlagValueInt64(cmd, "code-context-lines", c.GetCodeContextLines()))
+	c.SetRemediationPath(c.extractFlagValueString(cmd, "remediation-path", c.GetRemediationPath()))
+	c.SetTriageURL(c.extractFlagValueString(cmd, "triage-url", c.GetTriageURL()))
+	c.SetTriageModel(c.extractFlagValueString(cmd, "triage-model", c.GetTriageModel()))
+	c.SetTriageAPIKey(c.extractFlagValueString(cmd, "triage-api-key", c.GetTriageAPIKey()))
+	c.SetMinConfidence(c.extractFlagValueString(cmd, "min-confidence", c.GetMinConfidence()))
+	c.SetFilters(c.extractFlagValueStringSlice(cmd, "filters", c.GetFilters()))
+	c.SetCodeOwnersPath(c.extractFlagValueString(cmd, "code-owners-path", c.GetCodeOwnersPath()))
+	c.SetSplitReportByOwner(c.extractFlagValueBool(cmd, "split-report-by-owner", c.GetSplitReportByOwner()))
+	c.SetOwnerMappings(c.extractFlagValueStringSlice(cmd, "owner-mappings", c.GetOwnerMappings()))
+	c.SetOwnerWebhooks(c.extractFlagValueStringToString(cmd, "owner-webhooks", c.GetOwnerWebhooks()))
+	c.SetTicketsPath(c.extractFlagValueString(cmd, "tickets-path", c.GetTicketsPath()))
+	c.SetTicketsExcludeFromGates(c.extractFlagValueBool(cmd, "tickets-exclude-from-gates",
+		c.GetTicketsExcludeFromGates()))
+	c.SetPublishCheckRun(c.extractFlagValueBool(cmd, "publish-check-run", c.GetPublishCheckRun()))
+	c.SetGiteaURL(c.extractFlagValueString(cmd, "gitea-url", c.GetGiteaURL()))
+	c.SetGiteaToken(c.extractFlagValueString(cmd, "gitea-token", c.GetGiteaToken()))
+	c.SetEnableLeaksEntropy(c.extractFlagValueBool(cmd, "enable-leaks-entropy", c.GetEnableLeaksEntropy()))
+	c.SetLeaksEntropyAllowlistPatterns(c.extractFlagValueStringSlice(cmd, "leaks-entropy-allowlist-patterns",
+		c.GetLeaksEntropyAllowlistPatterns()))
+	c.SetLeaksEntropyAllowlistPaths(c.extractFlagValueStringSlice(cmd, "leaks-entropy-allowlist-paths",
+		c.GetLeaksEntropyAllowlistPaths()))
+	c.SetLeaksEntropyMinLength(c.extractFlagValueInt64(cmd, "leaks-entropy-min-length", c.GetLeaksEntropyMinLength()))
+	c.SetLeaksEntropyBase64Threshold(c.extractFlagValueFloat64(cmd, "leaks-entropy-base64-threshold",
+		c.GetLeaksEntropyBase64Threshold()))
+	c.SetLeaksEntropyHexThreshold(c.extractFlagValueFloat64(cmd, "leaks-entropy-hex-threshold",
+		c.GetLeaksEntropyHexThreshold()))
+	c.SetRemoteCachePublicKey(c.extractFlagValueString(cmd, "remote-cache-public-key", c.GetRemoteCachePublicKey()))
+	c.SetRemoteCachePrivateKey(c.extractFlagValueString(cmd, "remote-cache-private-key", c.GetRemoteCachePrivateKey()))
+	c.SetReportMinSeverity(c.extractFlagValueString(cmd, "report-min-severity", c.GetReportMinSeverity()))
+	c.SetFailThreshold(c.extractFlagValueString(cmd, "fail-threshold", c.GetFailThreshold()))
+	c.SetOsvOfflineDatabasePath(c.extractFlagValueString(cmd, "osv-offline-database-path",
+		c.GetOsvOfflineDatabasePath()))
+	c.SetSeverityMapping(c.extractFlagValueStringToString(cmd, "severity-mapping", c.GetSeverityMapping()))
+	c.SetSeverityTablesPath(c.extractFlagValueString(cmd, "severity-tables-path", c.GetSeverityTablesPath()))
+	c.SetThreadFixFieldMapping(c.extractFlagValueStringToString(cmd, "threadfix-field-mapping",
+		c.GetThreadFixFieldMapping()))
+	c.SetDeterministic(c.extractFlagValueBool(cmd, "deterministic", c.GetDeterministic()))
+	c.SetSignReport(c.extractFlagValueBool(cmd, "sign-report", c.GetSignReport()))
+	c.SetEncryptReport(c.extractFlagValueBool(cmd, "encrypt-report", c.GetEncryptReport()))
+	c.SetReportRecipients(c.extractFlagValueStringSlice(cmd, "recipient", c.GetReportRecipients()))
+	c.SetRedact(c.extractFlagValueBool(cmd, "redact", c.GetRedact()))
+	c.SetEnableWorkDirDiscovery(c.extractFlagValueBool(cmd, "enable-work-dir-discovery", c.GetEnableWorkDirDiscovery()))
+	c.SetTestCodeMode(c.extractFlagValueString(cmd, "test-code-mode", c.GetTestCodeMode()))
+	c.SetTestCodePaths(c.extractFlagValueStringSlice(cmd, "test-code-paths", c.GetTestCodePaths()))
+	c.SetRulePacks(c.extractFlagValueStringSlice(cmd, "rule-packs", c.GetRulePacks()))
+	c.SetRulePacksDir(c.extractFlagValueString(cmd, "rule-packs-dir", c.GetRulePacksDir()))
+	c.SetPrintStyle(c.extractFlagValueString(cmd, "print-style", c.GetPrintStyle()))
+	c.SetQuiet(c.extractFlagValueBool(cmd, "quiet", c.GetQuiet()))
+	c.SetEventHooks(c.extractFlagValueStringSlice(cmd, "event-hooks", c.GetEventHooks()))
+	c.SetLogFilePath(c.extractFlagValueString(cmd, "log-file-path", c.GetLogFilePath()))
+	c.SetLogFileMaxSizeInMB(c.extractFlagValueInt64(cmd, "log-file-max-size-mb", c.GetLogFileMaxSizeInMB()))
+	c.SetLogFileMaxAgeInDays(c.extractFlagValueInt64(cmd, "log-file-max-age-days", c.GetLogFileMaxAgeInDays()))
+	c.SetLogModuleLevels(c.extractFlagValueStringToString(cmd, "log-module-levels", c.GetLogModuleLevels()))
+	c.SetArtifactsDir(c.extractFlagValueString(cmd, "artifacts-dir", c.GetArtifactsDir()))
+	c.SetStrict(c.extractFlagValueBool(cmd, "strict", c.GetStrict()))
+	c.SetTimeout(c.extractFlagValueString(cmd, "timeout", c.GetTimeout()))
+	c.SetWorkDirsStateFile(c.extractFlagValueString(cmd, "work-dirs-state-file", c.GetWorkDirsStateFile()))
+	c.SetOrphanWorkDirsMaxAgeInHours(c.extractFlagValueInt64(cmd, "orphan-work-dirs-max-age-hours", c.GetOrphanWorkDirsMaxAgeInHours()))
+	c.SetDockerDaemonFlavor(c.extractFlagValueString(cmd, "docker-daemon-flavor", c.GetDockerDaemonFlavor()))
+	c.SetFaultInjection(c.extractFlagValueString(cmd, "fault-injection", c.GetFaultInjection()))
 	return c
 }
 
@@ -106,6 +214,104 @@ func (c *Config) NewConfigsFromViper() IConfig {
 	c.SetHeaders(viper.GetStringMapString(c.toLowerCamel(EnvHeaders)))
 	c.SetContainerBindProjectPath(viper.GetString(c.toLowerCamel(EnvContainerBindProjectPath)))
 	c.SetToolsConfig(viper.Get(c.toLowerCamel(EnvToolsConfig)))
+	c.SetEnableSecretVerification(viper.GetBool(c.toLowerCamel(EnvEnableSecretVerification)))
+	c.SetEnableVendoredAndGeneratedCode(viper.GetBool(c.toLowerCamel(EnvEnableVendoredAndGeneratedCode)))
+	c.SetSymlinkMode(viper.GetString(c.toLowerCamel(EnvSymlinkMode)))
+	c.SetMaxParallel(viper.GetInt64(c.toLowerCamel(EnvMaxParallel)))
+	c.SetSourceMode(viper.GetString(c.toLowerCamel(EnvSourceMode)))
+	c.SetMaxOutputSizeInMB(viper.GetInt64(c.toLowerCamel(EnvMaxOutputSizeInMB)))
+	c.SetEngineWorkers(viper.GetInt64(c.toLowerCamel(EnvEngineWorkers)))
+	c.SetEngineMemoryLimitInMB(viper.GetInt64(c.toLowerCamel(EnvEngineMemoryLimitInMB)))
+	c.SetEngineRuleTimeoutInMs(viper.GetInt64(c.toLowerCamel(EnvEngineRuleTimeoutInMs)))
+	c.SetMaxFileSizeInMB(viper.GetInt64(c.toLowerCamel(EnvMaxFileSizeInMB)))
+	c.SetNoCache(viper.GetBool(c.toLowerCamel(EnvNoCache)))
+	c.SetCacheDir(viper.GetString(c.toLowerCamel(EnvCacheDir)))
+	c.SetRemoteCacheURL(viper.GetString(c.toLowerCamel(EnvRemoteCacheURL)))
+	c.SetRemoteCacheMode(viper.GetString(c.toLowerCamel(EnvRemoteCacheMode)))
+	c.SetInteractive(viper.GetBool(c.toLowerCamel(EnvInteractive)))
+	c.SetDryRun(viper.GetBool(c.toLowerCamel(EnvDryRun)))
+	c.SetEnableTelemetry(viper.GetBool(c.toLowerCamel(EnvEnableTelemetry)))
+	c.SetSubmitDependencies(viper.GetBool(c.toLowerCamel(EnvSubmitDependencies)))
+	c.SetElasticsearchURL(viper.GetString(c.toLowerCamel(EnvElasticsearchURL)))
+	c.SetElasticsearchIndex(viper.GetString(c.toLowerCamel(EnvElasticsearchIndex)))
+	c.SetElasticsearchAPIKey(viper.GetString(c.toLowerCamel(EnvElasticsearchAPIKey)))
+	c.SetLocalDBPath(viper.GetString(c.toLowerCamel(EnvLocalDBPath)))
+	c.SetPostgresURI(viper.GetString(c.toLowerCamel(EnvPostgresURI)))
+	c.SetPolicyPath(viper.GetString(c.toLowerCamel(EnvPolicyPath)))
+	c.SetPolicyBaselinePath(viper.GetString(c.toLowerCamel(EnvPolicyBaselinePath)))
+	c.SetRiskWeights(viper.GetStringMapString(c.toLowerCamel(EnvRiskWeights)))
+	c.SetMinGrade(viper.GetString(c.toLowerCamel(EnvMinGrade)))
+	c.SetMaxFindings(viper.GetStringMapString(c.toLowerCamel(EnvMaxFindings)))
+	c.SetQueueDir(viper.GetString(c.toLowerCamel(EnvQueueDir)))
+	c.SetTags(viper.GetStringMapString(c.toLowerCamel(EnvTags)))
+	c.SetSourceRepositoryURL(viper.GetString(c.toLowerCamel(EnvSourceRepositoryURL)))
+	c.SetSourceBranch(viper.GetString(c.toLowerCamel(EnvSourceBranch)))
+	c.SetSourceCommit(viper.GetString(c.toLowerCamel(EnvSourceCommit)))
+	c.SetSourcePullRequest(viper.GetString(c.toLowerCamel(EnvSourcePullRequest)))
+	c.SetRepoURL(viper.GetString(c.toLowerCamel(EnvRepoURL)))
+	c.SetRepoBranch(viper.GetString(c.toLowerCamel(EnvRepoBranch)))
+	c.SetRepoToken(viper.GetString(c.toLowerCamel(EnvRepoToken)))
+	c.SetRepoSSHKeyPath(viper.GetString(c.toLowerCamel(EnvRepoSSHKeyPath)))
+	c.SetBaseImageAdvisoriesPath(viper.GetString(c.toLowerCamel(EnvBaseImageAdvisoriesPath)))
+	c.SetTfPlanPath(viper.GetString(c.toLowerCamel(EnvTfPlanPath)))
+	c.SetRevealSecrets(viper.GetBool(c.toLowerCamel(EnvRevealSecrets)))
+	c.SetCodeContextLines(viper.GetInt64(c.toLowerCamel(EnvCodeContextLines)))
+	c.SetRemediationPath(viper.GetString(c.toLowerCamel(EnvRemediationPath)))
+	c.SetTriageURL(viper.GetString(c.toLowerCamel(EnvTriageURL)))
+	c.SetTriageModel(viper.GetString(c.toLowerCamel(EnvTriageModel)))
+	c.SetTriageAPIKey(viper.GetString(c.toLowerCamel(EnvTriageAPIKey)))
+	c.SetMinConfidence(viper.GetString(c.toLowerCamel(EnvMinConfidence)))
+	c.SetFilters(viper.GetStringSlice(c.toLowerCamel(EnvFilters)))
+	c.SetCodeOwnersPath(viper.GetString(c.toLowerCamel(EnvCodeOwnersPath)))
+	c.SetSplitReportByOwner(viper.GetBool(c.toLowerCamel(EnvSplitReportByOwner)))
+	c.SetOwnerMappings(viper.GetStringSlice(c.toLowerCamel(EnvOwnerMappings)))
+	c.SetOwnerWebhooks(viper.GetStringMapString(c.toLowerCamel(EnvOwnerWebhooks)))
+	c.SetTicketsPath(viper.GetString(c.toLowerCamel(EnvTicketsPath)))
+	c.SetTicketsExcludeFromGates(viper.GetBool(c.toLowerCamel(EnvTicketsExcludeFromGates)))
+	c.SetPublishCheckRun(viper.GetBool(c.toLowerCamel(EnvPublishCheckRun)))
+	c.SetGiteaURL(viper.GetString(c.toLowerCamel(EnvGiteaURL)))
+	c.SetGiteaToken(viper.GetString(c.toLowerCamel(EnvGiteaToken)))
+	c.SetEnableLeaksEntropy(viper.GetBool(c.toLowerCamel(EnvEnableLeaksEntropy)))
+	c.SetLeaksEntropyAllowlistPatterns(viper.GetStringSlice(c.toLowerCamel(EnvLeaksEntropyAllowlistPatterns)))
+	c.SetLeaksEntropyAllowlistPaths(viper.GetStringSlice(c.toLowerCamel(EnvLeaksEntropyAllowlistPaths)))
+	c.SetLeaksEntropyMinLength(viper.GetInt64(c.toLowerCamel(EnvLeaksEntropyMinLength)))
+	c.SetLeaksEntropyBase64Threshold(viper.GetFloat64(c.toLowerCamel(EnvLeaksEntropyBase64Threshold)))
+	c.SetLeaksEntropyHexThreshold(viper.GetFloat64(c.toLowerCamel(EnvLeaksEntropyHexThreshold)))
+	c.SetRemoteCachePublicKey(viper.GetString(c.toLowerCamel(EnvRemoteCachePublicKey)))
+	c.SetRemoteCachePrivateKey(viper.GetString(c.toLowerCamel(EnvRemoteCachePrivateKey)))
+	c.SetReportMinSeverity(viper.GetString(c.toLowerCamel(EnvReportMinSeverity)))
+	c.SetFailThreshold(viper.GetString(c.toLowerCamel(EnvFailThreshold)))
+	c.SetOsvOfflineDatabasePath(viper.GetString(c.toLowerCamel(EnvOsvOfflineDatabasePath)))
+	c.SetSeverityMapping(viper.GetStringMapString(c.toLowerCamel(EnvSeverityMapping)))
+	c.SetSeverityTablesPath(viper.GetString(c.toLowerCamel(EnvSeverityTablesPath)))
+	c.SetThreadFixFieldMapping(viper.GetStringMapString(c.toLowerCamel(EnvThreadFixFieldMapping)))
+	c.SetDeterministic(viper.GetBool(c.toLowerCamel(EnvDeterministic)))
+	c.SetSignReport(viper.GetBool(c.toLowerCamel(EnvSignReport)))
+	c.SetEncryptReport(viper.GetBool(c.toLowerCamel(EnvEncryptReport)))
+	c.SetReportRecipients(viper.GetStringSlice(c.toLowerCamel(EnvReportRecipients)))
+	c.SetRedact(viper.GetBool(c.toLowerCamel(EnvRedact)))
+	c.SetEnableWorkDirDiscovery(viper.GetBool(c.toLowerCamel(EnvEnableWorkDirDiscovery)))
+	c.SetTestCodeMode(viper.GetString(c.toLowerCamel(EnvTestCodeMode)))
+	c.SetTestCodePaths(viper.GetStringSlice(c.toLowerCamel(EnvTestCodePaths)))
+	c.SetRulePacks(viper.GetStringSlice(c.toLowerCamel(EnvRulePacks)))
+	c.SetRulePacksDir(viper.GetString(c.toLowerCamel(EnvRulePacksDir)))
+	c.SetRulePacksIndexURL(viper.GetString(c.toLowerCamel(EnvRulePacksIndexURL)))
+	c.SetRulePacksPublicKey(viper.GetString(c.toLowerCamel(EnvRulePacksPublicKey)))
+	c.SetPrintStyle(viper.GetString(c.toLowerCamel(EnvPrintStyle)))
+	c.SetQuiet(viper.GetBool(c.toLowerCamel(EnvQuiet)))
+	c.SetEventHooks(viper.GetStringSlice(c.toLowerCamel(EnvEventHooks)))
+	c.SetLifecycleHooks(viper.Get(c.toLowerCamel(EnvLifecycleHooks)))
+	c.SetLogFilePath(viper.GetString(c.toLowerCamel(EnvLogFilePath)))
+	c.SetLogFileMaxSizeInMB(viper.GetInt64(c.toLowerCamel(EnvLogFileMaxSizeInMB)))
+	c.SetLogFileMaxAgeInDays(viper.GetInt64(c.toLowerCamel(EnvLogFileMaxAgeInDays)))
+	c.SetLogModuleLevels(viper.GetStringMapString(c.toLowerCamel(EnvLogModuleLevels)))
+	c.SetArtifactsDir(viper.GetString(c.toLowerCamel(EnvArtifactsDir)))
+	c.SetStrict(viper.GetBool(c.toLowerCamel(EnvStrict)))
+	c.SetTimeout(viper.GetString(c.toLowerCamel(EnvTimeout)))
+	c.SetWorkDirsStateFile(viper.GetString(c.toLowerCamel(EnvWorkDirsStateFile)))
+	c.SetOrphanWorkDirsMaxAgeInHours(viper.GetInt64(c.toLowerCamel(EnvOrphanWorkDirsMaxAgeInHours)))
+	c.SetDockerDaemonFlavor(viper.GetString(c.toLowerCamel(EnvDockerDaemonFlavor)))
+	c.SetFaultInjection(viper.GetString(c.toLowerCamel(EnvFaultInjection)))
 	return c
 }
 
@@ -133,6 +339,104 @@ func (c *Config) NewConfigsFromEnvironments() IConfig {
 	c.SetToolsToIgnore(c.factoryParseInputToSliceString(env.GetEnvOrDefaultInterface(EnvToolsToIgnore, c.toolsToIgnore)))
 	c.SetHeaders(env.GetEnvOrDefaultInterface(EnvHeaders, c.headers))
 	c.SetContainerBindProjectPath(env.GetEnvOrDefault(EnvContainerBindProjectPath, c.containerBindProjectPath))
+	c.SetEnableSecretVerification(env.GetEnvOrDefaultBool(EnvEnableSecretVerification, c.enableSecretVerification))
+	c.SetEnableVendoredAndGeneratedCode(env.GetEnvOrDefaultBool(EnvEnableVendoredAndGeneratedCode, c.enableVendoredAndGeneratedCode))
+	c.SetSymlinkMode(env.GetEnvOrDefault(EnvSymlinkMode, c.symlinkMode))
+	c.SetMaxParallel(env.GetEnvOrDefaultInt64(EnvMaxParallel, c.maxParallel))
+	c.SetSourceMode(env.GetEnvOrDefault(EnvSourceMode, c.sourceMode))
+	c.SetMaxOutputSizeInMB(env.GetEnvOrDefaultInt64(EnvMaxOutputSizeInMB, c.maxOutputSizeInMB))
+	c.SetEngineWorkers(env.GetEnvOrDefaultInt64(EnvEngineWorkers, c.engineWorkers))
+	c.SetEngineMemoryLimitInMB(env.GetEnvOrDefaultInt64(EnvEngineMemoryLimitInMB, c.engineMemoryLimitInMB))
+	c.SetEngineRuleTimeoutInMs(env.GetEnvOrDefaultInt64(EnvEngineRuleTimeoutInMs, c.engineRuleTimeoutInMs))
+	c.SetMaxFileSizeInMB(env.GetEnvOrDefaultInt64(EnvMaxFileSizeInMB, c.maxFileSizeInMB))
+	c.SetNoCache(env.GetEnvOrDefaultBool(EnvNoCache, c.noCache))
+	c.SetCacheDir(env.GetEnvOrDefault(EnvCacheDir, c.cacheDir))
+	c.SetRemoteCacheURL(env.GetEnvOrDefault(EnvRemoteCacheURL, c.remoteCacheURL))
+	c.SetRemoteCacheMode(env.GetEnvOrDefault(EnvRemoteCacheMode, c.remoteCacheMode))
+	c.SetInteractive(env.GetEnvOrDefaultBool(EnvInteractive, c.interactive))
+	c.SetDryRun(env.GetEnvOrDefaultBool(EnvDryRun, c.dryRun))
+	c.SetEnableTelemetry(env.GetEnvOrDefaultBool(EnvEnableTelemetry, c.enableTelemetry))
+	c.SetSubmitDependencies(env.GetEnvOrDefaultBool(EnvSubmitDependencies, c.submitDependencies))
+	c.SetElasticsearchURL(env.GetEnvOrDefault(EnvElasticsearchURL, c.elasticsearchURL))
+	c.SetElasticsearchIndex(env.GetEnvOrDefault(EnvElasticsearchIndex, c.elasticsearchIndex))
+	c.SetElasticsearchAPIKey(env.GetEnvOrDefault(EnvElasticsearchAPIKey, c.elasticsearchAPIKey))
+	c.SetLocalDBPath(env.GetEnvOrDefault(EnvLocalDBPath, c.localDBPath))
+	c.SetPostgresURI(env.GetEnvOrDefault(EnvPostgresURI, c.postgresURI))
+	c.SetPolicyPath(env.GetEnvOrDefault(EnvPolicyPath, c.policyPath))
+	c.SetPolicyBaselinePath(env.GetEnvOrDefault(EnvPolicyBaselinePath, c.policyBaselinePath))
+	c.SetRiskWeights(env.GetEnvOrDefaultInterface(EnvRiskWeights, c.riskWeights))
+	c.SetMinGrade(env.GetEnvOrDefault(EnvMinGrade, c.minGrade))
+	c.SetMaxFindings(env.GetEnvOrDefaultInterface(EnvMaxFindings, c.maxFindings))
+	c.SetQueueDir(env.GetEnvOrDefault(EnvQueueDir, c.queueDir))
+	c.SetTags(env.GetEnvOrDefaultInterface(EnvTags, c.tags))
+	c.SetSourceRepositoryURL(env.GetEnvOrDefault(EnvSourceRepositoryURL, c.sourceRepositoryURL))
+	c.SetSourceBranch(env.GetEnvOrDefault(EnvSourceBranch, c.sourceBranch))
+	c.SetSourceCommit(env.GetEnvOrDefault(EnvSourceCommit, c.sourceCommit))
+	c.SetSourcePullRequest(env.GetEnvOrDefault(EnvSourcePullRequest, c.sourcePullRequest))
+	c.SetRepoURL(env.GetEnvOrDefault(EnvRepoURL, c.repoURL))
+	c.SetRepoBranch(env.GetEnvOrDefault(EnvRepoBranch, c.repoBranch))
+	c.SetRepoToken(env.GetEnvOrDefault(EnvRepoToken, c.repoToken))
+	c.SetRepoSSHKeyPath(env.GetEnvOrDefault(EnvRepoSSHKeyPath, c.repoSSHKeyPath))
+	c.SetBaseImageAdvisoriesPath(env.GetEnvOrDefault(EnvBaseImageAdvisoriesPath, c.baseImageAdvisoriesPath))
+	c.SetTfPlanPath(env.GetEnvOrDefault(EnvTfPlanPath, c.tfPlanPath))
+	c.SetRevealSecrets(env.GetEnvOrDefaultBool(EnvRevealSecrets, c.revealSecrets))
+	c.SetCodeContextLines(env.GetEnvOrDefaultInt64(EnvCodeContextLines, c.codeContextLines))
+	c.SetRemediationPath(env.GetEnvOrDefault(EnvRemediationPath, c.remediationPath))
+	c.SetTriageURL(env.GetEnvOrDefault(EnvTriageURL, c.triageURL))
+	c.SetTriageModel(env.GetEnvOrDefault(EnvTriageModel, c.triageModel))
+	c.SetTriageAPIKey(env.GetEnvOrDefault(EnvTriageAPIKey, c.triageAPIKey))
+	c.SetMinConfidence(env.GetEnvOrDefault(EnvMinConfidence, c.minConfidence))
+	c.SetFilters(c.factoryParseInputToSliceString(env.GetEnvOrDefaultInterface(EnvFilters, c.filters)))
+	c.SetCodeOwnersPath(env.GetEnvOrDefault(EnvCodeOwnersPath, c.codeOwnersPath))
+	c.SetSplitReportByOwner(env.GetEnvOrDefaultBool(EnvSplitReportByOwner, c.splitReportByOwner))
+	c.SetOwnerMappings(c.factoryParseInputToSliceString(env.GetEnvOrDefaultInterface(EnvOwnerMappings, c.ownerMappings)))
+	c.SetOwnerWebhooks(env.GetEnvOrDefaultInterface(EnvOwnerWebhooks, c.ownerWebhooks))
+	c.SetTicketsPath(env.GetEnvOrDefault(EnvTicketsPath, c.ticketsPath))
+	c.SetTicketsExcludeFromGates(env.GetEnvOrDefaultBool(EnvTicketsExcludeFromGates, c.ticketsExcludeFromGates))
+	c.SetPublishCheckRun(env.GetEnvOrDefaultBool(EnvPublishCheckRun, c.publishCheckRun))
+	c.SetGiteaURL(env.GetEnvOrDefault(EnvGiteaURL, c.giteaURL))
+	c.SetGiteaToken(env.GetEnvOrDefault(EnvGiteaToken, c.giteaToken))
+	c.SetEnableLeaksEntropy(env.GetEnvOrDefaultBool(EnvEnableLeaksEntropy, c.enableLeaksEntropy))
+	c.SetLeaksEntropyAllowlistPatterns(c.factoryParseInputToSliceString(env.GetEnvOrDefaultInterface(EnvLeaksEntropyAllowlistPatterns, c.leaksEntropyAllowlistPatterns)))
+	c.SetLeaksEntropyAllowlistPaths(c.factoryParseInputToSliceString(env.GetEnvOrDefaultInterface(EnvLeaksEntropyAllowlistPaths, c.leaksEntropyAllowlistPaths)))
+	c.SetLeaksEntropyMinLength(env.GetEnvOrDefaultInt64(EnvLeaksEntropyMinLength, c.leaksEntropyMinLength))
+	c.SetLeaksEntropyBase64Threshold(env.GetEnvOrDefaultFloat64(EnvLeaksEntropyBase64Threshold, c.leaksEntropyBase64Threshold))
+	c.SetLeaksEntropyHexThreshold(env.GetEnvOrDefaultFloat64(EnvLeaksEntropyHexThreshold, c.leaksEntropyHexThreshold))
+	c.SetRemoteCachePublicKey(env.GetEnvOrDefault(EnvRemoteCachePublicKey, c.remoteCachePublicKey))
+	c.SetRemoteCachePrivateKey(env.GetEnvOrDefault(EnvRemoteCachePrivateKey, c.remoteCachePrivateKey))
+	c.SetReportMinSeverity(env.GetEnvOrDefault(EnvReportMinSeverity, c.reportMinSeverity))
+	c.SetFailThreshold(env.GetEnvOrDefault(EnvFailThreshold, c.failThreshold))
+	c.SetOsvOfflineDatabasePath(env.GetEnvOrDefault(EnvOsvOfflineDatabasePath, c.osvOfflineDatabasePath))
+	c.SetSeverityMapping(env.GetEnvOrDefaultInterface(EnvSeverityMapping, c.severityMapping))
+	c.SetSeverityTablesPath(env.GetEnvOrDefault(EnvSeverityTablesPath, c.severityTablesPath))
+	c.SetThreadFixFieldMapping(env.GetEnvOrDefaultInterface(EnvThreadFixFieldMapping, c.threadFixFieldMapping))
+	c.SetDeterministic(env.GetEnvOrDefaultBool(EnvDeterministic, c.deterministic))
+	c.SetSignReport(env.GetEnvOrDefaultBool(EnvSignReport, c.signReport))
+	c.SetEncryptReport(env.GetEnvOrDefaultBool(EnvEncryptReport, c.encryptReport))
+	c.SetReportRecipients(c.factoryParseInputToSliceString(
+		env.GetEnvOrDefaultInterface(EnvReportRecipients, c.reportRecipients)))
+	c.SetRedact(env.GetEnvOrDefaultBool(EnvRedact, c.redact))
+	c.SetEnableWorkDirDiscovery(env.GetEnvOrDefaultBool(EnvEnableWorkDirDiscovery, c.enableWorkDirDiscovery))
+	c.SetTestCodeMode(env.GetEnvOrDefault(EnvTestCodeMode, c.testCodeMode))
+	c.SetTestCodePaths(c.factoryParseInputToSliceString(env.GetEnvOrDefaultInterface(EnvTestCodePaths, c.testCodePaths)))
+	c.SetRulePacks(c.factoryParseInputToSliceString(env.GetEnvOrDefaultInterface(EnvRulePacks, c.rulePacks)))
+	c.SetRulePacksDir(env.GetEnvOrDefault(EnvRulePacksDir, c.rulePacksDir))
+	c.SetRulePacksIndexURL(env.GetEnvOrDefault(EnvRulePacksIndexURL, c.rulePacksIndexURL))
+	c.SetRulePacksPublicKey(env.GetEnvOrDefault(EnvRulePacksPublicKey, c.rulePacksPublicKey))
+	c.SetPrintStyle(env.GetEnvOrDefault(EnvPrintStyle, c.printStyle))
+	c.SetQuiet(env.GetEnvOrDefaultBool(EnvQuiet, c.quiet))
+	c.SetEventHooks(c.factoryParseInputToSliceString(env.GetEnvOrDefaultInterface(EnvEventHooks, c.eventHooks)))
+	c.SetLogFilePath(env.GetEnvOrDefault(EnvLogFilePath, c.logFilePath))
+	c.SetLogFileMaxSizeInMB(env.GetEnvOrDefaultInt64(EnvLogFileMaxSizeInMB, c.logFileMaxSizeInMB))
+	c.SetLogFileMaxAgeInDays(env.GetEnvOrDefaultInt64(EnvLogFileMaxAgeInDays, c.logFileMaxAgeInDays))
+	c.SetLogModuleLevels(env.GetEnvOrDefaultInterface(EnvLogModuleLevels, c.logModuleLevels))
+	c.SetArtifactsDir(env.GetEnvOrDefault(EnvArtifactsDir, c.artifactsDir))
+	c.SetStrict(env.GetEnvOrDefaultBool(EnvStrict, c.strict))
+	c.SetTimeout(env.GetEnvOrDefault(EnvTimeout, c.timeout))
+	c.SetWorkDirsStateFile(env.GetEnvOrDefault(EnvWorkDirsStateFile, c.workDirsStateFile))
+	c.SetOrphanWorkDirsMaxAgeInHours(env.GetEnvOrDefaultInt64(EnvOrphanWorkDirsMaxAgeInHours, c.orphanWorkDirsMaxAgeInHours))
+	c.SetDockerDaemonFlavor(env.GetEnvOrDefault(EnvDockerDaemonFlavor, c.dockerDaemonFlavor))
+	c.SetFaultInjection(env.GetEnvOrDefault(EnvFaultInjection, c.faultInjection))
 	return c
 }
 
@@ -144,6 +448,30 @@ func (c *Config) GetDefaultConfigFilePath() string {
 	return path.Join(currentDir, "horusec-config.json")
 }
 
+func (c *Config) getDefaultCacheDir() string {
+	return filepath.Join(c.getUserCacheDir(), "horusec", "analysis")
+}
+
+func (c *Config) getDefaultQueueDir() string {
+	return filepath.Join(c.getUserCacheDir(), "horusec", "queue")
+}
+
+func (c *Config) getDefaultWorkDirsStateFile() string {
+	return filepath.Join(c.getUserCacheDir(), "horusec", "work-dirs.json")
+}
+
+func (c *Config) getDefaultRulePacksDir() string {
+	return filepath.Join(c.getUserCacheDir(), "horusec", "rule-packs")
+}
+
+func (c *Config) getUserCacheDir() string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return os.TempDir()
+	}
+	return cacheDir
+}
+
 func (c *Config) GetConfigFilePath() string {
 	return valueordefault.GetStringValueOrDefault(c.configFilePath, c.GetDefaultConfigFilePath())
 }
@@ -176,7 +504,11 @@ func (c *Config) SetTimeoutInSecondsRequest(timeoutInSecondsRequest int64) {
 	c.timeoutInSecondsRequest = timeoutInSecondsRequest
 }
 
+// GetTimeoutInSecondsAnalysis returns the seconds of the timeout duration when it is informed
 func (c *Config) GetTimeoutInSecondsAnalysis() int64 {
+	if timeout, err := time.ParseDuration(c.timeout); err == nil && timeout > 0 {
+		return int64(timeout.Seconds())
+	}
 	return valueordefault.GetInt64ValueOrDefault(c.timeoutInSecondsAnalysis, int64(600))
 }
 
@@ -375,6 +707,809 @@ func (c *Config) SetToolsConfig(toolsConfig interface{}) {
 	c.toolsConfig = toolsconfig.ParseInterfaceToMapToolsConfig(toolsConfig)
 }
 
+func (c *Config) GetEnableSecretVerification() bool {
+	return c.enableSecretVerification
+}
+
+func (c *Config) SetEnableSecretVerification(enableSecretVerification bool) {
+	c.enableSecretVerification = enableSecretVerification
+}
+
+func (c *Config) GetEnableVendoredAndGeneratedCode() bool {
+	return c.enableVendoredAndGeneratedCode
+}
+
+func (c *Config) SetEnableVendoredAndGeneratedCode(enableVendoredAndGeneratedCode bool) {
+	c.enableVendoredAndGeneratedCode = enableVendoredAndGeneratedCode
+}
+
+func (c *Config) GetSymlinkMode() string {
+	return valueordefault.GetStringValueOrDefault(c.symlinkMode, cli.SymlinkFollowWithinRoot.ToString())
+}
+
+func (c *Config) SetSymlinkMode(symlinkMode string) {
+	c.symlinkMode = symlinkMode
+}
+
+func (c *Config) GetMaxParallel() int64 {
+	if c.maxParallel <= 0 {
+		return int64(runtime.NumCPU())
+	}
+	return c.maxParallel
+}
+
+func (c *Config) SetMaxParallel(maxParallel int64) {
+	c.maxParallel = maxParallel
+}
+
+func (c *Config) GetSourceMode() string {
+	return valueordefault.GetStringValueOrDefault(c.sourceMode, cli.SourceCopy.ToString())
+}
+
+func (c *Config) SetSourceMode(sourceMode string) {
+	c.sourceMode = sourceMode
+}
+
+func (c *Config) GetMaxOutputSizeInMB() int64 {
+	return valueordefault.GetInt64ValueOrDefault(c.maxOutputSizeInMB, int64(250))
+}
+
+func (c *Config) SetMaxOutputSizeInMB(maxOutputSizeInMB int64) {
+	c.maxOutputSizeInMB = maxOutputSizeInMB
+}
+
+func (c *Config) GetEngineWorkers() int64 {
+	return c.engineWorkers
+}
+
+func (c *Config) SetEngineWorkers(engineWorkers int64) {
+	c.engineWorkers = engineWorkers
+}
+
+func (c *Config) GetEngineMemoryLimitInMB() int64 {
+	return c.engineMemoryLimitInMB
+}
+
+func (c *Config) SetEngineMemoryLimitInMB(engineMemoryLimitInMB int64) {
+	c.engineMemoryLimitInMB = engineMemoryLimitInMB
+}
+
+func (c *Config) GetEngineRuleTimeoutInMs() int64 {
+	return c.engineRuleTimeoutInMs
+}
+
+func (c *Config) SetEngineRuleTimeoutInMs(engineRuleTimeoutInMs int64) {
+	c.engineRuleTimeoutInMs = engineRuleTimeoutInMs
+}
+
+func (c *Config) GetMaxFileSizeInMB() int64 {
+	return c.maxFileSizeInMB
+}
+
+func (c *Config) SetMaxFileSizeInMB(maxFileSizeInMB int64) {
+	c.maxFileSizeInMB = maxFileSizeInMB
+}
+
+func (c *Config) GetNoCache() bool {
+	return c.noCache
+}
+
+func (c *Config) SetNoCache(noCache bool) {
+	c.noCache = noCache
+}
+
+func (c *Config) GetCacheDir() string {
+	return valueordefault.GetStringValueOrDefault(c.cacheDir, c.getDefaultCacheDir())
+}
+
+func (c *Config) SetCacheDir(cacheDir string) {
+	c.cacheDir = cacheDir
+}
+
+func (c *Config) GetRemoteCacheURL() string {
+	return c.remoteCacheURL
+}
+
+func (c *Config) SetRemoteCacheURL(remoteCacheURL string) {
+	c.remoteCacheURL = remoteCacheURL
+}
+
+func (c *Config) GetRemoteCacheMode() string {
+	return valueordefault.GetStringValueOrDefault(c.remoteCacheMode, cli.RemoteCacheReadOnly.ToString())
+}
+
+func (c *Config) SetRemoteCacheMode(remoteCacheMode string) {
+	c.remoteCacheMode = remoteCacheMode
+}
+
+func (c *Config) GetInteractive() bool {
+	return c.interactive
+}
+
+func (c *Config) SetInteractive(interactive bool) {
+	c.interactive = interactive
+}
+
+func (c *Config) GetDryRun() bool {
+	return c.dryRun
+}
+
+func (c *Config) SetDryRun(dryRun bool) {
+	c.dryRun = dryRun
+}
+
+func (c *Config) GetEnableTelemetry() bool {
+	return c.enableTelemetry
+}
+
+func (c *Config) SetEnableTelemetry(enableTelemetry bool) {
+	c.enableTelemetry = enableTelemetry
+}
+
+func (c *Config) GetSubmitDependencies() bool {
+	return c.submitDependencies
+}
+
+func (c *Config) SetSubmitDependencies(submitDependencies bool) {
+	c.submitDependencies = submitDependencies
+}
+
+func (c *Config) GetElasticsearchURL() string {
+	return c.elasticsearchURL
+}
+
+func (c *Config) SetElasticsearchURL(elasticsearchURL string) {
+	c.elasticsearchURL = elasticsearchURL
+}
+
+func (c *Config) GetElasticsearchIndex() string {
+	return valueordefault.GetStringValueOrDefault(c.elasticsearchIndex, "horusec-vulnerabilities")
+}
+
+func (c *Config) SetElasticsearchIndex(elasticsearchIndex string) {
+	c.elasticsearchIndex = elasticsearchIndex
+}
+
+func (c *Config) GetElasticsearchAPIKey() string {
+	return c.elasticsearchAPIKey
+}
+
+func (c *Config) SetElasticsearchAPIKey(elasticsearchAPIKey string) {
+	c.elasticsearchAPIKey = elasticsearchAPIKey
+}
+
+func (c *Config) GetLocalDBPath() string {
+	return c.localDBPath
+}
+
+func (c *Config) SetLocalDBPath(localDBPath string) {
+	c.localDBPath = localDBPath
+}
+
+func (c *Config) GetPostgresURI() string {
+	return c.postgresURI
+}
+
+func (c *Config) SetPostgresURI(postgresURI string) {
+	c.postgresURI = postgresURI
+}
+
+func (c *Config) GetPolicyPath() string {
+	return c.policyPath
+}
+
+func (c *Config) SetPolicyPath(policyPath string) {
+	c.policyPath = policyPath
+}
+
+func (c *Config) GetPolicyBaselinePath() string {
+	return c.policyBaselinePath
+}
+
+func (c *Config) SetPolicyBaselinePath(policyBaselinePath string) {
+	c.policyBaselinePath = policyBaselinePath
+}
+
+func (c *Config) GetRiskWeights() map[string]string {
+	return c.riskWeights
+}
+
+func (c *Config) SetRiskWeights(riskWeights interface{}) {
+	output, err := utilsJson.ConvertInterfaceToMapString(riskWeights)
+	logger.LogErrorWithLevel("Error on marshal riskWeights to bytes", err, logger.PanicLevel)
+	c.riskWeights = output
+}
+
+func (c *Config) GetMinGrade() string {
+	return c.minGrade
+}
+
+func (c *Config) SetMinGrade(minGrade string) {
+	c.minGrade = minGrade
+}
+
+func (c *Config) GetMaxFindings() map[string]string {
+	return c.maxFindings
+}
+
+func (c *Config) SetMaxFindings(maxFindings interface{}) {
+	output, err := utilsJson.ConvertInterfaceToMapString(maxFindings)
+	logger.LogErrorWithLevel("Error on marshal maxFindings to bytes", err, logger.PanicLevel)
+	c.maxFindings = output
+}
+
+func (c *Config) GetQueueDir() string {
+	return valueordefault.GetStringValueOrDefault(c.queueDir, c.getDefaultQueueDir())
+}
+
+func (c *Config) SetQueueDir(queueDir string) {
+	c.queueDir = queueDir
+}
+
+func (c *Config) GetTags() map[string]string {
+	return c.tags
+}
+
+func (c *Config) SetTags(tags interface{}) {
+	output, err := utilsJson.ConvertInterfaceToMapString(tags)
+	logger.LogErrorWithLevel("Error on marshal tags to bytes", err, logger.PanicLevel)
+	c.tags = output
+}
+
+func (c *Config) GetSourceRepositoryURL() string {
+	return c.sourceRepositoryURL
+}
+
+func (c *Config) SetSourceRepositoryURL(sourceRepositoryURL string) {
+	c.sourceRepositoryURL = sourceRepositoryURL
+}
+
+func (c *Config) GetSourceBranch() string {
+	return c.sourceBranch
+}
+
+func (c *Config) SetSourceBranch(sourceBranch string) {
+	c.sourceBranch = sourceBranch
+}
+
+func (c *Config) GetSourceCommit() string {
+	return c.sourceCommit
+}
+
+func (c *Config) SetSourceCommit(sourceCommit string) {
+	c.sourceCommit = sourceCommit
+}
+
+func (c *Config) GetSourcePullRequest() string {
+	return c.sourcePullRequest
+}
+
+func (c *Config) SetSourcePullRequest(sourcePullRequest string) {
+	c.sourcePullRequest = sourcePullRequest
+}
+
+func (c *Config) GetRepoURL() string {
+	return c.repoURL
+}
+
+func (c *Config) SetRepoURL(repoURL string) {
+	c.repoURL = repoURL
+}
+
+func (c *Config) GetRepoBranch() string {
+	return c.repoBranch
+}
+
+func (c *Config) SetRepoBranch(repoBranch string) {
+	c.repoBranch = repoBranch
+}
+
+func (c *Config) GetRepoToken() string {
+	return c.repoToken
+}
+
+func (c *Config) SetRepoToken(repoToken string) {
+	c.repoToken = repoToken
+}
+
+func (c *Config) GetRepoSSHKeyPath() string {
+	return c.repoSSHKeyPath
+}
+
+func (c *Config) SetRepoSSHKeyPath(repoSSHKeyPath string) {
+	c.repoSSHKeyPath = repoSSHKeyPath
+}
+
+func (c *Config) GetBaseImageAdvisoriesPath() string {
+	return c.baseImageAdvisoriesPath
+}
+
+func (c *Config) SetBaseImageAdvisoriesPath(baseImageAdvisoriesPath string) {
+	c.baseImageAdvisoriesPath = baseImageAdvisoriesPath
+}
+
+func (c *Config) GetTfPlanPath() string {
+	return c.tfPlanPath
+}
+
+func (c *Config) SetTfPlanPath(tfPlanPath string) {
+	c.tfPlanPath = tfPlanPath
+}
+
+func (c *Config) GetRevealSecrets() bool {
+	return c.revealSecrets
+}
+
+func (c *Config) SetRevealSecrets(revealSecrets bool) {
+	c.revealSecrets = revealSecrets
+}
+
+func (c *Config) GetCodeContextLines() int64 {
+	return c.codeContextLines
+}
+
+func (c *Config) SetCodeContextLines(codeContextLines int64) {
+	c.codeContextLines = codeContextLines
+}
+
+func (c *Config) GetRemediationPath() string {
+	return c.remediationPath
+}
+
+func (c *Config) SetRemediationPath(remediationPath string) {
+	c.remediationPath = remediationPath
+}
+
+func (c *Config) GetTriageURL() string {
+	return c.triageURL
+}
+
+func (c *Config) SetTriageURL(triageURL string) {
+	c.triageURL = triageURL
+}
+
+func (c *Config) GetTriageModel() string {
+	return c.triageModel
+}
+
+func (c *Config) SetTriageModel(triageModel string) {
+	c.triageModel = triageModel
+}
+
+func (c *Config) GetTriageAPIKey() string {
+	return c.triageAPIKey
+}
+
+func (c *Config) SetTriageAPIKey(triageAPIKey string) {
+	c.triageAPIKey = triageAPIKey
+}
+
+func (c *Config) GetMinConfidence() string {
+	return c.minConfidence
+}
+
+func (c *Config) SetMinConfidence(minConfidence string) {
+	c.minConfidence = minConfidence
+}
+
+func (c *Config) GetFilters() []string {
+	return c.filters
+}
+
+func (c *Config) SetFilters(filters []string) {
+	c.filters = c.factoryParseInputToSliceString(filters)
+}
+
+func (c *Config) GetCodeOwnersPath() string {
+	return c.codeOwnersPath
+}
+
+func (c *Config) SetCodeOwnersPath(codeOwnersPath string) {
+	c.codeOwnersPath = codeOwnersPath
+}
+
+func (c *Config) GetSplitReportByOwner() bool {
+	return c.splitReportByOwner
+}
+
+func (c *Config) SetSplitReportByOwner(splitReportByOwner bool) {
+	c.splitReportByOwner = splitReportByOwner
+}
+
+func (c *Config) GetOwnerMappings() []string {
+	return c.ownerMappings
+}
+
+func (c *Config) SetOwnerMappings(ownerMappings []string) {
+	c.ownerMappings = c.factoryParseInputToSliceString(ownerMappings)
+}
+
+func (c *Config) GetOwnerWebhooks() map[string]string {
+	return c.ownerWebhooks
+}
+
+func (c *Config) SetOwnerWebhooks(ownerWebhooks interface{}) {
+	output, err := utilsJson.ConvertInterfaceToMapString(ownerWebhooks)
+	logger.LogErrorWithLevel("Error on marshal ownerWebhooks to bytes", err, logger.PanicLevel)
+	c.ownerWebhooks = output
+}
+
+func (c *Config) GetTicketsPath() string {
+	return c.ticketsPath
+}
+
+func (c *Config) SetTicketsPath(ticketsPath string) {
+	c.ticketsPath = ticketsPath
+}
+
+func (c *Config) GetTicketsExcludeFromGates() bool {
+	return c.ticketsExcludeFromGates
+}
+
+func (c *Config) SetTicketsExcludeFromGates(ticketsExcludeFromGates bool) {
+	c.ticketsExcludeFromGates = ticketsExcludeFromGates
+}
+
+func (c *Config) GetPublishCheckRun() bool {
+	return c.publishCheckRun
+}
+
+func (c *Config) SetPublishCheckRun(publishCheckRun bool) {
+	c.publishCheckRun = publishCheckRun
+}
+
+func (c *Config) GetGiteaURL() string {
+	return c.giteaURL
+}
+
+func (c *Config) SetGiteaURL(giteaURL string) {
+	c.giteaURL = giteaURL
+}
+
+func (c *Config) GetGiteaToken() string {
+	return c.giteaToken
+}
+
+func (c *Config) SetGiteaToken(giteaToken string) {
+	c.giteaToken = giteaToken
+}
+
+func (c *Config) GetEnableLeaksEntropy() bool {
+	return c.enableLeaksEntropy
+}
+
+func (c *Config) SetEnableLeaksEntropy(enableLeaksEntropy bool) {
+	c.enableLeaksEntropy = enableLeaksEntropy
+}
+
+func (c *Config) GetLeaksEntropyAllowlistPatterns() []string {
+	return c.leaksEntropyAllowlistPatterns
+}
+
+func (c *Config) SetLeaksEntropyAllowlistPatterns(leaksEntropyAllowlistPatterns []string) {
+	// the commas are kept, they are part of the repetitions of the regular expressions
+	c.leaksEntropyAllowlistPatterns = leaksEntropyAllowlistPatterns
+}
+
+func (c *Config) GetLeaksEntropyAllowlistPaths() []string {
+	return c.leaksEntropyAllowlistPaths
+}
+
+func (c *Config) SetLeaksEntropyAllowlistPaths(leaksEntropyAllowlistPaths []string) {
+	c.leaksEntropyAllowlistPaths = c.factoryParseInputToSliceString(leaksEntropyAllowlistPaths)
+}
+
+func (c *Config) GetLeaksEntropyMinLength() int64 {
+	return c.leaksEntropyMinLength
+}
+
+func (c *Config) SetLeaksEntropyMinLength(leaksEntropyMinLength int64) {
+	c.leaksEntropyMinLength = leaksEntropyMinLength
+}
+
+func (c *Config) GetLeaksEntropyBase64Threshold() float64 {
+	return c.leaksEntropyBase64Threshold
+}
+
+func (c *Config) SetLeaksEntropyBase64Threshold(leaksEntropyBase64Threshold float64) {
+	c.leaksEntropyBase64Threshold = leaksEntropyBase64Threshold
+}
+
+func (c *Config) GetLeaksEntropyHexThreshold() float64 {
+	return c.leaksEntropyHexThreshold
+}
+
+func (c *Config) SetLeaksEntropyHexThreshold(leaksEntropyHexThreshold float64) {
+	c.leaksEntropyHexThreshold = leaksEntropyHexThreshold
+}
+
+func (c *Config) GetRemoteCachePublicKey() string {
+	return c.remoteCachePublicKey
+}
+
+func (c *Config) SetRemoteCachePublicKey(remoteCachePublicKey string) {
+	c.remoteCachePublicKey = remoteCachePublicKey
+}
+
+func (c *Config) GetRemoteCachePrivateKey() string {
+	return c.remoteCachePrivateKey
+}
+
+func (c *Config) SetRemoteCachePrivateKey(remoteCachePrivateKey string) {
+	c.remoteCachePrivateKey = remoteCachePrivateKey
+}
+
+func (c *Config) GetReportMinSeverity() string {
+	return c.reportMinSeverity
+}
+
+func (c *Config) SetReportMinSeverity(reportMinSeverity string) {
+	c.reportMinSeverity = reportMinSeverity
+}
+
+func (c *Config) GetFailThreshold() string {
+	return c.failThreshold
+}
+
+func (c *Config) SetFailThreshold(failThreshold string) {
+	c.failThreshold = failThreshold
+}
+
+func (c *Config) GetOsvOfflineDatabasePath() string {
+	return c.osvOfflineDatabasePath
+}
+
+func (c *Config) SetOsvOfflineDatabasePath(osvOfflineDatabasePath string) {
+	c.osvOfflineDatabasePath = osvOfflineDatabasePath
+}
+
+func (c *Config) GetSeverityMapping() map[string]string {
+	return c.severityMapping
+}
+
+func (c *Config) SetSeverityMapping(severityMapping interface{}) {
+	output, err := utilsJson.ConvertInterfaceToMapString(severityMapping)
+	logger.LogErrorWithLevel("Error on marshal severityMapping to bytes", err, logger.PanicLevel)
+	c.severityMapping = output
+}
+
+func (c *Config) GetSeverityTablesPath() string {
+	return c.severityTablesPath
+}
+
+func (c *Config) SetSeverityTablesPath(severityTablesPath string) {
+	c.severityTablesPath = severityTablesPath
+}
+
+func (c *Config) GetThreadFixFieldMapping() map[string]string {
+	return c.threadFixFieldMapping
+}
+
+func (c *Config) SetThreadFixFieldMapping(threadFixFieldMapping interface{}) {
+	output, err := utilsJson.ConvertInterfaceToMapString(threadFixFieldMapping)
+	logger.LogErrorWithLevel("Error on marshal threadFixFieldMapping to bytes", err, logger.PanicLevel)
+	c.threadFixFieldMapping = output
+}
+
+func (c *Config) GetDeterministic() bool {
+	return c.deterministic
+}
+
+func (c *Config) SetDeterministic(deterministic bool) {
+	c.deterministic = deterministic
+}
+
+func (c *Config) GetSignReport() bool {
+	return c.signReport
+}
+
+func (c *Config) SetSignReport(signReport bool) {
+	c.signReport = signReport
+}
+
+func (c *Config) GetEncryptReport() bool {
+	return c.encryptReport
+}
+
+func (c *Config) SetEncryptReport(encryptReport bool) {
+	c.encryptReport = encryptReport
+}
+
+func (c *Config) GetReportRecipients() []string {
+	return c.reportRecipients
+}
+
+func (c *Config) SetReportRecipients(reportRecipients []string) {
+	c.reportRecipients = reportRecipients
+}
+
+func (c *Config) GetRedact() bool {
+	return c.redact
+}
+
+func (c *Config) SetRedact(redact bool) {
+	c.redact = redact
+}
+
+func (c *Config) GetEnableWorkDirDiscovery() bool {
+	return c.enableWorkDirDiscovery
+}
+
+func (c *Config) SetEnableWorkDirDiscovery(enableWorkDirDiscovery bool) {
+	c.enableWorkDirDiscovery = enableWorkDirDiscovery
+}
+
+func (c *Config) GetTestCodeMode() string {
+	return valueordefault.GetStringValueOrDefault(c.testCodeMode, cli.TestCodeFlag.ToString())
+}
+
+func (c *Config) SetTestCodeMode(testCodeMode string) {
+	c.testCodeMode = testCodeMode
+}
+
+func (c *Config) GetTestCodePaths() []string {
+	return c.testCodePaths
+}
+
+func (c *Config) SetTestCodePaths(testCodePaths []string) {
+	c.testCodePaths = c.factoryParseInputToSliceString(testCodePaths)
+}
+
+func (c *Config) GetRulePacks() []string {
+	return c.rulePacks
+}
+
+func (c *Config) SetRulePacks(rulePacks []string) {
+	c.rulePacks = c.factoryParseInputToSliceString(rulePacks)
+}
+
+func (c *Config) GetRulePacksDir() string {
+	return valueordefault.GetStringValueOrDefault(c.rulePacksDir, c.getDefaultRulePacksDir())
+}
+
+func (c *Config) SetRulePacksDir(rulePacksDir string) {
+	c.rulePacksDir = rulePacksDir
+}
+
+func (c *Config) GetRulePacksIndexURL() string {
+	return c.rulePacksIndexURL
+}
+
+func (c *Config) SetRulePacksIndexURL(rulePacksIndexURL string) {
+	c.rulePacksIndexURL = rulePacksIndexURL
+}
+
+func (c *Config) GetRulePacksPublicKey() string {
+	return c.rulePacksPublicKey
+}
+
+func (c *Config) SetRulePacksPublicKey(rulePacksPublicKey string) {
+	c.rulePacksPublicKey = rulePacksPublicKey
+}
+
+func (c *Config) GetPrintStyle() string {
+	return valueordefault.GetStringValueOrDefault(c.printStyle, cli.PrintStyleFull.ToString())
+}
+
+func (c *Config) SetPrintStyle(printStyle string) {
+	c.printStyle = printStyle
+}
+
+func (c *Config) GetQuiet() bool {
+	return c.quiet
+}
+
+func (c *Config) SetQuiet(quiet bool) {
+	c.quiet = quiet
+}
+
+func (c *Config) GetEventHooks() []string {
+	return c.eventHooks
+}
+
+func (c *Config) SetEventHooks(eventHooks []string) {
+	c.eventHooks = c.factoryParseInputToSliceString(eventHooks)
+}
+
+func (c *Config) GetLifecycleHooks() lifecyclehooks.LifecycleHooks {
+	return c.lifecycleHooks
+}
+
+func (c *Config) SetLifecycleHooks(lifecycleHooks interface{}) {
+	c.lifecycleHooks = lifecyclehooks.ParseInterfaceToLifecycleHooks(lifecycleHooks)
+}
+
+func (c *Config) GetLogFilePath() string {
+	return c.logFilePath
+}
+
+func (c *Config) SetLogFilePath(logFilePath string) {
+	c.logFilePath = logFilePath
+}
+
+func (c *Config) GetLogFileMaxSizeInMB() int64 {
+	return valueordefault.GetInt64ValueOrDefault(c.logFileMaxSizeInMB, int64(10))
+}
+
+func (c *Config) SetLogFileMaxSizeInMB(logFileMaxSizeInMB int64) {
+	c.logFileMaxSizeInMB = logFileMaxSizeInMB
+}
+
+func (c *Config) GetLogFileMaxAgeInDays() int64 {
+	return valueordefault.GetInt64ValueOrDefault(c.logFileMaxAgeInDays, int64(7))
+}
+
+func (c *Config) SetLogFileMaxAgeInDays(logFileMaxAgeInDays int64) {
+	c.logFileMaxAgeInDays = logFileMaxAgeInDays
+}
+
+func (c *Config) GetLogModuleLevels() map[string]string {
+	return c.logModuleLevels
+}
+
+func (c *Config) SetLogModuleLevels(logModuleLevels interface{}) {
+	output, err := utilsJson.ConvertInterfaceToMapString(logModuleLevels)
+	logger.LogErrorWithLevel("Error on marshal logModuleLevels to bytes", err, logger.PanicLevel)
+	c.logModuleLevels = output
+	logger.SetModuleLevels(c.logModuleLevels)
+}
+
+func (c *Config) GetArtifactsDir() string {
+	return c.artifactsDir
+}
+
+func (c *Config) SetArtifactsDir(artifactsDir string) {
+	c.artifactsDir = artifactsDir
+}
+
+func (c *Config) GetStrict() bool {
+	return c.strict
+}
+
+func (c *Config) SetStrict(strict bool) {
+	c.strict = strict
+}
+
+func (c *Config) GetTimeout() string {
+	return c.timeout
+}
+
+func (c *Config) SetTimeout(timeout string) {
+	c.timeout = timeout
+}
+
+func (c *Config) GetWorkDirsStateFile() string {
+	return valueordefault.GetStringValueOrDefault(c.workDirsStateFile, c.getDefaultWorkDirsStateFile())
+}
+
+func (c *Config) SetWorkDirsStateFile(workDirsStateFile string) {
+	c.workDirsStateFile = workDirsStateFile
+}
+
+func (c *Config) GetOrphanWorkDirsMaxAgeInHours() int64 {
+	return valueordefault.GetInt64ValueOrDefault(c.orphanWorkDirsMaxAgeInHours, int64(24))
+}
+
+func (c *Config) SetOrphanWorkDirsMaxAgeInHours(orphanWorkDirsMaxAgeInHours int64) {
+	c.orphanWorkDirsMaxAgeInHours = orphanWorkDirsMaxAgeInHours
+}
+
+func (c *Config) GetDockerDaemonFlavor() string {
+	return valueordefault.GetStringValueOrDefault(c.dockerDaemonFlavor, cli.DockerDaemonAuto.ToString())
+}
+
+func (c *Config) SetDockerDaemonFlavor(dockerDaemonFlavor string) {
+	c.dockerDaemonFlavor = dockerDaemonFlavor
+}
+
+func (c *Config) GetFaultInjection() string {
+	return c.faultInjection
+}
+
+func (c *Config) SetFaultInjection(faultInjection string) {
+	c.faultInjection = faultInjection
+}
+
 func (c *Config) IsEmptyRepositoryAuthorization() bool {
 	return c.repositoryAuthorization == "" || c.repositoryAuthorization == uuid.Nil.String()
 }
@@ -397,6 +1532,15 @@ func (c *Config) extractFlagValueInt64(cmd *cobra.Command, name string, defaultV
 	return defaultValue
 }
 
+func (c *Config) extractFlagValueFloat64(cmd *cobra.Command, name string, defaultValue float64) float64 {
+	if cmd.PersistentFlags().Changed(name) {
+		flagValue, err := cmd.PersistentFlags().GetFloat64(name)
+		logger.LogPanicWithLevel(messages.MsgPanicGetFlagValue, err, logger.PanicLevel)
+		return flagValue
+	}
+	return defaultValue
+}
+
 func (c *Config) extractFlagValueBool(cmd *cobra.Command, name string, defaultValue bool) bool {
 	if cmd.PersistentFlags().Changed(name) {
 		flagValue, err := cmd.PersistentFlags().GetBool(name)
@@ -465,6 +1609,104 @@ func (c *Config) toMap() map[string]interface{} {
 		"headers":                         c.headers,
 		"toolsConfig":                     c.toolsConfig,
 		"workDir":                         c.workDir,
+		"enableSecretVerification":        c.enableSecretVerification,
+		"enableVendoredAndGeneratedCode":  c.enableVendoredAndGeneratedCode,
+		"symlinkMode":                     c.symlinkMode,
+		"maxParallel":                     c.maxParallel,
+		"sourceMode":                      c.sourceMode,
+		"maxOutputSizeInMB":               c.maxOutputSizeInMB,
+		"engineWorkers":                   c.engineWorkers,
+		"engineMemoryLimitInMB":           c.engineMemoryLimitInMB,
+		"engineRuleTimeoutInMs":           c.engineRuleTimeoutInMs,
+		"maxFileSizeInMB":                 c.maxFileSizeInMB,
+		"noCache":                         c.noCache,
+		"cacheDir":                        c.cacheDir,
+		"remoteCacheURL":                  c.remoteCacheURL,
+		"remoteCacheMode":                 c.remoteCacheMode,
+		"interactive":                     c.interactive,
+		"dryRun":                          c.dryRun,
+		"enableTelemetry":                 c.enableTelemetry,
+		"submitDependencies":              c.submitDependencies,
+		"elasticsearchURL":                c.elasticsearchURL,
+		"elasticsearchIndex":              c.GetElasticsearchIndex(),
+		"elasticsearchAPIKey":             c.elasticsearchAPIKey,
+		"localDBPath":                     c.localDBPath,
+		"postgresURI":                     c.postgresURI,
+		"policyPath":                      c.policyPath,
+		"policyBaselinePath":              c.policyBaselinePath,
+		"riskWeights":                     c.riskWeights,
+		"minGrade":                        c.minGrade,
+		"maxFindings":                     c.maxFindings,
+		"queueDir":                        c.queueDir,
+		"tags":                            c.tags,
+		"sourceRepositoryURL":             c.sourceRepositoryURL,
+		"sourceBranch":                    c.sourceBranch,
+		"sourceCommit":                    c.sourceCommit,
+		"sourcePullRequest":               c.sourcePullRequest,
+		"repoURL":                         c.repoURL,
+		"repoBranch":                      c.repoBranch,
+		"repoToken":                       c.repoToken,
+		"repoSSHKeyPath":                  c.repoSSHKeyPath,
+		"baseImageAdvisoriesPath":         c.baseImageAdvisoriesPath,
+		"tfPlanPath":                      c.tfPlanPath,
+		"revealSecrets":                   c.revealSecrets,
+		"codeContextLines":                c.codeContextLines,
+		"remediationPath":                 c.remediationPath,
+		"triageURL":                       c.triageURL,
+		"triageModel":                     c.triageModel,
+		"triageAPIKey":                    c.triageAPIKey,
+		"minConfidence":                   c.minConfidence,
+		"filters":                         c.filters,
+		"codeOwnersPath":                  c.codeOwnersPath,
+		"splitReportByOwner":              c.splitReportByOwner,
+		"ownerMappings":                   c.ownerMappings,
+		"ownerWebhooks":                   c.ownerWebhooks,
+		"ticketsPath":                     c.ticketsPath,
+		"ticketsExcludeFromGates":         c.ticketsExcludeFromGates,
+		"publishCheckRun":                 c.publishCheckRun,
+		"giteaURL":                        c.giteaURL,
+		"giteaToken":                      c.giteaToken,
+		"enableLeaksEntropy":              c.enableLeaksEntropy,
+		"leaksEntropyAllowlistPatterns":   c.leaksEntropyAllowlistPatterns,
+		"leaksEntropyAllowlistPaths":      c.leaksEntropyAllowlistPaths,
+		"leaksEntropyMinLength":           c.leaksEntropyMinLength,
+		"leaksEntropyBase64Threshold":     c.leaksEntropyBase64Threshold,
+		"leaksEntropyHexThreshold":        c.leaksEntropyHexThreshold,
+		"remoteCachePublicKey":            c.remoteCachePublicKey,
+		"remoteCachePrivateKey":           c.remoteCachePrivateKey,
+		"reportMinSeverity":               c.reportMinSeverity,
+		"failThreshold":                   c.failThreshold,
+		"osvOfflineDatabasePath":          c.osvOfflineDatabasePath,
+		"severityMapping":                 c.severityMapping,
+		"severityTablesPath":              c.severityTablesPath,
+		"threadFixFieldMapping":           c.threadFixFieldMapping,
+		"deterministic":                   c.deterministic,
+		"signReport":                      c.signReport,
+		"encryptReport":                   c.encryptReport,
+		"reportRecipients":                c.reportRecipients,
+		"redact":                          c.redact,
+		"enableWorkDirDiscovery":          c.enableWorkDirDiscovery,
+		"testCodeMode":                    c.testCodeMode,
+		"testCodePaths":                   c.testCodePaths,
+		"rulePacks":                       c.rulePacks,
+		"rulePacksDir":                    c.rulePacksDir,
+		"rulePacksIndexURL":               c.rulePacksIndexURL,
+		"rulePacksPublicKey":              c.rulePacksPublicKey,
+		"printStyle":                      c.printStyle,
+		"quiet":                           c.quiet,
+		"eventHooks":                      c.eventHooks,
+		"lifecycleHooks":                  c.lifecycleHooks,
+		"logFilePath":                     c.logFilePath,
+		"logFileMaxSizeInMB":              c.logFileMaxSizeInMB,
+		"logFileMaxAgeInDays":             c.logFileMaxAgeInDays,
+		"logModuleLevels":                 c.logModuleLevels,
+		"artifactsDir":                    c.artifactsDir,
+		"strict":                          c.strict,
+		"timeout":                         c.timeout,
+		"workDirsStateFile":               c.workDirsStateFile,
+		"orphanWorkDirsMaxAgeInHours":     c.orphanWorkDirsMaxAgeInHours,
+		"dockerDaemonFlavor":              c.dockerDaemonFlavor,
+		"faultInjection":                  c.faultInjection,
 	}
 }
 
@@ -490,6 +1732,26 @@ func (c *Config) NormalizeConfigs() IConfig {
 	return c
 }
 
+// SaveHashesInConfigFile writes the false positive and risk accept hashes in the config file, keeping the
+// other configurations of the file. When the file doesn't exist it is created with the hashes only
+func (c *Config) SaveHashesInConfigFile() error {
+	content := map[string]interface{}{}
+	if fileBytes, err := ioutil.ReadFile(c.GetConfigFilePath()); err == nil {
+		if err := json.Unmarshal(fileBytes, &content); err != nil {
+			return err
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	content[c.toLowerCamel(EnvFalsePositiveHashes)] = c.GetFalsePositiveHashes()
+	content[c.toLowerCamel(EnvRiskAcceptHashes)] = c.GetRiskAcceptHashes()
+	fileBytes, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.GetConfigFilePath(), fileBytes, 0600)
+}
+
 func (c *Config) toLowerCamel(value string) string {
 	return strcase.ToLowerCamel(strcase.ToSnake(value))
 }
@@ -21,8 +21,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"io/ioutil"
 	"os"
 	"path"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -59,6 +61,7 @@ func TestNewHorusecConfig(t *testing.T) {
 		assert.Equal(t, "", configs.GetContainerBindProjectPath())
 		assert.Equal(t, true, configs.IsEmptyRepositoryAuthorization())
 		assert.Equal(t, 0, len(configs.GetToolsConfig()))
+		assert.Equal(t, int64(runtime.NumCPU()), configs.GetMaxParallel())
 	})
 	t.Run("Should change horusec config and return your new values", func(t *testing.T) {
 		currentPath, _ := os.Getwd()
@@ -377,6 +380,24 @@ func TestNewHorusecConfig(t *testing.T) {
 	})
 }
 
+func TestConfig_GetTimeoutInSecondsAnalysis(t *testing.T) {
+	t.Run("Should return the seconds of the timeout duration when it is informed", func(t *testing.T) {
+		configs := NewConfig()
+		configs.SetTimeoutInSecondsAnalysis(1010)
+		configs.SetTimeout("30m")
+
+		assert.Equal(t, int64(1800), configs.GetTimeoutInSecondsAnalysis())
+	})
+
+	t.Run("Should return the timeout in seconds when the duration is invalid", func(t *testing.T) {
+		configs := NewConfig()
+		configs.SetTimeoutInSecondsAnalysis(1010)
+		configs.SetTimeout("30 minutes")
+
+		assert.Equal(t, int64(1010), configs.GetTimeoutInSecondsAnalysis())
+	})
+}
+
 func TestToLowerCamel(t *testing.T) {
 	t.Run("should success set all configs as lower camel case", func(t *testing.T) {
 		configs := &Config{}
@@ -428,3 +449,36 @@ func TestConfig_ToBytes(t *testing.T) {
 		assert.NotEmpty(t, config.ToBytes(true))
 	})
 }
+
+func TestConfig_SaveHashesInConfigFile(t *testing.T) {
+	t.Run("Should save hashes keeping the other configurations of the file", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "config")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		configFilePath := path.Join(dir, "horusec-config.json")
+		assert.NoError(t, ioutil.WriteFile(configFilePath, []byte(`{"horusecCliTimeoutInSecondsAnalysis": 20}`), 0600))
+
+		config := &Config{}
+		config.SetConfigFilePath(configFilePath)
+		config.SetFalsePositiveHashes([]string{"hash1"})
+		config.SetRiskAcceptHashes([]string{"hash2"})
+		assert.NoError(t, config.SaveHashesInConfigFile())
+
+		content, err := ioutil.ReadFile(configFilePath)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"horusecCliTimeoutInSecondsAnalysis": 20, "horusecCliFalsePositiveHashes": ["hash1"],
+			"horusecCliRiskAcceptHashes": ["hash2"]}`, string(content))
+	})
+
+	t.Run("Should return error when the config file is not a json", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "config")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		configFilePath := path.Join(dir, "horusec-config.json")
+		assert.NoError(t, ioutil.WriteFile(configFilePath, []byte("invalid"), 0600))
+
+		config := &Config{}
+		config.SetConfigFilePath(configFilePath)
+		assert.Error(t, config.SaveHashesInConfigFile())
+	})
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/lifecyclehooks"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 )
@@ -144,6 +145,434 @@ const (
 	// Used to pass project path in host when running horusec cli inside a container
 	// By default is empty
 	EnvContainerBindProjectPath = "HORUSEC_CLI_CONTAINER_BIND_PROJECT_PATH"
+	// Used to check if leaked credentials (AWS keys, GitHub tokens, Slack tokens and webhooks) are currently valid,
+	// using cheap read-only requests to the provider APIs. Active credentials are raised to CRITICAL severity.
+	// By default is false
+	// Validation: It is mandatory to be in "false", "true"
+	EnvEnableSecretVerification = "HORUSEC_CLI_ENABLE_SECRET_VERIFICATION"
+	// Used to analyze vendored folders (vendor, node_modules, bower_components) and generated files
+	// (protobuf stubs, minified javascript, source maps, files marked as generated) that are skipped by default
+	// By default is false
+	// Validation: It is mandatory to be in "false", "true"
+	EnvEnableVendoredAndGeneratedCode = "HORUSEC_CLI_ENABLE_VENDORED_AND_GENERATED_CODE"
+	// Used to setup how symbolic links are handled when the project is copied to the analysis folder.
+	// Links pointing outside of the project are never copied and cyclic links are copied only once
+	// By default is follow-within-root
+	// Validation: It is mandatory to be in "skip", "preserve", "follow-within-root"
+	EnvSymlinkMode = "HORUSEC_CLI_SYMLINK_MODE"
+	// Maximum weight of tool containers running at the same time across all languages.
+	// Heavy tools like SpotBugs take more than one slot. Zero or less uses the number of CPUs
+	// Validation: It is optional is necessary a valid int64 value
+	EnvMaxParallel = "HORUSEC_CLI_MAX_PARALLEL"
+	// How the project is given to the tools containers. copy duplicates the project in the analysis folder,
+	// hardlink links the files instead of copying and read-only mounts the project itself, both when no tool needs write access
+	// By default is copy
+	// Validation: It is mandatory to be in "copy", "hardlink", "read-only"
+	EnvSourceMode = "HORUSEC_CLI_SOURCE_MODE"
+	// Maximum size in megabytes of the output of each tool container, bigger outputs are truncated and the tool fails
+	// By default is 250
+	// Validation: It is optional is necessary a valid int64 value
+	EnvMaxOutputSizeInMB = "HORUSEC_CLI_MAX_OUTPUT_SIZE_MB"
+	// Files analyzed at the same time by each horusec engine, like horusec-java and horusec-leaks
+	// By default is the number of CPUs
+	// Validation: It is optional is necessary a valid int64 value
+	EnvEngineWorkers = "HORUSEC_CLI_ENGINE_WORKERS"
+	// Soft limit in megabytes of the memory of the horusec engines, above it the engine analyzes one file at a time
+	// until the memory is released
+	// By default is disabled
+	// Validation: It is optional is necessary a valid int64 value
+	EnvEngineMemoryLimitInMB = "HORUSEC_CLI_ENGINE_MEMORY_LIMIT_MB"
+	// Max time in milliseconds of each rule in each file in the horusec engines, the rule is skipped in the file and
+	// reported when it takes longer, so a pathological expression of a custom rule pack can't hang the analysis
+	// By default is disabled
+	// Validation: It is optional is necessary a valid int64 value
+	EnvEngineRuleTimeoutInMs = "HORUSEC_CLI_ENGINE_RULE_TIMEOUT_MS"
+	// Files greater than this size in megabytes are skipped by the horusec engines, a negative value disables the limit
+	// By default is used the limit of the engines, of 5 megabytes
+	// Validation: It is optional is necessary a valid int64 value
+	EnvMaxFileSizeInMB = "HORUSEC_CLI_MAX_FILE_SIZE_MB"
+	// Disable the cache of analysis results. By default when the same commit was already analyzed with the same
+	// configurations the cached result is returned
+	// Validation: It is optional is necessary a valid boolean value
+	EnvNoCache = "HORUSEC_CLI_NO_CACHE"
+	// Directory where the analysis results are cached, it can be shared between machines
+	// By default is the horusec folder in the user cache directory
+	// Validation: It is optional
+	EnvCacheDir = "HORUSEC_CLI_CACHE_DIR"
+	// Remote storage shared by the CI runners to cache the analysis results, the local cache is used first
+	// It can be s3://bucket/prefix, gs://bucket/prefix or an http(s) url accepting GET and PUT
+	// Validation: It is optional
+	EnvRemoteCacheURL = "HORUSEC_CLI_REMOTE_CACHE_URL"
+	// Whether the analysis results are only read from the remote cache or also written to it
+	// By default is read-only
+	// Validation: It is mandatory to be in "read-only", "read-write"
+	EnvRemoteCacheMode = "HORUSEC_CLI_REMOTE_CACHE_MODE"
+	// Used to browse the vulnerabilities in the terminal after the analysis and mark them as false positive or
+	// risk accepted, saving the hashes in the config file
+	// By default is false
+	// Validation: It is optional is necessary a valid boolean value
+	EnvInteractive = "HORUSEC_CLI_INTERACTIVE"
+	// Used to show the languages detected, the paths ignored, the tools with images and commands that would run
+	// and the effective configuration, without copying the project or starting containers
+	// By default is false
+	// Validation: It is optional is necessary a valid boolean value
+	EnvDryRun = "HORUSEC_CLI_DRY_RUN"
+	// Used to send anonymous usage data to the maintainers of horusec after the analysis: the version, OS and
+	// architecture, languages detected, duration and tools that failed. No code, paths or vulnerabilities are sent
+	// By default is false
+	// Validation: It is optional is necessary a valid boolean value
+	EnvEnableTelemetry = "HORUSEC_CLI_TELEMETRY"
+	// Used to submit the dependencies found by the dependency tools to the dependency submission API of GitHub,
+	// with the GITHUB_TOKEN of the GitHub Actions, so the Dependabot alerts cover them
+	// By default is false
+	// Validation: It is optional is necessary a valid boolean value
+	EnvSubmitDependencies = "HORUSEC_CLI_SUBMIT_DEPENDENCIES"
+	// Used to index the vulnerabilities of the analysis in an index of Elasticsearch or OpenSearch with the bulk API,
+	// to build dashboards over the history of the analyses without the horusec platform
+	// By default is empty and the vulnerabilities aren't indexed
+	// Validation: It is optional and when informed must be a valid url
+	EnvElasticsearchURL = "HORUSEC_CLI_ELASTICSEARCH_URL"
+	// Used to inform the index of the vulnerabilities, the index template of horusec is installed to the indexes
+	// starting with it
+	// By default is horusec-vulnerabilities
+	// Validation: It is optional and when informed must be a valid name of index
+	EnvElasticsearchIndex = "HORUSEC_CLI_ELASTICSEARCH_INDEX"
+	// Used to authenticate in Elasticsearch with an api key, the basic authentication can be informed in the url
+	// By default is empty, no api key is sent
+	EnvElasticsearchAPIKey = "HORUSEC_CLI_ELASTICSEARCH_API_KEY"
+	// Used to store each analysis in a SQLite database, created when it doesn't exist.
+	// The analyses stored are read by the commands db history and db query
+	// By default is empty and the analyses aren't stored
+	EnvLocalDBPath = "HORUSEC_CLI_LOCAL_DB_PATH"
+	// Used to export each analysis and its vulnerabilities to a PostgreSQL database, the tables are created or
+	// migrated by horusec before the export
+	// By default is empty and the analyses aren't exported
+	// Validation: It is optional and when informed must start with postgres:// or postgresql://
+	EnvPostgresURI = "HORUSEC_CLI_POSTGRES_URI"
+	// Used to evaluate a rego policy, file or directory, against the result of the analysis using the opa binary.
+	// The policy decision set the exit code of horusec instead of the return-error
+	// By default is empty
+	// Validation: It is optional is necessary a valid path
+	EnvPolicyPath = "HORUSEC_CLI_POLICY_PATH"
+	// Used to inform the json output of a previous analysis, so the vulnerabilities of the policy input are
+	// marked as new when their hashes are not in the baseline
+	// By default is empty
+	// Validation: It is optional is necessary a valid path
+	EnvPolicyBaselinePath = "HORUSEC_CLI_POLICY_BASELINE_PATH"
+	// Used to change the weights of the risk score, by severity, CWE or verified-secret, the extra weight of the
+	// leaked credentials verified as active. Example {"HIGH": "15", "CWE-89": "10", "verified-secret": "30"}
+	// By default is empty, using the default weights
+	// Validation: It is optional is necessary valid keys and integer values
+	EnvRiskWeights = "HORUSEC_CLI_RISK_WEIGHTS"
+	// Used to return error when the grade of the risk score of the analysis is worse than the grade informed
+	// By default is empty
+	// Validation: It is optional is necessary a grade between A and F
+	EnvMinGrade = "HORUSEC_CLI_MIN_GRADE"
+	// Used to return error when the analysis has more vulnerabilities of a severity than the max informed, only the new
+	// vulnerabilities are counted with the baseline of the policy. Example {"CRITICAL": "0", "HIGH": "5"}
+	// By default is empty
+	// Validation: It is optional is necessary severities as keys and not negative integer values
+	EnvMaxFindings = "HORUSEC_CLI_MAX_FINDINGS"
+	// Used to change the directory of the offline queue, where the analyses not sent to horusec platform
+	// because it was unreachable are kept until the next successful send or the command flush-queue
+	// By default is the directory horusec/queue in the cache directory of the user
+	// Validation: It is optional
+	EnvQueueDir = "HORUSEC_CLI_QUEUE_DIR"
+	// Used to attach metadata to the analysis, like branch, commit or team, sent to horusec platform and
+	// shown in the outputs. Example {"team": "payments", "branch": "main"}
+	// By default is empty
+	// Validation: It is optional
+	EnvTags = "HORUSEC_CLI_TAGS"
+	// Used to override the url of the repository detected from the CI environment or from git
+	// By default is empty
+	// Validation: It is optional
+	EnvSourceRepositoryURL = "HORUSEC_CLI_SOURCE_REPOSITORY_URL"
+	// Used to override the branch detected from the CI environment or from git
+	// By default is empty
+	// Validation: It is optional
+	EnvSourceBranch = "HORUSEC_CLI_SOURCE_BRANCH"
+	// Used to override the commit SHA detected from the CI environment or from git
+	// By default is empty
+	// Validation: It is optional
+	EnvSourceCommit = "HORUSEC_CLI_SOURCE_COMMIT"
+	// Used to override the number of the pull request detected from the CI environment
+	// By default is empty
+	// Validation: It is optional
+	EnvSourcePullRequest = "HORUSEC_CLI_SOURCE_PULL_REQUEST"
+	// Used to analyze a remote git repository, shallow cloned in a temporary directory removed after the analysis
+	// By default is empty
+	// Validation: It is optional
+	EnvRepoURL = "HORUSEC_CLI_REPO_URL"
+	// Used to clone a branch or tag of the repo url instead of the default branch
+	// By default is empty
+	// Validation: It is optional
+	EnvRepoBranch = "HORUSEC_CLI_REPO_BRANCH"
+	// Used to authenticate the clone of the repo url by https
+	// By default is empty
+	// Validation: It is optional
+	EnvRepoToken = "HORUSEC_CLI_REPO_TOKEN"
+	// Used to authenticate the clone of the repo url by ssh with a private key
+	// By default is empty
+	// Validation: It is optional and when informed the file must exist
+	EnvRepoSSHKeyPath = "HORUSEC_CLI_REPO_SSH_KEY_PATH"
+	// Used to replace the built-in dataset of vulnerable and end of life base images of the dockerfiles by a json file
+	// By default is empty
+	// Validation: It is optional and when informed the file must exist
+	EnvBaseImageAdvisoriesPath = "HORUSEC_CLI_BASE_IMAGE_ADVISORIES_PATH"
+	// Used to analyze the json of a terraform plan with checkov instead of the terraform files with tfsec
+	// By default is empty
+	// Validation: It is optional and when informed the file must exist
+	EnvTfPlanPath = "HORUSEC_CLI_TF_PLAN_PATH"
+	// Used to show the secrets found by the leaks tools without mask, to triage the analysis locally
+	// By default is false
+	// Validation: It is optional and can't be used when the analysis is sent to horusec platform
+	EnvRevealSecrets = "HORUSEC_CLI_REVEAL_SECRETS"
+	// Used to keep the lines of code before and after the line of each vulnerability in the output
+	// By default is 0, only the code reported by the tool
+	// Validation: It is optional and must be between 0 and 20
+	EnvCodeContextLines = "HORUSEC_CLI_CODE_CONTEXT_LINES"
+	// Used to add or replace the fixes added to the details of the vulnerabilities by a yaml file
+	// By default is empty, only the built-in fixes are used
+	// Validation: It is optional and when informed the file must exist
+	EnvRemediationPath = "HORUSEC_CLI_REMEDIATION_PATH"
+	// Used to send the context of the vulnerabilities to an OpenAI-compatible chat completions endpoint, that suggests
+	// their classification
+	// By default is empty and the vulnerabilities aren't sent
+	// Validation: It is optional and when informed must be a valid url
+	EnvTriageURL = "HORUSEC_CLI_TRIAGE_URL"
+	// Used to inform the model of the triage endpoint
+	// By default is empty, the default model of the endpoint is used
+	EnvTriageModel = "HORUSEC_CLI_TRIAGE_MODEL"
+	// Used to authenticate in the triage endpoint, sent as a bearer token
+	// By default is empty, no authentication is sent
+	EnvTriageAPIKey = "HORUSEC_CLI_TRIAGE_API_KEY"
+	// Used to remove the vulnerabilities with confidence below the informed level, the vulnerabilities of tools without
+	// confidence are kept
+	// By default is empty and no vulnerability is removed
+	// Validation: It is optional and when informed must be LOW, MEDIUM or HIGH
+	EnvMinConfidence = "HORUSEC_CLI_MIN_CONFIDENCE"
+	// Names of the filters that remove vulnerabilities of the report, run in the informed order: severity, path,
+	// baseline, suppressions, dedup, the filters compiled in or the executables horusec-filter-<name> in the PATH
+	// By default is empty and no filter is run
+	// Validation: It is optional is necessary the filters available
+	EnvFilters = "HORUSEC_CLI_FILTERS"
+	// Path of the CODEOWNERS file used to fill the owners of the vulnerabilities, relative to the project path
+	// By default is empty and the file is found in .github/CODEOWNERS, CODEOWNERS, docs/CODEOWNERS or
+	// .gitlab/CODEOWNERS of the project
+	// Validation: It is optional and when informed must be a file
+	EnvCodeOwnersPath = "HORUSEC_CLI_CODE_OWNERS_PATH"
+	// Used to write besides the report one report for each owner of the CODEOWNERS, with its vulnerabilities
+	// By default is false
+	// Validation: It is optional and when true is necessary the json output file path
+	EnvSplitReportByOwner = "HORUSEC_CLI_SPLIT_REPORT_BY_OWNER"
+	// Rules with the syntax of the lines of the CODEOWNERS, the pattern and the owners, applied after the CODEOWNERS,
+	// like the projects of a monorepo without CODEOWNERS
+	// By default is empty
+	// Validation: It is optional and when informed each rule must have the pattern and the owners
+	EnvOwnerMappings = "HORUSEC_CLI_OWNER_MAPPINGS"
+	// Webhooks notified with the vulnerabilities of each owner, the key is the owner or unowned and the value is the url
+	// By default is empty and no owner is notified
+	// Validation: It is optional and when informed the urls must be http or https
+	EnvOwnerWebhooks = "HORUSEC_CLI_OWNER_WEBHOOKS"
+	// Path of the yaml file with the ticket of each vulnerability hash, with its url and status
+	// By default is empty
+	// Validation: It is optional and when informed must be a valid yaml with the url of each ticket
+	EnvTicketsPath = "HORUSEC_CLI_TICKETS_PATH"
+	// Used to not count to fail the analysis the vulnerabilities with open tickets
+	// By default is false
+	EnvTicketsExcludeFromGates = "HORUSEC_CLI_TICKETS_EXCLUDE_FROM_GATES"
+	// Used to publish the result of the analysis as a check run of GitHub in the GitHub Actions, with the summary and
+	// the annotations of the vulnerabilities in the files changed by the pull request
+	// By default is false
+	EnvPublishCheckRun = "HORUSEC_CLI_PUBLISH_CHECK_RUN"
+	// Used to publish a comment in the pull request and the status of the commit in the Gitea or Forgejo of the url
+	// By default is empty
+	// Validation: It is optional, when informed it must be an http url and the gitea token is required
+	EnvGiteaURL = "HORUSEC_CLI_GITEA_URL"
+	// Used to authenticate in the api of Gitea or Forgejo, the token needs the permission to write the repository
+	// By default is empty
+	EnvGiteaToken = "HORUSEC_CLI_GITEA_TOKEN"
+	// Used to enable in horusec-leaks the detection of random tokens, base64 and hex, by its entropy
+	// By default is false
+	EnvEnableLeaksEntropy = "HORUSEC_CLI_ENABLE_LEAKS_ENTROPY"
+	// Used to inform the regular expressions of the tokens not reported by the entropy of horusec-leaks
+	// By default is empty
+	// Validation: It is optional and when informed each one must be a valid regular expression
+	EnvLeaksEntropyAllowlistPatterns = "HORUSEC_CLI_LEAKS_ENTROPY_ALLOWLIST_PATTERNS"
+	// Used to inform the globs of the files not checked by the entropy of horusec-leaks
+	// By default is empty
+	EnvLeaksEntropyAllowlistPaths = "HORUSEC_CLI_LEAKS_ENTROPY_ALLOWLIST_PATHS"
+	// Used to setup the min length of the tokens checked by the entropy of horusec-leaks
+	// By default is 0 and horusec-leaks uses its default of 20
+	// Validation: It is optional and when informed it must be greater than 0
+	EnvLeaksEntropyMinLength = "HORUSEC_CLI_LEAKS_ENTROPY_MIN_LENGTH"
+	// Used to setup the min entropy of the base64 tokens reported by horusec-leaks
+	// By default is 0 and horusec-leaks uses its default of 4.5
+	// Validation: It is optional and when informed it must be greater than 0 and at most 6, the max of base64
+	EnvLeaksEntropyBase64Threshold = "HORUSEC_CLI_LEAKS_ENTROPY_BASE64_THRESHOLD"
+	// Used to setup the min entropy of the hex tokens reported by horusec-leaks
+	// By default is 0 and horusec-leaks uses its default of 3.0
+	// Validation: It is optional and when informed it must be greater than 0 and at most 4, the max of hex
+	EnvLeaksEntropyHexThreshold = "HORUSEC_CLI_LEAKS_ENTROPY_HEX_THRESHOLD"
+	// Ed25519 public key in base64 used to verify the signature of the objects read from the remote cache
+	// By default is empty
+	// Validation: It is mandatory with the remote cache url
+	EnvRemoteCachePublicKey = "HORUSEC_CLI_REMOTE_CACHE_PUBLIC_KEY"
+	// Ed25519 private key, or its seed, in base64 used to sign the objects written in the remote cache
+	// By default is empty
+	// Validation: It is mandatory in the read-write mode and must be the private key of the public key
+	EnvRemoteCachePrivateKey = "HORUSEC_CLI_REMOTE_CACHE_PRIVATE_KEY"
+	// Used to remove of the report the vulnerabilities with severity below the informed level, they are still sent to
+	// horusec platform and counted to the fail threshold
+	// By default is empty and all vulnerabilities are in the report
+	// Validation: It is optional and when informed must be INFO, LOW, MEDIUM, HIGH or CRITICAL
+	EnvReportMinSeverity = "HORUSEC_CLI_REPORT_MIN_SEVERITY"
+	// Used to not count to the return error and to the risk score the vulnerabilities with severity below the informed
+	// level, they are still in the report
+	// By default is empty and all vulnerabilities are counted
+	// Validation: It is optional and when informed must be INFO, LOW, MEDIUM, HIGH or CRITICAL
+	EnvFailThreshold = "HORUSEC_CLI_FAIL_THRESHOLD"
+	// Used to check the go modules by OsvScanner only against the snapshot of the osv database of the directory
+	// By default is empty
+	// Validation: It is optional and when informed it must be a directory
+	EnvOsvOfflineDatabasePath = "HORUSEC_CLI_OSV_OFFLINE_DATABASE_PATH"
+	// Used to replace the severity normalized by horusec from the severity informed by the tool, the key is the tool and
+	// its severity separated by colon, like NpmAudit:moderate, and the value is the severity of horusec
+	// By default is empty and the normalization of each tool is used
+	// Validation: The tools must exist and the severities must be valid
+	EnvSeverityMapping = "HORUSEC_CLI_SEVERITY_MAPPING"
+	// Used to replace the built-in severity tables of the tools by a yaml file, that maps the severities informed by
+	// the tools and the ids of their rules to the severities of horusec, the CWEs and the OWASP categories
+	// By default is empty and the built-in tables are used
+	// Validation: It is optional and when informed the file must exist with valid tools and severities
+	EnvSeverityTablesPath = "HORUSEC_CLI_SEVERITY_TABLES_PATH"
+	// Used to replace the fields of the vulnerabilities used in the fields of the findings of the threadfix output,
+	// the key is the field of the finding and the value the field of the json output of the vulnerability
+	// By default is empty and the default field mapping is used
+	// Validation: The fields of the findings and of the vulnerabilities must exist
+	EnvThreadFixFieldMapping = "HORUSEC_CLI_THREADFIX_FIELD_MAPPING"
+	// Used to output the same report to the analyses of the same code, sorting the vulnerabilities by location and
+	// removing the ids and dates of the outputs
+	// By default is false
+	EnvDeterministic = "HORUSEC_CLI_DETERMINISTIC"
+	// Used to write an in-toto attestation of the json report with the digests of the tools images and the commit of
+	// the analysis, signed by cosign keyless with sigstore
+	// By default is false
+	EnvSignReport = "HORUSEC_CLI_SIGN_REPORT"
+	// Used to write the report of the output file encrypted to the recipients, with age or gpg, because the report
+	// has snippets of the code and of the secrets found
+	// By default is false
+	// Validation: It is optional, when true the recipients and the json output file path are required
+	EnvEncryptReport = "HORUSEC_CLI_ENCRYPT_REPORT"
+	// Used to inform the recipients of the encrypted report, age or ssh public keys encrypted by age, or keys of gpg,
+	// like the fingerprint or the email, encrypted by gpg
+	// By default is empty
+	// Validation: The recipients must be all of age or all of gpg
+	EnvReportRecipients = "HORUSEC_CLI_REPORT_RECIPIENTS"
+	// Used to output the report without the code, the secrets, the commit authors and the absolute paths, to share it
+	// with auditors and vendors
+	// By default is false
+	// Validation: It is optional
+	EnvRedact = "HORUSEC_CLI_REDACT"
+	// Used to run the tools in each folder with the manifest of a module, like go.mod, package.json or pom.xml, in the
+	// languages without workdir
+	// By default is false
+	EnvEnableWorkDirDiscovery = "HORUSEC_CLI_ENABLE_WORK_DIR_DISCOVERY"
+	// Used to setup how the vulnerabilities found in tests, examples and fixtures are handled: flag, downgrade or
+	// exclude-from-gates. They are always flagged as test code and are kept in the report
+	// By default is flag
+	// Validation: It is mandatory to be in "flag", "downgrade", "exclude-from-gates"
+	EnvTestCodeMode = "HORUSEC_CLI_TEST_CODE_MODE"
+	// Used to add glob patterns of the paths with test code besides the default ones, like **/*_test.go and **/tests/**
+	// By default is empty
+	EnvTestCodePaths = "HORUSEC_CLI_TEST_CODE_PATHS"
+	// Used to select and pin the rule packs of the horusec engines, like java-core@1.4, the engines without a pack
+	// selected use their builtin rules
+	// By default is empty
+	// Validation: It is mandatory to be builtin or downloaded with horusec rules update
+	EnvRulePacks = "HORUSEC_CLI_RULE_PACKS"
+	// Directory where the rule packs are downloaded
+	// By default is the horusec folder in the user cache directory
+	EnvRulePacksDir = "HORUSEC_CLI_RULE_PACKS_DIR"
+	// Url of the index of the rule packs used by horusec rules update, the signature of the index is read from
+	// the same url with the suffix .sig
+	// By default is empty
+	EnvRulePacksIndexURL = "HORUSEC_CLI_RULE_PACKS_INDEX_URL"
+	// Ed25519 public key in base64 used to verify the signature of the index of the rule packs
+	// By default is empty
+	EnvRulePacksPublicKey = "HORUSEC_CLI_RULE_PACKS_PUBLIC_KEY"
+	// Used to setup how the vulnerabilities are printed in the text output: full, compact with one line for each
+	// vulnerability or grouped by file
+	// By default is full
+	// Validation: It is mandatory to be in "full", "compact", "grouped"
+	EnvPrintStyle = "HORUSEC_CLI_PRINT_STYLE"
+	// Used to write only the report of the output format in the stdout, the human output and the logs are written in
+	// the stderr. The json and sonarqube reports are written in the stdout when the json output file isn't informed
+	// By default is false
+	// Validation: It is optional is necessary a valid boolean value
+	EnvQuiet = "HORUSEC_CLI_QUIET"
+	// Names of the executables horusec-hook-<name> in the PATH that receive the events of the analysis, like the
+	// start and the end of each tool and the vulnerabilities found, as json lines in the stdin
+	// By default is empty
+	// Validation: It is optional is necessary the executables in the PATH
+	EnvEventHooks = "HORUSEC_CLI_EVENT_HOOKS"
+	// Commands or requests run before the analysis, after each tool and after the analysis with a json payload of
+	// the current state. Only read from the config file
+	// By default is empty
+	// Validation: It is optional is necessary a command or a valid http url in each hook
+	EnvLifecycleHooks = "HORUSEC_CLI_LIFECYCLE_HOOKS"
+	// Path of the file where all logs until the debug level are written, independent of the log level of the console
+	// The file is rotated when exceeds the max size and the rotated files older than the max age are removed
+	// By default is empty
+	// Validation: It is optional is necessary a valid path of a file
+	EnvLogFilePath = "HORUSEC_CLI_LOG_FILE_PATH"
+	// Maximum size in megabytes of the log file before it is rotated
+	// By default is 10
+	// Validation: It is optional is necessary a valid int64 value
+	EnvLogFileMaxSizeInMB = "HORUSEC_CLI_LOG_FILE_MAX_SIZE_MB"
+	// Maximum age in days of the rotated log files, older files are removed
+	// By default is 7
+	// Validation: It is optional is necessary a valid int64 value
+	EnvLogFileMaxAgeInDays = "HORUSEC_CLI_LOG_FILE_MAX_AGE_DAYS"
+	// Used to change the log level of each module, the module is found by the directories of the package that wrote
+	// the log. Example {"docker": "debug", "formatters": "info", "engine": "warn"}
+	// By default is empty, all modules use the log level
+	// Validation: It is optional is necessary valid log levels
+	EnvLogModuleLevels = "HORUSEC_CLI_LOG_MODULE_LEVELS"
+	// Directory where horusec writes everything of the analysis: the raw output of each tool, the vulnerabilities
+	// parsed of each tool, the final analysis and reports, the scan manifest and the timings
+	// By default is empty
+	// Validation: It is optional is necessary a valid path
+	EnvArtifactsDir = "HORUSEC_CLI_ARTIFACTS_DIR"
+	// Used to stop the analysis when the formatter of a tool panics while parsing its output, by default the panic is
+	// recovered, kept in the errors of the analysis and the other tools continue
+	// By default is false
+	// Validation: It is optional is necessary a valid boolean value
+	EnvStrict = "HORUSEC_CLI_STRICT"
+	// Timeout of the whole analysis as a duration, like 30m or 1h30m, it replaces the timeout in seconds of the
+	// analysis. When exceeded the containers running are killed, the results of the tools finished are reported as
+	// a partial report and the cli exits with the code 3
+	// By default is empty
+	// Validation: It is optional is necessary a valid duration of at least 10 seconds
+	EnvTimeout = "HORUSEC_CLI_TIMEOUT"
+	// File where the folders of the analyses created in the .horusec folders are tracked until removed, so the folders
+	// left by analyses that crashed are removed in the next analyses
+	// By default is work-dirs.json in the horusec folder of the user cache dir
+	EnvWorkDirsStateFile = "HORUSEC_CLI_WORK_DIRS_STATE_FILE"
+	// Age in hours of the tracked analysis folders of .horusec removed when the analysis starts, a negative value
+	// disables the removal
+	// By default is 24
+	EnvOrphanWorkDirsMaxAgeInHours = "HORUSEC_CLI_ORPHAN_WORK_DIRS_MAX_AGE_HOURS"
+	// How the docker daemon expects the paths of the host in the bind mounts. auto translates the windows paths like
+	// desktop-hyperv, desktop-wsl2 translates C:\src to /mnt/c/src and windows keeps the windows paths
+	// By default is auto
+	// Validation: It is mandatory to be in "auto", "linux", "desktop-hyperv", "desktop-wsl2", "windows"
+	EnvDockerDaemonFlavor = "HORUSEC_CLI_DOCKER_DAEMON_FLAVOR"
+	// Developer mode that delays and fails the docker calls randomly with the profile, like
+	// seed=42,fail-rate=0.1,delay-rate=0.5,max-delay=2s,calls=ImagePull|ContainerWait
+	// By default is empty
+	// Validation: It is optional is necessary a valid profile
+	EnvFaultInjection = "HORUSEC_CLI_FAULT_INJECTION"
 )
 
 type Config struct {
@@ -177,4 +606,102 @@ type Config struct {
 	toolsConfig                     map[tools.Tool]toolsconfig.ToolConfig
 	headers                         map[string]string
 	workDir                         *workdir.WorkDir
+	enableSecretVerification        bool
+	enableVendoredAndGeneratedCode  bool
+	symlinkMode                     string
+	maxParallel                     int64
+	sourceMode                      string
+	maxOutputSizeInMB               int64
+	engineWorkers                   int64
+	engineMemoryLimitInMB           int64
+	engineRuleTimeoutInMs           int64
+	maxFileSizeInMB                 int64
+	noCache                         bool
+	cacheDir                        string
+	remoteCacheURL                  string
+	remoteCacheMode                 string
+	interactive                     bool
+	dryRun                          bool
+	enableTelemetry                 bool
+	submitDependencies              bool
+	elasticsearchURL                string
+	elasticsearchIndex              string
+	elasticsearchAPIKey             string
+	localDBPath                     string
+	postgresURI                     string
+	policyPath                      string
+	policyBaselinePath              string
+	riskWeights                     map[string]string
+	minGrade                        string
+	maxFindings                     map[string]string
+	queueDir                        string
+	tags                            map[string]string
+	sourceRepositoryURL             string
+	sourceBranch                    string
+	sourceCommit                    string
+	sourcePullRequest               string
+	repoURL                         string
+	repoBranch                      string
+	repoToken                       string
+	repoSSHKeyPath                  string
+	baseImageAdvisoriesPath         string
+	tfPlanPath                      string
+	revealSecrets                   bool
+	codeContextLines                int64
+	remediationPath                 string
+	triageURL                       string
+	triageModel                     string
+	triageAPIKey                    string
+	minConfidence                   string
+	filters                         []string
+	codeOwnersPath                  string
+	splitReportByOwner              bool
+	ownerMappings                   []string
+	ownerWebhooks                   map[string]string
+	ticketsPath                     string
+	ticketsExcludeFromGates         bool
+	publishCheckRun                 bool
+	giteaURL                        string
+	giteaToken                      string
+	enableLeaksEntropy              bool
+	leaksEntropyAllowlistPatterns   []string
+	leaksEntropyAllowlistPaths      []string
+	leaksEntropyMinLength           int64
+	leaksEntropyBase64Threshold     float64
+	leaksEntropyHexThreshold        float64
+	remoteCachePublicKey            string
+	remoteCachePrivateKey           string
+	reportMinSeverity               string
+	failThreshold                   string
+	osvOfflineDatabasePath          string
+	severityMapping                 map[string]string
+	severityTablesPath              string
+	threadFixFieldMapping           map[string]string
+	deterministic                   bool
+	signReport                      bool
+	encryptReport                   bool
+	reportRecipients                []string
+	redact                          bool
+	enableWorkDirDiscovery          bool
+	testCodeMode                    string
+	testCodePaths                   []string
+	rulePacks                       []string
+	rulePacksDir                    string
+	rulePacksIndexURL               string
+	rulePacksPublicKey              string
+	printStyle                      string
+	quiet                           bool
+	eventHooks                      []string
+	lifecycleHooks                  lifecyclehooks.LifecycleHooks
+	logFilePath                     string
+	logFileMaxSizeInMB              int64
+	logFileMaxAgeInDays             int64
+	logModuleLevels                 map[string]string
+	artifactsDir                    string
+	strict                          bool
+	timeout                         string
+	workDirsStateFile               string
+	orphanWorkDirsMaxAgeInHours     int64
+	dockerDaemonFlavor              string
+	faultInjection                  string
 }
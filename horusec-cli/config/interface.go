@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/lifecyclehooks"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/spf13/cobra"
@@ -95,7 +96,297 @@ type IConfig interface {
 	GetToolsConfig() map[tools.Tool]toolsconfig.ToolConfig
 	SetToolsConfig(toolsConfig interface{})
 
+	GetEnableSecretVerification() bool
+	SetEnableSecretVerification(enableSecretVerification bool)
+
+	GetEnableVendoredAndGeneratedCode() bool
+	SetEnableVendoredAndGeneratedCode(enableVendoredAndGeneratedCode bool)
+
+	GetSymlinkMode() string
+	SetSymlinkMode(symlinkMode string)
+
+	GetMaxParallel() int64
+	SetMaxParallel(maxParallel int64)
+
+	GetSourceMode() string
+	SetSourceMode(sourceMode string)
+
+	GetMaxOutputSizeInMB() int64
+	SetMaxOutputSizeInMB(maxOutputSizeInMB int64)
+	GetEngineWorkers() int64
+	SetEngineWorkers(engineWorkers int64)
+	GetEngineMemoryLimitInMB() int64
+	SetEngineMemoryLimitInMB(engineMemoryLimitInMB int64)
+	GetEngineRuleTimeoutInMs() int64
+	SetEngineRuleTimeoutInMs(engineRuleTimeoutInMs int64)
+	GetMaxFileSizeInMB() int64
+	SetMaxFileSizeInMB(maxFileSizeInMB int64)
+
+	GetNoCache() bool
+	SetNoCache(noCache bool)
+
+	GetCacheDir() string
+	SetCacheDir(cacheDir string)
+
+	GetRemoteCacheURL() string
+	SetRemoteCacheURL(remoteCacheURL string)
+
+	GetRemoteCacheMode() string
+	SetRemoteCacheMode(remoteCacheMode string)
+
+	GetInteractive() bool
+	SetInteractive(interactive bool)
+
+	GetDryRun() bool
+	SetDryRun(dryRun bool)
+
+	GetEnableTelemetry() bool
+	SetEnableTelemetry(enableTelemetry bool)
+
+	GetSubmitDependencies() bool
+	SetSubmitDependencies(submitDependencies bool)
+
+	GetElasticsearchURL() string
+	SetElasticsearchURL(elasticsearchURL string)
+
+	GetElasticsearchIndex() string
+	SetElasticsearchIndex(elasticsearchIndex string)
+
+	GetElasticsearchAPIKey() string
+	SetElasticsearchAPIKey(elasticsearchAPIKey string)
+
+	GetLocalDBPath() string
+	SetLocalDBPath(localDBPath string)
+
+	GetPostgresURI() string
+	SetPostgresURI(postgresURI string)
+
+	GetPolicyPath() string
+	SetPolicyPath(policyPath string)
+
+	GetPolicyBaselinePath() string
+	SetPolicyBaselinePath(policyBaselinePath string)
+
+	GetRiskWeights() map[string]string
+	SetRiskWeights(riskWeights interface{})
+
+	GetMinGrade() string
+	SetMinGrade(minGrade string)
+
+	GetMaxFindings() map[string]string
+	SetMaxFindings(maxFindings interface{})
+
+	GetQueueDir() string
+	SetQueueDir(queueDir string)
+
+	GetTags() map[string]string
+	SetTags(tags interface{})
+
+	GetSourceRepositoryURL() string
+	SetSourceRepositoryURL(sourceRepositoryURL string)
+
+	GetSourceBranch() string
+	SetSourceBranch(sourceBranch string)
+
+	GetSourceCommit() string
+	SetSourceCommit(sourceCommit string)
+
+	GetSourcePullRequest() string
+	SetSourcePullRequest(sourcePullRequest string)
+
+	GetRepoURL() string
+	SetRepoURL(repoURL string)
+
+	GetRepoBranch() string
+	SetRepoBranch(repoBranch string)
+
+	GetRepoToken() string
+	SetRepoToken(repoToken string)
+
+	GetRepoSSHKeyPath() string
+	SetRepoSSHKeyPath(repoSSHKeyPath string)
+
+	GetBaseImageAdvisoriesPath() string
+	SetBaseImageAdvisoriesPath(baseImageAdvisoriesPath string)
+
+	GetTfPlanPath() string
+	SetTfPlanPath(tfPlanPath string)
+
+	GetRevealSecrets() bool
+	SetRevealSecrets(revealSecrets bool)
+
+	GetCodeContextLines() int64
+	SetCodeContextLines(codeContextLines int64)
+
+	GetRemediationPath() string
+	SetRemediationPath(remediationPath string)
+
+	GetTriageURL() string
+	SetTriageURL(triageURL string)
+
+	GetTriageModel() string
+	SetTriageModel(triageModel string)
+
+	GetTriageAPIKey() string
+	SetTriageAPIKey(triageAPIKey string)
+
+	GetMinConfidence() string
+	SetMinConfidence(minConfidence string)
+
+	GetFilters() []string
+	SetFilters(filters []string)
+
+	GetCodeOwnersPath() string
+	SetCodeOwnersPath(codeOwnersPath string)
+
+	GetSplitReportByOwner() bool
+	SetSplitReportByOwner(splitReportByOwner bool)
+
+	GetOwnerMappings() []string
+	SetOwnerMappings(ownerMappings []string)
+
+	GetOwnerWebhooks() map[string]string
+	SetOwnerWebhooks(ownerWebhooks interface{})
+
+	GetTicketsPath() string
+	SetTicketsPath(ticketsPath string)
+
+	GetTicketsExcludeFromGates() bool
+	SetTicketsExcludeFromGates(ticketsExcludeFromGates bool)
+
+	GetPublishCheckRun() bool
+	SetPublishCheckRun(publishCheckRun bool)
+
+	GetGiteaURL() string
+	SetGiteaURL(giteaURL string)
+
+	GetGiteaToken() string
+	SetGiteaToken(giteaToken string)
+
+	GetEnableLeaksEntropy() bool
+	SetEnableLeaksEntropy(enableLeaksEntropy bool)
+
+	GetLeaksEntropyAllowlistPatterns() []string
+	SetLeaksEntropyAllowlistPatterns(leaksEntropyAllowlistPatterns []string)
+
+	GetLeaksEntropyAllowlistPaths() []string
+	SetLeaksEntropyAllowlistPaths(leaksEntropyAllowlistPaths []string)
+
+	GetLeaksEntropyMinLength() int64
+	SetLeaksEntropyMinLength(leaksEntropyMinLength int64)
+
+	GetLeaksEntropyBase64Threshold() float64
+	SetLeaksEntropyBase64Threshold(leaksEntropyBase64Threshold float64)
+
+	GetLeaksEntropyHexThreshold() float64
+	SetLeaksEntropyHexThreshold(leaksEntropyHexThreshold float64)
+
+	GetRemoteCachePublicKey() string
+	SetRemoteCachePublicKey(remoteCachePublicKey string)
+
+	GetRemoteCachePrivateKey() string
+	SetRemoteCachePrivateKey(remoteCachePrivateKey string)
+
+	GetReportMinSeverity() string
+	SetReportMinSeverity(reportMinSeverity string)
+
+	GetFailThreshold() string
+	SetFailThreshold(failThreshold string)
+
+	GetOsvOfflineDatabasePath() string
+	SetOsvOfflineDatabasePath(osvOfflineDatabasePath string)
+
+	GetSeverityMapping() map[string]string
+	SetSeverityMapping(severityMapping interface{})
+	GetSeverityTablesPath() string
+	SetSeverityTablesPath(severityTablesPath string)
+
+	GetThreadFixFieldMapping() map[string]string
+	SetThreadFixFieldMapping(threadFixFieldMapping interface{})
+
+	GetDeterministic() bool
+	SetDeterministic(deterministic bool)
+
+	GetSignReport() bool
+	SetSignReport(signReport bool)
+
+	GetEncryptReport() bool
+	SetEncryptReport(encryptReport bool)
+
+	GetReportRecipients() []string
+	SetReportRecipients(reportRecipients []string)
+
+	GetRedact() bool
+	SetRedact(redact bool)
+
+	GetEnableWorkDirDiscovery() bool
+	SetEnableWorkDirDiscovery(enableWorkDirDiscovery bool)
+
+	GetTestCodeMode() string
+	SetTestCodeMode(testCodeMode string)
+
+	GetTestCodePaths() []string
+	SetTestCodePaths(testCodePaths []string)
+
+	GetRulePacks() []string
+	SetRulePacks(rulePacks []string)
+
+	GetRulePacksDir() string
+	SetRulePacksDir(rulePacksDir string)
+
+	GetRulePacksIndexURL() string
+	SetRulePacksIndexURL(rulePacksIndexURL string)
+
+	GetRulePacksPublicKey() string
+	SetRulePacksPublicKey(rulePacksPublicKey string)
+
+	GetPrintStyle() string
+	SetPrintStyle(printStyle string)
+
+	GetQuiet() bool
+	SetQuiet(quiet bool)
+
+	GetEventHooks() []string
+	SetEventHooks(eventHooks []string)
+
+	GetLifecycleHooks() lifecyclehooks.LifecycleHooks
+	SetLifecycleHooks(lifecycleHooks interface{})
+
+	GetLogFilePath() string
+	SetLogFilePath(logFilePath string)
+
+	GetLogFileMaxSizeInMB() int64
+	SetLogFileMaxSizeInMB(logFileMaxSizeInMB int64)
+
+	GetLogFileMaxAgeInDays() int64
+	SetLogFileMaxAgeInDays(logFileMaxAgeInDays int64)
+
+	GetLogModuleLevels() map[string]string
+	SetLogModuleLevels(logModuleLevels interface{})
+
+	GetArtifactsDir() string
+	SetArtifactsDir(artifactsDir string)
+
+	GetStrict() bool
+	SetStrict(strict bool)
+
+	GetTimeout() string
+	SetTimeout(timeout string)
+
+	GetWorkDirsStateFile() string
+	SetWorkDirsStateFile(workDirsStateFile string)
+
+	GetOrphanWorkDirsMaxAgeInHours() int64
+	SetOrphanWorkDirsMaxAgeInHours(orphanWorkDirsMaxAgeInHours int64)
+
+	GetDockerDaemonFlavor() string
+	SetDockerDaemonFlavor(dockerDaemonFlavor string)
+
+	GetFaultInjection() string
+	SetFaultInjection(faultInjection string)
+
 	IsEmptyRepositoryAuthorization() bool
 	ToBytes(isMarshalIndent bool) (bytes []byte)
 	NormalizeConfigs() IConfig
+	SaveHashesInConfigFile() error
 }
@@ -15,12 +15,15 @@
 package analyser
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/signal"
 	"path"
-	"strconv"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -37,21 +40,41 @@ import (
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/kotlin/horuseckotlin"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	analysisUseCases "github.com/ZupIT/horusec/development-kit/pkg/usecases/analysis"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/file"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
 	languageDetect "github.com/ZupIT/horusec/horusec-cli/internal/controllers/language_detect"
 	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/printresults"
+	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/triage"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/aitriage"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/artifacts"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/attestation"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/cache"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/checkrun"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/cicontext"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/codecontext"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/codeowners"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/dependencysubmission"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/elasticsearch"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/events"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/filters"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/csharp/scs"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/generic/horusecdockerfile"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/generic/semgrep"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/golang/gosec"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/golang/osvscanner"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/hcl"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/hcl/checkov"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/javascript/eslint"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/javascript/npmaudit"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/javascript/yarnaudit"
@@ -60,7 +83,39 @@ import (
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/python/bandit"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/python/safety"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/ruby/brakeman"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/gitea"
 	horusecAPI "github.com/ZupIT/horusec/horusec-cli/internal/services/horusapi"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/lifecyclehooks"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/localdb"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/maxfindings"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/ownernotification"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/policy"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/postgres"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/progress"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/redact"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/remediation"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/risk"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/secretmask"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/secretverifier"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/severitymapping"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/telemetry"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/testcode"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/tickets"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/workdirs"
+)
+
+const (
+	// tfPlanFileName is the name of the terraform plan copied to the folder mounted in the container of checkov
+	tfPlanFileName = "horusec-tf-plan.json"
+	// maxWaitAfterTimeout is the time given to the formatters parsing the outputs when the timeout is reached
+	maxWaitAfterTimeout = 10 * time.Second
+)
+
+var (
+	ErrTfPlanOutsideProject = errors.New("{HORUSEC_CLI} the terraform plan must be inside the project path " +
+		"when the source mode is read-only")
+	ErrAnalysisTimeout = errors.New("{HORUSEC_CLI} analysis timed out, the report is partial with only the tools " +
+		"finished before the timeout")
 )
 
 type Interface interface {
@@ -77,12 +132,46 @@ type Analyser struct {
 	printController   printresults.Interface
 	horusecAPIService horusecAPI.IService
 	formatterService  formatters.IService
+	secretVerifier    secretverifier.Interface
+	cache             cache.Interface
+	progress          progress.Interface
+	triage            triage.Interface
+	telemetry         telemetry.Interface
+	policy            policy.Interface
+	risk              risk.Interface
+	ciContext         cicontext.Interface
+	codeContext       codecontext.Interface
+	remediation       remediation.Interface
+	aiTriage          aitriage.Interface
+	severityMapping   severitymapping.Interface
+	attestation       attestation.Interface
+	testCode          testcode.Interface
+	artifacts         artifacts.Interface
+	workDirs          workdirs.Interface
+	dependencyGraph   dependencysubmission.Interface
+	searchIndex       elasticsearch.Interface
+	localDB           localdb.Interface
+	postgres          postgres.Interface
+	redact            redact.Interface
+	maxFindings       maxfindings.Interface
+	lifecycleHooks    lifecyclehooks.Interface
+	filters           filters.Interface
+	codeOwners        codeowners.Interface
+	tickets           tickets.Interface
+	ownerNotification ownernotification.Interface
+	checkRun          checkrun.Interface
+	gitea             gitea.Interface
 }
 
 func NewAnalyser(config cliConfig.IConfig) Interface {
 	useCases := analysisUseCases.NewAnalysisUseCases()
 	analysis := useCases.NewAnalysisRunning()
-	dockerAPI := docker.NewDockerAPI(dockerClient.NewDockerClient(), config, analysis.ID)
+	client := dockerClient.NewFaultInjectionClient(dockerClient.NewDockerClient(), config.GetFaultInjection())
+	dockerAPI := docker.NewDockerAPI(client, config, analysis.ID)
+	formatterService := formatters.NewFormatterService(analysis, dockerAPI, config, nil)
+	analysisProgress := progress.NewProgress(config)
+	dockerAPI.SetProgress(analysisProgress)
+	formatterService.SetProgress(analysisProgress)
 	return &Analyser{
 		dockerSDK:         dockerAPI,
 		analysis:          analysis,
@@ -91,17 +180,84 @@ func NewAnalyser(config cliConfig.IConfig) Interface {
 		analysisUseCases:  useCases,
 		printController:   printresults.NewPrintResults(analysis, config),
 		horusecAPIService: horusecAPI.NewHorusecAPIService(config),
-		formatterService:  formatters.NewFormatterService(analysis, dockerAPI, config, nil),
+		formatterService:  formatterService,
+		secretVerifier:    secretverifier.NewSecretVerifier(config),
+		cache:             cache.NewCache(config),
+		progress:          analysisProgress,
+		triage:            triage.NewTriage(config),
+		telemetry:         telemetry.NewTelemetry(config),
+		policy:            policy.NewPolicy(config),
+		risk:              risk.NewRisk(config),
+		ciContext:         cicontext.NewCIContext(config),
+		codeContext:       codecontext.NewCodeContext(config),
+		remediation:       remediation.NewRemediation(config),
+		aiTriage:          aitriage.NewAITriage(config),
+		severityMapping:   severitymapping.NewSeverityMapping(config),
+		attestation:       attestation.NewAttestation(config, client),
+		testCode:          testcode.NewTestCode(config),
+		artifacts:         artifacts.NewArtifacts(config),
+		workDirs:          workdirs.NewWorkDirs(config),
+		dependencyGraph:   dependencysubmission.NewDependencySubmission(config),
+		searchIndex:       elasticsearch.NewElasticsearch(config),
+		localDB:           localdb.NewLocalDB(config),
+		postgres:          postgres.NewPostgres(config),
+		redact:            redact.NewRedact(config),
+		maxFindings:       maxfindings.NewMaxFindings(config),
+		lifecycleHooks:    lifecyclehooks.NewLifecycleHooks(config),
+		filters:           filters.NewChain(config),
+		codeOwners:        codeowners.NewCodeOwners(config),
+		tickets:           tickets.NewTickets(config),
+		ownerNotification: ownernotification.NewOwnerNotification(config),
+		checkRun:          checkrun.NewCheckRun(config),
+		gitea:             gitea.NewGitea(config),
 	}
 }
 
 func (a *Analyser) AnalysisDirectory() (totalVulns int, err error) {
 	a.removeTrashByInterruptProcess()
+	a.trackWorkDir()
+	stopEventHooks := events.StartExecHooks(a.config.GetEventHooks())
 	totalVulns, err = a.runAnalysis()
+	a.publishAnalysisCompleted(err)
+	err = a.runPostAnalysisHooks(err)
+	a.publishResult(totalVulns, err)
+	stopEventHooks()
 	a.removeHorusecFolder()
+	if err == nil && a.config.GetInteractive() && !a.config.GetDryRun() {
+		err = a.triage.StartTriage(a.analysis)
+	}
 	return totalVulns, err
 }
 
+// publishAnalysisCompleted runs after the print and the gates, so the event has the error of the gates that failed
+func (a *Analyser) publishAnalysisCompleted(err error) {
+	if a.config.GetDryRun() {
+		return
+	}
+	events.Publish(events.NewAnalysisCompleted(a.analysis, err))
+}
+
+// runPostAnalysisHooks keeps the error of the analysis, the hooks only fail the analysis that succeeded
+func (a *Analyser) runPostAnalysisHooks(err error) error {
+	if a.config.GetDryRun() {
+		return err
+	}
+	if hookErr := a.lifecycleHooks.RunPostAnalysis(a.analysis, err); err == nil {
+		return hookErr
+	}
+	return err
+}
+
+// publishResult runs after the hooks, so the check run and the commit status are the same of the exit code of horusec
+func (a *Analyser) publishResult(totalVulns int, err error) {
+	if a.config.GetDryRun() {
+		return
+	}
+	analysis := a.redact.Redact(a.analysis)
+	a.checkRun.Publish(analysis, totalVulns, err)
+	a.gitea.Publish(analysis, totalVulns, err)
+}
+
 func (a *Analyser) removeTrashByInterruptProcess() {
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt)
@@ -113,13 +269,42 @@ func (a *Analyser) removeTrashByInterruptProcess() {
 	}()
 }
 
+// trackWorkDir removes the analysis folders left by the analyses that crashed before, then tracks the folder of
+// this analysis until it is removed
+func (a *Analyser) trackWorkDir() {
+	if a.config.GetDryRun() {
+		return
+	}
+	a.workDirs.RemoveOrphans()
+	a.workDirs.Track(filepath.Join(a.config.GetProjectPath(), workdirs.HorusecFolder, a.analysis.ID.String()))
+}
+
 func (a *Analyser) removeHorusecFolder() {
-	err := os.RemoveAll(a.config.GetProjectPath() + file.ReplacePathSeparator("/.horusec"))
+	horusecFolder := a.config.GetProjectPath() + file.ReplacePathSeparator("/.horusec")
+	err := os.RemoveAll(horusecFolder)
 	logger.LogErrorWithLevel(messages.MsgErrorRemoveAnalysisFolder, err, logger.ErrorLevel)
+	if err == nil && !a.config.GetDryRun() {
+		a.workDirs.Untrack(horusecFolder)
+	}
 	a.dockerSDK.DeleteContainersFromAPI()
 }
 
 func (a *Analyser) runAnalysis() (totalVulns int, err error) {
+	if a.config.GetDryRun() {
+		return 0, a.runDryRun()
+	}
+
+	if err := a.lifecycleHooks.RunPreAnalysis(a.analysis); err != nil {
+		return 0, err
+	}
+
+	if cachedAnalysis := a.cache.GetAnalysis(); cachedAnalysis != nil {
+		a.setCachedAnalysis(cachedAnalysis)
+		a.setCodeContext()
+		a.maskSecrets()
+		return a.sendAnalysisAndStartPrintResults()
+	}
+
 	langs, err := a.languageDetect.LanguageDetect(a.config.GetProjectPath())
 	if err != nil {
 		return 0, err
@@ -128,22 +313,310 @@ func (a *Analyser) runAnalysis() (totalVulns int, err error) {
 	monitor := horusec.NewMonitor()
 
 	a.setMonitor(monitor)
+	a.formatterService.SetFilesByLanguage(a.languageDetect.GetFilesByLanguage())
+	a.lifecycleHooks.StartPostTool()
 	a.startDetectVulnerabilities(langs)
+	a.lifecycleHooks.StopPostTool()
+	if a.lifecycleHooks.IsAborted() {
+		return 0, lifecyclehooks.ErrAborted
+	}
+	a.setScanManifest()
+	a.artifacts.SetRawOutputPaths(a.analysis)
+	a.artifacts.SaveParsedVulnerabilities(a.analysis)
+	a.setRules()
+	a.setSeverities()
+	a.setTestCode()
+	a.setRemediations()
+	a.verifySecrets()
+	a.setCodeContext()
+	a.maskSecrets()
+	a.setTriages()
+	a.cache.SaveAnalysis(a.analysis)
+
+	totalVulns, err = a.sendAnalysisAndStartPrintResults()
+	a.telemetry.SendAnalysis(a.analysis, langs, a.formatterService.GetToolsFailed())
+	return totalVulns, err
+}
+
+// runDryRun shows what the analysis would do, the formatters log the containers instead of starting them
+func (a *Analyser) runDryRun() error {
+	logger.LogInfoWithLevel(messages.MsgInfoDryRunConfig+string(a.getConfigsToDryRun()), logger.InfoLevel)
+	langs, err := a.languageDetect.LanguageDetect(a.config.GetProjectPath())
+	if err != nil {
+		return err
+	}
+
+	logger.LogInfoWithLevel(messages.MsgInfoDryRunLanguages+a.languagesToString(langs), logger.InfoLevel)
+	logger.LogInfoWithLevel(messages.MsgInfoDryRunPathsIgnored+
+		strings.Join(a.languageDetect.GetPathsIgnored(), ", "), logger.InfoLevel)
+	a.setMonitor(horusec.NewMonitor())
+	a.formatterService.SetFilesByLanguage(a.languageDetect.GetFilesByLanguage())
+	a.startDetectVulnerabilities(langs)
+	return nil
+}
+
+func (a *Analyser) getConfigsToDryRun() []byte {
+	configs := map[string]interface{}{}
+	if err := json.Unmarshal(a.config.ToBytes(false), &configs); err != nil {
+		return nil
+	}
+	for _, key := range []string{"repositoryAuthorization", "triageAPIKey"} {
+		if configs[key] != "" {
+			configs[key] = "***"
+		}
+	}
+	configsBytes, _ := json.MarshalIndent(configs, "", "  ")
+	return configsBytes
+}
+
+func (a *Analyser) languagesToString(langs []languages.Language) string {
+	names := make([]string, 0, len(langs))
+	for _, language := range langs {
+		names = append(names, language.ToString())
+	}
+	return strings.Join(names, ", ")
+}
 
-	return a.sendAnalysisAndStartPrintResults()
+// setScanManifest adds the digests of the images after the analysis, because the images are pulled by the tools
+func (a *Analyser) setScanManifest() {
+	manifest := a.formatterService.GetScanManifest()
+	for index := range manifest.ToolsExecuted {
+		if image := manifest.ToolsExecuted[index].Image; image != "" {
+			manifest.ToolsExecuted[index].ImageDigest = a.dockerSDK.GetImageDigest(image)
+		}
+	}
+	manifest.FilesScanned = a.getFilesScanned()
+	manifest.CopyDurationInSeconds = a.languageDetect.GetCopyDuration().Seconds()
+	a.analysis.ScanManifest = manifest
+	a.analysis.SkippedFiles = a.languageDetect.GetVendoredOrGeneratedSkipped()
+}
+
+// getFilesScanned counts each file once, because the files can be of many languages, like the leaks
+func (a *Analyser) getFilesScanned() int {
+	files := map[string]bool{}
+	for _, languageFiles := range a.languageDetect.GetFilesByLanguage() {
+		for _, file := range languageFiles {
+			files[file] = true
+		}
+	}
+	return len(files)
+}
+
+// setCachedAnalysis keeps the id and dates of the current analysis, so the cached result is sent
+// as a new analysis
+func (a *Analyser) setCachedAnalysis(cachedAnalysis *horusec.Analysis) {
+	cachedAnalysis.ID = a.analysis.ID
+	cachedAnalysis.CreatedAt = a.analysis.CreatedAt
+	cachedAnalysis.RepositoryID = a.analysis.RepositoryID
+	cachedAnalysis.RepositoryName = a.analysis.RepositoryName
+	cachedAnalysis.CompanyID = a.analysis.CompanyID
+	cachedAnalysis.CompanyName = a.analysis.CompanyName
+	a.analysis = cachedAnalysis
 }
 
 func (a *Analyser) sendAnalysisAndStartPrintResults() (int, error) {
+	a.removeVulnerabilitiesBelowMinConfidence()
 	a.analysis = a.analysis.SetAnalysisFinishedData().SetupIDInAnalysisContents().
 		SortVulnerabilitiesByCriticality().SetDefaultVulnerabilityType().SortVulnerabilitiesByType()
+	a.setTags()
+	a.analysis.IsPartial = a.config.GetIsTimeout()
+	a.analysis.Source = a.ciContext.Detect()
 	a.horusecAPIService.SendAnalysis(a.analysis)
 	analysisSaved := a.horusecAPIService.GetAnalysis(a.analysis.ID)
 	if analysisSaved != nil && analysisSaved.ID != uuid.Nil {
+		analysisSaved.Tags = a.analysis.Tags
+		analysisSaved.Source = a.analysis.Source
+		analysisSaved.ScanManifest = a.analysis.ScanManifest
+		analysisSaved.SkippedFiles = a.analysis.SkippedFiles
 		a.analysis = analysisSaved
 	}
 	a.setFalsePositive()
-	a.printController.SetAnalysis(a.analysis)
-	return a.printController.StartPrintResults()
+	a.setWarnOnly()
+	a.setDeterministic()
+	a.codeOwners.SetOwners(a.analysis)
+	a.setTickets()
+	if err := a.filters.Apply(a.analysis); err != nil {
+		return 0, err
+	}
+	a.analysis.RiskScore = a.risk.Calculate(a.analysis)
+	if err := a.evaluatePolicy(); err != nil {
+		return 0, err
+	}
+	a.printController.SetAnalysis(a.redact.Redact(a.analysis))
+	totalVulns, err := a.printController.StartPrintResults()
+	a.artifacts.SaveAnalysis(a.analysis)
+	a.dependencyGraph.Submit(a.analysis)
+	a.searchIndex.Index(a.analysis)
+	a.ownerNotification.Notify(a.redact.Redact(a.analysis))
+	a.localDB.Store(a.analysis)
+	a.postgres.Export(a.analysis)
+	if err != nil {
+		return totalVulns, err
+	}
+	if err := a.signReport(); err != nil {
+		return totalVulns, err
+	}
+	return totalVulns, a.checkGates()
+}
+
+// signReport runs after the print, because the attestation has the digest of the json report written
+func (a *Analyser) signReport() error {
+	if !a.config.GetSignReport() {
+		return nil
+	}
+	return a.attestation.SignReport(a.analysis)
+}
+
+func (a *Analyser) checkGates() error {
+	if len(a.analysis.PolicyDenials) > 0 {
+		return policy.ErrDenied
+	}
+	if a.risk.IsBelowMinGrade(a.analysis.RiskScore) {
+		return risk.ErrGradeBelowMinimum
+	}
+	if exceeded := a.maxFindings.GetExceeded(a.analysis); len(exceeded) > 0 {
+		logger.LogWarnWithLevel(messages.MsgWarnMaxFindingsExceeded+strings.Join(exceeded, ", "), logger.WarnLevel)
+		return maxfindings.ErrMaxFindingsExceeded
+	}
+	if a.analysis.IsPartial && a.isTimeoutOfDuration() {
+		return ErrAnalysisTimeout
+	}
+	return nil
+}
+
+// isTimeoutOfDuration limits the exit code of the timeout to the flag timeout, the analyses that reach the older
+// analysis-timeout keep the exit code they had before the flag
+func (a *Analyser) isTimeoutOfDuration() bool {
+	timeout, err := time.ParseDuration(a.config.GetTimeout())
+	return err == nil && timeout > 0
+}
+
+// evaluatePolicy keeps the denials in the analysis, so the printers show why it was denied
+func (a *Analyser) evaluatePolicy() error {
+	denials, err := a.policy.Evaluate(a.analysis)
+	if err != nil {
+		return err
+	}
+	a.analysis.PolicyDenials = denials
+	return nil
+}
+
+// removeVulnerabilitiesBelowMinConfidence runs after the cache, that keeps all vulnerabilities to other min confidences
+func (a *Analyser) removeVulnerabilitiesBelowMinConfidence() {
+	if a.config.GetMinConfidence() == "" {
+		return
+	}
+	minConfidence := confidence.Confidence(strings.ToUpper(a.config.GetMinConfidence()))
+	analysisVulnerabilities := a.analysis.AnalysisVulnerabilities[:0]
+	for index := range a.analysis.AnalysisVulnerabilities {
+		vulnConfidence := confidence.Confidence(a.analysis.AnalysisVulnerabilities[index].Vulnerability.Confidence)
+		if !vulnConfidence.IsBelow(minConfidence) {
+			analysisVulnerabilities = append(analysisVulnerabilities, a.analysis.AnalysisVulnerabilities[index])
+		}
+	}
+	a.analysis.AnalysisVulnerabilities = analysisVulnerabilities
+}
+
+// setDeterministic runs after the analysis is sent to horusec platform, that needs the ids and dates
+func (a *Analyser) setDeterministic() {
+	if a.config.GetDeterministic() {
+		a.analysis = a.analysis.SortVulnerabilitiesByLocation().SortVulnerabilitiesByCriticality().
+			SortVulnerabilitiesByType().RemoveRandomData()
+	}
+}
+
+func (a *Analyser) setTags() {
+	if len(a.config.GetTags()) > 0 {
+		a.analysis.Tags = a.config.GetTags()
+	}
+}
+
+// setSeverities runs before the verification of the secrets, that raises the severity of the active secrets
+func (a *Analyser) setSeverities() {
+	if len(a.config.GetSeverityMapping()) > 0 {
+		a.severityMapping.SetSeverities(a.analysis)
+	}
+}
+
+// setWarnOnly runs to the cached analysis too, because the grace period of the tools can end after it was cached
+func (a *Analyser) setWarnOnly() {
+	warnOnlyTools := map[tools.Tool]bool{}
+	for index := range a.analysis.AnalysisVulnerabilities {
+		vulnerability := &a.analysis.AnalysisVulnerabilities[index].Vulnerability
+		toolConfig := a.config.GetToolsConfig()[vulnerability.SecurityTool]
+		vulnerability.IsWarnOnly = toolConfig.IsWarnOnly(time.Now())
+		if vulnerability.IsWarnOnly && !warnOnlyTools[vulnerability.SecurityTool] {
+			warnOnlyTools[vulnerability.SecurityTool] = true
+			logger.LogWarnWithLevel(strings.ReplaceAll(messages.MsgWarnToolWarnOnly, "{{0}}",
+				vulnerability.SecurityTool.ToString()), logger.WarnLevel)
+		}
+	}
+}
+
+// setTestCode runs after the mapping of the severities to downgrade the mapped severities of the test code
+func (a *Analyser) setTestCode() {
+	a.testCode.SetTestCode(a.analysis)
+}
+
+func (a *Analyser) verifySecrets() {
+	if a.config.GetEnableSecretVerification() {
+		a.secretVerifier.VerifyAnalysis(a.analysis)
+	}
+}
+
+// setRules runs before the severity mapping, that replaces the severities of the tables, and before the remediations,
+// so the remediations are also found by the cwe of the rules
+func (a *Analyser) setRules() {
+	a.formatterService.GetSeverityTables().SetRules(a.analysis)
+}
+
+// setRemediations isn't called to the cached analysis, it was saved with the remediations
+func (a *Analyser) setRemediations() {
+	if err := a.remediation.SetRemediations(a.analysis); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorSetRemediations, err, logger.ErrorLevel)
+	}
+}
+
+// setTickets runs to the cached analysis too, the status of the tickets changes without changes in the project
+func (a *Analyser) setTickets() {
+	if err := a.tickets.SetTickets(a.analysis); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorSetTickets, err, logger.ErrorLevel)
+	}
+}
+
+// setTriages runs after the code context is added, it is sent to the triage endpoint with the finding
+func (a *Analyser) setTriages() {
+	if a.config.GetTriageURL() != "" {
+		a.aiTriage.SetTriages(a.analysis)
+	}
+}
+
+func (a *Analyser) setCodeContext() {
+	if a.config.GetCodeContextLines() > 0 {
+		a.codeContext.SetCodeContext(a.analysis)
+	}
+}
+
+// maskSecrets runs after the verification of the secrets, that needs their values, so the secrets aren't kept in
+// the cache, the outputs and the analysis sent to horusec platform
+func (a *Analyser) maskSecrets() {
+	if a.config.GetRevealSecrets() {
+		return
+	}
+	for index := range a.analysis.AnalysisVulnerabilities {
+		vulnerability := &a.analysis.AnalysisVulnerabilities[index].Vulnerability
+		if vulnerability.SecurityTool == tools.HorusecLeaks || vulnerability.SecurityTool == tools.GitLeaks {
+			vulnerability.Code = secretmask.MaskLeakCode(vulnerability.Code)
+			a.maskCodeContext(vulnerability)
+		}
+	}
+	a.analysis.Errors = secretmask.Mask(a.analysis.Errors)
+}
+
+func (a *Analyser) maskCodeContext(vulnerability *horusec.Vulnerability) {
+	for index := range vulnerability.CodeContext {
+		vulnerability.CodeContext[index].Code = secretmask.MaskLeakCode(vulnerability.CodeContext[index].Code)
+	}
 }
 
 func (a *Analyser) setMonitor(monitor *horusec.Monitor) {
@@ -152,32 +625,59 @@ func (a *Analyser) setMonitor(monitor *horusec.Monitor) {
 }
 
 func (a *Analyser) startDetectVulnerabilities(langs []languages.Language) {
+	a.progress.Start()
+	defer a.progress.Stop()
+	workDir := a.getWorkDir()
 	for _, language := range langs {
-		for _, projectSubPath := range a.config.GetWorkDir().GetArrayByLanguage(language) {
+		for _, projectSubPath := range workDir.GetArrayByLanguage(language) {
 			if a.shouldAnalysePath(projectSubPath) {
 				a.logProjectSubPath(language, projectSubPath)
 				a.mapDetectVulnerabilityByLanguage()[language](projectSubPath)
 			}
 		}
 	}
+	if a.config.GetTfPlanPath() != "" {
+		a.detectVulnerabilityTfPlan()
+	}
 
 	a.runMonitorTimeout(a.config.GetTimeoutInSecondsAnalysis())
 }
 
+// getWorkDir expands the globs of the workdir and discovers the modules of the languages without workdir
+func (a *Analyser) getWorkDir() *workdir.WorkDir {
+	workDir := a.config.GetWorkDir().ExpandGlobs(a.config.GetProjectPath())
+	if a.config.GetEnableWorkDirDiscovery() {
+		workDir.DiscoverModuleRoots(a.config.GetProjectPath())
+	}
+	return workDir
+}
+
 func (a *Analyser) runMonitorTimeout(monitor int64) {
+	if a.lifecycleHooks.IsAborted() {
+		a.dockerSDK.DeleteContainersFromAPI()
+		a.waitFormattersAfterTimeout()
+		return
+	}
 	if monitor <= 0 {
 		a.dockerSDK.DeleteContainersFromAPI()
 		a.config.SetIsTimeout(true)
+		a.waitFormattersAfterTimeout()
 	}
 
 	if !a.monitor.IsFinished() && !a.config.GetIsTimeout() {
-		logger.LogInfoWithLevel(
-			fmt.Sprintf(messages.MsgInfoMonitorTimeoutIn+strconv.Itoa(int(monitor))+"s"), logger.InfoLevel)
 		time.Sleep(time.Duration(a.config.GetMonitorRetryInSeconds()) * time.Second)
 		a.runMonitorTimeout(monitor - a.config.GetMonitorRetryInSeconds())
 	}
 }
 
+// waitFormattersAfterTimeout keeps the results of the formatters that were parsing the outputs when the containers
+// were killed, the tools with the containers killed finish with error
+func (a *Analyser) waitFormattersAfterTimeout() {
+	for waited := time.Duration(0); !a.monitor.IsFinished() && waited < maxWaitAfterTimeout; waited += time.Second {
+		time.Sleep(time.Second)
+	}
+}
+
 //nolint:funlen all Languages is greater than 15
 func (a *Analyser) mapDetectVulnerabilityByLanguage() map[languages.Language]func(string) {
 	return map[languages.Language]func(string){
@@ -215,8 +715,9 @@ func (a *Analyser) detectVulnerabilityLeaks(projectSubPath string) {
 }
 
 func (a *Analyser) detectVulnerabilityGo(projectSubPath string) {
-	a.monitor.AddProcess(1)
+	a.monitor.AddProcess(2)
 	go gosec.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	go osvscanner.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityJava(projectSubPath string) {
@@ -248,7 +749,11 @@ func (a *Analyser) detectVulnerabilityRuby(projectSubPath string) {
 	go brakeman.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
 }
 
+// detectVulnerabilityHCL is skipped when the terraform plan is informed, the planned resources are checked instead
 func (a *Analyser) detectVulnerabilityHCL(projectSubPath string) {
+	if a.config.GetTfPlanPath() != "" {
+		return
+	}
 	a.monitor.AddProcess(1)
 	go hcl.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
 }
@@ -269,8 +774,50 @@ func (a *Analyser) detectVulnerabilityPHP(projectSubPath string) {
 }
 
 func (a *Analyser) detectVulnerabilityGeneric(projectSubPath string) {
-	a.monitor.AddProcess(1)
+	a.monitor.AddProcess(2)
 	go semgrep.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	go horusecdockerfile.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+}
+
+func (a *Analyser) detectVulnerabilityTfPlan() {
+	planPath, err := a.setupTfPlan()
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorSetupTfPlan, err, logger.ErrorLevel)
+		a.formatterService.SetAnalysisError(err)
+		return
+	}
+	a.monitor.AddProcess(1)
+	go checkov.NewFormatter(a.formatterService, planPath).StartAnalysis("")
+}
+
+// setupTfPlan returns the path of the terraform plan in the folder mounted in the container. The plan is copied to
+// the analysis folder, unless the project itself is mounted
+func (a *Analyser) setupTfPlan() (string, error) {
+	if a.config.GetSourceMode() == cli.SourceReadOnly.ToString() {
+		return a.getTfPlanPathInProject()
+	}
+	content, err := ioutil.ReadFile(a.config.GetTfPlanPath())
+	if err != nil {
+		return "", err
+	}
+	return tfPlanFileName, ioutil.WriteFile(
+		filepath.Join(a.formatterService.GetConfigProjectPath(), tfPlanFileName), content, 0600)
+}
+
+func (a *Analyser) getTfPlanPathInProject() (string, error) {
+	projectPath, err := filepath.Abs(a.config.GetProjectPath())
+	if err != nil {
+		return "", err
+	}
+	planPath, err := filepath.Abs(a.config.GetTfPlanPath())
+	if err != nil {
+		return "", err
+	}
+	relativePath, err := filepath.Rel(projectPath, planPath)
+	if err != nil || strings.HasPrefix(relativePath, "..") {
+		return "", ErrTfPlanOutsideProject
+	}
+	return filepath.ToSlash(relativePath), nil
 }
 
 func (a *Analyser) shouldAnalysePath(projectSubPath string) bool {
@@ -0,0 +1,112 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imagescan
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	analysisUseCases "github.com/ZupIT/horusec/development-kit/pkg/usecases/analysis"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/printresults"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/image/trivy"
+)
+
+// inputFileName is the name of the image saved as tar in the folder mounted in the container of the tool
+const inputFileName = "image.tar"
+
+type Interface interface {
+	Scan(target string) (totalVulns int, err error)
+}
+
+type ImageScan struct {
+	config           cliConfig.IConfig
+	analysis         *horusec.Analysis
+	dockerSDK        docker.Interface
+	formatterService formatters.IService
+	printController  printresults.Interface
+}
+
+func NewImageScan(config cliConfig.IConfig) Interface {
+	analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+	dockerAPI := docker.NewDockerAPI(dockerClient.NewDockerClient(), config, analysis.ID)
+	return &ImageScan{
+		config:           config,
+		analysis:         analysis,
+		dockerSDK:        dockerAPI,
+		formatterService: formatters.NewFormatterService(analysis, dockerAPI, config, horusec.NewMonitor()),
+		printController:  printresults.NewPrintResults(analysis, config),
+	}
+}
+
+// Scan analyzes the image reference, or the image saved as tar when the target is a file, and prints the
+// vulnerabilities found like the analysis of a project
+func (i *ImageScan) Scan(target string) (totalVulns int, err error) {
+	projectPath, err := ioutil.TempDir("", "horusec-image-")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(projectPath)
+	isInput, err := i.setupProject(projectPath, target)
+	if err != nil {
+		return 0, err
+	}
+	if isInput {
+		target = inputFileName
+	}
+
+	trivy.NewFormatter(i.formatterService, target, isInput).StartAnalysis("")
+	i.dockerSDK.DeleteContainersFromAPI()
+	i.analysis.SetAnalysisFinishedData().SetupIDInAnalysisContents().SortVulnerabilitiesByCriticality().
+		SetDefaultVulnerabilityType().SortVulnerabilitiesByType()
+	return i.printController.StartPrintResults()
+}
+
+// setupProject creates the analysis folder mounted in the container of the tool, with the copy of the target when
+// it is an image saved as tar
+func (i *ImageScan) setupProject(projectPath, target string) (isInput bool, err error) {
+	i.config.SetProjectPath(projectPath)
+	i.config.SetSourceMode(cli.SourceCopy.ToString())
+	analysisPath := filepath.Join(projectPath, ".horusec", i.analysis.ID.String())
+	if err := os.MkdirAll(analysisPath, os.ModePerm); err != nil {
+		return false, err
+	}
+	if fileInfo, err := os.Stat(target); err != nil || fileInfo.IsDir() {
+		return false, nil
+	}
+	return true, i.copyFile(target, filepath.Join(analysisPath, inputFileName))
+}
+
+func (i *ImageScan) copyFile(srcPath, dstPath string) error {
+	srcFile, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+	dstFile, err := os.Create(dstPath)
+	if err != nil {
+		return err
+	}
+	defer dstFile.Close()
+	_, err = io.Copy(dstFile, srcFile)
+	return err
+}
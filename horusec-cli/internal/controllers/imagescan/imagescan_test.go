@@ -0,0 +1,95 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package imagescan
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	analysisUseCases "github.com/ZupIT/horusec/development-kit/pkg/usecases/analysis"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/printresults"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/stretchr/testify/assert"
+)
+
+const trivyOutput = `[{"Target": "alpine:3.10 (alpine 3.10.9)", "Vulnerabilities": [{"VulnerabilityID": "CVE-2021-36159",
+	"PkgName": "apk-tools", "InstalledVersion": "2.10.6-r0", "Severity": "HIGH"}]}]`
+
+func newImageScanToTest(dockerMock *docker.Mock, printResultsMock *printresults.Mock) *ImageScan {
+	config := cliConfig.NewConfig()
+	config.SetWorkDir(&workdir.WorkDir{})
+	analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+	return &ImageScan{
+		config:           config,
+		analysis:         analysis,
+		dockerSDK:        dockerMock,
+		formatterService: formatters.NewFormatterService(analysis, dockerMock, config, horusec.NewMonitor()),
+		printController:  printResultsMock,
+	}
+}
+
+func TestImageScan_Scan(t *testing.T) {
+	t.Run("Should scan the image reference and print the vulnerabilities", func(t *testing.T) {
+		dockerMock := &docker.Mock{}
+		dockerMock.On("CreateLanguageAnalysisContainer").Return(trivyOutput, nil)
+		dockerMock.On("DeleteContainerFromAPI")
+		printResultsMock := &printresults.Mock{}
+		printResultsMock.On("StartPrintResults").Return(1, nil)
+
+		imageScan := newImageScanToTest(dockerMock, printResultsMock)
+		totalVulns, err := imageScan.Scan("alpine:3.10")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, totalVulns)
+		assert.Len(t, imageScan.analysis.AnalysisVulnerabilities, 1)
+		assert.NoDirExists(t, imageScan.config.GetProjectPath())
+		dockerMock.AssertCalled(t, "DeleteContainerFromAPI")
+	})
+
+	t.Run("Should copy the image saved as tar to the folder of the analysis", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "image")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		imagePath := filepath.Join(dir, "alpine.tar")
+		assert.NoError(t, ioutil.WriteFile(imagePath, []byte("image"), 0600))
+
+		imageScan := newImageScanToTest(&docker.Mock{}, &printresults.Mock{})
+		projectPath := filepath.Join(dir, "project")
+		isInput, err := imageScan.setupProject(projectPath, imagePath)
+
+		assert.NoError(t, err)
+		assert.True(t, isInput)
+		assert.FileExists(t, filepath.Join(projectPath, ".horusec", imageScan.analysis.ID.String(), inputFileName))
+	})
+
+	t.Run("Should not copy the target when it is an image reference", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "image")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		imageScan := newImageScanToTest(&docker.Mock{}, &printresults.Mock{})
+		isInput, err := imageScan.setupProject(dir, "alpine:3.10")
+
+		assert.NoError(t, err)
+		assert.False(t, isInput)
+		assert.DirExists(t, filepath.Join(dir, ".horusec", imageScan.analysis.ID.String()))
+	})
+}
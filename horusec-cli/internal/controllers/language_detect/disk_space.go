@@ -0,0 +1,54 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package languagedetect
+
+import (
+	"fmt"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	copyUtil "github.com/ZupIT/horusec/development-kit/pkg/utils/copy"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/disk"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+const bytesInMB = 1024 * 1024
+
+// getFreeSpace is a var to the tests set the free space of the volume
+var getFreeSpace = disk.GetFreeSpace
+
+// checkDiskSpace fails before the copy of the project when the volume doesn't have free space to it, instead of
+// failing in the middle of the copy. The hardlinks don't copy the files, so the check is skipped in this mode
+func (ld *LanguageDetect) checkDiskSpace(directory string) error {
+	if ld.configs.GetSourceMode() == cli.SourceHardlink.ToString() {
+		return nil
+	}
+	size, err := copyUtil.EstimateSizeWithSymlinkMode(directory, ld.filesAndFoldersToIgnore,
+		cli.SymlinkMode(ld.configs.GetSymlinkMode()))
+	if err != nil {
+		return err
+	}
+	freeSpace, err := getFreeSpace(directory)
+	if err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugDiskSpaceNotChecked, logger.DebugLevel, err)
+		return nil
+	}
+	logger.LogDebugWithLevel(messages.MsgDebugProjectCopySize, logger.DebugLevel,
+		float64(size)/bytesInMB, float64(freeSpace)/bytesInMB)
+	if uint64(size) > freeSpace {
+		return fmt.Errorf(messages.MsgErrorNotEnoughDiskSpace, float64(size)/bytesInMB, float64(freeSpace)/bytesInMB)
+	}
+	return nil
+}
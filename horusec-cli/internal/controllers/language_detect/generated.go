@@ -0,0 +1,120 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package languagedetect
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/file"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+const (
+	// Generators write their markers in the header of the file
+	generatedMarkerMaxBytes = 1024
+	// Average line length from which a javascript or css file is considered minified
+	minifiedAverageLineLength = 200
+)
+
+var generatedMarkerRegex = regexp.MustCompile(
+	`(?m)^\s*(//|#|/\*|\*|<!--)?\s*(Code generated .* DO NOT EDIT|@generated|<auto-generated)`)
+
+func (ld *LanguageDetect) isVendoredOrGenerated(path string) bool {
+	if ld.configs.GetEnableVendoredAndGeneratedCode() {
+		return false
+	}
+	return ld.checkVendoredFolder(path) || ld.checkGeneratedFileSuffix(path) || ld.generatedFiles[path]
+}
+
+func (ld *LanguageDetect) checkVendoredFolder(path string) bool {
+	for _, value := range cli.GetDefaultVendoredFoldersToIgnore() {
+		if strings.Contains(path, file.ReplacePathSeparator(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ld *LanguageDetect) checkGeneratedFileSuffix(path string) bool {
+	lowerPath := strings.ToLower(path)
+	for _, suffix := range cli.GetDefaultGeneratedFileSuffixesToIgnore() {
+		if strings.HasSuffix(lowerPath, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkGeneratedContent marks the file as generated when its header has a generated code marker
+// or when it is a minified javascript or css, so the file is not copied to the analysis folder
+func (ld *LanguageDetect) checkGeneratedContent(path string, content []byte) bool {
+	if ld.configs.GetEnableVendoredAndGeneratedCode() {
+		return false
+	}
+	if ld.hasGeneratedMarker(content) || ld.isMinified(path, content) {
+		ld.generatedFiles[path] = true
+	}
+	return ld.generatedFiles[path]
+}
+
+func (ld *LanguageDetect) hasGeneratedMarker(content []byte) bool {
+	if len(content) > generatedMarkerMaxBytes {
+		content = content[:generatedMarkerMaxBytes]
+	}
+	return generatedMarkerRegex.Match(content)
+}
+
+func (ld *LanguageDetect) isMinified(path string, content []byte) bool {
+	extension := strings.ToLower(filepath.Ext(path))
+	if (extension != ".js" && extension != ".css") || len(content) == 0 {
+		return false
+	}
+	return len(content)/(bytes.Count(content, []byte("\n"))+1) > minifiedAverageLineLength
+}
+
+func (ld *LanguageDetect) addVendoredOrGeneratedSkipped(directory, path string, info os.FileInfo) {
+	if info.IsDir() || !ld.isVendoredOrGenerated(path) {
+		return
+	}
+	relativePath, err := filepath.Rel(directory, path)
+	if err != nil {
+		relativePath = path
+	}
+	ld.vendoredOrGeneratedSkipped = append(ld.vendoredOrGeneratedSkipped, filepath.ToSlash(relativePath))
+}
+
+// GetVendoredOrGeneratedSkipped returns the files skipped in the last detection because they are vendored or
+// generated, relative to the project path
+func (ld *LanguageDetect) GetVendoredOrGeneratedSkipped() []string {
+	return ld.vendoredOrGeneratedSkipped
+}
+
+func (ld *LanguageDetect) logVendoredOrGeneratedSkipped() {
+	if len(ld.vendoredOrGeneratedSkipped) == 0 {
+		return
+	}
+	msg := strings.ReplaceAll(messages.MsgWarnVendoredOrGeneratedWasIgnored, "{{0}}",
+		strconv.Itoa(len(ld.vendoredOrGeneratedSkipped)))
+	logger.LogWarnWithLevel(msg, logger.WarnLevel)
+	logger.LogDebugWithLevel(messages.MsgDebugVendoredOrGeneratedIgnored, logger.DebugLevel,
+		ld.vendoredOrGeneratedSkipped)
+}
@@ -16,10 +16,12 @@ package languagedetect
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-enry/go-enry/v2"
 
@@ -34,22 +36,54 @@ import (
 	"github.com/google/uuid"
 )
 
+// Amount of bytes read from the start of each file to detect the language by its content
+const maxBytesToDetectLanguage = 16 * 1024
+
 type Interface interface {
 	LanguageDetect(directory string) ([]languages.Language, error)
+	GetFilesByLanguage() map[languages.Language][]string
+	GetPathsIgnored() []string
+	GetVendoredOrGeneratedSkipped() []string
+	GetCopyDuration() time.Duration
 }
 
 type LanguageDetect struct {
-	configs    config.IConfig
-	analysisID uuid.UUID
+	configs                    config.IConfig
+	analysisID                 uuid.UUID
+	filesByLanguage            map[languages.Language][]string
+	generatedFiles             map[string]bool
+	vendoredOrGeneratedSkipped []string
+	pathsIgnored               []string
+	symlinksFound              []string
+	copyDuration               time.Duration
 }
 
 func NewLanguageDetect(configs config.IConfig, analysisID uuid.UUID) Interface {
 	return &LanguageDetect{
-		analysisID: analysisID,
-		configs:    configs,
+		analysisID:      analysisID,
+		configs:         configs,
+		filesByLanguage: map[languages.Language][]string{},
+		generatedFiles:  map[string]bool{},
 	}
 }
 
+// GetFilesByLanguage returns the files found in the last detection grouped by language,
+// with paths relative to the project directory
+func (ld *LanguageDetect) GetFilesByLanguage() map[languages.Language][]string {
+	return ld.filesByLanguage
+}
+
+// GetCopyDuration returns the time of the copy of the project in the last detection, zero when it wasn't copied
+func (ld *LanguageDetect) GetCopyDuration() time.Duration {
+	return ld.copyDuration
+}
+
+// GetPathsIgnored returns the files and folders ignored in the last detection, with paths relative to the
+// project directory. Files inside an ignored folder are not returned
+func (ld *LanguageDetect) GetPathsIgnored() []string {
+	return ld.pathsIgnored
+}
+
 func (ld *LanguageDetect) LanguageDetect(directory string) ([]languages.Language, error) {
 	langs := []string{languages.Leaks.ToString(), languages.Generic.ToString()}
 	languagesFound, err := ld.getLanguages(directory)
@@ -61,17 +95,31 @@ func (ld *LanguageDetect) LanguageDetect(directory string) ([]languages.Language
 	langs = ld.appendLanguagesFound(langs, languagesFound)
 
 	ld.configs.SetProjectPath(directory)
+	supportedLanguages := ld.filterSupportedLanguages(langs)
+	ld.copyDuration = 0
+	if ld.configs.GetDryRun() || ld.isReadOnlySourceSafe(directory, supportedLanguages) {
+		return supportedLanguages, nil
+	}
+	ld.checkHardlinkSourceSafe(supportedLanguages)
+	startedAt := time.Now()
 	err = ld.copyProjectToHorusecFolder(directory)
-	return ld.filterSupportedLanguages(langs), err
+	ld.copyDuration = time.Since(startedAt)
+	return supportedLanguages, err
 }
 
 func (ld *LanguageDetect) getLanguages(directory string) (languagesFound []string, err error) {
+	ld.filesByLanguage = map[languages.Language][]string{}
+	ld.generatedFiles = map[string]bool{}
+	ld.vendoredOrGeneratedSkipped = []string{}
+	ld.pathsIgnored = []string{}
+	ld.symlinksFound = []string{}
 	filesToSkip, languagesFound, err := ld.walkInPathAndReturnTotalToSkip(directory)
 	if filesToSkip > 0 {
 		print("\n")
 		msg := strings.ReplaceAll(messages.MsgWarnTotalFolderOrFileWasIgnored, "{{0}}", strconv.Itoa(filesToSkip))
 		logger.LogWarnWithLevel(msg, logger.WarnLevel)
 	}
+	ld.logVendoredOrGeneratedSkipped()
 	return ld.uniqueLanguages(languagesFound), err
 }
 
@@ -84,28 +132,88 @@ func (ld *LanguageDetect) walkInPathAndReturnTotalToSkip(
 		currentLanguagesFound, skip := ld.execWalkToGetLanguagesAndReturnIfSkip(path, info)
 		if skip {
 			totalToSkip++
+			ld.addPathIgnored(directory, path)
+			ld.addVendoredOrGeneratedSkipped(directory, path, info)
+		} else {
+			ld.addSymlinkFound(directory, path, info)
 		}
+		ld.addFileByLanguage(directory, path, currentLanguagesFound)
 		languagesFound = ld.appendLanguagesFound(languagesFound, currentLanguagesFound)
 		return nil
 	})
 	return totalToSkip, languagesFound, err
 }
 
+func (ld *LanguageDetect) addPathIgnored(directory, path string) {
+	relativePath, err := filepath.Rel(directory, path)
+	if err != nil {
+		relativePath = path
+	}
+	if total := len(ld.pathsIgnored); total > 0 &&
+		strings.HasPrefix(relativePath, ld.pathsIgnored[total-1]+string(os.PathSeparator)) {
+		return
+	}
+	ld.pathsIgnored = append(ld.pathsIgnored, relativePath)
+}
+
 func (ld *LanguageDetect) execWalkToGetLanguagesAndReturnIfSkip(
 	path string, info os.FileInfo) (languagesFound []string, skip bool) {
 	skip = ld.filesAndFoldersToIgnore(path)
+	if !info.IsDir() && !skip {
+		content, err := ld.readFileHead(path)
+		skip = ld.checkGeneratedContent(path, content)
+		if !skip {
+			languagesFound = ld.getLanguagesOfFile(path, content, err)
+		}
+	}
 	if skip {
 		logger.LogDebugWithLevel(messages.MsgDebugFolderOrFileIgnored, logger.WarnLevel, path)
 	}
-	if !info.IsDir() && !skip {
-		newLanguages := enry.GetLanguages(path, nil)
-		logger.LogTraceWithLevel(messages.MsgTraceLanguageFound,
-			logger.TraceLevel, map[string][]string{path: newLanguages})
-		languagesFound = append(languagesFound, newLanguages...)
-	}
 	return languagesFound, skip
 }
 
+// getLanguagesOfFile uses the content of the file to detect extensionless scripts by shebang and to
+// disambiguate extensions shared by many languages. Binary files return no language
+func (ld *LanguageDetect) getLanguagesOfFile(path string, content []byte, errRead error) []string {
+	if errRead != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugReadFileToDetectLanguage, logger.DebugLevel, path, errRead.Error())
+		return enry.GetLanguages(path, nil)
+	}
+	newLanguages := enry.GetLanguages(path, content)
+	logger.LogTraceWithLevel(messages.MsgTraceLanguageFound,
+		logger.TraceLevel, map[string][]string{path: newLanguages})
+	return newLanguages
+}
+
+func (ld *LanguageDetect) readFileHead(path string) ([]byte, error) {
+	fileOpened, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = fileOpened.Close()
+	}()
+	content := make([]byte, maxBytesToDetectLanguage)
+	size, err := io.ReadFull(fileOpened, content)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	return content[:size], nil
+}
+
+func (ld *LanguageDetect) addFileByLanguage(directory, path string, languagesFound []string) {
+	relativePath, err := filepath.Rel(directory, path)
+	if err != nil {
+		return
+	}
+	for _, lang := range ld.appendLanguagesFound([]string{}, languagesFound) {
+		if ld.isSupportedLanguage(lang) {
+			language := languages.ParseStringToLanguage(lang)
+			ld.filesByLanguage[language] = append(ld.filesByLanguage[language], relativePath)
+		}
+	}
+}
+
 func (ld *LanguageDetect) uniqueLanguages(languagesFound []string) (output []string) {
 	for _, language := range languagesFound {
 		if len(output) == 0 {
@@ -134,7 +242,8 @@ func (ld *LanguageDetect) checkIfLanguageExistAndConcat(output []string, languag
 func (ld *LanguageDetect) filesAndFoldersToIgnore(path string) bool {
 	isToSkip := ld.checkDefaultPathsToIgnore(path) ||
 		ld.checkAdditionalPathsToIgnore(path) ||
-		ld.checkFileExtensionInvalid(path)
+		ld.checkFileExtensionInvalid(path) ||
+		ld.isVendoredOrGenerated(path)
 	return isToSkip
 }
 
@@ -169,9 +278,19 @@ func (ld *LanguageDetect) checkFileExtensionInvalid(path string) bool {
 
 func (ld *LanguageDetect) copyProjectToHorusecFolder(directory string) error {
 	folderDstName := file.ReplacePathSeparator(fmt.Sprintf("%s/.horusec/%s", directory, ld.analysisID.String()))
-	err := copyUtil.Copy(directory, folderDstName, ld.filesAndFoldersToIgnore)
+	if err := ld.checkDiskSpace(directory); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorCopyProjectToHorusecAnalysis, err, logger.ErrorLevel)
+		return err
+	}
+	copyProject := copyUtil.CopyWithSymlinkMode
+	if ld.configs.GetSourceMode() == cli.SourceHardlink.ToString() {
+		copyProject = copyUtil.HardlinkWithSymlinkMode
+	}
+	err := copyProject(directory, folderDstName, ld.filesAndFoldersToIgnore,
+		cli.SymlinkMode(ld.configs.GetSymlinkMode()))
 	if err != nil {
 		logger.LogErrorWithLevel(messages.MsgErrorCopyProjectToHorusecAnalysis, err, logger.ErrorLevel)
+		logger.LogError(messages.MsgErrorCopyProjectToHorusecAnalysis, os.RemoveAll(folderDstName))
 	} else {
 		fmt.Print("\n")
 		logger.LogWarnWithLevel(messages.MsgWarnDontRemoveHorusecFolder, logger.WarnLevel, folderDstName)
@@ -15,6 +15,8 @@
 package languagedetect
 
 import (
+	"time"
+
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	mock2 "github.com/ZupIT/horusec/development-kit/pkg/utils/mock"
 	"github.com/stretchr/testify/mock"
@@ -28,3 +30,23 @@ func (m *Mock) LanguageDetect(directory string) ([]languages.Language, error) {
 	args := m.MethodCalled("LanguageDetect")
 	return args.Get(0).([]languages.Language), mock2.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) GetFilesByLanguage() map[languages.Language][]string {
+	args := m.MethodCalled("GetFilesByLanguage")
+	return args.Get(0).(map[languages.Language][]string)
+}
+
+func (m *Mock) GetCopyDuration() time.Duration {
+	args := m.MethodCalled("GetCopyDuration")
+	return args.Get(0).(time.Duration)
+}
+
+func (m *Mock) GetPathsIgnored() []string {
+	args := m.MethodCalled("GetPathsIgnored")
+	return args.Get(0).([]string)
+}
+
+func (m *Mock) GetVendoredOrGeneratedSkipped() []string {
+	args := m.MethodCalled("GetVendoredOrGeneratedSkipped")
+	return args.Get(0).([]string)
+}
@@ -16,11 +16,16 @@ package languagedetect
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	analysisUseCases "github.com/ZupIT/horusec/development-kit/pkg/usecases/analysis"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/disk"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/zip"
 	"github.com/ZupIT/horusec/horusec-cli/config"
 	"github.com/google/uuid"
@@ -101,6 +106,7 @@ func TestNewLanguageDetect(t *testing.T) {
 		assert.Contains(t, langs, languages.Generic)
 		assert.Contains(t, langs, languages.Yaml)
 		assert.Len(t, langs, 4)
+		assert.Greater(t, int64(controller.GetCopyDuration()), int64(0))
 	})
 
 	t.Run("Should ignore additional specific file name setup in configs", func(t *testing.T) {
@@ -296,4 +302,157 @@ func TestNewLanguageDetect(t *testing.T) {
 		assert.Contains(t, langs, languages.Yaml)
 		assert.Len(t, langs, 4)
 	})
+
+	t.Run("Should detect extensionless scripts by content and group files by language", func(t *testing.T) {
+		configs := &config.Config{}
+		analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+		srcPath := getSourcePath(analysis.ID)
+
+		assert.NoError(t, os.MkdirAll(srcPath+"/scripts", os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/scripts/deploy", []byte("#!/usr/bin/env python3\nprint('ok')\n"), 0600))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/main.go", []byte("package main\n"), 0600))
+
+		controller := NewLanguageDetect(configs, analysis.ID)
+
+		langs, err := controller.LanguageDetect(srcPath)
+		assert.NoError(t, err)
+
+		assert.Contains(t, langs, languages.Python)
+		assert.Contains(t, langs, languages.Go)
+		assert.Equal(t, []string{filepath.Join("scripts", "deploy")}, controller.GetFilesByLanguage()[languages.Python])
+		assert.Equal(t, []string{"main.go"}, controller.GetFilesByLanguage()[languages.Go])
+	})
+
+	t.Run("Should skip vendored and generated files unless enabled", func(t *testing.T) {
+		analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+		srcPath := getSourcePath(analysis.ID)
+
+		assert.NoError(t, os.MkdirAll(srcPath+"/vendor/lib", os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/vendor/lib/lib.py", []byte("print('ok')\n"), 0600))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/api.pb.go", []byte("package api\n"), 0600))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/mock.go",
+			[]byte("// Code generated by MockGen. DO NOT EDIT.\npackage api\n"), 0600))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/app.js", []byte("var a=1;"+strings.Repeat("a=a+1;", 100)), 0600))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/main.rb", []byte("puts 'ok'\n"), 0600))
+
+		controller := NewLanguageDetect(&config.Config{}, analysis.ID)
+		langs, err := controller.LanguageDetect(srcPath)
+		assert.NoError(t, err)
+		assert.Contains(t, langs, languages.Ruby)
+		assert.NotContains(t, langs, languages.Python)
+		assert.NotContains(t, langs, languages.Go)
+		assert.NotContains(t, langs, languages.Javascript)
+		assert.Equal(t, []string{"api.pb.go", "app.js", "mock.go", "vendor/lib/lib.py"},
+			controller.GetVendoredOrGeneratedSkipped())
+
+		configs := &config.Config{}
+		configs.SetEnableVendoredAndGeneratedCode(true)
+		langs, err = NewLanguageDetect(configs, uuid.New()).LanguageDetect(srcPath)
+		assert.NoError(t, err)
+		assert.Contains(t, langs, languages.Python)
+		assert.Contains(t, langs, languages.Go)
+		assert.Contains(t, langs, languages.Javascript)
+	})
+	t.Run("Should mount read-only only when no tool needs write access", func(t *testing.T) {
+		analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+		srcPath := getSourcePath(analysis.ID)
+		assert.NoError(t, os.MkdirAll(srcPath, os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/main.rb", []byte("puts 'ok'\n"), 0600))
+
+		configs := &config.Config{}
+		configs.SetSourceMode(cli.SourceReadOnly.ToString())
+		_, err := NewLanguageDetect(configs, analysis.ID).LanguageDetect(srcPath)
+		assert.NoError(t, err)
+		assert.Equal(t, cli.SourceReadOnly.ToString(), configs.GetSourceMode())
+		assert.NoDirExists(t, srcPath+"/.horusec")
+
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/main.py", []byte("print('ok')\n"), 0600))
+		_, err = NewLanguageDetect(configs, analysis.ID).LanguageDetect(srcPath)
+		assert.NoError(t, err)
+		assert.Equal(t, cli.SourceCopy.ToString(), configs.GetSourceMode())
+		assert.DirExists(t, srcPath+"/.horusec/"+analysis.ID.String())
+	})
+
+	t.Run("Should mount read-only only when there are no files to ignore or symbolic links", func(t *testing.T) {
+		analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+		srcPath := getSourcePath(analysis.ID)
+		assert.NoError(t, os.MkdirAll(srcPath+"/.horusec/old-analysis", os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/main.rb", []byte("puts 'ok'\n"), 0600))
+
+		configs := &config.Config{}
+		configs.SetSourceMode(cli.SourceReadOnly.ToString())
+		_, err := NewLanguageDetect(configs, analysis.ID).LanguageDetect(srcPath)
+		assert.NoError(t, err)
+		assert.Equal(t, cli.SourceReadOnly.ToString(), configs.GetSourceMode())
+
+		assert.NoError(t, os.Symlink(srcPath+"/main.rb", srcPath+"/link.rb"))
+		_, err = NewLanguageDetect(configs, analysis.ID).LanguageDetect(srcPath)
+		assert.NoError(t, err)
+		assert.Equal(t, cli.SourceCopy.ToString(), configs.GetSourceMode())
+
+		assert.NoError(t, os.Remove(srcPath+"/link.rb"))
+		assert.NoError(t, os.MkdirAll(srcPath+"/.idea", os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/.idea/workspace.xml", []byte("<project/>\n"), 0600))
+		configs.SetSourceMode(cli.SourceReadOnly.ToString())
+		_, err = NewLanguageDetect(configs, analysis.ID).LanguageDetect(srcPath)
+		assert.NoError(t, err)
+		assert.Equal(t, cli.SourceCopy.ToString(), configs.GetSourceMode())
+	})
+
+	t.Run("Should copy instead of hardlink when a tool needs write access", func(t *testing.T) {
+		analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+		srcPath := getSourcePath(analysis.ID)
+		assert.NoError(t, os.MkdirAll(srcPath, os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/main.py", []byte("print('ok')\n"), 0600))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/requirements.txt", []byte("django==1.0\n"), 0600))
+
+		configs := &config.Config{}
+		configs.SetSourceMode(cli.SourceHardlink.ToString())
+		_, err := NewLanguageDetect(configs, analysis.ID).LanguageDetect(srcPath)
+		assert.NoError(t, err)
+		assert.Equal(t, cli.SourceCopy.ToString(), configs.GetSourceMode())
+
+		copied, err := os.OpenFile(srcPath+"/.horusec/"+analysis.ID.String()+"/requirements.txt",
+			os.O_APPEND|os.O_WRONLY, 0600)
+		assert.NoError(t, err)
+		_, err = copied.WriteString("flask==1.0\n")
+		assert.NoError(t, err)
+		assert.NoError(t, copied.Close())
+		content, err := ioutil.ReadFile(srcPath + "/requirements.txt")
+		assert.NoError(t, err)
+		assert.Equal(t, "django==1.0\n", string(content))
+	})
+
+	t.Run("Should not copy the project and return the paths ignored when is dry run", func(t *testing.T) {
+		analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+		srcPath := getSourcePath(analysis.ID)
+		assert.NoError(t, os.MkdirAll(srcPath+"/node_modules/lib", os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/node_modules/lib/index.js", []byte("var a = 1;\n"), 0600))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/main.py", []byte("print('ok')\n"), 0600))
+
+		configs := &config.Config{}
+		configs.SetDryRun(true)
+		controller := NewLanguageDetect(configs, analysis.ID)
+		langs, err := controller.LanguageDetect(srcPath)
+		assert.NoError(t, err)
+		assert.Contains(t, langs, languages.Python)
+		assert.NoDirExists(t, srcPath+"/.horusec")
+		assert.Equal(t, []string{filepath.Join("node_modules", "lib")}, controller.GetPathsIgnored())
+		assert.Zero(t, controller.GetCopyDuration())
+	})
+
+	t.Run("Should return error without copying the project when there is no free space to the copy", func(t *testing.T) {
+		analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+		srcPath := getSourcePath(analysis.ID)
+		assert.NoError(t, os.MkdirAll(srcPath, os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(srcPath+"/main.py", []byte("print('ok')\n"), 0600))
+
+		defer func() { getFreeSpace = disk.GetFreeSpace }()
+		getFreeSpace = func(path string) (uint64, error) { return 1, nil }
+
+		_, err := NewLanguageDetect(&config.Config{}, analysis.ID).LanguageDetect(srcPath)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Not enough disk space")
+		assert.NoDirExists(t, srcPath+"/.horusec/"+analysis.ID.String())
+	})
 }
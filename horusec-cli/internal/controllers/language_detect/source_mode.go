@@ -0,0 +1,120 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package languagedetect
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+// Tools that build the project or write files inside of it, so they can't run in a read-only mount
+var toolsWithWriteAccessByLanguage = map[languages.Language][]tools.Tool{
+	languages.CSharp: {tools.SecurityCodeScan},
+	languages.Python: {tools.Bandit, tools.Safety},
+}
+
+// isReadOnlySourceSafe checks if the project can be mounted read-only in the tools containers. When it is not
+// safe the source mode falls back to copy, so the rest of the analysis sees the analysis folder as usual
+func (ld *LanguageDetect) isReadOnlySourceSafe(directory string, langs []languages.Language) bool {
+	if ld.configs.GetSourceMode() != cli.SourceReadOnly.ToString() {
+		return false
+	}
+	if reason := ld.getReadOnlySourceUnsafeReason(langs); reason != "" {
+		logger.LogWarnWithLevel(messages.MsgWarnSourceModeReadOnlyNotSafe, logger.WarnLevel, reason)
+		ld.configs.SetSourceMode(cli.SourceCopy.ToString())
+		return false
+	}
+	logger.LogDebugWithLevel(messages.MsgDebugSourceModeReadOnly, logger.DebugLevel, directory)
+	return true
+}
+
+// checkHardlinkSourceSafe falls back to a real copy when a tool writes in the project, like Safety sorting the
+// requirements.txt and SecurityCodeScan adding its package, because the hardlinked files are the files of the user
+func (ld *LanguageDetect) checkHardlinkSourceSafe(langs []languages.Language) {
+	if ld.configs.GetSourceMode() != cli.SourceHardlink.ToString() {
+		return
+	}
+	if toolsWithWriteAccess := ld.getToolsWithWriteAccess(langs); len(toolsWithWriteAccess) > 0 {
+		logger.LogWarnWithLevel(messages.MsgWarnSourceModeHardlinkNotSafe, logger.WarnLevel,
+			"tools need write access: "+strings.Join(toolsWithWriteAccess, ", "))
+		ld.configs.SetSourceMode(cli.SourceCopy.ToString())
+	}
+}
+
+func (ld *LanguageDetect) getReadOnlySourceUnsafeReason(langs []languages.Language) string {
+	if toolsWithWriteAccess := ld.getToolsWithWriteAccess(langs); len(toolsWithWriteAccess) > 0 {
+		return "tools need write access: " + strings.Join(toolsWithWriteAccess, ", ")
+	}
+	if pathsIgnored := ld.getPathsIgnoredInProject(); len(pathsIgnored) > 0 {
+		return "files to ignore were found, they are skipped only in a copy: " + strings.Join(pathsIgnored, ", ")
+	}
+	if len(ld.symlinksFound) > 0 {
+		return "symbolic links were found, the symlink mode is applied only in a copy: " +
+			strings.Join(ld.symlinksFound, ", ")
+	}
+	return ""
+}
+
+// getPathsIgnoredInProject returns the paths ignored without the .horusec folder of the project, the folder of the
+// previous analyses is hidden from the tools by the docker service, so it is not a reason to copy the project
+func (ld *LanguageDetect) getPathsIgnoredInProject() (pathsIgnored []string) {
+	for _, path := range ld.pathsIgnored {
+		if path = filepath.ToSlash(path); !strings.HasPrefix(path+"/", ".horusec/") {
+			pathsIgnored = append(pathsIgnored, path)
+		}
+	}
+	return pathsIgnored
+}
+
+// addSymlinkFound records the symbolic links not ignored, because the walk doesn't follow them and in the read-only
+// mount they would be resolved by the tools, ignoring the symlink mode and the links pointing outside the project
+func (ld *LanguageDetect) addSymlinkFound(directory, path string, info os.FileInfo) {
+	if info.Mode()&os.ModeSymlink == 0 {
+		return
+	}
+	relativePath, err := filepath.Rel(directory, path)
+	if err != nil {
+		relativePath = path
+	}
+	ld.symlinksFound = append(ld.symlinksFound, filepath.ToSlash(relativePath))
+}
+
+func (ld *LanguageDetect) getToolsWithWriteAccess(langs []languages.Language) (toolsFound []string) {
+	toolsConfig := ld.configs.GetToolsConfig()
+	for _, language := range langs {
+		for _, tool := range toolsWithWriteAccessByLanguage[language] {
+			if !toolsConfig[tool].IsToIgnore && !ld.isInToolsToIgnore(tool) {
+				toolsFound = append(toolsFound, tool.ToString())
+			}
+		}
+	}
+	return toolsFound
+}
+
+func (ld *LanguageDetect) isInToolsToIgnore(tool tools.Tool) bool {
+	for _, toolToIgnore := range ld.configs.GetToolsToIgnore() {
+		if strings.EqualFold(toolToIgnore, tool.ToString()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printresults
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+// execPrinter runs an executable found in the PATH sending the analysis as json in its stdin. The stdout of
+// the executable is written in the json-output-file when informed, otherwise it is printed
+type execPrinter struct {
+	path string
+}
+
+func newExecPrinter(path string) *execPrinter {
+	return &execPrinter{path: path}
+}
+
+func (e *execPrinter) Print(analysis *horusec.Analysis, configs config.IConfig) error {
+	content, err := json.Marshal(analysis)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err, logger.ErrorLevel)
+		return err
+	}
+
+	cmd := exec.Command(e.path)
+	cmd.Stdin = bytes.NewReader(content)
+	cmd.Stderr = os.Stderr
+	output, err := cmd.Output()
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorRunPrinterPlugin+e.path, err, logger.ErrorLevel)
+		return err
+	}
+
+	if configs.GetJSONOutputFilePath() == "" {
+		fmt.Print(string(output))
+		return nil
+	}
+	return writeOutputFile(configs, output)
+}
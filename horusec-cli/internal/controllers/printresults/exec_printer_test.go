@@ -0,0 +1,57 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printresults
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecPrinter_Print(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts are not available on windows")
+	}
+	dir, err := ioutil.TempDir("", "horusec-printer")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	t.Run("Should write the output of the executable in the output file", func(t *testing.T) {
+		script := filepath.Join(dir, "horusec-printer-test")
+		assert.NoError(t, ioutil.WriteFile(script, []byte("#!/bin/sh\ncat\n"), 0700))
+		configs := &cliConfig.Config{}
+		configs.SetJSONOutputFilePath(filepath.Join(dir, "output.json"))
+
+		err := newExecPrinter(script).Print(&horusec.Analysis{Status: "success"}, configs)
+		assert.NoError(t, err)
+		content, err := ioutil.ReadFile(filepath.Join(dir, "output.json"))
+		assert.NoError(t, err)
+		assert.Contains(t, string(content), "\"status\":\"success\"")
+	})
+
+	t.Run("Should return error when the executable fails", func(t *testing.T) {
+		script := filepath.Join(dir, "horusec-printer-fail")
+		assert.NoError(t, ioutil.WriteFile(script, []byte("#!/bin/sh\nexit 1\n"), 0700))
+
+		err := newExecPrinter(script).Print(&horusec.Analysis{}, &cliConfig.Config{})
+		assert.Error(t, err)
+	})
+}
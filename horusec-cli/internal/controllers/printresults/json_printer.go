@@ -0,0 +1,165 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printresults
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/cyclonedx"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/encryption"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/jenkins"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/printer"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/sonarqube"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/spdx"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/threadfix"
+)
+
+func init() {
+	printer.Register(cli.JSON.ToString(), &jsonPrinter{})
+	printer.Register(cli.SonarQube.ToString(), &sonarQubePrinter{})
+	printer.Register(cli.ThreadFix.ToString(), &threadFixPrinter{})
+	printer.Register(cli.CycloneDXVDR.ToString(), &cycloneDXVDRPrinter{})
+	printer.Register(cli.SPDX3.ToString(), &spdx3Printer{})
+	printer.Register(cli.Jenkins.ToString(), &jenkinsPrinter{})
+}
+
+type jsonPrinter struct{}
+
+func (j *jsonPrinter) Print(analysis *horusec.Analysis, configs config.IConfig) error {
+	bytesToWrite, err := json.MarshalIndent(analysis, "", "  ")
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err, logger.ErrorLevel)
+		return err
+	}
+	return writeOutputFile(configs, bytesToWrite)
+}
+
+type sonarQubePrinter struct{}
+
+func (s *sonarQubePrinter) Print(analysis *horusec.Analysis, configs config.IConfig) error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateSonarQubeFile, logger.InfoLevel)
+	report := sonarqube.NewSonarQube(analysis).ConvertVulnerabilityDataToSonarQube()
+	bytesToWrite, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err, logger.ErrorLevel)
+		return err
+	}
+	return writeOutputFile(configs, bytesToWrite)
+}
+
+type threadFixPrinter struct{}
+
+func (t *threadFixPrinter) Print(analysis *horusec.Analysis, configs config.IConfig) error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateThreadFixFile, logger.InfoLevel)
+	report := threadfix.NewThreadFix(analysis, configs.GetThreadFixFieldMapping()).ConvertVulnerabilityDataToThreadFix()
+	bytesToWrite, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err, logger.ErrorLevel)
+		return err
+	}
+	return writeOutputFile(configs, bytesToWrite)
+}
+
+type cycloneDXVDRPrinter struct{}
+
+func (c *cycloneDXVDRPrinter) Print(analysis *horusec.Analysis, configs config.IConfig) error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateCycloneDXVDRFile, logger.InfoLevel)
+	report := cyclonedx.NewCycloneDX(analysis).ConvertVulnerabilityDataToCycloneDX()
+	bytesToWrite, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err, logger.ErrorLevel)
+		return err
+	}
+	return writeOutputFile(configs, bytesToWrite)
+}
+
+type spdx3Printer struct{}
+
+func (s *spdx3Printer) Print(analysis *horusec.Analysis, configs config.IConfig) error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateSPDX3File, logger.InfoLevel)
+	document := spdx.NewSPDX(analysis).ConvertVulnerabilityDataToSPDX()
+	bytesToWrite, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err, logger.ErrorLevel)
+		return err
+	}
+	return writeOutputFile(configs, bytesToWrite)
+}
+
+type jenkinsPrinter struct{}
+
+func (j *jenkinsPrinter) Print(analysis *horusec.Analysis, configs config.IConfig) error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateJenkinsFile, logger.InfoLevel)
+	report := jenkins.NewJenkins(analysis).ConvertVulnerabilityDataToJenkins()
+	bytesToWrite, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err, logger.ErrorLevel)
+		return err
+	}
+	return writeOutputFile(configs, bytesToWrite)
+}
+
+func returnDefaultErrOutputJSON(err error) error {
+	logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err, logger.ErrorLevel)
+	return ErrOutputJSON
+}
+
+// writeOutputFile writes the output of the printers in the path of the flag json-output-file, or in the stdout
+// without path in the quiet mode, both encrypted when the encryption of the report is enabled
+func writeOutputFile(configs config.IConfig, bytesToWrite []byte) error {
+	bytesToWrite, err := encryption.NewEncryption(configs).Encrypt(bytesToWrite)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorEncryptReport, err, logger.ErrorLevel)
+		return err
+	}
+	outputFilePath := configs.GetJSONOutputFilePath()
+	if outputFilePath == "" && printer.IsQuiet() {
+		if _, err := os.Stdout.Write(bytesToWrite); err != nil {
+			return returnDefaultErrOutputJSON(err)
+		}
+		return nil
+	}
+	completePath, err := filepath.Abs(outputFilePath)
+	if err != nil {
+		return returnDefaultErrOutputJSON(err)
+	}
+	if _, err := os.Create(completePath); err != nil {
+		return returnDefaultErrOutputJSON(err)
+	}
+	logger.LogInfoWithLevel(messages.MsgInfoStartWriteFile+completePath, logger.InfoLevel)
+	return openJSONFileAndWriteBytes(bytesToWrite, completePath)
+}
+
+func openJSONFileAndWriteBytes(bytesToWrite []byte, completePath string) error {
+	outputFile, err := os.OpenFile(completePath, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return returnDefaultErrOutputJSON(err)
+	}
+	if err = outputFile.Truncate(0); err != nil {
+		return returnDefaultErrOutputJSON(err)
+	}
+	bytesWritten, err := outputFile.Write(bytesToWrite)
+	if err != nil || bytesWritten != len(bytesToWrite) {
+		return returnDefaultErrOutputJSON(err)
+	}
+	return outputFile.Close()
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printresults
+
+import (
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/codeowners"
+)
+
+var invalidOwnerCharacters = regexp.MustCompile(`[^A-Za-z0-9@._-]+`)
+
+// ownerConfigs writes the report of the owner in its own path, the other configs are the same of the report
+type ownerConfigs struct {
+	config.IConfig
+	outputFilePath string
+}
+
+func (o *ownerConfigs) GetJSONOutputFilePath() string {
+	return o.outputFilePath
+}
+
+// printReportsByOwner writes besides the report one report for each owner with the same printer, the vulnerabilities
+// of many owners are in the report of each one and the vulnerabilities without owners are in the report unowned
+func (pr *PrintResults) printReportsByOwner(analysis *horusecEntities.Analysis) error {
+	if !pr.configs.GetSplitReportByOwner() {
+		return nil
+	}
+
+	vulnerabilitiesByOwner := codeowners.GetVulnerabilitiesByOwner(analysis)
+	owners := make([]string, 0, len(vulnerabilitiesByOwner))
+	for owner := range vulnerabilitiesByOwner {
+		owners = append(owners, owner)
+	}
+
+	sort.Strings(owners)
+	for _, owner := range owners {
+		ownerAnalysis := *analysis
+		ownerAnalysis.AnalysisVulnerabilities = vulnerabilitiesByOwner[owner]
+		configs := &ownerConfigs{IConfig: pr.configs,
+			outputFilePath: getOwnerReportPath(pr.configs.GetJSONOutputFilePath(), owner)}
+		if err := pr.getPrinter().Print(&ownerAnalysis, configs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getOwnerReportPath adds the owner to the name of the report, like report-@org-team.json to the owner @org/team
+func getOwnerReportPath(reportPath, owner string) string {
+	ext := filepath.Ext(reportPath)
+	return strings.TrimSuffix(reportPath, ext) + "-" + invalidOwnerCharacters.ReplaceAllString(owner, "-") + ext
+}
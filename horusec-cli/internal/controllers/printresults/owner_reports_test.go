@@ -0,0 +1,63 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printresults
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+func TestPrintResults_PrintReportsByOwner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "owner-reports")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	t.Run("Should write one report for each owner besides the report", func(t *testing.T) {
+		analysis := &horusecEntities.Analysis{}
+		for _, owners := range [][]string{{"@org/api"}, {"@org/api", "@john"}, nil} {
+			analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+				horusecEntities.AnalysisVulnerabilities{Vulnerability: horusecEntities.Vulnerability{Owners: owners}})
+		}
+		configs := config.NewConfig()
+		configs.SetPrintOutputType(cli.JSON.ToString())
+		configs.SetJSONOutputFilePath(filepath.Join(dir, "report.json"))
+		configs.SetSplitReportByOwner(true)
+
+		_, err := NewPrintResults(analysis, configs).StartPrintResults()
+		assert.NoError(t, err)
+		for path, total := range map[string]int{"report.json": 3, "report-@org-api.json": 2, "report-@john.json": 1,
+			"report-unowned.json": 1} {
+			content, err := ioutil.ReadFile(filepath.Join(dir, path))
+			assert.NoError(t, err)
+			report := &horusecEntities.Analysis{}
+			assert.NoError(t, json.Unmarshal(content, report))
+			assert.Len(t, report.AnalysisVulnerabilities, total, path)
+		}
+	})
+
+	t.Run("Should add the owner to the name of the report", func(t *testing.T) {
+		assert.Equal(t, "output/report-@org-team.json", getOwnerReportPath("output/report.json", "@org/team"))
+		assert.Equal(t, "report-team-a.json", getOwnerReportPath("report.json", "team a"))
+	})
+}
@@ -15,19 +15,16 @@
 package printresults
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
 	"strings"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
 
-	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
 	"github.com/ZupIT/horusec/horusec-cli/config"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
-	"github.com/ZupIT/horusec/horusec-cli/internal/services/sonarqube"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/printer"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/testcode"
 
 	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
@@ -39,10 +36,9 @@ var (
 )
 
 type PrintResults struct {
-	analysis         *horusecEntities.Analysis
-	configs          config.IConfig
-	totalVulns       int
-	sonarqubeService sonarqube.Interface
+	analysis   *horusecEntities.Analysis
+	configs    config.IConfig
+	totalVulns int
 }
 
 type Interface interface {
@@ -52,9 +48,8 @@ type Interface interface {
 
 func NewPrintResults(analysis *horusecEntities.Analysis, configs config.IConfig) Interface {
 	return &PrintResults{
-		analysis:         analysis,
-		configs:          configs,
-		sonarqubeService: sonarqube.NewSonarQube(analysis),
+		analysis: analysis,
+		configs:  configs,
 	}
 }
 
@@ -74,49 +69,50 @@ func (pr *PrintResults) StartPrintResults() (totalVulns int, err error) {
 	if pr.configs.GetIsTimeout() {
 		logger.LogWarnWithLevel(messages.MsgErrorTimeoutOccurs, logger.ErrorLevel)
 	}
+	pr.printSummary()
 
 	return pr.totalVulns, nil
 }
 
+// factoryPrintByType writes the report in the original stdout in the quiet mode
 func (pr *PrintResults) factoryPrintByType() error {
-	switch {
-	case pr.configs.GetPrintOutputType() == string(cli.JSON):
-		return pr.runPrintResultsJSON()
-	case pr.configs.GetPrintOutputType() == string(cli.SonarQube):
-		return pr.runPrintResultsSonarQube()
-	default:
-		return pr.runPrintResultsText()
-	}
-}
-
-// nolint
-func (pr *PrintResults) runPrintResultsText() error {
-	pr.logSeparator(true)
-
-	fmt.Println(fmt.Sprintf("HORUSEC ENDED THE ANALYSIS WITH STATUS OF \"%s\" AND WITH THE FOLLOWING RESULTS:", pr.analysis.Status))
-
-	pr.logSeparator(true)
-
-	fmt.Println(fmt.Sprintf("Analysis StartedAt: %s", pr.analysis.CreatedAt.Format("2006-01-02 15:04:05")))
-	fmt.Println(fmt.Sprintf("Analysis FinishedAt: %s", pr.analysis.FinishedAt.Format("2006-01-02 15:04:05")))
-
-	pr.logSeparator(true)
-
-	pr.printTextOutputVulnerability()
-	return nil
+	return printer.WithReportOutput(func() error {
+		analysis := pr.getAnalysisToReport()
+		if err := pr.getPrinter().Print(analysis, pr.configs); err != nil {
+			return err
+		}
+		return pr.printReportsByOwner(analysis)
+	})
 }
 
-func (pr *PrintResults) runPrintResultsJSON() error {
-	bytesToWrite, err := json.MarshalIndent(pr.analysis, "", "  ")
-	if err != nil {
-		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err, logger.ErrorLevel)
-		return err
+// getAnalysisToReport removes the vulnerabilities below the report min severity only of the report, they are still
+// counted to the fail threshold
+func (pr *PrintResults) getAnalysisToReport() *horusecEntities.Analysis {
+	if pr.configs.GetReportMinSeverity() == "" {
+		return pr.analysis
+	}
+	minSeverity := severity.Severity(strings.ToUpper(pr.configs.GetReportMinSeverity()))
+	analysis := *pr.analysis
+	analysis.AnalysisVulnerabilities = []horusecEntities.AnalysisVulnerabilities{}
+	for index := range pr.analysis.AnalysisVulnerabilities {
+		if !pr.analysis.AnalysisVulnerabilities[index].Vulnerability.Severity.IsBelow(minSeverity) {
+			analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+				pr.analysis.AnalysisVulnerabilities[index])
+		}
 	}
-	return pr.parseFilePathToAbsAndCreateOutputJSON(bytesToWrite)
+	return &analysis
 }
 
-func (pr *PrintResults) runPrintResultsSonarQube() error {
-	return pr.saveSonarQubeFormatResults()
+// getPrinter uses the printer registered to the output format, then the exec plugin found in the PATH and
+// the text printer when the output format is unknown
+func (pr *PrintResults) getPrinter() printer.Printer {
+	if registeredPrinter, ok := printer.Get(pr.configs.GetPrintOutputType()); ok {
+		return registeredPrinter
+	}
+	if path, ok := printer.LookExecPlugin(pr.configs.GetPrintOutputType()); ok {
+		return newExecPrinter(path)
+	}
+	return &textPrinter{}
 }
 
 func (pr *PrintResults) checkIfExistVulnerabilityOrNoSec() {
@@ -125,13 +121,13 @@ func (pr *PrintResults) checkIfExistVulnerabilityOrNoSec() {
 		pr.validateVulnerabilityToCheckTotalErrors(&vuln)
 	}
 	if logger.CurrentLevel >= logger.DebugLevel {
-		pr.logSeparator(len(pr.analysis.AnalysisVulnerabilities) > 0)
+		logSeparator(len(pr.analysis.AnalysisVulnerabilities) > 0)
 	}
 }
 
 func (pr *PrintResults) validateVulnerabilityToCheckTotalErrors(vuln *horusecEntities.Vulnerability) {
 	if vuln.Severity.ToString() != "" && !pr.isTypeVulnToSkip(vuln) {
-		if !pr.isIgnoredVulnerability(vuln.Severity.ToString()) {
+		if !pr.isIgnoredVulnerability(vuln.Severity.ToString()) && !pr.isBelowFailThreshold(vuln) {
 			logger.LogDebugWithLevel("{HORUSEC_CLI} Vulnerability Hash expected to be FIXED: "+vuln.VulnHash, logger.DebugLevel)
 			if logger.CurrentLevel >= logger.DebugLevel {
 				fmt.Println("")
@@ -142,7 +138,8 @@ func (pr *PrintResults) validateVulnerabilityToCheckTotalErrors(vuln *horusecEnt
 }
 
 func (pr *PrintResults) isTypeVulnToSkip(vuln *horusecEntities.Vulnerability) bool {
-	return vuln.Type == horusec.FalsePositive || vuln.Type == horusec.RiskAccepted || vuln.Type == horusec.Corrected
+	return vuln.Type == horusec.FalsePositive || vuln.Type == horusec.RiskAccepted || vuln.Type == horusec.Corrected ||
+		testcode.IsExcludedFromGates(pr.configs, vuln)
 }
 
 func (pr *PrintResults) isIgnoredVulnerability(vulnerabilityType string) (ignore bool) {
@@ -159,110 +156,9 @@ func (pr *PrintResults) isIgnoredVulnerability(vulnerabilityType string) (ignore
 	return ignore
 }
 
-func (pr *PrintResults) saveSonarQubeFormatResults() error {
-	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateSonarQubeFile, logger.InfoLevel)
-	report := pr.sonarqubeService.ConvertVulnerabilityDataToSonarQube()
-	bytesToWrite, err := json.MarshalIndent(report, "", "  ")
-	if err != nil {
-		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err, logger.ErrorLevel)
-		return err
-	}
-	return pr.parseFilePathToAbsAndCreateOutputJSON(bytesToWrite)
-}
-
-func (pr *PrintResults) returnDefaultErrOutputJSON(err error) error {
-	logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err, logger.ErrorLevel)
-	return ErrOutputJSON
-}
-
-func (pr *PrintResults) parseFilePathToAbsAndCreateOutputJSON(bytesToWrite []byte) error {
-	completePath, err := filepath.Abs(pr.configs.GetJSONOutputFilePath())
-	if err != nil {
-		return pr.returnDefaultErrOutputJSON(err)
-	}
-	if _, err := os.Create(completePath); err != nil {
-		return pr.returnDefaultErrOutputJSON(err)
-	}
-	logger.LogInfoWithLevel(messages.MsgInfoStartWriteFile+completePath, logger.InfoLevel)
-	return pr.openJSONFileAndWriteBytes(bytesToWrite, completePath)
-}
-
-func (pr *PrintResults) openJSONFileAndWriteBytes(bytesToWrite []byte, completePath string) error {
-	outputFile, err := os.OpenFile(completePath, os.O_CREATE|os.O_WRONLY, 0600)
-	if err != nil {
-		return pr.returnDefaultErrOutputJSON(err)
-	}
-	if err = outputFile.Truncate(0); err != nil {
-		return pr.returnDefaultErrOutputJSON(err)
-	}
-	bytesWritten, err := outputFile.Write(bytesToWrite)
-	if err != nil || bytesWritten != len(bytesToWrite) {
-		return pr.returnDefaultErrOutputJSON(err)
-	}
-	return outputFile.Close()
-}
-
-func (pr *PrintResults) printTextOutputVulnerability() {
-	for index := range pr.analysis.AnalysisVulnerabilities {
-		vulnerability := pr.analysis.AnalysisVulnerabilities[index].Vulnerability
-		pr.printTextOutputVulnerabilityData(&vulnerability)
-	}
-
-	pr.printTotalVulnerabilities()
-
-	pr.logSeparator(len(pr.analysis.AnalysisVulnerabilities) > 0)
-}
-
-func (pr *PrintResults) printTotalVulnerabilities() {
-	totalVulnerabilities := pr.analysis.GetTotalVulnerabilities()
-	if totalVulnerabilities > 0 {
-		fmt.Println(fmt.Sprintf("In this analysis, a total of %v possible vulnerabilities "+
-			"were found and we classified them into:", totalVulnerabilities))
-		fmt.Println("")
-	}
-	totalVulnerabilitiesBySeverity := pr.analysis.GetTotalVulnerabilitiesBySeverity()
-	for vulnType, countBySeverity := range totalVulnerabilitiesBySeverity {
-		for severityName, count := range countBySeverity {
-			if count > 0 {
-				fmt.Println(fmt.Sprintf("Total of %s %s is: %v", vulnType.ToString(), severityName.ToString(), count))
-			}
-		}
-	}
-}
-
-// nolint
-func (pr *PrintResults) printTextOutputVulnerabilityData(vulnerability *horusecEntities.Vulnerability) {
-	fmt.Println(fmt.Sprintf("Language: %s", vulnerability.Language))
-	fmt.Println(fmt.Sprintf("Severity: %s", vulnerability.Severity))
-	fmt.Println(fmt.Sprintf("Line: %s", vulnerability.Line))
-	fmt.Println(fmt.Sprintf("Column: %s", vulnerability.Column))
-	fmt.Println(fmt.Sprintf("SecurityTool: %s", vulnerability.SecurityTool))
-	fmt.Println(fmt.Sprintf("Confidence: %s", vulnerability.Confidence))
-	fmt.Println(fmt.Sprintf("File: %s/%s", pr.getProjectPath(), vulnerability.File))
-	fmt.Println(fmt.Sprintf("Code: %s", vulnerability.Code))
-	fmt.Println(fmt.Sprintf("Details: %s", vulnerability.Details))
-	fmt.Println(fmt.Sprintf("Type: %s", vulnerability.Type))
-
-	pr.printCommitAuthor(vulnerability)
-
-	fmt.Println(fmt.Sprintf("ReferenceHash: %s", vulnerability.VulnHash))
-
-	fmt.Print("\n")
-
-	pr.logSeparator(true)
-}
-
-// nolint
-func (pr *PrintResults) printCommitAuthor(vulnerability *horusecEntities.Vulnerability) {
-	if !pr.configs.GetEnableCommitAuthor() {
-		return
-	}
-	fmt.Println(fmt.Sprintf("Commit Author: %s", vulnerability.CommitAuthor))
-	fmt.Println(fmt.Sprintf("Commit Date: %s", vulnerability.CommitDate))
-	fmt.Println(fmt.Sprintf("Commit Email: %s", vulnerability.CommitEmail))
-	fmt.Println(fmt.Sprintf("Commit CommitHash: %s", vulnerability.CommitHash))
-	fmt.Println(fmt.Sprintf("Commit Message: %s", vulnerability.CommitMessage))
-
+func (pr *PrintResults) isBelowFailThreshold(vuln *horusecEntities.Vulnerability) bool {
+	failThreshold := pr.configs.GetFailThreshold()
+	return failThreshold != "" && vuln.Severity.IsBelow(severity.Severity(strings.ToUpper(failThreshold)))
 }
 
 func (pr *PrintResults) verifyRepositoryAuthorizationToken() {
@@ -275,7 +171,7 @@ func (pr *PrintResults) verifyRepositoryAuthorizationToken() {
 
 func (pr *PrintResults) checkIfExistsErrorsInAnalysis() {
 	if pr.analysis.HasErrors() {
-		pr.logSeparator(true)
+		logSeparator(true)
 		logger.LogWarnWithLevel(messages.MsgErrorFoundErrorsInAnalysis, logger.WarnLevel)
 		fmt.Print("\n")
 
@@ -307,17 +203,3 @@ func (pr *PrintResults) printResponseAnalysis() {
 	logger.LogWarnWithLevel(messages.MsgAnalysisFinishedWithoutVulns, logger.WarnLevel)
 	fmt.Print("\n")
 }
-
-func (pr *PrintResults) logSeparator(isToShow bool) {
-	if isToShow {
-		fmt.Println(fmt.Sprintf("\n==================================================================================\n"))
-	}
-}
-
-func (pr *PrintResults) getProjectPath() string {
-	if pr.configs.GetContainerBindProjectPath() != "" {
-		return pr.configs.GetContainerBindProjectPath()
-	}
-
-	return pr.configs.GetProjectPath()
-}
@@ -15,14 +15,19 @@
 package printresults
 
 import (
+	"encoding/json"
 	"errors"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/test"
 	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/printer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -69,6 +74,53 @@ func TestPrintResults_StartPrintResults(t *testing.T) {
 		assert.Equal(t, 0, totalVulns)
 	})
 
+	t.Run("Should write only the json in the stdout in the quiet mode", func(t *testing.T) {
+		stdout := os.Stdout
+		reader, writer, err := os.Pipe()
+		assert.NoError(t, err)
+		os.Stdout = writer
+		defer func() {
+			os.Stdout = stdout
+		}()
+
+		configs := &config.Config{}
+		configs.SetPrintOutputType(cli.JSON.ToString())
+		restore := printer.EnableQuiet()
+		_, err = NewPrintResults(test.CreateAnalysisMock(), configs).StartPrintResults()
+		restore()
+		assert.NoError(t, err)
+
+		assert.NoError(t, writer.Close())
+		content, err := ioutil.ReadAll(reader)
+		assert.NoError(t, err)
+		analysis := &horusec.Analysis{}
+		assert.NoError(t, json.Unmarshal(content, analysis))
+		assert.Len(t, analysis.AnalysisVulnerabilities, 11)
+	})
+
+	t.Run("Should not write the json unencrypted in the stdout when the encryption fails", func(t *testing.T) {
+		stdout := os.Stdout
+		reader, writer, err := os.Pipe()
+		assert.NoError(t, err)
+		os.Stdout = writer
+		defer func() {
+			os.Stdout = stdout
+		}()
+
+		configs := &config.Config{}
+		configs.SetPrintOutputType(cli.JSON.ToString())
+		configs.SetEncryptReport(true)
+		restore := printer.EnableQuiet()
+		_, err = NewPrintResults(test.CreateAnalysisMock(), configs).StartPrintResults()
+		restore()
+		assert.Error(t, err)
+
+		assert.NoError(t, writer.Close())
+		content, err := ioutil.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Empty(t, content)
+	})
+
 	t.Run("Should return not errors because exists error in analysis", func(t *testing.T) {
 		analysis := &horusec.Analysis{
 			Errors: "Exists an error when read analysis",
@@ -165,6 +217,21 @@ func TestPrintResults_StartPrintResults(t *testing.T) {
 		assert.Equal(t, 12, totalVulns)
 	})
 
+	t.Run("Should return 12 vulnerabilities with code context", func(t *testing.T) {
+		configs := &config.Config{}
+
+		analysis := test.CreateAnalysisMock()
+
+		vulnerability := test.GetGoVulnerabilityWithSeverity(severity.Medium)
+		vulnerability.CodeContext = []horusec.CodeLine{{Line: 1, Code: "package main"}, {Line: 2, Code: ""}}
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities, horusec.AnalysisVulnerabilities{Vulnerability: vulnerability})
+
+		totalVulns, err := NewPrintResults(analysis, configs).StartPrintResults()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 12, totalVulns)
+	})
+
 	t.Run("Should return 12 vulnerabilities", func(t *testing.T) {
 		configs := &config.Config{}
 
@@ -178,6 +245,22 @@ func TestPrintResults_StartPrintResults(t *testing.T) {
 		assert.Equal(t, 12, totalVulns)
 	})
 
+	t.Run("Should return 11 vulnerabilities when the test code is excluded from gates", func(t *testing.T) {
+		configs := &config.Config{}
+		configs.SetTestCodeMode(cli.TestCodeExcludeFromGates.ToString())
+
+		analysis := test.CreateAnalysisMock()
+
+		vulnerability := test.GetGoVulnerabilityWithSeverity(severity.High)
+		vulnerability.IsTestCode = true
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities, horusec.AnalysisVulnerabilities{Vulnerability: vulnerability})
+
+		totalVulns, err := NewPrintResults(analysis, configs).StartPrintResults()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 11, totalVulns)
+	})
+
 	t.Run("Should not return errors when configured to ignore vulnerabilities with severity LOW and MEDIUM", func(t *testing.T) {
 		analysis := test.CreateAnalysisMock()
 
@@ -205,4 +288,41 @@ func TestPrintResults_StartPrintResults(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, 1, totalVulns)
 	})
+
+	t.Run("Should keep in the report the vulnerabilities below the fail threshold without counting them", func(t *testing.T) {
+		analysis := test.CreateAnalysisMock()
+		analysis.AnalysisVulnerabilities = []horusec.AnalysisVulnerabilities{
+			{Vulnerability: test.GetGoVulnerabilityWithSeverity(severity.Low)},
+			{Vulnerability: test.GetGoVulnerabilityWithSeverity(severity.High)},
+		}
+
+		configs := &config.Config{}
+		configs.SetFailThreshold("high")
+		printResults := &PrintResults{analysis: analysis, configs: configs}
+
+		totalVulns, err := printResults.StartPrintResults()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, totalVulns)
+		assert.Len(t, printResults.getAnalysisToReport().AnalysisVulnerabilities, 2)
+	})
+
+	t.Run("Should remove of the report the vulnerabilities below the report min severity", func(t *testing.T) {
+		analysis := test.CreateAnalysisMock()
+		analysis.AnalysisVulnerabilities = []horusec.AnalysisVulnerabilities{
+			{Vulnerability: test.GetGoVulnerabilityWithSeverity(severity.Low)},
+			{Vulnerability: test.GetGoVulnerabilityWithSeverity(severity.High)},
+		}
+
+		configs := &config.Config{}
+		configs.SetReportMinSeverity("MEDIUM")
+		printResults := &PrintResults{analysis: analysis, configs: configs}
+
+		totalVulns, err := printResults.StartPrintResults()
+		assert.NoError(t, err)
+		assert.Equal(t, 2, totalVulns)
+		report := printResults.getAnalysisToReport()
+		assert.Len(t, report.AnalysisVulnerabilities, 1)
+		assert.Equal(t, severity.High, report.AnalysisVulnerabilities[0].Vulnerability.Severity)
+		assert.Len(t, analysis.AnalysisVulnerabilities, 2)
+	})
 }
@@ -0,0 +1,192 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printresults
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/codeowners"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/maxfindings"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/policy"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/risk"
+)
+
+// printSummary ends the text output with the totals of the analysis and the decision of the gates, so the logs of
+// the pipelines end with the verdict instead of the vulnerabilities
+func (pr *PrintResults) printSummary() {
+	if _, ok := pr.getPrinter().(*textPrinter); !ok {
+		return
+	}
+
+	fmt.Println("SUMMARY:")
+	fmt.Println(fmt.Sprintf("Vulnerabilities by severity: %s", pr.getTotalBySeverity()))
+	fmt.Println(fmt.Sprintf("Vulnerabilities by tool: %s", pr.getTotalByTool()))
+	if totalByOwner := pr.getTotalByOwner(); totalByOwner != "" {
+		fmt.Println(fmt.Sprintf("Vulnerabilities by owner: %s", totalByOwner))
+	}
+	pr.printManifestSummary()
+	if len(pr.analysis.SkippedFiles) > 0 {
+		fmt.Println(fmt.Sprintf("Vendored or generated files skipped: %s", strings.Join(pr.analysis.SkippedFiles, ", ")))
+	}
+	pr.printBaselineSummary()
+	fmt.Println(fmt.Sprintf("Gate: %s", pr.getGateDecision()))
+	logSeparator(true)
+}
+
+func (pr *PrintResults) getTotalBySeverity() string {
+	totals := pr.analysis.GetTotalVulnerabilitiesBySeverity()[horusec.Vulnerability]
+	var values []string
+	for _, severityName := range (&textPrinter{}).getSeveritiesInOrder() {
+		if count := totals[severityName]; count > 0 {
+			values = append(values, fmt.Sprintf("%s %d", severityName, count))
+		}
+	}
+
+	return joinOrNone(values)
+}
+
+func (pr *PrintResults) getTotalByTool() string {
+	totals := map[string]int{}
+	for index := range pr.analysis.AnalysisVulnerabilities {
+		if vuln := pr.analysis.AnalysisVulnerabilities[index].Vulnerability; vuln.Type == horusec.Vulnerability {
+			totals[vuln.SecurityTool.ToString()]++
+		}
+	}
+
+	var values []string
+	for tool, count := range totals {
+		values = append(values, fmt.Sprintf("%s %d", tool, count))
+	}
+
+	sort.Strings(values)
+	return joinOrNone(values)
+}
+
+// getTotalByOwner is empty when the project has no CODEOWNERS, the vulnerabilities of many owners count to each one
+func (pr *PrintResults) getTotalByOwner() string {
+	totals, hasOwners := map[string]int{}, false
+	for index := range pr.analysis.AnalysisVulnerabilities {
+		if vuln := pr.analysis.AnalysisVulnerabilities[index].Vulnerability; vuln.Type == horusec.Vulnerability {
+			hasOwners = hasOwners || len(vuln.Owners) > 0
+			for _, owner := range codeowners.GetOwnersOrUnowned(&vuln) {
+				totals[owner]++
+			}
+		}
+	}
+	if !hasOwners {
+		return ""
+	}
+
+	var values []string
+	for owner, count := range totals {
+		values = append(values, fmt.Sprintf("%s %d", owner, count))
+	}
+
+	sort.Strings(values)
+	return strings.Join(values, ", ")
+}
+
+func (pr *PrintResults) printManifestSummary() {
+	manifest := pr.analysis.ScanManifest
+	if manifest == nil {
+		return
+	}
+
+	fmt.Println(fmt.Sprintf("Files scanned: %d", manifest.FilesScanned))
+	var values []string
+	for tool, duration := range manifest.GetDurationByTool() {
+		values = append(values, fmt.Sprintf("%s %.1fs", tool, duration))
+	}
+
+	sort.Strings(values)
+	fmt.Println(fmt.Sprintf("Duration by tool: %s", joinOrNone(values)))
+	pr.printFiltersSummary(manifest.Filters)
+}
+
+// printFiltersSummary keeps the order of the filters, each one removes of the vulnerabilities kept by the others
+func (pr *PrintResults) printFiltersSummary(filters []horusecEntities.FilterExecution) {
+	if len(filters) == 0 {
+		return
+	}
+
+	values := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		values = append(values, fmt.Sprintf("%s %d", filter.Name, filter.Removed))
+	}
+
+	fmt.Println(fmt.Sprintf("Removed by filter: %s", strings.Join(values, ", ")))
+}
+
+func (pr *PrintResults) printBaselineSummary() {
+	baseline, err := policy.GetBaselineHashes(pr.configs)
+	if err != nil || baseline == nil {
+		return
+	}
+
+	newVulns, baselineVulns := 0, 0
+	for index := range pr.analysis.AnalysisVulnerabilities {
+		if vuln := pr.analysis.AnalysisVulnerabilities[index].Vulnerability; vuln.Type == horusec.Vulnerability {
+			if baseline[vuln.VulnHash] {
+				baselineVulns++
+				continue
+			}
+			newVulns++
+		}
+	}
+
+	fmt.Println(fmt.Sprintf("New vulnerabilities: %d, in the baseline: %d", newVulns, baselineVulns))
+}
+
+// getGateDecision follows the order of the gates of the analysis, the policy replaces the return-error
+func (pr *PrintResults) getGateDecision() string {
+	if len(pr.analysis.PolicyDenials) > 0 {
+		return fmt.Sprintf("FAILED, denied by the policy with %d reasons", len(pr.analysis.PolicyDenials))
+	}
+
+	if risk.NewRisk(pr.configs).IsBelowMinGrade(pr.analysis.RiskScore) {
+		return fmt.Sprintf("FAILED, risk grade %s is below the minimum %s", pr.analysis.RiskScore.Grade,
+			strings.ToUpper(pr.configs.GetMinGrade()))
+	}
+
+	if exceeded := maxfindings.NewMaxFindings(pr.configs).GetExceeded(pr.analysis); len(exceeded) > 0 {
+		return fmt.Sprintf("FAILED, max findings exceeded: %s", strings.Join(exceeded, ", "))
+	}
+
+	if pr.analysis.IsPartial {
+		return "FAILED, the analysis timed out and the report is partial"
+	}
+
+	if pr.configs.GetPolicyPath() == "" && pr.totalVulns > 0 {
+		if pr.configs.GetReturnErrorIfFoundVulnerability() {
+			return fmt.Sprintf("FAILED, %d blocking vulnerabilities", pr.totalVulns)
+		}
+
+		return fmt.Sprintf("PASSED, %d blocking vulnerabilities without return-error", pr.totalVulns)
+	}
+
+	return "PASSED"
+}
+
+func joinOrNone(values []string) string {
+	if len(values) == 0 {
+		return "none"
+	}
+
+	return strings.Join(values, ", ")
+}
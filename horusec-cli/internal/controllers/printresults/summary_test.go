@@ -0,0 +1,149 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printresults
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+func newSummaryAnalysisToTest() *horusecEntities.Analysis {
+	analysis := &horusecEntities.Analysis{ScanManifest: &horusecEntities.ScanManifest{FilesScanned: 42,
+		ToolsExecuted: []horusecEntities.ToolExecution{
+			{Tool: tools.GoSec, DurationInSeconds: 1.25}, {Tool: tools.GoSec, DurationInSeconds: 2},
+		}}}
+	for _, vuln := range []horusecEntities.Vulnerability{
+		{VulnHash: "1", Severity: severity.High, SecurityTool: tools.GoSec, Type: horusec.Vulnerability},
+		{VulnHash: "2", Severity: severity.High, SecurityTool: tools.HorusecLeaks, Type: horusec.Vulnerability},
+		{VulnHash: "3", Severity: severity.Low, SecurityTool: tools.GoSec, Type: horusec.Vulnerability},
+		{VulnHash: "4", Severity: severity.Critical, SecurityTool: tools.GoSec, Type: horusec.FalsePositive},
+	} {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+			horusecEntities.AnalysisVulnerabilities{Vulnerability: vuln})
+	}
+	return analysis
+}
+
+func TestPrintResults_Summary(t *testing.T) {
+	t.Run("should return the totals of the vulnerabilities by severity and by tool", func(t *testing.T) {
+		pr := &PrintResults{analysis: newSummaryAnalysisToTest(), configs: config.NewConfig()}
+
+		assert.Equal(t, "HIGH 2, LOW 1", pr.getTotalBySeverity())
+		assert.Equal(t, "GoSec 2, HorusecLeaks 1", pr.getTotalByTool())
+		assert.NotPanics(t, pr.printSummary)
+	})
+
+	t.Run("should return none without vulnerabilities", func(t *testing.T) {
+		pr := &PrintResults{analysis: &horusecEntities.Analysis{}, configs: config.NewConfig()}
+
+		assert.Equal(t, "none", pr.getTotalBySeverity())
+		assert.Equal(t, "none", pr.getTotalByTool())
+		assert.Equal(t, "PASSED", pr.getGateDecision())
+		assert.NotPanics(t, pr.printSummary)
+	})
+
+	t.Run("should print the new vulnerabilities of the baseline", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "summary")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		baseline := newSummaryAnalysisToTest()
+		baseline.AnalysisVulnerabilities = baseline.AnalysisVulnerabilities[:1]
+		content, err := json.Marshal(baseline)
+		assert.NoError(t, err)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "baseline.json"), content, 0600))
+
+		configs := config.NewConfig()
+		configs.SetPolicyBaselinePath(filepath.Join(dir, "baseline.json"))
+		pr := &PrintResults{analysis: newSummaryAnalysisToTest(), configs: configs}
+		assert.NotPanics(t, pr.printSummary)
+	})
+
+	t.Run("should print the vulnerabilities removed by each filter", func(t *testing.T) {
+		analysis := newSummaryAnalysisToTest()
+		analysis.ScanManifest.Filters = []horusecEntities.FilterExecution{
+			{Name: "severity", Removed: 2}, {Name: "dedup", Removed: 1},
+		}
+		pr := &PrintResults{analysis: analysis, configs: config.NewConfig()}
+		assert.NotPanics(t, pr.printSummary)
+	})
+
+	t.Run("should print the vendored or generated files skipped", func(t *testing.T) {
+		analysis := newSummaryAnalysisToTest()
+		analysis.SkippedFiles = []string{"vendor/lib/lib.py", "api.pb.go"}
+		pr := &PrintResults{analysis: analysis, configs: config.NewConfig()}
+		assert.NotPanics(t, pr.printSummary)
+	})
+}
+
+func TestPrintResults_GetGateDecision(t *testing.T) {
+	t.Run("should fail when the policy denied the analysis", func(t *testing.T) {
+		analysis := newSummaryAnalysisToTest()
+		analysis.PolicyDenials = []string{"critical vulnerability"}
+		pr := &PrintResults{analysis: analysis, configs: config.NewConfig()}
+
+		assert.Equal(t, "FAILED, denied by the policy with 1 reasons", pr.getGateDecision())
+	})
+
+	t.Run("should fail when the risk grade is below the minimum", func(t *testing.T) {
+		analysis := newSummaryAnalysisToTest()
+		analysis.RiskScore = &horusecEntities.RiskScore{Score: 60, Grade: "E"}
+		configs := config.NewConfig()
+		configs.SetMinGrade("b")
+		pr := &PrintResults{analysis: analysis, configs: configs}
+
+		assert.Equal(t, "FAILED, risk grade E is below the minimum B", pr.getGateDecision())
+	})
+
+	t.Run("should fail when the max findings are exceeded", func(t *testing.T) {
+		configs := config.NewConfig()
+		configs.SetMaxFindings(map[string]string{"critical": "0", "high": "1", "low": "1"})
+		pr := &PrintResults{analysis: newSummaryAnalysisToTest(), configs: configs}
+
+		assert.Equal(t, "FAILED, max findings exceeded: HIGH 2/1", pr.getGateDecision())
+	})
+
+	t.Run("should fail when the analysis timed out", func(t *testing.T) {
+		analysis := newSummaryAnalysisToTest()
+		analysis.IsPartial = true
+		pr := &PrintResults{analysis: analysis, configs: config.NewConfig()}
+
+		assert.Equal(t, "FAILED, the analysis timed out and the report is partial", pr.getGateDecision())
+	})
+
+	t.Run("should fail with blocking vulnerabilities and return-error", func(t *testing.T) {
+		configs := config.NewConfig()
+		configs.SetReturnErrorIfFoundVulnerability(true)
+		pr := &PrintResults{analysis: newSummaryAnalysisToTest(), configs: configs, totalVulns: 3}
+
+		assert.Equal(t, "FAILED, 3 blocking vulnerabilities", pr.getGateDecision())
+	})
+
+	t.Run("should pass with blocking vulnerabilities without return-error", func(t *testing.T) {
+		pr := &PrintResults{analysis: newSummaryAnalysisToTest(), configs: config.NewConfig(), totalVulns: 3}
+
+		assert.Equal(t, "PASSED, 3 blocking vulnerabilities without return-error", pr.getGateDecision())
+	})
+}
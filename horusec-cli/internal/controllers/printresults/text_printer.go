@@ -0,0 +1,234 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printresults
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/printer"
+)
+
+func init() {
+	printer.Register(cli.Text.ToString(), &textPrinter{})
+}
+
+type textPrinter struct {
+	isColored bool
+}
+
+// nolint
+func (t *textPrinter) Print(analysis *horusec.Analysis, configs config.IConfig) error {
+	t.isColored = isColorEnabled()
+	logSeparator(true)
+
+	fmt.Println(fmt.Sprintf("HORUSEC ENDED THE ANALYSIS WITH STATUS OF \"%s\" AND WITH THE FOLLOWING RESULTS:", analysis.Status))
+
+	logSeparator(true)
+
+	fmt.Println(fmt.Sprintf("Analysis StartedAt: %s", analysis.CreatedAt.Format("2006-01-02 15:04:05")))
+	fmt.Println(fmt.Sprintf("Analysis FinishedAt: %s", analysis.FinishedAt.Format("2006-01-02 15:04:05")))
+	t.printSource(analysis)
+	t.printTags(analysis)
+
+	logSeparator(true)
+
+	t.printVulnerabilitiesWithStyle(analysis, configs)
+	t.printRiskScore(analysis)
+	t.printPolicyDenials(analysis)
+	return nil
+}
+
+func (t *textPrinter) printVulnerabilitiesWithStyle(analysis *horusec.Analysis, configs config.IConfig) {
+	switch cli.PrintStyle(configs.GetPrintStyle()) {
+	case cli.PrintStyleCompact:
+		t.printCompact(analysis)
+	case cli.PrintStyleGrouped:
+		t.printGrouped(analysis)
+	default:
+		t.printTextOutputVulnerability(analysis, configs)
+	}
+}
+
+func (t *textPrinter) printRiskScore(analysis *horusec.Analysis) {
+	if analysis.RiskScore == nil {
+		return
+	}
+	fmt.Println(fmt.Sprintf("Risk Score: %v (grade %s)", analysis.RiskScore.Score, analysis.RiskScore.Grade))
+	logSeparator(true)
+}
+
+func (t *textPrinter) printPolicyDenials(analysis *horusec.Analysis) {
+	if len(analysis.PolicyDenials) == 0 {
+		return
+	}
+	fmt.Println("The analysis was denied by the policy:")
+	for _, denial := range analysis.PolicyDenials {
+		fmt.Println(fmt.Sprintf("- %s", denial))
+	}
+	logSeparator(true)
+}
+
+func (t *textPrinter) printSource(analysis *horusec.Analysis) {
+	if analysis.Source == nil {
+		return
+	}
+	for _, field := range [][2]string{
+		{"Repository", analysis.Source.RepositoryURL},
+		{"Branch", analysis.Source.Branch},
+		{"Commit", analysis.Source.CommitSHA},
+		{"Pull Request", analysis.Source.PullRequest},
+	} {
+		if field[1] != "" {
+			fmt.Println(fmt.Sprintf("%s: %s", field[0], field[1]))
+		}
+	}
+}
+
+func (t *textPrinter) printTags(analysis *horusec.Analysis) {
+	keys := make([]string, 0, len(analysis.Tags))
+	for key := range analysis.Tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Println(fmt.Sprintf("Tag %s: %s", key, analysis.Tags[key]))
+	}
+}
+
+func (t *textPrinter) printTextOutputVulnerability(analysis *horusec.Analysis, configs config.IConfig) {
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := analysis.AnalysisVulnerabilities[index].Vulnerability
+		t.printTextOutputVulnerabilityData(&vulnerability, configs)
+	}
+
+	t.printTotalVulnerabilities(analysis)
+
+	logSeparator(len(analysis.AnalysisVulnerabilities) > 0)
+}
+
+func (t *textPrinter) printTotalVulnerabilities(analysis *horusec.Analysis) {
+	totalVulnerabilities := analysis.GetTotalVulnerabilities()
+	if totalVulnerabilities > 0 {
+		fmt.Println(fmt.Sprintf("In this analysis, a total of %v possible vulnerabilities "+
+			"were found and we classified them into:", totalVulnerabilities))
+		fmt.Println("")
+	}
+	totalVulnerabilitiesBySeverity := analysis.GetTotalVulnerabilitiesBySeverity()
+	for _, vulnType := range t.getVulnerabilityTypesInOrder() {
+		for _, severityName := range t.getSeveritiesInOrder() {
+			if count := totalVulnerabilitiesBySeverity[vulnType][severityName]; count > 0 {
+				fmt.Println(fmt.Sprintf("Total of %s %s is: %v", vulnType.ToString(), severityName.ToString(), count))
+			}
+		}
+	}
+}
+
+// getVulnerabilityTypesInOrder is used instead of the keys of the totals, to print them in the same order always
+func (t *textPrinter) getVulnerabilityTypesInOrder() []enumHorusec.VulnerabilityType {
+	return []enumHorusec.VulnerabilityType{enumHorusec.Vulnerability, enumHorusec.RiskAccepted,
+		enumHorusec.FalsePositive, enumHorusec.Corrected}
+}
+
+func (t *textPrinter) getSeveritiesInOrder() []severity.Severity {
+	return []severity.Severity{severity.Critical, severity.High, severity.Medium, severity.Low, severity.Info,
+		severity.Audit, severity.NoSec}
+}
+
+// nolint
+func (t *textPrinter) printTextOutputVulnerabilityData(vulnerability *horusec.Vulnerability, configs config.IConfig) {
+	fmt.Println(fmt.Sprintf("Language: %s", vulnerability.Language))
+	fmt.Println(fmt.Sprintf("Severity: %s", t.colorize(vulnerability.Severity, vulnerability.Severity.ToString())))
+	if vulnerability.ToolSeverity != "" {
+		fmt.Println(fmt.Sprintf("ToolSeverity: %s", vulnerability.ToolSeverity))
+	}
+	fmt.Println(fmt.Sprintf("Line: %s", vulnerability.Line))
+	fmt.Println(fmt.Sprintf("Column: %s", vulnerability.Column))
+	fmt.Println(fmt.Sprintf("SecurityTool: %s", vulnerability.SecurityTool))
+	fmt.Println(fmt.Sprintf("Confidence: %s", vulnerability.Confidence))
+	fmt.Println(fmt.Sprintf("File: %s/%s", t.getProjectPath(configs), vulnerability.File))
+	fmt.Println(fmt.Sprintf("Code: %s", vulnerability.Code))
+	t.printCodeContext(vulnerability)
+	fmt.Println(fmt.Sprintf("Details: %s", vulnerability.Details))
+	fmt.Println(fmt.Sprintf("Type: %s", vulnerability.Type))
+	if vulnerability.IsTestCode {
+		fmt.Println("TestCode: true")
+	}
+	if vulnerability.IsWarnOnly {
+		fmt.Println("WarnOnly: true")
+	}
+	if vulnerability.VerificationStatus != "" {
+		fmt.Println(fmt.Sprintf("VerificationStatus: %s", vulnerability.VerificationStatus))
+	}
+	if vulnerability.Triage != nil {
+		fmt.Println(fmt.Sprintf("Triage: %s - %s", vulnerability.Triage.Classification,
+			vulnerability.Triage.Justification))
+	}
+
+	t.printCommitAuthor(vulnerability, configs)
+
+	fmt.Println(fmt.Sprintf("ReferenceHash: %s", vulnerability.VulnHash))
+
+	fmt.Print("\n")
+
+	logSeparator(true)
+}
+
+// printCodeContext marks the line of the vulnerability between the lines of context
+func (t *textPrinter) printCodeContext(vulnerability *horusec.Vulnerability) {
+	if len(vulnerability.CodeContext) == 0 {
+		return
+	}
+	fmt.Println("Code Context:")
+	for _, codeLine := range vulnerability.CodeContext {
+		marker := " "
+		if strconv.Itoa(codeLine.Line) == vulnerability.Line {
+			marker = ">"
+		}
+		fmt.Println(fmt.Sprintf("%s %5d | %s", marker, codeLine.Line, codeLine.Code))
+	}
+}
+
+// nolint
+func (t *textPrinter) printCommitAuthor(vulnerability *horusec.Vulnerability, configs config.IConfig) {
+	if !configs.GetEnableCommitAuthor() {
+		return
+	}
+	fmt.Println(fmt.Sprintf("Commit Author: %s", vulnerability.CommitAuthor))
+	fmt.Println(fmt.Sprintf("Commit Date: %s", vulnerability.CommitDate))
+	fmt.Println(fmt.Sprintf("Commit Email: %s", vulnerability.CommitEmail))
+	fmt.Println(fmt.Sprintf("Commit CommitHash: %s", vulnerability.CommitHash))
+	fmt.Println(fmt.Sprintf("Commit Message: %s", vulnerability.CommitMessage))
+}
+
+func (t *textPrinter) getProjectPath(configs config.IConfig) string {
+	if configs.GetContainerBindProjectPath() != "" {
+		return configs.GetContainerBindProjectPath()
+	}
+
+	return configs.GetProjectPath()
+}
+
+func logSeparator(isToShow bool) {
+	if isToShow {
+		fmt.Println(fmt.Sprintf("\n==================================================================================\n"))
+	}
+}
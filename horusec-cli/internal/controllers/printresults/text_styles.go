@@ -0,0 +1,148 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printresults
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+const (
+	colorReset = "\033[0m"
+	// maxDetailsLength truncates the details in the compact and grouped styles, to keep one line for each vulnerability
+	maxDetailsLength = 100
+)
+
+var severityColors = map[severity.Severity]string{
+	severity.Critical: "\033[1;31m",
+	severity.High:     "\033[31m",
+	severity.Medium:   "\033[33m",
+	severity.Low:      "\033[34m",
+	severity.Info:     "\033[36m",
+}
+
+// isColorEnabled disables the colors when the output is piped or redirected, or when NO_COLOR is set
+func isColorEnabled() bool {
+	info, err := os.Stdout.Stat()
+	return os.Getenv("NO_COLOR") == "" && err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+func (t *textPrinter) colorize(vulnSeverity severity.Severity, text string) string {
+	if color, ok := severityColors[vulnSeverity]; ok && t.isColored {
+		return color + text + colorReset
+	}
+
+	return text
+}
+
+func (t *textPrinter) printCompact(analysis *horusec.Analysis) {
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		fmt.Println(t.getCompactLine(vulnerability, getLocation(vulnerability.File, vulnerability)))
+	}
+
+	t.printTotalsOfStyle(analysis)
+}
+
+func (t *textPrinter) printGrouped(analysis *horusec.Analysis) {
+	files, vulnerabilitiesByFile := t.groupByFile(analysis)
+	for _, file := range files {
+		fmt.Println(fmt.Sprintf("%s (%d vulnerabilities)", file, len(vulnerabilitiesByFile[file])))
+		for _, vulnerability := range vulnerabilitiesByFile[file] {
+			fmt.Println("  " + t.getCompactLine(vulnerability, getLocation("", vulnerability)))
+		}
+	}
+
+	t.printTotalsOfStyle(analysis)
+}
+
+func (t *textPrinter) groupByFile(analysis *horusec.Analysis) ([]string, map[string][]*horusec.Vulnerability) {
+	vulnerabilitiesByFile := map[string][]*horusec.Vulnerability{}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		vulnerabilitiesByFile[vulnerability.File] = append(vulnerabilitiesByFile[vulnerability.File], vulnerability)
+	}
+
+	files := make([]string, 0, len(vulnerabilitiesByFile))
+	for file := range vulnerabilitiesByFile {
+		files = append(files, file)
+	}
+
+	sort.Strings(files)
+	return files, vulnerabilitiesByFile
+}
+
+func (t *textPrinter) printTotalsOfStyle(analysis *horusec.Analysis) {
+	if len(analysis.AnalysisVulnerabilities) > 0 {
+		fmt.Print("\n")
+	}
+
+	t.printTotalVulnerabilities(analysis)
+	logSeparator(true)
+}
+
+// getCompactLine is the severity, the location, the tool and the first line of the details, then the type when it
+// isn't a vulnerability and the hash used to mark it as false positive or risk accepted
+func (t *textPrinter) getCompactLine(vulnerability *horusec.Vulnerability, location string) string {
+	line := fmt.Sprintf("%s %s %s: %s", t.colorize(vulnerability.Severity, "["+vulnerability.Severity.ToString()+"]"),
+		location, vulnerability.SecurityTool, getFirstLine(vulnerability.Details))
+	if vulnerability.Type != "" && vulnerability.Type != enumHorusec.Vulnerability {
+		line += fmt.Sprintf(" (%s)", vulnerability.Type)
+	}
+
+	if vulnerability.IsTestCode {
+		line += " (test code)"
+	}
+
+	if vulnerability.IsWarnOnly {
+		line += " (warn only)"
+	}
+
+	return line + " " + vulnerability.VulnHash
+}
+
+func getLocation(file string, vulnerability *horusec.Vulnerability) string {
+	location := strings.Join(removeEmpty(file, vulnerability.Line), ":")
+	if vulnerability.Column != "" && vulnerability.Column != "0" {
+		location += ":" + vulnerability.Column
+	}
+
+	return location
+}
+
+func removeEmpty(values ...string) (notEmpty []string) {
+	for _, value := range values {
+		if value != "" {
+			notEmpty = append(notEmpty, value)
+		}
+	}
+
+	return notEmpty
+}
+
+func getFirstLine(details string) string {
+	line := strings.TrimSpace(strings.SplitN(strings.TrimSpace(details), "\n", 2)[0])
+	if runes := []rune(line); len(runes) > maxDetailsLength {
+		return string(runes[:maxDetailsLength]) + "..."
+	}
+
+	return line
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printresults
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+func TestTextPrinter_GetCompactLine(t *testing.T) {
+	vulnerability := &horusecEntities.Vulnerability{File: "api/main.go", Line: "12", Column: "4",
+		Severity: severity.High, SecurityTool: tools.GoSec, Details: "Use of weak crypto\nmore details",
+		VulnHash: "hash", Type: horusec.Vulnerability}
+
+	t.Run("should return the severity, location, tool, details and hash in one line", func(t *testing.T) {
+		line := (&textPrinter{}).getCompactLine(vulnerability, getLocation(vulnerability.File, vulnerability))
+		assert.Equal(t, "[HIGH] api/main.go:12:4 GoSec: Use of weak crypto hash", line)
+	})
+
+	t.Run("should return the type and test code without column", func(t *testing.T) {
+		vuln := *vulnerability
+		vuln.Column, vuln.Type, vuln.IsTestCode = "0", horusec.FalsePositive, true
+		line := (&textPrinter{}).getCompactLine(&vuln, getLocation("", &vuln))
+		assert.Equal(t, "[HIGH] 12 GoSec: Use of weak crypto (False Positive) (test code) hash", line)
+	})
+
+	t.Run("should color the severity", func(t *testing.T) {
+		line := (&textPrinter{isColored: true}).getCompactLine(vulnerability, "api/main.go:12")
+		assert.True(t, strings.HasPrefix(line, severityColors[severity.High]+"[HIGH]"+colorReset))
+	})
+}
+
+func TestGetFirstLine(t *testing.T) {
+	t.Run("should truncate the long details", func(t *testing.T) {
+		line := getFirstLine(strings.Repeat("a", maxDetailsLength+10))
+		assert.Equal(t, strings.Repeat("a", maxDetailsLength)+"...", line)
+	})
+}
+
+func TestTextPrinter_GroupByFile(t *testing.T) {
+	t.Run("should group the vulnerabilities by file sorted", func(t *testing.T) {
+		analysis := newSummaryAnalysisToTest()
+		analysis.AnalysisVulnerabilities[0].Vulnerability.File = "b.go"
+		analysis.AnalysisVulnerabilities[1].Vulnerability.File = "a.go"
+		analysis.AnalysisVulnerabilities[2].Vulnerability.File = "b.go"
+		analysis.AnalysisVulnerabilities[3].Vulnerability.File = "a.go"
+
+		files, vulnerabilitiesByFile := (&textPrinter{}).groupByFile(analysis)
+		assert.Equal(t, []string{"a.go", "b.go"}, files)
+		assert.Len(t, vulnerabilitiesByFile["a.go"], 2)
+		assert.Equal(t, "1", vulnerabilitiesByFile["b.go"][0].VulnHash)
+	})
+}
+
+func TestTextPrinter_PrintStyles(t *testing.T) {
+	for _, style := range []cli.PrintStyle{cli.PrintStyleFull, cli.PrintStyleCompact, cli.PrintStyleGrouped} {
+		t.Run("should print with the style "+style.ToString(), func(t *testing.T) {
+			configs := config.NewConfig()
+			configs.SetPrintStyle(style.ToString())
+
+			assert.NoError(t, (&textPrinter{}).Print(newSummaryAnalysisToTest(), configs))
+		})
+	}
+}
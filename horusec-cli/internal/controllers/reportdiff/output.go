@@ -0,0 +1,142 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reportdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+)
+
+const (
+	Text     = "text"
+	JSON     = "json"
+	Markdown = "markdown"
+)
+
+func OutputFormats() []string {
+	return []string{Text, JSON, Markdown}
+}
+
+// Write writes the diff in the output format, text is used when the format is unknown
+func (d *Diff) Write(writer io.Writer, outputFormat string) error {
+	switch outputFormat {
+	case JSON:
+		return d.writeJSON(writer)
+	case Markdown:
+		return d.writeMarkdown(writer)
+	default:
+		return d.writeText(writer)
+	}
+}
+
+func (d *Diff) writeJSON(writer io.Writer) error {
+	content, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(writer, string(content))
+	return err
+}
+
+func (d *Diff) writeText(writer io.Writer) error {
+	tab := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tab, "Security posture: %s\n", d.Posture)
+	fmt.Fprintf(tab, "Total of vulnerabilities: %v -> %v\n", d.TotalOld, d.TotalNew)
+	fmt.Fprintf(tab, "New: %v\tFixed: %v\tPersistent: %v\n\n", len(d.New), len(d.Fixed), len(d.Persistent))
+	d.writeTextDeltas(tab, "SEVERITY", d.SeverityDeltas)
+	d.writeTextDeltas(tab, "TOOL", d.ToolDeltas)
+	d.writeTextVulnerabilities(tab, "NEW VULNERABILITIES", d.New)
+	d.writeTextVulnerabilities(tab, "FIXED VULNERABILITIES", d.Fixed)
+	return tab.Flush()
+}
+
+func (d *Diff) writeTextDeltas(writer io.Writer, title string, deltas []Delta) {
+	if len(deltas) == 0 {
+		return
+	}
+	fmt.Fprintf(writer, "%s\tOLD\tNEW\tDELTA\n", title)
+	for _, delta := range deltas {
+		fmt.Fprintf(writer, "%s\t%v\t%v\t%+d\n", delta.Name, delta.Old, delta.New, delta.Delta)
+	}
+	fmt.Fprintln(writer)
+}
+
+func (d *Diff) writeTextVulnerabilities(writer io.Writer, title string, vulns []horusec.Vulnerability) {
+	if len(vulns) == 0 {
+		return
+	}
+	fmt.Fprintln(writer, title)
+	for index := range vulns {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", vulns[index].Severity, d.getLocation(&vulns[index]), vulns[index].VulnHash)
+	}
+	fmt.Fprintln(writer)
+}
+
+func (d *Diff) writeMarkdown(writer io.Writer) error {
+	builder := &strings.Builder{}
+	builder.WriteString("# Horusec report diff\n\n")
+	builder.WriteString(fmt.Sprintf("Security posture: **%s**\n\n", d.Posture))
+	builder.WriteString(fmt.Sprintf("Total of vulnerabilities: **%v** -> **%v**\n\n", d.TotalOld, d.TotalNew))
+	builder.WriteString(fmt.Sprintf("| New | Fixed | Persistent |\n|---|---|---|\n| %v | %v | %v |\n\n",
+		len(d.New), len(d.Fixed), len(d.Persistent)))
+	d.writeMarkdownDeltas(builder, "Severity", d.SeverityDeltas)
+	d.writeMarkdownDeltas(builder, "Tool", d.ToolDeltas)
+	d.writeMarkdownVulnerabilities(builder, "New vulnerabilities", d.New)
+	d.writeMarkdownVulnerabilities(builder, "Fixed vulnerabilities", d.Fixed)
+	_, err := io.WriteString(writer, builder.String())
+	return err
+}
+
+func (d *Diff) writeMarkdownDeltas(builder *strings.Builder, title string, deltas []Delta) {
+	if len(deltas) == 0 {
+		return
+	}
+	builder.WriteString(fmt.Sprintf("## %s\n\n| %s | Old | New | Delta |\n|---|---|---|---|\n", title, title))
+	for _, delta := range deltas {
+		builder.WriteString(fmt.Sprintf("| %s | %v | %v | %+d |\n", delta.Name, delta.Old, delta.New, delta.Delta))
+	}
+	builder.WriteString("\n")
+}
+
+func (d *Diff) writeMarkdownVulnerabilities(builder *strings.Builder, title string, vulns []horusec.Vulnerability) {
+	if len(vulns) == 0 {
+		return
+	}
+	builder.WriteString(fmt.Sprintf("## %s\n\n| Severity | Tool | File | Details | Hash |\n|---|---|---|---|---|\n",
+		title))
+	for index := range vulns {
+		builder.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s |\n", vulns[index].Severity,
+			vulns[index].SecurityTool, d.getLocation(&vulns[index]),
+			d.escapeMarkdown(vulns[index].Details), vulns[index].VulnHash))
+	}
+	builder.WriteString("\n")
+}
+
+func (d *Diff) getLocation(vuln *horusec.Vulnerability) string {
+	if vuln.Line == "" {
+		return vuln.File
+	}
+	return fmt.Sprintf("%s:%s", vuln.File, vuln.Line)
+}
+
+// escapeMarkdown keeps the details in one cell of the table
+func (d *Diff) escapeMarkdown(value string) string {
+	return strings.NewReplacer("|", `\|`, "\r", " ", "\n", " ").Replace(value)
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reportdiff
+
+import (
+	"sort"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+)
+
+const (
+	PostureImproved  = "improved"
+	PostureWorsened  = "worsened"
+	PostureUnchanged = "unchanged"
+)
+
+// Diff compares the open vulnerabilities of two analyses by hash, the vulnerabilities of type false positive,
+// risk accepted and corrected are not compared
+type Diff struct {
+	Posture        string                  `json:"posture"`
+	TotalOld       int                     `json:"totalOld"`
+	TotalNew       int                     `json:"totalNew"`
+	New            []horusec.Vulnerability `json:"new"`
+	Fixed          []horusec.Vulnerability `json:"fixed"`
+	Persistent     []horusec.Vulnerability `json:"persistent"`
+	SeverityDeltas []Delta                 `json:"severityDeltas"`
+	ToolDeltas     []Delta                 `json:"toolDeltas"`
+}
+
+// Delta is the change of the total of vulnerabilities of a severity or of a tool
+type Delta struct {
+	Name  string `json:"name"`
+	Old   int    `json:"old"`
+	New   int    `json:"new"`
+	Delta int    `json:"delta"`
+}
+
+func NewDiff(oldAnalysis, newAnalysis *horusec.Analysis) *Diff {
+	oldVulns, newVulns := getOpenVulnerabilities(oldAnalysis), getOpenVulnerabilities(newAnalysis)
+	diff := &Diff{
+		TotalOld:   len(oldVulns),
+		TotalNew:   len(newVulns),
+		New:        []horusec.Vulnerability{},
+		Fixed:      []horusec.Vulnerability{},
+		Persistent: []horusec.Vulnerability{},
+	}
+	oldHashes, newHashes := getHashes(oldVulns), getHashes(newVulns)
+	for index := range newVulns {
+		if oldHashes[newVulns[index].VulnHash] {
+			diff.Persistent = append(diff.Persistent, newVulns[index])
+		} else {
+			diff.New = append(diff.New, newVulns[index])
+		}
+	}
+	for index := range oldVulns {
+		if !newHashes[oldVulns[index].VulnHash] {
+			diff.Fixed = append(diff.Fixed, oldVulns[index])
+		}
+	}
+	diff.SeverityDeltas = newDeltas(oldVulns, newVulns, func(vuln *horusec.Vulnerability) string {
+		return vuln.Severity.ToString()
+	})
+	diff.ToolDeltas = newDeltas(oldVulns, newVulns, func(vuln *horusec.Vulnerability) string {
+		return vuln.SecurityTool.ToString()
+	})
+	diff.Posture = diff.getPosture()
+	return diff
+}
+
+func (d *Diff) getPosture() string {
+	switch {
+	case d.TotalNew < d.TotalOld:
+		return PostureImproved
+	case d.TotalNew > d.TotalOld:
+		return PostureWorsened
+	default:
+		return PostureUnchanged
+	}
+}
+
+func getOpenVulnerabilities(analysis *horusec.Analysis) (vulns []horusec.Vulnerability) {
+	for index := range analysis.AnalysisVulnerabilities {
+		vuln := analysis.AnalysisVulnerabilities[index].Vulnerability
+		if vuln.Type == enumHorusec.Vulnerability || vuln.Type == "" {
+			vulns = append(vulns, vuln)
+		}
+	}
+	return vulns
+}
+
+func getHashes(vulns []horusec.Vulnerability) map[string]bool {
+	hashes := map[string]bool{}
+	for index := range vulns {
+		hashes[vulns[index].VulnHash] = true
+	}
+	return hashes
+}
+
+func newDeltas(oldVulns, newVulns []horusec.Vulnerability, getName func(*horusec.Vulnerability) string) []Delta {
+	totals := map[string]*Delta{}
+	for index := range oldVulns {
+		getDelta(totals, getName(&oldVulns[index])).Old++
+	}
+	for index := range newVulns {
+		getDelta(totals, getName(&newVulns[index])).New++
+	}
+	deltas := make([]Delta, 0, len(totals))
+	for _, delta := range totals {
+		delta.Delta = delta.New - delta.Old
+		deltas = append(deltas, *delta)
+	}
+	sort.Slice(deltas, func(i, j int) bool {
+		return deltas[i].Name < deltas[j].Name
+	})
+	return deltas
+}
+
+func getDelta(totals map[string]*Delta, name string) *Delta {
+	if _, ok := totals[name]; !ok {
+		totals[name] = &Delta{Name: name}
+	}
+	return totals[name]
+}
@@ -0,0 +1,111 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reportdiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysisToTest(vulns ...horusec.Vulnerability) *horusec.Analysis {
+	analysis := &horusec.Analysis{}
+	for index := range vulns {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+			horusec.AnalysisVulnerabilities{Vulnerability: vulns[index]})
+	}
+	return analysis
+}
+
+func newDiffToTest() *Diff {
+	oldAnalysis := newAnalysisToTest(
+		horusec.Vulnerability{VulnHash: "fixed", Severity: severity.High, SecurityTool: tools.GoSec,
+			Type: enumHorusec.Vulnerability, File: "main.go", Line: "10"},
+		horusec.Vulnerability{VulnHash: "persistent", Severity: severity.Low, SecurityTool: tools.GoSec,
+			Type: enumHorusec.Vulnerability},
+		horusec.Vulnerability{VulnHash: "accepted", Severity: severity.High, SecurityTool: tools.GoSec,
+			Type: enumHorusec.RiskAccepted},
+	)
+	newAnalysis := newAnalysisToTest(
+		horusec.Vulnerability{VulnHash: "persistent", Severity: severity.Low, SecurityTool: tools.GoSec,
+			Type: enumHorusec.Vulnerability},
+		horusec.Vulnerability{VulnHash: "new", Severity: severity.Medium, SecurityTool: tools.GitLeaks,
+			Type: enumHorusec.Vulnerability, Details: "details | with pipe"},
+		horusec.Vulnerability{VulnHash: "new-2", Severity: severity.Low, SecurityTool: tools.GitLeaks,
+			Type: enumHorusec.Vulnerability},
+	)
+	return NewDiff(oldAnalysis, newAnalysis)
+}
+
+func TestNewDiff(t *testing.T) {
+	t.Run("Should return the new, fixed and persistent vulnerabilities", func(t *testing.T) {
+		diff := newDiffToTest()
+
+		assert.Equal(t, PostureWorsened, diff.Posture)
+		assert.Equal(t, 2, diff.TotalOld)
+		assert.Equal(t, 3, diff.TotalNew)
+		assert.Len(t, diff.New, 2)
+		assert.Equal(t, "fixed", diff.Fixed[0].VulnHash)
+		assert.Equal(t, "persistent", diff.Persistent[0].VulnHash)
+		assert.Equal(t, []Delta{
+			{Name: severity.High.ToString(), Old: 1, New: 0, Delta: -1},
+			{Name: severity.Low.ToString(), Old: 1, New: 2, Delta: 1},
+			{Name: severity.Medium.ToString(), Old: 0, New: 1, Delta: 1},
+		}, diff.SeverityDeltas)
+		assert.Equal(t, []Delta{
+			{Name: tools.GitLeaks.ToString(), Old: 0, New: 2, Delta: 2},
+			{Name: tools.GoSec.ToString(), Old: 2, New: 1, Delta: -1},
+		}, diff.ToolDeltas)
+	})
+
+	t.Run("Should return improved when vulnerabilities were fixed", func(t *testing.T) {
+		diff := NewDiff(newAnalysisToTest(horusec.Vulnerability{VulnHash: "fixed"}), &horusec.Analysis{})
+
+		assert.Equal(t, PostureImproved, diff.Posture)
+		assert.Len(t, diff.Fixed, 1)
+		assert.Empty(t, diff.New)
+	})
+}
+
+func TestWrite(t *testing.T) {
+	t.Run("Should write the diff in text", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		assert.NoError(t, newDiffToTest().Write(output, Text))
+		assert.Contains(t, output.String(), "Security posture: worsened")
+		assert.Contains(t, output.String(), "main.go:10")
+	})
+
+	t.Run("Should write the diff in json", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		assert.NoError(t, newDiffToTest().Write(output, JSON))
+		diff := &Diff{}
+		assert.NoError(t, json.Unmarshal(output.Bytes(), diff))
+		assert.Len(t, diff.New, 2)
+	})
+
+	t.Run("Should write the diff in markdown", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		assert.NoError(t, newDiffToTest().Write(output, Markdown))
+		assert.Contains(t, output.String(), "# Horusec report diff")
+		assert.Contains(t, output.String(), "| Severity | Old | New | Delta |")
+		assert.Contains(t, output.String(), `details \| with pipe`)
+	})
+}
@@ -0,0 +1,148 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	netHTTP "net/http"
+	"net/url"
+	"strings"
+
+	httpUtil "github.com/ZupIT/horusec/development-kit/pkg/utils/http"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/scheduler"
+	"github.com/go-chi/chi"
+	"github.com/go-chi/chi/middleware"
+)
+
+const AuthorizationHeader = "X-Horusec-Authorization"
+
+var (
+	errProjectNotFound = errors.New("{HORUSEC_CLI} project not found")
+	errUnauthorized    = errors.New("{HORUSEC_CLI} the token of the header X-Horusec-Authorization is not valid")
+)
+
+// Handler serves the projects scheduled and the history of their reports
+type Handler struct {
+	scheduler *scheduler.Scheduler
+	history   *scheduler.History
+	token     string
+}
+
+// ProjectResponse is the project scheduled without its args and the credentials of its repository url
+type ProjectResponse struct {
+	Name          string `json:"name"`
+	Path          string `json:"path"`
+	RepositoryURL string `json:"repositoryURL"`
+	Branch        string `json:"branch"`
+	Cron          string `json:"cron"`
+}
+
+func NewRouter(schedulerService *scheduler.Scheduler, history *scheduler.History, token string) *chi.Mux {
+	handler := &Handler{scheduler: schedulerService, history: history, token: token}
+	router := chi.NewRouter()
+	router.Use(middleware.Recoverer)
+	router.Get("/api/health", handler.GetHealth)
+	router.Route("/api/projects", func(router chi.Router) {
+		router.Use(handler.authorize)
+		router.Get("/", handler.GetProjects)
+		router.Get("/{projectName}/reports", handler.GetReports)
+		router.Get("/{projectName}/reports/{reportID}", handler.GetReport)
+	})
+	return router
+}
+
+func (h *Handler) GetHealth(w netHTTP.ResponseWriter, _ *netHTTP.Request) {
+	httpUtil.StatusOK(w, "service is healthy")
+}
+
+// authorize allows only the requests with the token of the server, the reports have the code of the vulnerabilities
+func (h *Handler) authorize(next netHTTP.Handler) netHTTP.Handler {
+	return netHTTP.HandlerFunc(func(w netHTTP.ResponseWriter, r *netHTTP.Request) {
+		token := r.Header.Get(AuthorizationHeader)
+		if h.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) != 1 {
+			httpUtil.StatusUnauthorized(w, errUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *Handler) GetProjects(w netHTTP.ResponseWriter, _ *netHTTP.Request) {
+	projects := []*ProjectResponse{}
+	for _, project := range h.scheduler.GetProjects() {
+		projects = append(projects, &ProjectResponse{
+			Name:          project.Name,
+			Path:          project.Path,
+			RepositoryURL: removeCredentials(project.RepositoryURL),
+			Branch:        project.Branch,
+			Cron:          project.Cron,
+		})
+	}
+	httpUtil.StatusOK(w, projects)
+}
+
+func (h *Handler) GetReports(w netHTTP.ResponseWriter, r *netHTTP.Request) {
+	projectName := chi.URLParam(r, "projectName")
+	if !h.existsProject(projectName) {
+		httpUtil.StatusNotFound(w, errProjectNotFound)
+		return
+	}
+	reports, err := h.history.List(projectName)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+	httpUtil.StatusOK(w, reports)
+}
+
+func (h *Handler) GetReport(w netHTTP.ResponseWriter, r *netHTTP.Request) {
+	projectName := chi.URLParam(r, "projectName")
+	if !h.existsProject(projectName) {
+		httpUtil.StatusNotFound(w, errProjectNotFound)
+		return
+	}
+	content, err := h.history.Get(projectName, chi.URLParam(r, "reportID"))
+	switch {
+	case errors.Is(err, scheduler.ErrReportNotFound) || errors.Is(err, scheduler.ErrInvalidName):
+		httpUtil.StatusNotFound(w, scheduler.ErrReportNotFound)
+	case err != nil:
+		httpUtil.StatusInternalServerError(w, err)
+	default:
+		httpUtil.StatusOK(w, json.RawMessage(content))
+	}
+}
+
+func (h *Handler) existsProject(projectName string) bool {
+	for _, project := range h.scheduler.GetProjects() {
+		if project.Name == projectName {
+			return true
+		}
+	}
+	return false
+}
+
+// removeCredentials removes the user, the password and the query of the repository url, where the tokens are informed.
+// The urls like git@github.com:ZupIT/horusec.git keep only the host and the path
+func removeCredentials(repositoryURL string) string {
+	parsedURL, err := url.Parse(repositoryURL)
+	if err != nil || parsedURL.Scheme == "" {
+		return repositoryURL[strings.LastIndex(repositoryURL, "@")+1:]
+	}
+	parsedURL.User = nil
+	parsedURL.RawQuery = ""
+	return parsedURL.String()
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package toolsbom
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	JSON      = "json"
+	CycloneDX = "cyclonedx"
+)
+
+const (
+	cycloneDXSpecVersion = "1.4"
+	defaultRegistry      = "docker.io/"
+)
+
+func OutputFormats() []string {
+	return []string{JSON, CycloneDX}
+}
+
+// Write writes the bom in the output format, json is used when the format is unknown
+func (b *BOM) Write(writer io.Writer, outputFormat string) error {
+	if outputFormat == CycloneDX {
+		return writeJSON(writer, b.toCycloneDX())
+	}
+	return writeJSON(writer, b)
+}
+
+func writeJSON(writer io.Writer, value interface{}) error {
+	content, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(append(content, '\n'))
+	return err
+}
+
+// toCycloneDX returns each image as a container with the analyzers installed in it, yarn audit and npm audit run
+// in the same image
+func (b *BOM) toCycloneDX() *cycloneDXBOM {
+	bom := &cycloneDXBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  cycloneDXSpecVersion,
+		SerialNumber: "urn:uuid:" + uuid.New().String(),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			Tools:     []cycloneDXTool{{Vendor: "ZupIT", Name: "horusec", Version: b.HorusecVersion}},
+		},
+		Components: []*cycloneDXComponent{},
+	}
+	containers := map[string]*cycloneDXComponent{}
+	for index := range b.Tools {
+		tool := &b.Tools[index]
+		container, ok := containers[tool.Image]
+		if !ok {
+			container = newCycloneDXContainer(tool)
+			containers[tool.Image] = container
+			bom.Components = append(bom.Components, container)
+		}
+		container.Components = append(container.Components, newCycloneDXAnalyzer(tool))
+	}
+	return bom
+}
+
+func newCycloneDXContainer(tool *Tool) *cycloneDXComponent {
+	name, tag := splitImagePath(tool.Image)
+	container := &cycloneDXComponent{
+		Type:    "container",
+		BOMRef:  tool.Image,
+		Name:    name,
+		Version: tag,
+		Purl:    getImagePurl(name, tag),
+	}
+	if digest := strings.SplitN(tool.Digest, ":", 2); len(digest) == 2 && digest[0] == "sha256" {
+		container.Hashes = []cycloneDXHash{{Alg: "SHA-256", Content: digest[1]}}
+	}
+	return container
+}
+
+func newCycloneDXAnalyzer(tool *Tool) *cycloneDXComponent {
+	license := cycloneDXLicense{ID: tool.License}
+	if strings.Contains(tool.License, " ") {
+		license = cycloneDXLicense{Name: tool.License}
+	}
+	return &cycloneDXComponent{
+		Type:               "application",
+		BOMRef:             tool.Image + "#" + tool.Tool,
+		Name:               tool.Analyzer,
+		Version:            tool.AnalyzerVersion,
+		Licenses:           []cycloneDXLicenseChoice{{License: license}},
+		ExternalReferences: []cycloneDXExternalReference{{Type: "vcs", URL: tool.URL}},
+		Properties:         []cycloneDXProperty{{Name: "horusec:tool", Value: tool.Tool}},
+	}
+}
+
+// splitImagePath returns the name with the registry and the tag of the image path, the tag is latest when the path
+// has no tag like in docker
+func splitImagePath(imagePath string) (name, tag string) {
+	name, tag = imagePath, "latest"
+	if index := strings.LastIndex(imagePath, ":"); index > strings.LastIndex(imagePath, "/") {
+		name, tag = imagePath[:index], imagePath[index+1:]
+	}
+	return name, tag
+}
+
+// getImagePurl returns the package url of docker, with the registry in the repository_url when it is not docker hub
+func getImagePurl(name, tag string) string {
+	if strings.HasPrefix(name, defaultRegistry) {
+		return "pkg:docker/" + strings.TrimPrefix(name, defaultRegistry) + "@" + tag
+	}
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 2 && strings.ContainsAny(parts[0], ".:") {
+		return "pkg:docker/" + parts[1] + "@" + tag + "?repository_url=" + parts[0]
+	}
+	return "pkg:docker/" + name + "@" + tag
+}
@@ -0,0 +1,79 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package toolsbom
+
+import (
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/version"
+	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/images"
+)
+
+// versionLabel is the label of the images with the version of the analyzer installed, when the image has it
+const versionLabel = "org.opencontainers.image.version"
+
+// Tool is a scanner run by horusec, with the image where it runs and the third-party analyzer installed in it
+type Tool struct {
+	Tool            string `json:"tool"`
+	Image           string `json:"image"`
+	Digest          string `json:"digest,omitempty"`
+	Analyzer        string `json:"analyzer"`
+	AnalyzerVersion string `json:"analyzerVersion"`
+	License         string `json:"license"`
+	URL             string `json:"url"`
+}
+
+// BOM is the bill of the tools run by horusec, the tools ignored in the tools config are not run
+type BOM struct {
+	HorusecVersion string `json:"horusecVersion"`
+	Tools          []Tool `json:"tools"`
+}
+
+// NewBOM lists the images of the tools config, the digest and the version label are read from the local docker
+// when the image is present
+func NewBOM(config cliConfig.IConfig, client dockerClient.Interface) *BOM {
+	bom := &BOM{HorusecVersion: version.Version, Tools: []Tool{}}
+	toolsConfig := config.GetToolsConfig()
+	values := images.Values()
+	for index := range values {
+		image := &values[index]
+		if toolsConfig[image.Tool].IsToIgnore {
+			continue
+		}
+		bom.Tools = append(bom.Tools, newTool(client, image, image.GetImagePath(toolsConfig)))
+	}
+	return bom
+}
+
+func newTool(client dockerClient.Interface, image *images.Image, imagePath string) Tool {
+	upstream := image.GetUpstream()
+	tool := Tool{
+		Tool:            image.Tool.ToString(),
+		Image:           imagePath,
+		Analyzer:        upstream.Name,
+		AnalyzerVersion: upstream.Version,
+		License:         upstream.License,
+		URL:             upstream.URL,
+	}
+	localImage, err := dockerClient.GetLocalImage(client, imagePath)
+	if err != nil || localImage == nil {
+		return tool
+	}
+	tool.Digest = dockerClient.GetDigest(localImage)
+	if labelVersion := localImage.Labels[versionLabel]; labelVersion != "" {
+		tool.AnalyzerVersion = labelVersion
+	}
+	return tool
+}
@@ -0,0 +1,121 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package toolsbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
+	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/images"
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTool(bom *BOM, tool tools.Tool) *Tool {
+	for index := range bom.Tools {
+		if bom.Tools[index].Tool == tool.ToString() {
+			return &bom.Tools[index]
+		}
+	}
+	return nil
+}
+
+func TestNewBOM(t *testing.T) {
+	t.Run("Should list the tools not ignored with the digest and the version of the images", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{
+			GoSec:  toolsconfig.ToolConfig{IsToIgnore: true},
+			Bandit: toolsconfig.ToolConfig{ImagePath: "registry.example.com/bandit:v2"},
+		})
+		dockerMock := &dockerClient.Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{{
+			ID:          "sha256:123",
+			RepoDigests: []string{"horuszup/image@sha256:456"},
+			Labels:      map[string]string{versionLabel: "1.7.0"},
+		}}, nil)
+
+		bom := NewBOM(config, dockerMock)
+
+		assert.Len(t, bom.Tools, len(images.Values())-1)
+		assert.Nil(t, getTool(bom, tools.GoSec))
+		bandit := getTool(bom, tools.Bandit)
+		assert.Equal(t, "registry.example.com/bandit:v2", bandit.Image)
+		assert.Equal(t, "sha256:456", bandit.Digest)
+		assert.Equal(t, "bandit", bandit.Analyzer)
+		assert.Equal(t, "1.7.0", bandit.AnalyzerVersion)
+		assert.Equal(t, "Apache-2.0", bandit.License)
+	})
+
+	t.Run("Should list the tools without digest when docker is not available", func(t *testing.T) {
+		dockerMock := &dockerClient.Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{}, errors.New("test"))
+
+		bom := NewBOM(cliConfig.NewConfig(), dockerMock)
+
+		assert.Len(t, bom.Tools, len(images.Values()))
+		spotBugs := getTool(bom, tools.SpotBugs)
+		assert.Empty(t, spotBugs.Digest)
+		assert.Equal(t, "4.1.2", spotBugs.AnalyzerVersion)
+	})
+}
+
+func TestBOM_Write(t *testing.T) {
+	bom := &BOM{HorusecVersion: "v1.0.0", Tools: []Tool{
+		{Tool: "NpmAudit", Image: "docker.io/horuszup/npmaudit:v1.0.0", Digest: "sha256:456", Analyzer: "npm",
+			AnalyzerVersion: "latest", License: "Artistic-2.0", URL: "https://github.com/npm/cli"},
+		{Tool: "YarnAudit", Image: "docker.io/horuszup/npmaudit:v1.0.0", Digest: "sha256:456", Analyzer: "yarn",
+			AnalyzerVersion: "latest", License: "BSD-2-Clause", URL: "https://github.com/yarnpkg/yarn"},
+		{Tool: "Brakeman", Image: "registry.example.com/brakeman", Analyzer: "brakeman",
+			AnalyzerVersion: "latest", License: "Brakeman Public Use License", URL: "https://brakemanscanner.org"},
+	}}
+
+	t.Run("Should write the tools in json", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		assert.NoError(t, bom.Write(output, JSON))
+
+		written := &BOM{}
+		assert.NoError(t, json.Unmarshal(output.Bytes(), written))
+		assert.Equal(t, bom, written)
+	})
+
+	t.Run("Should write each image as a container with the analyzers in cyclonedx", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		assert.NoError(t, bom.Write(output, CycloneDX))
+
+		written := &cycloneDXBOM{}
+		assert.NoError(t, json.Unmarshal(output.Bytes(), written))
+		assert.Equal(t, "CycloneDX", written.BOMFormat)
+		assert.Equal(t, "v1.0.0", written.Metadata.Tools[0].Version)
+		assert.Len(t, written.Components, 2)
+
+		npm := written.Components[0]
+		assert.Equal(t, "container", npm.Type)
+		assert.Equal(t, "pkg:docker/horuszup/npmaudit@v1.0.0", npm.Purl)
+		assert.Equal(t, []cycloneDXHash{{Alg: "SHA-256", Content: "456"}}, npm.Hashes)
+		assert.Len(t, npm.Components, 2)
+		assert.Equal(t, "Artistic-2.0", npm.Components[0].Licenses[0].License.ID)
+
+		brakeman := written.Components[1]
+		assert.Equal(t, "pkg:docker/brakeman@latest?repository_url=registry.example.com", brakeman.Purl)
+		assert.Empty(t, brakeman.Hashes)
+		assert.Equal(t, "Brakeman Public Use License", brakeman.Components[0].Licenses[0].License.Name)
+	})
+}
@@ -0,0 +1,293 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triage
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/utils/prompt"
+)
+
+const (
+	optionBySeverity    = "Browse by severity"
+	optionByFile        = "Browse by file"
+	optionByTool        = "Browse by tool"
+	optionSave          = "Save and exit"
+	optionExit          = "Exit without saving"
+	optionBack          = "Back"
+	optionFalsePositive = "Mark as false positive"
+	optionRiskAccepted  = "Mark as risk accepted"
+	optionVulnerability = "Mark as vulnerability"
+	// lines shown before and after the line of the vulnerability
+	codeContextLines = 3
+)
+
+// severitiesOrder shows the most critical severities first
+var severitiesOrder = []severity.Severity{severity.Critical, severity.High, severity.Medium, severity.Low,
+	severity.Audit, severity.Info, severity.NoSec}
+
+type Interface interface {
+	StartTriage(analysis *horusec.Analysis) error
+}
+
+type Triage struct {
+	config   cliConfig.IConfig
+	prompt   prompt.Interface
+	analysis *horusec.Analysis
+	// changes keeps the type chosen for each hash, applied in the config only when saved
+	changes map[string]enumHorusec.VulnerabilityType
+}
+
+func NewTriage(config cliConfig.IConfig) Interface {
+	return &Triage{
+		config: config,
+		prompt: prompt.NewPrompt(),
+	}
+}
+
+// StartTriage shows the vulnerabilities of the analysis until the user saves or exits. The vulnerabilities
+// marked are written in the config file as false positive and risk accept hashes
+func (t *Triage) StartTriage(analysis *horusec.Analysis) error {
+	t.analysis = analysis
+	t.changes = map[string]enumHorusec.VulnerabilityType{}
+	for {
+		option, err := t.prompt.Select(t.getSummary(), []string{optionBySeverity, optionByFile, optionByTool,
+			optionSave, optionExit})
+		if err != nil {
+			return err
+		}
+		switch option {
+		case optionBySeverity:
+			err = t.browse(t.getSeverityGroups())
+		case optionByFile:
+			err = t.browse(t.getGroups(func(v *horusec.Vulnerability) string { return v.File }))
+		case optionByTool:
+			err = t.browse(t.getGroups(func(v *horusec.Vulnerability) string { return v.SecurityTool.ToString() }))
+		case optionSave:
+			return t.saveChanges()
+		default:
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (t *Triage) getSummary() string {
+	return fmt.Sprintf("%d vulnerabilities found, %d changed. What do you want to do?",
+		len(t.getVulnerabilities()), len(t.changes))
+}
+
+func (t *Triage) getVulnerabilities() (vulnerabilities []*horusec.Vulnerability) {
+	for index := range t.analysis.AnalysisVulnerabilities {
+		vulnerabilities = append(vulnerabilities, &t.analysis.AnalysisVulnerabilities[index].Vulnerability)
+	}
+	return vulnerabilities
+}
+
+type group struct {
+	name            string
+	vulnerabilities []*horusec.Vulnerability
+}
+
+func (t *Triage) getGroups(getName func(v *horusec.Vulnerability) string) (groups []*group) {
+	byName := map[string]*group{}
+	for _, vulnerability := range t.getVulnerabilities() {
+		name := getName(vulnerability)
+		if byName[name] == nil {
+			byName[name] = &group{name: name}
+			groups = append(groups, byName[name])
+		}
+		byName[name].vulnerabilities = append(byName[name].vulnerabilities, vulnerability)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].name < groups[j].name
+	})
+	return groups
+}
+
+func (t *Triage) getSeverityGroups() (groups []*group) {
+	groupsByName := map[string]*group{}
+	for _, current := range t.getGroups(func(v *horusec.Vulnerability) string { return v.Severity.ToString() }) {
+		groupsByName[current.name] = current
+	}
+	for _, currentSeverity := range severitiesOrder {
+		if current, ok := groupsByName[currentSeverity.ToString()]; ok {
+			groups = append(groups, current)
+		}
+	}
+	return groups
+}
+
+func (t *Triage) browse(groups []*group) error {
+	for {
+		items := []string{optionBack}
+		byItem := map[string]*group{}
+		for _, current := range groups {
+			item := fmt.Sprintf("%s (%d)", current.name, len(current.vulnerabilities))
+			items = append(items, item)
+			byItem[item] = current
+		}
+		option, err := t.prompt.Select("Select a group of vulnerabilities", items)
+		if err != nil || option == optionBack {
+			return err
+		}
+		if err := t.browseVulnerabilities(byItem[option].vulnerabilities); err != nil {
+			return err
+		}
+	}
+}
+
+func (t *Triage) browseVulnerabilities(vulnerabilities []*horusec.Vulnerability) error {
+	for {
+		items := []string{optionBack}
+		byItem := map[string]*horusec.Vulnerability{}
+		for index, vulnerability := range vulnerabilities {
+			item := fmt.Sprintf("%d. [%s] [%s] %s:%s %s", index+1, vulnerability.Severity, vulnerability.Type,
+				vulnerability.File, vulnerability.Line, vulnerability.SecurityTool)
+			items = append(items, item)
+			byItem[item] = vulnerability
+		}
+		option, err := t.prompt.Select("Select a vulnerability", items)
+		if err != nil || option == optionBack {
+			return err
+		}
+		if err := t.reviewVulnerability(byItem[option]); err != nil {
+			return err
+		}
+	}
+}
+
+func (t *Triage) reviewVulnerability(vulnerability *horusec.Vulnerability) error {
+	t.printVulnerability(vulnerability)
+	option, err := t.prompt.Select("What do you want to do?", []string{optionBack, optionFalsePositive,
+		optionRiskAccepted, optionVulnerability})
+	if err != nil {
+		return err
+	}
+	switch option {
+	case optionFalsePositive:
+		t.setType(vulnerability.VulnHash, enumHorusec.FalsePositive)
+	case optionRiskAccepted:
+		t.setType(vulnerability.VulnHash, enumHorusec.RiskAccepted)
+	case optionVulnerability:
+		t.setType(vulnerability.VulnHash, enumHorusec.Vulnerability)
+	}
+	return nil
+}
+
+// setType changes all vulnerabilities with the same hash, like the hashes in the config file do
+func (t *Triage) setType(hash string, vulnerabilityType enumHorusec.VulnerabilityType) {
+	for _, vulnerability := range t.getVulnerabilities() {
+		if vulnerability.VulnHash == hash {
+			vulnerability.Type = vulnerabilityType
+		}
+	}
+	t.changes[hash] = vulnerabilityType
+}
+
+// nolint
+func (t *Triage) printVulnerability(vulnerability *horusec.Vulnerability) {
+	fmt.Println()
+	fmt.Println(fmt.Sprintf("Severity: %s", vulnerability.Severity))
+	fmt.Println(fmt.Sprintf("SecurityTool: %s", vulnerability.SecurityTool))
+	fmt.Println(fmt.Sprintf("File: %s", vulnerability.File))
+	fmt.Println(fmt.Sprintf("Line: %s", vulnerability.Line))
+	fmt.Println(fmt.Sprintf("Details: %s", vulnerability.Details))
+	fmt.Println(fmt.Sprintf("Type: %s", vulnerability.Type))
+	fmt.Println(fmt.Sprintf("ReferenceHash: %s", vulnerability.VulnHash))
+	fmt.Println()
+	fmt.Println(t.getCodeContext(vulnerability))
+	fmt.Println()
+}
+
+// getCodeContext reads the lines around the vulnerability from the project, using the code found by the tool
+// when the file is not available anymore
+func (t *Triage) getCodeContext(vulnerability *horusec.Vulnerability) string {
+	line, err := strconv.Atoi(vulnerability.Line)
+	file, errOpen := os.Open(filepath.Join(t.config.GetProjectPath(), vulnerability.File))
+	if err != nil || errOpen != nil || line <= 0 {
+		return vulnerability.Code
+	}
+	defer file.Close()
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for current := 1; scanner.Scan() && current <= line+codeContextLines; current++ {
+		if current < line-codeContextLines {
+			continue
+		}
+		marker := " "
+		if current == line {
+			marker = ">"
+		}
+		lines = append(lines, fmt.Sprintf("%s %5d | %s", marker, current, scanner.Text()))
+	}
+	if len(lines) == 0 {
+		return vulnerability.Code
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (t *Triage) saveChanges() error {
+	falsePositiveHashes := t.removeChangedHashes(t.config.GetFalsePositiveHashes())
+	riskAcceptHashes := t.removeChangedHashes(t.config.GetRiskAcceptHashes())
+	for _, hash := range t.getSortedChangedHashes() {
+		switch t.changes[hash] {
+		case enumHorusec.FalsePositive:
+			falsePositiveHashes = append(falsePositiveHashes, hash)
+		case enumHorusec.RiskAccepted:
+			riskAcceptHashes = append(riskAcceptHashes, hash)
+		}
+	}
+	t.config.SetFalsePositiveHashes(falsePositiveHashes)
+	t.config.SetRiskAcceptHashes(riskAcceptHashes)
+	if err := t.config.SaveHashesInConfigFile(); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorSaveTriageInConfigFile, err, logger.ErrorLevel)
+		return err
+	}
+	logger.LogInfoWithLevel(messages.MsgInfoTriageSavedInConfigFile+t.config.GetConfigFilePath(), logger.InfoLevel)
+	return nil
+}
+
+func (t *Triage) removeChangedHashes(hashes []string) (result []string) {
+	result = []string{}
+	for _, hash := range hashes {
+		if _, ok := t.changes[strings.TrimSpace(hash)]; !ok {
+			result = append(result, hash)
+		}
+	}
+	return result
+}
+
+func (t *Triage) getSortedChangedHashes() (hashes []string) {
+	for hash := range t.changes {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+	return hashes
+}
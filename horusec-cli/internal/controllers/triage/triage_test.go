@@ -0,0 +1,143 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package triage
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/utils/prompt"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysis() *horusec.Analysis {
+	return &horusec.Analysis{
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{VulnHash: "hash1", Severity: severity.High, File: "main.go",
+				Line: "2", SecurityTool: tools.GoSec, Type: enumHorusec.Vulnerability}},
+			{Vulnerability: horusec.Vulnerability{VulnHash: "hash2", Severity: severity.Low, File: "main.go",
+				Line: "3", SecurityTool: tools.GoSec, Type: enumHorusec.RiskAccepted}},
+		},
+	}
+}
+
+func newConfig(t *testing.T) (config *cliConfig.Config, dir string) {
+	dir, err := ioutil.TempDir("", "triage")
+	assert.NoError(t, err)
+	config = &cliConfig.Config{}
+	config.SetProjectPath(dir)
+	config.SetConfigFilePath(filepath.Join(dir, "horusec-config.json"))
+	config.SetRiskAcceptHashes([]string{"hash2", "hash3"})
+	return config, dir
+}
+
+func TestNewTriage(t *testing.T) {
+	t.Run("Should return a new triage", func(t *testing.T) {
+		assert.IsType(t, &Triage{}, NewTriage(&cliConfig.Config{}))
+	})
+}
+
+func TestTriage_StartTriage(t *testing.T) {
+	t.Run("Should mark vulnerabilities and save the hashes in the config file", func(t *testing.T) {
+		config, dir := newConfig(t)
+		defer os.RemoveAll(dir)
+		promptMock := &prompt.Mock{}
+		promptMock.On("Select").Return(optionBySeverity, nil).Once()
+		promptMock.On("Select").Return("HIGH (1)", nil).Once()
+		promptMock.On("Select").Return("1. [HIGH] [Vulnerability] main.go:2 GoSec", nil).Once()
+		promptMock.On("Select").Return(optionFalsePositive, nil).Once()
+		promptMock.On("Select").Return(optionBack, nil).Once()
+		promptMock.On("Select").Return(optionBack, nil).Once()
+		promptMock.On("Select").Return(optionByTool, nil).Once()
+		promptMock.On("Select").Return("GoSec (2)", nil).Once()
+		promptMock.On("Select").Return("2. [LOW] [Risk Accepted] main.go:3 GoSec", nil).Once()
+		promptMock.On("Select").Return(optionVulnerability, nil).Once()
+		promptMock.On("Select").Return(optionBack, nil).Once()
+		promptMock.On("Select").Return(optionBack, nil).Once()
+		promptMock.On("Select").Return(optionSave, nil).Once()
+		analysis := newAnalysis()
+
+		triage := &Triage{config: config, prompt: promptMock}
+		assert.NoError(t, triage.StartTriage(analysis))
+
+		assert.Equal(t, enumHorusec.FalsePositive, analysis.AnalysisVulnerabilities[0].Vulnerability.Type)
+		assert.Equal(t, enumHorusec.Vulnerability, analysis.AnalysisVulnerabilities[1].Vulnerability.Type)
+		assert.Equal(t, []string{"hash1"}, config.GetFalsePositiveHashes())
+		assert.Equal(t, []string{"hash3"}, config.GetRiskAcceptHashes())
+		content, err := ioutil.ReadFile(config.GetConfigFilePath())
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"horusecCliFalsePositiveHashes": ["hash1"], "horusecCliRiskAcceptHashes": ["hash3"]}`,
+			string(content))
+	})
+
+	t.Run("Should not change the config file when exit without saving", func(t *testing.T) {
+		config, dir := newConfig(t)
+		defer os.RemoveAll(dir)
+		promptMock := &prompt.Mock{}
+		promptMock.On("Select").Return(optionByFile, nil).Once()
+		promptMock.On("Select").Return("main.go (2)", nil).Once()
+		promptMock.On("Select").Return("1. [HIGH] [Vulnerability] main.go:2 GoSec", nil).Once()
+		promptMock.On("Select").Return(optionRiskAccepted, nil).Once()
+		promptMock.On("Select").Return(optionBack, nil).Once()
+		promptMock.On("Select").Return(optionBack, nil).Once()
+		promptMock.On("Select").Return(optionExit, nil).Once()
+
+		triage := &Triage{config: config, prompt: promptMock}
+		assert.NoError(t, triage.StartTriage(newAnalysis()))
+
+		assert.Equal(t, []string{"hash2", "hash3"}, config.GetRiskAcceptHashes())
+		assert.NoFileExists(t, config.GetConfigFilePath())
+	})
+
+	t.Run("Should return error when the prompt is interrupted", func(t *testing.T) {
+		promptMock := &prompt.Mock{}
+		promptMock.On("Select").Return("", errors.New("^C"))
+
+		triage := &Triage{config: &cliConfig.Config{}, prompt: promptMock}
+		assert.Error(t, triage.StartTriage(newAnalysis()))
+	})
+}
+
+func TestTriage_GetCodeContext(t *testing.T) {
+	t.Run("Should return the lines around the vulnerability", func(t *testing.T) {
+		config, dir := newConfig(t)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "main.go"),
+			[]byte("line1\nline2\nline3\nline4\nline5\nline6\nline7\nline8\nline9\n"), 0600))
+
+		triage := &Triage{config: config}
+		code := triage.getCodeContext(&horusec.Vulnerability{File: "main.go", Line: "6"})
+
+		assert.NotContains(t, code, "line2")
+		assert.Contains(t, code, "    3 | line3")
+		assert.Contains(t, code, ">     6 | line6")
+		assert.Contains(t, code, "    9 | line9")
+	})
+
+	t.Run("Should return the code of the vulnerability when the file doesn't exist", func(t *testing.T) {
+		triage := &Triage{config: &cliConfig.Config{}}
+		code := triage.getCodeContext(&horusec.Vulnerability{File: "not-exists.go", Line: "6", Code: "code"})
+
+		assert.Equal(t, "code", code)
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cyclonedx
+
+// VDR is the vulnerability disclosure report of CycloneDX, see https://cyclonedx.org/docs/1.5/json
+type VDR struct {
+	BOMFormat       string          `json:"bomFormat"`
+	SpecVersion     string          `json:"specVersion"`
+	SerialNumber    string          `json:"serialNumber"`
+	Version         int             `json:"version"`
+	Metadata        Metadata        `json:"metadata"`
+	Components      []Component     `json:"components"`
+	Vulnerabilities []Vulnerability `json:"vulnerabilities"`
+}
+
+type Metadata struct {
+	Timestamp string     `json:"timestamp"`
+	Tools     Tools      `json:"tools"`
+	Component *Component `json:"component,omitempty"`
+}
+
+type Tools struct {
+	Components []Component `json:"components"`
+}
+
+// Component is a library found in a lockfile, with the purl when the package type is known, or a source file of
+// the project
+type Component struct {
+	Type       string     `json:"type"`
+	BOMRef     string     `json:"bom-ref,omitempty"`
+	Author     string     `json:"author,omitempty"`
+	Name       string     `json:"name"`
+	Version    string     `json:"version,omitempty"`
+	Purl       string     `json:"purl,omitempty"`
+	Properties []Property `json:"properties,omitempty"`
+}
+
+type Property struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type Vulnerability struct {
+	BOMRef         string     `json:"bom-ref"`
+	ID             string     `json:"id"`
+	Source         *Source    `json:"source,omitempty"`
+	Ratings        []Rating   `json:"ratings"`
+	Cwes           []int      `json:"cwes,omitempty"`
+	Description    string     `json:"description"`
+	Recommendation string     `json:"recommendation,omitempty"`
+	Created        string     `json:"created,omitempty"`
+	Analysis       *Analysis  `json:"analysis,omitempty"`
+	Affects        []Affect   `json:"affects"`
+	Properties     []Property `json:"properties,omitempty"`
+}
+
+type Source struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type Rating struct {
+	Source   *Source `json:"source,omitempty"`
+	Severity string  `json:"severity"`
+	Method   string  `json:"method,omitempty"`
+}
+
+// Analysis is the triage of the vulnerability, with the state and the responses of the CycloneDX impact analysis
+type Analysis struct {
+	State    string   `json:"state"`
+	Response []string `json:"response,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+type Affect struct {
+	Ref string `json:"ref"`
+}
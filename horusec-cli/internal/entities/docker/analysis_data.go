@@ -17,12 +17,25 @@ package docker
 import (
 	"fmt"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 )
 
 type AnalysisData struct {
-	ImagePath string
-	CMD       string
-	Language  languages.Language
+	ImagePath      string
+	CMD            string
+	Language       languages.Language
+	Tool           tools.Tool
+	ProjectSubPath string
+	// Mounts are the paths of the host mounted in the container of the tool besides the project, like its config
+	Mounts []Mount
+}
+
+// Mount is a path of the host, or a docker volume when IsVolume, mounted in the target of the container
+type Mount struct {
+	Source   string
+	Target   string
+	ReadOnly bool
+	IsVolume bool
 }
 
 func (a *AnalysisData) IsInvalid() bool {
@@ -0,0 +1,38 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jenkins
+
+// Report is the native json format of the issues of the Warnings Next Generation plugin
+type Report struct {
+	Issues []Issue `json:"issues"`
+	Size   int     `json:"size"`
+}
+
+type Issue struct {
+	FileName    string `json:"fileName"`
+	LineStart   int    `json:"lineStart,omitempty"`
+	LineEnd     int    `json:"lineEnd,omitempty"`
+	ColumnStart int    `json:"columnStart,omitempty"`
+	ColumnEnd   int    `json:"columnEnd,omitempty"`
+	Category    string `json:"category"`
+	Type        string `json:"type"`
+	Severity    string `json:"severity"`
+	Message     string `json:"message"`
+	Description string `json:"description,omitempty"`
+	ModuleName  string `json:"moduleName,omitempty"`
+	Origin      string `json:"origin"`
+	OriginName  string `json:"originName"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecyclehooks
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+var ErrInvalidHook = errors.New("each hook needs a command or an http or https url")
+
+// Hook runs a command receiving the payload in the stdin or sends the payload to an url. The json names are
+// lower case, because the keys of the config file are read by viper in lower case
+type Hook struct {
+	// Command is the executable and its arguments, run without a shell
+	Command []string `json:"command"`
+	URL     string   `json:"url"`
+	// Method of the request to the url, by default POST
+	Method  string            `json:"method"`
+	Headers map[string]string `json:"headers"`
+	// TimeoutInSeconds is the max time of the command or the request, by default 30
+	TimeoutInSeconds int64 `json:"timeoutinseconds"`
+	// AbortOnFailure stops the analysis when the hook fails, otherwise the failure is only logged
+	AbortOnFailure bool `json:"abortonfailure"`
+}
+
+type LifecycleHooks struct {
+	PreAnalysis  []Hook `json:"preanalysis"`
+	PostTool     []Hook `json:"posttool"`
+	PostAnalysis []Hook `json:"postanalysis"`
+}
+
+func (h *Hook) Validate() error {
+	if (len(h.Command) == 0) == (h.URL == "") {
+		return ErrInvalidHook
+	}
+	if h.URL == "" {
+		return nil
+	}
+	parsed, err := url.Parse(h.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ErrInvalidHook
+	}
+	return nil
+}
+
+func (l *LifecycleHooks) Validate() error {
+	for _, hooks := range [][]Hook{l.PreAnalysis, l.PostTool, l.PostAnalysis} {
+		for index := range hooks {
+			if err := hooks[index].Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (l *LifecycleHooks) IsEmpty() bool {
+	return len(l.PreAnalysis) == 0 && len(l.PostTool) == 0 && len(l.PostAnalysis) == 0
+}
+
+func ParseInterfaceToLifecycleHooks(input interface{}) (output LifecycleHooks) {
+	if input == nil {
+		return output
+	}
+	bytes, err := json.Marshal(input)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorParseLifecycleHooks, err, logger.ErrorLevel)
+		return output
+	}
+	if err := json.Unmarshal(bytes, &output); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorParseLifecycleHooks, err, logger.ErrorLevel)
+		return LifecycleHooks{}
+	}
+	return output
+}
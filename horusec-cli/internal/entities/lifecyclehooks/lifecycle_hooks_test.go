@@ -0,0 +1,53 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecyclehooks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInterfaceToLifecycleHooks(t *testing.T) {
+	t.Run("Should parse the hooks of the config file with the keys in lower case", func(t *testing.T) {
+		hooks := ParseInterfaceToLifecycleHooks(map[string]interface{}{
+			"preanalysis": []interface{}{map[string]interface{}{"command": []interface{}{"echo", "test"}}},
+			"postanalysis": []interface{}{map[string]interface{}{"url": "https://example.com",
+				"abortonfailure": true}},
+		})
+		assert.Equal(t, []string{"echo", "test"}, hooks.PreAnalysis[0].Command)
+		assert.True(t, hooks.PostAnalysis[0].AbortOnFailure)
+		assert.False(t, hooks.IsEmpty())
+	})
+
+	t.Run("Should return empty when the hooks are invalid", func(t *testing.T) {
+		hooks := ParseInterfaceToLifecycleHooks(map[string]interface{}{"preanalysis": "invalid"})
+		assert.True(t, hooks.IsEmpty())
+	})
+}
+
+func TestLifecycleHooks_Validate(t *testing.T) {
+	t.Run("Should accept a command or an http url", func(t *testing.T) {
+		hooks := LifecycleHooks{PreAnalysis: []Hook{{Command: []string{"echo"}}},
+			PostTool: []Hook{{URL: "http://localhost:8000/hook"}}}
+		assert.NoError(t, hooks.Validate())
+	})
+
+	t.Run("Should return error when the hook has a command and an url or an invalid url", func(t *testing.T) {
+		assert.Equal(t, ErrInvalidHook, (&Hook{Command: []string{"echo"}, URL: "http://localhost"}).Validate())
+		assert.Equal(t, ErrInvalidHook, (&Hook{}).Validate())
+		assert.Equal(t, ErrInvalidHook, (&Hook{URL: "ftp://localhost"}).Validate())
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+// Document is the SPDX 3.0 json-ld serialization, with all the elements in the graph, see
+// https://spdx.github.io/spdx-spec/v3.0.1
+type Document struct {
+	Context string    `json:"@context"`
+	Graph   []Element `json:"@graph"`
+}
+
+// Element has the properties used by the elements of the core, software and security profiles written by horusec,
+// each type uses only some of them
+type Element struct {
+	Type               string               `json:"type"`
+	ID                 string               `json:"@id,omitempty"`
+	SpdxID             string               `json:"spdxId,omitempty"`
+	CreationInfo       string               `json:"creationInfo,omitempty"`
+	SpecVersion        string               `json:"specVersion,omitempty"`
+	Created            string               `json:"created,omitempty"`
+	CreatedBy          []string             `json:"createdBy,omitempty"`
+	CreatedUsing       []string             `json:"createdUsing,omitempty"`
+	Name               string               `json:"name,omitempty"`
+	Summary            string               `json:"summary,omitempty"`
+	Description        string               `json:"description,omitempty"`
+	Comment            string               `json:"comment,omitempty"`
+	DataLicense        string               `json:"dataLicense,omitempty"`
+	ProfileConformance []string             `json:"profileConformance,omitempty"`
+	RootElement        []string             `json:"rootElement,omitempty"`
+	Element            []string             `json:"element,omitempty"`
+	SbomType           []string             `json:"software_sbomType,omitempty"`
+	PackageVersion     string               `json:"software_packageVersion,omitempty"`
+	PackageURL         string               `json:"software_packageUrl,omitempty"`
+	ExternalIdentifier []ExternalIdentifier `json:"externalIdentifier,omitempty"`
+	From               string               `json:"from,omitempty"`
+	RelationshipType   string               `json:"relationshipType,omitempty"`
+	To                 []string             `json:"to,omitempty"`
+	ActionStatement    string               `json:"security_actionStatement,omitempty"`
+	ImpactStatement    string               `json:"security_impactStatement,omitempty"`
+}
+
+type ExternalIdentifier struct {
+	Type                   string   `json:"type"`
+	ExternalIdentifierType string   `json:"externalIdentifierType"`
+	Identifier             string   `json:"identifier"`
+	IdentifierLocator      []string `json:"identifierLocator,omitempty"`
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package threadfix
+
+// Report is the generic json format of ThreadFix, also accepted by other application security orchestration
+// platforms
+type Report struct {
+	Created        string    `json:"created"`
+	Exported       string    `json:"exported"`
+	CollectionType string    `json:"collectionType"`
+	Source         string    `json:"source"`
+	Findings       []Finding `json:"findings"`
+}
+
+type Finding struct {
+	NativeID              string             `json:"nativeId"`
+	Severity              string             `json:"severity"`
+	NativeSeverity        string             `json:"nativeSeverity"`
+	Summary               string             `json:"summary"`
+	Description           string             `json:"description"`
+	ScannerDetail         string             `json:"scannerDetail"`
+	ScannerRecommendation string             `json:"scannerRecommendation,omitempty"`
+	CweID                 int                `json:"cweId,omitempty"`
+	StaticDetails         *StaticDetails     `json:"staticDetails,omitempty"`
+	DependencyDetails     *DependencyDetails `json:"dependencyDetails,omitempty"`
+	Metadata              map[string]string  `json:"metadata,omitempty"`
+}
+
+type StaticDetails struct {
+	DataFlowElements []DataFlowElement `json:"dataFlowElements"`
+}
+
+type DataFlowElement struct {
+	File         string `json:"file"`
+	LineNumber   int    `json:"lineNumber"`
+	ColumnNumber int    `json:"columnNumber"`
+	LineText     string `json:"lineText"`
+	Sequence     int    `json:"sequence"`
+}
+
+type DependencyDetails struct {
+	Library string `json:"library"`
+	Version string `json:"version"`
+}
@@ -2,6 +2,8 @@ package toolsconfig
 
 import (
 	"encoding/json"
+	"time"
+
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
@@ -10,6 +12,36 @@ import (
 type ToolConfig struct {
 	IsToIgnore bool   `json:"istoignore"`
 	ImagePath  string `json:"imagepath"`
+	Weight     int64  `json:"weight"`
+	// SaveRawOutput writes the output of the tool in the artifacts dir and references it in the vulnerabilities
+	SaveRawOutput bool `json:"saverawoutput"`
+	// Args are added to the command of the tools that accept arguments, each one quoted to the shell of the container
+	Args []string `json:"args"`
+	// ConfigPath is the config file of the tool mounted in its container, only used by the tools that accept a config
+	ConfigPath string `json:"configpath"`
+	// Projects are the projects or solutions built by the tools that build the project, relative to the project
+	Projects []string `json:"projects"`
+	// SkipProjects are the projects that are built but not analysed by the tools that build the project
+	SkipProjects []string `json:"skipprojects"`
+	// CacheVolume is the docker volume mounted in the dependencies cache of the tools that download dependencies,
+	// so the next analysis doesn't download them again
+	CacheVolume string `json:"cachevolume"`
+	// WarnOnly reports the vulnerabilities of the tool without failing the analysis, to roll out a new tool
+	WarnOnly bool `json:"warnonly"`
+	// WarnOnlyUntil is the last day of the grace period of the tool, like 2021-12-31, when it is warn only
+	WarnOnlyUntil string `json:"warnonlyuntil"`
+}
+
+// WarnOnlyUntilLayout is the layout of the date of the grace period of the tools
+const WarnOnlyUntilLayout = "2006-01-02"
+
+// IsWarnOnly returns if the tool is warn only in the time informed, the grace period ends at the end of its last day
+func (t *ToolConfig) IsWarnOnly(now time.Time) bool {
+	if t.WarnOnly {
+		return true
+	}
+	until, err := time.Parse(WarnOnlyUntilLayout, t.WarnOnlyUntil)
+	return err == nil && now.Before(until.AddDate(0, 0, 1))
 }
 
 type ToolsConfigsStruct struct {
@@ -32,6 +64,11 @@ type ToolsConfigsStruct struct {
 	HorusecNodejs     ToolConfig `json:"horusecnodejs"`
 	Flawfinder        ToolConfig `json:"flawfinder"`
 	PhpCS             ToolConfig `json:"phpcs"`
+	Trivy             ToolConfig `json:"trivy"`
+	HorusecDockerfile ToolConfig `json:"horusecdockerfile"`
+	Checkov           ToolConfig `json:"checkov"`
+	SpotBugs          ToolConfig `json:"spotbugs"`
+	OsvScanner        ToolConfig `json:"osvscanner"`
 }
 
 //nolint:funlen parse struct is necessary > 15 lines
@@ -56,6 +93,11 @@ func (t *ToolsConfigsStruct) ToMap() map[tools.Tool]ToolConfig {
 		tools.HorusecNodejs:     t.HorusecNodejs,
 		tools.Flawfinder:        t.Flawfinder,
 		tools.PhpCS:             t.PhpCS,
+		tools.Trivy:             t.Trivy,
+		tools.HorusecDockerfile: t.HorusecDockerfile,
+		tools.Checkov:           t.Checkov,
+		tools.SpotBugs:          t.SpotBugs,
+		tools.OsvScanner:        t.OsvScanner,
 	}
 }
 
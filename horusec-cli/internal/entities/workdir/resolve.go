@@ -0,0 +1,162 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workdir
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+)
+
+// manifestsByLanguage are the files in the root of the modules of each language used by the discovery
+var manifestsByLanguage = map[languages.Language][]string{
+	languages.Go:         {"go.mod"},
+	languages.Javascript: {"package.json"},
+	languages.Java:       {"pom.xml", "build.gradle"},
+	languages.Kotlin:     {"build.gradle.kts"},
+	languages.Python:     {"requirements.txt", "setup.py", "pyproject.toml"},
+	languages.Ruby:       {"Gemfile"},
+	languages.PHP:        {"composer.json"},
+}
+
+// foldersToSkipInDiscovery have the dependencies of the projects, that have manifests but aren't modules of the project
+var foldersToSkipInDiscovery = map[string]bool{
+	".git": true, ".horusec": true, "node_modules": true, "vendor": true,
+}
+
+func IsGlob(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
+// ExpandGlobs returns a copy of the workdir with the globs replaced by the folders that match in the project, like
+// services/*/ to each folder in services. The globs use the syntax of filepath.Match, so ** isn't supported
+func (w *WorkDir) ExpandGlobs(projectPath string) *WorkDir {
+	expanded := &WorkDir{}
+	*expanded = *w
+	for _, paths := range expanded.getPathsByField() {
+		*paths = ExpandPaths(projectPath, *paths)
+	}
+	return expanded
+}
+
+// ExpandPaths keeps the paths that aren't globs and removes the globs without folders matching
+func ExpandPaths(projectPath string, paths []string) []string {
+	expanded := []string{}
+	for _, path := range paths {
+		if IsGlob(path) {
+			expanded = append(expanded, MatchFolders(projectPath, path)...)
+		} else {
+			expanded = append(expanded, path)
+		}
+	}
+	return expanded
+}
+
+// MatchFolders returns the folders that match the glob relative to the project path
+func MatchFolders(projectPath, glob string) []string {
+	projectPathAbs, _ := filepath.Abs(projectPath)
+	matches, err := filepath.Glob(filepath.Join(projectPathAbs, filepath.FromSlash(glob)))
+	if err != nil {
+		return []string{}
+	}
+	folders := []string{}
+	for _, match := range matches {
+		if info, err := os.Stat(match); err == nil && info.IsDir() {
+			relativePath, _ := filepath.Rel(projectPathAbs, match)
+			folders = append(folders, filepath.ToSlash(relativePath))
+		}
+	}
+	sort.Strings(folders)
+	return folders
+}
+
+// DiscoverModuleRoots sets the folders with the manifests of the language, like go.mod, in the languages without
+// workdir configured. The project root is used as an empty path, like when the workdir isn't configured
+func (w *WorkDir) DiscoverModuleRoots(projectPath string) *WorkDir {
+	roots := discoverModuleRoots(projectPath)
+	pathsByLanguage := w.getPathsByLanguage()
+	for language, paths := range pathsByLanguage {
+		if len(*paths) == 0 && len(roots[language]) > 0 {
+			*paths = roots[language]
+		}
+	}
+	return w
+}
+
+func discoverModuleRoots(projectPath string) map[languages.Language][]string {
+	projectPathAbs, _ := filepath.Abs(projectPath)
+	roots := map[languages.Language][]string{}
+	_ = filepath.Walk(projectPathAbs, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info.IsDir() {
+			if foldersToSkipInDiscovery[info.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if language, ok := getLanguageOfManifest(info.Name()); ok {
+			relativePath, _ := filepath.Rel(projectPathAbs, filepath.Dir(path))
+			roots[language] = appendRoot(roots[language], relativePath)
+		}
+		return nil
+	})
+	return roots
+}
+
+func getLanguageOfManifest(fileName string) (languages.Language, bool) {
+	for language, manifests := range manifestsByLanguage {
+		for _, manifest := range manifests {
+			if fileName == manifest {
+				return language, true
+			}
+		}
+	}
+	return "", false
+}
+
+func appendRoot(roots []string, relativePath string) []string {
+	if relativePath == "." {
+		relativePath = ""
+	}
+	relativePath = filepath.ToSlash(relativePath)
+	for _, root := range roots {
+		if root == relativePath {
+			return roots
+		}
+	}
+	return append(roots, relativePath)
+}
+
+func (w *WorkDir) getPathsByField() []*[]string {
+	return []*[]string{&w.Go, &w.NetCore, &w.CSharp, &w.Ruby, &w.Python, &w.Java, &w.Kotlin, &w.JavaScript,
+		&w.Leaks, &w.HCL, &w.PHP, &w.C, &w.Yaml, &w.Generic}
+}
+
+func (w *WorkDir) getPathsByLanguage() map[languages.Language]*[]string {
+	return map[languages.Language]*[]string{
+		languages.Go:         &w.Go,
+		languages.Javascript: &w.JavaScript,
+		languages.Java:       &w.Java,
+		languages.Kotlin:     &w.Kotlin,
+		languages.Python:     &w.Python,
+		languages.Ruby:       &w.Ruby,
+		languages.PHP:        &w.PHP,
+	}
+}
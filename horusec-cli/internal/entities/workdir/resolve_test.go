@@ -0,0 +1,79 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workdir
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newProjectWithFiles(t *testing.T, files ...string) string {
+	projectPath, err := ioutil.TempDir("", "horusec-workdir")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(projectPath)
+	})
+	for _, file := range files {
+		path := filepath.Join(projectPath, filepath.FromSlash(file))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0750))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(""), 0600))
+	}
+	return projectPath
+}
+
+func TestIsGlob(t *testing.T) {
+	t.Run("should return true only when the path has a pattern", func(t *testing.T) {
+		assert.True(t, IsGlob("services/*/"))
+		assert.True(t, IsGlob("services/api-?"))
+		assert.True(t, IsGlob("services/[ab]pi"))
+		assert.False(t, IsGlob("services/api"))
+	})
+}
+
+func TestExpandGlobs(t *testing.T) {
+	t.Run("should replace the globs by the folders matching and keep the paths", func(t *testing.T) {
+		projectPath := newProjectWithFiles(t, "services/web/main.go", "services/api/main.go",
+			"services/README.md", "frontend/package.json")
+		workDir := &WorkDir{Go: []string{"services/*/"}, JavaScript: []string{"frontend"},
+			Python: []string{"scripts/*"}}
+
+		expanded := workDir.ExpandGlobs(projectPath)
+
+		assert.Equal(t, []string{"services/api", "services/web"}, expanded.Go)
+		assert.Equal(t, []string{"frontend"}, expanded.JavaScript)
+		assert.Empty(t, expanded.Python)
+		assert.Equal(t, []string{"services/*/"}, workDir.Go)
+	})
+}
+
+func TestDiscoverModuleRoots(t *testing.T) {
+	t.Run("should set the folders with manifests in the languages without workdir", func(t *testing.T) {
+		projectPath := newProjectWithFiles(t, "go.mod", "tools/go.mod", "web/package.json",
+			"web/node_modules/lib/package.json", "vendor/lib/go.mod", "api/pom.xml", "app/Gemfile")
+		workDir := &WorkDir{Ruby: []string{"other"}}
+
+		workDir.DiscoverModuleRoots(projectPath)
+
+		assert.Equal(t, []string{"", "tools"}, workDir.Go)
+		assert.Equal(t, []string{"web"}, workDir.JavaScript)
+		assert.Equal(t, []string{"api"}, workDir.Java)
+		assert.Equal(t, []string{"other"}, workDir.Ruby)
+		assert.Empty(t, workDir.Python)
+	})
+}
@@ -43,4 +43,43 @@ const (
 	MsgDebugShowConfigs = "{HORUSEC_CLI} The current configuration for this analysis are:"
 	MsgDebugShowWorkdir = "{HORUSEC_CLI} The workdir setup for run in path:"
 	MsgDebugToolIgnored = "{HORUSEC_CLI} The tool was ignored for run in this analysis: "
+	// Fired with the list of vendored or generated files skipped from the analysis
+	MsgDebugVendoredOrGeneratedIgnored = "{HORUSEC_CLI} The vendored or generated files skipped from the analysis are:"
+	// Fired when the project is mounted read-only in the tools containers instead of being copied
+	MsgDebugSourceModeReadOnly = "{HORUSEC_CLI} The project will be mounted read-only in the tools containers: "
+	// Fired when the analysis result can't be cached, like when the project has uncommitted changes
+	MsgDebugAnalysisCacheNotUsed = "{HORUSEC_CLI} The analysis cache was not used: "
+	// Fired when the analysis result was not found or could not be read from the remote cache
+	MsgDebugRemoteCacheMiss = "{HORUSEC_CLI} The analysis result was not found in the remote cache: "
+	// Fired when was not possible read the content of the file to detect the language
+	MsgDebugReadFileToDetectLanguage = "{HORUSEC_CLI} Was not possible read file content to detect language: "
+	// Fired when was not possible check if a leaked secret is active
+	MsgDebugSecretVerificationFailed = "{HORUSEC_CLI} Was not possible verify if the secret is active: "
+	// Fired when the telemetry is enabled, showing all data sent
+	MsgDebugSendTelemetry = "{HORUSEC_CLI} Sending anonymous usage data: "
+	// Fired when was not possible send the telemetry, the analysis is not affected
+	MsgDebugSendTelemetryFailed = "{HORUSEC_CLI} Was not possible send the anonymous usage data: "
+	// Fired when the dependency submission is enabled but the dependency tools found no dependencies
+	MsgDebugNoDependenciesToSubmit = "{HORUSEC_CLI} No dependencies found by the dependency tools to submit to GitHub"
+	// Fired after the vulnerabilities of the analysis are indexed in elasticsearch
+	MsgDebugElasticsearchIndexed = "{HORUSEC_CLI} Vulnerabilities indexed in Elasticsearch: "
+	// Fired after the analysis is stored in the local database
+	MsgDebugAnalysisStoredInLocalDB = "{HORUSEC_CLI} Analysis stored in the local database: "
+	// Fired after the analysis is exported to postgres
+	MsgDebugAnalysisExportedToPostgres = "{HORUSEC_CLI} Analysis exported to PostgreSQL"
+	// Fired before each new attempt to send the analysis to horusec platform
+	MsgDebugRetrySendAnalysis = "{HORUSEC_CLI} Sending the analysis to horusec platform again after: "
+	// Fired before the copy of the project with the size estimated to the copy
+	MsgDebugProjectCopySize = "{HORUSEC_CLI} Size of the copy of the project in MB and free space in the volume: "
+	// Fired when was not possible check the free space of the volume, the project is copied anyway
+	MsgDebugDiskSpaceNotChecked = "{HORUSEC_CLI} Was not possible check the free space to copy the project: "
+	// Fired for each analysis folder left inside of .horusec by a crashed analysis that is removed
+	MsgDebugOrphanWorkDirRemoved = "{HORUSEC_CLI} Analysis folder left by a previous analysis removed: "
+	// Fired when the default docker socket is absent and no socket of the known docker engines of macOS is listening
+	MsgDebugDockerEngineNotDiscovered = "{HORUSEC_CLI} No socket of colima, Rancher Desktop, Docker Desktop or " +
+		"podman machine is listening"
+	// Fired when the pull of the image was shared by the formatters that need the same image at the same time
+	MsgDebugDockerAPIPullShared = "{HORUSEC_CLI} Pull of the image shared by the tools that need it: "
+	// Fired when the stream of the pull of an image is not the json expected, the progress of the pull is not shown
+	MsgDebugDockerAPIPullProgressUnknown = "{HORUSEC_CLI} Progress of the pull of the image unknown: "
 )
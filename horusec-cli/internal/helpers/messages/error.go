@@ -28,6 +28,47 @@ const (
 	MsgErrorFalsePositiveNotValid = "False positive is not valid because is duplicated in risk accept: "
 	// USED IN USE CASES: Fired when an risk accept is not allowed in configs
 	MsgErrorRiskAcceptNotValid = "Risk Accept is not valid because is duplicated in false positive: "
+	// USED IN USE CASES: Fired when the remote cache url has an unsupported scheme
+	MsgErrorInvalidRemoteCacheURL = "Remote cache url is not valid, it must start with s3://, gs://, http:// or https://"
+	// USED IN USE CASES: Fired when the remote cache is used without the public key that verifies its objects
+	MsgErrorInvalidRemoteCachePublicKey = "Remote cache requires the public key, an ed25519 public key in base64, " +
+		"to verify the objects read"
+	// USED IN USE CASES: Fired when the remote cache is written without the private key of the public key
+	MsgErrorInvalidRemoteCachePrivateKey = "Remote cache in the read-write mode requires the private key, the " +
+		"ed25519 private key or its seed in base64 of the public key, to sign the objects written"
+	// USED IN USE CASES: Fired when the key of the risk weights is unknown or its weight is not an integer
+	MsgErrorInvalidRiskWeight = "Risk weight is not valid, the key must be a severity, a CWE or verified-secret " +
+		"and the weight an integer: "
+	// USED IN USE CASES: Fired when the level of a module of the log module levels is not a log level
+	MsgErrorInvalidLogModuleLevel = "Log module level is not valid, the level must be panic, fatal, error, warn, info, " +
+		"debug or trace: "
+	// USED IN USE CASES: Fired when the timeout is not a positive duration
+	MsgErrorInvalidTimeout = "Timeout is not valid, it must be a duration like 30m or 1h30m: "
+	// USED IN USE CASES: Fired when the min grade is not a grade between A and F
+	MsgErrorInvalidMinGrade = "Min grade is not valid, it must be between A and F: "
+	// USED IN USE CASES: Fired when the end of the grace period of a tool is not a date like 2021-12-31
+	MsgErrorInvalidWarnOnlyUntil = "Warn only until is not valid, it must be a date like 2021-12-31 of the tool "
+	// USED IN USE CASES: Fired when the key of the max findings is not a severity or its max is not a positive integer
+	MsgErrorInvalidMaxFindings = "Max findings is not valid, the key must be a severity and the max a not negative " +
+		"integer: "
+	// USED IN USE CASES: Fired when the min confidence is not a level of confidence
+	MsgErrorInvalidMinConfidence = "Min confidence is not valid, it must be LOW, MEDIUM or HIGH: "
+	// USED IN USE CASES: Fired when the report min severity or the fail threshold is not a level of severity
+	MsgErrorInvalidSeverityLevel = "Severity is not valid, it must be INFO, LOW, MEDIUM, HIGH or CRITICAL: "
+	// USED IN USE CASES: Fired when the key of the severity mapping isn't a tool and its severity separated by colon
+	// or the value isn't a severity
+	MsgErrorInvalidSeverityMapping = "Severity mapping is not valid, the key must be the tool and its severity " +
+		"separated by colon and the value a severity of horusec: "
+	// USED IN USE CASES: Fired when the key of the threadfix field mapping isn't a field of the findings or the value
+	// isn't a field of the json output of the vulnerability
+	MsgErrorInvalidThreadFixFieldMapping = "ThreadFix field mapping is not valid, the key must be a field of the " +
+		"findings and the value a field of the json output of the vulnerability: "
+	// USED IN USE CASES: Fired when the yaml of the severity tables can't be read or has invalid tools or severities
+	MsgErrorInvalidSeverityTables = "Severity tables are not valid, the keys must be tools and the values " +
+		"severities of horusec: "
+	// USED IN USE CASES: Fired when the flag reveal-secrets is used in an analysis sent to horusec platform
+	MsgErrorRevealSecretsWithAuthorization = "The secrets can only be revealed in local analysis, " +
+		"remove the flag reveal-secrets or the repository authorization"
 	// Fired when an unexpected error occurs when check if the requirements it's ok
 	MsgErrorWhenCheckRequirements = "{HORUSEC_CLI} Error when check if requirements it's ok!"
 	// Fired when an unexpected error occurs when check if the docker is running
@@ -90,4 +131,168 @@ const (
 	MsgErrorYarnProcess    = "{HORUSEC_CLI} Error Yarn returned an error: "
 	MsgErrorDeferFileClose = "{HORUSEC_CLI} Error defer file close: "
 	MsgErrorGetCurrentPath = "{HORUSEC-CLI} Error on get current path"
+	// Fired when was not possible to write the analysis result in the cache directory
+	MsgErrorSaveAnalysisCache = "{HORUSEC_CLI} Error when save analysis result in the cache: "
+	// Fired when was not possible to send the analysis result to the remote cache
+	MsgErrorSaveAnalysisRemoteCache = "{HORUSEC_CLI} Error when save analysis result in the remote cache: "
+	// Fired when was not possible to write the hashes marked in the triage in the config file
+	MsgErrorSaveTriageInConfigFile = "{HORUSEC_CLI} Error when save false positive and risk accept hashes " +
+		"in the config file: "
+	// Fired when the report used in the review command can't be read
+	MsgErrorReadReportToReview = "{HORUSEC_CLI} Error when read the json report to review: "
+	// Fired when the command fix can't read the json report or change the manifests of the dependencies
+	MsgErrorFixDependencies = "{HORUSEC_CLI} Error when fix the dependencies of the json report: "
+	// Fired when one of the reports used in the report diff command can't be read
+	MsgErrorReadReportToDiff = "{HORUSEC_CLI} Error when read the json report to compare: "
+	// Fired when the diff of the reports can't be written in the output file
+	MsgErrorWriteReportDiff = "{HORUSEC_CLI} Error when write the diff of the reports: "
+	// Fired when the output format of the report diff command is unknown
+	MsgErrorInvalidReportDiffFormat = "{HORUSEC_CLI} Output format of the diff not valid, the available ones are: "
+	// Fired when was not possible to write the man pages of the commands
+	MsgErrorGenerateManPages = "{HORUSEC_CLI} Error when generate the man pages: "
+	// Fired when the executable used as printer of the output format returned error
+	MsgErrorRunPrinterPlugin = "{HORUSEC_CLI} Error when run the printer plugin: "
+	// Fired when the output format informed has no printer registered and no executable in the PATH
+	MsgErrorOutputTypeNotAvailable = "output format not available, the available ones are: "
+	// Fired when the opa binary failed or returned an invalid output when evaluating the policy
+	MsgErrorEvaluatePolicy = "{HORUSEC_CLI} Error when evaluate the policy: "
+	// Fired when the json output informed as baseline of the policy cannot be read
+	MsgErrorReadPolicyBaseline = "{HORUSEC_CLI} Error when read the baseline of the policy: "
+	// Fired when the commands of the local database are used without the path of the database
+	MsgErrorLocalDBPathNotInformed = "{HORUSEC_CLI} The path of the local database is not informed, " +
+		"use the flag --store-local-db"
+	// Fired when the query of the command db query fails
+	MsgErrorQueryLocalDB = "{HORUSEC_CLI} Error when query the local database: "
+	// USED IN CMD: Fired when the format of the commands of the local database isn't supported
+	MsgErrorInvalidLocalDBFormat = "{HORUSEC_CLI} Format of the local database query is not valid, the formats are: "
+	// Fired when the report is encrypted but the binary of the recipients, age or gpg, is not in the PATH
+	MsgErrorEncryptionBinaryNotFound = "{HORUSEC_CLI} {{0}} binary not found in the PATH, it is required to " +
+		"encrypt the report to the recipients"
+	// Fired when the encryption of the report fails, the report is not written unencrypted
+	MsgErrorEncryptReport = "{HORUSEC_CLI} Error when encrypt the report: "
+	// USED IN USE CASES: Fired when the report is encrypted without recipients or with recipients of age and gpg
+	MsgErrorInvalidReportRecipients = "Encrypt report requires recipients, all age public keys or all gpg keys"
+	// USED IN USE CASES: Fired when the report is encrypted without the path of the output file
+	MsgErrorEncryptReportWithoutOutputFile = "Encrypt report requires an output type written in the json output " +
+		"file path, like json, sonarqube or threadfix"
+	// USED IN USE CASES: Fired when the report is encrypted with the artifacts dir or the raw outputs of the tools
+	MsgErrorEncryptReportWithArtifacts = "Encrypt report can't be used with the artifacts dir or the " +
+		"saveRawOutput of the tools, they are written unencrypted"
+	// Fired when the policy was informed but the opa binary is not in the PATH
+	MsgErrorOPANotFound = "{HORUSEC_CLI} opa binary not found in the PATH, " +
+		"see how to install it in https://www.openpolicyagent.org/docs/latest/#running-opa"
+	// Fired when the analysis can't be saved in the offline queue, then it is lost
+	MsgErrorSaveAnalysisInQueue = "{HORUSEC_CLI} Error when save the analysis in the offline queue: "
+	// Fired when the analyses of the offline queue can't be sent
+	MsgErrorFlushQueue = "{HORUSEC_CLI} Error when send the analyses of the offline queue: "
+	// Fired when the command flush-queue runs without the authorization token of the analyses
+	MsgErrorFlushQueueWithoutAuthorization = "{HORUSEC_CLI} The authorization token is required to send " +
+		"the analyses of the offline queue, use the flag -a"
+	// Fired when the schedules file of the server mode can't be read or is invalid
+	MsgErrorLoadSchedules = "{HORUSEC_CLI} Error when load the schedules of the server: "
+	// Fired when a scheduled analysis of the server mode fails, the {{0}} is the name of the project
+	MsgErrorRunScheduledAnalysis = "{HORUSEC_CLI} Error when run the scheduled analysis of the project {{0}}: "
+	// Fired when the server mode starts without the token of the flag token or the env HORUSEC_CLI_SERVER_TOKEN
+	MsgErrorServerWithoutToken = "{HORUSEC_CLI} The token is required to serve the reports, use the flag --token " +
+		"or the environment variable HORUSEC_CLI_SERVER_TOKEN"
+	// Fired when the http server of the server mode stops with error
+	MsgErrorStartServer = "{HORUSEC_CLI} Error when start the server: "
+	// Fired when the repository of the flag repo-url can't be cloned
+	MsgErrorCloneRepository = "{HORUSEC_CLI} Error when clone the repository: "
+	// Fired when the scan of the container image informed in the command image scan fails
+	MsgErrorScanImage = "{HORUSEC_CLI} Error when scan the container image: "
+	// Fired when the terraform plan of the flag tf-plan can't be prepared to the container of checkov
+	MsgErrorSetupTfPlan = "{HORUSEC_CLI} Error when prepare the terraform plan to analysis: "
+	// Fired when the yaml of the flag remediation-path can't be read, the vulnerabilities are kept without fixes
+	MsgErrorSetRemediations = "{HORUSEC_CLI} Error when add the remediations to the vulnerabilities"
+	// Fired when the yaml of the flag severity-tables-path can't be read, the built-in tables are used
+	MsgErrorLoadSeverityTables = "{HORUSEC_CLI} Error when load the severity tables, using the built-in tables"
+	// USED IN USE CASES: Fired when the triage url isn't an http url
+	MsgErrorInvalidTriageURL = "Triage url is not valid, it must start with http:// or https://"
+	// USED IN USE CASES: Fired when the elasticsearch url isn't an http url
+	MsgErrorInvalidElasticsearchURL = "Elasticsearch url is not valid, it must start with http:// or https://"
+	// USED IN USE CASES: Fired when the elasticsearch index has characters not allowed in the names of the indexes
+	MsgErrorInvalidElasticsearchIndex = "Elasticsearch index is not valid, it must be lowercase and can't start " +
+		"with -, _ or + or have spaces and the characters \\ / * ? \" < > | , # :"
+	// USED IN USE CASES: Fired when the postgres uri isn't a postgres url
+	MsgErrorInvalidPostgresURI = "PostgreSQL uri is not valid, it must start with postgres:// or postgresql://"
+	// Fired when cosign can't sign the attestation of the report of the flag sign-report
+	MsgErrorSignReport = "{HORUSEC_CLI} Error when sign the attestation of the report: "
+	// USED IN USE CASES: Fired when the flag sign-report is used without the json output type
+	MsgErrorSignReportWithoutJSON = "Sign report requires the output type json with the json output file path"
+	// USED IN USE CASES: Fired when a glob of the workdir doesn't match any folder of the project
+	MsgErrorWorkDirGlobWithoutMatch = "Workdir glob doesn't match any folder of the project to the language "
+	// USED IN USE CASES: Fired when a rule pack of the flag rule-packs isn't in the format name@version
+	MsgErrorInvalidRulePack = "Rule pack is not valid, it must be informed as name@version: "
+	// USED IN USE CASES: Fired when a rule pack of the flag rule-packs isn't builtin or downloaded
+	MsgErrorRulePackNotFound = "Rule pack not found, download it with horusec rules update: "
+	// USED IN USE CASES: Fired when the config file of a tool in the tools config doesn't exist
+	MsgErrorToolConfigPathNotFound = "Config file of the tool not found: "
+	// Fired when the command rules update can't download the rule packs of the index
+	MsgErrorUpdateRulePacks = "{HORUSEC_CLI} Error when update the rule packs: "
+	// Fired when the command rules test can't read the rule packs or the fixtures of the directory
+	MsgErrorRulesTest = "{HORUSEC_CLI} Error when test the rules: "
+	// Fired when the log file informed in the flag log-file-path can't be opened
+	MsgErrorSetLogFile = "{HORUSEC_CLI} Error when open the log file: "
+	// Fired when a file of the analysis can't be written in the directory of the flag artifacts-dir
+	MsgErrorSaveArtifact = "{HORUSEC_CLI} Error when save the artifact of the analysis: "
+	// Fired when the formatter of a tool panics while parsing its output and the panic is recovered
+	MsgErrorFormatterPanic = "{HORUSEC_CLI} The parsing of the output of the tool panicked, the analysis continues " +
+		"without its vulnerabilities: "
+	// Fired when the volume of the project doesn't have free space to copy the project to the .horusec folder
+	MsgErrorNotEnoughDiskSpace = "{HORUSEC_CLI} Not enough disk space to copy the project to the .horusec folder, " +
+		"the copy needs %.1fMB and there are %.1fMB free in the volume of the project"
+	// Fired when an analysis folder left inside of .horusec by a crashed analysis can't be removed
+	MsgErrorRemoveOrphanWorkDir = "{HORUSEC_CLI} Error when remove the analysis folder left inside of .horusec: "
+	// Fired when the file of the flag work-dirs-state-file can't be read or written
+	MsgErrorWorkDirsStateFile = "{HORUSEC_CLI} Error when update the state file of the analysis folders: "
+	// Fired when the command clean can't walk the folders of the project path
+	MsgErrorClean = "{HORUSEC_CLI} Error when clean the analysis folders of the project: "
+	// Fired when the path of the host can't be translated to the path of the bind mount of the docker daemon flavor
+	MsgErrorTranslateDockerPath = "{HORUSEC_CLI} Error when translate the path to the docker daemon flavor: "
+	// Fired in the command images pull when the language informed is not supported
+	MsgErrorImagesUnknownLanguage = "{HORUSEC_CLI} Language not supported, the images of it can't be pulled: "
+	// Fired in the command tools export when the format informed is not supported
+	MsgErrorInvalidToolsExportFormat = "{HORUSEC_CLI} Format of the tools export not supported, the formats are: "
+	// Fired when the bom of the tools can't be written in the output file
+	MsgErrorWriteToolsExport = "{HORUSEC_CLI} Error when writing the export of the tools: "
+	// Fired in the command bench when a run of the analysis fails without writing the report
+	MsgErrorBenchRun = "{HORUSEC_CLI} Error when running the analysis of the bench: "
+	// Fired when the profile of the flag fault-injection is invalid
+	MsgErrorFaultInjectionProfile = "{HORUSEC_CLI} Fault injection profile is not valid: "
+	// Fired when the executable of an event hook isn't found in the PATH
+	MsgErrorEventHookNotFound = "{HORUSEC_CLI} Event hook not found, the executable horusec-hook-<name> is necessary in the PATH: "
+	// Fired when the executable of an event hook can't be started or stops receiving the events
+	MsgErrorRunEventHook = "{HORUSEC_CLI} Error when run the event hook: "
+	// Fired when the lifecycle hooks of the config file can't be parsed
+	MsgErrorParseLifecycleHooks = "{HORUSEC_CLI} Error when parse the lifecycle hooks of the config file: "
+	// Fired when a command or a request of a lifecycle hook fails
+	MsgErrorRunLifecycleHook = "{HORUSEC_CLI} Error when run the lifecycle hook: "
+	// Fired when the filter informed isn't available or its executable fails
+	MsgErrorRunFilter = "{HORUSEC_CLI} Error when run the filter of vulnerabilities "
+	// Fired when the filter informed has no filter registered and no executable in the PATH
+	MsgErrorFilterNotAvailable = "{HORUSEC_CLI} Filter not available, the filters are: "
+	// Fired when the CODEOWNERS of the project can't be read, the vulnerabilities are kept without owners
+	MsgErrorReadCodeOwners = "{HORUSEC_CLI} Error when read the CODEOWNERS file: "
+	// Fired when the tickets file can't be read, the vulnerabilities are kept without tickets
+	MsgErrorSetTickets = "{HORUSEC_CLI} Error when set the tickets of the vulnerabilities"
+	// USED IN USE CASES: Fired when the report is split by owner without the path of the output file
+	MsgErrorSplitReportWithoutOutputFile = "Split report by owner requires an output type written in the json " +
+		"output file path, like json, sonarqube or threadfix"
+	// USED IN USE CASES: Fired when a rule of the owner mappings has no pattern or no owners
+	MsgErrorInvalidOwnerMapping = "Owner mapping is not valid, it must be the pattern and the owners separated by " +
+		"spaces, like services/api/ @org/api: "
+	// USED IN USE CASES: Fired when the webhook of an owner isn't an http or https url
+	MsgErrorInvalidOwnerWebhook = "Owner webhook is not valid, it must start with http:// or https://: "
+	// USED IN USE CASES: Fired when the tickets file can't be read or has a ticket without url
+	MsgErrorInvalidTicketsFile = "Tickets file is not valid, it must be a yaml of the vulnerability hash to the url " +
+		"and the status of its ticket: "
+	// USED IN USE CASES: Fired when the gitea url isn't an http url
+	MsgErrorInvalidGiteaURL = "Gitea url is not valid, it must start with http:// or https://"
+	// USED IN USE CASES: Fired when the gitea url is informed without the token to authenticate in its api
+	MsgErrorGiteaURLWithoutToken = "Gitea url requires the gitea token to publish the comment and the commit status"
+	// USED IN USE CASES: Fired when an allowlist pattern of the leaks entropy isn't a valid regular expression
+	MsgErrorInvalidLeaksEntropyAllowlistPattern = "Leaks entropy allowlist pattern is not a valid regular expression: "
+	// USED IN USE CASES: Fired when the offline database path of osv-scanner is not a directory
+	MsgErrorInvalidOsvOfflineDatabasePath = "Osv offline database path must be a directory"
 )
@@ -27,8 +27,19 @@ const (
 	MsgInfoConfigFilePath = "{HORUSEC_CLI} Using config file: "
 	// Fired when is setup to the output is sonarqube
 	MsgInfoStartGenerateSonarQubeFile = "{HORUSEC_CLI} Generating SonarQube output..."
+	// Fired when is setup to the output is threadfix
+	MsgInfoStartGenerateThreadFixFile = "{HORUSEC_CLI} Generating ThreadFix output..."
+	// Fired when is setup to the output is cyclonedx-vdr
+	MsgInfoStartGenerateCycloneDXVDRFile = "{HORUSEC_CLI} Generating CycloneDX vulnerability disclosure report output..."
+	// Fired when is setup to the output is spdx3
+	MsgInfoStartGenerateSPDX3File = "{HORUSEC_CLI} Generating SPDX 3 output..."
+	// Fired when is setup to the output is jenkins
+	MsgInfoStartGenerateJenkinsFile = "{HORUSEC_CLI} Generating Jenkins warnings-ng output..."
 	// Fired when is setup to the output is sonarqube
 	MsgInfoStartWriteFile = "{HORUSEC_CLI} Writing output JSON to file in the path: "
+	// Fired when the same commit was already analyzed with the same configurations
+	MsgInfoAnalysisFromCache = "{HORUSEC_CLI} This commit was already analyzed with the same configurations, " +
+		"using the cached result. To run the analysis again use the flag --no-cache"
 	// Fired when monitor log timeout
 	MsgInfoMonitorTimeoutIn = "Hold on! Horusec still analysis your code. Timeout in: "
 	// Fired in print results service when analysis is finished
@@ -39,4 +50,55 @@ const (
 	// Occurs when o docker is lower version than recommend
 	MsgDockerLowerVersion = "{HORUSEC_CLI} We recommend version 19.03 or higher of the docker." +
 		" Versions prior to this may have problems during execution"
+	// Fired when the hashes marked in the triage were saved in the config file
+	MsgInfoTriageSavedInConfigFile = "{HORUSEC_CLI} False positive and risk accept hashes saved in the config file: "
+	// Fired when the man pages of the commands were written
+	MsgInfoManPagesGenerated = "{HORUSEC_CLI} Man pages generated in the directory: "
+	// Fired in dry run mode with the effective configuration used in the analysis
+	MsgInfoDryRunConfig = "{HORUSEC_CLI} Dry run enabled, no container will be started. Configuration used:\n"
+	// Fired in dry run mode with the languages detected in the project
+	MsgInfoDryRunLanguages = "{HORUSEC_CLI} Dry run: languages detected: "
+	// Fired in dry run mode with the files and folders that will not be analyzed
+	MsgInfoDryRunPathsIgnored = "{HORUSEC_CLI} Dry run: files and folders ignored: "
+	// Fired in dry run mode for each tool that would run in a container
+	MsgInfoDryRunTool = "{HORUSEC_CLI} Dry run: the tool {{0}} would run the image {{1}} " +
+		"in the path \"{{2}}\" with the command:\n{{3}}"
+	// Fired when the analyses of the offline queue were sent to horusec platform
+	MsgInfoAnalysesSentFromQueue = "{HORUSEC_CLI} Total of analyses sent from the offline queue: "
+	// Fired when the server mode starts to listen the requests
+	MsgInfoServerListening = "{HORUSEC_CLI} Horusec server listening on: "
+	// Fired when a scheduled analysis of the server mode finishes, the {{0}} is the name of the project
+	MsgInfoScheduledAnalysisFinished = "{HORUSEC_CLI} Scheduled analysis of the project {{0}} finished, report: "
+	// Fired before the clone of the repository informed in the flag repo-url
+	MsgInfoCloningRepository = "{HORUSEC_CLI} Cloning the repository to analyze: "
+	// Fired for each dependency upgraded by the command fix, the {{0}} is the manifest and {{1}} is the dependency
+	MsgInfoDependencyUpgraded = "{HORUSEC_CLI} Dependency {{1}} of {{0}} upgraded to: "
+	// Fired when the command fix changed the manifests, the lock files must be updated by the package managers
+	MsgInfoDependenciesFixed = "{HORUSEC_CLI} Manifests changed, update the lock files with the package managers, " +
+		"like npm install, yarn install or go mod tidy"
+	// Fired when the command fix wrote the changes of the manifests in the patch file
+	MsgInfoDependenciesPatchSaved = "{HORUSEC_CLI} Patch with the upgrades of the dependencies saved, apply it with " +
+		"git apply: "
+	// Fired when the report has no dependency with fixed version to upgrade
+	MsgInfoNoDependencyToFix = "{HORUSEC_CLI} No dependency with fixed version to upgrade in the manifests"
+	// Fired before sending the vulnerabilities to the triage endpoint, the {{0}} is the number of vulnerabilities
+	MsgInfoTriageStarted = "{HORUSEC_CLI} Sending {{0}} vulnerabilities with the secrets redacted to the triage " +
+		"endpoint: "
+	// Fired after the attestation of the report of the flag sign-report is signed, the {{0}} is its path
+	MsgInfoReportSigned = "{HORUSEC_CLI} Attestation of the report signed and written in: {{0}}"
+	// Fired after the command rules update, the {{0}} is the number of packs downloaded and the {{1}} is the dir
+	MsgInfoRulePacksUpdated = "{HORUSEC_CLI} {{0}} rule packs downloaded in {{1}}: "
+	// Fired in the command rules test for each rule with the findings equal to the annotations of the fixtures
+	MsgInfoRuleTestPassed = "{HORUSEC_CLI} PASS {{0}} with {{1}} findings expected"
+	// Fired after the command clean, the {{0}} is the number of analysis folders removed
+	MsgInfoWorkDirsCleaned = "{HORUSEC_CLI} {{0}} analysis folders removed from the .horusec folders of: "
+	// Fired when the default docker socket is absent and the socket of other docker engine of macOS is used
+	MsgInfoDockerEngineDiscovered = "{HORUSEC_CLI} Docker socket not found in the default location, using the " +
+		"engine and socket found: "
+	// Fired in the command images pull before pulling each image, the images present are not pulled again
+	MsgInfoPullingImage = "{HORUSEC_CLI} Pulling the image if it is not present: "
+	// Fired after the command images pull, the {{0}} is the number of images ready of the {{1}} images needed
+	MsgInfoImagesPulled = "{HORUSEC_CLI} {{0}} of {{1}} images ready to be used by the analyses"
+	// Fired in the command bench before each run of the analysis, the {{0}} is the run and the {{1}} the total of runs
+	MsgInfoBenchRun = "{HORUSEC_CLI} Running the analysis {{0}} of {{1}} of the bench"
 )
@@ -27,6 +27,18 @@ const (
 	// Fired when occurs of ignore folder or file to send horusec analysis
 	MsgWarnTotalFolderOrFileWasIgnored = "{HORUSEC_CLI} When starting the analysis WE SKIP A TOTAL OF {{0}} FILES " +
 		"that are not considered to be analyzed. To see more details use flag --log-level=debug"
+	// Fired when vendored or generated files are skipped from the analysis
+	MsgWarnVendoredOrGeneratedWasIgnored = "{HORUSEC_CLI} A TOTAL OF {{0}} VENDORED OR GENERATED FILES WERE SKIPPED " +
+		"from the analysis. To analyze them use the flag --enable-vendored-and-generated-code"
+	// Fired when the read-only source mode is not safe for the project and the project is copied
+	MsgWarnSourceModeReadOnlyNotSafe = "{HORUSEC_CLI} The project will be copied to the analysis folder " +
+		"because the read-only source mode is not safe: "
+	// Fired when a tool writes in the project, so its files are copied instead of hardlinked
+	MsgWarnSourceModeHardlinkNotSafe = "{HORUSEC_CLI} The files of the project will be copied to the analysis " +
+		"folder because the hardlink source mode is not safe: "
+	// Fired when the output of a tool container is bigger than the max output size
+	MsgWarnContainerOutputTooLarge = "{HORUSEC_CLI} The output of the tool was truncated because it is bigger than " +
+		"the max output size. To increase it use the flag --max-output-size-mb"
 	MsgWarnGitHistoryEnable = "{HORUSEC_CLI} Starting the analysis with git history enabled. " +
 		"ATTENTION the waiting time can be longer when this option is enabled!"
 	MsgWarnNetCoreDeprecated = "{HORUSEC_CLI} The 'netcore' key will be removed in the next release after 23 dec 2020," +
@@ -34,4 +46,50 @@ const (
 	MsgWarnToolsToIgnoreDeprecated = "{HORUSEC_CLI} The option 'tools to ignore' key will be removed in the next release" +
 		" after 16 jan 2021, please use tools config option"
 	MsgWarnHashNotExistOnAnalysis = "{HORUSEC_CLI} Hash not found in the list of vulnerabilities pointed out by Horusec: "
+	// Fired when horusec platform was unreachable and the analysis was saved in the offline queue
+	MsgWarnAnalysisSavedInQueue = "{HORUSEC_CLI} Analysis saved in the offline queue, it will be sent in the next " +
+		"successful send or with the command flush-queue. Queue directory: "
+	// Fired when the dependency isn't declared in the manifest, like the transitive dependencies, the {{0}} is the
+	// manifest and {{1}} is the dependency
+	MsgWarnDependencyNotInManifest = "{HORUSEC_CLI} Dependency {{1}} not declared in {{0}}, upgrade the dependency " +
+		"that requires it or add it to the manifest to use the fixed version: "
+	// Fired when the triage endpoint fails or returns an unknown classification, the vulnerability is kept without
+	// triage and the next ones are still sent
+	MsgWarnTriageFailed = "{HORUSEC_CLI} Was not possible triage the vulnerability: "
+	// Fired when the dependency submission is enabled outside of the GitHub Actions or without the GITHUB_TOKEN
+	MsgWarnDependencySubmissionNotInGitHubActions = "{HORUSEC_CLI} The dependencies are only submitted in the " +
+		"GitHub Actions, with the environment variables GITHUB_REPOSITORY, GITHUB_SHA and GITHUB_TOKEN"
+	// Fired when the dependency submission API of GitHub fails, the analysis is not affected
+	MsgWarnDependencySubmissionFailed = "{HORUSEC_CLI} Was not possible submit the dependencies to GitHub: "
+	// Fired when the check run is enabled out of the GitHub Actions, the analysis is not affected
+	MsgWarnCheckRunNotInGitHubActions = "{HORUSEC_CLI} The check run is only published in the GitHub Actions, " +
+		"with the environment variables GITHUB_REPOSITORY, GITHUB_SHA and GITHUB_TOKEN"
+	// Fired when the checks API of GitHub fails, the analysis is not affected
+	MsgWarnCheckRunFailed = "{HORUSEC_CLI} Was not possible publish the check run in GitHub: "
+	// Fired when the gitea url is informed but the repository, the commit and the pull request weren't detected
+	MsgWarnGiteaWithoutSource = "{HORUSEC_CLI} Gitea needs the repository url and the commit or the pull request, " +
+		"detected from the CI environment or informed in the flags source-repository-url, source-commit and " +
+		"source-pull-request"
+	// Fired when the api of Gitea or Forgejo fails, the analysis is not affected
+	MsgWarnGiteaPublishFailed = "{HORUSEC_CLI} Was not possible publish the result of the analysis in Gitea: "
+	// Fired when the bulk indexing of the vulnerabilities in elasticsearch fails, the analysis is not affected
+	MsgWarnElasticsearchIndexFailed = "{HORUSEC_CLI} Was not possible index the vulnerabilities in Elasticsearch: "
+	// Fired when the webhook of an owner fails, the analysis is not affected
+	MsgWarnOwnerNotificationFailed = "{HORUSEC_CLI} Was not possible notify the owner of the vulnerabilities: "
+	// Fired when the analysis can't be stored in the local database, the analysis is not affected
+	MsgWarnStoreLocalDBFailed = "{HORUSEC_CLI} Was not possible store the analysis in the local database: "
+	// Fired when the analysis can't be exported to postgres, the analysis is not affected
+	MsgWarnPostgresExportFailed = "{HORUSEC_CLI} Was not possible export the analysis to PostgreSQL: "
+	// Fired once to each tool warn only with vulnerabilities, they are reported but don't fail the analysis
+	MsgWarnToolWarnOnly = "{HORUSEC_CLI} The tool {{0}} is warn only, its vulnerabilities don't fail the analysis"
+	// Fired when the analysis has more vulnerabilities of a severity than the max findings, with the totals and the max
+	MsgWarnMaxFindingsExceeded = "{HORUSEC_CLI} The analysis has more vulnerabilities than the max findings: "
+	// Fired in the command rules test for each rule with findings missing or unexpected in the fixtures
+	MsgWarnRuleTestFailed = "{HORUSEC_CLI} FAIL {{0}} missing: [{{1}}] unexpected: [{{2}}]"
+	// Fired when the developer mode fault-injection is enabled, with the seed to inject the same faults again
+	MsgWarnFaultInjectionEnabled = "{HORUSEC_CLI} Fault injection enabled, the docker calls are delayed and failed " +
+		"randomly. To inject the same faults use the seed: "
+	// Fired when an object of the remote cache has no valid signature of the public key, then it is not used
+	MsgWarnRemoteCacheInvalidSignature = "{HORUSEC_CLI} Object of the remote cache ignored because its signature " +
+		"is not valid to the public key of the remote cache, it was written without the private key: "
 )
@@ -12,10 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package semgrep
+package version
 
-type Extra struct {
-	Message  string `json:"message"`
-	Severity string `json:"severity"`
-	Code     string `json:"lines"`
-}
+// Version of the horusec cli, replaced in the build of each release by deployments/scripts/update-image.sh
+const Version = "{{VERSION_NOT_FOUND}}"
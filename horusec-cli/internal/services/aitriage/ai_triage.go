@@ -0,0 +1,188 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aitriage
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/secretmask"
+)
+
+const systemPrompt = "You triage the findings of a security analysis tool. For the finding informed by the user, " +
+	"answer only a json object with the fields classification, one of true-positive, false-positive or uncertain, " +
+	"and justification, with one or two sentences explaining the classification. The secrets of the code are " +
+	"redacted with ****."
+
+var ErrInvalidClassification = errors.New("invalid classification in the response of the triage endpoint")
+
+type Interface interface {
+	SetTriages(analysis *horusec.Analysis)
+}
+
+type AITriage struct {
+	config     cliConfig.IConfig
+	httpClient client.Interface
+}
+
+type finding struct {
+	Rule        string   `json:"rule,omitempty"`
+	Tool        string   `json:"tool"`
+	Severity    string   `json:"severity"`
+	Details     string   `json:"details"`
+	File        string   `json:"file"`
+	Line        string   `json:"line"`
+	Code        string   `json:"code"`
+	CodeContext []string `json:"codeContext,omitempty"`
+}
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model       string    `json:"model,omitempty"`
+	Temperature float64   `json:"temperature"`
+	Messages    []message `json:"messages"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message message `json:"message"`
+	} `json:"choices"`
+}
+
+func NewAITriage(config cliConfig.IConfig) Interface {
+	return &AITriage{
+		config:     config,
+		httpClient: client.NewHTTPClient(int(config.GetTimeoutInSecondsRequest())),
+	}
+}
+
+// SetTriages stops when the endpoint is unreachable, to not wait the timeout of each vulnerability
+func (t *AITriage) SetTriages(analysis *horusec.Analysis) {
+	logger.LogInfoWithLevel(strings.ReplaceAll(messages.MsgInfoTriageStarted, "{{0}}",
+		strconv.Itoa(len(analysis.AnalysisVulnerabilities)))+t.config.GetTriageURL(), logger.InfoLevel)
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		content, err := t.sendFinding(t.newFinding(vulnerability))
+		if err != nil {
+			logger.LogWarnWithLevel(messages.MsgWarnTriageFailed, logger.WarnLevel, err.Error())
+			return
+		}
+		if vulnerability.Triage, err = t.parseTriage(content); err != nil {
+			logger.LogWarnWithLevel(messages.MsgWarnTriageFailed, logger.WarnLevel, err.Error())
+		}
+	}
+}
+
+// newFinding redacts the secrets even when they are revealed, they are never sent to the triage endpoint
+func (t *AITriage) newFinding(vulnerability *horusec.Vulnerability) *finding {
+	redact := secretmask.Mask
+	if vulnerability.SecurityTool == tools.HorusecLeaks || vulnerability.SecurityTool == tools.GitLeaks {
+		redact = secretmask.MaskLeakCode
+	}
+	output := &finding{
+		Rule:     vulnerability.RuleID,
+		Tool:     vulnerability.SecurityTool.ToString(),
+		Severity: vulnerability.Severity.ToString(),
+		Details:  secretmask.Mask(vulnerability.Details),
+		File:     vulnerability.File,
+		Line:     vulnerability.Line,
+		Code:     redact(vulnerability.Code),
+	}
+	for _, codeLine := range vulnerability.CodeContext {
+		output.CodeContext = append(output.CodeContext, redact(codeLine.Code))
+	}
+	return output
+}
+
+func (t *AITriage) sendFinding(output *finding) (string, error) {
+	findingBytes, err := json.Marshal(output)
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(&chatRequest{
+		Model:    t.config.GetTriageModel(),
+		Messages: []message{{Role: "system", Content: systemPrompt}, {Role: "user", Content: string(findingBytes)}},
+	})
+	if err != nil {
+		return "", err
+	}
+	return t.doRequest(body)
+}
+
+func (t *AITriage) doRequest(body []byte) (string, error) {
+	req, err := http.NewRequest(http.MethodPost, t.config.GetTriageURL(), bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if t.config.GetTriageAPIKey() != "" {
+		req.Header.Set("Authorization", "Bearer "+t.config.GetTriageAPIKey())
+	}
+	response, err := t.httpClient.DoRequest(req, &tls.Config{})
+	if err != nil {
+		return "", err
+	}
+	defer response.CloseBody()
+	if response.GetStatusCode() != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d from triage endpoint", response.GetStatusCode())
+	}
+	return t.getContent(response.GetBody())
+}
+
+func (t *AITriage) getContent(body []byte, err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	chat := &chatResponse{}
+	if err := json.Unmarshal(body, chat); err != nil {
+		return "", err
+	}
+	if len(chat.Choices) == 0 {
+		return "", errors.New("response of the triage endpoint without choices")
+	}
+	return chat.Choices[0].Message.Content, nil
+}
+
+// parseTriage ignores the text around the json object, like the markdown fences added by some models
+func (t *AITriage) parseTriage(content string) (*horusec.Triage, error) {
+	start, end := strings.Index(content, "{"), strings.LastIndex(content, "}")
+	if start < 0 || end < start {
+		return nil, ErrInvalidClassification
+	}
+	triage := &horusec.Triage{}
+	if err := json.Unmarshal([]byte(content[start:end+1]), triage); err != nil {
+		return nil, err
+	}
+	if !triage.Classification.IsValid() {
+		return nil, ErrInvalidClassification
+	}
+	return triage, nil
+}
@@ -0,0 +1,208 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifacts
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+const (
+	toolsDir   = "tools"
+	reportsDir = "reports"
+	// DefaultDir is used to save the raw outputs of the tools with saveRawOutput when the artifacts dir is empty
+	DefaultDir = "horusec-artifacts"
+)
+
+type Interface interface {
+	SaveToolOutput(tool tools.Tool, projectSubPath, output string) string
+	SaveParsedVulnerabilities(analysis *horusec.Analysis)
+	SetRawOutputPaths(analysis *horusec.Analysis)
+	SaveAnalysis(analysis *horusec.Analysis)
+}
+
+type Artifacts struct {
+	config cliConfig.IConfig
+}
+
+type Timings struct {
+	TotalInSeconds float64                `json:"totalInSeconds"`
+	Tools          map[tools.Tool]float64 `json:"tools"`
+}
+
+// NewArtifacts writes everything of the analysis in the artifacts dir, so the parsing of the outputs of the tools
+// can be reproduced without run the analysis again. Nothing is written when the artifacts dir is empty
+func NewArtifacts(config cliConfig.IConfig) Interface {
+	return &Artifacts{
+		config: config,
+	}
+}
+
+// SaveToolOutput writes the output of the container of the tool without changes and returns the path of the file.
+// The containers run with tty, so the stderr of the tool is in the same output
+func (a *Artifacts) SaveToolOutput(tool tools.Tool, projectSubPath, output string) string {
+	dir := a.getToolOutputDir(tool)
+	if dir == "" {
+		return ""
+	}
+
+	path := filepath.Join(dir, toolsDir, a.getToolFileName(tool, projectSubPath)+".output.txt")
+	if err := a.write(path, []byte(output)); err != nil {
+		return ""
+	}
+	return path
+}
+
+func (a *Artifacts) getToolOutputDir(tool tools.Tool) string {
+	if a.config.GetArtifactsDir() == "" && a.isToSaveRawOutput(tool) {
+		return DefaultDir
+	}
+	return a.config.GetArtifactsDir()
+}
+
+func (a *Artifacts) isToSaveRawOutput(tool tools.Tool) bool {
+	return a.config.GetToolsConfig()[tool].SaveRawOutput
+}
+
+func (a *Artifacts) getToolFileName(tool tools.Tool, projectSubPath string) string {
+	projectSubPath = strings.Trim(filepath.ToSlash(projectSubPath), "/.")
+	if projectSubPath == "" {
+		return tool.ToString()
+	}
+	return tool.ToString() + "_" + strings.ReplaceAll(projectSubPath, "/", "_")
+}
+
+// SaveParsedVulnerabilities writes the vulnerabilities of each tool as parsed by the formatters, before the
+// severities, triages and the other changes done in the analysis
+func (a *Artifacts) SaveParsedVulnerabilities(analysis *horusec.Analysis) {
+	if a.config.GetArtifactsDir() == "" {
+		return
+	}
+
+	vulnerabilitiesByTool := map[tools.Tool][]horusec.Vulnerability{}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := analysis.AnalysisVulnerabilities[index].Vulnerability
+		vulnerabilitiesByTool[vulnerability.SecurityTool] =
+			append(vulnerabilitiesByTool[vulnerability.SecurityTool], vulnerability)
+	}
+
+	for tool, vulnerabilities := range vulnerabilitiesByTool {
+		a.writeJSON(filepath.Join(a.config.GetArtifactsDir(), toolsDir, tool.ToString()+".parsed.json"),
+			vulnerabilities)
+	}
+}
+
+// SetRawOutputPaths references the raw output in the vulnerabilities of the tools with saveRawOutput. When the tool
+// ran in more than one project sub path, the output is of the project sub path of the file of the vulnerability
+func (a *Artifacts) SetRawOutputPaths(analysis *horusec.Analysis) {
+	if analysis.ScanManifest == nil {
+		return
+	}
+
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		if a.isToSaveRawOutput(vulnerability.SecurityTool) {
+			vulnerability.RawOutputPath = a.getRawOutputPath(analysis.ScanManifest, vulnerability)
+		}
+	}
+}
+
+func (a *Artifacts) getRawOutputPath(manifest *horusec.ScanManifest, vulnerability *horusec.Vulnerability) string {
+	rawOutputPath, longestSubPath := "", -1
+	file := filepath.ToSlash(vulnerability.File)
+	for index := range manifest.ToolsExecuted {
+		execution := manifest.ToolsExecuted[index]
+		if execution.Tool != vulnerability.SecurityTool || execution.RawOutputPath == "" {
+			continue
+		}
+
+		if rawOutputPath == "" {
+			rawOutputPath = execution.RawOutputPath
+		}
+		subPath := strings.Trim(filepath.ToSlash(execution.ProjectSubPath), "/.")
+		if strings.HasPrefix(file, subPath) && len(subPath) > longestSubPath {
+			rawOutputPath, longestSubPath = execution.RawOutputPath, len(subPath)
+		}
+	}
+	return rawOutputPath
+}
+
+// SaveAnalysis writes the final analysis, the scan manifest, the timings and a copy of the report written in the
+// json output file path
+func (a *Artifacts) SaveAnalysis(analysis *horusec.Analysis) {
+	if a.config.GetArtifactsDir() == "" {
+		return
+	}
+
+	a.writeJSON(filepath.Join(a.config.GetArtifactsDir(), "analysis.json"), analysis)
+	if analysis.ScanManifest != nil {
+		a.writeJSON(filepath.Join(a.config.GetArtifactsDir(), "scan-manifest.json"), analysis.ScanManifest)
+	}
+	a.writeJSON(filepath.Join(a.config.GetArtifactsDir(), "timings.json"), a.getTimings(analysis))
+	a.copyReport(a.config.GetJSONOutputFilePath())
+}
+
+func (a *Artifacts) getTimings(analysis *horusec.Analysis) *Timings {
+	timings := &Timings{
+		TotalInSeconds: analysis.FinishedAt.Sub(analysis.CreatedAt).Seconds(),
+		Tools:          map[tools.Tool]float64{},
+	}
+	if analysis.ScanManifest != nil {
+		timings.Tools = analysis.ScanManifest.GetDurationByTool()
+	}
+	return timings
+}
+
+func (a *Artifacts) copyReport(reportPath string) {
+	if reportPath == "" {
+		return
+	}
+
+	content, err := ioutil.ReadFile(reportPath)
+	if err != nil {
+		return
+	}
+
+	_ = a.write(filepath.Join(a.config.GetArtifactsDir(), reportsDir, filepath.Base(reportPath)), content)
+}
+
+func (a *Artifacts) writeJSON(path string, content interface{}) {
+	bytes, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorSaveArtifact, err, logger.ErrorLevel)
+		return
+	}
+
+	_ = a.write(path, bytes)
+}
+
+func (a *Artifacts) write(path string, content []byte) error {
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err == nil {
+		err = ioutil.WriteFile(path, content, 0600)
+	}
+
+	logger.LogErrorWithLevel(messages.MsgErrorSaveArtifact, err, logger.ErrorLevel, map[string]interface{}{"path": path})
+	return err
+}
@@ -0,0 +1,144 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package artifacts
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/test"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newConfigWithArtifactsDir(t *testing.T) (*cliConfig.Config, string) {
+	dir, err := ioutil.TempDir("", "horusec-artifacts")
+	assert.NoError(t, err)
+	config := &cliConfig.Config{}
+	config.SetArtifactsDir(dir)
+	return config, dir
+}
+
+func TestSaveToolOutput(t *testing.T) {
+	t.Run("should write the output of the tool by project sub path", func(t *testing.T) {
+		config, dir := newConfigWithArtifactsDir(t)
+		defer os.RemoveAll(dir)
+
+		path := NewArtifacts(config).SaveToolOutput(tools.GoSec, "api/v1", "raw output")
+
+		assert.Equal(t, filepath.Join(dir, "tools", "GoSec_api_v1.output.txt"), path)
+		content, err := ioutil.ReadFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, "raw output", string(content))
+	})
+
+	t.Run("should not write when the artifacts dir is empty", func(t *testing.T) {
+		assert.Empty(t, NewArtifacts(&cliConfig.Config{}).SaveToolOutput(tools.GoSec, "", "raw output"))
+	})
+
+	t.Run("should write in the default dir when the tool saves the raw output", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "horusec-artifacts")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		workingDir, _ := os.Getwd()
+		assert.NoError(t, os.Chdir(dir))
+		defer func() { _ = os.Chdir(workingDir) }()
+
+		config := &cliConfig.Config{}
+		config.SetToolsConfig(map[string]interface{}{"gosec": map[string]interface{}{"saverawoutput": true}})
+		path := NewArtifacts(config).SaveToolOutput(tools.GoSec, "", "raw output")
+
+		assert.Equal(t, filepath.Join(DefaultDir, "tools", "GoSec.output.txt"), path)
+		assert.FileExists(t, filepath.Join(dir, path))
+		assert.Empty(t, NewArtifacts(config).SaveToolOutput(tools.Bandit, "", "raw output"))
+	})
+}
+
+func TestSetRawOutputPaths(t *testing.T) {
+	t.Run("should reference the output of the project sub path of the file", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetToolsConfig(map[string]interface{}{"gosec": map[string]interface{}{"saverawoutput": true}})
+		analysis := &horusec.Analysis{
+			AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+				{Vulnerability: horusec.Vulnerability{SecurityTool: tools.GoSec, File: "api/main.go"}},
+				{Vulnerability: horusec.Vulnerability{SecurityTool: tools.GoSec, File: "main.go"}},
+				{Vulnerability: horusec.Vulnerability{SecurityTool: tools.Bandit, File: "api/main.py"}},
+			},
+			ScanManifest: &horusec.ScanManifest{ToolsExecuted: []horusec.ToolExecution{
+				{Tool: tools.GoSec, ProjectSubPath: "", RawOutputPath: "tools/GoSec.output.txt"},
+				{Tool: tools.GoSec, ProjectSubPath: "api", RawOutputPath: "tools/GoSec_api.output.txt"},
+				{Tool: tools.Bandit, ProjectSubPath: "", RawOutputPath: "tools/Bandit.output.txt"},
+			}},
+		}
+
+		NewArtifacts(config).SetRawOutputPaths(analysis)
+
+		assert.Equal(t, "tools/GoSec_api.output.txt", analysis.AnalysisVulnerabilities[0].Vulnerability.RawOutputPath)
+		assert.Equal(t, "tools/GoSec.output.txt", analysis.AnalysisVulnerabilities[1].Vulnerability.RawOutputPath)
+		assert.Empty(t, analysis.AnalysisVulnerabilities[2].Vulnerability.RawOutputPath)
+	})
+}
+
+func TestSaveParsedVulnerabilities(t *testing.T) {
+	t.Run("should write the vulnerabilities of each tool", func(t *testing.T) {
+		config, dir := newConfigWithArtifactsDir(t)
+		defer os.RemoveAll(dir)
+
+		NewArtifacts(config).SaveParsedVulnerabilities(test.CreateAnalysisMock())
+
+		content, err := ioutil.ReadFile(filepath.Join(dir, "tools", "GoSec.parsed.json"))
+		assert.NoError(t, err)
+		var vulnerabilities []horusec.Vulnerability
+		assert.NoError(t, json.Unmarshal(content, &vulnerabilities))
+		assert.NotEmpty(t, vulnerabilities)
+		for index := range vulnerabilities {
+			assert.Equal(t, tools.GoSec, vulnerabilities[index].SecurityTool)
+		}
+	})
+}
+
+func TestSaveAnalysis(t *testing.T) {
+	t.Run("should write the analysis, the scan manifest, the timings and the report", func(t *testing.T) {
+		config, dir := newConfigWithArtifactsDir(t)
+		defer os.RemoveAll(dir)
+		reportPath := filepath.Join(dir, "horusec.json")
+		assert.NoError(t, ioutil.WriteFile(reportPath, []byte("{}"), 0600))
+		config.SetJSONOutputFilePath(reportPath)
+
+		analysis := test.CreateAnalysisMock()
+		analysis.CreatedAt = time.Now().Add(-time.Minute)
+		analysis.FinishedAt = time.Now()
+		analysis.ScanManifest = &horusec.ScanManifest{
+			ToolsExecuted: []horusec.ToolExecution{{Tool: tools.GoSec, DurationInSeconds: 10}},
+		}
+		NewArtifacts(config).SaveAnalysis(analysis)
+
+		for _, name := range []string{"analysis.json", "scan-manifest.json", filepath.Join("reports", "horusec.json")} {
+			assert.FileExists(t, filepath.Join(dir, name))
+		}
+		content, err := ioutil.ReadFile(filepath.Join(dir, "timings.json"))
+		assert.NoError(t, err)
+		timings := &Timings{}
+		assert.NoError(t, json.Unmarshal(content, timings))
+		assert.InDelta(t, 60, timings.TotalInSeconds, 1)
+		assert.Equal(t, float64(10), timings.Tools[tools.GoSec])
+	})
+}
@@ -0,0 +1,257 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/version"
+	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/images"
+	"github.com/google/uuid"
+)
+
+const (
+	StatementType = "https://in-toto.io/Statement/v0.1"
+	PredicateType = "https://slsa.dev/provenance/v0.2"
+	BuilderID     = "https://github.com/ZupIT/horusec"
+	BuildType     = "https://github.com/ZupIT/horusec/analysis@v1"
+	// Extensions of the files written next to the json report
+	StatementExtension = ".intoto.json"
+	BundleExtension    = ".bundle"
+)
+
+var ErrCosignNotFound = errors.New("cosign is not installed or is not in the PATH")
+
+type Interface interface {
+	SignReport(analysis *horusec.Analysis) error
+}
+
+type Attestation struct {
+	config       cliConfig.IConfig
+	dockerClient dockerClient.Interface
+	runCosign    func(args ...string) error
+}
+
+func NewAttestation(config cliConfig.IConfig, client dockerClient.Interface) Interface {
+	return &Attestation{
+		config:       config,
+		dockerClient: client,
+		runCosign:    runCosign,
+	}
+}
+
+// SignReport writes the in-toto statement of the json report already printed and signs it with cosign keyless, so
+// the report can be verified as the output of this analysis
+func (a *Attestation) SignReport(analysis *horusec.Analysis) error {
+	reportPath, err := filepath.Abs(a.config.GetJSONOutputFilePath())
+	if err != nil {
+		return err
+	}
+	statement, err := a.newStatement(analysis, reportPath)
+	if err != nil {
+		return err
+	}
+	statementPath := reportPath + StatementExtension
+	if err := a.writeStatement(statement, statementPath); err != nil {
+		return err
+	}
+	if err := a.runCosign("sign-blob", "--yes", "--bundle", statementPath+BundleExtension, statementPath); err != nil {
+		return err
+	}
+	logger.LogInfoWithLevel(strings.ReplaceAll(messages.MsgInfoReportSigned, "{{0}}", statementPath), logger.InfoLevel)
+	return nil
+}
+
+func (a *Attestation) newStatement(analysis *horusec.Analysis, reportPath string) (*Statement, error) {
+	reportDigest, err := getFileDigest(reportPath)
+	if err != nil {
+		return nil, err
+	}
+	return &Statement{
+		Type:          StatementType,
+		Subject:       []Subject{{Name: filepath.Base(reportPath), Digest: map[string]string{"sha256": reportDigest}}},
+		PredicateType: PredicateType,
+		Predicate: Predicate{
+			Builder:    Builder{ID: BuilderID},
+			BuildType:  BuildType,
+			Invocation: a.getInvocation(analysis),
+			Metadata:   getMetadata(analysis),
+			Materials:  append(getSourceMaterials(analysis.Source), a.getImagesMaterials(analysis)...),
+		},
+	}, nil
+}
+
+func (a *Attestation) getInvocation(analysis *horusec.Analysis) Invocation {
+	parameters := map[string]string{"horusecVersion": version.Version}
+	if analysis.ID != uuid.Nil {
+		parameters["analysisID"] = analysis.ID.String()
+	}
+	if a.config.GetRepositoryName() != "" {
+		parameters["repositoryName"] = a.config.GetRepositoryName()
+	}
+	return Invocation{Parameters: parameters}
+}
+
+// getMetadata returns nil when the dates were removed by the deterministic mode
+func getMetadata(analysis *horusec.Analysis) *Metadata {
+	if analysis.CreatedAt.IsZero() || analysis.FinishedAt.IsZero() {
+		return nil
+	}
+	createdAt, finishedAt := analysis.CreatedAt.UTC(), analysis.FinishedAt.UTC()
+	return &Metadata{BuildStartedOn: &createdAt, BuildFinishedOn: &finishedAt}
+}
+
+func getSourceMaterials(source *horusec.SourceContext) []Material {
+	if source == nil || source.CommitSHA == "" {
+		return []Material{}
+	}
+	uri := source.RepositoryURL
+	if uri == "" {
+		uri = "git"
+	}
+	return []Material{{URI: "git+" + uri, Digest: map[string]string{"sha1": source.CommitSHA}}}
+}
+
+// getImagesMaterials uses the images of the tools executed in the scan manifest, or when the analysis has no manifest
+// the images of the tools not ignored that are present in the local docker, the images not pulled weren't used
+func (a *Attestation) getImagesMaterials(analysis *horusec.Analysis) (materials []Material) {
+	if analysis.ScanManifest != nil {
+		return getManifestMaterials(analysis.ScanManifest)
+	}
+	toolsConfig := a.config.GetToolsConfig()
+	for _, image := range images.Values() {
+		imagePath := image.GetFullImagePath()
+		if toolConfig, ok := toolsConfig[image.Tool]; ok {
+			if toolConfig.IsToIgnore {
+				continue
+			}
+			if toolConfig.ImagePath != "" {
+				imagePath = toolConfig.ImagePath
+			}
+		}
+		if material := a.getImageMaterial(imagePath); material != nil {
+			materials = append(materials, *material)
+		}
+	}
+	return materials
+}
+
+func getManifestMaterials(manifest *horusec.ScanManifest) (materials []Material) {
+	added := map[string]bool{}
+	for _, execution := range manifest.ToolsExecuted {
+		if material := newImageMaterial(execution.Image, execution.ImageDigest); material != nil && !added[material.URI] {
+			added[material.URI] = true
+			materials = append(materials, *material)
+		}
+	}
+	return materials
+}
+
+func (a *Attestation) getImageMaterial(imagePath string) *Material {
+	digest, err := dockerClient.GetLocalDigest(a.dockerClient, imagePath)
+	if err != nil {
+		return nil
+	}
+	return newImageMaterial(imagePath, digest)
+}
+
+func newImageMaterial(imagePath, digest string) *Material {
+	if !strings.Contains(digest, ":") {
+		return nil
+	}
+	algorithmAndValue := strings.SplitN(digest, ":", 2)
+	return &Material{URI: "docker://" + imagePath, Digest: map[string]string{algorithmAndValue[0]: algorithmAndValue[1]}}
+}
+
+func (a *Attestation) writeStatement(statement *Statement, statementPath string) error {
+	content, err := json.MarshalIndent(statement, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(statementPath, content, 0600)
+}
+
+func getFileDigest(path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func runCosign(args ...string) error {
+	if _, err := exec.LookPath("cosign"); err != nil {
+		return ErrCosignNotFound
+	}
+	output, err := exec.Command("cosign", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s%w: %s", messages.MsgErrorSignReport, err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// Statement is the in-toto statement with the slsa provenance of the report
+type Statement struct {
+	Type          string    `json:"_type"`
+	Subject       []Subject `json:"subject"`
+	PredicateType string    `json:"predicateType"`
+	Predicate     Predicate `json:"predicate"`
+}
+
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+type Predicate struct {
+	Builder    Builder    `json:"builder"`
+	BuildType  string     `json:"buildType"`
+	Invocation Invocation `json:"invocation"`
+	Metadata   *Metadata  `json:"metadata,omitempty"`
+	Materials  []Material `json:"materials"`
+}
+
+type Builder struct {
+	ID string `json:"id"`
+}
+
+type Invocation struct {
+	Parameters map[string]string `json:"parameters"`
+}
+
+type Metadata struct {
+	BuildStartedOn  *time.Time `json:"buildStartedOn,omitempty"`
+	BuildFinishedOn *time.Time `json:"buildFinishedOn,omitempty"`
+}
+
+type Material struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest"`
+}
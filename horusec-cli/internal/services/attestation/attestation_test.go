@@ -0,0 +1,155 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attestation
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
+	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/images"
+)
+
+func writeReport(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "horusec-attestation")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	path := filepath.Join(dir, "output.json")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func readStatement(t *testing.T, path string) *Statement {
+	content, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	statement := &Statement{}
+	assert.NoError(t, json.Unmarshal(content, statement))
+	return statement
+}
+
+func newAttestation(config cliConfig.IConfig, dockerMock *dockerClient.Mock, cosignArgs *[]string) *Attestation {
+	return &Attestation{
+		config:       config,
+		dockerClient: dockerMock,
+		runCosign: func(args ...string) error {
+			*cosignArgs = args
+			return nil
+		},
+	}
+}
+
+func TestSignReport(t *testing.T) {
+	t.Run("Should write the statement of the report and sign it with cosign", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetJSONOutputFilePath(writeReport(t, "{}"))
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{GoSec: toolsconfig.ToolConfig{IsToIgnore: true}})
+		dockerMock := &dockerClient.Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{
+			{ID: "sha256:123", RepoDigests: []string{"horuszup/image@sha256:456"}}}, nil)
+		var cosignArgs []string
+		analysis := &horusec.Analysis{ID: uuid.New(), CreatedAt: time.Now(), FinishedAt: time.Now(),
+			Source: &horusec.SourceContext{RepositoryURL: "https://github.com/ZupIT/horusec", CommitSHA: "abc"}}
+
+		err := newAttestation(config, dockerMock, &cosignArgs).SignReport(analysis)
+
+		assert.NoError(t, err)
+		statementPath := config.GetJSONOutputFilePath() + StatementExtension
+		assert.Equal(t, []string{"sign-blob", "--yes", "--bundle", statementPath + BundleExtension, statementPath},
+			cosignArgs)
+		statement := readStatement(t, statementPath)
+		assert.Equal(t, StatementType, statement.Type)
+		assert.Equal(t, PredicateType, statement.PredicateType)
+		assert.Equal(t, []Subject{{Name: "output.json", Digest: map[string]string{
+			"sha256": "44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a"}}}, statement.Subject)
+		assert.Equal(t, analysis.ID.String(), statement.Predicate.Invocation.Parameters["analysisID"])
+		assert.NotNil(t, statement.Predicate.Metadata)
+		assert.Len(t, statement.Predicate.Materials, len(images.Values()))
+		assert.Equal(t, Material{URI: "git+https://github.com/ZupIT/horusec", Digest: map[string]string{"sha1": "abc"}},
+			statement.Predicate.Materials[0])
+		assert.Equal(t, map[string]string{"sha256": "456"}, statement.Predicate.Materials[1].Digest)
+	})
+
+	t.Run("Should write the statement without dates and images when removed or not present", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetJSONOutputFilePath(writeReport(t, "{}"))
+		dockerMock := &dockerClient.Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{}, nil)
+		var cosignArgs []string
+
+		err := newAttestation(config, dockerMock, &cosignArgs).SignReport(&horusec.Analysis{})
+
+		assert.NoError(t, err)
+		statement := readStatement(t, config.GetJSONOutputFilePath()+StatementExtension)
+		assert.Nil(t, statement.Predicate.Metadata)
+		assert.Empty(t, statement.Predicate.Materials)
+		assert.NotContains(t, statement.Predicate.Invocation.Parameters, "analysisID")
+	})
+
+	t.Run("Should use the images of the tools executed in the scan manifest", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetJSONOutputFilePath(writeReport(t, "{}"))
+		var cosignArgs []string
+		analysis := &horusec.Analysis{ScanManifest: &horusec.ScanManifest{ToolsExecuted: []horusec.ToolExecution{
+			{Tool: tools.GoSec, ProjectSubPath: "api", Image: "docker.io/horuszup/gosec:v1.0.0", ImageDigest: "sha256:1"},
+			{Tool: tools.GoSec, ProjectSubPath: "cli", Image: "docker.io/horuszup/gosec:v1.0.0", ImageDigest: "sha256:1"},
+			{Tool: tools.HorusecDockerfile},
+		}}}
+
+		err := newAttestation(config, &dockerClient.Mock{}, &cosignArgs).SignReport(analysis)
+
+		assert.NoError(t, err)
+		statement := readStatement(t, config.GetJSONOutputFilePath()+StatementExtension)
+		assert.Equal(t, []Material{{URI: "docker://docker.io/horuszup/gosec:v1.0.0",
+			Digest: map[string]string{"sha256": "1"}}}, statement.Predicate.Materials)
+	})
+
+	t.Run("Should return error when the report was not written", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetJSONOutputFilePath(filepath.Join(os.TempDir(), "horusec-not-found", "output.json"))
+		var cosignArgs []string
+
+		err := newAttestation(config, &dockerClient.Mock{}, &cosignArgs).SignReport(&horusec.Analysis{})
+
+		assert.Error(t, err)
+		assert.Empty(t, cosignArgs)
+	})
+
+	t.Run("Should return error when cosign fails", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetJSONOutputFilePath(writeReport(t, "{}"))
+		dockerMock := &dockerClient.Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{}, errors.New("test"))
+		attestation := &Attestation{config: config, dockerClient: dockerMock, runCosign: func(args ...string) error {
+			return ErrCosignNotFound
+		}}
+
+		assert.Equal(t, ErrCosignNotFound, attestation.SignReport(&horusec.Analysis{}))
+	})
+}
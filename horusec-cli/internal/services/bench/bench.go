@@ -0,0 +1,259 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bench
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rulepack"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+)
+
+const (
+	// StageAll runs the whole analysis, the other stages ignore the tools not benchmarked
+	StageAll    = "all"
+	StageCopy   = "copy"
+	StageEngine = "engine"
+
+	stageTotal = "total"
+	bytesInMB  = 1024 * 1024
+)
+
+var ErrInvalidStage = errors.New("{HORUSEC_CLI} the stage of the bench must be all, copy, engine or a tool")
+
+// Result is the time of each stage of one run, the stages are the copy, the engines and each tool, the tools that
+// run once for each project sub path have the sum of its executions
+type Result struct {
+	Duration         time.Duration
+	MaxMemoryInBytes int64
+	Stages           map[string]time.Duration
+}
+
+// Summary is the min, average and max of a stage in all the runs where the stage was executed
+type Summary struct {
+	Stage string
+	Runs  int
+	Min   time.Duration
+	Avg   time.Duration
+	Max   time.Duration
+}
+
+type Runner interface {
+	Run(projectPath string, args []string) (*Result, error)
+}
+
+// execRunner runs the analysis with the command start of the horusec binary itself, like the scheduler of the
+// server, so the memory of each run is measured in its own process
+type execRunner struct {
+	executable string
+	logLevel   string
+}
+
+func NewRunner(logLevel string) (Runner, error) {
+	executable, err := os.Executable()
+	return &execRunner{executable: executable, logLevel: logLevel}, err
+}
+
+func (e *execRunner) Run(projectPath string, args []string) (*Result, error) {
+	reportDir, err := ioutil.TempDir("", "horusec-bench")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(reportDir)
+	reportPath := filepath.Join(reportDir, "report.json")
+	command := exec.Command(e.executable, append([]string{"start", "-p", projectPath, "-o", "json", "-O", reportPath,
+		"--no-cache", "--log-level", e.logLevel}, args...)...)
+	startedAt := time.Now()
+	output, err := command.CombinedOutput()
+	duration := time.Since(startedAt)
+	// the report is used even when the gates of the analysis return error
+	analysis, readErr := readReport(reportPath)
+	if readErr == nil {
+		return NewResult(analysis, duration, getMaxMemoryInBytes(command.ProcessState)), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil, readErr
+}
+
+func readReport(reportPath string) (*horusec.Analysis, error) {
+	content, err := ioutil.ReadFile(reportPath)
+	if err != nil {
+		return nil, err
+	}
+	analysis := &horusec.Analysis{}
+	return analysis, json.Unmarshal(content, analysis)
+}
+
+// NewResult reads the time of the stages of the scan manifest of the analysis
+func NewResult(analysis *horusec.Analysis, duration time.Duration, maxMemoryInBytes int64) *Result {
+	result := &Result{Duration: duration, MaxMemoryInBytes: maxMemoryInBytes,
+		Stages: map[string]time.Duration{stageTotal: duration}}
+	if analysis.ScanManifest == nil {
+		return result
+	}
+	result.Stages[StageCopy] = toDuration(analysis.ScanManifest.CopyDurationInSeconds)
+	for _, execution := range analysis.ScanManifest.ToolsExecuted {
+		toolDuration := toDuration(execution.DurationInSeconds)
+		result.Stages[execution.Tool.ToString()] += toolDuration
+		if IsEngine(execution.Tool) {
+			result.Stages[StageEngine] += toolDuration
+		}
+	}
+	return result
+}
+
+func toDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// IsEngine returns if the tool is one of the horusec engines, that have rule packs
+func IsEngine(tool tools.Tool) bool {
+	_, ok := rulepack.GetBuiltinPacks()[tool]
+	return ok
+}
+
+// GetStages returns the stages accepted by the bench, the tools are the stages of a single tool
+func GetStages() (stages []string) {
+	stages = []string{StageAll, StageCopy, StageEngine}
+	for _, tool := range tools.Values() {
+		stages = append(stages, tool.ToString())
+	}
+	return stages
+}
+
+// GetStageArgs returns the args of the command start to run only the stage, ignoring the other tools
+func GetStageArgs(stage string) ([]string, error) {
+	isTool := false
+	for _, tool := range tools.Values() {
+		isTool = isTool || strings.EqualFold(tool.ToString(), stage)
+	}
+	if stage != StageAll && stage != StageCopy && stage != StageEngine && !isTool {
+		return nil, ErrInvalidStage
+	}
+	var toolsToIgnore []string
+	for _, tool := range tools.Values() {
+		if isToIgnoreInStage(stage, tool) {
+			toolsToIgnore = append(toolsToIgnore, tool.ToString())
+		}
+	}
+	if len(toolsToIgnore) == 0 {
+		return nil, nil
+	}
+	return []string{"--tools-ignore", strings.Join(toolsToIgnore, ",")}, nil
+}
+
+func isToIgnoreInStage(stage string, tool tools.Tool) bool {
+	switch stage {
+	case StageAll:
+		return false
+	case StageCopy:
+		return true
+	case StageEngine:
+		return !IsEngine(tool)
+	}
+	return !strings.EqualFold(tool.ToString(), stage)
+}
+
+// Summarize returns the summary of the total, copy and engine stages first and then of the tools by name
+func Summarize(results []*Result) (summaries []Summary) {
+	for _, stage := range getStagesOfResults(results) {
+		summary := Summary{Stage: stage}
+		for _, result := range results {
+			duration, ok := result.Stages[stage]
+			if !ok {
+				continue
+			}
+			summary.add(duration)
+		}
+		summary.Avg /= time.Duration(summary.Runs)
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+func (s *Summary) add(duration time.Duration) {
+	if s.Runs == 0 || duration < s.Min {
+		s.Min = duration
+	}
+	if duration > s.Max {
+		s.Max = duration
+	}
+	s.Avg += duration
+	s.Runs++
+}
+
+func getStagesOfResults(results []*Result) []string {
+	found := map[string]bool{}
+	var toolStages []string
+	for _, result := range results {
+		for stage := range result.Stages {
+			if !found[stage] && stage != stageTotal && stage != StageCopy && stage != StageEngine {
+				toolStages = append(toolStages, stage)
+			}
+			found[stage] = true
+		}
+	}
+	sort.Strings(toolStages)
+	var stages []string
+	for _, stage := range []string{stageTotal, StageCopy, StageEngine} {
+		if found[stage] {
+			stages = append(stages, stage)
+		}
+	}
+	return append(stages, toolStages...)
+}
+
+// Print writes the table with the summary of the stages and the max memory of the process of the cli in each run,
+// the tools run in containers, so their memory isn't in the memory of the cli
+func Print(writer io.Writer, results []*Result) error {
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(table, "STAGE\tRUNS\tMIN\tAVG\tMAX")
+	for _, summary := range Summarize(results) {
+		_, _ = fmt.Fprintf(table, "%s\t%d\t%s\t%s\t%s\n", summary.Stage, summary.Runs, formatDuration(summary.Min),
+			formatDuration(summary.Avg), formatDuration(summary.Max))
+	}
+	_, _ = fmt.Fprintln(table)
+	_, _ = fmt.Fprintln(table, "RUN\tDURATION\tMAX MEMORY OF THE CLI")
+	for index, result := range results {
+		_, _ = fmt.Fprintf(table, "%d\t%s\t%s\n", index+1, formatDuration(result.Duration),
+			formatMemory(result.MaxMemoryInBytes))
+	}
+	return table.Flush()
+}
+
+func formatDuration(duration time.Duration) string {
+	return duration.Round(time.Millisecond).String()
+}
+
+func formatMemory(bytes int64) string {
+	if bytes <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f MB", float64(bytes)/bytesInMB)
+}
@@ -0,0 +1,125 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bench
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysisToTest() *horusec.Analysis {
+	return &horusec.Analysis{ScanManifest: &horusec.ScanManifest{
+		CopyDurationInSeconds: 0.5,
+		ToolsExecuted: []horusec.ToolExecution{
+			{Tool: tools.GoSec, DurationInSeconds: 2},
+			{Tool: tools.HorusecJava, ProjectSubPath: "api", DurationInSeconds: 1},
+			{Tool: tools.HorusecJava, ProjectSubPath: "web", DurationInSeconds: 1},
+			{Tool: tools.HorusecLeaks, DurationInSeconds: 3},
+		},
+	}}
+}
+
+func TestNewResult(t *testing.T) {
+	t.Run("Should sum the durations of the tools and of the engines", func(t *testing.T) {
+		result := NewResult(newAnalysisToTest(), 10*time.Second, 1024)
+
+		assert.Equal(t, 10*time.Second, result.Stages[stageTotal])
+		assert.Equal(t, 500*time.Millisecond, result.Stages[StageCopy])
+		assert.Equal(t, 5*time.Second, result.Stages[StageEngine])
+		assert.Equal(t, 2*time.Second, result.Stages[tools.HorusecJava.ToString()])
+		assert.Equal(t, 2*time.Second, result.Stages[tools.GoSec.ToString()])
+	})
+	t.Run("Should return only the total without the scan manifest", func(t *testing.T) {
+		result := NewResult(&horusec.Analysis{}, time.Second, 0)
+
+		assert.Len(t, result.Stages, 1)
+	})
+}
+
+func TestSummarize(t *testing.T) {
+	t.Run("Should return the min, avg and max of each stage ordered", func(t *testing.T) {
+		first := NewResult(newAnalysisToTest(), 10*time.Second, 0)
+		second := NewResult(newAnalysisToTest(), 20*time.Second, 0)
+		delete(second.Stages, tools.GoSec.ToString())
+
+		summaries := Summarize([]*Result{first, second})
+
+		var stages []string
+		for _, summary := range summaries {
+			stages = append(stages, summary.Stage)
+		}
+		assert.Equal(t, []string{"total", "copy", "engine", "GoSec", "HorusecJava", "HorusecLeaks"}, stages)
+		assert.Equal(t, Summary{Stage: "total", Runs: 2, Min: 10 * time.Second, Avg: 15 * time.Second,
+			Max: 20 * time.Second}, summaries[0])
+		assert.Equal(t, 1, summaries[3].Runs)
+	})
+}
+
+func TestGetStageArgs(t *testing.T) {
+	t.Run("Should not ignore tools to the whole analysis", func(t *testing.T) {
+		args, err := GetStageArgs(StageAll)
+		assert.NoError(t, err)
+		assert.Empty(t, args)
+	})
+	t.Run("Should ignore the tools that aren't engines", func(t *testing.T) {
+		args, err := GetStageArgs(StageEngine)
+		assert.NoError(t, err)
+		assert.Len(t, args, 2)
+		assert.Contains(t, args[1], "GoSec")
+		assert.NotContains(t, args[1], "HorusecJava")
+	})
+	t.Run("Should ignore all the tools to the copy and the other tools to a tool", func(t *testing.T) {
+		args, err := GetStageArgs(StageCopy)
+		assert.NoError(t, err)
+		assert.Contains(t, args[1], "GoSec")
+
+		args, err = GetStageArgs("gosec")
+		assert.NoError(t, err)
+		assert.NotContains(t, args[1], "GoSec")
+		assert.Contains(t, args[1], "HorusecJava")
+	})
+	t.Run("Should return error when the stage is invalid", func(t *testing.T) {
+		_, err := GetStageArgs("build")
+		assert.Equal(t, ErrInvalidStage, err)
+	})
+}
+
+func TestPrint(t *testing.T) {
+	t.Run("Should print the stages and the memory of each run", func(t *testing.T) {
+		output := &bytes.Buffer{}
+
+		assert.NoError(t, Print(output, []*Result{NewResult(newAnalysisToTest(), 10*time.Second, 50*bytesInMB),
+			NewResult(newAnalysisToTest(), 12*time.Second, 0)}))
+
+		assert.Contains(t, output.String(), "total         2     10s    11s    12s")
+		assert.Contains(t, output.String(), "1    10s       50.0 MB")
+		assert.Contains(t, output.String(), "2    12s       -")
+	})
+}
+
+func TestExecRunner_Run(t *testing.T) {
+	t.Run("Should return error when the command fails without report", func(t *testing.T) {
+		runner := &execRunner{executable: "./not-exists", logLevel: "info"}
+
+		_, err := runner.Run(".", nil)
+
+		assert.Error(t, err)
+	})
+}
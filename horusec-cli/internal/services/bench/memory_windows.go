@@ -12,9 +12,14 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package semgrep
+//go:build windows
+// +build windows
 
-type Position struct {
-	Line int `json:"line"`
-	Col  int `json:"col"`
+package bench
+
+import "os"
+
+// getMaxMemoryInBytes isn't measured in windows, the max memory of the runs is printed as not available
+func getMaxMemoryInBytes(_ *os.ProcessState) int64 {
+	return 0
 }
@@ -0,0 +1,362 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rulepack"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/version"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/images"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/remotecache"
+)
+
+// Changed when the content of the cached analysis changes, so old caches are not used anymore
+const cacheVersion = "v2"
+
+var (
+	ErrCacheDisabled   = errors.New("cache disabled by the flag --no-cache")
+	ErrNotGitCommit    = errors.New("project is not in a git repository with commits")
+	ErrUncommittedWork = errors.New("project has uncommitted changes")
+)
+
+type Interface interface {
+	GetAnalysis() *horusec.Analysis
+	SaveAnalysis(analysis *horusec.Analysis)
+}
+
+type Cache struct {
+	config cliConfig.IConfig
+	key    string
+	remote remotecache.Store
+}
+
+func NewCache(config cliConfig.IConfig) Interface {
+	return &Cache{
+		config: config,
+		remote: newRemoteStoreFromConfig(config),
+	}
+}
+
+func newRemoteStoreFromConfig(config cliConfig.IConfig) remotecache.Store {
+	remote, err := remotecache.NewStoreFromConfig(config)
+	if err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugAnalysisCacheNotUsed, logger.DebugLevel, err.Error())
+		return nil
+	}
+	return remote
+}
+
+// GetAnalysis returns the cached analysis of the current commit. The key is calculated before the analysis runs,
+// so files written by the analysis don't change it
+func (c *Cache) GetAnalysis() *horusec.Analysis {
+	key, err := c.getKey()
+	if err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugAnalysisCacheNotUsed, logger.DebugLevel, err.Error())
+		return nil
+	}
+	c.key = key
+	content, err := c.readContent()
+	if err != nil {
+		return nil
+	}
+	analysis := &horusec.Analysis{}
+	if err := json.Unmarshal(content, analysis); err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugAnalysisCacheNotUsed, logger.DebugLevel, err.Error())
+		return nil
+	}
+	logger.LogInfoWithLevel(messages.MsgInfoAnalysisFromCache, logger.InfoLevel)
+	return analysis
+}
+
+// readContent uses the remote cache only when the local one misses, keeping the remote result locally
+func (c *Cache) readContent() ([]byte, error) {
+	content, err := ioutil.ReadFile(c.getFilePath())
+	if err == nil || c.remote == nil {
+		return content, err
+	}
+	content, err = c.remote.Get(c.key)
+	if err != nil {
+		c.logRemoteCacheError(err)
+		return nil, err
+	}
+	if err := c.writeFile(content); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorSaveAnalysisCache, err, logger.ErrorLevel)
+	}
+	return content, nil
+}
+
+// logRemoteCacheError warns about the invalid signatures, the object was changed by someone without the private key
+func (c *Cache) logRemoteCacheError(err error) {
+	if errors.Is(err, remotecache.ErrInvalidSignature) {
+		logger.LogWarnWithLevel(messages.MsgWarnRemoteCacheInvalidSignature, logger.WarnLevel, c.key)
+		return
+	}
+	logger.LogDebugWithLevel(messages.MsgDebugRemoteCacheMiss, logger.DebugLevel, err.Error())
+}
+
+// SaveAnalysis writes the analysis in a temporary file and renames it, so analyses running at the same
+// time with a shared cache directory never read a file partially written
+func (c *Cache) SaveAnalysis(analysis *horusec.Analysis) {
+	if c.key == "" || analysis.HasErrors() || c.config.GetIsTimeout() {
+		return
+	}
+	content, err := json.Marshal(analysis)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorSaveAnalysisCache, err, logger.ErrorLevel)
+		return
+	}
+	if err := c.writeFile(content); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorSaveAnalysisCache, err, logger.ErrorLevel)
+	}
+	c.saveRemoteContent(content)
+}
+
+func (c *Cache) saveRemoteContent(content []byte) {
+	if c.remote == nil || c.config.GetRemoteCacheMode() != cli.RemoteCacheReadWrite.ToString() {
+		return
+	}
+	if err := c.remote.Put(c.key, content); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorSaveAnalysisRemoteCache, err, logger.ErrorLevel)
+	}
+}
+
+func (c *Cache) writeFile(content []byte) error {
+	if err := os.MkdirAll(c.config.GetCacheDir(), os.ModePerm); err != nil {
+		return err
+	}
+	tmpFile, err := ioutil.TempFile(c.config.GetCacheDir(), c.key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+	if _, err := tmpFile.Write(content); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), c.getFilePath())
+}
+
+func (c *Cache) getFilePath() string {
+	return filepath.Join(c.config.GetCacheDir(), c.key+".json")
+}
+
+func (c *Cache) getKey() (string, error) {
+	if c.config.GetNoCache() {
+		return "", ErrCacheDisabled
+	}
+	commit, err := c.runGit("rev-parse", "HEAD")
+	if err != nil {
+		return "", ErrNotGitCommit
+	}
+	if status, err := c.runGit(c.getStatusArgs()...); err != nil || status != "" {
+		return "", ErrUncommittedWork
+	}
+	// the path inside of the repository is used instead of the project path, so different machines
+	// sharing the cache directory find the same key
+	prefix, _ := c.runGit("rev-parse", "--show-prefix")
+	remote, _ := c.runGit("config", "--get", "remote.origin.url")
+	configHash, err := c.getConfigHash()
+	if err != nil {
+		return "", err
+	}
+	return c.hash(strings.Join([]string{cacheVersion, version.Version, remote, prefix, commit, configHash}, "\n")), nil
+}
+
+// getStatusArgs ignores the analysis folder and the output file, both written by horusec inside of the project
+func (c *Cache) getStatusArgs() []string {
+	args := []string{"status", "--porcelain", "--", ".", ":(exclude).horusec"}
+	if outputPath := c.config.GetJSONOutputFilePath(); outputPath != "" {
+		absOutputPath, _ := filepath.Abs(outputPath)
+		if relativePath, err := filepath.Rel(c.config.GetProjectPath(), absOutputPath); err == nil &&
+			!strings.HasPrefix(relativePath, "..") {
+			args = append(args, ":(exclude)"+filepath.ToSlash(relativePath))
+		}
+	}
+	return args
+}
+
+func (c *Cache) runGit(args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = c.config.GetProjectPath()
+	output, err := cmd.Output()
+	return strings.TrimSpace(string(output)), err
+}
+
+// getConfigHash uses only the configurations that change the vulnerabilities found, with the content of the files
+// informed by them, and the images of the tools. The options of the rules, like ignoreComments, are in the content
+// of the rule packs
+func (c *Cache) getConfigHash() (string, error) {
+	filesHashes, err := c.getFilesHashes()
+	if err != nil {
+		return "", err
+	}
+	content, err := json.Marshal(map[string]interface{}{
+		"filesOrPathsToIgnore":           c.config.GetFilesOrPathsToIgnore(),
+		"toolsToIgnore":                  c.config.GetToolsToIgnore(),
+		"toolsConfig":                    c.config.GetToolsConfig(),
+		"toolsConfigFiles":               c.getToolsConfigFilesHashes(),
+		"workDir":                        c.config.GetWorkDir(),
+		"filterPath":                     c.config.GetFilterPath(),
+		"enableGitHistoryAnalysis":       c.config.GetEnableGitHistoryAnalysis(),
+		"enableCommitAuthor":             c.config.GetEnableCommitAuthor(),
+		"enableSecretVerification":       c.config.GetEnableSecretVerification(),
+		"enableVendoredAndGeneratedCode": c.config.GetEnableVendoredAndGeneratedCode(),
+		"enableWorkDirDiscovery":         c.config.GetEnableWorkDirDiscovery(),
+		"symlinkMode":                    c.config.GetSymlinkMode(),
+		"testCodeMode":                   c.config.GetTestCodeMode(),
+		"testCodePaths":                  c.config.GetTestCodePaths(),
+		"revealSecrets":                  c.config.GetRevealSecrets(),
+		"remediationPath":                c.config.GetRemediationPath(),
+		"remediation":                    filesHashes["remediation"],
+		"triageURL":                      c.config.GetTriageURL(),
+		"triageModel":                    c.config.GetTriageModel(),
+		"severityMapping":                c.config.GetSeverityMapping(),
+		"severityTables":                 filesHashes["severityTables"],
+		"rulePacks":                      c.getRulePacksHashes(),
+		"images":                         c.getImagePaths(),
+		"tfPlanPath":                     c.config.GetTfPlanPath(),
+		"tfPlan":                         filesHashes["tfPlan"],
+		"baseImageAdvisoriesPath":        c.config.GetBaseImageAdvisoriesPath(),
+		"baseImageAdvisories":            filesHashes["baseImageAdvisories"],
+		"osvOfflineDatabasePath":         c.config.GetOsvOfflineDatabasePath(),
+		"osvOfflineDatabase":             filesHashes["osvOfflineDatabase"],
+		"engineRuleTimeoutInMs":          c.config.GetEngineRuleTimeoutInMs(),
+		"maxFileSizeInMB":                c.config.GetMaxFileSizeInMB(),
+		"maxOutputSizeInMB":              c.config.GetMaxOutputSizeInMB(),
+		"codeContextLines":               c.config.GetCodeContextLines(),
+		"enableLeaksEntropy":             c.config.GetEnableLeaksEntropy(),
+		"leaksEntropyAllowlistPatterns":  c.config.GetLeaksEntropyAllowlistPatterns(),
+		"leaksEntropyAllowlistPaths":     c.config.GetLeaksEntropyAllowlistPaths(),
+		"leaksEntropyMinLength":          c.config.GetLeaksEntropyMinLength(),
+		"leaksEntropyBase64Threshold":    c.config.GetLeaksEntropyBase64Threshold(),
+		"leaksEntropyHexThreshold":       c.config.GetLeaksEntropyHexThreshold(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return c.hash(string(content)), nil
+}
+
+// getFilesHashes returns the hash of the content of each file informed in the configurations, and of all the files
+// of the snapshot of the OSV database, that is a folder
+func (c *Cache) getFilesHashes() (map[string]string, error) {
+	hashes := map[string]string{}
+	for name, path := range map[string]string{
+		"severityTables":      c.config.GetSeverityTablesPath(),
+		"tfPlan":              c.config.GetTfPlanPath(),
+		"baseImageAdvisories": c.config.GetBaseImageAdvisoriesPath(),
+		"remediation":         c.config.GetRemediationPath(),
+	} {
+		hash, err := c.hashFile(path)
+		if err != nil {
+			return nil, err
+		}
+		hashes[name] = hash
+	}
+	hash, err := c.hashDir(c.config.GetOsvOfflineDatabasePath())
+	hashes["osvOfflineDatabase"] = hash
+	return hashes, err
+}
+
+// getRulePacksHashes uses the content of the packs downloaded, so a pack downloaded again with other rules changes
+// the key. The builtin packs change only with the version of horusec and the images of the engines
+func (c *Cache) getRulePacksHashes() map[string]string {
+	hashes := map[string]string{}
+	for _, value := range c.config.GetRulePacks() {
+		reference, err := rulepack.ParseReference(value)
+		if err != nil || reference.IsBuiltin() {
+			hashes[value] = ""
+			continue
+		}
+		hashes[value], _ = c.hashFile(filepath.Join(c.config.GetRulePacksDir(), reference.GetFileName()))
+	}
+	return hashes
+}
+
+// getToolsConfigFilesHashes uses the content of the config file of each tool, like the .gitleaks.toml, so the
+// rules changed in the same file change the key. The files not found are validated before the analysis
+func (c *Cache) getToolsConfigFilesHashes() map[string]string {
+	hashes := map[string]string{}
+	for tool, toolConfig := range c.config.GetToolsConfig() {
+		if toolConfig.ConfigPath != "" {
+			hashes[tool.ToString()], _ = c.hashFile(toolConfig.ConfigPath)
+		}
+	}
+	return hashes
+}
+
+// getImagePaths returns the image with the tag, or the digest when it is pinned in the tools config, of each tool
+func (c *Cache) getImagePaths() map[string]string {
+	imagePaths := map[string]string{}
+	values := images.Values()
+	for index := range values {
+		imagePaths[values[index].Tool.ToString()] = values[index].GetImagePath(c.config.GetToolsConfig())
+	}
+	return imagePaths
+}
+
+func (c *Cache) hashFile(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return c.hash(string(content)), nil
+}
+
+// hashDir hashes the path and the content of each file of the folder, the files are walked in lexical order
+func (c *Cache) hashDir(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	var hashes []string
+	err := filepath.Walk(path, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		hash, err := c.hashFile(filePath)
+		relativePath, _ := filepath.Rel(path, filePath)
+		hashes = append(hashes, filepath.ToSlash(relativePath)+" "+hash)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return c.hash(strings.Join(hashes, "\n")), nil
+}
+
+func (c *Cache) hash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,396 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGitProject(t *testing.T) (projectPath, cacheDir string) {
+	basePath, err := ioutil.TempDir("", "cache")
+	assert.NoError(t, err)
+	projectPath = filepath.Join(basePath, "project")
+	assert.NoError(t, os.MkdirAll(projectPath, os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "main.go"), []byte("package main"), 0600))
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"add", "."},
+		{"-c", "user.name=horusec", "-c", "user.email=horusec@zup.com.br", "commit", "-q", "-m", "first"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = projectPath
+		assert.NoError(t, cmd.Run())
+	}
+	return projectPath, filepath.Join(basePath, "cache")
+}
+
+func newConfig(projectPath, cacheDir string) *cliConfig.Config {
+	config := &cliConfig.Config{}
+	config.SetProjectPath(projectPath)
+	config.SetCacheDir(cacheDir)
+	return config
+}
+
+// newFakeStorage keeps the objects sent with PUT in memory and returns them with GET
+func newFakeStorage(t *testing.T, requests *[]*http.Request) *httptest.Server {
+	objects := sync.Map{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r)
+		switch r.Method {
+		case http.MethodPut:
+			body, err := ioutil.ReadAll(r.Body)
+			assert.NoError(t, err)
+			objects.Store(r.URL.Path, body)
+		case http.MethodGet:
+			if body, ok := objects.Load(r.URL.Path); ok {
+				_, _ = w.Write(body.([]byte))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func setRemoteCache(t *testing.T, config *cliConfig.Config, url string) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	config.SetRemoteCacheURL(url)
+	config.SetRemoteCachePublicKey(base64.StdEncoding.EncodeToString(publicKey))
+	config.SetRemoteCachePrivateKey(base64.StdEncoding.EncodeToString(privateKey.Seed()))
+}
+
+func TestCache(t *testing.T) {
+	t.Run("Should return cached analysis of the same commit and configurations", func(t *testing.T) {
+		projectPath, cacheDir := newGitProject(t)
+		defer os.RemoveAll(filepath.Dir(projectPath))
+		analysis := &horusec.Analysis{ID: uuid.New()}
+
+		cache := NewCache(newConfig(projectPath, cacheDir))
+		assert.Nil(t, cache.GetAnalysis())
+		assert.NoError(t, os.MkdirAll(filepath.Join(projectPath, ".horusec", analysis.ID.String()), os.ModePerm))
+		cache.SaveAnalysis(analysis)
+
+		cachedAnalysis := NewCache(newConfig(projectPath, cacheDir)).GetAnalysis()
+		assert.NotNil(t, cachedAnalysis)
+		assert.Equal(t, analysis.ID, cachedAnalysis.ID)
+
+		config := newConfig(projectPath, cacheDir)
+		config.SetFilesOrPathsToIgnore([]string{"**/test/**"})
+		assert.Nil(t, NewCache(config).GetAnalysis())
+	})
+
+	t.Run("Should change the key with the content of the files and the images of the tools", func(t *testing.T) {
+		projectPath, cacheDir := newGitProject(t)
+		defer os.RemoveAll(filepath.Dir(projectPath))
+		severityTablesPath := filepath.Join(cacheDir, "severity-tables.json")
+		assert.NoError(t, os.MkdirAll(cacheDir, os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(severityTablesPath, []byte(`{"GoSec":{"default":"HIGH"}}`), 0600))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(cacheDir, "java-core@1.4.json"), []byte("{}"), 0600))
+		config := newConfig(projectPath, cacheDir)
+		config.SetSeverityTablesPath(severityTablesPath)
+		config.SetRulePacks([]string{"java-core@1.4"})
+		config.SetRulePacksDir(cacheDir)
+		cache := &Cache{config: config}
+		key, err := cache.getKey()
+		assert.NoError(t, err)
+
+		assert.NoError(t, ioutil.WriteFile(severityTablesPath, []byte(`{"GoSec":{"default":"LOW"}}`), 0600))
+		severityTablesKey, err := cache.getKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, key, severityTablesKey)
+
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(cacheDir, "java-core@1.4.json"), []byte(`{"rules":[]}`), 0600))
+		rulePacksKey, err := cache.getKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, severityTablesKey, rulePacksKey)
+
+		config.SetToolsConfig(map[string]interface{}{"gosec": map[string]interface{}{
+			"imagepath": "registry.example.com/gosec@sha256:0123"}})
+		imagesKey, err := cache.getKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, rulePacksKey, imagesKey)
+
+		tfPlanPath := filepath.Join(cacheDir, "plan.json")
+		assert.NoError(t, ioutil.WriteFile(tfPlanPath, []byte(`{"planned_values":{}}`), 0600))
+		config.SetTfPlanPath(tfPlanPath)
+		tfPlanKey, err := cache.getKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, imagesKey, tfPlanKey)
+		assert.NoError(t, ioutil.WriteFile(tfPlanPath, []byte(`{"planned_values":{"root_module":{}}}`), 0600))
+		tfPlanContentKey, err := cache.getKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, tfPlanKey, tfPlanContentKey)
+
+		config.SetEngineRuleTimeoutInMs(100)
+		ruleTimeoutKey, err := cache.getKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, tfPlanContentKey, ruleTimeoutKey)
+
+		assert.NoError(t, os.Remove(severityTablesPath))
+		_, err = cache.getKey()
+		assert.Error(t, err)
+	})
+
+	t.Run("Should change the key with each configuration that changes the vulnerabilities found", func(t *testing.T) {
+		projectPath, cacheDir := newGitProject(t)
+		defer os.RemoveAll(filepath.Dir(projectPath))
+		advisoriesPath := filepath.Join(cacheDir, "advisories.json")
+		assert.NoError(t, os.MkdirAll(cacheDir, os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(advisoriesPath, []byte("[]"), 0600))
+		key, err := (&Cache{config: newConfig(projectPath, cacheDir)}).getKey()
+		assert.NoError(t, err)
+
+		for name, setConfig := range map[string]func(config *cliConfig.Config){
+			"filesOrPathsToIgnore":     func(c *cliConfig.Config) { c.SetFilesOrPathsToIgnore([]string{"**/test/**"}) },
+			"toolsToIgnore":            func(c *cliConfig.Config) { c.SetToolsToIgnore([]string{"GoSec"}) },
+			"workDir":                  func(c *cliConfig.Config) { c.SetWorkDir(map[string]interface{}{"go": []string{"api"}}) },
+			"filterPath":               func(c *cliConfig.Config) { c.SetFilterPath("api") },
+			"enableGitHistoryAnalysis": func(c *cliConfig.Config) { c.SetEnableGitHistoryAnalysis(true) },
+			"enableCommitAuthor":       func(c *cliConfig.Config) { c.SetEnableCommitAuthor(true) },
+			"enableSecretVerification": func(c *cliConfig.Config) { c.SetEnableSecretVerification(true) },
+			"enableVendoredAndGeneratedCode": func(c *cliConfig.Config) {
+				c.SetEnableVendoredAndGeneratedCode(true)
+			},
+			"enableWorkDirDiscovery": func(c *cliConfig.Config) { c.SetEnableWorkDirDiscovery(true) },
+			"symlinkMode": func(c *cliConfig.Config) {
+				c.SetSymlinkMode(cli.SymlinkSkip.ToString())
+			},
+			"testCodeMode":    func(c *cliConfig.Config) { c.SetTestCodeMode(cli.TestCodeDowngrade.ToString()) },
+			"testCodePaths":   func(c *cliConfig.Config) { c.SetTestCodePaths([]string{"**/fixtures/**"}) },
+			"revealSecrets":   func(c *cliConfig.Config) { c.SetRevealSecrets(true) },
+			"remediationPath": func(c *cliConfig.Config) { c.SetRemediationPath(advisoriesPath) },
+			"triageURL":       func(c *cliConfig.Config) { c.SetTriageURL("http://localhost:8080") },
+			"triageModel":     func(c *cliConfig.Config) { c.SetTriageModel("model") },
+			"severityMapping": func(c *cliConfig.Config) {
+				c.SetSeverityMapping(map[string]interface{}{"HS-LEAKS-1": "LOW"})
+			},
+			"rulePacks":              func(c *cliConfig.Config) { c.SetRulePacks([]string{"java-core"}) },
+			"baseImageAdvisories":    func(c *cliConfig.Config) { c.SetBaseImageAdvisoriesPath(advisoriesPath) },
+			"osvOfflineDatabasePath": func(c *cliConfig.Config) { c.SetOsvOfflineDatabasePath(cacheDir) },
+			"engineRuleTimeoutInMs":  func(c *cliConfig.Config) { c.SetEngineRuleTimeoutInMs(100) },
+			"maxFileSizeInMB":        func(c *cliConfig.Config) { c.SetMaxFileSizeInMB(10) },
+			"maxOutputSizeInMB":      func(c *cliConfig.Config) { c.SetMaxOutputSizeInMB(1) },
+			"codeContextLines":       func(c *cliConfig.Config) { c.SetCodeContextLines(3) },
+			"enableLeaksEntropy":     func(c *cliConfig.Config) { c.SetEnableLeaksEntropy(true) },
+			"leaksEntropyAllowlistPatterns": func(c *cliConfig.Config) {
+				c.SetLeaksEntropyAllowlistPatterns([]string{"^EXAMPLE"})
+			},
+			"leaksEntropyAllowlistPaths": func(c *cliConfig.Config) {
+				c.SetLeaksEntropyAllowlistPaths([]string{"**/fixtures/**"})
+			},
+			"leaksEntropyMinLength":       func(c *cliConfig.Config) { c.SetLeaksEntropyMinLength(30) },
+			"leaksEntropyBase64Threshold": func(c *cliConfig.Config) { c.SetLeaksEntropyBase64Threshold(5) },
+			"leaksEntropyHexThreshold":    func(c *cliConfig.Config) { c.SetLeaksEntropyHexThreshold(3.5) },
+		} {
+			config := newConfig(projectPath, cacheDir)
+			setConfig(config)
+			configKey, err := (&Cache{config: config}).getKey()
+			assert.NoError(t, err, name)
+			assert.NotEqual(t, key, configKey, name)
+		}
+	})
+
+	t.Run("Should change the key with the content of the remediation file and of the OSV database", func(t *testing.T) {
+		projectPath, cacheDir := newGitProject(t)
+		defer os.RemoveAll(filepath.Dir(projectPath))
+		remediationPath := filepath.Join(cacheDir, "remediation.yaml")
+		osvPath := filepath.Join(cacheDir, "osv", "Go")
+		assert.NoError(t, os.MkdirAll(osvPath, os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(remediationPath, []byte("rules: {}"), 0600))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(osvPath, "all.zip"), []byte("GO-2021-0001"), 0600))
+		config := newConfig(projectPath, cacheDir)
+		config.SetRemediationPath(remediationPath)
+		config.SetOsvOfflineDatabasePath(filepath.Dir(osvPath))
+		cache := &Cache{config: config}
+		key, err := cache.getKey()
+		assert.NoError(t, err)
+
+		assert.NoError(t, ioutil.WriteFile(remediationPath, []byte("rules: {HS-LEAKS-1: {}}"), 0600))
+		remediationKey, err := cache.getKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, key, remediationKey)
+
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(osvPath, "all.zip"), []byte("GO-2021-0002"), 0600))
+		osvKey, err := cache.getKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, remediationKey, osvKey)
+
+		assert.NoError(t, os.RemoveAll(osvPath))
+		_, err = cache.getKey()
+		assert.NoError(t, err)
+		assert.NoError(t, os.RemoveAll(filepath.Dir(osvPath)))
+		_, err = cache.getKey()
+		assert.Error(t, err)
+	})
+
+	t.Run("Should change the key with the content of the config file of the tools config", func(t *testing.T) {
+		projectPath, cacheDir := newGitProject(t)
+		defer os.RemoveAll(filepath.Dir(projectPath))
+		configPath := filepath.Join(cacheDir, ".gitleaks.toml")
+		assert.NoError(t, os.MkdirAll(cacheDir, os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(configPath, []byte(`title = "gitleaks"`), 0600))
+		config := newConfig(projectPath, cacheDir)
+		config.SetToolsConfig(map[string]interface{}{"gitleaks": map[string]interface{}{"configpath": configPath}})
+		cache := &Cache{config: config}
+		key, err := cache.getKey()
+		assert.NoError(t, err)
+
+		assert.NoError(t, ioutil.WriteFile(configPath, []byte("title = \"gitleaks\"\n[[rules]]"), 0600))
+		configKey, err := cache.getKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, key, configKey)
+	})
+
+	t.Run("Should change the key with the options of the rules in the rule packs", func(t *testing.T) {
+		projectPath, cacheDir := newGitProject(t)
+		defer os.RemoveAll(filepath.Dir(projectPath))
+		packPath := filepath.Join(cacheDir, "leaks-custom@1.0.json")
+		assert.NoError(t, os.MkdirAll(cacheDir, os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(packPath, []byte(`{"rules":[{"id":"HS-LEAKS-9001"}]}`), 0600))
+		config := newConfig(projectPath, cacheDir)
+		config.SetRulePacks([]string{"leaks-custom@1.0"})
+		config.SetRulePacksDir(cacheDir)
+		cache := &Cache{config: config}
+		key, err := cache.getKey()
+		assert.NoError(t, err)
+
+		assert.NoError(t, ioutil.WriteFile(packPath,
+			[]byte(`{"rules":[{"id":"HS-LEAKS-9001","ignoreComments":true}]}`), 0600))
+		ignoreCommentsKey, err := cache.getKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, key, ignoreCommentsKey)
+	})
+
+	t.Run("Should not use cache when there are uncommitted changes or no cache is enabled", func(t *testing.T) {
+		projectPath, cacheDir := newGitProject(t)
+		defer os.RemoveAll(filepath.Dir(projectPath))
+
+		config := newConfig(projectPath, cacheDir)
+		config.SetNoCache(true)
+		cache := &Cache{config: config}
+		_, err := cache.getKey()
+		assert.Equal(t, ErrCacheDisabled, err)
+
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "main.go"), []byte("package other"), 0600))
+		cache = &Cache{config: newConfig(projectPath, cacheDir)}
+		_, err = cache.getKey()
+		assert.Equal(t, ErrUncommittedWork, err)
+	})
+
+	t.Run("Should not save analysis with errors", func(t *testing.T) {
+		projectPath, cacheDir := newGitProject(t)
+		defer os.RemoveAll(filepath.Dir(projectPath))
+		analysis := &horusec.Analysis{ID: uuid.New(), Errors: "some error"}
+
+		cache := NewCache(newConfig(projectPath, cacheDir))
+		assert.Nil(t, cache.GetAnalysis())
+		cache.SaveAnalysis(analysis)
+
+		assert.Nil(t, NewCache(newConfig(projectPath, cacheDir)).GetAnalysis())
+	})
+
+	t.Run("Should use analysis of the remote cache when the local cache misses", func(t *testing.T) {
+		projectPath, cacheDir := newGitProject(t)
+		defer os.RemoveAll(filepath.Dir(projectPath))
+		var requests []*http.Request
+		server := newFakeStorage(t, &requests)
+		defer server.Close()
+		analysis := &horusec.Analysis{ID: uuid.New()}
+
+		config := newConfig(projectPath, cacheDir)
+		setRemoteCache(t, config, server.URL)
+		config.SetRemoteCacheMode(cli.RemoteCacheReadWrite.ToString())
+		cache := NewCache(config)
+		assert.Nil(t, cache.GetAnalysis())
+		cache.SaveAnalysis(analysis)
+		assert.NoError(t, os.RemoveAll(cacheDir))
+
+		config.SetRemoteCacheMode(cli.RemoteCacheReadOnly.ToString())
+		config.SetRemoteCachePrivateKey("")
+		cachedAnalysis := NewCache(config).GetAnalysis()
+		assert.NotNil(t, cachedAnalysis)
+		assert.Equal(t, analysis.ID, cachedAnalysis.ID)
+		assert.FileExists(t, filepath.Join(cacheDir, cache.(*Cache).key+".json"))
+	})
+
+	t.Run("Should not use the analysis of the remote cache without a valid signature", func(t *testing.T) {
+		projectPath, cacheDir := newGitProject(t)
+		defer os.RemoveAll(filepath.Dir(projectPath))
+		var requests []*http.Request
+		server := newFakeStorage(t, &requests)
+		defer server.Close()
+
+		config := newConfig(projectPath, cacheDir)
+		setRemoteCache(t, config, server.URL)
+		config.SetRemoteCacheMode(cli.RemoteCacheReadWrite.ToString())
+		cache := NewCache(config)
+		assert.Nil(t, cache.GetAnalysis())
+		cache.SaveAnalysis(&horusec.Analysis{ID: uuid.New()})
+		assert.NoError(t, os.RemoveAll(cacheDir))
+
+		otherKeys := newConfig(projectPath, cacheDir)
+		setRemoteCache(t, otherKeys, server.URL)
+		assert.Nil(t, NewCache(otherKeys).GetAnalysis())
+		assert.NoFileExists(t, filepath.Join(cacheDir, cache.(*Cache).key+".json"))
+	})
+
+	t.Run("Should not use the remote cache without the public key", func(t *testing.T) {
+		projectPath, cacheDir := newGitProject(t)
+		defer os.RemoveAll(filepath.Dir(projectPath))
+		var requests []*http.Request
+		server := newFakeStorage(t, &requests)
+		defer server.Close()
+
+		config := newConfig(projectPath, cacheDir)
+		config.SetRemoteCacheURL(server.URL)
+		cache := NewCache(config)
+		assert.Nil(t, cache.GetAnalysis())
+		cache.SaveAnalysis(&horusec.Analysis{ID: uuid.New()})
+
+		assert.Empty(t, requests)
+	})
+
+	t.Run("Should not write in the remote cache when it is read only", func(t *testing.T) {
+		projectPath, cacheDir := newGitProject(t)
+		defer os.RemoveAll(filepath.Dir(projectPath))
+		var requests []*http.Request
+		server := newFakeStorage(t, &requests)
+		defer server.Close()
+
+		config := newConfig(projectPath, cacheDir)
+		setRemoteCache(t, config, server.URL)
+		cache := NewCache(config)
+		assert.Nil(t, cache.GetAnalysis())
+		cache.SaveAnalysis(&horusec.Analysis{ID: uuid.New()})
+
+		assert.Len(t, requests, 1)
+		assert.Equal(t, http.MethodGet, requests[0].Method)
+	})
+}
@@ -0,0 +1,430 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkrun
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+const (
+	DefaultAPIURL = "https://api.github.com"
+	Name          = "Horusec"
+	// annotationsByRequest is the max of annotations of each request of the checks API, the others are sent updating
+	// the check run
+	annotationsByRequest = 50
+	// maxAnnotations is the max of annotations of the check run, the summary has the total of vulnerabilities
+	maxAnnotations = 1000
+	// maxChangedFilesPages is the max of pages of the files of the pull request, the API lists up to 3000 files
+	maxChangedFilesPages = 30
+	// maxOutputText is the max of characters of the summary and of the message of the annotations in the checks API
+	maxOutputText  = 65535
+	requestTimeout = 30
+)
+
+const (
+	ConclusionSuccess = "success"
+	ConclusionNeutral = "neutral"
+	ConclusionFailure = "failure"
+)
+
+type Interface interface {
+	Publish(analysis *horusec.Analysis, totalVulns int, err error)
+}
+
+// CheckRun is the body of the checks API of GitHub
+type CheckRun struct {
+	Name        string  `json:"name,omitempty"`
+	HeadSha     string  `json:"head_sha,omitempty"`
+	Status      string  `json:"status,omitempty"`
+	Conclusion  string  `json:"conclusion,omitempty"`
+	CompletedAt string  `json:"completed_at,omitempty"`
+	DetailsURL  string  `json:"details_url,omitempty"`
+	Output      *Output `json:"output"`
+}
+
+type Output struct {
+	Title       string       `json:"title"`
+	Summary     string       `json:"summary"`
+	Annotations []Annotation `json:"annotations,omitempty"`
+}
+
+type Annotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	EndLine         int    `json:"end_line"`
+	AnnotationLevel string `json:"annotation_level"`
+	Title           string `json:"title,omitempty"`
+	Message         string `json:"message"`
+}
+
+type checkRunResponse struct {
+	ID int64 `json:"id"`
+}
+
+type pullRequestFile struct {
+	Filename string `json:"filename"`
+}
+
+// event is the part of the payload of the workflow event used to find the pull request
+type event struct {
+	PullRequest *struct {
+		Number int `json:"number"`
+		Head   struct {
+			Sha string `json:"sha"`
+		} `json:"head"`
+	} `json:"pull_request"`
+}
+
+type Checks struct {
+	config     cliConfig.IConfig
+	httpClient client.Interface
+	getEnv     func(key string) string
+}
+
+func NewCheckRun(config cliConfig.IConfig) Interface {
+	return &Checks{
+		config:     config,
+		httpClient: client.NewHTTPClient(requestTimeout),
+		getEnv:     os.Getenv,
+	}
+}
+
+// Publish does nothing unless the check run was enabled. It only runs in the GitHub Actions, where the repository,
+// the commit and the token are in the environment, and the errors are only logged, the check run never changes the
+// result of the analysis
+func (c *Checks) Publish(analysis *horusec.Analysis, totalVulns int, err error) {
+	if !c.config.GetPublishCheckRun() {
+		return
+	}
+	if c.getEnv("GITHUB_REPOSITORY") == "" || c.getEnv("GITHUB_SHA") == "" || c.getEnv("GITHUB_TOKEN") == "" {
+		logger.LogWarnWithLevel(messages.MsgWarnCheckRunNotInGitHubActions, logger.WarnLevel)
+		return
+	}
+	if publishErr := c.publish(analysis, totalVulns, err); publishErr != nil {
+		logger.LogWarnWithLevel(messages.MsgWarnCheckRunFailed, logger.WarnLevel, publishErr.Error())
+	}
+}
+
+func (c *Checks) publish(analysis *horusec.Analysis, totalVulns int, err error) error {
+	pullRequest := c.getPullRequest()
+	annotations, err2 := c.getAnnotations(analysis, pullRequest)
+	if err2 != nil {
+		return err2
+	}
+	checkRun := c.newCheckRun(analysis, totalVulns, err, pullRequest, len(annotations))
+	checkRun.Output.Annotations = annotations[:c.min(annotationsByRequest, len(annotations))]
+	id, err2 := c.create(checkRun)
+	if err2 != nil {
+		return err2
+	}
+	for start := annotationsByRequest; start < len(annotations); start += annotationsByRequest {
+		output := &Output{Title: checkRun.Output.Title, Summary: checkRun.Output.Summary,
+			Annotations: annotations[start:c.min(start+annotationsByRequest, len(annotations))]}
+		if err2 := c.update(id, output); err2 != nil {
+			return err2
+		}
+	}
+	return nil
+}
+
+// getPullRequest reads the event of the workflow, in the pull requests the GITHUB_SHA is the merge commit and the
+// check run must be in the head commit. It returns nil in the other events
+func (c *Checks) getPullRequest() *event {
+	content, err := ioutil.ReadFile(c.getEnv("GITHUB_EVENT_PATH"))
+	if err != nil {
+		return nil
+	}
+	workflowEvent := &event{}
+	if err := json.Unmarshal(content, workflowEvent); err != nil || workflowEvent.PullRequest == nil {
+		return nil
+	}
+	return workflowEvent
+}
+
+func (c *Checks) newCheckRun(analysis *horusec.Analysis, totalVulns int, err error, pullRequest *event,
+	totalAnnotations int) *CheckRun {
+	checkRun := &CheckRun{
+		Name:        Name,
+		HeadSha:     c.getEnv("GITHUB_SHA"),
+		Status:      "completed",
+		Conclusion:  c.getConclusion(analysis, totalVulns, err),
+		CompletedAt: time.Now().UTC().Format(time.RFC3339),
+		Output: &Output{
+			Title:   c.getTitle(analysis, totalVulns, err),
+			Summary: c.getSummary(analysis, err, pullRequest != nil, totalAnnotations),
+		},
+	}
+	if pullRequest != nil && pullRequest.PullRequest.Head.Sha != "" {
+		checkRun.HeadSha = pullRequest.PullRequest.Head.Sha
+	}
+	if c.getEnv("GITHUB_RUN_ID") != "" {
+		checkRun.DetailsURL = c.getServerURL() + "/" + c.getEnv("GITHUB_REPOSITORY") + "/actions/runs/" +
+			c.getEnv("GITHUB_RUN_ID")
+	}
+	return checkRun
+}
+
+// getConclusion is like the exit code of horusec: the errors of the analysis and of the gates, and the blocking
+// vulnerabilities with return-error, are failure. The vulnerabilities that don't fail the analysis are neutral
+func (c *Checks) getConclusion(analysis *horusec.Analysis, totalVulns int, err error) string {
+	if err != nil || (c.isReturnErrorIfFoundVulnerability() && totalVulns > 0) {
+		return ConclusionFailure
+	}
+	if len(c.getVulnerabilities(analysis)) > 0 {
+		return ConclusionNeutral
+	}
+	return ConclusionSuccess
+}
+
+func (c *Checks) isReturnErrorIfFoundVulnerability() bool {
+	return c.config.GetPolicyPath() == "" && c.config.GetReturnErrorIfFoundVulnerability()
+}
+
+func (c *Checks) getTitle(analysis *horusec.Analysis, totalVulns int, err error) string {
+	if err != nil {
+		return "Analysis failed: " + c.truncate(err.Error(), 200)
+	}
+	return fmt.Sprintf("%d vulnerabilities found, %d blocking", len(c.getVulnerabilities(analysis)), totalVulns)
+}
+
+// getSummary is the markdown of the totals by severity and by tool, the gate and the annotations not sent
+func (c *Checks) getSummary(analysis *horusec.Analysis, err error, isPullRequest bool, totalAnnotations int) string {
+	vulnerabilities := c.getVulnerabilities(analysis)
+	summary := &strings.Builder{}
+	summary.WriteString("| Severity | Total |\n|---|---|\n")
+	for _, row := range c.getTotals(vulnerabilities, func(vuln *horusec.Vulnerability) string {
+		return vuln.Severity.ToString()
+	}) {
+		summary.WriteString(row)
+	}
+	summary.WriteString("\n| Tool | Total |\n|---|---|\n")
+	for _, row := range c.getTotals(vulnerabilities, func(vuln *horusec.Vulnerability) string {
+		return vuln.SecurityTool.ToString()
+	}) {
+		summary.WriteString(row)
+	}
+	if err != nil {
+		summary.WriteString("\n**Gate:** " + err.Error() + "\n")
+	}
+	if isPullRequest {
+		summary.WriteString(fmt.Sprintf("\n%d vulnerabilities in the files changed by the pull request are annotated.",
+			totalAnnotations))
+	} else {
+		summary.WriteString(fmt.Sprintf("\n%d vulnerabilities are annotated.", totalAnnotations))
+	}
+	if c.getTotalToAnnotate(vulnerabilities) > maxAnnotations {
+		summary.WriteString(fmt.Sprintf(" Only the first %d are annotated, see the report of the analysis.",
+			maxAnnotations))
+	}
+	return c.truncate(summary.String(), maxOutputText)
+}
+
+func (c *Checks) getTotals(vulnerabilities []*horusec.Vulnerability,
+	getKey func(vuln *horusec.Vulnerability) string) (rows []string) {
+	totals := map[string]int{}
+	for _, vuln := range vulnerabilities {
+		totals[getKey(vuln)]++
+	}
+	for key, total := range totals {
+		rows = append(rows, fmt.Sprintf("| %s | %d |\n", key, total))
+	}
+	sort.Strings(rows)
+	return rows
+}
+
+// getVulnerabilities returns the vulnerabilities of the type vulnerability, without the false positives, the risk
+// accepted and the corrected
+func (c *Checks) getVulnerabilities(analysis *horusec.Analysis) (vulnerabilities []*horusec.Vulnerability) {
+	for index := range analysis.AnalysisVulnerabilities {
+		if vuln := &analysis.AnalysisVulnerabilities[index].Vulnerability; vuln.Type == enumHorusec.Vulnerability {
+			vulnerabilities = append(vulnerabilities, vuln)
+		}
+	}
+	return vulnerabilities
+}
+
+func (c *Checks) getTotalToAnnotate(vulnerabilities []*horusec.Vulnerability) (total int) {
+	for _, vuln := range vulnerabilities {
+		if vuln.File != "" {
+			total++
+		}
+	}
+	return total
+}
+
+// getAnnotations annotates only the files changed by the pull request, and all the files in the other events
+func (c *Checks) getAnnotations(analysis *horusec.Analysis, pullRequest *event) ([]Annotation, error) {
+	var changedFiles map[string]bool
+	if pullRequest != nil {
+		files, err := c.getChangedFiles(pullRequest.PullRequest.Number)
+		if err != nil {
+			return nil, err
+		}
+		changedFiles = files
+	}
+	annotations := []Annotation{}
+	for _, vuln := range c.getVulnerabilities(analysis) {
+		path := strings.TrimPrefix(strings.ReplaceAll(vuln.File, "\\", "/"), "./")
+		if path == "" || (changedFiles != nil && !changedFiles[path]) {
+			continue
+		}
+		annotations = append(annotations, c.newAnnotation(vuln, path))
+		if len(annotations) == maxAnnotations {
+			break
+		}
+	}
+	return annotations, nil
+}
+
+func (c *Checks) newAnnotation(vuln *horusec.Vulnerability, path string) Annotation {
+	line, err := strconv.Atoi(vuln.Line)
+	if err != nil || line < 1 {
+		line = 1
+	}
+	title := fmt.Sprintf("%s: %s", vuln.Severity, vuln.SecurityTool)
+	if vuln.RuleID != "" {
+		title += " " + vuln.RuleID
+	}
+	return Annotation{
+		Path:            path,
+		StartLine:       line,
+		EndLine:         line,
+		AnnotationLevel: c.getAnnotationLevel(vuln.Severity),
+		Title:           title,
+		Message:         c.truncate(vuln.Details+"\nReferenceHash: "+vuln.VulnHash, maxOutputText),
+	}
+}
+
+func (c *Checks) getAnnotationLevel(vulnSeverity severity.Severity) string {
+	switch vulnSeverity {
+	case severity.Critical, severity.High:
+		return "failure"
+	case severity.Medium:
+		return "warning"
+	default:
+		return "notice"
+	}
+}
+
+func (c *Checks) getChangedFiles(number int) (map[string]bool, error) {
+	changedFiles := map[string]bool{}
+	for page := 1; page <= maxChangedFilesPages; page++ {
+		content, err := c.doRequest(http.MethodGet,
+			fmt.Sprintf("/pulls/%d/files?per_page=100&page=%d", number, page), nil)
+		if err != nil {
+			return nil, err
+		}
+		files := []pullRequestFile{}
+		if err := json.Unmarshal(content, &files); err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			changedFiles[file.Filename] = true
+		}
+		if len(files) < 100 {
+			break
+		}
+	}
+	return changedFiles, nil
+}
+
+func (c *Checks) create(checkRun *CheckRun) (int64, error) {
+	content, err := json.Marshal(checkRun)
+	if err != nil {
+		return 0, err
+	}
+	body, err := c.doRequest(http.MethodPost, "/check-runs", content)
+	if err != nil {
+		return 0, err
+	}
+	response := &checkRunResponse{}
+	return response.ID, json.Unmarshal(body, response)
+}
+
+func (c *Checks) update(id int64, output *Output) error {
+	content, err := json.Marshal(&CheckRun{Output: output})
+	if err != nil {
+		return err
+	}
+	_, err = c.doRequest(http.MethodPatch, fmt.Sprintf("/check-runs/%d", id), content)
+	return err
+}
+
+func (c *Checks) doRequest(method, path string, content []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, c.getRepositoryURL()+path, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+c.getEnv("GITHUB_TOKEN"))
+
+	response, err := c.httpClient.DoRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.CloseBody()
+
+	if response.GetStatusCode() < http.StatusOK || response.GetStatusCode() >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("unexpected status code %d in %s", response.GetStatusCode(), path)
+	}
+	return response.GetBody()
+}
+
+func (c *Checks) getRepositoryURL() string {
+	apiURL := c.getEnv("GITHUB_API_URL")
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+	return strings.TrimSuffix(apiURL, "/") + "/repos/" + c.getEnv("GITHUB_REPOSITORY")
+}
+
+func (c *Checks) getServerURL() string {
+	if serverURL := c.getEnv("GITHUB_SERVER_URL"); serverURL != "" {
+		return strings.TrimSuffix(serverURL, "/")
+	}
+	return "https://github.com"
+}
+
+func (c *Checks) truncate(text string, size int) string {
+	if len(text) <= size {
+		return text
+	}
+	return text[:size-3] + "..."
+}
+
+func (c *Checks) min(value, other int) int {
+	if value < other {
+		return value
+	}
+	return other
+}
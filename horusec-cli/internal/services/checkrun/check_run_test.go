@@ -0,0 +1,184 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkrun
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type request struct {
+	method        string
+	path          string
+	authorization string
+	checkRun      CheckRun
+}
+
+func newFakeServer(requests *[]request, changedFiles []string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received := request{method: r.Method, path: r.URL.Path, authorization: r.Header.Get("Authorization")}
+		_ = json.Unmarshal(body, &received.checkRun)
+		*requests = append(*requests, received)
+		if r.Method == http.MethodGet {
+			files := []pullRequestFile{}
+			for _, file := range changedFiles {
+				files = append(files, pullRequestFile{Filename: file})
+			}
+			content, _ := json.Marshal(files)
+			_, _ = w.Write(content)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 7}`))
+	}))
+}
+
+func newGitHubEnv(apiURL, eventPath string) func(key string) string {
+	return func(key string) string {
+		return map[string]string{
+			"GITHUB_API_URL":    apiURL,
+			"GITHUB_EVENT_PATH": eventPath,
+			"GITHUB_REPOSITORY": "ZupIT/horusec",
+			"GITHUB_SHA":        "8a1b2c3",
+			"GITHUB_RUN_ID":     "42",
+			"GITHUB_TOKEN":      "token",
+		}[key]
+	}
+}
+
+func newService(enabled bool, getEnv func(key string) string) *Checks {
+	configs := &config.Config{}
+	configs.SetPublishCheckRun(enabled)
+	configs.SetReturnErrorIfFoundVulnerability(true)
+	return &Checks{config: configs, httpClient: client.NewHTTPClient(requestTimeout), getEnv: getEnv}
+}
+
+func newAnalysis(total int) *horusec.Analysis {
+	analysis := &horusec.Analysis{}
+	for index := 0; index < total; index++ {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities, horusec.AnalysisVulnerabilities{
+			Vulnerability: horusec.Vulnerability{SecurityTool: tools.GoSec, Severity: severity.High,
+				Type: enumHorusec.Vulnerability, File: fmt.Sprintf("./cmd/file%d.go", index%2), Line: "10",
+				Details: "hardcoded credentials", VulnHash: fmt.Sprintf("hash%d", index)},
+		})
+	}
+	return analysis
+}
+
+func writeEvent(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "event.json")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), os.ModePerm))
+	return path
+}
+
+func TestChecks_Publish(t *testing.T) {
+	t.Run("should create the check run with the annotations of the push", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests, nil)
+		defer server.Close()
+
+		newService(true, newGitHubEnv(server.URL, "")).Publish(newAnalysis(2), 2, nil)
+
+		assert.Len(t, requests, 1)
+		assert.Equal(t, http.MethodPost, requests[0].method)
+		assert.Equal(t, "/repos/ZupIT/horusec/check-runs", requests[0].path)
+		assert.Equal(t, "Bearer token", requests[0].authorization)
+		assert.Equal(t, "8a1b2c3", requests[0].checkRun.HeadSha)
+		assert.Equal(t, ConclusionFailure, requests[0].checkRun.Conclusion)
+		assert.Equal(t, "https://github.com/ZupIT/horusec/actions/runs/42", requests[0].checkRun.DetailsURL)
+		assert.Contains(t, requests[0].checkRun.Output.Summary, "| HIGH | 2 |")
+		assert.Contains(t, requests[0].checkRun.Output.Summary, "| GoSec | 2 |")
+		assert.Len(t, requests[0].checkRun.Output.Annotations, 2)
+		assert.Equal(t, Annotation{Path: "cmd/file0.go", StartLine: 10, EndLine: 10, AnnotationLevel: "failure",
+			Title: "HIGH: GoSec", Message: "hardcoded credentials\nReferenceHash: hash0"},
+			requests[0].checkRun.Output.Annotations[0])
+	})
+
+	t.Run("should annotate only the files changed by the pull request in the head commit", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests, []string{"cmd/file1.go"})
+		defer server.Close()
+		eventPath := writeEvent(t, `{"pull_request": {"number": 3, "head": {"sha": "9d8e7f6"}}}`)
+
+		newService(true, newGitHubEnv(server.URL, eventPath)).Publish(newAnalysis(4), 4, nil)
+
+		assert.Len(t, requests, 2)
+		assert.Equal(t, "/repos/ZupIT/horusec/pulls/3/files", requests[0].path)
+		assert.Equal(t, "9d8e7f6", requests[1].checkRun.HeadSha)
+		assert.Len(t, requests[1].checkRun.Output.Annotations, 2)
+		for _, annotation := range requests[1].checkRun.Output.Annotations {
+			assert.Equal(t, "cmd/file1.go", annotation.Path)
+		}
+	})
+
+	t.Run("should send the annotations in batches up to the limit of the check run", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests, nil)
+		defer server.Close()
+
+		newService(true, newGitHubEnv(server.URL, "")).Publish(newAnalysis(maxAnnotations+10), 0, nil)
+
+		assert.Len(t, requests, maxAnnotations/annotationsByRequest)
+		assert.Equal(t, http.MethodPatch, requests[1].method)
+		assert.Equal(t, "/repos/ZupIT/horusec/check-runs/7", requests[1].path)
+		for _, received := range requests {
+			assert.Len(t, received.checkRun.Output.Annotations, annotationsByRequest)
+		}
+		assert.Equal(t, ConclusionNeutral, requests[0].checkRun.Conclusion)
+		assert.Contains(t, requests[0].checkRun.Output.Summary, "Only the first 1000 are annotated")
+	})
+
+	t.Run("should conclude with success without vulnerabilities and failure with the error of the gates",
+		func(t *testing.T) {
+			var requests []request
+			server := newFakeServer(&requests, nil)
+			defer server.Close()
+			service := newService(true, newGitHubEnv(server.URL, ""))
+
+			service.Publish(newAnalysis(0), 0, nil)
+			service.Publish(newAnalysis(0), 0, errors.New("policy denied"))
+
+			assert.Equal(t, ConclusionSuccess, requests[0].checkRun.Conclusion)
+			assert.Equal(t, ConclusionFailure, requests[1].checkRun.Conclusion)
+			assert.Contains(t, requests[1].checkRun.Output.Summary, "**Gate:** policy denied")
+		})
+
+	t.Run("should not publish when disabled or out of the GitHub Actions", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests, nil)
+		defer server.Close()
+
+		newService(false, newGitHubEnv(server.URL, "")).Publish(newAnalysis(1), 1, nil)
+		newService(true, func(string) string { return "" }).Publish(newAnalysis(1), 1, nil)
+
+		assert.Empty(t, requests)
+	})
+}
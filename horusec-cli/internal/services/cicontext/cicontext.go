@@ -0,0 +1,217 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cicontext
+
+import (
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+const (
+	GitHubActions = "github-actions"
+	GitLabCI      = "gitlab-ci"
+	Jenkins       = "jenkins"
+	AzurePipeline = "azure-pipelines"
+	Bitbucket     = "bitbucket-pipelines"
+	Git           = "git"
+)
+
+var gitHubPullRequestRef = regexp.MustCompile(`^refs/pull/(\d+)/`)
+
+type Interface interface {
+	Detect() *horusec.SourceContext
+}
+
+type CIContext struct {
+	config cliConfig.IConfig
+	getEnv func(key string) string
+	runGit func(dir string, args ...string) string
+}
+
+func NewCIContext(config cliConfig.IConfig) Interface {
+	return &CIContext{
+		config: config,
+		getEnv: os.Getenv,
+		runGit: runGit,
+	}
+}
+
+// Detect uses the first CI environment found, or the git of the project when not running in CI or when analyzing
+// the clone of the repo url, and then the values of the config. It returns nil when nothing was found
+func (c *CIContext) Detect() *horusec.SourceContext {
+	var source *horusec.SourceContext
+	if c.config.GetRepoURL() == "" {
+		source = c.detectFromCI()
+	}
+	if source == nil {
+		source = c.detectFromGit()
+	}
+
+	c.setConfigValues(source)
+	if source.IsEmpty() {
+		return nil
+	}
+
+	return source
+}
+
+func (c *CIContext) detectFromCI() *horusec.SourceContext {
+	for _, detect := range []func() *horusec.SourceContext{
+		c.detectGitHubActions, c.detectGitLabCI, c.detectJenkins, c.detectAzurePipelines, c.detectBitbucket,
+	} {
+		if source := detect(); source != nil {
+			return source
+		}
+	}
+
+	return nil
+}
+
+func (c *CIContext) detectGitHubActions() *horusec.SourceContext {
+	if c.getEnv("GITHUB_ACTIONS") != "true" {
+		return nil
+	}
+
+	source := &horusec.SourceContext{
+		CIProvider:    GitHubActions,
+		RepositoryURL: c.joinURL(c.getEnv("GITHUB_SERVER_URL"), c.getEnv("GITHUB_REPOSITORY")),
+		Branch:        c.firstNotEmpty(c.getEnv("GITHUB_HEAD_REF"), c.getEnv("GITHUB_REF_NAME")),
+		CommitSHA:     c.getEnv("GITHUB_SHA"),
+	}
+	if match := gitHubPullRequestRef.FindStringSubmatch(c.getEnv("GITHUB_REF")); match != nil {
+		source.PullRequest = match[1]
+	} else if source.Branch == "" {
+		source.Branch = strings.TrimPrefix(c.getEnv("GITHUB_REF"), "refs/heads/")
+	}
+
+	return source
+}
+
+func (c *CIContext) detectGitLabCI() *horusec.SourceContext {
+	if c.getEnv("GITLAB_CI") != "true" {
+		return nil
+	}
+
+	return &horusec.SourceContext{
+		CIProvider:    GitLabCI,
+		RepositoryURL: c.getEnv("CI_PROJECT_URL"),
+		Branch:        c.firstNotEmpty(c.getEnv("CI_MERGE_REQUEST_SOURCE_BRANCH_NAME"), c.getEnv("CI_COMMIT_REF_NAME")),
+		CommitSHA:     c.getEnv("CI_COMMIT_SHA"),
+		PullRequest:   c.getEnv("CI_MERGE_REQUEST_IID"),
+	}
+}
+
+func (c *CIContext) detectJenkins() *horusec.SourceContext {
+	if c.getEnv("JENKINS_URL") == "" {
+		return nil
+	}
+
+	return &horusec.SourceContext{
+		CIProvider:    Jenkins,
+		RepositoryURL: c.getEnv("GIT_URL"),
+		Branch:        strings.TrimPrefix(c.firstNotEmpty(c.getEnv("CHANGE_BRANCH"), c.getEnv("GIT_BRANCH")), "origin/"),
+		CommitSHA:     c.getEnv("GIT_COMMIT"),
+		PullRequest:   c.getEnv("CHANGE_ID"),
+	}
+}
+
+func (c *CIContext) detectAzurePipelines() *horusec.SourceContext {
+	if !strings.EqualFold(c.getEnv("TF_BUILD"), "true") {
+		return nil
+	}
+
+	return &horusec.SourceContext{
+		CIProvider:    AzurePipeline,
+		RepositoryURL: c.getEnv("BUILD_REPOSITORY_URI"),
+		Branch: strings.TrimPrefix(c.firstNotEmpty(c.getEnv("SYSTEM_PULLREQUEST_SOURCEBRANCH"),
+			c.getEnv("BUILD_SOURCEBRANCH")), "refs/heads/"),
+		CommitSHA: c.getEnv("BUILD_SOURCEVERSION"),
+		PullRequest: c.firstNotEmpty(c.getEnv("SYSTEM_PULLREQUEST_PULLREQUESTNUMBER"),
+			c.getEnv("SYSTEM_PULLREQUEST_PULLREQUESTID")),
+	}
+}
+
+func (c *CIContext) detectBitbucket() *horusec.SourceContext {
+	if c.getEnv("BITBUCKET_BUILD_NUMBER") == "" {
+		return nil
+	}
+
+	return &horusec.SourceContext{
+		CIProvider:    Bitbucket,
+		RepositoryURL: c.getEnv("BITBUCKET_GIT_HTTP_ORIGIN"),
+		Branch:        c.getEnv("BITBUCKET_BRANCH"),
+		CommitSHA:     c.getEnv("BITBUCKET_COMMIT"),
+		PullRequest:   c.getEnv("BITBUCKET_PR_ID"),
+	}
+}
+
+func (c *CIContext) detectFromGit() *horusec.SourceContext {
+	projectPath := c.config.GetProjectPath()
+	source := &horusec.SourceContext{
+		RepositoryURL: c.runGit(projectPath, "config", "--get", "remote.origin.url"),
+		Branch:        c.runGit(projectPath, "rev-parse", "--abbrev-ref", "HEAD"),
+		CommitSHA:     c.runGit(projectPath, "rev-parse", "HEAD"),
+	}
+	if source.Branch == "HEAD" {
+		source.Branch = ""
+	}
+	if !source.IsEmpty() {
+		source.CIProvider = Git
+	}
+
+	return source
+}
+
+func (c *CIContext) setConfigValues(source *horusec.SourceContext) {
+	source.RepositoryURL = c.firstNotEmpty(c.config.GetSourceRepositoryURL(), source.RepositoryURL)
+	source.Branch = c.firstNotEmpty(c.config.GetSourceBranch(), source.Branch)
+	source.CommitSHA = c.firstNotEmpty(c.config.GetSourceCommit(), source.CommitSHA)
+	source.PullRequest = c.firstNotEmpty(c.config.GetSourcePullRequest(), source.PullRequest)
+}
+
+func (c *CIContext) joinURL(serverURL, repository string) string {
+	if serverURL == "" || repository == "" {
+		return ""
+	}
+
+	return strings.TrimSuffix(serverURL, "/") + "/" + repository
+}
+
+func (c *CIContext) firstNotEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// runGit returns empty when git is not installed or the path is not a git repository
+func runGit(dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(output))
+}
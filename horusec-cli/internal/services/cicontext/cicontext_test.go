@@ -0,0 +1,150 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cicontext
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCIContextToTest(config cliConfig.IConfig, env map[string]string, git map[string]string) *CIContext {
+	return &CIContext{
+		config: config,
+		getEnv: func(key string) string {
+			return env[key]
+		},
+		runGit: func(_ string, args ...string) string {
+			return git[strings.Join(args, " ")]
+		},
+	}
+}
+
+func TestDetect(t *testing.T) {
+	t.Run("Should detect the source context of a pull request in github actions", func(t *testing.T) {
+		source := newCIContextToTest(&cliConfig.Config{}, map[string]string{
+			"GITHUB_ACTIONS":    "true",
+			"GITHUB_SERVER_URL": "https://github.com",
+			"GITHUB_REPOSITORY": "ZupIT/horusec",
+			"GITHUB_HEAD_REF":   "feature",
+			"GITHUB_REF":        "refs/pull/42/merge",
+			"GITHUB_SHA":        "abc123",
+		}, nil).Detect()
+
+		assert.Equal(t, &horusec.SourceContext{CIProvider: GitHubActions, RepositoryURL: "https://github.com/ZupIT/horusec",
+			Branch: "feature", CommitSHA: "abc123", PullRequest: "42"}, source)
+	})
+
+	t.Run("Should detect the source context of a merge request in gitlab ci", func(t *testing.T) {
+		source := newCIContextToTest(&cliConfig.Config{}, map[string]string{
+			"GITLAB_CI":                           "true",
+			"CI_PROJECT_URL":                      "https://gitlab.com/zup/horusec",
+			"CI_MERGE_REQUEST_SOURCE_BRANCH_NAME": "feature",
+			"CI_COMMIT_REF_NAME":                  "main",
+			"CI_COMMIT_SHA":                       "abc123",
+			"CI_MERGE_REQUEST_IID":                "7",
+		}, nil).Detect()
+
+		assert.Equal(t, &horusec.SourceContext{CIProvider: GitLabCI, RepositoryURL: "https://gitlab.com/zup/horusec",
+			Branch: "feature", CommitSHA: "abc123", PullRequest: "7"}, source)
+	})
+
+	t.Run("Should detect the source context in jenkins", func(t *testing.T) {
+		source := newCIContextToTest(&cliConfig.Config{}, map[string]string{
+			"JENKINS_URL": "https://jenkins.local",
+			"GIT_URL":     "https://github.com/ZupIT/horusec.git",
+			"GIT_BRANCH":  "origin/main",
+			"GIT_COMMIT":  "abc123",
+		}, nil).Detect()
+
+		assert.Equal(t, &horusec.SourceContext{CIProvider: Jenkins, RepositoryURL: "https://github.com/ZupIT/horusec.git",
+			Branch: "main", CommitSHA: "abc123"}, source)
+	})
+
+	t.Run("Should detect the source context in azure pipelines", func(t *testing.T) {
+		source := newCIContextToTest(&cliConfig.Config{}, map[string]string{
+			"TF_BUILD":                             "True",
+			"BUILD_REPOSITORY_URI":                 "https://dev.azure.com/zup/horusec",
+			"SYSTEM_PULLREQUEST_SOURCEBRANCH":      "refs/heads/feature",
+			"BUILD_SOURCEVERSION":                  "abc123",
+			"SYSTEM_PULLREQUEST_PULLREQUESTNUMBER": "3",
+		}, nil).Detect()
+
+		assert.Equal(t, &horusec.SourceContext{CIProvider: AzurePipeline, RepositoryURL: "https://dev.azure.com/zup/horusec",
+			Branch: "feature", CommitSHA: "abc123", PullRequest: "3"}, source)
+	})
+
+	t.Run("Should detect the source context in bitbucket pipelines", func(t *testing.T) {
+		source := newCIContextToTest(&cliConfig.Config{}, map[string]string{
+			"BITBUCKET_BUILD_NUMBER":    "10",
+			"BITBUCKET_GIT_HTTP_ORIGIN": "http://bitbucket.org/zup/horusec",
+			"BITBUCKET_BRANCH":          "main",
+			"BITBUCKET_COMMIT":          "abc123",
+		}, nil).Detect()
+
+		assert.Equal(t, &horusec.SourceContext{CIProvider: Bitbucket, RepositoryURL: "http://bitbucket.org/zup/horusec",
+			Branch: "main", CommitSHA: "abc123"}, source)
+	})
+
+	t.Run("Should detect the source context with git when not running in ci", func(t *testing.T) {
+		source := newCIContextToTest(&cliConfig.Config{}, nil, map[string]string{
+			"config --get remote.origin.url": "git@github.com:ZupIT/horusec.git",
+			"rev-parse --abbrev-ref HEAD":    "main",
+			"rev-parse HEAD":                 "abc123",
+		}).Detect()
+
+		assert.Equal(t, &horusec.SourceContext{CIProvider: Git, RepositoryURL: "git@github.com:ZupIT/horusec.git",
+			Branch: "main", CommitSHA: "abc123"}, source)
+	})
+
+	t.Run("Should detect the source context with git when analyzing the clone of the repo url", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetRepoURL("https://github.com/ZupIT/horusec.git")
+
+		source := newCIContextToTest(config, map[string]string{
+			"GITLAB_CI":     "true",
+			"CI_COMMIT_SHA": "def456",
+		}, map[string]string{
+			"config --get remote.origin.url": "https://github.com/ZupIT/horusec.git",
+			"rev-parse --abbrev-ref HEAD":    "main",
+			"rev-parse HEAD":                 "abc123",
+		}).Detect()
+
+		assert.Equal(t, &horusec.SourceContext{CIProvider: Git, RepositoryURL: "https://github.com/ZupIT/horusec.git",
+			Branch: "main", CommitSHA: "abc123"}, source)
+	})
+
+	t.Run("Should use the values of the config over the detected ones", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetSourceBranch("release")
+		config.SetSourcePullRequest("99")
+
+		source := newCIContextToTest(config, map[string]string{
+			"BITBUCKET_BUILD_NUMBER": "10",
+			"BITBUCKET_BRANCH":       "main",
+			"BITBUCKET_COMMIT":       "abc123",
+		}, nil).Detect()
+
+		assert.Equal(t, &horusec.SourceContext{CIProvider: Bitbucket, Branch: "release", CommitSHA: "abc123",
+			PullRequest: "99"}, source)
+	})
+
+	t.Run("Should return nil when nothing was detected", func(t *testing.T) {
+		assert.Nil(t, newCIContextToTest(&cliConfig.Config{}, nil, nil).Detect())
+	})
+}
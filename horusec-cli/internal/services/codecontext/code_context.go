@@ -0,0 +1,125 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecontext
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+const (
+	// maxFileSize avoids reading generated files, like bundles and dumps, that aren't reviewed by their code
+	maxFileSize = 5 * 1024 * 1024
+	// maxLineLength truncates the minified lines, that would fill the output with a single line
+	maxLineLength = 300
+)
+
+type Interface interface {
+	SetCodeContext(analysis *horusec.Analysis)
+}
+
+type CodeContext struct {
+	config cliConfig.IConfig
+}
+
+func NewCodeContext(config cliConfig.IConfig) Interface {
+	return &CodeContext{config: config}
+}
+
+// SetCodeContext keeps the lines before and after the line of each vulnerability, read from the files of the project.
+// The vulnerabilities without line or with files not found, like the ones of the dependencies, are kept without context
+func (c *CodeContext) SetCodeContext(analysis *horusec.Analysis) {
+	filesLines := map[string][]string{}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		vulnerability.CodeContext = nil
+		line, err := strconv.Atoi(vulnerability.Line)
+		if err != nil || line <= 0 || c.isFromGitHistory(vulnerability) {
+			continue
+		}
+		lines, ok := filesLines[vulnerability.File]
+		if !ok {
+			lines = c.readLines(vulnerability.File)
+			filesLines[vulnerability.File] = lines
+		}
+		vulnerability.CodeContext = c.getCodeContext(lines, line)
+	}
+}
+
+// isFromGitHistory checks the leaks found in the commits, their lines can be different in the current files
+func (c *CodeContext) isFromGitHistory(vulnerability *horusec.Vulnerability) bool {
+	return vulnerability.SecurityTool == tools.GitLeaks && c.config.GetEnableGitHistoryAnalysis()
+}
+
+func (c *CodeContext) getCodeContext(lines []string, line int) (codeContext []horusec.CodeLine) {
+	if line > len(lines) {
+		return nil
+	}
+	contextLines := int(c.config.GetCodeContextLines())
+	for current := line - contextLines; current <= line+contextLines; current++ {
+		if current >= 1 && current <= len(lines) {
+			codeContext = append(codeContext, horusec.CodeLine{Line: current, Code: lines[current-1]})
+		}
+	}
+	return codeContext
+}
+
+func (c *CodeContext) readLines(path string) (lines []string) {
+	file, err := c.openFile(path)
+	if err != nil {
+		return nil
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, bufio.MaxScanTokenSize), maxFileSize)
+	for scanner.Scan() {
+		lines = append(lines, c.truncateLine(strings.TrimRight(scanner.Text(), "\r")))
+	}
+	if scanner.Err() != nil {
+		return nil
+	}
+	return lines
+}
+
+func (c *CodeContext) openFile(path string) (*os.File, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(c.config.GetProjectPath(), path)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.Mode().IsRegular() || info.Size() > maxFileSize {
+		return nil, os.ErrInvalid
+	}
+	return os.Open(path)
+}
+
+func (c *CodeContext) truncateLine(line string) string {
+	runes := []rune(line)
+	if len(runes) <= maxLineLength {
+		return line
+	}
+	return string(runes[:maxLineLength]) + "..."
+}
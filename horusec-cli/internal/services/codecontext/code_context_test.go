@@ -0,0 +1,105 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codecontext
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysisWithVulnerabilities(vulnerabilities ...horusec.Vulnerability) *horusec.Analysis {
+	analysis := &horusec.Analysis{}
+	for index := range vulnerabilities {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+			horusec.AnalysisVulnerabilities{Vulnerability: vulnerabilities[index]})
+	}
+	return analysis
+}
+
+func newConfigWithProject(t *testing.T, contextLines int64) cliConfig.IConfig {
+	projectPath, err := ioutil.TempDir("", "horusec-code-context")
+	assert.NoError(t, err)
+	content := "package main\n\nimport \"os\"\n\nfunc main() {\n\tos.Exit(0)\n}\n"
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "main.go"), []byte(content), 0600))
+	t.Cleanup(func() {
+		_ = os.RemoveAll(projectPath)
+	})
+	config := cliConfig.NewConfig()
+	config.SetProjectPath(projectPath)
+	config.SetCodeContextLines(contextLines)
+	return config
+}
+
+func TestSetCodeContext(t *testing.T) {
+	t.Run("Should keep the lines around the line of the vulnerability", func(t *testing.T) {
+		analysis := newAnalysisWithVulnerabilities(horusec.Vulnerability{File: "main.go", Line: "3"})
+
+		NewCodeContext(newConfigWithProject(t, 1)).SetCodeContext(analysis)
+
+		assert.Equal(t, []horusec.CodeLine{{Line: 2, Code: ""}, {Line: 3, Code: `import "os"`},
+			{Line: 4, Code: ""}}, analysis.AnalysisVulnerabilities[0].Vulnerability.CodeContext)
+	})
+	t.Run("Should keep only the lines of the file when the vulnerability is near the start", func(t *testing.T) {
+		analysis := newAnalysisWithVulnerabilities(horusec.Vulnerability{File: "main.go", Line: "1"})
+
+		NewCodeContext(newConfigWithProject(t, 2)).SetCodeContext(analysis)
+
+		assert.Equal(t, []horusec.CodeLine{{Line: 1, Code: "package main"}, {Line: 2, Code: ""},
+			{Line: 3, Code: `import "os"`}}, analysis.AnalysisVulnerabilities[0].Vulnerability.CodeContext)
+	})
+	t.Run("Should not keep context when the line or the file aren't valid", func(t *testing.T) {
+		analysis := newAnalysisWithVulnerabilities(
+			horusec.Vulnerability{File: "main.go", Line: "0"},
+			horusec.Vulnerability{File: "main.go", Line: "100"},
+			horusec.Vulnerability{File: "go.sum", Line: "1"},
+			horusec.Vulnerability{File: "main.go", Line: "-"},
+		)
+
+		NewCodeContext(newConfigWithProject(t, 2)).SetCodeContext(analysis)
+
+		for index := range analysis.AnalysisVulnerabilities {
+			assert.Empty(t, analysis.AnalysisVulnerabilities[index].Vulnerability.CodeContext)
+		}
+	})
+	t.Run("Should not keep context of the leaks found in the git history", func(t *testing.T) {
+		analysis := newAnalysisWithVulnerabilities(
+			horusec.Vulnerability{File: "main.go", Line: "3", SecurityTool: tools.GitLeaks})
+		config := newConfigWithProject(t, 2)
+		config.SetEnableGitHistoryAnalysis(true)
+
+		NewCodeContext(config).SetCodeContext(analysis)
+
+		assert.Empty(t, analysis.AnalysisVulnerabilities[0].Vulnerability.CodeContext)
+	})
+	t.Run("Should truncate the long lines", func(t *testing.T) {
+		config := newConfigWithProject(t, 0)
+		content := []byte(strings.Repeat("a", maxLineLength+10))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(config.GetProjectPath(), "bundle.js"), content, 0600))
+		analysis := newAnalysisWithVulnerabilities(horusec.Vulnerability{File: "bundle.js", Line: "1"})
+
+		NewCodeContext(config).SetCodeContext(analysis)
+
+		assert.Equal(t, strings.Repeat("a", maxLineLength)+"...",
+			analysis.AnalysisVulnerabilities[0].Vulnerability.CodeContext[0].Code)
+	})
+}
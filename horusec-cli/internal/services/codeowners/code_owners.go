@@ -0,0 +1,193 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codeowners
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v2"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+// Unowned is the name of the report of the vulnerabilities without owners when the report is split by owner
+const Unowned = "unowned"
+
+// defaultPaths are the paths of the CODEOWNERS in the order that GitHub and GitLab look for them
+var defaultPaths = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS", ".gitlab/CODEOWNERS"}
+
+type Interface interface {
+	SetOwners(analysis *horusec.Analysis)
+}
+
+type CodeOwners struct {
+	config cliConfig.IConfig
+}
+
+type rule struct {
+	patterns []string
+	owners   []string
+}
+
+func NewCodeOwners(config cliConfig.IConfig) Interface {
+	return &CodeOwners{
+		config: config,
+	}
+}
+
+// SetOwners fills the owners of the vulnerabilities with the last rule of the CODEOWNERS that matches their file,
+// like GitHub and GitLab, the owner mappings of the config are the last rules. Without rules the analysis isn't
+// changed
+func (c *CodeOwners) SetOwners(analysis *horusec.Analysis) {
+	rules, err := c.getRules()
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadCodeOwners, err, logger.ErrorLevel)
+	}
+	mappings, _ := parse(strings.NewReader(strings.Join(c.config.GetOwnerMappings(), "\n")))
+	if rules = append(rules, mappings...); len(rules) == 0 {
+		return
+	}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		vulnerability.Owners = getOwners(rules, vulnerability.File)
+	}
+}
+
+func (c *CodeOwners) getRules() ([]rule, error) {
+	path := c.getPath()
+	if path == "" {
+		return nil, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return parse(file)
+}
+
+func (c *CodeOwners) getPath() string {
+	if c.config.GetCodeOwnersPath() != "" {
+		return c.joinProjectPath(c.config.GetCodeOwnersPath())
+	}
+	for _, path := range defaultPaths {
+		if info, err := os.Stat(c.joinProjectPath(path)); err == nil && !info.IsDir() {
+			return c.joinProjectPath(path)
+		}
+	}
+	return ""
+}
+
+func (c *CodeOwners) joinProjectPath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(c.config.GetProjectPath(), filepath.FromSlash(path))
+}
+
+// parse ignores the comments and the headers of the sections of GitLab, a rule without owners removes the owners of
+// the rules before it
+func parse(reader io.Reader) (rules []rule, err error) {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if index := strings.Index(line, " #"); index >= 0 {
+			line = line[:index]
+		}
+		if line == "" || strings.HasPrefix(line, "#") || isSectionHeader(line) {
+			continue
+		}
+		fields := strings.Fields(line)
+		rules = append(rules, rule{patterns: toDoublestarPatterns(fields[0]), owners: fields[1:]})
+	}
+	return rules, scanner.Err()
+}
+
+func isSectionHeader(line string) bool {
+	return strings.HasPrefix(line, "[") || strings.HasPrefix(line, "^[")
+}
+
+// toDoublestarPatterns converts the pattern of the CODEOWNERS, that follows the rules of the gitignore: the patterns
+// without slash match in any folder, the patterns of folders match all their files and * doesn't match the files of
+// the sub folders
+func toDoublestarPatterns(pattern string) []string {
+	isFolder := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	if !strings.Contains(pattern, "/") {
+		pattern = "**/" + pattern
+	}
+	pattern = strings.TrimPrefix(pattern, "/")
+	if isFolder {
+		return []string{pattern + "/**"}
+	}
+	if strings.HasSuffix(pattern, "/*") {
+		return []string{pattern}
+	}
+	return []string{pattern, pattern + "/**"}
+}
+
+func getOwners(rules []rule, file string) []string {
+	file = strings.TrimPrefix(strings.TrimPrefix(filepath.ToSlash(file), "./"), "/")
+	if file == "" {
+		return nil
+	}
+	for index := len(rules) - 1; index >= 0; index-- {
+		if rules[index].match(file) {
+			return rules[index].owners
+		}
+	}
+	return nil
+}
+
+func (r *rule) match(file string) bool {
+	for _, pattern := range r.patterns {
+		if matched, err := doublestar.Match(pattern, file); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// GetVulnerabilitiesByOwner groups the vulnerabilities by owner, the vulnerabilities of many owners are in the group
+// of each one and the vulnerabilities without owners are in the group unowned
+func GetVulnerabilitiesByOwner(analysis *horusec.Analysis) map[string][]horusec.AnalysisVulnerabilities {
+	vulnerabilitiesByOwner := map[string][]horusec.AnalysisVulnerabilities{}
+	for index := range analysis.AnalysisVulnerabilities {
+		for _, owner := range GetOwnersOrUnowned(&analysis.AnalysisVulnerabilities[index].Vulnerability) {
+			vulnerabilitiesByOwner[owner] = append(vulnerabilitiesByOwner[owner], analysis.AnalysisVulnerabilities[index])
+		}
+	}
+	return vulnerabilitiesByOwner
+}
+
+// IsValidRule checks if the line of the owner mappings has the pattern and the owners
+func IsValidRule(line string) bool {
+	return len(strings.Fields(line)) > 1 && !strings.HasPrefix(strings.TrimSpace(line), "#")
+}
+
+// GetOwnersOrUnowned returns the owners of the vulnerability or unowned, used to split the report by owner
+func GetOwnersOrUnowned(vulnerability *horusec.Vulnerability) []string {
+	if len(vulnerability.Owners) == 0 {
+		return []string{Unowned}
+	}
+	return vulnerability.Owners
+}
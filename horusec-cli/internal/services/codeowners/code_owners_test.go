@@ -0,0 +1,138 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codeowners
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+const codeOwnersToTest = `# default owners
+*       @org/security
+
+*.js    @org/frontend # javascript
+/docs/  @org/docs
+apps/   @org/apps
+src/api/* @org/api  @john
+/vendor/
+
+[Section]
+`
+
+func newAnalysisToTest(files ...string) *horusec.Analysis {
+	analysis := &horusec.Analysis{}
+	for _, file := range files {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+			horusec.AnalysisVulnerabilities{Vulnerability: horusec.Vulnerability{File: file}})
+	}
+	return analysis
+}
+
+func TestGetOwners(t *testing.T) {
+	rules, err := parse(strings.NewReader(codeOwnersToTest))
+	assert.NoError(t, err)
+
+	t.Run("Should return the owners of the last rule that matches the file", func(t *testing.T) {
+		assert.Equal(t, []string{"@org/security"}, getOwners(rules, "main.go"))
+		assert.Equal(t, []string{"@org/frontend"}, getOwners(rules, "web/src/index.js"))
+		assert.Equal(t, []string{"@org/docs"}, getOwners(rules, "docs/guide/index.md"))
+		assert.Equal(t, []string{"@org/apps"}, getOwners(rules, "services/apps/main.go"))
+		assert.Equal(t, []string{"@org/api", "@john"}, getOwners(rules, "./src/api/handler.go"))
+	})
+
+	t.Run("Should not match the files of the sub folders with *", func(t *testing.T) {
+		assert.Equal(t, []string{"@org/security"}, getOwners(rules, "src/api/v1/handler.go"))
+	})
+
+	t.Run("Should return no owners when the last rule has no owners", func(t *testing.T) {
+		assert.Empty(t, getOwners(rules, "vendor/lib/lib.go"))
+		assert.Empty(t, getOwners(rules, ""))
+	})
+}
+
+func TestSetOwners(t *testing.T) {
+	dir, err := ioutil.TempDir("", "codeowners")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	t.Run("Should not change the analysis without CODEOWNERS", func(t *testing.T) {
+		configs := cliConfig.NewConfig()
+		configs.SetProjectPath(dir)
+		analysis := newAnalysisToTest("main.go")
+
+		NewCodeOwners(configs).SetOwners(analysis)
+		assert.Nil(t, analysis.AnalysisVulnerabilities[0].Vulnerability.Owners)
+	})
+
+	t.Run("Should fill the owners with the CODEOWNERS of the .github folder", func(t *testing.T) {
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, ".github"), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".github", "CODEOWNERS"),
+			[]byte(codeOwnersToTest), 0600))
+		configs := cliConfig.NewConfig()
+		configs.SetProjectPath(dir)
+		analysis := newAnalysisToTest("main.go", "index.js")
+
+		NewCodeOwners(configs).SetOwners(analysis)
+		assert.Equal(t, []string{"@org/security"}, analysis.AnalysisVulnerabilities[0].Vulnerability.Owners)
+		assert.Equal(t, []string{"@org/frontend"}, analysis.AnalysisVulnerabilities[1].Vulnerability.Owners)
+	})
+
+	t.Run("Should fill the owners with the CODEOWNERS of the config", func(t *testing.T) {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "OWNERS"), []byte("* @org/platform\n"), 0600))
+		configs := cliConfig.NewConfig()
+		configs.SetProjectPath(dir)
+		configs.SetCodeOwnersPath("OWNERS")
+		analysis := newAnalysisToTest("main.go")
+
+		NewCodeOwners(configs).SetOwners(analysis)
+		assert.Equal(t, []string{"@org/platform"}, analysis.AnalysisVulnerabilities[0].Vulnerability.Owners)
+	})
+
+	t.Run("Should apply the owner mappings after the CODEOWNERS", func(t *testing.T) {
+		configs := cliConfig.NewConfig()
+		configs.SetProjectPath(dir)
+		configs.SetOwnerMappings([]string{"services/api/ @org/api", "services/api/docs/ @org/docs @john"})
+		analysis := newAnalysisToTest("main.go", "services/api/main.go", "services/api/docs/index.md")
+
+		NewCodeOwners(configs).SetOwners(analysis)
+		assert.Equal(t, []string{"@org/security"}, analysis.AnalysisVulnerabilities[0].Vulnerability.Owners)
+		assert.Equal(t, []string{"@org/api"}, analysis.AnalysisVulnerabilities[1].Vulnerability.Owners)
+		assert.Equal(t, []string{"@org/docs", "@john"}, analysis.AnalysisVulnerabilities[2].Vulnerability.Owners)
+	})
+
+	t.Run("Should group the vulnerabilities by owner", func(t *testing.T) {
+		analysis := newAnalysisToTest("main.go", "index.js", "README.md")
+		analysis.AnalysisVulnerabilities[0].Vulnerability.Owners = []string{"@org/api", "@org/web"}
+		analysis.AnalysisVulnerabilities[1].Vulnerability.Owners = []string{"@org/web"}
+
+		vulnerabilitiesByOwner := GetVulnerabilitiesByOwner(analysis)
+		assert.Len(t, vulnerabilitiesByOwner["@org/api"], 1)
+		assert.Len(t, vulnerabilitiesByOwner["@org/web"], 2)
+		assert.Len(t, vulnerabilitiesByOwner[Unowned], 1)
+	})
+
+	t.Run("Should return unowned to the vulnerabilities without owners", func(t *testing.T) {
+		assert.Equal(t, []string{Unowned}, GetOwnersOrUnowned(&horusec.Vulnerability{}))
+		assert.Equal(t, []string{"@john"}, GetOwnersOrUnowned(&horusec.Vulnerability{Owners: []string{"@john"}}))
+	})
+}
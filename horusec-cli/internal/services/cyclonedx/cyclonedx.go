@@ -0,0 +1,250 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cyclonedx
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	horusecSeverity "github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/cyclonedx"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/version"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/dependencysubmission"
+)
+
+const (
+	BOMFormat      = "CycloneDX"
+	SpecVersion    = "1.5"
+	ProjectBOMRef  = "project"
+	DefaultProject = "project"
+	NVDURL         = "https://nvd.nist.gov/vuln/detail/"
+)
+
+var (
+	cwePattern = regexp.MustCompile(`CWE-(\d+)`)
+	cvePattern = regexp.MustCompile(`CVE-\d{4}-\d{4,}`)
+)
+
+type Interface interface {
+	ConvertVulnerabilityDataToCycloneDX() cyclonedx.VDR
+}
+
+type CycloneDX struct {
+	analysis   *horusecEntities.Analysis
+	components map[string]bool
+	bomRefs    map[string]int
+}
+
+func NewCycloneDX(analysis *horusecEntities.Analysis) Interface {
+	return &CycloneDX{
+		analysis: analysis,
+	}
+}
+
+// ConvertVulnerabilityDataToCycloneDX writes all the vulnerabilities, the false positives, risk accepted and
+// corrected are written with the state of the impact analysis of CycloneDX
+func (c *CycloneDX) ConvertVulnerabilityDataToCycloneDX() cyclonedx.VDR {
+	c.components = map[string]bool{}
+	c.bomRefs = map[string]int{}
+	report := cyclonedx.VDR{
+		BOMFormat:       BOMFormat,
+		SpecVersion:     SpecVersion,
+		SerialNumber:    "urn:uuid:" + c.analysis.ID.String(),
+		Version:         1,
+		Metadata:        c.newMetadata(),
+		Components:      []cyclonedx.Component{},
+		Vulnerabilities: []cyclonedx.Vulnerability{},
+	}
+	for index := range c.analysis.AnalysisVulnerabilities {
+		vulnerability := &c.analysis.AnalysisVulnerabilities[index].Vulnerability
+		component := c.newComponent(vulnerability)
+		if component != nil && !c.components[component.BOMRef] {
+			c.components[component.BOMRef] = true
+			report.Components = append(report.Components, *component)
+		}
+		report.Vulnerabilities = append(report.Vulnerabilities, c.newVulnerability(vulnerability, component))
+	}
+	return report
+}
+
+func (c *CycloneDX) newMetadata() cyclonedx.Metadata {
+	name := c.analysis.RepositoryName
+	if name == "" {
+		name = DefaultProject
+	}
+	return cyclonedx.Metadata{
+		Timestamp: c.getTimestamp(),
+		Tools: cyclonedx.Tools{Components: []cyclonedx.Component{
+			{Type: "application", Author: "ZupIT", Name: "horusec", Version: version.Version},
+		}},
+		Component: &cyclonedx.Component{Type: "application", BOMRef: ProjectBOMRef, Name: name},
+	}
+}
+
+func (c *CycloneDX) getTimestamp() string {
+	date := c.analysis.FinishedAt
+	if date.IsZero() {
+		date = c.analysis.CreatedAt
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+	return date.UTC().Format(time.RFC3339)
+}
+
+// newComponent returns the library of the dependency, identified by the purl when the tool has a package type, or
+// the source file of the vulnerability, nil when the vulnerability has neither
+func (c *CycloneDX) newComponent(vulnerability *horusecEntities.Vulnerability) *cyclonedx.Component {
+	if vulnerability.Dependency != nil && vulnerability.Dependency.Name != "" {
+		purl := dependencysubmission.GetPackageURL(vulnerability)
+		bomRef := purl
+		if bomRef == "" {
+			bomRef = fmt.Sprintf("dependency:%s@%s", vulnerability.Dependency.Name, vulnerability.Dependency.Version)
+		}
+		return &cyclonedx.Component{Type: "library", BOMRef: bomRef, Name: vulnerability.Dependency.Name,
+			Version: vulnerability.Dependency.Version, Purl: purl}
+	}
+	if vulnerability.File != "" {
+		return &cyclonedx.Component{Type: "file", BOMRef: "file:" + vulnerability.File, Name: vulnerability.File}
+	}
+	return nil
+}
+
+func (c *CycloneDX) newVulnerability(vulnerability *horusecEntities.Vulnerability,
+	component *cyclonedx.Component) cyclonedx.Vulnerability {
+	affectedRef := ProjectBOMRef
+	if component != nil {
+		affectedRef = component.BOMRef
+	}
+	id, source := c.getIDAndSource(vulnerability)
+	return cyclonedx.Vulnerability{
+		BOMRef: c.getBOMRef(vulnerability),
+		ID:     id,
+		Source: source,
+		Ratings: []cyclonedx.Rating{{Source: &cyclonedx.Source{Name: vulnerability.SecurityTool.ToString()},
+			Severity: c.convertHorusecSeverityToCycloneDX(vulnerability.Severity), Method: "other"}},
+		Cwes:           c.getCwes(vulnerability.Details),
+		Description:    vulnerability.Details,
+		Recommendation: c.getRecommendation(vulnerability),
+		Analysis:       c.getAnalysis(vulnerability),
+		Affects:        []cyclonedx.Affect{{Ref: affectedRef}},
+		Properties:     c.getProperties(vulnerability),
+	}
+}
+
+// getBOMRef uses the hash of the vulnerability, with a suffix when the same hash was already used in the report
+func (c *CycloneDX) getBOMRef(vulnerability *horusecEntities.Vulnerability) string {
+	bomRef := vulnerability.VulnHash
+	if bomRef == "" {
+		bomRef = vulnerability.VulnerabilityID.String()
+	}
+	c.bomRefs[bomRef]++
+	if count := c.bomRefs[bomRef]; count > 1 {
+		return fmt.Sprintf("%s-%d", bomRef, count)
+	}
+	return bomRef
+}
+
+// getIDAndSource uses the CVE of the details with the NVD as source, or the rule of the tool with the tool as source
+func (c *CycloneDX) getIDAndSource(vulnerability *horusecEntities.Vulnerability) (string, *cyclonedx.Source) {
+	if cve := cvePattern.FindString(vulnerability.Details); cve != "" {
+		return cve, &cyclonedx.Source{Name: "NVD", URL: NVDURL + cve}
+	}
+	source := &cyclonedx.Source{Name: vulnerability.SecurityTool.ToString()}
+	if vulnerability.RuleID != "" {
+		return vulnerability.RuleID, source
+	}
+	return vulnerability.VulnHash, source
+}
+
+func (c *CycloneDX) getCwes(details string) (cwes []int) {
+	found := map[int]bool{}
+	for _, match := range cwePattern.FindAllStringSubmatch(details, -1) {
+		cweID, err := strconv.Atoi(match[1])
+		if err == nil && !found[cweID] {
+			found[cweID] = true
+			cwes = append(cwes, cweID)
+		}
+	}
+	return cwes
+}
+
+func (c *CycloneDX) getRecommendation(vulnerability *horusecEntities.Vulnerability) string {
+	if vulnerability.Dependency == nil || vulnerability.Dependency.FixedVersion == "" {
+		return ""
+	}
+	return fmt.Sprintf("Upgrade %s to the version %s", vulnerability.Dependency.Name,
+		vulnerability.Dependency.FixedVersion)
+}
+
+// getAnalysis returns the impact analysis only to the vulnerabilities already triaged
+func (c *CycloneDX) getAnalysis(vulnerability *horusecEntities.Vulnerability) *cyclonedx.Analysis {
+	analysis := &cyclonedx.Analysis{}
+	switch vulnerability.Type {
+	case enumHorusec.FalsePositive:
+		analysis.State = "false_positive"
+	case enumHorusec.RiskAccepted:
+		analysis.State = "exploitable"
+		analysis.Response = []string{"will_not_fix"}
+	case enumHorusec.Corrected:
+		analysis.State = "resolved"
+	default:
+		return nil
+	}
+	if vulnerability.Triage != nil {
+		analysis.Detail = vulnerability.Triage.Justification
+	}
+	return analysis
+}
+
+// getProperties keeps the source file reference of the vulnerability, the file is the lockfile to the dependencies
+func (c *CycloneDX) getProperties(vulnerability *horusecEntities.Vulnerability) []cyclonedx.Property {
+	properties := []cyclonedx.Property{
+		{Name: "horusec:vulnHash", Value: vulnerability.VulnHash},
+		{Name: "horusec:language", Value: vulnerability.Language.ToString()},
+		{Name: "horusec:confidence", Value: vulnerability.Confidence},
+	}
+	for _, property := range []cyclonedx.Property{{Name: "horusec:file", Value: vulnerability.File},
+		{Name: "horusec:line", Value: vulnerability.Line}, {Name: "horusec:column", Value: vulnerability.Column}} {
+		if property.Value != "" {
+			properties = append(properties, property)
+		}
+	}
+	return properties
+}
+
+// convertHorusecSeverityToCycloneDX uses none to NOSEC and unknown to the severities without a CycloneDX severity,
+// like AUDIT
+func (c *CycloneDX) convertHorusecSeverityToCycloneDX(severity horusecSeverity.Severity) string {
+	if cycloneDXSeverity, ok := c.getCycloneDXSeverityMap()[severity]; ok {
+		return cycloneDXSeverity
+	}
+	return "unknown"
+}
+
+func (c *CycloneDX) getCycloneDXSeverityMap() map[horusecSeverity.Severity]string {
+	return map[horusecSeverity.Severity]string{
+		horusecSeverity.Critical: "critical",
+		horusecSeverity.High:     "high",
+		horusecSeverity.Medium:   "medium",
+		horusecSeverity.Low:      "low",
+		horusecSeverity.Info:     "info",
+		horusecSeverity.NoSec:    "none",
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cyclonedx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/cyclonedx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysis() *horusec.Analysis {
+	return &horusec.Analysis{
+		ID:             uuid.New(),
+		CreatedAt:      time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC),
+		RepositoryName: "my-repository",
+		Status:         enumHorusec.Success,
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{
+				Vulnerability: horusec.Vulnerability{
+					Line:         "10",
+					Column:       "2",
+					File:         "main.go",
+					Details:      "Command injection\nCWE-78: Improper Neutralization",
+					Severity:     severity.High,
+					SecurityTool: tools.GoSec,
+					RuleID:       "G204",
+					VulnHash:     "hash-1",
+					Type:         enumHorusec.Vulnerability,
+				},
+			},
+			{
+				Vulnerability: horusec.Vulnerability{
+					File:         "package-lock.json",
+					Details:      "Prototype pollution CVE-2021-23337",
+					Severity:     severity.Critical,
+					SecurityTool: tools.NpmAudit,
+					VulnHash:     "hash-2",
+					Type:         enumHorusec.RiskAccepted,
+					Dependency:   &horusec.Dependency{Name: "lodash", Version: "4.17.15", FixedVersion: "4.17.21"},
+				},
+			},
+			{
+				Vulnerability: horusec.Vulnerability{
+					File:         "yarn.lock",
+					Details:      "Prototype pollution CVE-2021-23337",
+					Severity:     severity.Critical,
+					SecurityTool: tools.YarnAudit,
+					VulnHash:     "hash-2",
+					Type:         enumHorusec.FalsePositive,
+					Dependency:   &horusec.Dependency{Name: "lodash", Version: "4.17.15"},
+					Triage:       &horusec.Triage{Justification: "not used in production"},
+				},
+			},
+		},
+	}
+}
+
+func TestConvertVulnerabilityDataToCycloneDX(t *testing.T) {
+	t.Run("should convert the vulnerabilities to the vulnerability disclosure report", func(t *testing.T) {
+		analysis := newAnalysis()
+		report := NewCycloneDX(analysis).ConvertVulnerabilityDataToCycloneDX()
+
+		assert.Equal(t, "CycloneDX", report.BOMFormat)
+		assert.Equal(t, "1.5", report.SpecVersion)
+		assert.Equal(t, "urn:uuid:"+analysis.ID.String(), report.SerialNumber)
+		assert.Equal(t, "2021-01-02T03:04:05Z", report.Metadata.Timestamp)
+		assert.Equal(t, "my-repository", report.Metadata.Component.Name)
+		assert.Len(t, report.Components, 2)
+		assert.Len(t, report.Vulnerabilities, 3)
+	})
+	t.Run("should link the static vulnerabilities to the source file", func(t *testing.T) {
+		report := NewCycloneDX(newAnalysis()).ConvertVulnerabilityDataToCycloneDX()
+
+		vulnerability := report.Vulnerabilities[0]
+		assert.Equal(t, "file", report.Components[0].Type)
+		assert.Equal(t, "file:main.go", vulnerability.Affects[0].Ref)
+		assert.Equal(t, "G204", vulnerability.ID)
+		assert.Equal(t, "high", vulnerability.Ratings[0].Severity)
+		assert.Equal(t, []int{78}, vulnerability.Cwes)
+		assert.Nil(t, vulnerability.Analysis)
+		assert.Contains(t, vulnerability.Properties, cyclonedx.Property{Name: "horusec:line", Value: "10"})
+	})
+	t.Run("should link the dependency vulnerabilities to the purl of the component", func(t *testing.T) {
+		report := NewCycloneDX(newAnalysis()).ConvertVulnerabilityDataToCycloneDX()
+
+		vulnerability := report.Vulnerabilities[1]
+		assert.Equal(t, "pkg:npm/lodash@4.17.15", report.Components[1].Purl)
+		assert.Equal(t, "pkg:npm/lodash@4.17.15", vulnerability.Affects[0].Ref)
+		assert.Equal(t, "CVE-2021-23337", vulnerability.ID)
+		assert.Equal(t, "NVD", vulnerability.Source.Name)
+		assert.Equal(t, "Upgrade lodash to the version 4.17.21", vulnerability.Recommendation)
+		assert.Equal(t, "exploitable", vulnerability.Analysis.State)
+		assert.Equal(t, []string{"will_not_fix"}, vulnerability.Analysis.Response)
+	})
+	t.Run("should keep the triage of the false positives and unique bom refs", func(t *testing.T) {
+		report := NewCycloneDX(newAnalysis()).ConvertVulnerabilityDataToCycloneDX()
+
+		vulnerability := report.Vulnerabilities[2]
+		assert.Equal(t, "hash-2-2", vulnerability.BOMRef)
+		assert.Equal(t, "false_positive", vulnerability.Analysis.State)
+		assert.Equal(t, "not used in production", vulnerability.Analysis.Detail)
+	})
+	t.Run("should affect the project when the vulnerability has no file", func(t *testing.T) {
+		analysis := &horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{Severity: severity.Audit, VulnHash: "hash"}},
+		}}
+		report := NewCycloneDX(analysis).ConvertVulnerabilityDataToCycloneDX()
+
+		assert.Empty(t, report.Components)
+		assert.Equal(t, ProjectBOMRef, report.Vulnerabilities[0].Affects[0].Ref)
+		assert.Equal(t, "unknown", report.Vulnerabilities[0].Ratings[0].Severity)
+		assert.Equal(t, DefaultProject, report.Metadata.Component.Name)
+	})
+}
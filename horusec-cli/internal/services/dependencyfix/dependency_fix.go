@@ -0,0 +1,209 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencyfix
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/semver"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+// Upgrade is the change of a dependency of a manifest, relative to the project path, to the version that fixes its
+// vulnerabilities
+type Upgrade struct {
+	Manifest     string
+	Name         string
+	Version      string
+	FixedVersion string
+}
+
+// Change is the content of a manifest before and after the upgrades of its dependencies
+type Change struct {
+	Manifest string
+	Before   string
+	After    string
+	Upgrades []Upgrade
+}
+
+type Interface interface {
+	GetChanges(analysis *horusec.Analysis) (changes []Change, notFound []Upgrade, err error)
+	Apply(changes []Change) error
+	Patch(changes []Change) (string, error)
+}
+
+type DependencyFix struct {
+	config cliConfig.IConfig
+}
+
+func NewDependencyFix(config cliConfig.IConfig) Interface {
+	return &DependencyFix{config: config}
+}
+
+// GetChanges upgrades the dependencies of the vulnerabilities with fixed version, the false positives and the risk
+// accepted are kept. The lock files can't be changed without the package managers, so the manifests are changed
+// and the lock files must be updated after, like with npm install
+func (d *DependencyFix) GetChanges(analysis *horusec.Analysis) (changes []Change, notFound []Upgrade, err error) {
+	for manifest, upgrades := range d.getUpgradesByManifest(analysis) {
+		change, notFoundInManifest, err := d.getChange(manifest, upgrades)
+		if err != nil {
+			return nil, nil, err
+		}
+		notFound = append(notFound, notFoundInManifest...)
+		if change.Before != change.After {
+			changes = append(changes, change)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Manifest < changes[j].Manifest
+	})
+	return changes, notFound, nil
+}
+
+func (d *DependencyFix) Apply(changes []Change) error {
+	for _, change := range changes {
+		manifestPath := d.getManifestPath(change.Manifest)
+		info, err := os.Stat(manifestPath)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(manifestPath, []byte(change.After), info.Mode()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Patch returns the unified diff of the changes, with the paths relative to the project path, so it can be applied
+// with git apply in the project
+func (d *DependencyFix) Patch(changes []Change) (string, error) {
+	patch := ""
+	for _, change := range changes {
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        d.splitLines(change.Before),
+			B:        d.splitLines(change.After),
+			FromFile: "a/" + change.Manifest,
+			ToFile:   "b/" + change.Manifest,
+			Context:  3,
+		})
+		if err != nil {
+			return "", err
+		}
+		patch += diff
+	}
+	return patch, nil
+}
+
+// splitLines keeps the line breaks, that are expected by difflib, without the empty line added by difflib.SplitLines
+// to the files ending with a line break
+func (d *DependencyFix) splitLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+	lines[len(lines)-1] += "\n"
+	return lines
+}
+
+func (d *DependencyFix) getChange(manifest string, upgrades []Upgrade) (Change, []Upgrade, error) {
+	content, err := ioutil.ReadFile(d.getManifestPath(manifest))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Change{}, upgrades, nil
+		}
+		return Change{}, nil, err
+	}
+	change := Change{Manifest: manifest, Before: string(content)}
+	var notFound []Upgrade
+	switch path.Base(manifest) {
+	case "package.json":
+		change.After, change.Upgrades, notFound = editPackageJSON(change.Before, upgrades)
+	case "go.mod":
+		change.After, change.Upgrades, notFound = editGoMod(change.Before, upgrades)
+	default:
+		change.After, change.Upgrades, notFound = editRequirements(change.Before, upgrades)
+	}
+	return change, notFound, nil
+}
+
+// getUpgradesByManifest keeps the greater fixed version of each dependency, that fixes all of its vulnerabilities
+func (d *DependencyFix) getUpgradesByManifest(analysis *horusec.Analysis) map[string][]Upgrade {
+	upgrades := map[string]*Upgrade{}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		manifest := d.getManifest(vulnerability.File)
+		if !d.isToFix(vulnerability) || manifest == "" {
+			continue
+		}
+		key := manifest + "@" + vulnerability.Dependency.Name
+		upgrade, ok := upgrades[key]
+		if !ok {
+			upgrades[key] = &Upgrade{Manifest: manifest, Name: vulnerability.Dependency.Name,
+				Version: vulnerability.Dependency.Version, FixedVersion: vulnerability.Dependency.FixedVersion}
+			continue
+		}
+		if semver.Compare(vulnerability.Dependency.FixedVersion, upgrade.FixedVersion) > 0 {
+			upgrade.FixedVersion = vulnerability.Dependency.FixedVersion
+		}
+	}
+	return d.groupByManifest(upgrades)
+}
+
+func (d *DependencyFix) groupByManifest(upgrades map[string]*Upgrade) map[string][]Upgrade {
+	upgradesByManifest := map[string][]Upgrade{}
+	for _, upgrade := range upgrades {
+		upgradesByManifest[upgrade.Manifest] = append(upgradesByManifest[upgrade.Manifest], *upgrade)
+	}
+	for manifest := range upgradesByManifest {
+		sort.Slice(upgradesByManifest[manifest], func(i, j int) bool {
+			return upgradesByManifest[manifest][i].Name < upgradesByManifest[manifest][j].Name
+		})
+	}
+	return upgradesByManifest
+}
+
+func (d *DependencyFix) isToFix(vulnerability *horusec.Vulnerability) bool {
+	return vulnerability.Dependency != nil && vulnerability.Dependency.FixedVersion != "" &&
+		vulnerability.Type != enumHorusec.FalsePositive && vulnerability.Type != enumHorusec.RiskAccepted
+}
+
+// getManifest returns the manifest declaring the dependencies of the file of the vulnerability, the lock files of
+// npm and yarn are generated from the package.json of their folder
+func (d *DependencyFix) getManifest(file string) string {
+	file = filepath.ToSlash(file)
+	switch name := path.Base(file); {
+	case name == "package.json" || name == "package-lock.json" || name == "yarn.lock":
+		return path.Join(path.Dir(file), "package.json")
+	case name == "go.mod" || name == "go.sum":
+		return path.Join(path.Dir(file), "go.mod")
+	case strings.HasPrefix(name, "requirements") && strings.HasSuffix(name, ".txt"):
+		return file
+	default:
+		return ""
+	}
+}
+
+func (d *DependencyFix) getManifestPath(manifest string) string {
+	return filepath.Join(d.config.GetProjectPath(), filepath.FromSlash(manifest))
+}
@@ -0,0 +1,99 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencyfix
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+func newProjectToFix(t *testing.T) cliConfig.IConfig {
+	projectPath, err := ioutil.TempDir("", "horusec-dependency-fix")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(projectPath)
+	})
+	assert.NoError(t, os.MkdirAll(filepath.Join(projectPath, "web"), 0750))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "web", "package.json"),
+		[]byte("{\n  \"dependencies\": {\n    \"qs\": \"^6.0.0\"\n  }\n}\n"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "requirements.txt"),
+		[]byte("jinja2==2.7.1\nflask==1.0\n"), 0600))
+	config := cliConfig.NewConfig()
+	config.SetProjectPath(projectPath)
+	return config
+}
+
+func newDependencyVulnerability(file, name, version, fixedVersion string) horusec.AnalysisVulnerabilities {
+	return horusec.AnalysisVulnerabilities{Vulnerability: horusec.Vulnerability{File: file,
+		Dependency: &horusec.Dependency{Name: name, Version: version, FixedVersion: fixedVersion}}}
+}
+
+func newAnalysisToFix() *horusec.Analysis {
+	riskAccepted := newDependencyVulnerability("requirements.txt", "flask", "1.0", "1.0.1")
+	riskAccepted.Vulnerability.Type = enumHorusec.RiskAccepted
+	return &horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+		newDependencyVulnerability("web/package-lock.json", "qs", "6.0.0", "6.0.4"),
+		newDependencyVulnerability("web/package-lock.json", "qs", "6.0.0", "6.3.2"),
+		newDependencyVulnerability("web/package-lock.json", "minimist", "0.0.8", "1.2.3"),
+		newDependencyVulnerability("requirements.txt", "jinja2", "2.7.1", "2.7.2"),
+		newDependencyVulnerability("requirements.txt", "django", "2.2.1", ""),
+		riskAccepted,
+	}}
+}
+
+func TestGetChanges(t *testing.T) {
+	t.Run("Should upgrade the dependencies to the greater fixed version of their vulnerabilities", func(t *testing.T) {
+		changes, notFound, err := NewDependencyFix(newProjectToFix(t)).GetChanges(newAnalysisToFix())
+
+		assert.NoError(t, err)
+		assert.Len(t, changes, 2)
+		assert.Equal(t, "requirements.txt", changes[0].Manifest)
+		assert.Equal(t, "jinja2==2.7.2\nflask==1.0\n", changes[0].After)
+		assert.Equal(t, "web/package.json", changes[1].Manifest)
+		assert.Equal(t, "{\n  \"dependencies\": {\n    \"qs\": \"^6.3.2\"\n  }\n}\n", changes[1].After)
+		assert.Equal(t, []Upgrade{{Manifest: "web/package.json", Name: "minimist", Version: "0.0.8",
+			FixedVersion: "1.2.3"}}, notFound)
+	})
+}
+
+func TestApplyAndPatch(t *testing.T) {
+	config := newProjectToFix(t)
+	dependencyFix := NewDependencyFix(config)
+	changes, _, err := dependencyFix.GetChanges(newAnalysisToFix())
+	assert.NoError(t, err)
+
+	t.Run("Should return the unified diff of the changes", func(t *testing.T) {
+		patch, err := dependencyFix.Patch(changes[:1])
+
+		assert.NoError(t, err)
+		assert.Equal(t, "--- a/requirements.txt\n+++ b/requirements.txt\n@@ -1,2 +1,2 @@\n-jinja2==2.7.1\n"+
+			"+jinja2==2.7.2\n flask==1.0\n", patch)
+	})
+	t.Run("Should write the changes in the manifests of the project", func(t *testing.T) {
+		assert.NoError(t, dependencyFix.Apply(changes))
+
+		content, err := ioutil.ReadFile(filepath.Join(config.GetProjectPath(), "requirements.txt"))
+		assert.NoError(t, err)
+		assert.Equal(t, "jinja2==2.7.2\nflask==1.0\n", string(content))
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencyfix
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/semver"
+)
+
+var (
+	requirementRegex = regexp.MustCompile(`^(\s*)([A-Za-z0-9][A-Za-z0-9._-]*)(\[[^\]]*\])?(\s*)(===|==|~=|>=)?(\s*)` +
+		`([^\s;#,]*)(.*)$`)
+	goRequireRegex   = regexp.MustCompile(`^(\s*(?:require\s+)?)(\S+)(\s+)(v\S+)(.*)$`)
+	pythonNameRegex  = regexp.MustCompile(`[-_.]+`)
+	numericSpecRegex = regexp.MustCompile(`^v?\d`)
+)
+
+// editPackageJSON changes the version of the direct dependencies keeping the prefix of the range, like ^ and ~, and
+// the format of the file. Transitive dependencies aren't in the package.json, they are returned as not found
+func editPackageJSON(content string, upgrades []Upgrade) (after string, upgraded, notFound []Upgrade) {
+	for _, upgrade := range upgrades {
+		found, changed := false, false
+		regex := regexp.MustCompile(`("` + regexp.QuoteMeta(upgrade.Name) + `"\s*:\s*")(\^|~|>=\s*)?([^"]*)(")`)
+		content = regex.ReplaceAllStringFunc(content, func(match string) string {
+			groups := regex.FindStringSubmatch(match)
+			if !numericSpecRegex.MatchString(groups[3]) {
+				return match
+			}
+			found = true
+			if semver.Compare(groups[3], upgrade.FixedVersion) >= 0 {
+				return match
+			}
+			changed = true
+			return groups[1] + groups[2] + strings.TrimPrefix(upgrade.FixedVersion, "v") + groups[4]
+		})
+		upgraded, notFound = appendUpgrade(upgraded, notFound, upgrade, found, changed)
+	}
+	return content, upgraded, notFound
+}
+
+// editRequirements keeps the operator of the pinned requirements and adds >= to the requirements without version
+func editRequirements(content string, upgrades []Upgrade) (after string, upgraded, notFound []Upgrade) {
+	lines := strings.Split(content, "\n")
+	for _, upgrade := range upgrades {
+		found, changed := false, false
+		for index, line := range lines {
+			groups := requirementRegex.FindStringSubmatch(strings.TrimRight(line, "\r"))
+			if groups == nil || normalizePythonName(groups[2]) != normalizePythonName(upgrade.Name) {
+				continue
+			}
+			found = true
+			if groups[7] != "" && semver.Compare(groups[7], upgrade.FixedVersion) >= 0 {
+				continue
+			}
+			lines[index] = strings.Replace(line, groups[0], newRequirement(groups, upgrade.FixedVersion), 1)
+			changed = true
+		}
+		upgraded, notFound = appendUpgrade(upgraded, notFound, upgrade, found, changed)
+	}
+	return strings.Join(lines, "\n"), upgraded, notFound
+}
+
+func newRequirement(groups []string, fixedVersion string) string {
+	operator := groups[5]
+	if operator == "" || groups[7] == "" {
+		return groups[1] + groups[2] + groups[3] + ">=" + fixedVersion + groups[8]
+	}
+	return groups[1] + groups[2] + groups[3] + groups[4] + operator + groups[6] + fixedVersion + groups[8]
+}
+
+func normalizePythonName(name string) string {
+	return strings.ToLower(pythonNameRegex.ReplaceAllString(name, "-"))
+}
+
+// editGoMod changes only the require directives, the replace directives are kept as they were written by the team
+func editGoMod(content string, upgrades []Upgrade) (after string, upgraded, notFound []Upgrade) {
+	lines := strings.Split(content, "\n")
+	for _, upgrade := range upgrades {
+		fixedVersion := "v" + strings.TrimPrefix(upgrade.FixedVersion, "v")
+		found, changed := false, false
+		isRequireBlock := false
+		for index, line := range lines {
+			trimmed := strings.TrimSpace(line)
+			if isRequireBlock && trimmed == ")" {
+				isRequireBlock = false
+				continue
+			}
+			if strings.HasPrefix(trimmed, "require") && strings.HasSuffix(trimmed, "(") {
+				isRequireBlock = true
+				continue
+			}
+			if !isRequireBlock && !strings.HasPrefix(trimmed, "require ") {
+				continue
+			}
+			groups := goRequireRegex.FindStringSubmatch(line)
+			if groups == nil || groups[2] != upgrade.Name {
+				continue
+			}
+			found = true
+			if semver.Compare(groups[4], fixedVersion) < 0 {
+				lines[index] = groups[1] + groups[2] + groups[3] + fixedVersion + groups[5]
+				changed = true
+			}
+		}
+		upgraded, notFound = appendUpgrade(upgraded, notFound, upgrade, found, changed)
+	}
+	return strings.Join(lines, "\n"), upgraded, notFound
+}
+
+// appendUpgrade ignores the dependencies found with a version that already fixes the vulnerabilities
+func appendUpgrade(upgraded, notFound []Upgrade, upgrade Upgrade, found, changed bool) ([]Upgrade, []Upgrade) {
+	if !found {
+		return upgraded, append(notFound, upgrade)
+	}
+	if changed {
+		return append(upgraded, upgrade), notFound
+	}
+	return upgraded, notFound
+}
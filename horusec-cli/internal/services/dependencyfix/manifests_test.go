@@ -0,0 +1,91 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencyfix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditPackageJSON(t *testing.T) {
+	t.Run("Should change the version of the dependencies keeping the prefix of the range", func(t *testing.T) {
+		content := `{
+  "name": "app",
+  "dependencies": {
+    "express": "^4.0.0",
+    "lodash": "4.17.15",
+    "qs": "~6.9.0"
+  }
+}`
+		after, upgraded, notFound := editPackageJSON(content, []Upgrade{
+			{Name: "express", FixedVersion: "4.5.0"}, {Name: "lodash", FixedVersion: "4.17.19"},
+			{Name: "qs", FixedVersion: "6.2.3"}, {Name: "minimist", FixedVersion: "1.2.3"},
+		})
+
+		assert.Equal(t, `{
+  "name": "app",
+  "dependencies": {
+    "express": "^4.5.0",
+    "lodash": "4.17.19",
+    "qs": "~6.9.0"
+  }
+}`, after)
+		assert.Equal(t, []Upgrade{{Name: "express", FixedVersion: "4.5.0"}, {Name: "lodash", FixedVersion: "4.17.19"}},
+			upgraded)
+		assert.Equal(t, []Upgrade{{Name: "minimist", FixedVersion: "1.2.3"}}, notFound)
+	})
+	t.Run("Should not change the dependencies that aren't versions", func(t *testing.T) {
+		content := `{"dependencies": {"lib": "github:org/lib"}}`
+
+		after, upgraded, notFound := editPackageJSON(content, []Upgrade{{Name: "lib", FixedVersion: "1.0.0"}})
+
+		assert.Equal(t, content, after)
+		assert.Empty(t, upgraded)
+		assert.Len(t, notFound, 1)
+	})
+}
+
+func TestEditRequirements(t *testing.T) {
+	t.Run("Should change the version of the requirements keeping the operator", func(t *testing.T) {
+		content := "Django==2.2.1 # web\njinja2>=2.7\nrequests\nflask_cors~=3.0.0\n-r base.txt\n"
+
+		after, upgraded, notFound := editRequirements(content, []Upgrade{
+			{Name: "django", FixedVersion: "2.2.4"}, {Name: "Jinja2", FixedVersion: "2.7.2"},
+			{Name: "requests", FixedVersion: "2.20.0"}, {Name: "flask-cors", FixedVersion: "3.0.9"},
+			{Name: "pyyaml", FixedVersion: "5.4"},
+		})
+
+		assert.Equal(t, "Django==2.2.4 # web\njinja2>=2.7.2\nrequests>=2.20.0\nflask_cors~=3.0.9\n-r base.txt\n", after)
+		assert.Len(t, upgraded, 4)
+		assert.Equal(t, []Upgrade{{Name: "pyyaml", FixedVersion: "5.4"}}, notFound)
+	})
+}
+
+func TestEditGoMod(t *testing.T) {
+	t.Run("Should change the version of the require directives", func(t *testing.T) {
+		content := "module app\n\ngo 1.14\n\nrequire github.com/gin-gonic/gin v1.6.0\n\nrequire (\n" +
+			"\tgolang.org/x/text v0.3.2 // indirect\n)\n\nreplace golang.org/x/text v0.3.2 => ./text\n"
+
+		after, upgraded, notFound := editGoMod(content, []Upgrade{
+			{Name: "github.com/gin-gonic/gin", FixedVersion: "1.6.3"}, {Name: "golang.org/x/text", FixedVersion: "v0.3.3"},
+		})
+
+		assert.Equal(t, "module app\n\ngo 1.14\n\nrequire github.com/gin-gonic/gin v1.6.3\n\nrequire (\n"+
+			"\tgolang.org/x/text v0.3.3 // indirect\n)\n\nreplace golang.org/x/text v0.3.2 => ./text\n", after)
+		assert.Len(t, upgraded, 2)
+		assert.Empty(t, notFound)
+	})
+}
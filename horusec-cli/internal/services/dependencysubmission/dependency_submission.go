@@ -0,0 +1,217 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencysubmission
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/version"
+)
+
+const (
+	DefaultAPIURL  = "https://api.github.com"
+	DetectorName   = "horusec"
+	DetectorURL    = "https://github.com/ZupIT/horusec"
+	requestTimeout = 30
+)
+
+// packageTypes are the types of the package urls of the dependencies found by each dependency tool
+var packageTypes = map[tools.Tool]string{
+	tools.NpmAudit:   "npm",
+	tools.YarnAudit:  "npm",
+	tools.Safety:     "pypi",
+	tools.OsvScanner: "golang",
+}
+
+type Interface interface {
+	Submit(analysis *horusec.Analysis)
+}
+
+// Snapshot is the body of the dependency submission API of GitHub
+type Snapshot struct {
+	Version   int                  `json:"version"`
+	Sha       string               `json:"sha"`
+	Ref       string               `json:"ref"`
+	Job       Job                  `json:"job"`
+	Detector  Detector             `json:"detector"`
+	Scanned   string               `json:"scanned"`
+	Manifests map[string]*Manifest `json:"manifests"`
+}
+
+type Job struct {
+	Correlator string `json:"correlator"`
+	ID         string `json:"id"`
+}
+
+type Detector struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	URL     string `json:"url"`
+}
+
+type Manifest struct {
+	Name     string             `json:"name"`
+	File     ManifestFile       `json:"file"`
+	Resolved map[string]Package `json:"resolved"`
+}
+
+type ManifestFile struct {
+	SourceLocation string `json:"source_location"`
+}
+
+type Package struct {
+	PackageURL string `json:"package_url"`
+}
+
+type DependencySubmission struct {
+	config     cliConfig.IConfig
+	httpClient client.Interface
+	getEnv     func(key string) string
+}
+
+func NewDependencySubmission(config cliConfig.IConfig) Interface {
+	return &DependencySubmission{
+		config:     config,
+		httpClient: client.NewHTTPClient(requestTimeout),
+		getEnv:     os.Getenv,
+	}
+}
+
+// Submit does nothing unless the submission was enabled. It only runs in the GitHub Actions, where the repository,
+// the commit and the token are in the environment, and the errors are only logged, the submission never changes the
+// result of the analysis
+func (d *DependencySubmission) Submit(analysis *horusec.Analysis) {
+	if !d.config.GetSubmitDependencies() {
+		return
+	}
+	if d.getEnv("GITHUB_REPOSITORY") == "" || d.getEnv("GITHUB_SHA") == "" || d.getEnv("GITHUB_TOKEN") == "" {
+		logger.LogWarnWithLevel(messages.MsgWarnDependencySubmissionNotInGitHubActions, logger.WarnLevel)
+		return
+	}
+	snapshot := d.newSnapshot(analysis)
+	if len(snapshot.Manifests) == 0 {
+		logger.LogDebugWithLevel(messages.MsgDebugNoDependenciesToSubmit, logger.DebugLevel)
+		return
+	}
+	if err := d.send(snapshot); err != nil {
+		logger.LogWarnWithLevel(messages.MsgWarnDependencySubmissionFailed, logger.WarnLevel, err.Error())
+	}
+}
+
+func (d *DependencySubmission) newSnapshot(analysis *horusec.Analysis) *Snapshot {
+	return &Snapshot{
+		Version: 0,
+		Sha:     d.getEnv("GITHUB_SHA"),
+		Ref:     d.getEnv("GITHUB_REF"),
+		Job: Job{
+			Correlator: strings.Join([]string{DetectorName, d.getEnv("GITHUB_WORKFLOW"), d.getEnv("GITHUB_JOB")}, " "),
+			ID:         d.getEnv("GITHUB_RUN_ID"),
+		},
+		Detector:  Detector{Name: DetectorName, Version: version.Version, URL: DetectorURL},
+		Scanned:   time.Now().UTC().Format(time.RFC3339),
+		Manifests: d.getManifests(analysis),
+	}
+}
+
+// getManifests groups the dependencies of the vulnerabilities by the file where the tool found them, the dependencies
+// of the false positives and risk accepted are kept because they are still resolved in the project
+func (d *DependencySubmission) getManifests(analysis *horusec.Analysis) map[string]*Manifest {
+	manifests := map[string]*Manifest{}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		packageURL := GetPackageURL(vulnerability)
+		if packageURL == "" {
+			continue
+		}
+		manifest, ok := manifests[vulnerability.File]
+		if !ok {
+			manifest = &Manifest{Name: vulnerability.File, File: ManifestFile{SourceLocation: vulnerability.File},
+				Resolved: map[string]Package{}}
+			manifests[vulnerability.File] = manifest
+		}
+		manifest.Resolved[packageURL] = Package{PackageURL: packageURL}
+	}
+	return manifests
+}
+
+// GetPackageURL returns the package url of the dependency of the vulnerability, empty when the vulnerability has no
+// dependency or the tool has no package type
+func GetPackageURL(vulnerability *horusec.Vulnerability) string {
+	packageType, ok := packageTypes[vulnerability.SecurityTool]
+	if !ok || vulnerability.Dependency == nil || vulnerability.Dependency.Name == "" {
+		return ""
+	}
+	return newPackageURL(packageType, vulnerability.Dependency)
+}
+
+// newPackageURL escapes the name of the package, like the @ of the npm scopes, keeping the slash between the scope
+// and the name
+func newPackageURL(packageType string, dependency *horusec.Dependency) string {
+	names := strings.Split(dependency.Name, "/")
+	for index := range names {
+		names[index] = strings.ReplaceAll(url.PathEscape(names[index]), "@", "%40")
+	}
+	packageURL := "pkg:" + packageType + "/" + strings.Join(names, "/")
+	if dependency.Version != "" {
+		packageURL += "@" + url.PathEscape(dependency.Version)
+	}
+	return packageURL
+}
+
+func (d *DependencySubmission) send(snapshot *Snapshot) error {
+	content, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, d.getSnapshotsURL(), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+d.getEnv("GITHUB_TOKEN"))
+
+	response, err := d.httpClient.DoRequest(req, nil)
+	if err != nil {
+		return err
+	}
+	defer response.CloseBody()
+
+	if response.GetStatusCode() < http.StatusOK || response.GetStatusCode() >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d", response.GetStatusCode())
+	}
+	return nil
+}
+
+func (d *DependencySubmission) getSnapshotsURL() string {
+	apiURL := d.getEnv("GITHUB_API_URL")
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+	return strings.TrimSuffix(apiURL, "/") + "/repos/" + d.getEnv("GITHUB_REPOSITORY") + "/dependency-graph/snapshots"
+}
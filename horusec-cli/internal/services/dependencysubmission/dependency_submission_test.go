@@ -0,0 +1,155 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencysubmission
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type request struct {
+	path          string
+	authorization string
+	snapshot      Snapshot
+}
+
+func newFakeServer(requests *[]request) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received := request{path: r.URL.Path, authorization: r.Header.Get("Authorization")}
+		_ = json.Unmarshal(body, &received.snapshot)
+		*requests = append(*requests, received)
+		w.WriteHeader(http.StatusCreated)
+	}))
+}
+
+func newGitHubEnv(apiURL string) func(key string) string {
+	return func(key string) string {
+		return map[string]string{
+			"GITHUB_API_URL":    apiURL,
+			"GITHUB_REPOSITORY": "ZupIT/horusec",
+			"GITHUB_SHA":        "8a1b2c3",
+			"GITHUB_REF":        "refs/heads/main",
+			"GITHUB_WORKFLOW":   "security",
+			"GITHUB_JOB":        "horusec",
+			"GITHUB_RUN_ID":     "42",
+			"GITHUB_TOKEN":      "token",
+		}[key]
+	}
+}
+
+func newService(enabled bool, getEnv func(key string) string) *DependencySubmission {
+	configs := &config.Config{}
+	configs.SetSubmitDependencies(enabled)
+	return &DependencySubmission{config: configs, httpClient: client.NewHTTPClient(requestTimeout), getEnv: getEnv}
+}
+
+func newDependencyVulnerability(tool tools.Tool, file, name, version string) horusec.AnalysisVulnerabilities {
+	return horusec.AnalysisVulnerabilities{Vulnerability: horusec.Vulnerability{SecurityTool: tool, File: file,
+		Dependency: &horusec.Dependency{Name: name, Version: version}}}
+}
+
+func TestDependencySubmission_Submit(t *testing.T) {
+	analysis := &horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+		newDependencyVulnerability(tools.NpmAudit, "web/package-lock.json", "@babel/core", "7.0.0"),
+		newDependencyVulnerability(tools.NpmAudit, "web/package-lock.json", "lodash", "4.17.15"),
+		newDependencyVulnerability(tools.NpmAudit, "web/package-lock.json", "lodash", "4.17.15"),
+		newDependencyVulnerability(tools.Safety, "requirements.txt", "django", "2.2.0"),
+		{Vulnerability: horusec.Vulnerability{SecurityTool: tools.GoSec, File: "main.go"}},
+	}}
+
+	t.Run("Should submit the dependencies grouped by manifest to the repository", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests)
+		defer server.Close()
+
+		newService(true, newGitHubEnv(server.URL)).Submit(analysis)
+
+		assert.Len(t, requests, 1)
+		assert.Equal(t, "/repos/ZupIT/horusec/dependency-graph/snapshots", requests[0].path)
+		assert.Equal(t, "Bearer token", requests[0].authorization)
+		snapshot := requests[0].snapshot
+		assert.Equal(t, "8a1b2c3", snapshot.Sha)
+		assert.Equal(t, "refs/heads/main", snapshot.Ref)
+		assert.Equal(t, Job{Correlator: "horusec security horusec", ID: "42"}, snapshot.Job)
+		assert.Len(t, snapshot.Manifests, 2)
+		assert.Equal(t, map[string]Package{
+			"pkg:npm/%40babel/core@7.0.0": {PackageURL: "pkg:npm/%40babel/core@7.0.0"},
+			"pkg:npm/lodash@4.17.15":      {PackageURL: "pkg:npm/lodash@4.17.15"},
+		}, snapshot.Manifests["web/package-lock.json"].Resolved)
+		assert.Equal(t, "requirements.txt", snapshot.Manifests["requirements.txt"].File.SourceLocation)
+	})
+
+	t.Run("Should not submit when the submission is not enabled", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests)
+		defer server.Close()
+
+		newService(false, newGitHubEnv(server.URL)).Submit(analysis)
+
+		assert.Empty(t, requests)
+	})
+
+	t.Run("Should not submit outside of the github actions", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests)
+		defer server.Close()
+		getEnv := func(key string) string {
+			if key == "GITHUB_API_URL" {
+				return server.URL
+			}
+			return ""
+		}
+
+		newService(true, getEnv).Submit(analysis)
+
+		assert.Empty(t, requests)
+	})
+
+	t.Run("Should not submit when there are no dependencies", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests)
+		defer server.Close()
+
+		newService(true, newGitHubEnv(server.URL)).Submit(&horusec.Analysis{})
+
+		assert.Empty(t, requests)
+	})
+
+	t.Run("Should not panic when github is not available", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			newService(true, newGitHubEnv("http://127.0.0.1:1")).Submit(analysis)
+		})
+	})
+}
+
+func TestNewDependencySubmission(t *testing.T) {
+	t.Run("Should use the api of github.com by default", func(t *testing.T) {
+		service := NewDependencySubmission(&config.Config{}).(*DependencySubmission)
+		service.getEnv = newGitHubEnv("")
+
+		assert.Equal(t, "https://api.github.com/repos/ZupIT/horusec/dependency-graph/snapshots",
+			service.getSnapshotsURL())
+	})
+}
@@ -0,0 +1,220 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"golang.org/x/net/context"
+)
+
+const defaultFaultMaxDelay = time.Second
+
+var (
+	ErrFaultInjected       = errors.New("{HORUSEC_CLI} docker call failed by the fault injection")
+	ErrInvalidFaultProfile = errors.New("{HORUSEC_CLI} the fault injection profile must be a list of key=value, " +
+		"like seed=42,fail-rate=0.1,delay-rate=0.5,max-delay=2s,calls=ImagePull|ContainerWait")
+)
+
+// FaultProfile is how the docker calls are delayed and failed, the same seed injects the same faults in the same
+// sequence of calls, the calls empty are all the calls of the docker client
+type FaultProfile struct {
+	Seed      int64
+	FailRate  float64
+	DelayRate float64
+	MaxDelay  time.Duration
+	Calls     map[string]bool
+}
+
+// ParseFaultProfile reads the profile of the flag fault-injection, without seed the seed is the current time
+func ParseFaultProfile(value string) (*FaultProfile, error) {
+	profile := &FaultProfile{Seed: time.Now().UnixNano(), MaxDelay: defaultFaultMaxDelay, Calls: map[string]bool{}}
+	for _, item := range strings.Split(value, ",") {
+		keyValue := strings.SplitN(strings.TrimSpace(item), "=", 2)
+		if len(keyValue) != 2 {
+			return nil, ErrInvalidFaultProfile
+		}
+		if err := profile.set(keyValue[0], keyValue[1]); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidFaultProfile, item)
+		}
+	}
+	return profile, nil
+}
+
+func (p *FaultProfile) set(key, value string) (err error) {
+	switch key {
+	case "seed":
+		p.Seed, err = strconv.ParseInt(value, 10, 64)
+	case "fail-rate":
+		p.FailRate, err = parseRate(value)
+	case "delay-rate":
+		p.DelayRate, err = parseRate(value)
+	case "max-delay":
+		p.MaxDelay, err = time.ParseDuration(value)
+	case "calls":
+		for _, call := range strings.Split(value, "|") {
+			p.Calls[strings.TrimSpace(call)] = true
+		}
+	default:
+		return ErrInvalidFaultProfile
+	}
+	return err
+}
+
+func parseRate(value string) (float64, error) {
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil || rate < 0 || rate > 1 {
+		return 0, ErrInvalidFaultProfile
+	}
+	return rate, nil
+}
+
+// faultInjection delays and fails the calls of the docker client randomly, so the retries, the timeouts and the
+// partial reports can be exercised without a broken docker daemon
+type faultInjection struct {
+	Interface
+	profile *FaultProfile
+	random  *rand.Rand
+	mutex   sync.Mutex
+}
+
+// NewFaultInjectionClient returns the client without changes when the profile is empty or invalid
+func NewFaultInjectionClient(dockerClient Interface, value string) Interface {
+	if value == "" {
+		return dockerClient
+	}
+	profile, err := ParseFaultProfile(value)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorFaultInjectionProfile, err, logger.ErrorLevel)
+		return dockerClient
+	}
+	logger.LogWarnWithLevel(messages.MsgWarnFaultInjectionEnabled+strconv.FormatInt(profile.Seed, 10), logger.WarnLevel)
+	return &faultInjection{Interface: dockerClient, profile: profile,
+		random: rand.New(rand.NewSource(profile.Seed))}
+}
+
+// inject draws the delay and the failure of the call, the sleep is interrupted when the context is done
+func (f *faultInjection) inject(ctx context.Context, call string) error {
+	if len(f.profile.Calls) > 0 && !f.profile.Calls[call] {
+		return nil
+	}
+	delay, fail := f.draw()
+	if delay > 0 {
+		logger.LogDebugWithLevel(fmt.Sprintf("{HORUSEC_CLI} Fault injection delaying %s in %s", call, delay),
+			logger.DebugLevel)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if fail {
+		logger.LogDebugWithLevel("{HORUSEC_CLI} Fault injection failing "+call, logger.DebugLevel)
+		return fmt.Errorf("%w: %s", ErrFaultInjected, call)
+	}
+	return nil
+}
+
+func (f *faultInjection) draw() (delay time.Duration, fail bool) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.profile.MaxDelay > 0 && f.random.Float64() < f.profile.DelayRate {
+		delay = time.Duration(f.random.Int63n(int64(f.profile.MaxDelay)))
+	}
+	return delay, f.random.Float64() < f.profile.FailRate
+}
+
+func (f *faultInjection) ContainerCreate(ctx context.Context, config *container.Config,
+	hostConfig *container.HostConfig, networkingConfig *network.NetworkingConfig,
+	containerName string) (container.ContainerCreateCreatedBody, error) {
+	if err := f.inject(ctx, "ContainerCreate"); err != nil {
+		return container.ContainerCreateCreatedBody{}, err
+	}
+	return f.Interface.ContainerCreate(ctx, config, hostConfig, networkingConfig, containerName)
+}
+
+func (f *faultInjection) ContainerStart(ctx context.Context, containerID string,
+	options types.ContainerStartOptions) error {
+	if err := f.inject(ctx, "ContainerStart"); err != nil {
+		return err
+	}
+	return f.Interface.ContainerStart(ctx, containerID, options)
+}
+
+func (f *faultInjection) ContainerList(ctx context.Context,
+	options types.ContainerListOptions) ([]types.Container, error) {
+	if err := f.inject(ctx, "ContainerList"); err != nil {
+		return nil, err
+	}
+	return f.Interface.ContainerList(ctx, options)
+}
+
+func (f *faultInjection) ContainerWait(ctx context.Context, containerID string) (int64, error) {
+	if err := f.inject(ctx, "ContainerWait"); err != nil {
+		return 0, err
+	}
+	return f.Interface.ContainerWait(ctx, containerID)
+}
+
+func (f *faultInjection) ContainerLogs(ctx context.Context, containerID string,
+	options types.ContainerLogsOptions) (io.ReadCloser, error) {
+	if err := f.inject(ctx, "ContainerLogs"); err != nil {
+		return nil, err
+	}
+	return f.Interface.ContainerLogs(ctx, containerID, options)
+}
+
+func (f *faultInjection) ContainerRemove(ctx context.Context, containerID string,
+	options types.ContainerRemoveOptions) error {
+	if err := f.inject(ctx, "ContainerRemove"); err != nil {
+		return err
+	}
+	return f.Interface.ContainerRemove(ctx, containerID, options)
+}
+
+func (f *faultInjection) ImageList(ctx context.Context,
+	options types.ImageListOptions) ([]types.ImageSummary, error) {
+	if err := f.inject(ctx, "ImageList"); err != nil {
+		return nil, err
+	}
+	return f.Interface.ImageList(ctx, options)
+}
+
+func (f *faultInjection) ImagePull(ctx context.Context, ref string,
+	options types.ImagePullOptions) (io.ReadCloser, error) {
+	if err := f.inject(ctx, "ImagePull"); err != nil {
+		return nil, err
+	}
+	return f.Interface.ImagePull(ctx, ref, options)
+}
+
+func (f *faultInjection) Ping(ctx context.Context) (types.Ping, error) {
+	if err := f.inject(ctx, "Ping"); err != nil {
+		return types.Ping{}, err
+	}
+	return f.Interface.Ping(ctx)
+}
@@ -0,0 +1,93 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func TestParseFaultProfile(t *testing.T) {
+	t.Run("Should parse all the keys of the profile", func(t *testing.T) {
+		profile, err := ParseFaultProfile("seed=42, fail-rate=0.1,delay-rate=0.5,max-delay=2s,calls=ImagePull|Ping")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &FaultProfile{Seed: 42, FailRate: 0.1, DelayRate: 0.5, MaxDelay: 2 * time.Second,
+			Calls: map[string]bool{"ImagePull": true, "Ping": true}}, profile)
+	})
+
+	t.Run("Should return error when the profile is invalid", func(t *testing.T) {
+		for _, value := range []string{"seed", "fail-rate=2", "max-delay=2", "retries=3", "seed=abc"} {
+			_, err := ParseFaultProfile(value)
+			assert.True(t, errors.Is(err, ErrInvalidFaultProfile), value)
+		}
+	})
+}
+
+func TestNewFaultInjectionClient(t *testing.T) {
+	t.Run("Should return the client without changes when the profile is empty or invalid", func(t *testing.T) {
+		dockerMock := &Mock{}
+
+		assert.Equal(t, dockerMock, NewFaultInjectionClient(dockerMock, ""))
+		assert.Equal(t, dockerMock, NewFaultInjectionClient(dockerMock, "fail-rate=2"))
+	})
+
+	t.Run("Should fail the calls of the profile without calling docker", func(t *testing.T) {
+		dockerMock := &Mock{}
+		dockerMock.On("ContainerWait").Return(int64(0), nil)
+		client := NewFaultInjectionClient(dockerMock, "seed=1,fail-rate=1,calls=Ping|ImagePull")
+
+		_, err := client.Ping(context.Background())
+		assert.True(t, errors.Is(err, ErrFaultInjected))
+		_, err = client.ImagePull(context.Background(), "horuszup/gosec:v1.0.0", types.ImagePullOptions{})
+		assert.True(t, errors.Is(err, ErrFaultInjected))
+
+		_, err = client.ContainerWait(context.Background(), "id")
+		assert.NoError(t, err)
+		dockerMock.AssertNumberOfCalls(t, "ContainerWait", 1)
+	})
+
+	t.Run("Should inject the same faults with the same seed", func(t *testing.T) {
+		getFailures := func() (failures []bool) {
+			dockerMock := &Mock{}
+			dockerMock.On("Ping").Return(types.Ping{}, nil)
+			client := NewFaultInjectionClient(dockerMock, "seed=7,fail-rate=0.5")
+			for index := 0; index < 20; index++ {
+				_, err := client.Ping(context.Background())
+				failures = append(failures, err != nil)
+			}
+			return failures
+		}
+
+		failures := getFailures()
+		assert.Contains(t, failures, true)
+		assert.Contains(t, failures, false)
+		assert.Equal(t, failures, getFailures())
+	})
+
+	t.Run("Should stop the delay when the context is done", func(t *testing.T) {
+		client := NewFaultInjectionClient(&Mock{}, "seed=1,delay-rate=1,max-delay=1h")
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := client.ContainerStart(ctx, "id", types.ContainerStartOptions{})
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+}
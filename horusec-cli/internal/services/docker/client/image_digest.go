@@ -0,0 +1,53 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"golang.org/x/net/context"
+)
+
+// GetLocalDigest returns the digest of the image pulled in the local docker, or empty when it is not present
+func GetLocalDigest(client Interface, imagePath string) (string, error) {
+	image, err := GetLocalImage(client, imagePath)
+	if err != nil || image == nil {
+		return "", err
+	}
+	return GetDigest(image), nil
+}
+
+// GetDigest returns the digest of the repo of the image, or the id when the image was not pulled from a repo
+func GetDigest(image *types.ImageSummary) string {
+	for _, repoDigest := range image.RepoDigests {
+		if index := strings.Index(repoDigest, "@"); index >= 0 {
+			return repoDigest[index+1:]
+		}
+	}
+	return image.ID
+}
+
+// GetLocalImage returns the image pulled in the local docker, or nil when it is not present
+func GetLocalImage(client Interface, imagePath string) (*types.ImageSummary, error) {
+	args := filters.NewArgs()
+	args.Add("reference", strings.TrimPrefix(imagePath, "docker.io/"))
+	result, err := client.ImageList(context.Background(), types.ImageListOptions{Filters: args})
+	if err != nil || len(result) == 0 {
+		return nil, err
+	}
+	return &result[0], nil
+}
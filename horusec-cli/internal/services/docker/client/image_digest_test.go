@@ -0,0 +1,82 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLocalDigest(t *testing.T) {
+	t.Run("Should return the digest of the repo when the image is present", func(t *testing.T) {
+		dockerMock := &Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{
+			{ID: "sha256:123", RepoDigests: []string{"horuszup/gosec@sha256:456"}}}, nil)
+
+		digest, err := GetLocalDigest(dockerMock, "docker.io/horuszup/gosec:v1.0.0")
+		assert.NoError(t, err)
+		assert.Equal(t, "sha256:456", digest)
+	})
+
+	t.Run("Should return the id when the image has no repo digest", func(t *testing.T) {
+		dockerMock := &Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{{ID: "sha256:123"}}, nil)
+
+		digest, err := GetLocalDigest(dockerMock, "horuszup/gosec:v1.0.0")
+		assert.NoError(t, err)
+		assert.Equal(t, "sha256:123", digest)
+	})
+
+	t.Run("Should return empty when the image is not present", func(t *testing.T) {
+		dockerMock := &Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{}, nil)
+
+		digest, err := GetLocalDigest(dockerMock, "horuszup/gosec:v1.0.0")
+		assert.NoError(t, err)
+		assert.Empty(t, digest)
+	})
+
+	t.Run("Should return error when docker is not available", func(t *testing.T) {
+		dockerMock := &Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{}, errors.New("test"))
+
+		_, err := GetLocalDigest(dockerMock, "horuszup/gosec:v1.0.0")
+		assert.Error(t, err)
+	})
+}
+
+func TestGetLocalImage(t *testing.T) {
+	t.Run("Should return the image with its labels when it is present", func(t *testing.T) {
+		dockerMock := &Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{
+			{ID: "sha256:123", Labels: map[string]string{"org.opencontainers.image.version": "2.8.1"}}}, nil)
+
+		image, err := GetLocalImage(dockerMock, "horuszup/gosec:v1.0.0")
+		assert.NoError(t, err)
+		assert.Equal(t, "2.8.1", image.Labels["org.opencontainers.image.version"])
+	})
+
+	t.Run("Should return nil when the image is not present", func(t *testing.T) {
+		dockerMock := &Mock{}
+		dockerMock.On("ImageList").Return([]types.ImageSummary{}, nil)
+
+		image, err := GetLocalImage(dockerMock, "horuszup/gosec:v1.0.0")
+		assert.NoError(t, err)
+		assert.Nil(t, image)
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+const (
+	envDockerHost     = "DOCKER_HOST"
+	defaultSocketPath = "/var/run/docker.sock"
+	dialTimeout       = time.Second
+)
+
+type engineSocket struct {
+	engine string
+	path   string
+}
+
+type socketDiscovery struct {
+	goos              string
+	homeDir           string
+	tmpDir            string
+	defaultSocketPath string
+}
+
+// DiscoverDockerHost sets the DOCKER_HOST with the first socket listening of the docker engines of macOS when the
+// default socket is absent and the DOCKER_HOST is empty, so the docker cli and the docker client use the same
+// engine. It returns the engine selected or empty when nothing was changed
+func DiscoverDockerHost() string {
+	homeDir, _ := os.UserHomeDir()
+	discovery := &socketDiscovery{
+		goos:              runtime.GOOS,
+		homeDir:           homeDir,
+		tmpDir:            os.TempDir(),
+		defaultSocketPath: defaultSocketPath,
+	}
+	return discovery.discover()
+}
+
+func (s *socketDiscovery) discover() string {
+	if s.goos != "darwin" || os.Getenv(envDockerHost) != "" || isSocketListening(s.defaultSocketPath) {
+		return ""
+	}
+	for _, socket := range s.getKnownSockets() {
+		if isSocketListening(socket.path) {
+			_ = os.Setenv(envDockerHost, "unix://"+socket.path)
+			logger.LogInfoWithLevel(messages.MsgInfoDockerEngineDiscovered, logger.InfoLevel, socket.engine, socket.path)
+			return socket.engine
+		}
+	}
+	logger.LogDebugWithLevel(messages.MsgDebugDockerEngineNotDiscovered, logger.DebugLevel)
+	return ""
+}
+
+// getKnownSockets returns the sockets of the default profiles of the engines in the order of preference
+func (s *socketDiscovery) getKnownSockets() []engineSocket {
+	return []engineSocket{
+		{engine: "Docker Desktop", path: filepath.Join(s.homeDir, ".docker", "run", "docker.sock")},
+		{engine: "Docker Desktop", path: filepath.Join(s.homeDir, ".docker", "desktop", "docker.sock")},
+		{engine: "colima", path: filepath.Join(s.homeDir, ".colima", "default", "docker.sock")},
+		{engine: "colima", path: filepath.Join(s.homeDir, ".colima", "docker.sock")},
+		{engine: "Rancher Desktop", path: filepath.Join(s.homeDir, ".rd", "docker.sock")},
+		{engine: "podman machine", path: filepath.Join(s.homeDir, ".local", "share", "containers", "podman",
+			"machine", "podman.sock")},
+		{engine: "podman machine", path: filepath.Join(s.homeDir, ".local", "share", "containers", "podman",
+			"machine", "qemu", "podman.sock")},
+		{engine: "podman machine", path: filepath.Join(s.tmpDir, "podman", "podman-machine-default-api.sock")},
+	}
+}
+
+// isSocketListening connects to the socket, because the sockets of the engines stopped are left in the disk
+func isSocketListening(path string) bool {
+	conn, err := net.DialTimeout("unix", path, dialTimeout)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+	return true
+}
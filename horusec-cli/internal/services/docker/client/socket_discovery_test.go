@@ -0,0 +1,107 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSocketDiscoveryToTest(t *testing.T) (*socketDiscovery, string) {
+	homeDir, err := ioutil.TempDir("", "home")
+	assert.NoError(t, err)
+	return &socketDiscovery{goos: "darwin", homeDir: homeDir, tmpDir: homeDir,
+		defaultSocketPath: filepath.Join(homeDir, "docker.sock")}, homeDir
+}
+
+func listenSocket(t *testing.T, path string) net.Listener {
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	listener, err := net.Listen("unix", path)
+	assert.NoError(t, err)
+	return listener
+}
+
+func unsetDockerHost(t *testing.T) func() {
+	dockerHost, isSet := os.LookupEnv(envDockerHost)
+	assert.NoError(t, os.Unsetenv(envDockerHost))
+	return func() {
+		if isSet {
+			_ = os.Setenv(envDockerHost, dockerHost)
+			return
+		}
+		_ = os.Unsetenv(envDockerHost)
+	}
+}
+
+func TestSocketDiscovery(t *testing.T) {
+	t.Run("Should set the docker host with the first socket listening", func(t *testing.T) {
+		defer unsetDockerHost(t)()
+		discovery, homeDir := newSocketDiscoveryToTest(t)
+		defer os.RemoveAll(homeDir)
+		colimaSocket := filepath.Join(homeDir, ".colima", "default", "docker.sock")
+		defer listenSocket(t, colimaSocket).Close()
+		rancherSocket := filepath.Join(homeDir, ".rd", "docker.sock")
+		defer listenSocket(t, rancherSocket).Close()
+
+		assert.Equal(t, "colima", discovery.discover())
+		assert.Equal(t, "unix://"+colimaSocket, os.Getenv(envDockerHost))
+	})
+
+	t.Run("Should skip the sockets left in the disk by the engines stopped", func(t *testing.T) {
+		defer unsetDockerHost(t)()
+		discovery, homeDir := newSocketDiscoveryToTest(t)
+		defer os.RemoveAll(homeDir)
+		stoppedSocket := filepath.Join(homeDir, ".docker", "run", "docker.sock")
+		assert.NoError(t, os.MkdirAll(filepath.Dir(stoppedSocket), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(stoppedSocket, []byte{}, 0600))
+		podmanSocket := filepath.Join(homeDir, "podman", "podman-machine-default-api.sock")
+		defer listenSocket(t, podmanSocket).Close()
+
+		assert.Equal(t, "podman machine", discovery.discover())
+		assert.Equal(t, "unix://"+podmanSocket, os.Getenv(envDockerHost))
+	})
+
+	t.Run("Should keep the docker host when the default socket is listening", func(t *testing.T) {
+		defer unsetDockerHost(t)()
+		discovery, homeDir := newSocketDiscoveryToTest(t)
+		defer os.RemoveAll(homeDir)
+		defer listenSocket(t, discovery.defaultSocketPath).Close()
+		defer listenSocket(t, filepath.Join(homeDir, ".rd", "docker.sock")).Close()
+
+		assert.Empty(t, discovery.discover())
+		assert.Empty(t, os.Getenv(envDockerHost))
+	})
+
+	t.Run("Should keep the docker host when it is set or the os is not macOS", func(t *testing.T) {
+		defer unsetDockerHost(t)()
+		discovery, homeDir := newSocketDiscoveryToTest(t)
+		defer os.RemoveAll(homeDir)
+		defer listenSocket(t, filepath.Join(homeDir, ".rd", "docker.sock")).Close()
+
+		discovery.goos = "linux"
+		assert.Empty(t, discovery.discover())
+		assert.Empty(t, os.Getenv(envDockerHost))
+
+		discovery.goos = "darwin"
+		assert.NoError(t, os.Setenv(envDockerHost, "tcp://localhost:2375"))
+		assert.Empty(t, discovery.discover())
+		assert.Equal(t, "tcp://localhost:2375", os.Getenv(envDockerHost))
+	})
+}
@@ -17,28 +17,41 @@ package docker
 import (
 	"fmt"
 	"io"
-	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
 
+	standardConfig "github.com/ZupIT/horusec/development-kit/pkg/cli_standard/config"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
 	enumErrors "github.com/ZupIT/horusec/development-kit/pkg/enums/errors"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
 	dockerService "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker/hostpath"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/progress"
 	dockerTypes "github.com/docker/docker/api/types"
 	dockerContainer "github.com/docker/docker/api/types/container"
 	dockerTypesFilters "github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/mount"
 	"github.com/google/uuid"
 	goContext "golang.org/x/net/context"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/sync/singleflight"
 )
 
+// Path where the rule packs dir is mounted in the containers of the horusec engines
+const pathRulePacksInContainer = "/horusec-rule-packs"
+
 type Interface interface {
 	CreateLanguageAnalysisContainer(data *dockerEntities.AnalysisData) (containerOutPut string, err error)
 	DeleteContainersFromAPI()
+	SetProgress(progress progress.Interface)
+	GetImageDigest(imagePath string) string
+	PullImage(imagePath string) error
 }
 
 type API struct {
@@ -47,6 +60,9 @@ type API struct {
 	config                 cliConfig.IConfig
 	analysisID             uuid.UUID
 	pathDestinyInContainer string
+	pool                   *semaphore.Weighted
+	progress               progress.Interface
+	pullGroup              singleflight.Group
 }
 
 func NewDockerAPI(docker dockerService.Interface, config cliConfig.IConfig, analysisID uuid.UUID) Interface {
@@ -56,6 +72,7 @@ func NewDockerAPI(docker dockerService.Interface, config cliConfig.IConfig, anal
 		config:                 config,
 		analysisID:             analysisID,
 		pathDestinyInContainer: "/src",
+		pool:                   semaphore.NewWeighted(config.GetMaxParallel()),
 	}
 }
 
@@ -68,10 +85,49 @@ func (d *API) CreateLanguageAnalysisContainer(data *dockerEntities.AnalysisData)
 		return "", err
 	}
 
-	return d.logStatusAndExecuteCRDContainer(data.ImagePath, d.replaceCMDAnalysisID(data.CMD))
+	weight := d.getToolWeight(data.Tool)
+	if err := d.pool.Acquire(d.ctx, weight); err != nil {
+		return "", err
+	}
+	defer d.pool.Release(weight)
+
+	if d.progress != nil {
+		d.progress.SetToolStatus(data.Tool, data.ProjectSubPath, progress.Running)
+	}
+	return d.logStatusAndExecuteCRDContainer(data.ImagePath, d.replaceCMDAnalysisID(data.CMD), data.Mounts)
+}
+
+func (d *API) SetProgress(progress progress.Interface) {
+	d.progress = progress
+}
+
+// GetImageDigest returns empty when the image is not present or docker is not available
+func (d *API) GetImageDigest(imagePath string) string {
+	digest, err := dockerService.GetLocalDigest(d.dockerClient, imagePath)
+	if err != nil {
+		return ""
+	}
+	return digest
+}
+
+// PullImage pulls the image when it is not present, like before running the container of a tool
+func (d *API) PullImage(imagePath string) error {
+	return d.pullNewImage(imagePath)
 }
 
+// pullNewImage pulls the image once when the formatters need the same image at the same time, the others wait for
+// the result of the same pull
 func (d *API) pullNewImage(imagePath string) error {
+	_, err, shared := d.pullGroup.Do(imagePath, func() (interface{}, error) {
+		return nil, d.pullNewImageIfNotExists(imagePath)
+	})
+	if shared {
+		d.loggerAPIStatus(messages.MsgDebugDockerAPIPullShared, imagePath)
+	}
+	return err
+}
+
+func (d *API) pullNewImageIfNotExists(imagePath string) error {
 	d.loggerAPIStatus(messages.MsgDebugDockerAPIPullNewImage, imagePath)
 	if imageNotExist, err := d.checkImageNotExists(imagePath); err != nil || !imageNotExist {
 		return err
@@ -87,10 +143,15 @@ func (d *API) downloadImage(imagePath string) error {
 		return err
 	}
 
-	readResult, err := ioutil.ReadAll(reader)
-	if err != nil {
+	defer func() {
+		logger.LogError(messages.MsgErrorDockerPullImage, reader.Close())
+	}()
+	if d.progress != nil {
+		defer d.progress.FinishImagePull(imagePath)
+	}
+
+	if err := d.readPullProgress(imagePath, reader); err != nil {
 		logger.LogErrorWithLevel(messages.MsgErrorDockerPullImage, err, logger.ErrorLevel)
-		logger.LogDebugWithLevel(string(readResult), logger.ErrorLevel)
 		return err
 	}
 
@@ -112,13 +173,29 @@ func (d *API) checkImageNotExists(imagePath string) (bool, error) {
 }
 
 func (d *API) replaceCMDAnalysisID(cmd string) string {
-	return strings.ReplaceAll(cmd, "ANALYSISID", d.analysisID.String())
+	return d.replaceCMDPermissions(strings.ReplaceAll(cmd, "ANALYSISID", d.analysisID.String()))
+}
+
+// replaceCMDPermissions avoids changing permissions of the original files when the source is not a copy. The
+// folders of a hardlinked project are created by horusec, so only they are changed, that is enough to remove the files
+// created by the tools. The files are never changed, the hardlinked files share the inode with the files of the user
+// and the count of links is not reliable in the mounts of the docker engines of macOS and windows
+func (d *API) replaceCMDPermissions(cmd string) string {
+	if d.config.GetSourceMode() == cli.SourceCopy.ToString() {
+		return cmd
+	}
+	return strings.ReplaceAll(cmd, "chmod -R 777 .", `find . -type d -exec chmod 777 {} + 2> /dev/null`)
+}
+
+func (d *API) isReadOnlySource() bool {
+	return d.config.GetSourceMode() == cli.SourceReadOnly.ToString()
 }
 
-func (d *API) logStatusAndExecuteCRDContainer(imageNameWithTag, cmd string) (containerOutput string, err error) {
+func (d *API) logStatusAndExecuteCRDContainer(imageNameWithTag, cmd string,
+	mounts []dockerEntities.Mount) (containerOutput string, err error) {
 	d.loggerAPIStatus(messages.MsgDebugDockerAPIDownloadWithSuccess, imageNameWithTag)
 
-	containerOutput, err = d.executeCRDContainer(imageNameWithTag, cmd)
+	containerOutput, err = d.executeCRDContainer(imageNameWithTag, cmd, mounts)
 	if err != nil {
 		d.loggerAPIStatus(messages.MsgDebugDockerAPIFinishedError, imageNameWithTag)
 		return "", err
@@ -128,8 +205,9 @@ func (d *API) logStatusAndExecuteCRDContainer(imageNameWithTag, cmd string) (con
 	return containerOutput, nil
 }
 
-func (d *API) executeCRDContainer(imageNameWithTag, cmd string) (containerOutput string, err error) {
-	containerID, err := d.createContainer(imageNameWithTag, cmd)
+func (d *API) executeCRDContainer(imageNameWithTag, cmd string,
+	mounts []dockerEntities.Mount) (containerOutput string, err error) {
+	containerID, err := d.createContainer(imageNameWithTag, cmd, mounts)
 	if err != nil {
 		return "", err
 	}
@@ -149,8 +227,8 @@ func (d *API) removeContainer(containerID string) {
 	logger.LogErrorWithLevel(messages.MsgErrorDockerRemoveContainer, err, logger.ErrorLevel)
 }
 
-func (d *API) createContainer(imageNameWithTag, cmd string) (string, error) {
-	config, host := d.getConfigAndHostToCreateContainer(imageNameWithTag, cmd)
+func (d *API) createContainer(imageNameWithTag, cmd string, mounts []dockerEntities.Mount) (string, error) {
+	config, host := d.getConfigAndHostToCreateContainer(imageNameWithTag, cmd, mounts)
 	response, err := d.dockerClient.ContainerCreate(d.ctx, config, host, nil, d.getImageID())
 	if err != nil {
 		logger.LogErrorWithLevel(messages.MsgErrorDockerCreateContainer, err, logger.ErrorLevel)
@@ -186,43 +264,144 @@ func (d *API) readContainer(containerID string) (string, error) {
 	return d.getOutputString(containerOutput)
 }
 
+// getOutputString reads the output up to the max output size straight to a string builder,
+// avoiding the copy of the whole output done when converting bytes to string
 func (d *API) getOutputString(containerOutPut io.Reader) (string, error) {
-	containerOutPutBytes, err := ioutil.ReadAll(containerOutPut)
+	maxOutputSize := d.config.GetMaxOutputSizeInMB() * 1024 * 1024
+	output := &strings.Builder{}
+	size, err := io.Copy(output, io.LimitReader(containerOutPut, maxOutputSize+1))
 	if err != nil {
 		return "", err
 	}
+	if size > maxOutputSize {
+		logger.LogWarnWithLevel(messages.MsgWarnContainerOutputTooLarge, logger.WarnLevel,
+			map[string]interface{}{"maxOutputSizeInMB": d.config.GetMaxOutputSizeInMB(),
+				"analysisId": d.analysisID.String()})
+		return "", enumErrors.ErrContainerOutputTooLarge
+	}
 
-	return string(containerOutPutBytes), err
+	return output.String(), nil
 }
 
-func (d *API) getConfigAndHostToCreateContainer(
-	imageNameWithTag, cmd string) (*dockerContainer.Config, *dockerContainer.HostConfig) {
+func (d *API) getConfigAndHostToCreateContainer(imageNameWithTag, cmd string,
+	mounts []dockerEntities.Mount) (*dockerContainer.Config, *dockerContainer.HostConfig) {
 	config := d.getContainerConfig(imageNameWithTag, cmd)
 
-	return config, d.getContainerHostConfig()
+	return config, d.getContainerHostConfig(mounts...)
 }
 
+// getContainerConfig replaces the entrypoint by the shell, so the images of tools with the tool as entrypoint, like
+// trivy and checkov, run the command like the images of horusec
 func (d *API) getContainerConfig(imageNameWithTag, cmd string) *dockerContainer.Config {
 	return &dockerContainer.Config{
-		Image: imageNameWithTag,
-		Tty:   true,
-		Cmd:   []string{"/bin/sh", "-c", fmt.Sprintf(`cd %s && %s`, d.pathDestinyInContainer, cmd)},
+		Image:      imageNameWithTag,
+		Tty:        true,
+		Entrypoint: []string{"/bin/sh", "-c"},
+		Cmd:        []string{fmt.Sprintf(`cd %s && %s`, d.pathDestinyInContainer, cmd)},
+		Env:        append(d.getRulePacksEnv(), d.getEngineEnv()...),
+	}
+}
+
+// getRulePacksEnv selects the rule packs in the horusec engines, the other tools ignore these envs
+func (d *API) getRulePacksEnv() []string {
+	if len(d.config.GetRulePacks()) == 0 {
+		return nil
+	}
+	return []string{
+		standardConfig.EnvRulePacks + "=" + strings.Join(d.config.GetRulePacks(), ","),
+		standardConfig.EnvRulePacksPath + "=" + pathRulePacksInContainer,
+	}
+}
+
+// getEngineEnv limits the workers, the memory and the time of the rules of the horusec engines, the other tools ignore these envs
+func (d *API) getEngineEnv() (engineEnv []string) {
+	if d.config.GetEngineWorkers() > 0 {
+		engineEnv = append(engineEnv,
+			fmt.Sprintf("%s=%d", standardConfig.EnvEngineWorkers, d.config.GetEngineWorkers()))
+	}
+	if d.config.GetEngineMemoryLimitInMB() > 0 {
+		engineEnv = append(engineEnv,
+			fmt.Sprintf("%s=%d", standardConfig.EnvEngineMemoryLimitInMB, d.config.GetEngineMemoryLimitInMB()))
+	}
+	if d.config.GetEngineRuleTimeoutInMs() > 0 {
+		engineEnv = append(engineEnv,
+			fmt.Sprintf("%s=%d", standardConfig.EnvEngineRuleTimeoutInMs, d.config.GetEngineRuleTimeoutInMs()))
 	}
+	if d.config.GetMaxFileSizeInMB() != 0 {
+		engineEnv = append(engineEnv,
+			fmt.Sprintf("%s=%d", standardConfig.EnvMaxFileSizeInMB, d.config.GetMaxFileSizeInMB()))
+	}
+	return engineEnv
 }
 
-func (d *API) getContainerHostConfig() *dockerContainer.HostConfig {
-	return &dockerContainer.HostConfig{
+// getContainerHostConfig mounts the project, the rule packs and the mounts of the tool
+func (d *API) getContainerHostConfig(mounts ...dockerEntities.Mount) *dockerContainer.HostConfig {
+	hostConfig := &dockerContainer.HostConfig{
 		Mounts: []mount.Mount{
 			{
-				Type:   mount.TypeBind,
-				Source: d.getSourceFolder(),
-				Target: d.pathDestinyInContainer,
+				Type:     mount.TypeBind,
+				Source:   d.getSourceFolder(),
+				Target:   d.pathDestinyInContainer,
+				ReadOnly: d.isReadOnlySource(),
 				BindOptions: &mount.BindOptions{
 					Propagation: mount.PropagationPrivate,
 				},
 			},
 		},
 	}
+	if horusecFolderMount, ok := d.getHorusecFolderMount(); ok {
+		hostConfig.Mounts = append(hostConfig.Mounts, horusecFolderMount)
+	}
+	if rulePacksMount, ok := d.getRulePacksMount(); ok {
+		hostConfig.Mounts = append(hostConfig.Mounts, rulePacksMount)
+	}
+	for _, toolMount := range mounts {
+		hostConfig.Mounts = append(hostConfig.Mounts, d.getToolMount(toolMount))
+	}
+	return hostConfig
+}
+
+// getToolMount keeps the source of the volumes, only the paths of the host are translated to the docker daemon
+func (d *API) getToolMount(toolMount dockerEntities.Mount) mount.Mount {
+	if toolMount.IsVolume {
+		return mount.Mount{Type: mount.TypeVolume, Source: toolMount.Source, Target: toolMount.Target,
+			ReadOnly: toolMount.ReadOnly}
+	}
+	return mount.Mount{Type: mount.TypeBind, Source: d.toDaemonPath(toolMount.Source), Target: toolMount.Target,
+		ReadOnly: toolMount.ReadOnly}
+}
+
+// getHorusecFolderMount hides the .horusec folder of the project in the read-only mount with an empty tmpfs, so the
+// copies left by previous analyses are not analysed by the tools. The folder must exist, it can't be created in the
+// read-only mount
+func (d *API) getHorusecFolderMount() (mount.Mount, bool) {
+	if !d.isReadOnlySource() {
+		return mount.Mount{}, false
+	}
+	if _, err := os.Stat(filepath.Join(d.config.GetProjectPath(), ".horusec")); err != nil {
+		return mount.Mount{}, false
+	}
+	return mount.Mount{
+		Type:     mount.TypeTmpfs,
+		Target:   d.pathDestinyInContainer + "/.horusec",
+		ReadOnly: true,
+	}, true
+}
+
+// getRulePacksMount mounts the rule packs dir only when it exists, the builtin packs don't need it
+func (d *API) getRulePacksMount() (mount.Mount, bool) {
+	if len(d.config.GetRulePacks()) == 0 {
+		return mount.Mount{}, false
+	}
+	if _, err := os.Stat(d.config.GetRulePacksDir()); err != nil {
+		return mount.Mount{}, false
+	}
+	return mount.Mount{
+		Type:     mount.TypeBind,
+		Source:   d.toDaemonPath(d.config.GetRulePacksDir()),
+		Target:   pathRulePacksInContainer,
+		ReadOnly: true,
+	}, true
 }
 
 func (d *API) loggerAPIStatus(message, imageNameWithTag string) {
@@ -269,12 +448,22 @@ func (d *API) getSourceFolder() (path string) {
 	} else {
 		path = fmt.Sprintf("%s/.horusec/%s", d.config.GetProjectPath(), d.analysisID.String())
 	}
+	if d.isReadOnlySource() {
+		path = strings.TrimSuffix(path, fmt.Sprintf("/.horusec/%s", d.analysisID.String()))
+	}
+
+	return d.toDaemonPath(path)
+}
 
-	separator := path[1:2]
-	if separator == ":" {
-		return d.getSourceFolderFromWindows(path)
+// toDaemonPath translates the path of the host to the docker daemon flavor, when it can't be translated the path is
+// kept and the error of the daemon about the mount is returned by the container creation
+func (d *API) toDaemonPath(path string) string {
+	daemonPath, err := hostpath.ToDaemonPath(path, cli.DockerDaemonFlavor(d.config.GetDockerDaemonFlavor()))
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorTranslateDockerPath+path, err, logger.ErrorLevel)
+		return path
 	}
-	return path
+	return daemonPath
 }
 
 func (d *API) listContainersByAnalysisID() ([]dockerTypes.Container, error) {
@@ -286,21 +475,3 @@ func (d *API) listContainersByAnalysisID() ([]dockerTypes.Container, error) {
 		Filters: args,
 	})
 }
-
-func (d *API) getSourceFolderFromWindows(path string) string {
-	// C:/Users/usr/Documents/Horusec/charlescd/.horusec/ID
-	partitionLower := strings.ToLower(path[0:1])
-	pathSplit := strings.Split(path, ":")
-	pathSplit[0] = partitionLower
-	path = strings.Join(pathSplit, ":")
-	// c:/Users/usr/Documents/Horusec/project/.horusec/ID
-	path = strings.ReplaceAll(path, ":", "")
-	// c/Users/usr/Documents/Horusec/project/.horusec/ID
-	path = "/" + path
-	// /c/Users/usr/Documents/Horusec/project/.horusec/ID
-	path = strings.ReplaceAll(path, "\\", "/")
-	// /c/Users/usr/Documents/Horusec/project/.horusec/ID
-	path = strings.ReplaceAll(path, "/", "//")
-	// //c//Users//usr//Documents//Horusec//project//.horusec//ID
-	return path
-}
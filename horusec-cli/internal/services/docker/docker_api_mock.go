@@ -17,6 +17,7 @@ package docker
 import (
 	utilsMock "github.com/ZupIT/horusec/development-kit/pkg/utils/mock"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/progress"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -32,3 +33,17 @@ func (m *Mock) CreateLanguageAnalysisContainer(data *dockerEntities.AnalysisData
 func (m *Mock) DeleteContainersFromAPI() {
 	m.MethodCalled("DeleteContainerFromAPI")
 }
+
+func (m *Mock) SetProgress(progress progress.Interface) {
+	_ = m.MethodCalled("SetProgress")
+}
+
+func (m *Mock) GetImageDigest(imagePath string) string {
+	args := m.MethodCalled("GetImageDigest")
+	return args.Get(0).(string)
+}
+
+func (m *Mock) PullImage(imagePath string) error {
+	args := m.MethodCalled("PullImage", imagePath)
+	return utilsMock.ReturnNilOrError(args, 0)
+}
@@ -19,17 +19,28 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	goContext "golang.org/x/net/context"
 
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	enumErrors "github.com/ZupIT/horusec/development-kit/pkg/enums/errors"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/copy"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/progress"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var ErrGeneric = errors.New("some error generic")
@@ -249,6 +260,21 @@ func TestDockerAPI_CreateLanguageAnalysisContainer(t *testing.T) {
 	})
 }
 
+func TestDockerAPI_GetOutputString(t *testing.T) {
+	t.Run("Should return error when output is bigger than max output size", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetMaxOutputSizeInMB(1)
+		api := &API{config: config, analysisID: uuid.New()}
+
+		output, err := api.getOutputString(bytes.NewReader(make([]byte, 1024*1024)))
+		assert.NoError(t, err)
+		assert.Len(t, output, 1024*1024)
+
+		_, err = api.getOutputString(bytes.NewReader(make([]byte, 1024*1024+1)))
+		assert.Equal(t, enumErrors.ErrContainerOutputTooLarge, err)
+	})
+}
+
 func TestDeleteContainersFromAPI(t *testing.T) {
 	t.Run("should not panics", func(t *testing.T) {
 		dockerAPIClient := &client.Mock{}
@@ -290,4 +316,289 @@ func TestDeleteContainersFromAPI(t *testing.T) {
 		response := api.getSourceFolder()
 		assert.Equal(t, "//c//Users//usr//Documents//Horusec//project//.horusec//"+api.analysisID.String(), response)
 	})
+
+	t.Run("Should replace docker bind folder to the flavor of the docker daemon", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetProjectPath(`C:\Users\usr\Documents\Horusec\project`)
+		config.SetDockerDaemonFlavor(cli.DockerDaemonDesktopWSL2.ToString())
+		api := &API{config: config, analysisID: uuid.New(), pathDestinyInContainer: "/src"}
+
+		assert.Equal(t, "/mnt/c/Users/usr/Documents/Horusec/project/.horusec/"+api.analysisID.String(),
+			api.getSourceFolder())
+	})
+
+	t.Run("Should mount project path read-only and keep original permissions", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetProjectPath("/home/usr/project")
+		config.SetSourceMode(cli.SourceReadOnly.ToString())
+		api := &API{config: config, analysisID: uuid.New(), pathDestinyInContainer: "/src"}
+
+		assert.Equal(t, "/home/usr/project", api.getSourceFolder())
+		assert.True(t, api.getContainerHostConfig().Mounts[0].ReadOnly)
+		assert.NotContains(t, api.replaceCMDAnalysisID("gosec ./...\n chmod -R 777 ."), "chmod -R 777 .")
+	})
+
+	t.Run("Should keep the modes of the files of the project after a hardlinked run", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "horusec-hardlink")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		projectPath := filepath.Join(dir, "project")
+		analysisPath := filepath.Join(projectPath, ".horusec", "analysis-id")
+		assert.NoError(t, os.MkdirAll(filepath.Join(projectPath, "api"), 0750))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "api", "main.go"), []byte("package api\n"), 0600))
+		assert.NoError(t, copy.HardlinkWithSymlinkMode(projectPath, analysisPath,
+			func(src string) bool { return filepath.Base(src) == ".horusec" }, cli.SymlinkFollowWithinRoot))
+		config := &cliConfig.Config{}
+		config.SetSourceMode(cli.SourceHardlink.ToString())
+		api := &API{config: config, analysisID: uuid.New(), pathDestinyInContainer: "/src"}
+
+		cmd := exec.Command("sh", "-c", api.replaceCMDAnalysisID("mkdir -p output && echo '{}' > output/result.json\n"+
+			"chmod -R 777 ."))
+		cmd.Dir = analysisPath
+		assert.NoError(t, cmd.Run())
+
+		info, err := os.Stat(filepath.Join(projectPath, "api", "main.go"))
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+		info, err = os.Stat(filepath.Join(projectPath, "api"))
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0750), info.Mode().Perm())
+		info, err = os.Stat(filepath.Join(analysisPath, "output"))
+		assert.NoError(t, err)
+		assert.Equal(t, os.ModePerm, info.Mode().Perm())
+	})
+
+	t.Run("Should hide the .horusec folder of the project in the read-only mount", func(t *testing.T) {
+		projectPath, err := ioutil.TempDir("", "horusec-project")
+		assert.NoError(t, err)
+		defer os.RemoveAll(projectPath)
+		config := &cliConfig.Config{}
+		config.SetProjectPath(projectPath)
+		config.SetSourceMode(cli.SourceReadOnly.ToString())
+		api := &API{config: config, analysisID: uuid.New(), pathDestinyInContainer: "/src"}
+		assert.Len(t, api.getContainerHostConfig().Mounts, 1)
+
+		assert.NoError(t, os.MkdirAll(projectPath+"/.horusec/old-analysis", os.ModePerm))
+		mounts := api.getContainerHostConfig().Mounts
+		assert.Len(t, mounts, 2)
+		assert.Equal(t, mount.TypeTmpfs, mounts[1].Type)
+		assert.Equal(t, "/src/.horusec", mounts[1].Target)
+	})
+}
+
+func TestDockerAPI_RulePacks(t *testing.T) {
+	t.Run("Should send the rule packs to the containers", func(t *testing.T) {
+		rulePacksDir, err := ioutil.TempDir("", "horusec-rule-packs")
+		assert.NoError(t, err)
+		defer os.RemoveAll(rulePacksDir)
+		config := &cliConfig.Config{}
+		config.SetRulePacks([]string{"java-core@1.4", "leaks"})
+		config.SetRulePacksDir(rulePacksDir)
+		api := &API{config: config, analysisID: uuid.New(), pathDestinyInContainer: "/src"}
+
+		assert.Equal(t, []string{"HORUSEC_RULE_PACKS=java-core@1.4,leaks",
+			"HORUSEC_RULE_PACKS_PATH=/horusec-rule-packs"}, api.getContainerConfig("image", "cmd").Env)
+		mounts := api.getContainerHostConfig().Mounts
+		assert.Len(t, mounts, 2)
+		assert.Equal(t, rulePacksDir, mounts[1].Source)
+		assert.True(t, mounts[1].ReadOnly)
+	})
+
+	t.Run("Should not send the rule packs when they are not selected", func(t *testing.T) {
+		api := &API{config: &cliConfig.Config{}, analysisID: uuid.New(), pathDestinyInContainer: "/src"}
+
+		assert.Empty(t, api.getContainerConfig("image", "cmd").Env)
+		assert.Len(t, api.getContainerHostConfig().Mounts, 1)
+	})
+}
+
+func TestDockerAPI_EngineEnv(t *testing.T) {
+	t.Run("Should send the workers, the memory limit, the rule timeout and the max file size to the engines",
+		func(t *testing.T) {
+			config := &cliConfig.Config{}
+			config.SetEngineWorkers(4)
+			config.SetEngineMemoryLimitInMB(1024)
+			config.SetEngineRuleTimeoutInMs(5000)
+			config.SetMaxFileSizeInMB(10)
+			api := &API{config: config, analysisID: uuid.New(), pathDestinyInContainer: "/src"}
+
+			assert.Equal(t, []string{"HORUSEC_ENGINE_WORKERS=4", "HORUSEC_ENGINE_MEMORY_LIMIT_MB=1024",
+				"HORUSEC_ENGINE_RULE_TIMEOUT_MS=5000", "HORUSEC_MAX_FILE_SIZE_MB=10"},
+				api.getContainerConfig("image", "cmd").Env)
+		})
+
+	t.Run("Should send the max file size to disable the limit of the engines", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetMaxFileSizeInMB(-1)
+		api := &API{config: config, analysisID: uuid.New(), pathDestinyInContainer: "/src"}
+
+		assert.Equal(t, []string{"HORUSEC_MAX_FILE_SIZE_MB=-1"}, api.getContainerConfig("image", "cmd").Env)
+	})
+}
+
+func TestDockerAPI_ToolMounts(t *testing.T) {
+	t.Run("Should mount the paths of the tool after the project", func(t *testing.T) {
+		api := &API{config: &cliConfig.Config{}, analysisID: uuid.New(), pathDestinyInContainer: "/src"}
+
+		mounts := api.getContainerHostConfig(dockerEntities.Mount{Source: "/home/usr/gitleaks.toml",
+			Target: "/horusec-tool-config/gitleaks.toml", ReadOnly: true}).Mounts
+
+		assert.Len(t, mounts, 2)
+		assert.Equal(t, "/home/usr/gitleaks.toml", mounts[1].Source)
+		assert.Equal(t, "/horusec-tool-config/gitleaks.toml", mounts[1].Target)
+		assert.True(t, mounts[1].ReadOnly)
+	})
+
+	t.Run("Should mount the volumes of the tool without translating the source", func(t *testing.T) {
+		api := &API{config: &cliConfig.Config{}, analysisID: uuid.New(), pathDestinyInContainer: "/src"}
+
+		mounts := api.getContainerHostConfig(dockerEntities.Mount{Source: "horusec-nuget",
+			Target: "/root/.nuget/packages", IsVolume: true}).Mounts
+
+		assert.Len(t, mounts, 2)
+		assert.Equal(t, mount.TypeVolume, mounts[1].Type)
+		assert.Equal(t, "horusec-nuget", mounts[1].Source)
+		assert.False(t, mounts[1].ReadOnly)
+	})
+}
+
+func TestDockerAPI_GetImageDigest(t *testing.T) {
+	t.Run("Should return the digest of the image present", func(t *testing.T) {
+		dockerAPIClient := &client.Mock{}
+		dockerAPIClient.On("ImageList").Return([]types.ImageSummary{
+			{ID: "sha256:123", RepoDigests: []string{"horuszup/gosec@sha256:456"}}}, nil)
+
+		api := NewDockerAPI(dockerAPIClient, &cliConfig.Config{}, uuid.New())
+		assert.Equal(t, "sha256:456", api.GetImageDigest("docker.io/horuszup/gosec:v1.0.0"))
+	})
+	t.Run("Should return empty when docker is not available", func(t *testing.T) {
+		dockerAPIClient := &client.Mock{}
+		dockerAPIClient.On("ImageList").Return([]types.ImageSummary{}, ErrGeneric)
+
+		api := NewDockerAPI(dockerAPIClient, &cliConfig.Config{}, uuid.New())
+		assert.Empty(t, api.GetImageDigest("docker.io/horuszup/gosec:v1.0.0"))
+	})
+}
+
+func TestDockerAPI_GetToolWeight(t *testing.T) {
+	t.Run("Should return default and heavy tools weights", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetMaxParallel(4)
+		api := &API{config: config}
+
+		assert.Equal(t, int64(1), api.getToolWeight(tools.GoSec))
+		assert.Equal(t, int64(2), api.getToolWeight(tools.SpotBugs))
+	})
+
+	t.Run("Should return weight of tools config and clamp it to max parallel", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetMaxParallel(3)
+		config.SetToolsConfig(map[string]interface{}{
+			"gosec":   map[string]interface{}{"weight": 2},
+			"semgrep": map[string]interface{}{"weight": 10},
+		})
+		api := &API{config: config}
+
+		assert.Equal(t, int64(2), api.getToolWeight(tools.GoSec))
+		assert.Equal(t, int64(3), api.getToolWeight(tools.Semgrep))
+	})
+}
+
+func TestDockerAPI_PullNewImage(t *testing.T) {
+	t.Run("Should pull the image once when it is needed at the same time", func(t *testing.T) {
+		dockerAPIClient := &client.Mock{}
+		dockerAPIClient.On("ImageList").Return([]types.ImageSummary{}, nil)
+		dockerAPIClient.On("ImagePull").Run(func(_ mock.Arguments) {
+			time.Sleep(200 * time.Millisecond)
+		}).Return(ioutil.NopCloser(bytes.NewReader([]byte("pulled"))), nil)
+		api := &API{ctx: goContext.Background(), dockerClient: dockerAPIClient, config: &cliConfig.Config{}}
+
+		wg := sync.WaitGroup{}
+		for index := 0; index < 5; index++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, api.pullNewImage("horuszup/gosec:v1.0.0"))
+			}()
+		}
+		wg.Wait()
+
+		dockerAPIClient.AssertNumberOfCalls(t, "ImagePull", 1)
+	})
+
+	t.Run("Should return the error of the pull to all waiting for it", func(t *testing.T) {
+		dockerAPIClient := &client.Mock{}
+		dockerAPIClient.On("ImageList").Return([]types.ImageSummary{}, nil)
+		dockerAPIClient.On("ImagePull").Run(func(_ mock.Arguments) {
+			time.Sleep(200 * time.Millisecond)
+		}).Return(ioutil.NopCloser(bytes.NewReader([]byte{})), ErrGeneric)
+		api := &API{ctx: goContext.Background(), dockerClient: dockerAPIClient, config: &cliConfig.Config{}}
+
+		wg := sync.WaitGroup{}
+		for index := 0; index < 3; index++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.Equal(t, ErrGeneric, api.pullNewImage("horuszup/gosec:v1.0.0"))
+			}()
+		}
+		wg.Wait()
+
+		dockerAPIClient.AssertNumberOfCalls(t, "ImagePull", 1)
+	})
+}
+
+type pullProgressRecorder struct {
+	progress.Mock
+	downloaded []int64
+	total      []int64
+}
+
+func (p *pullProgressRecorder) SetImagePullProgress(imagePath string, downloaded, total int64) {
+	p.downloaded = append(p.downloaded, downloaded)
+	p.total = append(p.total, total)
+}
+
+func TestDockerAPI_ReadPullProgress(t *testing.T) {
+	t.Run("Should sum the bytes downloaded of all layers", func(t *testing.T) {
+		recorder := &pullProgressRecorder{}
+		api := &API{progress: recorder}
+		stream := `{"status":"Pulling fs layer","id":"a"}
+{"status":"Downloading","progressDetail":{"current":10,"total":100},"id":"a"}
+{"status":"Downloading","progressDetail":{"current":50,"total":300},"id":"b"}
+{"status":"Download complete","id":"a"}
+{"status":"Extracting","progressDetail":{"current":10,"total":100},"id":"a"}
+`
+
+		assert.NoError(t, api.readPullProgress("horuszup/gosec:v1.0.0", bytes.NewReader([]byte(stream))))
+		assert.Equal(t, []int64{10, 60, 150}, recorder.downloaded)
+		assert.Equal(t, []int64{100, 400, 400}, recorder.total)
+	})
+
+	t.Run("Should return the error of the pull sent in the stream", func(t *testing.T) {
+		api := &API{}
+		stream := `{"status":"Pulling fs layer","id":"a"}
+{"error":"manifest unknown"}
+`
+
+		err := api.readPullProgress("horuszup/gosec:v1.0.0", bytes.NewReader([]byte(stream)))
+		assert.EqualError(t, err, "manifest unknown")
+	})
+
+	t.Run("Should ignore the stream when it is not json", func(t *testing.T) {
+		api := &API{}
+
+		assert.NoError(t, api.readPullProgress("horuszup/gosec:v1.0.0", bytes.NewReader([]byte("Some data"))))
+	})
+
+	t.Run("Should finish the progress of the pull after downloading the image", func(t *testing.T) {
+		progressMock := &progress.Mock{}
+		progressMock.On("FinishImagePull")
+		dockerAPIClient := &client.Mock{}
+		dockerAPIClient.On("ImagePull").Return(ioutil.NopCloser(bytes.NewReader([]byte(""))), nil)
+		api := &API{ctx: goContext.Background(), dockerClient: dockerAPIClient, progress: progressMock}
+
+		assert.NoError(t, api.downloadImage("horuszup/gosec:v1.0.0"))
+		progressMock.AssertCalled(t, "FinishImagePull")
+	})
 }
@@ -0,0 +1,131 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostpath
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+)
+
+const (
+	// maxPath is the MAX_PATH of windows, longer paths need the extended-length prefix
+	maxPath            = 260
+	extendedPrefix     = `\\?\`
+	extendedUNCPrefix  = `\\?\UNC\`
+	windowsSeparator   = `\`
+	wsl2MountDirectory = "/mnt/"
+)
+
+var (
+	// drive letter with optional leading slash of the msys shells, like C:/src, c:\src or /c:/src
+	driveRegex = regexp.MustCompile(`^/?([a-zA-Z]):(/.*)?$`)
+	// unc path of a share, like \\server\share\src
+	uncRegex = regexp.MustCompile(`^//([^/?]+)/([^/]+)(/.*)?$`)
+
+	ErrUNCPathNotSupported = errors.New("{HORUSEC_CLI} UNC paths can't be mounted by the docker daemon flavor, " +
+		"map the share to a drive letter or use the flavor windows")
+)
+
+type windowsPath struct {
+	drive    string
+	server   string
+	share    string
+	segments []string
+}
+
+// ToDaemonPath translates the path of the host to the path expected by the docker daemon flavor in the bind mounts.
+// The paths that aren't windows paths, like the paths already translated, are returned without changes
+func ToDaemonPath(path string, flavor cli.DockerDaemonFlavor) (string, error) {
+	parsed, ok := parseWindowsPath(path)
+	if !ok {
+		return path, nil
+	}
+	switch flavor {
+	case cli.DockerDaemonLinux:
+		return path, nil
+	case cli.DockerDaemonDesktopWSL2:
+		return parsed.toWSL2()
+	case cli.DockerDaemonWindows:
+		return parsed.toWindows(), nil
+	default:
+		return parsed.toDesktopHyperV()
+	}
+}
+
+func parseWindowsPath(path string) (*windowsPath, bool) {
+	normalized := path
+	switch {
+	case strings.HasPrefix(normalized, extendedUNCPrefix):
+		normalized = `\\` + strings.TrimPrefix(normalized, extendedUNCPrefix)
+	case strings.HasPrefix(normalized, extendedPrefix):
+		normalized = strings.TrimPrefix(normalized, extendedPrefix)
+	}
+	normalized = strings.ReplaceAll(normalized, windowsSeparator, "/")
+
+	if match := driveRegex.FindStringSubmatch(normalized); match != nil {
+		return &windowsPath{drive: match[1], segments: splitSegments(match[2])}, true
+	}
+	if match := uncRegex.FindStringSubmatch(normalized); match != nil {
+		return &windowsPath{server: match[1], share: match[2], segments: splitSegments(match[3])}, true
+	}
+	return nil, false
+}
+
+func splitSegments(path string) (segments []string) {
+	for _, segment := range strings.Split(path, "/") {
+		if segment != "" && segment != "." {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+func (w *windowsPath) isUNC() bool {
+	return w.drive == ""
+}
+
+// toDesktopHyperV keeps the format of the older versions, C:\src is //c//src
+func (w *windowsPath) toDesktopHyperV() (string, error) {
+	if w.isUNC() {
+		return "", ErrUNCPathNotSupported
+	}
+	return strings.Join(append([]string{"//" + strings.ToLower(w.drive)}, w.segments...), "//"), nil
+}
+
+func (w *windowsPath) toWSL2() (string, error) {
+	if w.isUNC() {
+		return "", ErrUNCPathNotSupported
+	}
+	return wsl2MountDirectory + strings.Join(append([]string{strings.ToLower(w.drive)}, w.segments...), "/"), nil
+}
+
+// toWindows returns the path with the extended-length prefix when it is longer than MAX_PATH
+func (w *windowsPath) toWindows() string {
+	if w.isUNC() {
+		path := strings.Join(append([]string{w.server, w.share}, w.segments...), windowsSeparator)
+		if len(path)+2 >= maxPath {
+			return extendedUNCPrefix + path
+		}
+		return `\\` + path
+	}
+	path := strings.ToUpper(w.drive) + `:\` + strings.Join(w.segments, windowsSeparator)
+	if len(path) >= maxPath {
+		return extendedPrefix + path
+	}
+	return path
+}
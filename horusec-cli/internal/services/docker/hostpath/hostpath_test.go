@@ -0,0 +1,128 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostpath
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/stretchr/testify/assert"
+)
+
+var longSegments = strings.Repeat("very-long-folder-name/", 15) + "project"
+
+func TestToDaemonPathAuto(t *testing.T) {
+	t.Run("Should translate the windows paths like desktop-hyperv", func(t *testing.T) {
+		path, err := ToDaemonPath(`C:\Users\usr\project\.horusec\ID`, cli.DockerDaemonAuto)
+		assert.NoError(t, err)
+		assert.Equal(t, "//c//Users//usr//project//.horusec//ID", path)
+	})
+	t.Run("Should keep the paths that aren't windows paths", func(t *testing.T) {
+		for _, path := range []string{"/home/usr/project", "/mnt/c/Users/usr/project", "//c//Users//usr", "./project"} {
+			translated, err := ToDaemonPath(path, cli.DockerDaemonAuto)
+			assert.NoError(t, err)
+			assert.Equal(t, path, translated)
+		}
+	})
+}
+
+func TestToDaemonPathLinux(t *testing.T) {
+	t.Run("Should keep the paths without changes", func(t *testing.T) {
+		for _, path := range []string{"/home/usr/project", `C:\Users\usr\project`} {
+			translated, err := ToDaemonPath(path, cli.DockerDaemonLinux)
+			assert.NoError(t, err)
+			assert.Equal(t, path, translated)
+		}
+	})
+}
+
+func TestToDaemonPathDesktopHyperV(t *testing.T) {
+	t.Run("Should translate the drive letters in any case and with any separator", func(t *testing.T) {
+		paths := map[string]string{
+			`C:\Users\usr\project`:     "//c//Users//usr//project",
+			"c:/Users/usr/project":     "//c//Users//usr//project",
+			`D:/Users\usr/project`:     "//d//Users//usr//project",
+			"/C:/Users/usr/project":    "//c//Users//usr//project",
+			`\\?\C:\Users\usr\project`: "//c//Users//usr//project",
+			`C:\Users\\usr\.\project\`: "//c//Users//usr//project",
+		}
+		for path, expected := range paths {
+			translated, err := ToDaemonPath(path, cli.DockerDaemonDesktopHyperV)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, translated)
+		}
+	})
+	t.Run("Should translate the root of the drive", func(t *testing.T) {
+		path, err := ToDaemonPath(`E:\`, cli.DockerDaemonDesktopHyperV)
+		assert.NoError(t, err)
+		assert.Equal(t, "//e", path)
+	})
+	t.Run("Should translate the long paths without the extended-length prefix", func(t *testing.T) {
+		path, err := ToDaemonPath(`\\?\C:\`+strings.ReplaceAll(longSegments, "/", `\`), cli.DockerDaemonDesktopHyperV)
+		assert.NoError(t, err)
+		assert.Equal(t, "//c//"+strings.ReplaceAll(longSegments, "/", "//"), path)
+	})
+	t.Run("Should return error to the unc paths", func(t *testing.T) {
+		_, err := ToDaemonPath(`\\server\share\project`, cli.DockerDaemonDesktopHyperV)
+		assert.Equal(t, ErrUNCPathNotSupported, err)
+	})
+}
+
+func TestToDaemonPathDesktopWSL2(t *testing.T) {
+	t.Run("Should translate the drive letters to the mounts of the wsl", func(t *testing.T) {
+		path, err := ToDaemonPath(`C:\Users\usr\project\.horusec\ID`, cli.DockerDaemonDesktopWSL2)
+		assert.NoError(t, err)
+		assert.Equal(t, "/mnt/c/Users/usr/project/.horusec/ID", path)
+	})
+	t.Run("Should keep the paths already inside of the wsl", func(t *testing.T) {
+		path, err := ToDaemonPath("/mnt/c/Users/usr/project", cli.DockerDaemonDesktopWSL2)
+		assert.NoError(t, err)
+		assert.Equal(t, "/mnt/c/Users/usr/project", path)
+	})
+	t.Run("Should translate the long paths without the extended-length prefix", func(t *testing.T) {
+		path, err := ToDaemonPath(`\\?\D:\`+strings.ReplaceAll(longSegments, "/", `\`), cli.DockerDaemonDesktopWSL2)
+		assert.NoError(t, err)
+		assert.Equal(t, "/mnt/d/"+longSegments, path)
+	})
+	t.Run("Should return error to the unc paths", func(t *testing.T) {
+		_, err := ToDaemonPath(`\\?\UNC\server\share\project`, cli.DockerDaemonDesktopWSL2)
+		assert.Equal(t, ErrUNCPathNotSupported, err)
+	})
+}
+
+func TestToDaemonPathWindows(t *testing.T) {
+	t.Run("Should return the windows paths with backslashes", func(t *testing.T) {
+		path, err := ToDaemonPath("c:/Users/usr/project/.horusec/ID", cli.DockerDaemonWindows)
+		assert.NoError(t, err)
+		assert.Equal(t, `C:\Users\usr\project\.horusec\ID`, path)
+	})
+	t.Run("Should return the unc paths", func(t *testing.T) {
+		path, err := ToDaemonPath("//server/share/project", cli.DockerDaemonWindows)
+		assert.NoError(t, err)
+		assert.Equal(t, `\\server\share\project`, path)
+	})
+	t.Run("Should add the extended-length prefix to the paths longer than MAX_PATH", func(t *testing.T) {
+		longPath := strings.ReplaceAll(longSegments, "/", `\`)
+
+		path, err := ToDaemonPath("C:/"+longSegments, cli.DockerDaemonWindows)
+		assert.NoError(t, err)
+		assert.Equal(t, `\\?\C:\`+longPath, path)
+
+		path, err = ToDaemonPath(`\\server\share\`+longPath, cli.DockerDaemonWindows)
+		assert.NoError(t, err)
+		assert.Equal(t, `\\?\UNC\server\share\`+longPath, path)
+	})
+}
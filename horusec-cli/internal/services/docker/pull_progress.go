@@ -0,0 +1,96 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+// pullMessage is each json of the stream of the pull, like
+// {"status":"Downloading","progressDetail":{"current":1024,"total":4096},"id":"a1b2c3"}
+type pullMessage struct {
+	Status         string `json:"status"`
+	ID             string `json:"id"`
+	Error          string `json:"error"`
+	ProgressDetail struct {
+		Current int64 `json:"current"`
+		Total   int64 `json:"total"`
+	} `json:"progressDetail"`
+}
+
+type layerProgress struct {
+	downloaded int64
+	total      int64
+}
+
+// readPullProgress reads the stream of the pull until the end, showing the bytes downloaded of all layers in the
+// progress. The errors of the pull are in the stream, the response of the api is successful. When the stream is not
+// the expected json the rest of it is discarded, the progress is only informative
+func (d *API) readPullProgress(imagePath string, reader io.Reader) error {
+	layers := map[string]*layerProgress{}
+	decoder := json.NewDecoder(reader)
+	for {
+		message := pullMessage{}
+		if err := decoder.Decode(&message); err != nil {
+			if err != io.EOF {
+				logger.LogDebugWithLevel(messages.MsgDebugDockerAPIPullProgressUnknown, logger.DebugLevel, err.Error())
+				_, _ = io.Copy(ioutil.Discard, reader)
+			}
+			return nil
+		}
+		if message.Error != "" {
+			return errors.New(message.Error)
+		}
+		if d.setLayerProgress(layers, &message) && d.progress != nil {
+			downloaded, total := d.sumLayersProgress(layers)
+			d.progress.SetImagePullProgress(imagePath, downloaded, total)
+		}
+	}
+}
+
+// setLayerProgress returns true when the bytes downloaded of the layer changed, the extraction is ignored
+func (d *API) setLayerProgress(layers map[string]*layerProgress, message *pullMessage) bool {
+	if message.ID == "" {
+		return false
+	}
+	layer, ok := layers[message.ID]
+	if !ok {
+		layer = &layerProgress{}
+		layers[message.ID] = layer
+	}
+	switch message.Status {
+	case "Downloading":
+		layer.downloaded, layer.total = message.ProgressDetail.Current, message.ProgressDetail.Total
+	case "Download complete", "Pull complete", "Already exists":
+		layer.downloaded = layer.total
+	default:
+		return false
+	}
+	return true
+}
+
+func (d *API) sumLayersProgress(layers map[string]*layerProgress) (downloaded, total int64) {
+	for _, layer := range layers {
+		downloaded += layer.downloaded
+		total += layer.total
+	}
+	return downloaded, total
+}
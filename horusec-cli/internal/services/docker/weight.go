@@ -0,0 +1,43 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package docker
+
+import "github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+
+const defaultToolWeight int64 = 1
+
+// Tools that compile the project or load big rule sets take more memory and cpu,
+// so they hold more slots of the pool while running
+var heavyToolsWeight = map[tools.Tool]int64{
+	tools.SpotBugs:         2,
+	tools.SecurityCodeScan: 2,
+	tools.Semgrep:          2,
+	tools.HorusecJava:      2,
+	tools.HorusecKotlin:    2,
+}
+
+func (d *API) getToolWeight(tool tools.Tool) int64 {
+	weight := defaultToolWeight
+	if heavyWeight, ok := heavyToolsWeight[tool]; ok {
+		weight = heavyWeight
+	}
+	if configWeight := d.config.GetToolsConfig()[tool].Weight; configWeight > 0 {
+		weight = configWeight
+	}
+	if weight > d.config.GetMaxParallel() {
+		return d.config.GetMaxParallel()
+	}
+	return weight
+}
@@ -0,0 +1,244 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/google/uuid"
+)
+
+const (
+	requestTimeout = 30
+	// bulkSize is the max of vulnerabilities sent in each request of the bulk API
+	bulkSize = 500
+	// templatePriority is above the priority of the built-in templates of elasticsearch, like the ones of logs-*-*
+	templatePriority = 200
+)
+
+// IndexMappings are the mappings of the index template of horusec, the text fields are searched by words and the
+// other strings are keywords, to be used in the filters and aggregations of the dashboards
+const IndexMappings = `{
+  "dynamic_templates": [
+    {
+      "strings_as_keywords": {
+        "match_mapping_type": "string",
+        "mapping": {"type": "keyword", "ignore_above": 1024}
+      }
+    }
+  ],
+  "properties": {
+    "@timestamp": {"type": "date"},
+    "details": {"type": "text"},
+    "code": {"type": "text"},
+    "commitMessage": {"type": "text"},
+    "analysis": {
+      "properties": {
+        "createdAt": {"type": "date"},
+        "finishedAt": {"type": "date"},
+        "riskScore": {"properties": {"score": {"type": "integer"}}}
+      }
+    }
+  }
+}`
+
+type Interface interface {
+	Index(analysis *horusec.Analysis)
+}
+
+// Document is a vulnerability with the data of its analysis, so each analysis is a new set of documents and the
+// dashboards can show the history of the repositories
+type Document struct {
+	Timestamp time.Time    `json:"@timestamp"`
+	Analysis  AnalysisData `json:"analysis"`
+	horusec.Vulnerability
+}
+
+type AnalysisData struct {
+	ID             uuid.UUID              `json:"id"`
+	RepositoryName string                 `json:"repositoryName"`
+	Status         enumHorusec.Status     `json:"status"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	FinishedAt     time.Time              `json:"finishedAt"`
+	IsPartial      bool                   `json:"isPartial"`
+	RiskScore      *horusec.RiskScore     `json:"riskScore,omitempty"`
+	Tags           map[string]string      `json:"tags,omitempty"`
+	Source         *horusec.SourceContext `json:"source,omitempty"`
+}
+
+type bulkResponse struct {
+	Errors bool                            `json:"errors"`
+	Items  []map[string]bulkResponseResult `json:"items"`
+}
+
+type bulkResponseResult struct {
+	Status int `json:"status"`
+	Error  *struct {
+		Type   string `json:"type"`
+		Reason string `json:"reason"`
+	} `json:"error,omitempty"`
+}
+
+type Elasticsearch struct {
+	config     cliConfig.IConfig
+	httpClient client.Interface
+}
+
+func NewElasticsearch(config cliConfig.IConfig) Interface {
+	return &Elasticsearch{
+		config:     config,
+		httpClient: client.NewHTTPClient(requestTimeout),
+	}
+}
+
+// Index does nothing unless the url was informed. The index template is installed before the vulnerabilities, so a
+// new index is created with the mappings of horusec, and the errors are only logged, the indexing never changes the
+// result of the analysis
+func (e *Elasticsearch) Index(analysis *horusec.Analysis) {
+	if e.config.GetElasticsearchURL() == "" || len(analysis.AnalysisVulnerabilities) == 0 {
+		return
+	}
+	if err := e.putIndexTemplate(); err != nil {
+		logger.LogWarnWithLevel(messages.MsgWarnElasticsearchIndexFailed, logger.WarnLevel, err.Error())
+		return
+	}
+	documents := e.newDocuments(analysis)
+	for start := 0; start < len(documents); start += bulkSize {
+		end := start + bulkSize
+		if end > len(documents) {
+			end = len(documents)
+		}
+		if err := e.bulkIndex(documents[start:end]); err != nil {
+			logger.LogWarnWithLevel(messages.MsgWarnElasticsearchIndexFailed, logger.WarnLevel, err.Error())
+			return
+		}
+	}
+	logger.LogDebugWithLevel(messages.MsgDebugElasticsearchIndexed, logger.DebugLevel, len(documents))
+}
+
+func (e *Elasticsearch) newDocuments(analysis *horusec.Analysis) (documents []Document) {
+	analysisData := AnalysisData{
+		ID:             analysis.ID,
+		RepositoryName: analysis.RepositoryName,
+		Status:         analysis.Status,
+		CreatedAt:      analysis.CreatedAt,
+		FinishedAt:     analysis.FinishedAt,
+		IsPartial:      analysis.IsPartial,
+		RiskScore:      analysis.RiskScore,
+		Tags:           analysis.Tags,
+		Source:         analysis.Source,
+	}
+	for index := range analysis.AnalysisVulnerabilities {
+		documents = append(documents, Document{
+			Timestamp:     analysis.CreatedAt,
+			Analysis:      analysisData,
+			Vulnerability: analysis.AnalysisVulnerabilities[index].Vulnerability,
+		})
+	}
+	return documents
+}
+
+func (e *Elasticsearch) putIndexTemplate() error {
+	content, err := json.Marshal(map[string]interface{}{
+		"index_patterns": []string{e.config.GetElasticsearchIndex() + "*"},
+		"priority":       templatePriority,
+		"template":       map[string]interface{}{"mappings": json.RawMessage(IndexMappings)},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = e.doRequest(http.MethodPut, "/_index_template/"+e.config.GetElasticsearchIndex(), "application/json",
+		content)
+	return err
+}
+
+// bulkIndex uses the id of the vulnerability as the id of the document, so sending the same analysis again replaces
+// its documents
+func (e *Elasticsearch) bulkIndex(documents []Document) error {
+	body := &bytes.Buffer{}
+	encoder := json.NewEncoder(body)
+	for index := range documents {
+		action := map[string]interface{}{"index": map[string]string{
+			"_index": e.config.GetElasticsearchIndex(),
+			"_id":    documents[index].VulnerabilityID.String(),
+		}}
+		if err := encoder.Encode(action); err != nil {
+			return err
+		}
+		if err := encoder.Encode(documents[index]); err != nil {
+			return err
+		}
+	}
+	content, err := e.doRequest(http.MethodPost, "/_bulk", "application/x-ndjson", body.Bytes())
+	if err != nil {
+		return err
+	}
+	return e.getBulkError(content)
+}
+
+// getBulkError returns the first error of the items, the bulk API answers with success even if all items failed
+func (e *Elasticsearch) getBulkError(content []byte) error {
+	response := bulkResponse{}
+	if err := json.Unmarshal(content, &response); err != nil {
+		return err
+	}
+	if !response.Errors {
+		return nil
+	}
+	for _, item := range response.Items {
+		for _, result := range item {
+			if result.Error != nil {
+				return fmt.Errorf("%s: %s", result.Error.Type, result.Error.Reason)
+			}
+		}
+	}
+	return errors.New("bulk request with errors")
+}
+
+// doRequest uses the basic authentication of the url, that is sent by the http client, unless an api key is informed
+func (e *Elasticsearch) doRequest(method, path, contentType string, content []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, strings.TrimSuffix(e.config.GetElasticsearchURL(), "/")+path,
+		bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if apiKey := e.config.GetElasticsearchAPIKey(); apiKey != "" {
+		req.Header.Set("Authorization", "ApiKey "+apiKey)
+	}
+
+	response, err := e.httpClient.DoRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.CloseBody()
+
+	if response.GetStatusCode() < http.StatusOK || response.GetStatusCode() >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("unexpected status code %d in %s", response.GetStatusCode(), path)
+	}
+	return response.GetBody()
+}
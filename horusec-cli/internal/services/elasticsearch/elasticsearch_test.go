@@ -0,0 +1,125 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearch
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type request struct {
+	method        string
+	path          string
+	authorization string
+	lines         []string
+}
+
+func newFakeServer(requests *[]request, bulkResponse string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		*requests = append(*requests, request{method: r.Method, path: r.URL.Path,
+			authorization: r.Header.Get("Authorization"), lines: strings.Split(strings.TrimSpace(string(body)), "\n")})
+		if r.URL.Path == "/_bulk" {
+			_, _ = w.Write([]byte(bulkResponse))
+			return
+		}
+		_, _ = w.Write([]byte(`{"acknowledged":true}`))
+	}))
+}
+
+func newService(url string) *Elasticsearch {
+	configs := &config.Config{}
+	configs.SetElasticsearchURL(url)
+	configs.SetElasticsearchAPIKey("key")
+	return &Elasticsearch{config: configs, httpClient: client.NewHTTPClient(requestTimeout)}
+}
+
+func newAnalysis() *horusec.Analysis {
+	return &horusec.Analysis{
+		ID:             uuid.New(),
+		RepositoryName: "horusec",
+		CreatedAt:      time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC),
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{VulnerabilityID: uuid.New(), Severity: severity.High}},
+			{Vulnerability: horusec.Vulnerability{VulnerabilityID: uuid.New(), Severity: severity.Low}},
+		},
+	}
+}
+
+func TestElasticsearch_Index(t *testing.T) {
+	t.Run("Should install the index template and index the vulnerabilities", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests, `{"errors":false,"items":[]}`)
+		defer server.Close()
+		analysis := newAnalysis()
+
+		newService(server.URL + "/").Index(analysis)
+
+		assert.Len(t, requests, 2)
+		assert.Equal(t, http.MethodPut, requests[0].method)
+		assert.Equal(t, "/_index_template/horusec-vulnerabilities", requests[0].path)
+		assert.Contains(t, requests[0].lines[0], `"index_patterns":["horusec-vulnerabilities*"]`)
+		assert.Equal(t, "/_bulk", requests[1].path)
+		assert.Equal(t, "ApiKey key", requests[1].authorization)
+		assert.Len(t, requests[1].lines, 4)
+		assert.Equal(t, `{"index":{"_id":"`+analysis.AnalysisVulnerabilities[0].Vulnerability.VulnerabilityID.String()+
+			`","_index":"horusec-vulnerabilities"}}`, requests[1].lines[0])
+		document := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal([]byte(requests[1].lines[1]), &document))
+		assert.Equal(t, "2021-01-02T03:04:05Z", document["@timestamp"])
+		assert.Equal(t, "HIGH", document["severity"])
+		assert.Equal(t, "horusec", document["analysis"].(map[string]interface{})["repositoryName"])
+	})
+
+	t.Run("Should not index when the url is not informed", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests, `{"errors":false,"items":[]}`)
+		defer server.Close()
+
+		newService("").Index(newAnalysis())
+
+		assert.Empty(t, requests)
+	})
+
+	t.Run("Should return the error of the items of the bulk response", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests, `{"errors":true,"items":[{"index":{"status":400,"error":{`+
+			`"type":"mapper_parsing_exception","reason":"failed to parse"}}}]}`)
+		defer server.Close()
+		service := newService(server.URL)
+
+		err := service.bulkIndex(service.newDocuments(newAnalysis()))
+
+		assert.EqualError(t, err, "mapper_parsing_exception: failed to parse")
+	})
+
+	t.Run("Should not panic when elasticsearch is not available", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			newService("http://127.0.0.1:1").Index(newAnalysis())
+		})
+	})
+}
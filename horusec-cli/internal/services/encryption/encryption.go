@@ -0,0 +1,113 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+	"strings"
+
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+const (
+	ageBinary = "age"
+	gpgBinary = "gpg"
+)
+
+// agePrefixes are the prefixes of the recipients encrypted by age, the age and ssh public keys, the other recipients
+// are keys of gpg, like the fingerprint or the email
+var agePrefixes = []string{"age1", "ssh-ed25519 ", "ssh-rsa "}
+
+type Interface interface {
+	Encrypt(content []byte) ([]byte, error)
+}
+
+type Encryption struct {
+	config    cliConfig.IConfig
+	runBinary func(binary string, args []string, input []byte) ([]byte, error)
+}
+
+func NewEncryption(config cliConfig.IConfig) Interface {
+	return &Encryption{
+		config:    config,
+		runBinary: runBinary,
+	}
+}
+
+// IsAgeRecipient returns if the recipient is encrypted by age instead of gpg
+func IsAgeRecipient(recipient string) bool {
+	for _, prefix := range agePrefixes {
+		if strings.HasPrefix(strings.TrimSpace(recipient), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidRecipients checks that the recipients are all of age or all of gpg, because each binary only encrypts to
+// its keys
+func IsValidRecipients(recipients []string) bool {
+	for _, recipient := range recipients {
+		if IsAgeRecipient(recipient) != IsAgeRecipient(recipients[0]) {
+			return false
+		}
+	}
+	return len(recipients) > 0
+}
+
+// Encrypt returns the content encrypted in ascii armor, so the report is still a text file. The content is not
+// encrypted when the encryption of the report is disabled
+func (e *Encryption) Encrypt(content []byte) ([]byte, error) {
+	if !e.config.GetEncryptReport() {
+		return content, nil
+	}
+	recipients := e.config.GetReportRecipients()
+	if !IsValidRecipients(recipients) {
+		return nil, errors.New(messages.MsgErrorInvalidReportRecipients)
+	}
+	if IsAgeRecipient(recipients[0]) {
+		return e.runBinary(ageBinary, e.getRecipientsArgs([]string{"--encrypt", "--armor"}, recipients), content)
+	}
+	return e.runBinary(gpgBinary, e.getRecipientsArgs([]string{"--batch", "--yes", "--armor", "--trust-model",
+		"always", "--encrypt"}, recipients), content)
+}
+
+func (e *Encryption) getRecipientsArgs(args, recipients []string) []string {
+	for _, recipient := range recipients {
+		args = append(args, "--recipient", strings.TrimSpace(recipient))
+	}
+	return args
+}
+
+func runBinary(binary string, args []string, input []byte) ([]byte, error) {
+	path, err := exec.LookPath(binary)
+	if err != nil {
+		return nil, errors.New(strings.ReplaceAll(messages.MsgErrorEncryptionBinaryNotFound, "{{0}}", binary))
+	}
+
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stderr = stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, errors.New(strings.TrimSpace(err.Error() + ": " + stderr.String()))
+	}
+
+	return output, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encryption
+
+import (
+	"testing"
+
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const ageRecipientToTest = "age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p"
+
+func TestIsValidRecipients(t *testing.T) {
+	t.Run("Should return true when the recipients are all of age or all of gpg", func(t *testing.T) {
+		assert.True(t, IsValidRecipients([]string{ageRecipientToTest, "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAI"}))
+		assert.True(t, IsValidRecipients([]string{"security@company.com", "3AA5C34371567BD2"}))
+	})
+
+	t.Run("Should return false when the recipients are empty or of age and gpg", func(t *testing.T) {
+		assert.False(t, IsValidRecipients(nil))
+		assert.False(t, IsValidRecipients([]string{ageRecipientToTest, "security@company.com"}))
+	})
+}
+
+func TestEncrypt(t *testing.T) {
+	t.Run("Should return the content when the encryption of the report is disabled", func(t *testing.T) {
+		encryption := &Encryption{config: &cliConfig.Config{}}
+
+		content, err := encryption.Encrypt([]byte("report"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("report"), content)
+	})
+
+	t.Run("Should encrypt with age to the age recipients", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetEncryptReport(true)
+		config.SetReportRecipients([]string{ageRecipientToTest})
+		encryption := &Encryption{config: config, runBinary: func(binary string, args []string, input []byte) ([]byte, error) {
+			assert.Equal(t, "age", binary)
+			assert.Equal(t, []string{"--encrypt", "--armor", "--recipient", ageRecipientToTest}, args)
+			assert.Equal(t, []byte("report"), input)
+			return []byte("-----BEGIN AGE ENCRYPTED FILE-----"), nil
+		}}
+
+		content, err := encryption.Encrypt([]byte("report"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("-----BEGIN AGE ENCRYPTED FILE-----"), content)
+	})
+
+	t.Run("Should encrypt with gpg to the gpg recipients", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetEncryptReport(true)
+		config.SetReportRecipients([]string{"security@company.com", " 3AA5C34371567BD2"})
+		encryption := &Encryption{config: config, runBinary: func(binary string, args []string, _ []byte) ([]byte, error) {
+			assert.Equal(t, "gpg", binary)
+			assert.Equal(t, []string{"--batch", "--yes", "--armor", "--trust-model", "always", "--encrypt",
+				"--recipient", "security@company.com", "--recipient", "3AA5C34371567BD2"}, args)
+			return []byte("-----BEGIN PGP MESSAGE-----"), nil
+		}}
+
+		content, err := encryption.Encrypt([]byte("report"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("-----BEGIN PGP MESSAGE-----"), content)
+	})
+
+	t.Run("Should return error when the recipients are of age and gpg", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetEncryptReport(true)
+		config.SetReportRecipients([]string{ageRecipientToTest, "security@company.com"})
+
+		_, err := (&Encryption{config: config}).Encrypt([]byte("report"))
+		assert.Error(t, err)
+	})
+}
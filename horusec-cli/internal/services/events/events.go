@@ -0,0 +1,129 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+)
+
+type Type string
+
+const (
+	ToolStarted        Type = "ToolStarted"
+	ToolFinished       Type = "ToolFinished"
+	VulnerabilityFound Type = "VulnerabilityFound"
+	AnalysisCompleted  Type = "AnalysisCompleted"
+)
+
+// Event is published while the analysis runs, the fields not related to the type are empty
+type Event struct {
+	Type                 Type                   `json:"type"`
+	AnalysisID           string                 `json:"analysisID"`
+	Time                 time.Time              `json:"time"`
+	Tool                 tools.Tool             `json:"tool,omitempty"`
+	ProjectSubPath       string                 `json:"projectSubPath,omitempty"`
+	Status               string                 `json:"status,omitempty"`
+	Error                string                 `json:"error,omitempty"`
+	Vulnerability        *horusec.Vulnerability `json:"vulnerability,omitempty"`
+	TotalVulnerabilities int                    `json:"totalVulnerabilities,omitempty"`
+}
+
+// Subscriber receives the events in the order they are published. The analysis waits the subscribers, so they
+// should return quickly
+type Subscriber interface {
+	Handle(event *Event)
+}
+
+type SubscriberFunc func(event *Event)
+
+func (f SubscriberFunc) Handle(event *Event) {
+	f(event)
+}
+
+var (
+	subscribers = map[string]Subscriber{}
+	mutex       sync.Mutex
+)
+
+// Subscribe adds the subscriber with the name informed, replacing the subscriber subscribed before. Like the
+// printers, it is called in the init of the package of the subscriber, so subscribers compiled in with build tags
+// don't need changes in the core
+func Subscribe(name string, subscriber Subscriber) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	subscribers[name] = subscriber
+}
+
+func Unsubscribe(name string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	delete(subscribers, name)
+}
+
+// Publish sends the event to the subscribers sorted by name. The tools run concurrently, so the lock keeps the
+// subscribers receiving one event at a time
+func Publish(event *Event) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+	for _, name := range getSubscriberNames() {
+		subscribers[name].Handle(event)
+	}
+}
+
+func getSubscriberNames() (names []string) {
+	for name := range subscribers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func NewToolStarted(analysisID string, tool tools.Tool, projectSubPath string) *Event {
+	return &Event{Type: ToolStarted, AnalysisID: analysisID, Tool: tool, ProjectSubPath: projectSubPath}
+}
+
+// NewToolFinished has the status of the tool in the scan manifest, success or failed with the error
+func NewToolFinished(analysisID string, tool tools.Tool, projectSubPath string, err error) *Event {
+	event := &Event{Type: ToolFinished, AnalysisID: analysisID, Tool: tool, ProjectSubPath: projectSubPath,
+		Status: horusec.ToolStatusSuccess}
+	if err != nil {
+		event.Status = horusec.ToolStatusFailed
+		event.Error = err.Error()
+	}
+	return event
+}
+
+func NewVulnerabilityFound(analysisID string, vulnerability *horusec.Vulnerability) *Event {
+	return &Event{Type: VulnerabilityFound, AnalysisID: analysisID, Tool: vulnerability.SecurityTool,
+		Vulnerability: vulnerability}
+}
+
+// NewAnalysisCompleted has the error that stopped the analysis or failed the gates, like the vulnerabilities found
+func NewAnalysisCompleted(analysis *horusec.Analysis, err error) *Event {
+	event := &Event{Type: AnalysisCompleted, AnalysisID: analysis.GetIDString(), Status: string(analysis.Status),
+		TotalVulnerabilities: len(analysis.AnalysisVulnerabilities)}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	return event
+}
@@ -0,0 +1,86 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	horusecEnums "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublish(t *testing.T) {
+	t.Run("Should send the events to the subscribers sorted by name", func(t *testing.T) {
+		var received []string
+		Subscribe("b", SubscriberFunc(func(event *Event) { received = append(received, "b") }))
+		Subscribe("a", SubscriberFunc(func(event *Event) { received = append(received, "a") }))
+		defer Unsubscribe("a")
+		defer Unsubscribe("b")
+
+		event := NewToolStarted("id", tools.GoSec, "api")
+		Publish(event)
+		assert.Equal(t, []string{"a", "b"}, received)
+		assert.False(t, event.Time.IsZero())
+	})
+
+	t.Run("Should not send the events after unsubscribe", func(t *testing.T) {
+		total := 0
+		Subscribe("test", SubscriberFunc(func(event *Event) { total++ }))
+		Unsubscribe("test")
+
+		Publish(NewToolStarted("id", tools.GoSec, ""))
+		assert.Equal(t, 0, total)
+	})
+}
+
+func TestNewToolFinished(t *testing.T) {
+	t.Run("Should return the tool finished with success", func(t *testing.T) {
+		event := NewToolFinished("id", tools.GoSec, "api", nil)
+		assert.Equal(t, ToolFinished, event.Type)
+		assert.Equal(t, horusec.ToolStatusSuccess, event.Status)
+		assert.Empty(t, event.Error)
+	})
+
+	t.Run("Should return the tool failed with the error", func(t *testing.T) {
+		event := NewToolFinished("id", tools.GoSec, "api", errors.New("test"))
+		assert.Equal(t, horusec.ToolStatusFailed, event.Status)
+		assert.Equal(t, "test", event.Error)
+	})
+}
+
+func TestNewVulnerabilityFound(t *testing.T) {
+	t.Run("Should return the vulnerability with the tool", func(t *testing.T) {
+		vulnerability := &horusec.Vulnerability{SecurityTool: tools.GoSec, VulnHash: "hash"}
+		event := NewVulnerabilityFound("id", vulnerability)
+		assert.Equal(t, VulnerabilityFound, event.Type)
+		assert.Equal(t, tools.GoSec, event.Tool)
+		assert.Equal(t, "hash", event.Vulnerability.VulnHash)
+	})
+}
+
+func TestNewAnalysisCompleted(t *testing.T) {
+	t.Run("Should return the status and the total of vulnerabilities of the analysis", func(t *testing.T) {
+		analysis := &horusec.Analysis{Status: horusecEnums.Success,
+			AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{{}, {}}}
+		event := NewAnalysisCompleted(analysis, errors.New("gate failed"))
+		assert.Equal(t, AnalysisCompleted, event.Type)
+		assert.Equal(t, "success", event.Status)
+		assert.Equal(t, 2, event.TotalVulnerabilities)
+		assert.Equal(t, "gate failed", event.Error)
+	})
+}
@@ -0,0 +1,123 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+// ExecHookPrefix is the prefix of the executables in the PATH used as event hooks. The executable receives each
+// event as a json line in the stdin, that is closed when the analysis finishes
+const ExecHookPrefix = "horusec-hook-"
+
+// waitTimeout is how long the hooks have to finish after the stdin is closed before they are killed
+const waitTimeout = 10 * time.Second
+
+type execHook struct {
+	name    string
+	path    string
+	cmd     *exec.Cmd
+	stdin   io.WriteCloser
+	encoder *json.Encoder
+	failed  bool
+}
+
+// LookExecHook returns the path of the executable horusec-hook-<name> found in the PATH
+func LookExecHook(name string) (string, bool) {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return "", false
+	}
+	path, err := exec.LookPath(ExecHookPrefix + name)
+	return path, err == nil
+}
+
+// StartExecHooks subscribes the executables of the hooks informed, the hooks not found or that fail to start are
+// logged and the analysis continues without them. The function returned unsubscribes and waits the hooks
+func StartExecHooks(names []string) (stop func()) {
+	var hooks []*execHook
+	for _, name := range names {
+		path, ok := LookExecHook(name)
+		if !ok {
+			logger.LogErrorWithLevel(messages.MsgErrorEventHookNotFound+name, exec.ErrNotFound, logger.ErrorLevel)
+			continue
+		}
+		hook, err := newExecHook(name, path)
+		if err != nil {
+			logger.LogErrorWithLevel(messages.MsgErrorRunEventHook+path, err, logger.ErrorLevel)
+			continue
+		}
+		Subscribe(hook.getSubscriberName(), hook)
+		hooks = append(hooks, hook)
+	}
+	return func() {
+		for _, hook := range hooks {
+			Unsubscribe(hook.getSubscriberName())
+			hook.stop()
+		}
+	}
+}
+
+// newExecHook starts the executable writing its output in the stderr, so the stdout is kept to the reports
+func newExecHook(name, path string) (*execHook, error) {
+	cmd := exec.Command(path)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return &execHook{name: name, path: path, cmd: cmd, stdin: stdin, encoder: json.NewEncoder(stdin)}, nil
+}
+
+func (e *execHook) getSubscriberName() string {
+	return ExecHookPrefix + e.name
+}
+
+// Handle stops writing after the first error, like when the hook exited before the end of the analysis
+func (e *execHook) Handle(event *Event) {
+	if e.failed {
+		return
+	}
+	if err := e.encoder.Encode(event); err != nil {
+		e.failed = true
+		logger.LogErrorWithLevel(messages.MsgErrorRunEventHook+e.path, err, logger.ErrorLevel)
+	}
+}
+
+func (e *execHook) stop() {
+	_ = e.stdin.Close()
+	done := make(chan error, 1)
+	go func() {
+		done <- e.cmd.Wait()
+	}()
+	select {
+	case err := <-done:
+		logger.LogErrorWithLevel(messages.MsgErrorRunEventHook+e.path, err, logger.ErrorLevel)
+	case <-time.After(waitTimeout):
+		_ = e.cmd.Process.Kill()
+		logger.LogErrorWithLevel(messages.MsgErrorRunEventHook+e.path, <-done, logger.ErrorLevel)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartExecHooks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts are not available on windows")
+	}
+	dir, err := ioutil.TempDir("", "horusec-hook")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := os.Getenv("PATH")
+	assert.NoError(t, os.Setenv("PATH", dir+string(os.PathListSeparator)+path))
+	defer func() { _ = os.Setenv("PATH", path) }()
+
+	t.Run("Should send the events as json lines to the executable", func(t *testing.T) {
+		output := filepath.Join(dir, "events.jsonl")
+		script := "#!/bin/sh\ncat > " + output + "\n"
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "horusec-hook-test"), []byte(script), 0700))
+
+		stop := StartExecHooks([]string{"test"})
+		Publish(NewToolStarted("id", tools.GoSec, "api"))
+		Publish(NewToolFinished("id", tools.GoSec, "api", nil))
+		stop()
+
+		content, err := ioutil.ReadFile(output)
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		assert.Len(t, lines, 2)
+		event := &Event{}
+		assert.NoError(t, json.Unmarshal([]byte(lines[1]), event))
+		assert.Equal(t, ToolFinished, event.Type)
+		assert.Equal(t, tools.GoSec, event.Tool)
+	})
+
+	t.Run("Should continue without the hooks not found or that exited", func(t *testing.T) {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "horusec-hook-exit"), []byte("#!/bin/sh\nexit 1\n"), 0700))
+
+		stop := StartExecHooks([]string{"not-found", "exit"})
+		assert.NotPanics(t, func() {
+			for index := 0; index < 1000; index++ {
+				Publish(NewToolStarted("id", tools.GoSec, ""))
+			}
+		})
+		stop()
+		assert.Empty(t, getSubscriberNames())
+	})
+}
+
+func TestLookExecHook(t *testing.T) {
+	t.Run("Should not look paths informed as name", func(t *testing.T) {
+		_, ok := LookExecHook("../horusec-hook-test")
+		assert.False(t, ok)
+	})
+}
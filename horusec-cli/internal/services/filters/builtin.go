@@ -0,0 +1,113 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filters
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/policy"
+	"github.com/bmatcuk/doublestar/v2"
+)
+
+const (
+	Severity     = "severity"
+	Path         = "path"
+	Baseline     = "baseline"
+	Suppressions = "suppressions"
+	Dedup        = "dedup"
+)
+
+func init() {
+	Register(Severity, keepFilter(keepSeverity))
+	Register(Path, keepFilter(keepPath))
+	Register(Baseline, &baselineFilter{})
+	Register(Suppressions, keepFilter(keepSuppression))
+	Register(Dedup, &dedupFilter{})
+}
+
+// keepFilter is a filter that decides each vulnerability alone
+type keepFilter func(vulnerability *horusec.Vulnerability, configs cliConfig.IConfig) bool
+
+func (f keepFilter) Filter(analysis *horusec.Analysis,
+	configs cliConfig.IConfig) ([]horusec.AnalysisVulnerabilities, error) {
+	kept := []horusec.AnalysisVulnerabilities{}
+	for index := range analysis.AnalysisVulnerabilities {
+		if f(&analysis.AnalysisVulnerabilities[index].Vulnerability, configs) {
+			kept = append(kept, analysis.AnalysisVulnerabilities[index])
+		}
+	}
+	return kept, nil
+}
+
+// keepSeverity removes the severities of the flag ignore-severity, that without the filter are only not counted
+func keepSeverity(vulnerability *horusec.Vulnerability, configs cliConfig.IConfig) bool {
+	for _, severityToIgnore := range configs.GetSeveritiesToIgnore() {
+		if strings.EqualFold(vulnerability.Severity.ToString(), strings.TrimSpace(severityToIgnore)) {
+			return false
+		}
+	}
+	return true
+}
+
+// keepPath removes the vulnerabilities in the files of the flag ignore, like the ones found in the dependencies
+// of the project that are not copied
+func keepPath(vulnerability *horusec.Vulnerability, configs cliConfig.IConfig) bool {
+	file := filepath.ToSlash(vulnerability.File)
+	for _, pattern := range configs.GetFilesOrPathsToIgnore() {
+		if matched, _ := doublestar.Match(strings.TrimSpace(pattern), file); matched {
+			return false
+		}
+	}
+	return true
+}
+
+// keepSuppression removes the false positives and the risk accepted, that without the filter are in the report
+func keepSuppression(vulnerability *horusec.Vulnerability, _ cliConfig.IConfig) bool {
+	return vulnerability.Type != enumHorusec.FalsePositive && vulnerability.Type != enumHorusec.RiskAccepted
+}
+
+// baselineFilter keeps only the new vulnerabilities, the ones not found in the baseline of the policy
+type baselineFilter struct{}
+
+func (f *baselineFilter) Filter(analysis *horusec.Analysis,
+	configs cliConfig.IConfig) ([]horusec.AnalysisVulnerabilities, error) {
+	baseline, err := policy.GetBaselineHashes(configs)
+	if err != nil || baseline == nil {
+		return analysis.AnalysisVulnerabilities, err
+	}
+	return keepFilter(func(vulnerability *horusec.Vulnerability, _ cliConfig.IConfig) bool {
+		return !baseline[vulnerability.VulnHash]
+	}).Filter(analysis, configs)
+}
+
+// dedupFilter keeps the first vulnerability of each hash, like the same finding reported by two tools
+type dedupFilter struct{}
+
+func (f *dedupFilter) Filter(analysis *horusec.Analysis,
+	configs cliConfig.IConfig) ([]horusec.AnalysisVulnerabilities, error) {
+	hashes := map[string]bool{}
+	return keepFilter(func(vulnerability *horusec.Vulnerability, _ cliConfig.IConfig) bool {
+		if vulnerability.VulnHash == "" {
+			return true
+		}
+		isDuplicated := hashes[vulnerability.VulnHash]
+		hashes[vulnerability.VulnHash] = true
+		return !isDuplicated
+	}).Filter(analysis, configs)
+}
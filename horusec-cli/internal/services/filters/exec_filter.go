@@ -0,0 +1,52 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filters
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+// execFilter runs an executable found in the PATH sending the analysis as json in its stdin. The stdout of the
+// executable is the json array of the analysis vulnerabilities kept
+type execFilter struct {
+	path string
+}
+
+func newExecFilter(path string) *execFilter {
+	return &execFilter{path: path}
+}
+
+func (e *execFilter) Filter(analysis *horusec.Analysis,
+	_ cliConfig.IConfig) (kept []horusec.AnalysisVulnerabilities, err error) {
+	content, err := json.Marshal(analysis)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(e.path)
+	cmd.Stdin = bytes.NewReader(content)
+	cmd.Stderr = os.Stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return kept, json.Unmarshal(output, &kept)
+}
@@ -0,0 +1,146 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filters
+
+import (
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+// ExecPluginPrefix is the prefix of the executables in the PATH used as filters not compiled in horusec. The
+// executable receives the analysis as json in the stdin and writes the analysis vulnerabilities kept in the stdout
+const ExecPluginPrefix = "horusec-filter-"
+
+// Filter removes vulnerabilities of the analysis, it returns the analysis vulnerabilities kept in the same order
+type Filter interface {
+	Filter(analysis *horusec.Analysis, configs cliConfig.IConfig) ([]horusec.AnalysisVulnerabilities, error)
+}
+
+var (
+	filters = map[string]Filter{}
+	mutex   sync.RWMutex
+)
+
+// Register makes the filter available with the name informed, replacing the filter registered before. Like the
+// printers, it is called in the init of the package of the filter, so filters compiled in with build tags don't
+// need changes in the core
+func Register(name string, filter Filter) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	filters[name] = filter
+}
+
+func Get(name string) (Filter, bool) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	filter, ok := filters[name]
+	return filter, ok
+}
+
+// Names returns the filters registered sorted by name, without the exec plugins
+func Names() (names []string) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	for name := range filters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LookExecPlugin returns the path of the executable horusec-filter-<name> found in the PATH
+func LookExecPlugin(name string) (string, bool) {
+	if name == "" || strings.ContainsAny(name, `/\`) {
+		return "", false
+	}
+	path, err := exec.LookPath(ExecPluginPrefix + name)
+	return path, err == nil
+}
+
+// IsAvailable checks if the filter is registered or has an exec plugin in the PATH
+func IsAvailable(name string) bool {
+	if _, ok := Get(name); ok {
+		return true
+	}
+	_, ok := LookExecPlugin(name)
+	return ok
+}
+
+type Interface interface {
+	Apply(analysis *horusec.Analysis) error
+}
+
+type Chain struct {
+	config cliConfig.IConfig
+}
+
+func NewChain(config cliConfig.IConfig) Interface {
+	return &Chain{config: config}
+}
+
+// Apply runs the filters of the config in order, each one receives the vulnerabilities kept by the filters before.
+// How many vulnerabilities each filter removed is kept in the scan manifest to the summary
+func (c *Chain) Apply(analysis *horusec.Analysis) error {
+	if len(c.config.GetFilters()) == 0 {
+		return nil
+	}
+	if analysis.ScanManifest == nil {
+		analysis.ScanManifest = &horusec.ScanManifest{}
+	}
+	analysis.ScanManifest.Filters = []horusec.FilterExecution{}
+	for _, name := range c.config.GetFilters() {
+		removed, err := c.applyFilter(analysis, name)
+		if err != nil {
+			return fmt.Errorf("%s%s: %w", messages.MsgErrorRunFilter, name, err)
+		}
+		analysis.ScanManifest.Filters = append(analysis.ScanManifest.Filters,
+			horusec.FilterExecution{Name: name, Removed: removed})
+	}
+	return nil
+}
+
+func (c *Chain) applyFilter(analysis *horusec.Analysis, name string) (int, error) {
+	filter, err := c.getFilter(name)
+	if err != nil {
+		return 0, err
+	}
+	before := len(analysis.AnalysisVulnerabilities)
+	kept, err := filter.Filter(analysis, c.config)
+	if err != nil {
+		return 0, err
+	}
+	if kept == nil {
+		kept = []horusec.AnalysisVulnerabilities{}
+	}
+	analysis.AnalysisVulnerabilities = kept
+	return before - len(kept), nil
+}
+
+func (c *Chain) getFilter(name string) (Filter, error) {
+	if filter, ok := Get(name); ok {
+		return filter, nil
+	}
+	if path, ok := LookExecPlugin(name); ok {
+		return newExecFilter(path), nil
+	}
+	return nil, exec.ErrNotFound
+}
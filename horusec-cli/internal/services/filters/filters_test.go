@@ -0,0 +1,163 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filters
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type filterErrorTest struct{}
+
+func (f *filterErrorTest) Filter(_ *horusec.Analysis, _ cliConfig.IConfig) ([]horusec.AnalysisVulnerabilities, error) {
+	return nil, errors.New("test")
+}
+
+func newAnalysisToTest() *horusec.Analysis {
+	analysis := &horusec.Analysis{}
+	for _, vuln := range []horusec.Vulnerability{
+		{VulnHash: "1", Severity: severity.High, File: "src/main.go", Type: enumHorusec.Vulnerability},
+		{VulnHash: "1", Severity: severity.High, File: "src/main.go", Type: enumHorusec.Vulnerability},
+		{VulnHash: "2", Severity: severity.Low, File: "src/util.go", Type: enumHorusec.Vulnerability},
+		{VulnHash: "3", Severity: severity.High, File: "vendor/lib/lib.go", Type: enumHorusec.Vulnerability},
+		{VulnHash: "4", Severity: severity.Medium, File: "src/db.go", Type: enumHorusec.FalsePositive},
+		{VulnHash: "5", Severity: severity.Medium, File: "src/api.go", Type: enumHorusec.RiskAccepted},
+	} {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+			horusec.AnalysisVulnerabilities{Vulnerability: vuln})
+	}
+	return analysis
+}
+
+func TestRegister(t *testing.T) {
+	t.Run("Should register and get the filter", func(t *testing.T) {
+		Register("test-register", &filterErrorTest{})
+
+		filter, ok := Get("test-register")
+		assert.True(t, ok)
+		assert.NotNil(t, filter)
+		assert.Contains(t, Names(), "test-register")
+		assert.True(t, IsAvailable("test-register"))
+	})
+
+	t.Run("Should return false when filter is not registered", func(t *testing.T) {
+		_, ok := Get("not-registered")
+		assert.False(t, ok)
+		assert.False(t, IsAvailable("not-registered"))
+	})
+}
+
+func TestChain_Apply(t *testing.T) {
+	t.Run("Should not change the analysis without filters", func(t *testing.T) {
+		analysis := newAnalysisToTest()
+
+		assert.NoError(t, NewChain(cliConfig.NewConfig()).Apply(analysis))
+		assert.Len(t, analysis.AnalysisVulnerabilities, 6)
+		assert.Nil(t, analysis.ScanManifest)
+	})
+
+	t.Run("Should apply the filters in order and keep the removed by each one", func(t *testing.T) {
+		configs := cliConfig.NewConfig()
+		configs.SetFilters([]string{Severity, Path, Suppressions, Dedup})
+		configs.SetSeveritiesToIgnore([]string{"LOW"})
+		configs.SetFilesOrPathsToIgnore([]string{"vendor/**"})
+		analysis := newAnalysisToTest()
+
+		assert.NoError(t, NewChain(configs).Apply(analysis))
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		assert.Equal(t, []horusec.FilterExecution{
+			{Name: Severity, Removed: 1}, {Name: Path, Removed: 1}, {Name: Suppressions, Removed: 2},
+			{Name: Dedup, Removed: 1},
+		}, analysis.ScanManifest.Filters)
+	})
+
+	t.Run("Should remove the vulnerabilities found in the baseline", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "horusec-filter")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		baseline := `{"analysisVulnerabilities":[{"vulnerabilities":{"vulnHash":"1"}}]}`
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "baseline.json"), []byte(baseline), 0600))
+		configs := cliConfig.NewConfig()
+		configs.SetFilters([]string{Baseline})
+		configs.SetPolicyBaselinePath(filepath.Join(dir, "baseline.json"))
+		analysis := newAnalysisToTest()
+
+		assert.NoError(t, NewChain(configs).Apply(analysis))
+		assert.Len(t, analysis.AnalysisVulnerabilities, 4)
+		assert.Equal(t, 2, analysis.ScanManifest.Filters[0].Removed)
+	})
+
+	t.Run("Should return error when the filter fails", func(t *testing.T) {
+		Register("test-error", &filterErrorTest{})
+		configs := cliConfig.NewConfig()
+		configs.SetFilters([]string{"test-error"})
+
+		err := NewChain(configs).Apply(newAnalysisToTest())
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "test-error")
+	})
+
+	t.Run("Should return error when the filter is not available", func(t *testing.T) {
+		configs := cliConfig.NewConfig()
+		configs.SetFilters([]string{"not-registered"})
+
+		assert.Error(t, NewChain(configs).Apply(newAnalysisToTest()))
+	})
+}
+
+func TestExecFilter_Filter(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts are not available on windows")
+	}
+	dir, err := ioutil.TempDir("", "horusec-filter")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	oldPath := os.Getenv("PATH")
+	defer os.Setenv("PATH", oldPath)
+	_ = os.Setenv("PATH", dir+string(os.PathListSeparator)+oldPath)
+
+	t.Run("Should keep the vulnerabilities written by the executable", func(t *testing.T) {
+		script := "#!/bin/sh\ncat > /dev/null\necho '[{\"vulnerabilities\":{\"vulnHash\":\"9\"}}]'\n"
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, ExecPluginPrefix+"test"), []byte(script), 0700))
+		configs := cliConfig.NewConfig()
+		configs.SetFilters([]string{"test"})
+		analysis := newAnalysisToTest()
+
+		assert.True(t, IsAvailable("test"))
+		assert.NoError(t, NewChain(configs).Apply(analysis))
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		assert.Equal(t, "9", analysis.AnalysisVulnerabilities[0].Vulnerability.VulnHash)
+		assert.Equal(t, 5, analysis.ScanManifest.Filters[0].Removed)
+	})
+
+	t.Run("Should return error when the executable fails", func(t *testing.T) {
+		script := "#!/bin/sh\nexit 1\n"
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, ExecPluginPrefix+"fail"), []byte(script), 0700))
+		configs := cliConfig.NewConfig()
+		configs.SetFilters([]string{"fail"})
+
+		assert.Error(t, NewChain(configs).Apply(newAnalysisToTest()))
+	})
+}
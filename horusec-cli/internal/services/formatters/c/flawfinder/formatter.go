@@ -40,13 +40,14 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
+	defer f.RecoverFromPanic(tools.Flawfinder, projectSubPath)
 	if f.ToolIsToIgnore(tools.Flawfinder) {
 		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.Flawfinder.ToString(), logger.DebugLevel)
 		return
 	}
 
 	err := f.startFlawFinder(projectSubPath)
-	f.SetLanguageIsFinished()
+	f.SetToolIsFinished(err, tools.Flawfinder, projectSubPath)
 	f.LogAnalysisError(err, tools.Flawfinder, projectSubPath)
 }
 
@@ -65,14 +66,22 @@ func (f *Formatter) startFlawFinder(projectSubPath string) error {
 
 func (f *Formatter) getConfigData(projectSubPath string) *dockerEntities.AnalysisData {
 	ad := &dockerEntities.AnalysisData{
-		CMD:      f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.Flawfinder),
-		Language: languages.C,
+		CMD:            f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.Flawfinder),
+		Language:       languages.C,
+		Tool:           tools.Flawfinder,
+		ProjectSubPath: projectSubPath,
 	}
 	ad.SetFullImagePath(f.GetToolsConfig()[tools.Flawfinder].ImagePath, ImageName, ImageTag)
 	return ad
 }
 
 func (f *Formatter) parseOutput(output string) error {
+	if output == "" {
+		logger.LogDebugWithLevel(messages.MsgDebugOutputEmpty,
+			logger.DebugLevel, map[string]interface{}{"tool": tools.Flawfinder.ToString()})
+		return nil
+	}
+
 	var results []c.Result
 
 	if err := gocsv.UnmarshalString(output, &results); err != nil {
@@ -95,7 +104,8 @@ func (f *Formatter) appendResults(results []c.Result) {
 
 func (f *Formatter) setVulnerabilityData(results []c.Result, index int) *horusec.Vulnerability {
 	vulnerability := f.getDefaultVulnerabilitySeverity()
-	vulnerability.Severity = results[index].GetSeverity()
+	vulnerability.Severity = f.GetSeverityTables().Get(tools.Flawfinder).GetSeverity("", results[index].Level)
+	vulnerability.ToolSeverity = results[index].Level
 	vulnerability.Details = results[index].GetDetails()
 	vulnerability.Line = results[index].Line
 	vulnerability.Column = results[index].Column
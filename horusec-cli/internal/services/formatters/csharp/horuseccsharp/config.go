@@ -16,10 +16,10 @@ package horuseccsharp
 
 const (
 	ImageName = "horuszup/horusec-csharp"
-	ImageTag  = "v1.0.0"
+	ImageTag  = "v1.1.0"
 	ImageCmd  = `
 		{{WORK_DIR}}
-		horusec-csharp run -o="./output-ANALYSISID.json"
-		cat ./output-ANALYSISID.json
+		horusec-csharp run -o="/tmp/output-ANALYSISID.json"
+		cat /tmp/output-ANALYSISID.json
   `
 )
@@ -15,19 +15,45 @@
 package scs
 
 const (
+	// CachePath is the NuGet packages cache of the image, where the cache volume of the tools config is mounted
+	CachePath = "/root/.nuget/packages"
 	ImageName = "horuszup/dotnet-core-3.1"
 	ImageTag  = "v1.0.0"
 	// nolint
 	ImageCmd = `
 		{{WORK_DIR}}
 		touch /tmp/output_tmp-ANALYSISID.txt
-		dotnet add package -n SecurityCodeScan.VS2017 > /tmp/add_packet_output-ANALYSISID.txt
-		if [ $? -eq 0 ]; then
-			dotnet build --nologo -v q > /tmp/output_tmp-ANALYSISID.txt
-		else
+		is_skipped() {
+			for SKIP in {{SKIP_PROJECTS}}; do
+				if [ "${1#./}" = "${SKIP#./}" ] || [ "$(basename "$1")" = "$SKIP" ]; then return 0; fi
+			done
+			return 1
+		}
+		add_package() {
+			if ! is_skipped "$1"; then
+				dotnet add "$1" package -n SecurityCodeScan.VS2017 >> /tmp/add_packet_output-ANALYSISID.txt || echo "$1" >> /tmp/add_packet_errors-ANALYSISID.txt
+			fi
+		}
+		set -- {{PROJECTS}}
+		if [ $# -eq 0 ]; then
+			dotnet add package -n SecurityCodeScan.VS2017 > /tmp/add_packet_output-ANALYSISID.txt || echo "." >> /tmp/add_packet_errors-ANALYSISID.txt
+		fi
+		for TARGET in "$@"; do
+			case "$TARGET" in
+				*.sln) dotnet sln "$TARGET" list | tail -n +3 | tr '\\' '/' | while read -r PROJECT; do add_package "$(dirname "$TARGET")/$PROJECT"; done ;;
+				*) add_package "$TARGET" ;;
+			esac
+		done
+		if [ -s /tmp/add_packet_errors-ANALYSISID.txt ]; then
 			echo "ERROR_ADDING_PACKAGE"
 			exit 1
 		fi
+		if [ $# -eq 0 ]; then
+			dotnet build --nologo -v q > /tmp/output_tmp-ANALYSISID.txt
+		fi
+		for TARGET in "$@"; do
+			dotnet build "$TARGET" --nologo -v q >> /tmp/output_tmp-ANALYSISID.txt
+		done
 
     	while read -r LINE; do
 		
@@ -15,7 +15,6 @@
 package scs
 
 import (
-	"encoding/json"
 	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
 	"strings"
 
@@ -24,13 +23,24 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	fileUtil "github.com/ZupIT/horusec/development-kit/pkg/utils/file"
+	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
 	errorsEnums "github.com/ZupIT/horusec/horusec-cli/internal/enums/errors"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/sdk"
 )
 
+var container = &sdk.Container{
+	Tool:      tools.SecurityCodeScan,
+	Language:  languages.CSharp,
+	ImageName: ImageName,
+	ImageTag:  ImageTag,
+	ImageCmd:  ImageCmd,
+	CachePath: CachePath,
+}
+
 type Formatter struct {
 	formatters.IService
 }
@@ -42,12 +52,13 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
+	defer f.RecoverFromPanic(tools.SecurityCodeScan, projectSubPath)
 	if f.ToolIsToIgnore(tools.SecurityCodeScan) {
 		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.SecurityCodeScan.ToString(), logger.DebugLevel)
 		return
 	}
 	err := f.startSecurityCodeScanAnalysis(projectSubPath)
-	f.SetLanguageIsFinished()
+	f.SetToolIsFinished(err, tools.SecurityCodeScan, projectSubPath)
 	f.LogAnalysisError(err, tools.SecurityCodeScan, projectSubPath)
 }
 
@@ -90,18 +101,27 @@ func (f *Formatter) splitSCSContainerOutput(output string) []string {
 }
 
 func (f *Formatter) parseStringToStruct(output string) (containerOutput dotnet.Output, err error) {
-	err = json.Unmarshal([]byte(output), &containerOutput)
+	err = jsonUtils.ConvertStringToOutput(output, &containerOutput)
 	logger.LogErrorWithLevel(f.GetAnalysisIDErrorMessage(tools.SecurityCodeScan, output), err, logger.ErrorLevel)
 	return containerOutput, err
 }
 
-func (f *Formatter) removeDuplicatedOutputs(outputs []dotnet.Output) []dotnet.Output {
-	return outputs[0 : len(outputs)/2]
+// removeDuplicatedOutputs removes the warnings repeated in the summary of each build
+func (f *Formatter) removeDuplicatedOutputs(outputs []dotnet.Output) (uniqueOutputs []dotnet.Output) {
+	found := map[dotnet.Output]bool{}
+	for _, output := range outputs {
+		if !found[output] {
+			found[output] = true
+			uniqueOutputs = append(uniqueOutputs, output)
+		}
+	}
+	return uniqueOutputs
 }
 
 func (f *Formatter) setVulnerabilitySeverityData(output dotnet.Output) *horusec.Vulnerability {
 	data := f.getDefaultVulnerabilitySeverity()
-	data.Severity = output.GetSeverity()
+	data.Severity = f.GetSeverityTables().Get(tools.SecurityCodeScan).GetSeverity(output.ErrorID, "")
+	data.RuleID = output.ErrorID
 	data.Details = f.removeCsprojPathFromDetails(output.IssueText)
 	data.Line = output.GetLine()
 	data.Column = output.GetColumn()
@@ -141,15 +161,19 @@ func (f *Formatter) appendVulnerabilities(vulnerability *horusec.Vulnerability)
 }
 
 func (f *Formatter) getConfigData(projectSubPath string) *dockerEntities.AnalysisData {
-	ad := &dockerEntities.AnalysisData{
-		CMD: f.AddWorkDirInCmd(ImageCmd,
-			fileUtil.GetSubPathByExtension(f.GetConfigProjectPath(), projectSubPath, "*.csproj"), tools.SecurityCodeScan),
-		Language: languages.CSharp,
-	}
-	ad.SetFullImagePath(f.GetToolsConfig()[tools.SecurityCodeScan].ImagePath, ImageName, ImageTag)
+	ad := sdk.NewAnalysisData(f, container, f.getWorkDir(projectSubPath))
+	ad.ProjectSubPath = projectSubPath
 	return ad
 }
 
+// getWorkDir is the folder of the first csproj, the projects of the tools config are relative to the project sub path
+func (f *Formatter) getWorkDir(projectSubPath string) string {
+	if len(f.GetToolsConfig()[tools.SecurityCodeScan].Projects) > 0 {
+		return projectSubPath
+	}
+	return fileUtil.GetSubPathByExtension(f.GetConfigProjectPath(), projectSubPath, "*.csproj")
+}
+
 func (f *Formatter) verifyIsCsProjError(output string, err error) error {
 	if strings.Contains(output, "Could not find any project in") {
 		msg := f.GetAnalysisIDErrorMessage(tools.SecurityCodeScan, output)
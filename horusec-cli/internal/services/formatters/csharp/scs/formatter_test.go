@@ -19,6 +19,8 @@ import (
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
@@ -97,3 +99,33 @@ func TestParseStringToStruct(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestGetConfigData(t *testing.T) {
+	t.Run("Should build the projects of the tools config in the project sub path", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{SecurityCodeScan: toolsconfig.ToolConfig{
+			Projects: []string{"App.sln"}, SkipProjects: []string{"App.Tests.csproj"}}})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, config, &horusec.Monitor{})
+
+		data := (&Formatter{service}).getConfigData("api")
+
+		assert.Contains(t, data.CMD, "cd api")
+		assert.Contains(t, data.CMD, "set -- 'App.sln'")
+		assert.Contains(t, data.CMD, "for SKIP in 'App.Tests.csproj'; do")
+		assert.Equal(t, "api", data.ProjectSubPath)
+	})
+
+	t.Run("Should mount the cache volume of the tools config in the NuGet cache", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{
+			SecurityCodeScan: toolsconfig.ToolConfig{CacheVolume: "horusec-nuget"}})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, config, &horusec.Monitor{})
+
+		data := (&Formatter{service}).getConfigData("")
+
+		assert.Equal(t, []dockerEntities.Mount{{Source: "horusec-nuget", Target: CachePath, IsVolume: true}},
+			data.Mounts)
+	})
+}
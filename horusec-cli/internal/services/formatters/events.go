@@ -0,0 +1,40 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/events"
+)
+
+// publishToolFinished publishes before the vulnerabilities appended since the last tool finished. The formatters
+// append the vulnerabilities in the analysis directly, so they are published when the parse of the tool ends
+func (s *Service) publishToolFinished(err error, tool tools.Tool, projectSubPath string) {
+	if s.config.GetDryRun() {
+		return
+	}
+	s.publishVulnerabilitiesFound()
+	events.Publish(events.NewToolFinished(s.GetAnalysisID(), tool, projectSubPath, err))
+}
+
+func (s *Service) publishVulnerabilitiesFound() {
+	s.mutex.Lock()
+	vulnerabilities := s.analysis.AnalysisVulnerabilities[s.vulnerabilitiesPublished:]
+	s.vulnerabilitiesPublished = len(s.analysis.AnalysisVulnerabilities)
+	s.mutex.Unlock()
+	for index := range vulnerabilities {
+		events.Publish(events.NewVulnerabilityFound(s.GetAnalysisID(), &vulnerabilities[index].Vulnerability))
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecdockerfile
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+// Advisory marks the tags of a base image as vulnerable or end of life. Empty tags match every tag of the image and
+// the suggested tag is a full image reference when the image itself is deprecated, like rockylinux:9 for centos
+type Advisory struct {
+	Image        string   `json:"image"`
+	Tags         []string `json:"tags"`
+	Severity     string   `json:"severity"`
+	Reason       string   `json:"reason"`
+	SuggestedTag string   `json:"suggestedTag"`
+}
+
+func (a *Advisory) GetSeverity() severity.Severity {
+	if value := severity.ParseStringToSeverity(strings.ToUpper(a.Severity)); value != "" {
+		return value
+	}
+	return severity.Medium
+}
+
+// Matches checks the tag by version prefix, so the advisory of node 10 matches 10.24.1 and 10-alpine but not 100
+func (a *Advisory) Matches(image *BaseImage) bool {
+	if !strings.EqualFold(a.Image, image.Name) {
+		return false
+	}
+	if len(a.Tags) == 0 {
+		return true
+	}
+	for _, tag := range a.Tags {
+		if a.matchesTag(image.Tag, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Advisory) matchesTag(imageTag, tag string) bool {
+	if imageTag == tag {
+		return true
+	}
+	return strings.HasPrefix(imageTag, tag) && strings.ContainsAny(imageTag[len(tag):len(tag)+1], ".-_")
+}
+
+func (a *Advisory) GetSuggestedImage() string {
+	if strings.Contains(a.SuggestedTag, ":") {
+		return a.SuggestedTag
+	}
+	return a.Image + ":" + a.SuggestedTag
+}
+
+// LoadAdvisories reads the dataset of the file informed in the config. When it is empty the built-in dataset is used,
+// it doesn't need network access and is updated with the releases of horusec
+func LoadAdvisories(path string) (advisories []Advisory, err error) {
+	if path == "" {
+		return DefaultAdvisories(), nil
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return advisories, json.Unmarshal(content, &advisories)
+}
+
+func FindAdvisory(advisories []Advisory, image *BaseImage) *Advisory {
+	for index := range advisories {
+		if advisories[index].Matches(image) {
+			return &advisories[index]
+		}
+	}
+	return nil
+}
+
+//nolint:funlen dataset is greater than 15 lines
+func DefaultAdvisories() []Advisory {
+	return []Advisory{
+		{Image: "debian", Tags: []string{"7", "wheezy", "8", "jessie", "9", "stretch"}, Severity: "HIGH",
+			Reason:       "Debian 9 and older reached the end of the long term support and have unpatched vulnerabilities",
+			SuggestedTag: "12"},
+		{Image: "debian", Tags: []string{"10", "buster", "11", "bullseye"}, Severity: "MEDIUM",
+			Reason: "Debian 10 and 11 reached the end of the long term support", SuggestedTag: "12"},
+		{Image: "ubuntu", Tags: []string{"12.04", "precise", "14.04", "trusty", "16.04", "xenial"}, Severity: "HIGH",
+			Reason:       "Ubuntu 16.04 and older reached the end of the standard support and have unpatched vulnerabilities",
+			SuggestedTag: "24.04"},
+		{Image: "ubuntu", Tags: []string{"18.04", "bionic", "20.04", "focal"}, Severity: "MEDIUM",
+			Reason: "Ubuntu 18.04 and 20.04 reached the end of the standard support", SuggestedTag: "24.04"},
+		{Image: "centos", Severity: "HIGH", Reason: "CentOS Linux reached the end of life and the image is deprecated",
+			SuggestedTag: "rockylinux:9"},
+		{Image: "alpine", Tags: []string{"3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "3.6", "3.7", "3.8", "3.9", "3.10",
+			"3.11", "3.12", "3.13", "3.14", "3.15", "3.16", "3.17", "3.18"}, Severity: "MEDIUM",
+			Reason: "Alpine 3.18 and older reached the end of the support", SuggestedTag: "3.22"},
+		{Image: "node", Tags: []string{"4", "6", "8", "10", "12", "14", "16"}, Severity: "HIGH",
+			Reason:       "Node.js 16 and older reached the end of life and have unpatched vulnerabilities",
+			SuggestedTag: "24"},
+		{Image: "node", Tags: []string{"18", "20"}, Severity: "MEDIUM",
+			Reason: "Node.js 18 and 20 reached the end of life", SuggestedTag: "24"},
+		{Image: "python", Tags: []string{"2", "3.4", "3.5", "3.6", "3.7"}, Severity: "HIGH",
+			Reason:       "Python 3.7 and older reached the end of life and have unpatched vulnerabilities",
+			SuggestedTag: "3.13"},
+		{Image: "python", Tags: []string{"3.8", "3.9"}, Severity: "MEDIUM",
+			Reason: "Python 3.8 and 3.9 reached the end of life", SuggestedTag: "3.13"},
+		{Image: "php", Tags: []string{"5", "7"}, Severity: "HIGH",
+			Reason: "PHP 7 and older reached the end of life and have unpatched vulnerabilities", SuggestedTag: "8.3"},
+		{Image: "php", Tags: []string{"8.0", "8.1"}, Severity: "MEDIUM",
+			Reason: "PHP 8.0 and 8.1 reached the end of life", SuggestedTag: "8.3"},
+		{Image: "ruby", Tags: []string{"2", "3.0", "3.1"}, Severity: "MEDIUM",
+			Reason: "Ruby 3.1 and older reached the end of life", SuggestedTag: "3.3"},
+		{Image: "openjdk", Severity: "MEDIUM", Reason: "The openjdk image is deprecated and no longer receives updates",
+			SuggestedTag: "eclipse-temurin:21"},
+		{Image: "mcr.microsoft.com/dotnet/core/aspnet", Severity: "HIGH",
+			Reason:       ".NET Core 3.1 and older reached the end of life and the repository is deprecated",
+			SuggestedTag: "mcr.microsoft.com/dotnet/aspnet:8.0"},
+		{Image: "mcr.microsoft.com/dotnet/core/sdk", Severity: "HIGH",
+			Reason:       ".NET Core 3.1 and older reached the end of life and the repository is deprecated",
+			SuggestedTag: "mcr.microsoft.com/dotnet/sdk:8.0"},
+		{Image: "mcr.microsoft.com/dotnet/aspnet", Tags: []string{"5.0", "6.0", "7.0"}, Severity: "MEDIUM",
+			Reason: ".NET 7 and older reached the end of life", SuggestedTag: "8.0"},
+		{Image: "mcr.microsoft.com/dotnet/sdk", Tags: []string{"5.0", "6.0", "7.0"}, Severity: "MEDIUM",
+			Reason: ".NET 7 and older reached the end of life", SuggestedTag: "8.0"},
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecdockerfile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdvisory_Matches(t *testing.T) {
+	advisory := &Advisory{Image: "node", Tags: []string{"10"}}
+
+	t.Run("Should match the tags of the version", func(t *testing.T) {
+		assert.True(t, advisory.Matches(&BaseImage{Name: "node", Tag: "10"}))
+		assert.True(t, advisory.Matches(&BaseImage{Name: "node", Tag: "10.24.1"}))
+		assert.True(t, advisory.Matches(&BaseImage{Name: "node", Tag: "10-alpine"}))
+	})
+
+	t.Run("Should not match other versions or images", func(t *testing.T) {
+		assert.False(t, advisory.Matches(&BaseImage{Name: "node", Tag: "100"}))
+		assert.False(t, advisory.Matches(&BaseImage{Name: "node", Tag: "latest"}))
+		assert.False(t, advisory.Matches(&BaseImage{Name: "python", Tag: "10"}))
+	})
+
+	t.Run("Should match every tag when the advisory has no tags", func(t *testing.T) {
+		assert.True(t, (&Advisory{Image: "centos"}).Matches(&BaseImage{Name: "centos", Tag: "latest"}))
+	})
+}
+
+func TestAdvisory_GetSuggestedImage(t *testing.T) {
+	t.Run("Should return the suggested tag of the same image or the image replacing it", func(t *testing.T) {
+		assert.Equal(t, "node:24", (&Advisory{Image: "node", SuggestedTag: "24"}).GetSuggestedImage())
+		assert.Equal(t, "rockylinux:9",
+			(&Advisory{Image: "centos", SuggestedTag: "rockylinux:9"}).GetSuggestedImage())
+	})
+}
+
+func TestAdvisory_GetSeverity(t *testing.T) {
+	t.Run("Should return the severity of the advisory or medium when invalid", func(t *testing.T) {
+		assert.Equal(t, severity.High, (&Advisory{Severity: "high"}).GetSeverity())
+		assert.Equal(t, severity.Medium, (&Advisory{Severity: "test"}).GetSeverity())
+	})
+}
+
+func TestLoadAdvisories(t *testing.T) {
+	t.Run("Should return the built-in dataset when the path is empty", func(t *testing.T) {
+		advisories, err := LoadAdvisories("")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, FindAdvisory(advisories, &BaseImage{Name: "debian", Tag: "stretch-slim"}))
+		assert.Nil(t, FindAdvisory(advisories, &BaseImage{Name: "debian", Tag: "12"}))
+	})
+
+	t.Run("Should read the dataset of the file", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "advisories")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "advisories.json")
+		assert.NoError(t, ioutil.WriteFile(path,
+			[]byte(`[{"image": "alpine", "tags": ["3.19"], "severity": "LOW", "suggestedTag": "3.22"}]`), 0600))
+
+		advisories, err := LoadAdvisories(path)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Advisory{{Image: "alpine", Tags: []string{"3.19"}, Severity: "LOW", SuggestedTag: "3.22"}},
+			advisories)
+	})
+
+	t.Run("Should return error when the file is invalid", func(t *testing.T) {
+		_, err := LoadAdvisories("./not-exists.json")
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecdockerfile
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var regexVariable = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?}|\$([A-Za-z_][A-Za-z0-9_]*)`)
+
+// BaseImage is an image of a FROM instruction, with the docker hub prefixes removed from the name
+type BaseImage struct {
+	Name string
+	Tag  string
+	Line int
+	Code string
+}
+
+func (b *BaseImage) String() string {
+	return b.Name + ":" + b.Tag
+}
+
+// IsDockerfile checks the names used by docker and podman, like Dockerfile, Dockerfile.dev, app.dockerfile and
+// Containerfile. The prefix is case sensitive to not match source files like dockerfile.go
+func IsDockerfile(name string) bool {
+	lowerName := strings.ToLower(name)
+	return lowerName == "dockerfile" || lowerName == "containerfile" || strings.HasPrefix(name, "Dockerfile.") ||
+		strings.HasSuffix(lowerName, ".dockerfile")
+}
+
+func ParseDockerfileFromPath(path string) ([]BaseImage, error) {
+	fileOpened, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = fileOpened.Close()
+	}()
+	return ParseDockerfile(fileOpened)
+}
+
+// ParseDockerfile returns the base images of the stages, skipping scratch, the stages used as base of other stages
+// and the images with arguments without default value
+func ParseDockerfile(reader io.Reader) (images []BaseImage, err error) {
+	instructions, err := readInstructions(reader)
+	if err != nil {
+		return nil, err
+	}
+	args := map[string]string{}
+	stages := map[string]bool{}
+	for _, instruction := range instructions {
+		fields := strings.Fields(instruction.code)
+		switch {
+		case strings.EqualFold(fields[0], "ARG") && len(images) == 0 && len(fields) > 1:
+			setArg(args, fields[1])
+		case strings.EqualFold(fields[0], "FROM"):
+			if image := parseFrom(fields[1:], args, stages); image != nil {
+				image.Line = instruction.line
+				image.Code = instruction.code
+				images = append(images, *image)
+			}
+		}
+	}
+	return images, nil
+}
+
+type instruction struct {
+	code string
+	line int
+}
+
+// readInstructions joins the lines continued with backslash and removes the comments
+func readInstructions(reader io.Reader) (instructions []instruction, err error) {
+	scanner := bufio.NewScanner(reader)
+	current := instruction{}
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if current.code == "" {
+			current.line = line
+		}
+		if strings.HasSuffix(text, "\\") {
+			current.code += strings.TrimSpace(strings.TrimSuffix(text, "\\")) + " "
+			continue
+		}
+		current.code = strings.TrimSpace(current.code + text)
+		instructions = append(instructions, current)
+		current = instruction{}
+	}
+	return instructions, scanner.Err()
+}
+
+func setArg(args map[string]string, arg string) {
+	keyValue := strings.SplitN(arg, "=", 2)
+	if len(keyValue) == 2 {
+		args[keyValue[0]] = strings.Trim(keyValue[1], `"'`)
+	}
+}
+
+func parseFrom(fields []string, args map[string]string, stages map[string]bool) *BaseImage {
+	for len(fields) > 0 && strings.HasPrefix(fields[0], "--") {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	if len(fields) == 3 && strings.EqualFold(fields[1], "AS") {
+		defer func() { stages[strings.ToLower(fields[2])] = true }()
+	}
+	reference, ok := expandArgs(fields[0], args)
+	if !ok || stages[strings.ToLower(reference)] || strings.EqualFold(reference, "scratch") {
+		return nil
+	}
+	return parseReference(reference)
+}
+
+// expandArgs replaces the arguments declared before the first FROM, returns false when one of them has no value
+func expandArgs(value string, args map[string]string) (string, bool) {
+	isResolved := true
+	expanded := regexVariable.ReplaceAllStringFunc(value, func(variable string) string {
+		groups := regexVariable.FindStringSubmatch(variable)
+		name, defaultValue := groups[1]+groups[4], groups[3]
+		if argValue, ok := args[name]; ok && argValue != "" {
+			return argValue
+		}
+		if defaultValue == "" {
+			isResolved = false
+		}
+		return defaultValue
+	})
+	return expanded, isResolved
+}
+
+func parseReference(reference string) *BaseImage {
+	reference = strings.SplitN(reference, "@", 2)[0]
+	image := &BaseImage{Name: reference, Tag: "latest"}
+	if index := strings.LastIndex(reference, ":"); index > strings.LastIndex(reference, "/") {
+		image.Name, image.Tag = reference[:index], reference[index+1:]
+	}
+	for _, prefix := range []string{"docker.io/", "index.docker.io/", "library/"} {
+		image.Name = strings.TrimPrefix(image.Name, prefix)
+	}
+	return image
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecdockerfile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDockerfile(t *testing.T) {
+	t.Run("Should return the base images of the stages", func(t *testing.T) {
+		images, err := ParseDockerfile(strings.NewReader(`# build
+ARG NODE_VERSION=10
+FROM --platform=linux/amd64 node:${NODE_VERSION}-alpine AS build
+RUN npm ci
+
+FROM build AS test
+FROM scratch
+FROM docker.io/library/debian:9@sha256:abc \
+  AS runtime
+FROM nginx
+`))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []BaseImage{
+			{Name: "node", Tag: "10-alpine", Line: 3,
+				Code: "FROM --platform=linux/amd64 node:${NODE_VERSION}-alpine AS build"},
+			{Name: "debian", Tag: "9", Line: 8, Code: "FROM docker.io/library/debian:9@sha256:abc AS runtime"},
+			{Name: "nginx", Tag: "latest", Line: 10, Code: "FROM nginx"},
+		}, images)
+	})
+
+	t.Run("Should keep the registry and port in the name of the image", func(t *testing.T) {
+		images, err := ParseDockerfile(strings.NewReader("FROM localhost:5000/team/app:1.0\n" +
+			"FROM mcr.microsoft.com/dotnet/sdk:6.0"))
+
+		assert.NoError(t, err)
+		assert.Len(t, images, 2)
+		assert.Equal(t, "localhost:5000/team/app:1.0", images[0].String())
+		assert.Equal(t, "mcr.microsoft.com/dotnet/sdk:6.0", images[1].String())
+	})
+
+	t.Run("Should use the default value of the argument and skip arguments without value", func(t *testing.T) {
+		images, err := ParseDockerfile(strings.NewReader("ARG IMAGE\nFROM ${BASE:-python:3.6}\nFROM $IMAGE"))
+
+		assert.NoError(t, err)
+		assert.Len(t, images, 1)
+		assert.Equal(t, "python:3.6", images[0].String())
+	})
+}
+
+func TestIsDockerfile(t *testing.T) {
+	t.Run("Should check the names of dockerfiles", func(t *testing.T) {
+		assert.True(t, IsDockerfile("Dockerfile"))
+		assert.True(t, IsDockerfile("Dockerfile.dev"))
+		assert.True(t, IsDockerfile("app.dockerfile"))
+		assert.True(t, IsDockerfile("Containerfile"))
+		assert.False(t, IsDockerfile("dockerfile.go"))
+		assert.False(t, IsDockerfile("docker-compose.yml"))
+		assert.False(t, IsDockerfile("main.go"))
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecdockerfile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+)
+
+type Formatter struct {
+	formatters.IService
+}
+
+func NewFormatter(service formatters.IService) formatters.IFormatter {
+	return &Formatter{
+		service,
+	}
+}
+
+func (f *Formatter) StartAnalysis(projectSubPath string) {
+	defer f.RecoverFromPanic(tools.HorusecDockerfile, projectSubPath)
+	if f.ToolIsToIgnore(tools.HorusecDockerfile) {
+		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.HorusecDockerfile.ToString(), logger.DebugLevel)
+		return
+	}
+	err := f.startBaseImageAnalysis(projectSubPath)
+	f.SetToolIsFinished(err, tools.HorusecDockerfile, projectSubPath)
+	f.LogAnalysisError(err, tools.HorusecDockerfile, projectSubPath)
+}
+
+// startBaseImageAnalysis runs without container, the dockerfiles are parsed and checked against the advisories
+func (f *Formatter) startBaseImageAnalysis(projectSubPath string) error {
+	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.HorusecDockerfile)
+
+	advisories, err := LoadAdvisories(f.GetBaseImageAdvisoriesPath())
+	if err != nil {
+		f.SetAnalysisError(err)
+		return err
+	}
+
+	dockerfiles, err := f.getDockerfiles(filepath.Join(f.GetConfigProjectPath(), projectSubPath))
+	if err != nil {
+		f.SetAnalysisError(err)
+		return err
+	}
+
+	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.HorusecDockerfile)
+	return f.checkDockerfiles(dockerfiles, advisories)
+}
+
+func (f *Formatter) getDockerfiles(directory string) (dockerfiles []string, err error) {
+	return dockerfiles, filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && (info.Name() == ".horusec" || info.Name() == ".git") {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() && IsDockerfile(info.Name()) {
+			dockerfiles = append(dockerfiles, path)
+		}
+		return nil
+	})
+}
+
+func (f *Formatter) checkDockerfiles(dockerfiles []string, advisories []Advisory) error {
+	for _, dockerfile := range dockerfiles {
+		images, err := ParseDockerfileFromPath(dockerfile)
+		if err != nil {
+			return err
+		}
+		f.appendResults(dockerfile, images, advisories)
+	}
+	return nil
+}
+
+func (f *Formatter) appendResults(dockerfile string, images []BaseImage, advisories []Advisory) {
+	for index := range images {
+		advisory := FindAdvisory(advisories, &images[index])
+		if advisory == nil {
+			continue
+		}
+		f.GetAnalysis().AnalysisVulnerabilities = append(f.GetAnalysis().AnalysisVulnerabilities,
+			horusec.AnalysisVulnerabilities{
+				Vulnerability: *f.setVulnerabilityData(dockerfile, &images[index], advisory),
+			})
+	}
+}
+
+func (f *Formatter) setVulnerabilityData(dockerfile string, image *BaseImage,
+	advisory *Advisory) *horusec.Vulnerability {
+	vulnerability := f.getDefaultVulnerabilitySeverity()
+	vulnerability.Severity = advisory.GetSeverity()
+	vulnerability.Details = fmt.Sprintf("Vulnerable or end of life base image\n%s. Update %s to %s",
+		advisory.Reason, image.String(), advisory.GetSuggestedImage())
+	vulnerability.Line = strconv.Itoa(image.Line)
+	vulnerability.Column = "0"
+	vulnerability.Code = f.GetCodeWithMaxCharacters(image.Code, 0)
+	vulnerability.File = f.getRelativePath(dockerfile)
+
+	// Set vulnerabilitySeverity.VulnHash value
+	vulnerability = vulnhash.Bind(vulnerability)
+
+	return f.setCommitAuthor(vulnerability)
+}
+
+func (f *Formatter) getRelativePath(dockerfile string) string {
+	relativePath, err := filepath.Rel(f.GetConfigProjectPath(), dockerfile)
+	if err != nil {
+		return dockerfile
+	}
+	return filepath.ToSlash(relativePath)
+}
+
+func (f *Formatter) setCommitAuthor(vulnerability *horusec.Vulnerability) *horusec.Vulnerability {
+	commitAuthor := f.GetCommitAuthor(vulnerability.Line, vulnerability.File)
+
+	vulnerability.CommitAuthor = commitAuthor.Author
+	vulnerability.CommitHash = commitAuthor.CommitHash
+	vulnerability.CommitDate = commitAuthor.Date
+	vulnerability.CommitEmail = commitAuthor.Email
+	vulnerability.CommitMessage = commitAuthor.Message
+
+	return vulnerability
+}
+
+func (f *Formatter) getDefaultVulnerabilitySeverity() *horusec.Vulnerability {
+	vulnerabilitySeverity := &horusec.Vulnerability{}
+	vulnerabilitySeverity.SecurityTool = tools.HorusecDockerfile
+	vulnerabilitySeverity.Language = languages.Generic
+	return vulnerabilitySeverity
+}
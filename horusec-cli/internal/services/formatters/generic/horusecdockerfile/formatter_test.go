@@ -0,0 +1,136 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecdockerfile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/stretchr/testify/assert"
+)
+
+func newProjectToTest(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "horusec-dockerfile")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0750))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	}
+	return dir
+}
+
+func newFormatterToTest(analysis *horusec.Analysis, config cliConfig.IConfig, projectPath string) formatters.IFormatter {
+	config.SetWorkDir(&workdir.WorkDir{})
+	config.SetProjectPath(projectPath)
+	config.SetSourceMode(cli.SourceReadOnly.ToString())
+	return NewFormatter(formatters.NewFormatterService(analysis, &docker.Mock{}, config, &horusec.Monitor{}))
+}
+
+func TestNewFormatter(t *testing.T) {
+	assert.IsType(t, &Formatter{}, NewFormatter(formatters.NewFormatterService(nil, nil, &cliConfig.Config{}, nil)))
+}
+
+func TestFormatter_StartAnalysis(t *testing.T) {
+	t.Run("Should add the vulnerable base images of the dockerfiles", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		projectPath := newProjectToTest(t, map[string]string{
+			"Dockerfile":           "FROM node:10-alpine AS build\nFROM node:24\n",
+			"api/Dockerfile.prod":  "FROM centos:7",
+			"api/main.go":          "FROM debian:9",
+			".horusec/Dockerfile":  "FROM debian:9",
+			"docs/site.dockerfile": "FROM nginx",
+		})
+
+		newFormatterToTest(analysis, &cliConfig.Config{}, projectPath).StartAnalysis("")
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 2)
+		files := map[string]horusec.Vulnerability{}
+		for _, analysisVulnerability := range analysis.AnalysisVulnerabilities {
+			files[analysisVulnerability.Vulnerability.File] = analysisVulnerability.Vulnerability
+		}
+		assert.Equal(t, severity.High, files["Dockerfile"].Severity)
+		assert.Equal(t, "1", files["Dockerfile"].Line)
+		assert.Equal(t, tools.HorusecDockerfile, files["Dockerfile"].SecurityTool)
+		assert.Contains(t, files["Dockerfile"].Details, "Update node:10-alpine to node:24")
+		assert.NotEmpty(t, files["Dockerfile"].VulnHash)
+		assert.Contains(t, files["api/Dockerfile.prod"].Details, "Update centos:7 to rockylinux:9")
+	})
+
+	t.Run("Should analyze only the dockerfiles of the project sub path", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		projectPath := newProjectToTest(t, map[string]string{
+			"Dockerfile":     "FROM debian:9",
+			"api/Dockerfile": "FROM python:3.6-slim",
+		})
+
+		newFormatterToTest(analysis, &cliConfig.Config{}, projectPath).StartAnalysis("api")
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		assert.Equal(t, "api/Dockerfile", analysis.AnalysisVulnerabilities[0].Vulnerability.File)
+	})
+
+	t.Run("Should use the advisories of the file informed", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		projectPath := newProjectToTest(t, map[string]string{
+			"Dockerfile":      "FROM debian:9\nFROM alpine:3.19",
+			"advisories.json": `[{"image": "alpine", "tags": ["3.19"], "severity": "LOW", "suggestedTag": "3.22"}]`,
+		})
+		config := &cliConfig.Config{}
+		config.SetBaseImageAdvisoriesPath(filepath.Join(projectPath, "advisories.json"))
+
+		newFormatterToTest(analysis, config, projectPath).StartAnalysis("")
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		assert.Equal(t, severity.Low, analysis.AnalysisVulnerabilities[0].Vulnerability.Severity)
+	})
+
+	t.Run("Should set analysis error when the advisories file is invalid", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		config := &cliConfig.Config{}
+		config.SetBaseImageAdvisoriesPath("./not-exists.json")
+
+		newFormatterToTest(analysis, config, newProjectToTest(t, nil)).StartAnalysis("")
+
+		assert.NotEmpty(t, analysis.Errors)
+		assert.Empty(t, analysis.AnalysisVulnerabilities)
+	})
+
+	t.Run("Should not run when the tool is ignored", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		config := &cliConfig.Config{}
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{HorusecDockerfile: toolsconfig.ToolConfig{IsToIgnore: true}})
+
+		newFormatterToTest(analysis, config, newProjectToTest(t, map[string]string{
+			"Dockerfile": "FROM debian:9",
+		})).StartAnalysis("")
+
+		assert.Empty(t, analysis.AnalysisVulnerabilities)
+	})
+}
@@ -20,7 +20,7 @@ const (
 	// nolint
 	ImageCmd = `
 	    {{WORK_DIR}}
-		semgrep --config=p/r2c-ci -q --json .
+		semgrep --config=p/r2c-ci -q --sarif .
 		chmod -R 777 .
   `
 )
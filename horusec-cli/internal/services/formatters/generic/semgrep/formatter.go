@@ -15,21 +15,21 @@
 package semgrep
 
 import (
-	"encoding/json"
-	"github.com/ZupIT/horusec/development-kit/pkg/entities/analyser/general/semgrep"
-	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
-	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
-	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
-	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
-	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
-	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/sdk"
 	"path/filepath"
-	"strconv"
 )
 
+var container = &sdk.Container{
+	Tool:      tools.Semgrep,
+	Language:  languages.Generic,
+	ImageName: ImageName,
+	ImageTag:  ImageTag,
+	ImageCmd:  ImageCmd,
+}
+
 type Formatter struct {
 	formatters.IService
 }
@@ -41,21 +41,12 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
-	if f.ToolIsToIgnore(tools.Semgrep) {
-		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.Semgrep.ToString(), logger.DebugLevel)
-		return
-	}
-	err := f.startSecurityCodeScanAnalysis(projectSubPath)
-	f.SetLanguageIsFinished()
-	f.LogAnalysisError(err, tools.SecurityCodeScan, projectSubPath)
+	sdk.Run(f, tools.Semgrep, projectSubPath, f.startSemgrepAnalysis)
 }
 
-func (f *Formatter) startSecurityCodeScanAnalysis(projectSubPath string) error {
-	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.Semgrep)
-
-	output, err := f.ExecuteContainer(f.getConfigData(projectSubPath))
+func (f *Formatter) startSemgrepAnalysis(projectSubPath string) error {
+	output, err := sdk.Execute(f, sdk.NewAnalysisData(f, container, projectSubPath))
 	if err != nil {
-		f.SetAnalysisError(err)
 		return err
 	}
 
@@ -63,66 +54,12 @@ func (f *Formatter) startSecurityCodeScanAnalysis(projectSubPath string) error {
 	if err != nil {
 		f.SetAnalysisError(err)
 	}
-	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.Semgrep)
 	return err
 }
 
-func (f *Formatter) getConfigData(projectSubPath string) *dockerEntities.AnalysisData {
-	ad := &dockerEntities.AnalysisData{
-		CMD:      f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.Semgrep),
-		Language: languages.Generic,
-	}
-	ad.SetFullImagePath(f.GetToolsConfig()[tools.Semgrep].ImagePath, ImageName, ImageTag)
-	return ad
-}
-
+// parseOutput reads the sarif output of semgrep, that keeps the metadata of the rules and the fingerprints
 func (f *Formatter) parseOutput(output string) error {
-	var analysis *semgrep.Analysis
-
-	err := json.Unmarshal([]byte(output), &analysis)
-	if err != nil {
-		return err
-	}
-
-	for _, result := range analysis.Results {
-		item := result
-		f.setAnalysisResults(f.setVulnerabilityData(&item))
-	}
-
-	return nil
-}
-
-func (f *Formatter) setVulnerabilityData(result *semgrep.Result) *horusec.Vulnerability {
-	data := f.getDefaultVulnerabilityData()
-	data.Details = result.Extra.Message
-	data.Severity = f.getSeverity(result.Extra.Severity)
-	data.Line = strconv.Itoa(result.Start.Line)
-	data.Column = strconv.Itoa(result.Start.Col)
-	data.File = result.Path
-	data.Code = f.GetCodeWithMaxCharacters(result.Extra.Code, 0)
-	data.Language = f.getLanguageByFile(result.Path)
-
-	data = vulnhash.Bind(data)
-
-	return f.setCommitAuthor(data)
-}
-
-func (f *Formatter) setCommitAuthor(vulnerability *horusec.Vulnerability) *horusec.Vulnerability {
-	commitAuthor := f.GetCommitAuthor(vulnerability.Line, vulnerability.File)
-
-	vulnerability.CommitAuthor = commitAuthor.Author
-	vulnerability.CommitHash = commitAuthor.CommitHash
-	vulnerability.CommitDate = commitAuthor.Date
-	vulnerability.CommitEmail = commitAuthor.Email
-	vulnerability.CommitMessage = commitAuthor.Message
-
-	return vulnerability
-}
-
-func (f *Formatter) getDefaultVulnerabilityData() *horusec.Vulnerability {
-	vulnerabilitySeverity := &horusec.Vulnerability{}
-	vulnerabilitySeverity.SecurityTool = tools.Semgrep
-	return vulnerabilitySeverity
+	return sdk.AddSARIFResults(f, tools.Semgrep, output, f.getLanguageByFile)
 }
 
 func (f *Formatter) getLanguageByFile(file string) languages.Language {
@@ -169,21 +106,3 @@ func (f *Formatter) getExtensionList() []string {
 		".html",
 	}
 }
-
-func (f *Formatter) setAnalysisResults(vulnerability *horusec.Vulnerability) {
-	f.GetAnalysis().AnalysisVulnerabilities = append(f.GetAnalysis().AnalysisVulnerabilities,
-		horusec.AnalysisVulnerabilities{
-			Vulnerability: *vulnerability,
-		})
-}
-
-func (f *Formatter) getSeverity(resultSeverity string) severity.Severity {
-	switch resultSeverity {
-	case "ERROR":
-		return severity.High
-	case "WARNING":
-		return severity.Medium
-	}
-
-	return severity.Low
-}
@@ -17,6 +17,8 @@ package semgrep
 import (
 	"errors"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
@@ -26,19 +28,23 @@ import (
 )
 
 func TestParseOutput(t *testing.T) {
-	t.Run("Should return 1 vulnerabilities with no errors", func(t *testing.T) {
+	t.Run("Should return 1 vulnerabilities with the metadata of the rule", func(t *testing.T) {
 		dockerAPIControllerMock := &docker.Mock{}
 		dockerAPIControllerMock.On("SetAnalysisID")
 		analysis := &horusec.Analysis{}
 		config := &cliConfig.Config{}
 		config.SetWorkDir(&workdir.WorkDir{})
 
-		output := "{ \"results\":[ { \"check_id\":\"python.lang.correctness.useless-comparison.no-strings-as-booleans\"," +
-			" \"path\":\"bad/vulpy.py\", \"start\":{ \"line\":36, \"col\":1 }, \"end\":{ \"line\":37, \"col\":23 }, " +
-			"\"extra\":{ \"message\":\"Using strings as booleans in Python has unexpected results.\\n`\\\"one\\\" and " +
-			"\\\"two\\\"` will return \\\"two\\\".\\n`\\\"one\\\" or \\\"two\\\"` will return \\\"one\\\".\\n In Python" +
-			", strings are truthy, evaluating to True.\\n\", \"metavars\":{ }, \"metadata\":{ }, \"severity\":\"ERROR\"" +
-			", \"lines\":\"if csp:\\n    print('CSP:', csp)\" } } ] }"
+		output := `{"version":"2.1.0","runs":[{"tool":{"driver":{"name":"semgrep","rules":[{
+			"id":"python.lang.security.audit.formatted-sql-query.formatted-sql-query",
+			"defaultConfiguration":{"level":"warning"},
+			"properties":{"precision":"very-high","tags":["CWE-89: Improper Neutralization of Special Elements ` +
+			`used in an SQL Command","security"]}}]}},
+			"results":[{"ruleId":"python.lang.security.audit.formatted-sql-query.formatted-sql-query",
+			"level":"error","message":{"text":"Detected possible formatted SQL query."},
+			"fingerprints":{"matchBasedId/v1":"abc123"},
+			"locations":[{"physicalLocation":{"artifactLocation":{"uri":"bad/vulpy.py"},
+			"region":{"startLine":36,"startColumn":1,"snippet":{"text":"cursor.execute(query % user)"}}}}]}]}]}`
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
@@ -47,21 +53,28 @@ func TestParseOutput(t *testing.T) {
 
 		formatter.StartAnalysis("")
 		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, "HIGH", vulnerability.Confidence)
+		assert.Equal(t, "error", vulnerability.ToolSeverity)
+		assert.Equal(t, severity.High, vulnerability.Severity)
+		assert.Equal(t, languages.Python, vulnerability.Language)
+		assert.Equal(t, "36", vulnerability.Line)
+		assert.Equal(t, "bad/vulpy.py", vulnerability.File)
+		assert.Equal(t, "abc123", vulnerability.Fingerprint)
+		assert.Equal(t, "Detected possible formatted SQL query.\nReferences: CWE-89", vulnerability.Details)
 	})
 
-	t.Run("Should return 1 vulnerabilities with no errors", func(t *testing.T) {
+	t.Run("Should return 1 vulnerabilities with the level of the rule", func(t *testing.T) {
 		dockerAPIControllerMock := &docker.Mock{}
 		dockerAPIControllerMock.On("SetAnalysisID")
 		analysis := &horusec.Analysis{}
 		config := &cliConfig.Config{}
 		config.SetWorkDir(&workdir.WorkDir{})
 
-		output := "{ \"results\":[ { \"check_id\":\"python.lang.correctness.useless-comparison.no-strings-as-booleans\"," +
-			" \"path\":\"bad/vulpy.py\", \"start\":{ \"line\":36, \"col\":1 }, \"end\":{ \"line\":37, \"col\":23 }, " +
-			"\"extra\":{ \"message\":\"Using strings as booleans in Python has unexpected results.\\n`\\\"one\\\" and " +
-			"\\\"two\\\"` will return \\\"two\\\".\\n`\\\"one\\\" or \\\"two\\\"` will return \\\"one\\\".\\n In Python" +
-			", strings are truthy, evaluating to True.\\n\", \"metavars\":{ }, \"metadata\":{ }, \"severity\":\"WARNING\"" +
-			", \"lines\":\"if csp:\\n    print('CSP:', csp)\" } } ] }"
+		output := `{"runs":[{"tool":{"driver":{"rules":[{"id":"no-strings-as-booleans",
+			"defaultConfiguration":{"level":"warning"}}]}},"results":[{"ruleId":"no-strings-as-booleans","ruleIndex":0,
+			"message":{"text":"Using strings as booleans in Python has unexpected results."},
+			"locations":[{"physicalLocation":{"artifactLocation":{"uri":"bad"},"region":{"startLine":36}}}]}]}]}`
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
@@ -70,29 +83,26 @@ func TestParseOutput(t *testing.T) {
 
 		formatter.StartAnalysis("")
 		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		assert.Equal(t, "warning", analysis.AnalysisVulnerabilities[0].Vulnerability.ToolSeverity)
+		assert.Equal(t, severity.Medium, analysis.AnalysisVulnerabilities[0].Vulnerability.Severity)
+		assert.Equal(t, languages.Unknown, analysis.AnalysisVulnerabilities[0].Vulnerability.Language)
 	})
 
-	t.Run("Should return 1 vulnerabilities with no errors", func(t *testing.T) {
+	t.Run("Should return no vulnerabilities when the output is empty", func(t *testing.T) {
 		dockerAPIControllerMock := &docker.Mock{}
 		dockerAPIControllerMock.On("SetAnalysisID")
 		analysis := &horusec.Analysis{}
 		config := &cliConfig.Config{}
 		config.SetWorkDir(&workdir.WorkDir{})
 
-		output := "{ \"results\":[ { \"check_id\":\"python.lang.correctness.useless-comparison.no-strings-as-booleans\"," +
-			" \"path\":\"bad\", \"start\":{ \"line\":36, \"col\":1 }, \"end\":{ \"line\":37, \"col\":23 }, " +
-			"\"extra\":{ \"message\":\"Using strings as booleans in Python has unexpected results.\\n`\\\"one\\\" and " +
-			"\\\"two\\\"` will return \\\"two\\\".\\n`\\\"one\\\" or \\\"two\\\"` will return \\\"one\\\".\\n In Python" +
-			", strings are truthy, evaluating to True.\\n\", \"metavars\":{ }, \"metadata\":{ }, \"severity\":\"test\"" +
-			", \"lines\":\"if csp:\\n    print('CSP:', csp)\" } } ] }"
-
-		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
+		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return("", nil)
 
 		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
-		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		assert.Empty(t, analysis.AnalysisVulnerabilities)
+		assert.Empty(t, analysis.Errors)
 	})
 
 	t.Run("Should return error when invalid output", func(t *testing.T) {
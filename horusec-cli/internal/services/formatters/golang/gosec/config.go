@@ -21,7 +21,7 @@ const (
 	ImageCmd = `
 		{{WORK_DIR}}
 		touch /tmp/results-ANALYSISID.json
-		$(which gosec) -quiet -fmt=json -log=log-ANALYSISID.txt -out=/tmp/results-ANALYSISID.json ./... 2> /dev/null
+		$(which gosec) -quiet -fmt=json -log=/tmp/log-ANALYSISID.txt -out=/tmp/results-ANALYSISID.json {{ARGS}} ./... 2> /dev/null
 		jq -j -M -c . /tmp/results-ANALYSISID.json
 		chmod -R 777 .
 	`
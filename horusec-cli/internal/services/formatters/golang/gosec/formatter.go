@@ -15,19 +15,24 @@
 package gosec
 
 import (
+	"strconv"
+
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/analyser/golang"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
-	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
-	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
-	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
-	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
-	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
-	"strconv"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/sdk"
 )
 
+var container = &sdk.Container{
+	Tool:      tools.GoSec,
+	Language:  languages.Go,
+	ImageName: ImageName,
+	ImageTag:  ImageTag,
+	ImageCmd:  ImageCmd,
+}
+
 type Formatter struct {
 	formatters.IService
 }
@@ -39,110 +44,46 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
-	if f.ToolIsToIgnore(tools.GoSec) {
-		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.GoSec.ToString(), logger.DebugLevel)
-		return
-	}
-	err := f.startGoLangGoSecAnalysis(projectSubPath)
-	f.SetLanguageIsFinished()
-	f.LogAnalysisError(err, tools.GoSec, projectSubPath)
+	sdk.Run(f, tools.GoSec, projectSubPath, f.startGoLangGoSecAnalysis)
 }
 
 func (f *Formatter) startGoLangGoSecAnalysis(projectSubPath string) error {
-	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.GoSec)
-
-	output, err := f.ExecuteContainer(f.getAnalysisData(projectSubPath))
+	output, err := sdk.Execute(f, sdk.NewAnalysisData(f, container, projectSubPath))
 	if err != nil {
-		f.SetAnalysisError(err)
 		return err
 	}
 
 	f.processOutput(output)
-	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.GoSec)
 	return nil
 }
 
+// processOutput only logs the invalid outputs, gosec prints the errors of the packages that don't compile
 func (f *Formatter) processOutput(output string) {
-	if output == "" {
-		logger.LogDebugWithLevel(
-			messages.MsgDebugOutputEmpty, logger.DebugLevel, map[string]interface{}{"tool": tools.GoSec.ToString()})
+	var golangOutput golang.Output
+	if decoded, _ := sdk.DecodeJSON(f, tools.GoSec, output, &golangOutput); !decoded {
 		return
 	}
 
-	golangOutput, err := f.parseOutputToGoOutput(output)
-	if err != nil {
-		return
-	}
-
-	f.setGoSecOutPutInHorusecAnalysis(golangOutput)
-}
-
-func (f *Formatter) parseOutputToGoOutput(output string) (golangOutput golang.Output, err error) {
-	err = jsonUtils.ConvertStringToOutput(output, &golangOutput)
-	logger.LogErrorWithLevel(f.GetAnalysisIDErrorMessage(tools.GoSec, output), err, logger.ErrorLevel)
-	return golangOutput, err
-}
-
-func (f *Formatter) setGoSecOutPutInHorusecAnalysis(golangOutput golang.Output) {
-	for _, value := range golangOutput.Issues {
-		issue := value
-		vulnerability := f.setupVulnerabilitiesSeveritiesGoSec(&issue)
-		f.addVulnerabilityBySeverityGoSec(vulnerability)
+	for index := range golangOutput.Issues {
+		sdk.AddVulnerability(f, f.newVulnerability(&golangOutput.Issues[index]))
 	}
 }
 
-func (f *Formatter) setupVulnerabilitiesSeveritiesGoSec(issue *golang.Issue) *horusec.Vulnerability {
-	vulnerability := f.getDefaultVulnerabilitySeverity()
+func (f *Formatter) newVulnerability(issue *golang.Issue) *horusec.Vulnerability {
+	vulnerability := sdk.NewVulnerability(tools.GoSec, languages.Go)
 	vulnerability.Severity = issue.Severity
+	vulnerability.ToolSeverity = issue.Severity.ToString()
 	vulnerability.Details = issue.Details
+	vulnerability.RuleID = issue.RuleID
 	vulnerability.Code = f.getCode(issue.Code, issue.Column)
 	vulnerability.Line = issue.Line
 	vulnerability.Column = issue.Column
 	vulnerability.Confidence = issue.Confidence
 	vulnerability.File = f.RemoveSrcFolderFromPath(issue.File)
-
-	// Set vulnerabilitySeverity.VulnHash value
-	vulnerability = vulnhash.Bind(vulnerability)
-
-	return f.setCommitAuthor(vulnerability)
+	return vulnerability
 }
 
 func (f *Formatter) getCode(code, column string) string {
 	columnNumber, _ := strconv.Atoi(column)
 	return f.GetCodeWithMaxCharacters(code, columnNumber)
 }
-
-func (f *Formatter) setCommitAuthor(vulnerability *horusec.Vulnerability) *horusec.Vulnerability {
-	commitAuthor := f.GetCommitAuthor(vulnerability.Line, vulnerability.File)
-
-	vulnerability.CommitAuthor = commitAuthor.Author
-	vulnerability.CommitHash = commitAuthor.CommitHash
-	vulnerability.CommitDate = commitAuthor.Date
-	vulnerability.CommitEmail = commitAuthor.Email
-	vulnerability.CommitMessage = commitAuthor.Message
-
-	return vulnerability
-}
-
-func (f *Formatter) getDefaultVulnerabilitySeverity() *horusec.Vulnerability {
-	vulnerabilitySeverity := &horusec.Vulnerability{}
-	vulnerabilitySeverity.Language = languages.Go
-	vulnerabilitySeverity.SecurityTool = tools.GoSec
-	return vulnerabilitySeverity
-}
-
-func (f *Formatter) addVulnerabilityBySeverityGoSec(vulnerability *horusec.Vulnerability) {
-	f.GetAnalysis().AnalysisVulnerabilities = append(f.GetAnalysis().AnalysisVulnerabilities,
-		horusec.AnalysisVulnerabilities{
-			Vulnerability: *vulnerability,
-		})
-}
-
-func (f *Formatter) getAnalysisData(projectSubPath string) *dockerEntities.AnalysisData {
-	ad := &dockerEntities.AnalysisData{
-		CMD:      f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.GoSec),
-		Language: languages.Go,
-	}
-	ad.SetFullImagePath(f.GetToolsConfig()[tools.GoSec].ImagePath, ImageName, ImageTag)
-	return ad
-}
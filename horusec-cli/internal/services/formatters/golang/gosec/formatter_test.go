@@ -20,9 +20,11 @@ import (
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/sdk"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -124,3 +126,17 @@ func TestGoLang_StartAnalysis(t *testing.T) {
 		formatter.StartAnalysis("")
 	})
 }
+
+func TestGoLang_AnalysisData(t *testing.T) {
+	t.Run("Should add the args of the tools config before the packages", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{
+			GoSec: toolsconfig.ToolConfig{Args: []string{"-exclude=G104", "-exclude-generated"}}})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, config, &horusec.Monitor{})
+
+		data := sdk.NewAnalysisData(service, container, "")
+
+		assert.Contains(t, data.CMD, "-out=/tmp/results-ANALYSISID.json '-exclude=G104' '-exclude-generated' ./...")
+	})
+}
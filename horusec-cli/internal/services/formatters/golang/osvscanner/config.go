@@ -0,0 +1,36 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvscanner
+
+const (
+	ImageName = "horuszup/osv-scanner"
+	ImageTag  = "v1.0.0"
+	// OfflineDatabasePath is the folder of the container where the snapshot of the osv database is mounted, the
+	// database of the go modules is the file osv-scanner/Go/all.zip inside of it
+	OfflineDatabasePath = "/horusec-osv-database"
+	//nolint
+	ImageCmd = `
+		{{WORK_DIR}}
+		if [ -f go.mod ]; then
+			osv-scanner --format=json {{OFFLINE}} {{ARGS}} --lockfile=go.mod > /tmp/output-ANALYSISID.json 2> /tmp/error-ANALYSISID
+			if [ -s /tmp/output-ANALYSISID.json ]; then
+				cat /tmp/output-ANALYSISID.json
+			else
+				echo "ERROR_RUNNING_OSV_SCANNER"
+				cat /tmp/error-ANALYSISID
+			fi
+		fi
+	`
+)
@@ -0,0 +1,149 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvscanner
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/analyser/golang/osvscanner"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/sdk"
+)
+
+const errorRunningOsvScanner = "ERROR_RUNNING_OSV_SCANNER"
+
+var container = &sdk.Container{
+	Tool:      tools.OsvScanner,
+	Language:  languages.Go,
+	ImageName: ImageName,
+	ImageTag:  ImageTag,
+	ImageCmd:  ImageCmd,
+}
+
+// Formatter audits the go modules of the go.mod against the osv database, or against its snapshot in the offline
+// database path
+type Formatter struct {
+	formatters.IService
+}
+
+func NewFormatter(service formatters.IService) formatters.IFormatter {
+	return &Formatter{
+		service,
+	}
+}
+
+func (f *Formatter) StartAnalysis(projectSubPath string) {
+	sdk.Run(f, tools.OsvScanner, projectSubPath, f.startOsvScannerAnalysis)
+}
+
+func (f *Formatter) startOsvScannerAnalysis(projectSubPath string) error {
+	output, err := sdk.Execute(f, f.getAnalysisData(projectSubPath))
+	if err != nil {
+		return err
+	}
+	if err := f.parseOutput(output, projectSubPath); err != nil {
+		f.SetAnalysisError(err)
+		return err
+	}
+	return nil
+}
+
+func (f *Formatter) getAnalysisData(projectSubPath string) *dockerEntities.AnalysisData {
+	ad := sdk.NewAnalysisData(f, container, projectSubPath)
+	databasePath := f.GetOsvOfflineDatabasePath()
+	if databasePath == "" {
+		ad.CMD = strings.ReplaceAll(ad.CMD, "{{OFFLINE}}", "")
+		return ad
+	}
+	if source, err := filepath.Abs(databasePath); err == nil {
+		databasePath = source
+	}
+	ad.Mounts = append(ad.Mounts,
+		dockerEntities.Mount{Source: databasePath, Target: OfflineDatabasePath, ReadOnly: true})
+	ad.CMD = strings.ReplaceAll(ad.CMD, "{{OFFLINE}}",
+		sdk.QuoteArgs([]string{"--experimental-offline", "--experimental-local-db-path=" + OfflineDatabasePath}))
+	return ad
+}
+
+func (f *Formatter) parseOutput(output, projectSubPath string) error {
+	if strings.HasPrefix(strings.TrimSpace(output), errorRunningOsvScanner) {
+		return errors.New(f.GetAnalysisIDErrorMessage(tools.OsvScanner, output))
+	}
+	var osvOutput osvscanner.Output
+	if decoded, err := sdk.DecodeJSON(f, tools.OsvScanner, output, &osvOutput); !decoded {
+		return err
+	}
+	file := path.Join(filepath.ToSlash(projectSubPath), "go.mod")
+	for _, finding := range osvOutput.GetFindings() {
+		sdk.AddVulnerability(f, f.newVulnerability(&finding, file))
+	}
+	return nil
+}
+
+func (f *Formatter) newVulnerability(finding *osvscanner.Finding, file string) *horusec.Vulnerability {
+	vulnerability := sdk.NewVulnerability(tools.OsvScanner, languages.Go)
+	vulnerability.Severity = f.GetSeverityTables().Get(tools.OsvScanner).
+		GetSeverity(finding.Vulnerability.ID, finding.GetSeverity())
+	vulnerability.ToolSeverity = finding.GetSeverity()
+	vulnerability.Details = finding.GetDetails()
+	vulnerability.RuleID = finding.Vulnerability.ID
+	vulnerability.Code = f.GetCodeWithMaxCharacters(finding.Package.Name+" "+finding.Package.Version, 0)
+	vulnerability.File = file
+	vulnerability.Line = f.getLine(file, finding.Package.Name)
+	vulnerability.Column = "0"
+	vulnerability.Confidence = "-"
+	vulnerability.Dependency = &horusec.Dependency{Name: finding.Package.Name, Version: finding.Package.Version,
+		FixedVersion: finding.GetFixedVersion()}
+	return vulnerability
+}
+
+// getLine returns the line of the module in the go.mod, the modules of the go.sum only are reported in the line 0
+func (f *Formatter) getLine(file, module string) string {
+	goMod, err := os.Open(filepath.Join(f.GetConfigProjectPath(), filepath.FromSlash(file)))
+	if err != nil {
+		return "0"
+	}
+	defer func() {
+		logger.LogErrorWithLevel(messages.MsgErrorDeferFileClose, goMod.Close(), logger.ErrorLevel)
+	}()
+	scanner := bufio.NewScanner(goMod)
+	for line := 1; scanner.Scan(); line++ {
+		if fields := strings.Fields(scanner.Text()); f.containsModule(fields, module) {
+			return strconv.Itoa(line)
+		}
+	}
+	return "0"
+}
+
+func (f *Formatter) containsModule(fields []string, module string) bool {
+	for _, field := range fields {
+		if field == module {
+			return true
+		}
+	}
+	return false
+}
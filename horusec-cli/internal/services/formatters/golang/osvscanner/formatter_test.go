@@ -0,0 +1,124 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osvscanner
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/stretchr/testify/assert"
+)
+
+const outputToTest = `{"results": [{"source": {"path": "/src/code/go.mod", "type": "lockfile"}, "packages": [{
+	"package": {"name": "golang.org/x/text", "version": "0.3.5", "ecosystem": "Go"},
+	"vulnerabilities": [{"id": "GO-2021-0113", "summary": "Out-of-bounds read in golang.org/x/text/language",
+		"affected": [{"package": {"name": "golang.org/x/text"}, "ranges": [{"events": [{"introduced": "0"},
+			{"fixed": "0.3.7"}]}]}]}],
+	"groups": [{"ids": ["GO-2021-0113", "CVE-2021-38561"], "max_severity": "7.5"}]
+}]}]}`
+
+func newServiceToTest(t *testing.T, output string, err error) (formatters.IService, *horusec.Analysis) {
+	config := cliConfig.NewConfig()
+	config.SetWorkDir(&workdir.WorkDir{})
+	config.SetProjectPath(t.TempDir())
+	dockerAPIControllerMock := &docker.Mock{}
+	dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, err)
+	analysis := &horusec.Analysis{}
+	service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+
+	assert.NoError(t, os.MkdirAll(service.GetConfigProjectPath(), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(service.GetConfigProjectPath(), "go.mod"),
+		[]byte("module example.com/api\n\ngo 1.14\n\nrequire golang.org/x/text v0.3.5\n"), 0600))
+	return service, analysis
+}
+
+func TestFormatter_StartAnalysis(t *testing.T) {
+	t.Run("Should add the vulnerabilities of the go modules", func(t *testing.T) {
+		service, analysis := newServiceToTest(t, outputToTest, nil)
+
+		NewFormatter(service).StartAnalysis("")
+
+		assert.Empty(t, analysis.Errors)
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, "GO-2021-0113", vulnerability.RuleID)
+		assert.Equal(t, severity.High, vulnerability.Severity)
+		assert.Equal(t, "go.mod", vulnerability.File)
+		assert.Equal(t, "5", vulnerability.Line)
+		assert.Equal(t, "golang.org/x/text 0.3.5", vulnerability.Code)
+		assert.Equal(t, &horusec.Dependency{Name: "golang.org/x/text", Version: "0.3.5", FixedVersion: "0.3.7"},
+			vulnerability.Dependency)
+	})
+
+	t.Run("Should not add vulnerabilities when the output is empty", func(t *testing.T) {
+		service, analysis := newServiceToTest(t, "", nil)
+
+		NewFormatter(service).StartAnalysis("")
+
+		assert.Empty(t, analysis.Errors)
+		assert.Empty(t, analysis.AnalysisVulnerabilities)
+	})
+
+	t.Run("Should set the error of the analysis when osv-scanner fails", func(t *testing.T) {
+		service, analysis := newServiceToTest(t, "ERROR_RUNNING_OSV_SCANNER\nfailed to load the database", nil)
+
+		NewFormatter(service).StartAnalysis("")
+
+		assert.Contains(t, analysis.Errors, "failed to load the database")
+		assert.Empty(t, analysis.AnalysisVulnerabilities)
+	})
+
+	t.Run("Should set the error of the analysis when the container fails", func(t *testing.T) {
+		service, analysis := newServiceToTest(t, "", errors.New("test"))
+
+		NewFormatter(service).StartAnalysis("")
+
+		assert.NotEmpty(t, analysis.Errors)
+	})
+}
+
+func TestFormatter_getAnalysisData(t *testing.T) {
+	t.Run("Should mount the offline database and run osv-scanner offline", func(t *testing.T) {
+		databasePath := t.TempDir()
+		config := cliConfig.NewConfig()
+		config.SetOsvOfflineDatabasePath(databasePath)
+		data := (&Formatter{formatters.NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, config,
+			&horusec.Monitor{})}).getAnalysisData("api")
+
+		assert.Equal(t, []dockerEntities.Mount{{Source: databasePath, Target: OfflineDatabasePath, ReadOnly: true}},
+			data.Mounts)
+		assert.Contains(t, data.CMD, "cd api")
+		assert.Contains(t, data.CMD, `'--experimental-offline' '--experimental-local-db-path=/horusec-osv-database'`)
+	})
+
+	t.Run("Should run osv-scanner online without the offline database", func(t *testing.T) {
+		service, _ := newServiceToTest(t, "", nil)
+		data := (&Formatter{service}).getAnalysisData("")
+
+		assert.Empty(t, data.Mounts)
+		assert.NotContains(t, data.CMD, "--experimental-offline")
+		assert.NotContains(t, data.CMD, "{{OFFLINE}}")
+	})
+}
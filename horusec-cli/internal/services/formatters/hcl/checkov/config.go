@@ -0,0 +1,30 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkov
+
+const (
+	ImageName = "bridgecrew/checkov"
+	ImageTag  = "2.0.1140"
+	// nolint
+	ImageCmd = `
+      checkov --quiet --framework terraform_plan --output json --file {{TARGET}} > /tmp/output-ANALYSISID.json 2> /tmp/error-ANALYSISID
+      if [ -s /tmp/output-ANALYSISID.json ]; then
+        cat /tmp/output-ANALYSISID.json
+      else
+        echo "ERROR_RUNNING_CHECKOV"
+        cat /tmp/error-ANALYSISID
+      fi
+  `
+)
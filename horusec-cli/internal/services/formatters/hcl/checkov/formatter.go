@@ -0,0 +1,134 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkov
+
+import (
+	"errors"
+	"strings"
+
+	checkovEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/analyser/hcl/checkov"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
+	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+)
+
+const errorRunningCheckov = "ERROR_RUNNING_CHECKOV"
+
+// Formatter checks the planned resources of the json of a terraform plan, the plan path is relative to the folder
+// mounted in the container of the tool
+type Formatter struct {
+	formatters.IService
+	planPath string
+}
+
+func NewFormatter(service formatters.IService, planPath string) formatters.IFormatter {
+	return &Formatter{
+		IService: service,
+		planPath: planPath,
+	}
+}
+
+func (f *Formatter) StartAnalysis(projectSubPath string) {
+	defer f.RecoverFromPanic(tools.Checkov, projectSubPath)
+	if f.ToolIsToIgnore(tools.Checkov) {
+		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.Checkov.ToString(), logger.DebugLevel)
+		return
+	}
+	err := f.startCheckovAnalysis(projectSubPath)
+	f.LogAnalysisError(err, tools.Checkov, projectSubPath)
+	f.SetToolIsFinished(err, tools.Checkov, projectSubPath)
+}
+
+func (f *Formatter) startCheckovAnalysis(projectSubPath string) error {
+	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.Checkov)
+
+	output, err := f.ExecuteContainer(f.getAnalysisData(projectSubPath))
+	if err != nil {
+		f.SetAnalysisError(err)
+		return err
+	}
+
+	if err := f.parseOutput(output); err != nil {
+		f.SetAnalysisError(err)
+		return err
+	}
+	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.Checkov)
+	return nil
+}
+
+func (f *Formatter) getAnalysisData(projectSubPath string) *dockerEntities.AnalysisData {
+	ad := &dockerEntities.AnalysisData{
+		CMD:            strings.ReplaceAll(ImageCmd, "{{TARGET}}", f.getQuotedPlanPath()),
+		Language:       languages.HCL,
+		Tool:           tools.Checkov,
+		ProjectSubPath: projectSubPath,
+	}
+	ad.SetFullImagePath(f.GetToolsConfig()[tools.Checkov].ImagePath, ImageName, ImageTag)
+	return ad
+}
+
+func (f *Formatter) getQuotedPlanPath() string {
+	return "'" + strings.ReplaceAll(f.planPath, "'", `'\''`) + "'"
+}
+
+func (f *Formatter) parseOutput(output string) error {
+	if output == "" {
+		logger.LogDebugWithLevel(messages.MsgDebugOutputEmpty, logger.DebugLevel,
+			map[string]interface{}{"tool": tools.Checkov.ToString()})
+		return nil
+	}
+	if strings.HasPrefix(strings.TrimSpace(output), errorRunningCheckov) {
+		return errors.New(f.GetAnalysisIDErrorMessage(tools.Checkov, output))
+	}
+	checkovOutput := checkovEntities.Output{}
+	if err := jsonUtils.ConvertStringToOutput(output, &checkovOutput); err != nil {
+		logger.LogErrorWithLevel(f.GetAnalysisIDErrorMessage(tools.Checkov, output), err, logger.ErrorLevel)
+		return err
+	}
+	f.setCheckovOutputInHorusecAnalysis(checkovOutput.GetFailedChecks())
+	return nil
+}
+
+func (f *Formatter) setCheckovOutputInHorusecAnalysis(checks []checkovEntities.Check) {
+	for index := range checks {
+		f.GetAnalysis().AnalysisVulnerabilities = append(f.GetAnalysis().AnalysisVulnerabilities,
+			horusec.AnalysisVulnerabilities{
+				Vulnerability: *vulnhash.Bind(f.newVulnerability(&checks[index])),
+			})
+	}
+}
+
+// newVulnerability uses the resource address as code, the line of the plan doesn't point to the terraform files
+func (f *Formatter) newVulnerability(check *checkovEntities.Check) *horusec.Vulnerability {
+	return &horusec.Vulnerability{
+		Language:     languages.HCL,
+		SecurityTool: tools.Checkov,
+		Severity:     f.GetSeverityTables().Get(tools.Checkov).GetSeverity(check.CheckID, check.Severity),
+		ToolSeverity: check.Severity,
+		Details:      check.GetDetails(),
+		RuleID:       check.CheckID,
+		Code:         check.Resource,
+		File:         f.planPath,
+		Line:         check.GetLine(),
+		Column:       "0",
+		Confidence:   "-",
+	}
+}
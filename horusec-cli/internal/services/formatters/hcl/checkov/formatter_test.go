@@ -0,0 +1,118 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkov
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func getAnalysis() *horusec.Analysis {
+	return &horusec.Analysis{
+		ID:                      uuid.New(),
+		Status:                  enumHorusec.Running,
+		CreatedAt:               time.Now(),
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{},
+	}
+}
+
+func newFormatterToTest(analysis *horusec.Analysis, output string, err error) formatters.IFormatter {
+	config := &cliConfig.Config{}
+	config.SetWorkDir(&workdir.WorkDir{})
+
+	dockerAPIControllerMock := &docker.Mock{}
+	dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, err)
+
+	service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+	return NewFormatter(service, "plan.json")
+}
+
+func TestNewFormatter(t *testing.T) {
+	config := &cliConfig.Config{}
+	config.SetWorkDir(&workdir.WorkDir{})
+
+	service := formatters.NewFormatterService(nil, nil, config, &horusec.Monitor{})
+
+	assert.IsType(t, NewFormatter(service, "plan.json"), &Formatter{})
+}
+
+func TestFormatter_StartAnalysis(t *testing.T) {
+	t.Run("Should add the failed checks of the plan in the analysis", func(t *testing.T) {
+		analysis := getAnalysis()
+		output := `{"check_type": "terraform_plan", "results": {"passed_checks": [], "failed_checks": [
+			{"check_id": "CKV_AWS_20", "check_name": "S3 Bucket allows public READ access", "file_path": "/plan.json",
+			"file_line_range": [0, 0], "resource": "module.storage.aws_s3_bucket.data", "severity": null}]}}`
+
+		newFormatterToTest(analysis, output, nil).StartAnalysis("")
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, tools.Checkov, vulnerability.SecurityTool)
+		assert.Equal(t, languages.HCL, vulnerability.Language)
+		assert.Equal(t, severity.High, vulnerability.Severity)
+		assert.Equal(t, "plan.json", vulnerability.File)
+		assert.Equal(t, "module.storage.aws_s3_bucket.data", vulnerability.Code)
+		assert.NotEmpty(t, vulnerability.VulnHash)
+		assert.Empty(t, analysis.Errors)
+	})
+
+	t.Run("Should set error in the analysis when checkov fails", func(t *testing.T) {
+		analysis := getAnalysis()
+
+		newFormatterToTest(analysis, "ERROR_RUNNING_CHECKOV\ninvalid plan", nil).StartAnalysis("")
+
+		assert.Empty(t, analysis.AnalysisVulnerabilities)
+		assert.Contains(t, analysis.Errors, "invalid plan")
+	})
+
+	t.Run("Should set error in the analysis when the container fails", func(t *testing.T) {
+		analysis := getAnalysis()
+
+		newFormatterToTest(analysis, "", errors.New("test")).StartAnalysis("")
+
+		assert.NotEmpty(t, analysis.Errors)
+	})
+
+	t.Run("Should not run when the tool is ignored", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.SetToolsToIgnore([]string{"Checkov"})
+		dockerAPIControllerMock := &docker.Mock{}
+
+		service := formatters.NewFormatterService(getAnalysis(), dockerAPIControllerMock, config, &horusec.Monitor{})
+		NewFormatter(service, "plan.json").StartAnalysis("")
+
+		dockerAPIControllerMock.AssertNotCalled(t, "CreateLanguageAnalysisContainer")
+	})
+}
+
+func TestFormatter_getQuotedPlanPath(t *testing.T) {
+	t.Run("Should quote the plan path", func(t *testing.T) {
+		assert.Equal(t, `'team'\''s/plan.json'`, (&Formatter{planPath: "team's/plan.json"}).getQuotedPlanPath())
+	})
+}
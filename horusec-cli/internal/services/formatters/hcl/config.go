@@ -19,8 +19,8 @@ const (
 	ImageTag  = "v1.0.0"
 	ImageCmd  = `
 			{{WORK_DIR}}
-        	tfsec --format=json | grep -v "WARNING: skipped" > results.json
-			cat results.json
+        	tfsec --format=json | grep -v "WARNING: skipped" > /tmp/results-ANALYSISID.json
+			cat /tmp/results-ANALYSISID.json
 	  		chmod -R 777 .
   `
 )
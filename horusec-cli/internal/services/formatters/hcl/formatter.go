@@ -15,8 +15,8 @@
 package hcl
 
 import (
-	"encoding/json"
 	"fmt"
+	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
 	"strings"
@@ -42,12 +42,13 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
+	defer f.RecoverFromPanic(tools.TfSec, projectSubPath)
 	if f.ToolIsToIgnore(tools.TfSec) {
 		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.TfSec.ToString(), logger.DebugLevel)
 		return
 	}
 	err := f.startTfSec(projectSubPath)
-	f.SetLanguageIsFinished()
+	f.SetToolIsFinished(err, tools.TfSec, projectSubPath)
 	f.LogAnalysisError(err, tools.TfSec, projectSubPath)
 }
 
@@ -66,17 +67,25 @@ func (f *Formatter) startTfSec(projectSubPath string) error {
 
 func (f *Formatter) getConfigData(projectSubPath string) *dockerEntities.AnalysisData {
 	ad := &dockerEntities.AnalysisData{
-		CMD:      f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.TfSec),
-		Language: languages.HCL,
+		CMD:            f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.TfSec),
+		Language:       languages.HCL,
+		Tool:           tools.TfSec,
+		ProjectSubPath: projectSubPath,
 	}
 	ad.SetFullImagePath(f.GetToolsConfig()[tools.TfSec].ImagePath, ImageName, ImageTag)
 	return ad
 }
 
 func (f *Formatter) parseOutput(output string) error {
+	if output == "" {
+		logger.LogDebugWithLevel(messages.MsgDebugOutputEmpty,
+			logger.DebugLevel, map[string]interface{}{"tool": tools.TfSec.ToString()})
+		return nil
+	}
+
 	var vulnerabilities *hcl.Vulnerabilities
 
-	if err := json.Unmarshal([]byte(output), &vulnerabilities); err != nil {
+	if err := jsonUtils.ConvertStringToOutput(output, &vulnerabilities); err != nil {
 		if !strings.Contains(output, "panic") {
 			f.SetAnalysisError(fmt.Errorf("{HORUSEC_CLI} Error %s", output))
 		}
@@ -101,7 +110,9 @@ func (f *Formatter) appendResults(hclVulnerabilities *hcl.Vulnerabilities) {
 func (f *Formatter) setVulnerabilityData(result *hcl.Result) *horusec.Vulnerability {
 	vulnerability := f.getDefaultVulnerabilitySeverity()
 	vulnerability.Severity = severity.High
+	vulnerability.ToolSeverity = result.Severity
 	vulnerability.Details = result.GetDetails()
+	vulnerability.RuleID = result.RuleID
 	vulnerability.Line = result.GetStartLine()
 	vulnerability.Code = f.GetCodeWithMaxCharacters(result.GetCode(), 0)
 	vulnerability.File = f.RemoveSrcFolderFromPath(result.GetFilename())
@@ -0,0 +1,30 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trivy
+
+const (
+	ImageName = "aquasec/trivy"
+	ImageTag  = "0.16.0"
+	// nolint
+	ImageCmd = `
+      trivy image --quiet --no-progress --format json --output /tmp/output-ANALYSISID.json {{TARGET}} > /tmp/error-ANALYSISID 2>&1
+      if [ $? -eq 0 ]; then
+        cat /tmp/output-ANALYSISID.json
+      else
+        echo "ERROR_RUNNING_TRIVY"
+        cat /tmp/error-ANALYSISID
+      fi
+  `
+)
@@ -0,0 +1,143 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trivy
+
+import (
+	"errors"
+	"strings"
+
+	trivyEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/analyser/image/trivy"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
+	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+)
+
+const errorRunningTrivy = "ERROR_RUNNING_TRIVY"
+
+// Formatter scans a container image, the target is the image reference or an image saved as tar in the project
+type Formatter struct {
+	formatters.IService
+	target  string
+	isInput bool
+}
+
+func NewFormatter(service formatters.IService, target string, isInput bool) formatters.IFormatter {
+	return &Formatter{
+		IService: service,
+		target:   target,
+		isInput:  isInput,
+	}
+}
+
+func (f *Formatter) StartAnalysis(projectSubPath string) {
+	defer f.RecoverFromPanic(tools.Trivy, projectSubPath)
+	if f.ToolIsToIgnore(tools.Trivy) {
+		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.Trivy.ToString(), logger.DebugLevel)
+		return
+	}
+	err := f.startTrivyAnalysis(projectSubPath)
+	f.LogAnalysisError(err, tools.Trivy, projectSubPath)
+	f.SetToolIsFinished(err, tools.Trivy, projectSubPath)
+}
+
+func (f *Formatter) startTrivyAnalysis(projectSubPath string) error {
+	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.Trivy)
+
+	output, err := f.ExecuteContainer(f.getAnalysisData(projectSubPath))
+	if err != nil {
+		f.SetAnalysisError(err)
+		return err
+	}
+
+	if err := f.parseOutput(output); err != nil {
+		f.SetAnalysisError(err)
+		return err
+	}
+	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.Trivy)
+	return nil
+}
+
+func (f *Formatter) getAnalysisData(projectSubPath string) *dockerEntities.AnalysisData {
+	ad := &dockerEntities.AnalysisData{
+		CMD:            strings.ReplaceAll(ImageCmd, "{{TARGET}}", f.getTargetArgs()),
+		Language:       languages.Generic,
+		Tool:           tools.Trivy,
+		ProjectSubPath: projectSubPath,
+	}
+	ad.SetFullImagePath(f.GetToolsConfig()[tools.Trivy].ImagePath, ImageName, ImageTag)
+	return ad
+}
+
+// getTargetArgs quotes the target, that is given by the user, to be used in the shell of the container
+func (f *Formatter) getTargetArgs() string {
+	target := "'" + strings.ReplaceAll(f.target, "'", `'\''`) + "'"
+	if f.isInput {
+		return "--input " + target
+	}
+	return target
+}
+
+func (f *Formatter) parseOutput(output string) error {
+	if output == "" {
+		logger.LogDebugWithLevel(messages.MsgDebugOutputEmpty, logger.DebugLevel,
+			map[string]interface{}{"tool": tools.Trivy.ToString()})
+		return nil
+	}
+	if strings.HasPrefix(strings.TrimSpace(output), errorRunningTrivy) {
+		return errors.New(f.GetAnalysisIDErrorMessage(tools.Trivy, output))
+	}
+	trivyOutput := trivyEntities.Output{}
+	if err := jsonUtils.ConvertStringToOutput(output, &trivyOutput); err != nil {
+		logger.LogErrorWithLevel(f.GetAnalysisIDErrorMessage(tools.Trivy, output), err, logger.ErrorLevel)
+		return err
+	}
+	f.setTrivyOutputInHorusecAnalysis(trivyOutput.Results)
+	return nil
+}
+
+func (f *Formatter) setTrivyOutputInHorusecAnalysis(results []trivyEntities.Result) {
+	for resultIndex := range results {
+		for index := range results[resultIndex].Vulnerabilities {
+			vulnerability := f.newVulnerability(results[resultIndex].Target,
+				&results[resultIndex].Vulnerabilities[index])
+			f.GetAnalysis().AnalysisVulnerabilities = append(f.GetAnalysis().AnalysisVulnerabilities,
+				horusec.AnalysisVulnerabilities{
+					Vulnerability: *vulnhash.Bind(vulnerability),
+				})
+		}
+	}
+}
+
+func (f *Formatter) newVulnerability(
+	target string, trivyVulnerability *trivyEntities.Vulnerability) *horusec.Vulnerability {
+	return &horusec.Vulnerability{
+		Language:     languages.Generic,
+		SecurityTool: tools.Trivy,
+		Severity:     f.GetSeverityTables().Get(tools.Trivy).GetSeverity("", trivyVulnerability.Severity),
+		ToolSeverity: trivyVulnerability.Severity,
+		Details:      trivyVulnerability.GetDetails(),
+		Code:         trivyVulnerability.GetPackage(),
+		File:         target,
+		Line:         "0",
+		Column:       "0",
+		Confidence:   "-",
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trivy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func getAnalysis() *horusec.Analysis {
+	return &horusec.Analysis{
+		ID:                      uuid.New(),
+		Status:                  enumHorusec.Running,
+		CreatedAt:               time.Now(),
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{},
+	}
+}
+
+func newFormatterToTest(analysis *horusec.Analysis, output string, err error) (formatters.IFormatter, *docker.Mock) {
+	config := &cliConfig.Config{}
+	config.SetWorkDir(&workdir.WorkDir{})
+
+	dockerAPIControllerMock := &docker.Mock{}
+	dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, err)
+
+	service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+	return NewFormatter(service, "alpine:3.10", false), dockerAPIControllerMock
+}
+
+func TestNewFormatter(t *testing.T) {
+	config := &cliConfig.Config{}
+	config.SetWorkDir(&workdir.WorkDir{})
+
+	service := formatters.NewFormatterService(nil, nil, config, &horusec.Monitor{})
+
+	assert.IsType(t, NewFormatter(service, "alpine:3.10", false), &Formatter{})
+}
+
+func TestFormatter_StartAnalysis(t *testing.T) {
+	t.Run("Should add the vulnerabilities of the image in the analysis", func(t *testing.T) {
+		analysis := getAnalysis()
+		output := `[{"Target": "alpine:3.10 (alpine 3.10.9)", "Type": "alpine", "Vulnerabilities": [
+			{"VulnerabilityID": "CVE-2021-36159", "PkgName": "apk-tools", "InstalledVersion": "2.10.6-r0",
+			"FixedVersion": "2.10.7-r0", "Severity": "CRITICAL"}]}]`
+		formatter, _ := newFormatterToTest(analysis, output, nil)
+
+		formatter.StartAnalysis("")
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, tools.Trivy, vulnerability.SecurityTool)
+		assert.Equal(t, severity.High, vulnerability.Severity)
+		assert.Equal(t, "alpine:3.10 (alpine 3.10.9)", vulnerability.File)
+		assert.Equal(t, "apk-tools@2.10.6-r0", vulnerability.Code)
+		assert.NotEmpty(t, vulnerability.VulnHash)
+		assert.Empty(t, analysis.Errors)
+	})
+
+	t.Run("Should set error in the analysis when trivy fails", func(t *testing.T) {
+		analysis := getAnalysis()
+		formatter, _ := newFormatterToTest(analysis, "ERROR_RUNNING_TRIVY\nimage not found", nil)
+
+		formatter.StartAnalysis("")
+
+		assert.Empty(t, analysis.AnalysisVulnerabilities)
+		assert.Contains(t, analysis.Errors, "image not found")
+	})
+
+	t.Run("Should set error in the analysis when the container fails", func(t *testing.T) {
+		analysis := getAnalysis()
+		formatter, _ := newFormatterToTest(analysis, "", errors.New("test"))
+
+		formatter.StartAnalysis("")
+
+		assert.NotEmpty(t, analysis.Errors)
+	})
+
+	t.Run("Should not run when the tool is ignored", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.SetToolsToIgnore([]string{"Trivy"})
+		dockerAPIControllerMock := &docker.Mock{}
+
+		service := formatters.NewFormatterService(getAnalysis(), dockerAPIControllerMock, config, &horusec.Monitor{})
+		NewFormatter(service, "alpine:3.10", false).StartAnalysis("")
+
+		dockerAPIControllerMock.AssertNotCalled(t, "CreateLanguageAnalysisContainer")
+	})
+}
+
+func TestFormatter_getTargetArgs(t *testing.T) {
+	t.Run("Should quote the image reference and the input", func(t *testing.T) {
+		assert.Equal(t, `'alpine'\''s:3.10'`, (&Formatter{target: "alpine's:3.10"}).getTargetArgs())
+		assert.Equal(t, "--input '/src/image.tar'", (&Formatter{target: "/src/image.tar", isInput: true}).getTargetArgs())
+	})
+}
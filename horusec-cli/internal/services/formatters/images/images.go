@@ -0,0 +1,144 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"fmt"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/c/flawfinder"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/csharp/horuseccsharp"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/csharp/scs"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/generic/semgrep"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/golang/gosec"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/golang/osvscanner"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/hcl"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/hcl/checkov"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/image/trivy"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/java/horusecjava"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/java/spotbugs"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/javascript/eslint"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/javascript/horusecnodejs"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/javascript/npmaudit"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/kotlin/horuseckotlin"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/leaks/gitleaks"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/leaks/horusecleaks"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/php/phpcs"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/python/bandit"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/python/safety"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/ruby/brakeman"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/yaml/horuseckubernetes"
+)
+
+// Image is the default container image used by a tool, when the image path is not changed in the tools config
+type Image struct {
+	Tool tools.Tool
+	Name string
+	Tag  string
+}
+
+func (i *Image) GetFullImagePath() string {
+	return fmt.Sprintf("docker.io/%s:%s", i.Name, i.Tag)
+}
+
+// Languages analyzed by each tool, trivy analyzes container images and not the languages of the projects
+var languagesByTool = map[tools.Tool]languages.Language{
+	tools.GoSec:             languages.Go,
+	tools.SecurityCodeScan:  languages.CSharp,
+	tools.Brakeman:          languages.Ruby,
+	tools.Safety:            languages.Python,
+	tools.Bandit:            languages.Python,
+	tools.NpmAudit:          languages.Javascript,
+	tools.YarnAudit:         languages.Javascript,
+	tools.SpotBugs:          languages.Java,
+	tools.HorusecKotlin:     languages.Kotlin,
+	tools.HorusecJava:       languages.Java,
+	tools.HorusecLeaks:      languages.Leaks,
+	tools.GitLeaks:          languages.Leaks,
+	tools.TfSec:             languages.HCL,
+	tools.Semgrep:           languages.Generic,
+	tools.HorusecCsharp:     languages.CSharp,
+	tools.HorusecKubernetes: languages.Yaml,
+	tools.Eslint:            languages.Javascript,
+	tools.HorusecNodejs:     languages.Javascript,
+	tools.Flawfinder:        languages.C,
+	tools.PhpCS:             languages.PHP,
+	tools.Checkov:           languages.HCL,
+	tools.OsvScanner:        languages.Go,
+}
+
+// GetImagePath returns the image path changed in the tools config, or the default image of the tool
+func (i *Image) GetImagePath(toolsConfig map[tools.Tool]toolsconfig.ToolConfig) string {
+	if imagePath := toolsConfig[i.Tool].ImagePath; imagePath != "" {
+		return imagePath
+	}
+	return i.GetFullImagePath()
+}
+
+// ValuesOfLanguages returns the images of the tools that analyze the languages, all images when no language is
+// informed
+func ValuesOfLanguages(langs []languages.Language) (images []Image) {
+	if len(langs) == 0 {
+		return Values()
+	}
+	for _, image := range Values() {
+		language, ok := languagesByTool[image.Tool]
+		if ok && containsLanguage(langs, language) {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
+func containsLanguage(langs []languages.Language, language languages.Language) bool {
+	for _, lang := range langs {
+		if lang == language {
+			return true
+		}
+	}
+	return false
+}
+
+//nolint:funlen all tools is greater than 15
+func Values() []Image {
+	return []Image{
+		{Tool: tools.GoSec, Name: gosec.ImageName, Tag: gosec.ImageTag},
+		{Tool: tools.SecurityCodeScan, Name: scs.ImageName, Tag: scs.ImageTag},
+		{Tool: tools.Brakeman, Name: brakeman.ImageName, Tag: brakeman.ImageTag},
+		{Tool: tools.Safety, Name: safety.ImageName, Tag: safety.ImageTag},
+		{Tool: tools.Bandit, Name: bandit.ImageName, Tag: bandit.ImageTag},
+		{Tool: tools.NpmAudit, Name: npmaudit.ImageName, Tag: npmaudit.ImageTag},
+		// yarn audit runs in the image of npm audit
+		{Tool: tools.YarnAudit, Name: npmaudit.ImageName, Tag: npmaudit.ImageTag},
+		{Tool: tools.SpotBugs, Name: spotbugs.ImageName, Tag: spotbugs.ImageTag},
+		{Tool: tools.HorusecKotlin, Name: horuseckotlin.ImageName, Tag: horuseckotlin.ImageTag},
+		{Tool: tools.HorusecJava, Name: horusecjava.ImageName, Tag: horusecjava.ImageTag},
+		{Tool: tools.HorusecLeaks, Name: horusecleaks.ImageName, Tag: horusecleaks.ImageTag},
+		{Tool: tools.GitLeaks, Name: gitleaks.ImageName, Tag: gitleaks.ImageTag},
+		{Tool: tools.TfSec, Name: hcl.ImageName, Tag: hcl.ImageTag},
+		{Tool: tools.Semgrep, Name: semgrep.ImageName, Tag: semgrep.ImageTag},
+		{Tool: tools.HorusecCsharp, Name: horuseccsharp.ImageName, Tag: horuseccsharp.ImageTag},
+		{Tool: tools.HorusecKubernetes, Name: horuseckubernetes.ImageName, Tag: horuseckubernetes.ImageTag},
+		{Tool: tools.Eslint, Name: eslint.ImageName, Tag: eslint.ImageTag},
+		{Tool: tools.HorusecNodejs, Name: horusecnodejs.ImageName, Tag: horusecnodejs.ImageTag},
+		{Tool: tools.Flawfinder, Name: flawfinder.ImageName, Tag: flawfinder.ImageTag},
+		{Tool: tools.PhpCS, Name: phpcs.ImageName, Tag: phpcs.ImageTag},
+		{Tool: tools.Trivy, Name: trivy.ImageName, Tag: trivy.ImageTag},
+		{Tool: tools.Checkov, Name: checkov.ImageName, Tag: checkov.ImageTag},
+		{Tool: tools.OsvScanner, Name: osvscanner.ImageName, Tag: osvscanner.ImageTag},
+	}
+}
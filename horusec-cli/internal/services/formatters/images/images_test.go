@@ -0,0 +1,110 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValues(t *testing.T) {
+	t.Run("Should return one image for each tool that runs in container", func(t *testing.T) {
+		var toolsWithImage []tools.Tool
+		for _, tool := range tools.Values() {
+			// horusec dockerfile runs in the process of horusec
+			if tool != tools.HorusecDockerfile {
+				toolsWithImage = append(toolsWithImage, tool)
+			}
+		}
+		values := Values()
+		assert.Len(t, values, len(toolsWithImage))
+		for index, tool := range toolsWithImage {
+			assert.Equal(t, tool, values[index].Tool)
+			assert.NotEmpty(t, values[index].Name)
+			assert.NotEmpty(t, values[index].Tag)
+		}
+	})
+}
+
+func TestGetFullImagePath(t *testing.T) {
+	t.Run("Should return the image path in the docker hub", func(t *testing.T) {
+		image := &Image{Name: "horuszup/gosec", Tag: "v1.0.0"}
+		assert.Equal(t, "docker.io/horuszup/gosec:v1.0.0", image.GetFullImagePath())
+	})
+}
+
+func TestValuesOfLanguages(t *testing.T) {
+	t.Run("Should return the images of the tools of the languages", func(t *testing.T) {
+		values := ValuesOfLanguages([]languages.Language{languages.Go, languages.Python})
+		var valuesTools []tools.Tool
+		for _, image := range values {
+			valuesTools = append(valuesTools, image.Tool)
+		}
+		assert.Equal(t, []tools.Tool{tools.GoSec, tools.Safety, tools.Bandit, tools.OsvScanner}, valuesTools)
+	})
+
+	t.Run("Should return all images when no language is informed", func(t *testing.T) {
+		assert.Equal(t, Values(), ValuesOfLanguages(nil))
+	})
+
+	t.Run("Should have the language of all tools of the analysis of projects", func(t *testing.T) {
+		for _, image := range Values() {
+			if image.Tool != tools.Trivy {
+				assert.Contains(t, languagesByTool, image.Tool)
+			}
+		}
+	})
+}
+
+func TestGetImagePath(t *testing.T) {
+	image := &Image{Tool: tools.GoSec, Name: "horuszup/gosec", Tag: "v1.0.0"}
+
+	t.Run("Should return the image path of the tools config", func(t *testing.T) {
+		toolsConfig := map[tools.Tool]toolsconfig.ToolConfig{
+			tools.GoSec: {ImagePath: "registry.example.com/gosec:v1.0.0"},
+		}
+		assert.Equal(t, "registry.example.com/gosec:v1.0.0", image.GetImagePath(toolsConfig))
+	})
+
+	t.Run("Should return the default image path when it is not changed", func(t *testing.T) {
+		assert.Equal(t, "docker.io/horuszup/gosec:v1.0.0", image.GetImagePath(nil))
+	})
+}
+
+func TestGetUpstream(t *testing.T) {
+	t.Run("Should return the analyzer of all images", func(t *testing.T) {
+		for _, image := range Values() {
+			upstream := image.GetUpstream()
+			assert.NotEmpty(t, upstream.Name, image.Tool)
+			assert.NotEmpty(t, upstream.Version, image.Tool)
+			assert.NotEmpty(t, upstream.License, image.Tool)
+			assert.NotEmpty(t, upstream.URL, image.Tool)
+		}
+	})
+
+	t.Run("Should return the version of the image when the analyzer is the image", func(t *testing.T) {
+		image := &Image{Tool: tools.Trivy, Name: "aquasec/trivy", Tag: "0.16.0"}
+		assert.Equal(t, "0.16.0", image.GetUpstream().Version)
+	})
+
+	t.Run("Should return the version installed in the image", func(t *testing.T) {
+		image := &Image{Tool: tools.SpotBugs, Name: "horuszup/spotbugs", Tag: "v1.0.0"}
+		assert.Equal(t, "4.1.2", image.GetUpstream().Version)
+	})
+}
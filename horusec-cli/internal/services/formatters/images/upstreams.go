@@ -0,0 +1,81 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package images
+
+import "github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+
+const (
+	// versionLatest is the version of the analyzers installed without a pinned version when the images are built
+	versionLatest     = "latest"
+	horusecEnginesURL = "https://github.com/ZupIT/horusec"
+)
+
+// Upstream is the third-party analyzer installed in the image of the tool. The license is a SPDX id, or the name of
+// the license when it has no SPDX id. Without version the analyzer has the version of the image
+type Upstream struct {
+	Name    string
+	Version string
+	License string
+	URL     string
+}
+
+func (i *Image) GetUpstream() Upstream {
+	upstream := upstreams[i.Tool]
+	if upstream.Version == "" {
+		upstream.Version = i.Tag
+	}
+	return upstream
+}
+
+var upstreams = map[tools.Tool]Upstream{
+	tools.GoSec: {Name: "gosec", Version: versionLatest, License: "Apache-2.0",
+		URL: "https://github.com/securego/gosec"},
+	tools.SecurityCodeScan: {Name: "security-code-scan", Version: versionLatest, License: "LGPL-3.0-only",
+		URL: "https://github.com/security-code-scan/security-code-scan"},
+	tools.Brakeman: {Name: "brakeman", Version: versionLatest, License: "Brakeman Public Use License",
+		URL: "https://github.com/presidentbeef/brakeman"},
+	tools.Safety: {Name: "safety", Version: versionLatest, License: "MIT",
+		URL: "https://github.com/pyupio/safety"},
+	tools.Bandit: {Name: "bandit", Version: versionLatest, License: "Apache-2.0",
+		URL: "https://github.com/PyCQA/bandit"},
+	tools.NpmAudit: {Name: "npm", Version: versionLatest, License: "Artistic-2.0",
+		URL: "https://github.com/npm/cli"},
+	tools.YarnAudit: {Name: "yarn", Version: versionLatest, License: "BSD-2-Clause",
+		URL: "https://github.com/yarnpkg/yarn"},
+	tools.SpotBugs: {Name: "spotbugs", Version: "4.1.2", License: "LGPL-2.1-only",
+		URL: "https://github.com/spotbugs/spotbugs"},
+	tools.HorusecKotlin: {Name: "horusec-kotlin", License: "Apache-2.0", URL: horusecEnginesURL},
+	tools.HorusecJava:   {Name: "horusec-java", License: "Apache-2.0", URL: horusecEnginesURL},
+	tools.HorusecLeaks:  {Name: "horusec-leaks", License: "Apache-2.0", URL: horusecEnginesURL},
+	tools.GitLeaks: {Name: "gitleaks", Version: versionLatest, License: "MIT",
+		URL: "https://github.com/zricethezav/gitleaks"},
+	tools.TfSec: {Name: "tfsec", Version: versionLatest, License: "MIT",
+		URL: "https://github.com/aquasecurity/tfsec"},
+	tools.Semgrep: {Name: "semgrep", Version: versionLatest, License: "LGPL-2.1-only",
+		URL: "https://github.com/returntocorp/semgrep"},
+	tools.HorusecCsharp:     {Name: "horusec-csharp", License: "Apache-2.0", URL: horusecEnginesURL},
+	tools.HorusecKubernetes: {Name: "horusec-kubernetes", License: "Apache-2.0", URL: horusecEnginesURL},
+	tools.Eslint: {Name: "eslint", Version: versionLatest, License: "MIT",
+		URL: "https://github.com/eslint/eslint"},
+	tools.HorusecNodejs: {Name: "horusec-nodejs", License: "Apache-2.0", URL: horusecEnginesURL},
+	tools.Flawfinder: {Name: "flawfinder", Version: versionLatest, License: "GPL-2.0-or-later",
+		URL: "https://github.com/david-a-wheeler/flawfinder"},
+	tools.PhpCS: {Name: "php_codesniffer", Version: versionLatest, License: "BSD-3-Clause",
+		URL: "https://github.com/squizlabs/PHP_CodeSniffer"},
+	tools.Trivy:   {Name: "trivy", License: "Apache-2.0", URL: "https://github.com/aquasecurity/trivy"},
+	tools.Checkov: {Name: "checkov", License: "Apache-2.0", URL: "https://github.com/bridgecrewio/checkov"},
+	tools.OsvScanner: {Name: "osv-scanner", Version: "1.9.2", License: "Apache-2.0",
+		URL: "https://github.com/google/osv-scanner"},
+}
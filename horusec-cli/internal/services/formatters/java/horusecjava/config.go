@@ -16,10 +16,10 @@ package horusecjava
 
 const (
 	ImageName = "horuszup/horusec-java"
-	ImageTag  = "v0.3.5"
+	ImageTag  = "v0.4.0"
 	ImageCmd  = `
 		{{WORK_DIR}}
-		horusec-java run -o="./output-ANALYSISID.json"
-		cat ./output-ANALYSISID.json
+		horusec-java run -o="/tmp/output-ANALYSISID.json"
+		cat /tmp/output-ANALYSISID.json
   `
 )
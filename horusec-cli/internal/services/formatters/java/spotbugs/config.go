@@ -15,6 +15,9 @@
 package spotbugs
 
 const (
+	// CachePath is where the cache volume of the tools config is mounted, with the folders of the maven repository
+	// and of the gradle home
+	CachePath = "/horusec-cache"
 	ImageName = "horuszup/spotbugs"
 	ImageTag  = "v1.0.1"
 	// nolint
@@ -23,6 +26,11 @@ const (
 		cp -r src tmp
 		cd tmp/src
 		{{WORK_DIR}}
+       if [ -d /horusec-cache ]; then
+           mkdir -p /horusec-cache/m2/repository /horusec-cache/gradle
+           export MAVEN_OPTS="$MAVEN_OPTS -Dmaven.repo.local=/horusec-cache/m2/repository"
+           export GRADLE_USER_HOME=/horusec-cache/gradle
+       fi
        if [ -f "pom.xml" ]; then
            project_type=$(cat pom.xml|grep packaging|cut -d'<' -f2|cut -d'>' -f2)
            bash /usr/local/bin/mvn-entrypoint.sh 2> /tmp/errorMavenBuild 1> /dev/null
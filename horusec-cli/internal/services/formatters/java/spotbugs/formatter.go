@@ -24,19 +24,13 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/analyser/java"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
-	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	xmlUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/xml"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
-)
-
-const (
-	highMaxSeverityValue   = 9
-	mediumMinSeverityValue = 10
-	mediumMaxSeverityValue = 14
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/sdk"
 )
 
 const (
@@ -44,6 +38,15 @@ const (
 	confidenceMedium = "2"
 )
 
+var container = &sdk.Container{
+	Tool:      tools.SpotBugs,
+	Language:  languages.Java,
+	ImageName: ImageName,
+	ImageTag:  ImageTag,
+	ImageCmd:  ImageCmd,
+	CachePath: CachePath,
+}
+
 type Formatter struct {
 	formatters.IService
 }
@@ -55,12 +58,13 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
+	defer f.RecoverFromPanic(tools.SpotBugs, projectSubPath)
 	if f.ToolIsToIgnore(tools.SpotBugs) {
 		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.SpotBugs.ToString(), logger.DebugLevel)
 		return
 	}
 	err := f.startSpotbugsAnalysis(projectSubPath)
-	f.SetLanguageIsFinished()
+	f.SetToolIsFinished(err, tools.SpotBugs, projectSubPath)
 	f.LogAnalysisError(err, tools.SpotBugs, projectSubPath)
 }
 
@@ -153,7 +157,9 @@ func (f *Formatter) setOutputInHorusecAnalysis(javaOutput *java.SpotBugsOutput)
 func (f *Formatter) setupVulnerabilitiesSeverities(
 	javaOutput *java.SpotBugsOutput, indexSpotBugsIssue, indexSourceLine int) (
 	vulnerabilitySeverity horusec.Vulnerability) {
-	vulnerabilitySeverity.Severity = f.parseSpotbugsRankToSeverity(javaOutput, indexSpotBugsIssue)
+	vulnerabilitySeverity.Severity = f.GetSeverityTables().Get(tools.SpotBugs).
+		GetSeverity("", javaOutput.SpotBugsIssue[indexSpotBugsIssue].Rank)
+	vulnerabilitySeverity.ToolSeverity = javaOutput.SpotBugsIssue[indexSpotBugsIssue].Rank
 	vulnerabilitySeverity.Details = javaOutput.SpotBugsIssue[indexSpotBugsIssue].Type
 	vulnerabilitySeverity.Code = f.getVulnerabilitiesSeveritiesCode(javaOutput, indexSpotBugsIssue, indexSourceLine)
 	vulnerabilitySeverity.Line = f.getVulnerabilitiesSeveritiesLine(javaOutput, indexSpotBugsIssue, indexSourceLine)
@@ -176,19 +182,6 @@ func (f *Formatter) parseSpotbugsPriorityToConfidence(javaOutput *java.SpotBugsO
 	}
 }
 
-func (f *Formatter) parseSpotbugsRankToSeverity(
-	javaOutput *java.SpotBugsOutput, indexSpotBugsIssue int) severity.Severity {
-	rank, _ := strconv.Atoi(javaOutput.SpotBugsIssue[indexSpotBugsIssue].Rank)
-	switch {
-	case rank <= highMaxSeverityValue:
-		return severity.High
-	case rank >= mediumMinSeverityValue && rank <= mediumMaxSeverityValue:
-		return severity.Medium
-	default:
-		return severity.Low
-	}
-}
-
 func (f *Formatter) getVulnerabilitiesSeveritiesLine(
 	javaOutput *java.SpotBugsOutput, indexSpotBugsIssue, indexSourceLine int) string {
 	return javaOutput.SpotBugsIssue[indexSpotBugsIssue].SourceLine[indexSourceLine].Start
@@ -222,12 +215,7 @@ func (f *Formatter) isJavaOutput(fileName string) bool {
 }
 
 func (f *Formatter) getImageTagCmd(projectSubPath string) *dockerEntities.AnalysisData {
-	ad := &dockerEntities.AnalysisData{
-		CMD:      f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.SpotBugs),
-		Language: languages.Java,
-	}
-	ad.SetFullImagePath(f.GetToolsConfig()[tools.SpotBugs].ImagePath, ImageName, ImageTag)
-	return ad
+	return sdk.NewAnalysisData(f, container, projectSubPath)
 }
 
 func (f *Formatter) verifyOutputErrors(output string) (err error) {
@@ -20,6 +20,8 @@ import (
 	"time"
 
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
@@ -617,3 +619,26 @@ func TestJava_StartAnalysis(t *testing.T) {
 		formatter.StartAnalysis("")
 	})
 }
+
+func TestJava_GetImageTagCmd(t *testing.T) {
+	t.Run("Should mount the cache volume of the tools config in the cache of maven and gradle", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{SpotBugs: toolsconfig.ToolConfig{CacheVolume: "horusec-m2"}})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, config, &horusec.Monitor{})
+
+		data := (&Formatter{service}).getImageTagCmd("")
+
+		assert.Equal(t, []dockerEntities.Mount{{Source: "horusec-m2", Target: CachePath, IsVolume: true}}, data.Mounts)
+		assert.Contains(t, data.CMD, "-Dmaven.repo.local=/horusec-cache/m2/repository")
+		assert.Contains(t, data.CMD, "GRADLE_USER_HOME=/horusec-cache/gradle")
+	})
+
+	t.Run("Should not mount a volume when the tools config has no cache volume", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, config, &horusec.Monitor{})
+
+		assert.Empty(t, (&Formatter{service}).getImageTagCmd("").Mounts)
+	})
+}
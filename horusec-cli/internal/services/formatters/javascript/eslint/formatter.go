@@ -16,6 +16,7 @@ package eslint
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/analyser/eslint"
@@ -42,6 +43,7 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
+	defer f.RecoverFromPanic(tools.Eslint, projectSubPath)
 	if f.ToolIsToIgnore(tools.Eslint) {
 		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.Eslint.ToString(), logger.DebugLevel)
 		return
@@ -50,7 +52,7 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 	err := f.executeDockerContainer(projectSubPath)
 	f.LogAnalysisError(err, tools.Eslint, projectSubPath)
 
-	f.SetLanguageIsFinished()
+	f.SetToolIsFinished(err, tools.Eslint, projectSubPath)
 }
 
 func (f *Formatter) executeDockerContainer(projectSubPath string) error {
@@ -70,8 +72,10 @@ func (f *Formatter) executeDockerContainer(projectSubPath string) error {
 
 func (f *Formatter) getDockerConfig(projectSubPath string) *dockerEntities.AnalysisData {
 	ad := &dockerEntities.AnalysisData{
-		CMD:      f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.Eslint),
-		Language: languages.Javascript,
+		CMD:            f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.Eslint),
+		Language:       languages.Javascript,
+		Tool:           tools.Eslint,
+		ProjectSubPath: projectSubPath,
 	}
 	ad.SetFullImagePath(f.GetToolsConfig()[tools.Eslint].ImagePath, ImageName, ImageTag)
 	return ad
@@ -120,8 +124,10 @@ func (f *Formatter) parseOutputToVuln(filePath, source string, message eslint.Me
 		Language:     languages.Javascript,
 		SecurityTool: tools.Eslint,
 		Details:      message.Message,
+		RuleID:       message.RuleID,
 		Code:         f.getCode(source, message.Line, message.EndLine, message.Column),
 		Severity:     severity.Low,
+		ToolSeverity: strconv.Itoa(message.Severity),
 	}
 }
 
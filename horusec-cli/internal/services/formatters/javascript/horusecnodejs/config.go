@@ -16,10 +16,10 @@ package horusecnodejs
 
 const (
 	ImageName = "horuszup/horusec-nodejs"
-	ImageTag  = "v1.0.0"
+	ImageTag  = "v1.1.0"
 	ImageCmd  = `
 		{{WORK_DIR}}
-		horusec-nodejs run -o="./output-ANALYSISID.json"
-		cat ./output-ANALYSISID.json
+		horusec-nodejs run -o="/tmp/output-ANALYSISID.json"
+		cat /tmp/output-ANALYSISID.json
   `
 )
@@ -16,7 +16,6 @@ package npmaudit
 
 import (
 	"bufio"
-	"encoding/json"
 	"errors"
 	"fmt"
 	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
@@ -29,6 +28,7 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	fileUtil "github.com/ZupIT/horusec/development-kit/pkg/utils/file"
+	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
@@ -46,13 +46,14 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
+	defer f.RecoverFromPanic(tools.NpmAudit, projectSubPath)
 	if f.ToolIsToIgnore(tools.NpmAudit) {
 		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.NpmAudit.ToString(), logger.DebugLevel)
 		return
 	}
 	err := f.startNpmAuditAnalysis(projectSubPath)
 	f.LogAnalysisError(err, tools.NpmAudit, projectSubPath)
-	f.SetLanguageIsFinished()
+	f.SetToolIsFinished(err, tools.NpmAudit, projectSubPath)
 }
 
 func (f *Formatter) startNpmAuditAnalysis(projectSubPath string) error {
@@ -91,7 +92,7 @@ func (f *Formatter) newContainerOutputFromString(containerOutput string) (output
 		return &npm.Output{}, nil
 	}
 
-	err = json.Unmarshal([]byte(containerOutput), &output)
+	err = jsonUtils.ConvertStringToOutput(containerOutput, &output)
 	if err != nil {
 		logger.LogErrorWithLevel(f.GetAnalysisIDErrorMessage(tools.NpmAudit, containerOutput), err, logger.ErrorLevel)
 	}
@@ -101,10 +102,13 @@ func (f *Formatter) newContainerOutputFromString(containerOutput string) (output
 
 func (f *Formatter) setVulnerabilitySeverityData(output *npm.Issue) (data *horusec.Vulnerability) {
 	data = f.getDefaultVulnerabilitySeverity()
-	data.Severity = output.GetSeverity()
+	data.Severity = f.GetSeverityTables().Get(tools.NpmAudit).GetSeverity("", output.Severity)
+	data.ToolSeverity = output.Severity
 	data.Details = output.Overview
 	data.Code = output.ModuleName
 	data.Line = f.getVulnerabilityLineByName(fmt.Sprintf(`"version": "%s"`, output.GetVersion()), data.Code, data.File)
+	data.Dependency = &horusec.Dependency{Name: output.ModuleName, Version: output.GetVersion(),
+		FixedVersion: output.GetFixedVersion()}
 	data = vulnhash.Bind(data)
 	return f.setCommitAuthor(data)
 }
@@ -185,8 +189,10 @@ func isModuleInScannerText(isFoundModule bool, module, scannerText string) bool
 
 func (f *Formatter) getConfigDataNpm(projectSubPath string) *dockerEntities.AnalysisData {
 	ad := &dockerEntities.AnalysisData{
-		CMD:      f.getConfigCMD(projectSubPath),
-		Language: languages.Javascript,
+		CMD:            f.getConfigCMD(projectSubPath),
+		Language:       languages.Javascript,
+		Tool:           tools.NpmAudit,
+		ProjectSubPath: projectSubPath,
 	}
 	ad.SetFullImagePath(f.GetToolsConfig()[tools.NpmAudit].ImagePath, ImageName, ImageTag)
 	return ad
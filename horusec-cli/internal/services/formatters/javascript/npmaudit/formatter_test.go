@@ -45,6 +45,7 @@ func TestStartNpmAudit(t *testing.T) {
 
 		formatter.StartAnalysis("")
 		assert.Equal(t, 1, len(analysis.AnalysisVulnerabilities))
+		assert.Equal(t, "high", analysis.AnalysisVulnerabilities[0].Vulnerability.ToolSeverity)
 	})
 	t.Run("Should parse output with no errors", func(t *testing.T) {
 		analysis := &horusec.Analysis{}
@@ -16,7 +16,6 @@ package yarnaudit
 
 import (
 	"bufio"
-	"encoding/json"
 	"errors"
 	"fmt"
 	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
@@ -29,6 +28,7 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	fileUtil "github.com/ZupIT/horusec/development-kit/pkg/utils/file"
+	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
@@ -47,13 +47,14 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
+	defer f.RecoverFromPanic(tools.YarnAudit, projectSubPath)
 	if f.ToolIsToIgnore(tools.YarnAudit) {
 		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.YarnAudit.ToString(), logger.DebugLevel)
 		return
 	}
 	err := f.startYarnAuditAnalysis(projectSubPath)
 	f.LogAnalysisError(err, tools.YarnAudit, projectSubPath)
-	f.SetLanguageIsFinished()
+	f.SetToolIsFinished(err, tools.YarnAudit, projectSubPath)
 }
 
 func (f *Formatter) startYarnAuditAnalysis(projectSubPath string) error {
@@ -89,7 +90,7 @@ func (f *Formatter) newContainerOutputFromString(containerOutput string) (output
 			map[string]interface{}{"tool": tools.YarnAudit.ToString()})
 		return &yarn.Output{}, nil
 	}
-	if err = json.Unmarshal([]byte(containerOutput), &output); err != nil {
+	if err = jsonUtils.ConvertStringToOutput(containerOutput, &output); err != nil {
 		logger.LogErrorWithLevel(f.GetAnalysisIDErrorMessage(tools.YarnAudit, containerOutput),
 			err, logger.ErrorLevel)
 	}
@@ -99,10 +100,13 @@ func (f *Formatter) newContainerOutputFromString(containerOutput string) (output
 
 func (f *Formatter) setVulnerabilitySeverityData(output *yarn.Issue) *horusec.Vulnerability {
 	data := f.getDefaultVulnerabilitySeverity()
-	data.Severity = output.GetSeverity()
+	data.Severity = f.GetSeverityTables().Get(tools.YarnAudit).GetSeverity("", output.Severity)
+	data.ToolSeverity = output.Severity
 	data.Details = output.Overview
 	data.Code = output.ModuleName
 	data.Line = f.getVulnerabilityLineByName(data.Code, output.GetVersion(), data.File)
+	data.Dependency = &horusec.Dependency{Name: output.ModuleName, Version: output.GetVersion(),
+		FixedVersion: output.GetFixedVersion()}
 	data = vulnhash.Bind(data)
 	return f.setCommitAuthor(data)
 }
@@ -213,8 +217,10 @@ func (f *Formatter) mapPossibleExistingNames(module, version string) []string {
 
 func (f *Formatter) getConfigDataYarn(projectSubPath string) *dockerEntities.AnalysisData {
 	ad := &dockerEntities.AnalysisData{
-		CMD:      f.getConfigCMD(projectSubPath),
-		Language: languages.Javascript,
+		CMD:            f.getConfigCMD(projectSubPath),
+		Language:       languages.Javascript,
+		Tool:           tools.YarnAudit,
+		ProjectSubPath: projectSubPath,
 	}
 	ad.SetFullImagePath(f.GetToolsConfig()[tools.NpmAudit].ImagePath, npmaudit.ImageName, npmaudit.ImageTag)
 	return ad
@@ -16,10 +16,10 @@ package horuseckotlin
 
 const (
 	ImageName = "horuszup/horusec-kotlin"
-	ImageTag  = "v0.3.2"
+	ImageTag  = "v0.4.0"
 	ImageCmd  = `
 		{{WORK_DIR}}
-		horusec-kotlin run -o="./output-ANALYSISID.json"
-		cat ./output-ANALYSISID.json
+		horusec-kotlin run -o="/tmp/output-ANALYSISID.json"
+		cat /tmp/output-ANALYSISID.json
   `
 )
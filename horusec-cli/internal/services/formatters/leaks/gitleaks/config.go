@@ -21,7 +21,10 @@ const (
 	ImageCmd = `
 		{{WORK_DIR}}
         touch /tmp/results-ANALYSISID.json
-        gitleaks --config="/rules/rules.toml" --owner-path=. --verbose --pretty --report="/tmp/results-ANALYSISID.json" &> /tmp/errorGitleaks-ANALYSISID
+        GITLEAKS_CONFIG={{CONFIG}}
+        if [ -z "$GITLEAKS_CONFIG" ] && [ -f .gitleaks.toml ]; then GITLEAKS_CONFIG=.gitleaks.toml; fi
+        if [ -z "$GITLEAKS_CONFIG" ]; then GITLEAKS_CONFIG=/rules/rules.toml; fi
+        gitleaks --config="$GITLEAKS_CONFIG" --owner-path=. --verbose --pretty --report="/tmp/results-ANALYSISID.json" &> /tmp/errorGitleaks-ANALYSISID
         if [ $? -eq 2 ]; then
             echo 'ERROR_RUNNING_GITLEAKS'
             cat /tmp/errorGitleaks-ANALYSISID
@@ -25,11 +25,19 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
-	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/sdk"
 )
 
+var container = &sdk.Container{
+	Tool:      tools.GitLeaks,
+	Language:  languages.Leaks,
+	ImageName: ImageName,
+	ImageTag:  ImageTag,
+	ImageCmd:  ImageCmd,
+}
+
 type Formatter struct {
 	formatters.IService
 }
@@ -41,25 +49,15 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
-	if f.ToolIsToIgnore(tools.GitLeaks) {
-		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.GitLeaks.ToString(), logger.DebugLevel)
-		return
-	}
-	err := f.startGitLeaksAnalysis(projectSubPath)
-	f.SetLanguageIsFinished()
-	f.LogAnalysisError(err, tools.GitLeaks, projectSubPath)
+	sdk.Run(f, tools.GitLeaks, projectSubPath, f.startGitLeaksAnalysis)
 }
 
 func (f *Formatter) startGitLeaksAnalysis(projectSubPath string) error {
-	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.GitLeaks)
-
-	output, err := f.ExecuteContainer(f.gitLeaksImageTagCmd(projectSubPath))
+	output, err := sdk.Execute(f, sdk.NewAnalysisData(f, container, projectSubPath))
 	if err != nil {
-		f.SetAnalysisError(err)
 		return err
 	}
 
-	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.GitLeaks)
 	return f.formatOutputGitLeaks(output)
 }
 
@@ -123,15 +121,6 @@ func (f *Formatter) setCommitAuthor(vulnerability *horusec.Vulnerability, issue
 	return vulnerability
 }
 
-func (f *Formatter) gitLeaksImageTagCmd(projectSubPath string) *dockerEntities.AnalysisData {
-	ad := &dockerEntities.AnalysisData{
-		CMD:      f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.GitLeaks),
-		Language: languages.Leaks,
-	}
-	ad.SetFullImagePath(f.GetToolsConfig()[tools.GitLeaks].ImagePath, ImageName, ImageTag)
-	return ad
-}
-
 func (f *Formatter) getDefaultSeverity() *horusec.Vulnerability {
 	vulnerabilitySeverity := &horusec.Vulnerability{}
 	vulnerabilitySeverity.Language = languages.Leaks
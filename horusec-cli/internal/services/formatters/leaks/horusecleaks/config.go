@@ -16,10 +16,10 @@ package horusecleaks
 
 const (
 	ImageName = "horuszup/horusec-leaks"
-	ImageTag  = "v0.3.0"
+	ImageTag  = "v0.4.0"
 	ImageCmd  = `
 		{{WORK_DIR}}
-		horusec-leaks run -o="./output-ANALYSISID.json"
-		cat ./output-ANALYSISID.json
+		horusec-leaks run -o="/tmp/output-ANALYSISID.json" {{ENTROPY}} {{ARGS}}
+		cat /tmp/output-ANALYSISID.json
   `
 )
@@ -15,21 +15,25 @@
 package horusecleaks
 
 import (
-	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
+	"fmt"
 	"strconv"
+	"strings"
 
 	engine "github.com/ZupIT/horusec-engine"
-	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
-	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
-	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
-	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
-	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
-	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/sdk"
 )
 
+var container = &sdk.Container{
+	Tool:      tools.HorusecLeaks,
+	Language:  languages.Leaks,
+	ImageName: ImageName,
+	ImageTag:  ImageTag,
+	ImageCmd:  ImageCmd,
+}
+
 type Formatter struct {
 	formatters.IService
 }
@@ -41,99 +45,69 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
-	if f.ToolIsToIgnore(tools.HorusecLeaks) {
-		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.HorusecLeaks.ToString(), logger.DebugLevel)
-		return
-	}
-	err := f.startHorusecLeaksAnalysis(projectSubPath)
-	f.SetLanguageIsFinished()
-	f.LogAnalysisError(err, tools.HorusecLeaks, projectSubPath)
+	sdk.Run(f, tools.HorusecLeaks, projectSubPath, f.startHorusecLeaksAnalysis)
 }
 
 func (f *Formatter) startHorusecLeaksAnalysis(projectSubPath string) error {
-	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.HorusecLeaks)
-
-	output, err := f.ExecuteContainer(f.getImageTagCmd(projectSubPath))
+	output, err := sdk.Execute(f, sdk.NewAnalysisData(f, f.getContainer(), projectSubPath))
 	if err != nil {
-		f.SetAnalysisError(err)
 		return err
 	}
-	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.HorusecLeaks)
 	return f.formatOutput(output)
 }
 
-func (f *Formatter) getImageTagCmd(projectSubPath string) *dockerEntities.AnalysisData {
-	ad := &dockerEntities.AnalysisData{
-		CMD:      f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.HorusecLeaks),
-		Language: languages.Leaks,
-	}
-	ad.SetFullImagePath(f.GetToolsConfig()[tools.HorusecLeaks].ImagePath, ImageName, ImageTag)
-	return ad
+// getContainer adds the flags of the entropy to the command only when it is enabled, the images of horusec-leaks
+// before v0.4.0 don't have them
+func (f *Formatter) getContainer() *sdk.Container {
+	withEntropy := *container
+	withEntropy.ImageCmd = strings.ReplaceAll(container.ImageCmd, "{{ENTROPY}}", f.getEntropyFlags())
+	return &withEntropy
 }
 
-func (f *Formatter) formatOutput(output string) error {
-	var reportOutput []engine.Finding
-	if output == "" || output == "null" {
-		logger.LogDebugWithLevel(messages.MsgDebugOutputEmpty, logger.DebugLevel,
-			map[string]interface{}{"tool": tools.HorusecLeaks.ToString()})
-
-		return f.setOutputInHorusecAnalysis(reportOutput)
+func (f *Formatter) getEntropyFlags() string {
+	if !f.GetEnableLeaksEntropy() {
+		return ""
 	}
-	leaksOutput, err := f.convertOutputAndValidate(output, &reportOutput)
-	if err != nil {
-		return err
+	flags := append([]string{"--entropy=true"}, f.getEntropyThresholdFlags()...)
+	for _, pattern := range f.GetLeaksEntropyAllowlistPatterns() {
+		flags = append(flags, "--entropy-allowlist-pattern="+f.toSliceFlagValue(pattern))
 	}
-	return f.setOutputInHorusecAnalysis(leaksOutput)
-}
-
-func (f *Formatter) convertOutputAndValidate(
-	output string, reportOutput *[]engine.Finding) ([]engine.Finding, error) {
-	if err := jsonUtils.ConvertStringToOutput(output, reportOutput); err != nil {
-		logger.LogErrorWithLevel(f.GetAnalysisIDErrorMessage(tools.HorusecLeaks, output), err, logger.ErrorLevel)
-		return *reportOutput, err
+	for _, path := range f.GetLeaksEntropyAllowlistPaths() {
+		flags = append(flags, "--entropy-allowlist-path="+f.toSliceFlagValue(path))
 	}
-	return *reportOutput, nil
+	return sdk.QuoteArgs(flags)
 }
 
-func (f *Formatter) setOutputInHorusecAnalysis(reportOutput []engine.Finding) error {
-	for index := range reportOutput {
-		vulnerability := f.setupVulnerabilitiesSeverities(reportOutput, index)
-		vulnerability = f.setupCommitAuthorInVulnerability(vulnerability)
-
-		// Set vulnerabilitySeverity.VulnHash value
-		vulnerability = vulnhash.Bind(vulnerability)
-
-		f.GetAnalysis().AnalysisVulnerabilities = append(f.GetAnalysis().AnalysisVulnerabilities,
-			horusec.AnalysisVulnerabilities{
-				Vulnerability: *vulnerability,
-			})
+// getEntropyThresholdFlags keeps the defaults of horusec-leaks for the values not informed
+func (f *Formatter) getEntropyThresholdFlags() (flags []string) {
+	if f.GetLeaksEntropyMinLength() > 0 {
+		flags = append(flags, fmt.Sprintf("--entropy-min-length=%d", f.GetLeaksEntropyMinLength()))
 	}
-	return nil
+	if f.GetLeaksEntropyBase64Threshold() > 0 {
+		flags = append(flags, "--entropy-base64-threshold="+
+			strconv.FormatFloat(f.GetLeaksEntropyBase64Threshold(), 'f', -1, 64))
+	}
+	if f.GetLeaksEntropyHexThreshold() > 0 {
+		flags = append(flags, "--entropy-hex-threshold="+
+			strconv.FormatFloat(f.GetLeaksEntropyHexThreshold(), 'f', -1, 64))
+	}
+	return flags
 }
 
-func (f *Formatter) setupVulnerabilitiesSeverities(reportOutput []engine.Finding,
-	index int) (vulnerabilitySeverity *horusec.Vulnerability) {
-	line := strconv.Itoa(reportOutput[index].SourceLocation.Line)
-	column := strconv.Itoa(reportOutput[index].SourceLocation.Column)
-	return &horusec.Vulnerability{
-		Line:         line,
-		Column:       column,
-		Confidence:   reportOutput[index].Confidence,
-		File:         f.RemoveSrcFolderFromPath(reportOutput[index].SourceLocation.Filename),
-		Code:         f.GetCodeWithMaxCharacters(reportOutput[index].CodeSample, reportOutput[index].SourceLocation.Column),
-		Details:      reportOutput[index].Name + "\n" + reportOutput[index].Description,
-		SecurityTool: tools.HorusecLeaks,
-		Language:     languages.Leaks,
-		Severity:     severity.ParseStringToSeverity(reportOutput[index].Severity),
+// toSliceFlagValue quotes the value as a csv field when needed, the slice flags of horusec-leaks split the values
+// by comma, like in the repetitions of the regular expressions
+func (f *Formatter) toSliceFlagValue(value string) string {
+	if !strings.ContainsAny(value, ",\"") {
+		return value
 	}
+	return `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
 }
 
-func (f *Formatter) setupCommitAuthorInVulnerability(vulnerability *horusec.Vulnerability) *horusec.Vulnerability {
-	commitAuthor := f.GetCommitAuthor(vulnerability.Line, vulnerability.File)
-	vulnerability.CommitAuthor = commitAuthor.Author
-	vulnerability.CommitEmail = commitAuthor.Email
-	vulnerability.CommitHash = commitAuthor.CommitHash
-	vulnerability.CommitMessage = commitAuthor.Message
-	vulnerability.CommitDate = commitAuthor.Date
-	return vulnerability
+func (f *Formatter) formatOutput(output string) error {
+	var findings []engine.Finding
+	if _, err := sdk.DecodeJSON(f, tools.HorusecLeaks, output, &findings); err != nil {
+		return err
+	}
+	sdk.AddEngineFindings(f, tools.HorusecLeaks, languages.Leaks, findings)
+	return nil
 }
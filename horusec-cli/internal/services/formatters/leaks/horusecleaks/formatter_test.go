@@ -137,4 +137,45 @@ func TestParseOutputHorusecJava(t *testing.T) {
 
 		formatter.StartAnalysis("")
 	})
+	t.Run("Should add the entropy flags to the command when the entropy is enabled", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.SetEnableLeaksEntropy(true)
+		config.SetLeaksEntropyAllowlistPatterns([]string{"^[a-f0-9]{40}$", "^EXAMPLE[0-9]{4,8}$"})
+		config.SetLeaksEntropyAllowlistPaths([]string{"fixtures/"})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, config, &horusec.Monitor{})
+
+		cmd := NewFormatter(service).(*Formatter).getContainer().ImageCmd
+
+		assert.Contains(t, cmd, `'--entropy=true' '--entropy-allowlist-pattern=^[a-f0-9]{40}$' `+
+			`'--entropy-allowlist-pattern="^EXAMPLE[0-9]{4,8}$"' '--entropy-allowlist-path=fixtures/'`)
+		assert.NotContains(t, cmd, "{{ENTROPY}}")
+		assert.Contains(t, container.ImageCmd, "{{ENTROPY}}")
+		assert.NotContains(t, cmd, "--entropy-min-length")
+	})
+	t.Run("Should add the entropy thresholds to the command when they are informed", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.SetEnableLeaksEntropy(true)
+		config.SetLeaksEntropyMinLength(24)
+		config.SetLeaksEntropyBase64Threshold(4.8)
+		config.SetLeaksEntropyHexThreshold(3)
+		service := formatters.NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, config, &horusec.Monitor{})
+
+		cmd := NewFormatter(service).(*Formatter).getContainer().ImageCmd
+
+		assert.Contains(t, cmd, `'--entropy=true' '--entropy-min-length=24' '--entropy-base64-threshold=4.8' `+
+			`'--entropy-hex-threshold=3'`)
+	})
+	t.Run("Should not add the entropy flags to the command when the entropy is disabled", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.SetLeaksEntropyAllowlistPaths([]string{"fixtures/"})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, config, &horusec.Monitor{})
+
+		cmd := NewFormatter(service).(*Formatter).getContainer().ImageCmd
+
+		assert.NotContains(t, cmd, "--entropy")
+		assert.NotContains(t, cmd, "{{ENTROPY}}")
+	})
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
@@ -40,13 +41,14 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
+	defer f.RecoverFromPanic(tools.PhpCS, projectSubPath)
 	if f.ToolIsToIgnore(tools.PhpCS) {
 		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.PhpCS.ToString(), logger.DebugLevel)
 		return
 	}
 
 	err := f.startPhpCs(projectSubPath)
-	f.SetLanguageIsFinished()
+	f.SetToolIsFinished(err, tools.PhpCS, projectSubPath)
 	f.LogAnalysisError(err, tools.PhpCS, projectSubPath)
 }
 
@@ -65,17 +67,25 @@ func (f *Formatter) startPhpCs(projectSubPath string) error {
 
 func (f *Formatter) getConfigData(projectSubPath string) *dockerEntities.AnalysisData {
 	ad := &dockerEntities.AnalysisData{
-		CMD:      f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.PhpCS),
-		Language: languages.PHP,
+		CMD:            f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.PhpCS),
+		Language:       languages.PHP,
+		Tool:           tools.PhpCS,
+		ProjectSubPath: projectSubPath,
 	}
 	ad.SetFullImagePath(f.GetToolsConfig()[tools.PhpCS].ImagePath, ImageName, ImageTag)
 	return ad
 }
 
 func (f *Formatter) parseOutput(output string) error {
+	if output == "" {
+		logger.LogDebugWithLevel(messages.MsgDebugOutputEmpty,
+			logger.DebugLevel, map[string]interface{}{"tool": tools.PhpCS.ToString()})
+		return nil
+	}
+
 	var results map[string]interface{}
 
-	if err := json.Unmarshal([]byte(output), &results); err != nil {
+	if err := jsonUtils.ConvertStringToOutput(output, &results); err != nil {
 		f.SetAnalysisError(fmt.Errorf("{HORUSEC_CLI} Error %s", output))
 		return err
 	}
@@ -111,6 +121,7 @@ func (f *Formatter) appendResults(filepath string, message phpEntities.Message)
 func (f *Formatter) setVulnerabilityData(filepath string, result phpEntities.Message) *horusec.Vulnerability {
 	vulnerability := f.getDefaultVulnerabilitySeverity()
 	vulnerability.Severity = severity.Info
+	vulnerability.ToolSeverity = result.Type
 	vulnerability.Details = result.Message
 	vulnerability.Line = result.GetLine()
 	vulnerability.Column = result.GetColumn()
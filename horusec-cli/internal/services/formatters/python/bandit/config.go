@@ -21,7 +21,8 @@ const (
 		{{WORK_DIR}}
       	chmod +x /usr/local/bin/horusec-file-ignore.sh
       	horusec-file-ignore.sh 2> /tmp/errorBanditIgnoreScript-ANALYSISID 1> /dev/null
-      	bandit -r . -f json 2> /dev/null > results-ANALYSISID.json
+      	if [ -f .bandit ]; then BANDIT_INI="--ini .bandit"; fi
+      	bandit -r . {{SCRIPTS}} -f json $BANDIT_INI {{ARGS}} 2> /dev/null > results-ANALYSISID.json
       	jq -j -M -c . results-ANALYSISID.json
 	  	chmod -R 777 .
   `
@@ -15,7 +15,7 @@
 package bandit
 
 import (
-	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -23,13 +23,21 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
-	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/sdk"
 )
 
+var container = &sdk.Container{
+	Tool:      tools.Bandit,
+	Language:  languages.Python,
+	ImageName: ImageName,
+	ImageTag:  ImageTag,
+	ImageCmd:  ImageCmd,
+}
+
 type Formatter struct {
 	formatters.IService
 }
@@ -41,66 +49,54 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
-	if f.ToolIsToIgnore(tools.Bandit) {
-		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.Bandit.ToString(), logger.DebugLevel)
-		return
-	}
-	err := f.startBanditAnalysis(projectSubPath)
-	f.LogAnalysisError(err, tools.Bandit, projectSubPath)
-	f.SetLanguageIsFinished()
+	sdk.Run(f, tools.Bandit, projectSubPath, f.startBanditAnalysis)
 }
 
 func (f *Formatter) startBanditAnalysis(projectSubPath string) error {
-	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.Bandit)
-
-	output, err := f.ExecuteContainer(f.getAnalysisData(projectSubPath))
+	output, err := sdk.Execute(f, f.getAnalysisData(projectSubPath))
 	if err != nil {
-		f.SetAnalysisError(err)
 		return err
 	}
 
 	f.parseOutput(output)
-	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.Bandit)
 	return nil
 }
 
 func (f *Formatter) getAnalysisData(projectSubPath string) *dockerEntities.AnalysisData {
-	ad := &dockerEntities.AnalysisData{
-		CMD:      f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.Bandit),
-		Language: languages.Python,
-	}
-	ad.SetFullImagePath(f.GetToolsConfig()[tools.Bandit].ImagePath, ImageName, ImageTag)
+	ad := sdk.NewAnalysisData(f, container, projectSubPath)
+	ad.CMD = strings.ReplaceAll(ad.CMD, "{{SCRIPTS}}", sdk.QuoteArgs(f.getScripts(projectSubPath)))
 	return ad
 }
 
-func (f *Formatter) parseOutput(output string) {
-	if output == "" {
-		logger.LogDebugWithLevel(messages.MsgDebugOutputEmpty,
-			logger.DebugLevel, map[string]interface{}{"tool": tools.Bandit.ToString()})
-		return
+// getScripts returns the python files detected by content without the extensions found by bandit -r, like the
+// scripts with a shebang, relative to the project sub path
+func (f *Formatter) getScripts(projectSubPath string) (scripts []string) {
+	for _, file := range f.GetFilesByLanguage(languages.Python) {
+		relativePath, err := filepath.Rel(filepath.FromSlash(projectSubPath), file)
+		if err != nil || strings.HasPrefix(relativePath, "..") {
+			continue
+		}
+		if extension := strings.ToLower(filepath.Ext(file)); extension != ".py" && extension != ".pyw" {
+			scripts = append(scripts, filepath.ToSlash(relativePath))
+		}
 	}
+	return scripts
+}
 
-	banditOutput, err := f.parseOutputToBanditOutput(output)
-	if err != nil {
+func (f *Formatter) parseOutput(output string) {
+	var banditOutput python.BanditOutput
+	if decoded, _ := sdk.DecodeJSON(f, tools.Bandit, output, &banditOutput); !decoded {
 		return
 	}
 
 	f.setBanditOutPutInHorusecAnalysis(banditOutput.Results)
 }
 
-func (f *Formatter) parseOutputToBanditOutput(output string) (banditOutput python.BanditOutput, err error) {
-	err = jsonUtils.ConvertStringToOutput(output, &banditOutput)
-	logger.LogErrorWithLevel(f.GetAnalysisIDErrorMessage(tools.Bandit, output), err, logger.ErrorLevel)
-	return banditOutput, err
-}
-
 func (f *Formatter) setBanditOutPutInHorusecAnalysis(issues []python.BanditResult) {
 	totalInformation := 0
 	for index := range issues {
 		if f.notSkipVulnerabilityBecauseIsInformation(issues, index) {
-			vulnerability := f.setupVulnerabilitiesSeveritiesBandit(issues, index)
-			f.GetAnalysis().AnalysisVulnerabilities = append(f.GetAnalysis().AnalysisVulnerabilities,
-				horusec.AnalysisVulnerabilities{Vulnerability: *vulnerability})
+			sdk.AddVulnerability(f, f.setupVulnerabilitiesSeveritiesBandit(issues, index))
 		} else {
 			totalInformation++
 		}
@@ -114,37 +110,16 @@ func (f *Formatter) setBanditOutPutInHorusecAnalysis(issues []python.BanditResul
 
 func (f *Formatter) setupVulnerabilitiesSeveritiesBandit(
 	issues []python.BanditResult, index int) *horusec.Vulnerability {
-	vulnerabilitySeverity := f.getDefaultVulnerabilitySeverity()
+	vulnerabilitySeverity := sdk.NewVulnerability(tools.Bandit, languages.Python)
+	vulnerabilitySeverity.Column = "0"
 	vulnerabilitySeverity.Severity = issues[index].IssueSeverity
+	vulnerabilitySeverity.ToolSeverity = issues[index].IssueSeverity.ToString()
 	vulnerabilitySeverity.Details = issues[index].IssueText
+	vulnerabilitySeverity.RuleID = issues[index].TestID
 	vulnerabilitySeverity.Code = f.GetCodeWithMaxCharacters(issues[index].Code, 0)
 	vulnerabilitySeverity.Line = strconv.Itoa(issues[index].LineNumber)
 	vulnerabilitySeverity.Confidence = issues[index].IssueConfidence
 	vulnerabilitySeverity.File = issues[index].GetFile()
-
-	// Set vulnerabilitySeverity.VulnHash value
-	vulnerabilitySeverity = vulnhash.Bind(vulnerabilitySeverity)
-
-	return f.setCommitAuthor(vulnerabilitySeverity)
-}
-
-func (f *Formatter) setCommitAuthor(vulnerability *horusec.Vulnerability) *horusec.Vulnerability {
-	commitAuthor := f.GetCommitAuthor(vulnerability.Line, vulnerability.File)
-
-	vulnerability.CommitAuthor = commitAuthor.Author
-	vulnerability.CommitHash = commitAuthor.CommitHash
-	vulnerability.CommitDate = commitAuthor.Date
-	vulnerability.CommitEmail = commitAuthor.Email
-	vulnerability.CommitMessage = commitAuthor.Message
-
-	return vulnerability
-}
-
-func (f *Formatter) getDefaultVulnerabilitySeverity() *horusec.Vulnerability {
-	vulnerabilitySeverity := &horusec.Vulnerability{}
-	vulnerabilitySeverity.Language = languages.Python
-	vulnerabilitySeverity.SecurityTool = tools.Bandit
-	vulnerabilitySeverity.Column = "0"
 	return vulnerabilitySeverity
 }
 
@@ -16,11 +16,13 @@ package bandit
 
 import (
 	"errors"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
@@ -159,3 +161,32 @@ func TestFormatter_StartSafety(t *testing.T) {
 		formatter.StartAnalysis("")
 	})
 }
+
+func TestFormatter_getAnalysisData(t *testing.T) {
+	t.Run("Should add the python scripts without extension detected by content to bandit", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		service := formatters.NewFormatterService(getAnalysis(), &docker.Mock{}, config, &horusec.Monitor{})
+		service.SetFilesByLanguage(map[languages.Language][]string{languages.Python: {
+			filepath.Join("api", "main.py"), filepath.Join("api", "scripts", "deploy"), filepath.Join("cli", "run"),
+		}})
+
+		data := (&Formatter{service}).getAnalysisData("api")
+
+		assert.Contains(t, data.CMD, "cd api")
+		assert.Contains(t, data.CMD, "bandit -r . 'scripts/deploy' -f json")
+		assert.NotContains(t, data.CMD, "main.py")
+		assert.NotContains(t, data.CMD, "'run'")
+	})
+
+	t.Run("Should run bandit only in the python files when there are no scripts", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		service := formatters.NewFormatterService(getAnalysis(), &docker.Mock{}, config, &horusec.Monitor{})
+
+		data := (&Formatter{service}).getAnalysisData("")
+
+		assert.Contains(t, data.CMD, "bandit -r .  -f json")
+		assert.NotContains(t, data.CMD, "{{SCRIPTS}}")
+	})
+}
@@ -47,13 +47,14 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
+	defer f.RecoverFromPanic(tools.Safety, projectSubPath)
 	if f.ToolIsToIgnore(tools.Safety) {
 		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.Safety.ToString(), logger.DebugLevel)
 		return
 	}
 	err := f.startSafetyAnalysis(projectSubPath)
 	f.LogAnalysisError(err, tools.Safety, projectSubPath)
-	f.SetLanguageIsFinished()
+	f.SetToolIsFinished(err, tools.Safety, projectSubPath)
 }
 
 func (f *Formatter) startSafetyAnalysis(projectSubPath string) error {
@@ -74,7 +75,9 @@ func (f *Formatter) getAnalysisData(projectSubPath string) *dockerEntities.Analy
 	ad := &dockerEntities.AnalysisData{
 		CMD: f.AddWorkDirInCmd(ImageCmd,
 			fileUtil.GetSubPathByExtension(f.GetConfigProjectPath(), projectSubPath, "requirements.txt"), tools.Safety),
-		Language: languages.Python,
+		Language:       languages.Python,
+		Tool:           tools.Safety,
+		ProjectSubPath: projectSubPath,
 	}
 	ad.SetFullImagePath(f.GetToolsConfig()[tools.Safety].ImagePath, ImageName, ImageTag)
 	return ad
@@ -121,6 +124,8 @@ func (f *Formatter) setupVulnerabilitiesSeveritiesSafety(
 	vulnerabilitySeverity.Details = issues[index].Description
 	vulnerabilitySeverity.Code = f.GetCodeWithMaxCharacters(issues[index].Dependency, 0)
 	vulnerabilitySeverity.Line = f.getVulnerabilityLineByName(lineContent, vulnerabilitySeverity.File)
+	vulnerabilitySeverity.Dependency = &horusec.Dependency{Name: issues[index].Dependency,
+		Version: issues[index].InstalledVersion, FixedVersion: issues[index].GetFixedVersion()}
 
 	// Set vulnerabilitySeverity.VulnHash value
 	vulnerabilitySeverity = vulnhash.Bind(vulnerabilitySeverity)
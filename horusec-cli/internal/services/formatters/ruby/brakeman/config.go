@@ -19,8 +19,10 @@ const (
 	ImageTag  = "v1.0.0"
 	ImageCmd  = `
 		{{WORK_DIR}}
-		brakeman -q -o results-ANALYSISID.json .
-		jq -j -M -c . results-ANALYSISID.json
+		BRAKEMAN_IGNORE={{CONFIG}}
+		if [ -z "$BRAKEMAN_IGNORE" ] && [ -f config/brakeman.ignore ]; then BRAKEMAN_IGNORE=config/brakeman.ignore; fi
+		brakeman -q ${BRAKEMAN_IGNORE:+-i "$BRAKEMAN_IGNORE"} -o /tmp/results-ANALYSISID.json {{ARGS}} .
+		jq -j -M -c . /tmp/results-ANALYSISID.json
 	  	chmod -R 777 .
   `
 )
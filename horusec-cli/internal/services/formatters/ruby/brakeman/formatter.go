@@ -15,21 +15,27 @@
 package brakeman
 
 import (
-	"encoding/json"
-	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
 	"strings"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/analyser/ruby"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
-	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
 	errorsEnums "github.com/ZupIT/horusec/horusec-cli/internal/enums/errors"
-	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/sdk"
 )
 
+var container = &sdk.Container{
+	Tool:      tools.Brakeman,
+	Language:  languages.Ruby,
+	ImageName: ImageName,
+	ImageTag:  ImageTag,
+	ImageCmd:  ImageCmd,
+}
+
 type Formatter struct {
 	formatters.IService
 }
@@ -41,25 +47,15 @@ func NewFormatter(service formatters.IService) formatters.IFormatter {
 }
 
 func (f *Formatter) StartAnalysis(projectSubPath string) {
-	if f.ToolIsToIgnore(tools.Brakeman) {
-		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tools.Brakeman.ToString(), logger.DebugLevel)
-		return
-	}
-	err := f.startBrakemanAnalysis(projectSubPath)
-	f.SetLanguageIsFinished()
-	f.LogAnalysisError(err, tools.Brakeman, projectSubPath)
+	sdk.Run(f, tools.Brakeman, projectSubPath, f.startBrakemanAnalysis)
 }
 
 func (f *Formatter) startBrakemanAnalysis(projectSubPath string) error {
-	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.Brakeman)
-
-	output, err := f.ExecuteContainer(f.getConfigData(projectSubPath))
+	output, err := sdk.Execute(f, sdk.NewAnalysisData(f, container, projectSubPath))
 	if err != nil {
-		f.SetAnalysisError(err)
 		return err
 	}
 
-	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.Brakeman)
 	return f.parseOutput(output)
 }
 
@@ -73,7 +69,7 @@ func (f *Formatter) parseOutput(containerOutput string) error {
 	}
 	for _, warning := range outputs.Warnings {
 		value := warning
-		f.setAnalysisResults(f.setVulnerabilityData(&value))
+		sdk.AddVulnerability(f, f.setVulnerabilityData(&value))
 	}
 	return nil
 }
@@ -83,60 +79,21 @@ func (f *Formatter) newContainerOutputFromString(containerOutput string) (output
 		f.SetAnalysisError(errorsEnums.ErrNotFoundRailsProject)
 		return ruby.Output{}, errorsEnums.ErrNotFoundRailsProject
 	}
-	err = json.Unmarshal([]byte(containerOutput), &output)
+	err = jsonUtils.ConvertStringToOutput(containerOutput, &output)
 	logger.LogErrorWithLevel(f.GetAnalysisIDErrorMessage(tools.Brakeman, containerOutput),
 		err, logger.ErrorLevel)
 	return output, err
 }
 
 func (f *Formatter) setVulnerabilityData(output *ruby.Warning) *horusec.Vulnerability {
-	data := f.getDefaultVulnerabilitySeverity()
-	data.Severity = output.GetSeverity()
-	data.Confidence = output.GetSeverity().ToString()
+	data := sdk.NewVulnerability(tools.Brakeman, languages.Ruby)
+	data.Severity = f.GetSeverityTables().Get(tools.Brakeman).GetSeverity("", output.Confidence)
+	data.Confidence = output.GetConfidence()
 	data.Details = output.GetDetails()
 	data.Line = output.GetLine()
 	data.File = output.File
 	data.Code = f.GetCodeWithMaxCharacters(output.Code, 0)
-
-	// Set data.VulnHash value
-	data = vulnhash.Bind(data)
-
-	return f.setCommitAuthor(data)
-}
-
-func (f *Formatter) setCommitAuthor(vulnerability *horusec.Vulnerability) *horusec.Vulnerability {
-	commitAuthor := f.GetCommitAuthor(vulnerability.Line, vulnerability.File)
-
-	vulnerability.CommitAuthor = commitAuthor.Author
-	vulnerability.CommitHash = commitAuthor.CommitHash
-	vulnerability.CommitDate = commitAuthor.Date
-	vulnerability.CommitEmail = commitAuthor.Email
-	vulnerability.CommitMessage = commitAuthor.Message
-
-	return vulnerability
-}
-
-func (f *Formatter) getDefaultVulnerabilitySeverity() *horusec.Vulnerability {
-	vulnerabilitySeverity := &horusec.Vulnerability{}
-	vulnerabilitySeverity.SecurityTool = tools.Brakeman
-	vulnerabilitySeverity.Language = languages.Ruby
-	return vulnerabilitySeverity
-}
-
-func (f *Formatter) setAnalysisResults(vulnerability *horusec.Vulnerability) {
-	f.GetAnalysis().AnalysisVulnerabilities = append(f.GetAnalysis().AnalysisVulnerabilities,
-		horusec.AnalysisVulnerabilities{
-			Vulnerability: *vulnerability,
-		})
-}
-
-func (f *Formatter) getConfigData(projectSubPath string) *dockerEntities.AnalysisData {
-	ad := &dockerEntities.AnalysisData{
-		CMD:      f.AddWorkDirInCmd(ImageCmd, projectSubPath, tools.Brakeman),
-		Language: languages.Ruby,
-	}
-	ad.SetFullImagePath(f.GetToolsConfig()[tools.Bandit].ImagePath, ImageName, ImageTag)
-	return ad
+	return data
 }
 
 func (f *Formatter) isNotFoundRailsProject(output string) bool {
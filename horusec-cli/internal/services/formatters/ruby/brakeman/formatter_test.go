@@ -20,9 +20,11 @@ import (
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/sdk"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -140,3 +142,30 @@ func TestParseOutput(t *testing.T) {
 		formatter.StartAnalysis("")
 	})
 }
+
+func TestBrakeman_AnalysisData(t *testing.T) {
+	t.Run("Should add the args and the ignore file of the tools config", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{
+			Brakeman: toolsconfig.ToolConfig{Args: []string{"-w2"}, ConfigPath: "/home/user/brakeman.ignore"}})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, config, &horusec.Monitor{})
+
+		data := sdk.NewAnalysisData(service, container, "")
+
+		assert.Contains(t, data.CMD, "BRAKEMAN_IGNORE='/horusec-tool-config/brakeman.ignore'")
+		assert.Contains(t, data.CMD, "-o /tmp/results-ANALYSISID.json '-w2' .")
+		assert.Len(t, data.Mounts, 1)
+	})
+
+	t.Run("Should use the ignore file of the project when the tools config has no config", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, config, &horusec.Monitor{})
+
+		data := sdk.NewAnalysisData(service, container, "")
+
+		assert.Contains(t, data.CMD, "BRAKEMAN_IGNORE=config/brakeman.ignore")
+		assert.Empty(t, data.Mounts)
+	})
+}
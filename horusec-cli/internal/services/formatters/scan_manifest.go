@@ -0,0 +1,179 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/version"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/rulepacks"
+)
+
+const (
+	ReasonIgnoredInToolsConfig   = "ignored in the tools config"
+	ReasonIgnoredInToolsToIgnore = "ignored in the tools to ignore"
+	ReasonDryRun                 = "dry run, the container was not started"
+	ReasonGitHistoryDisabled     = "the analysis of the git history is disabled"
+	ReasonTfPlanInformed         = "the terraform plan is analyzed instead of the terraform files"
+	ReasonTfPlanNotInformed      = "the terraform plan was not informed"
+	ReasonImageScanOnly          = "runs only in the command image scan"
+	ReasonLanguageNotFound       = "no files of its languages were found in the project"
+	// Status of the tools that were running when the timeout of the analysis was reached
+	ToolStatusTimeout = "timeout"
+)
+
+type toolExecution struct {
+	execution  horusec.ToolExecution
+	startedAt  time.Time
+	isFinished bool
+	// outputSnippet is the beginning of the output, kept to show when the parsing of the output panics
+	outputSnippet string
+}
+
+// startToolExecution is called before the container of the tool is started, so the duration includes the pull
+func (s *Service) startToolExecution(data *dockerEntities.AnalysisData) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	current := s.getToolExecution(data.Tool, data.ProjectSubPath)
+	current.startedAt = time.Now()
+	current.execution.Image = data.ImagePath
+	current.execution.RulesVersion = s.getRulesVersion(data.Tool, data.ImagePath)
+}
+
+func (s *Service) setToolOutput(data *dockerEntities.AnalysisData, rawOutputPath, output string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	current := s.getToolExecution(data.Tool, data.ProjectSubPath)
+	current.execution.RawOutputPath = rawOutputPath
+	if len(output) > maxOutputLengthInErrorMessage {
+		// copy of the beginning, so the whole output isn't kept in memory
+		output = string([]byte(output[:maxOutputLengthInErrorMessage]))
+	}
+	current.outputSnippet = output
+}
+
+func (s *Service) getOutputSnippet(tool tools.Tool, projectSubPath string) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.getToolExecution(tool, projectSubPath).outputSnippet
+}
+
+func (s *Service) isToolFinished(tool tools.Tool, projectSubPath string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.getToolExecution(tool, projectSubPath).isFinished
+}
+
+// finishToolExecution is called even for the tools that run without container, these have no image and duration
+func (s *Service) finishToolExecution(err error, tool tools.Tool, projectSubPath string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.toolsSkipped[tool] == ReasonDryRun {
+		return
+	}
+	current := s.getToolExecution(tool, projectSubPath)
+	current.isFinished = true
+	current.execution.Status = horusec.ToolStatusSuccess
+	if err != nil {
+		current.execution.Status = horusec.ToolStatusFailed
+	}
+	if !current.startedAt.IsZero() {
+		current.execution.DurationInSeconds = time.Since(current.startedAt).Seconds()
+	}
+}
+
+func (s *Service) getToolExecution(tool tools.Tool, projectSubPath string) *toolExecution {
+	for _, current := range s.toolsExecuted {
+		if current.execution.Tool == tool && current.execution.ProjectSubPath == projectSubPath {
+			return current
+		}
+	}
+	current := &toolExecution{execution: horusec.ToolExecution{Tool: tool, ProjectSubPath: projectSubPath}}
+	s.toolsExecuted = append(s.toolsExecuted, current)
+	return current
+}
+
+func (s *Service) addToolSkipped(tool tools.Tool, reason string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.toolsSkipped == nil {
+		s.toolsSkipped = map[tools.Tool]string{}
+	}
+	s.toolsSkipped[tool] = reason
+}
+
+// GetScanManifest returns the tools executed until now, the tools not finished are in timeout, and the reason of
+// the others tools didn't run. The digests of the images are added by the analyser
+func (s *Service) GetScanManifest() *horusec.ScanManifest {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	manifest := &horusec.ScanManifest{HorusecVersion: version.Version, ToolsExecuted: []horusec.ToolExecution{},
+		ToolsSkipped: []horusec.ToolSkipped{}}
+	executed := map[tools.Tool]bool{}
+	for _, current := range s.toolsExecuted {
+		execution := current.execution
+		if !current.isFinished {
+			execution.Status = ToolStatusTimeout
+			execution.DurationInSeconds = time.Since(current.startedAt).Seconds()
+		}
+		manifest.ToolsExecuted = append(manifest.ToolsExecuted, execution)
+		executed[execution.Tool] = true
+	}
+	for _, tool := range tools.Values() {
+		if !executed[tool] {
+			manifest.ToolsSkipped = append(manifest.ToolsSkipped, horusec.ToolSkipped{Tool: tool,
+				Reason: s.getReasonSkipped(tool)})
+		}
+	}
+	return manifest.Sort()
+}
+
+func (s *Service) getReasonSkipped(tool tools.Tool) string {
+	if reason, ok := s.toolsSkipped[tool]; ok {
+		return reason
+	}
+	switch {
+	case tool == tools.GitLeaks && !s.config.GetEnableGitHistoryAnalysis():
+		return ReasonGitHistoryDisabled
+	case tool == tools.TfSec && s.config.GetTfPlanPath() != "":
+		return ReasonTfPlanInformed
+	case tool == tools.Checkov && s.config.GetTfPlanPath() == "":
+		return ReasonTfPlanNotInformed
+	case tool == tools.Trivy:
+		return ReasonImageScanOnly
+	}
+	return ReasonLanguageNotFound
+}
+
+// getRulesVersion returns the rule packs selected to the horusec engines, without packs selected returns the tag of
+// the image of the engine, because the builtin rules are built in the image
+func (s *Service) getRulesVersion(tool tools.Tool, imagePath string) string {
+	if references := rulepacks.GetToolRulePacks(s.config, tool); len(references) > 0 {
+		return strings.Join(references, ",")
+	}
+	return getImageRulesVersion(tool, imagePath)
+}
+
+func getImageRulesVersion(tool tools.Tool, imagePath string) string {
+	index := strings.LastIndex(imagePath, ":")
+	if !strings.HasPrefix(tool.ToString(), "Horusec") || index < 0 || strings.Contains(imagePath[index:], "/") {
+		return ""
+	}
+	return imagePath[index+1:]
+}
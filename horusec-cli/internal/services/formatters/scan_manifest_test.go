@@ -0,0 +1,125 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	"github.com/stretchr/testify/assert"
+)
+
+func getToolSkipped(manifest *horusec.ScanManifest, tool tools.Tool) *horusec.ToolSkipped {
+	for index := range manifest.ToolsSkipped {
+		if manifest.ToolsSkipped[index].Tool == tool {
+			return &manifest.ToolsSkipped[index]
+		}
+	}
+	return nil
+}
+
+func TestGetScanManifest(t *testing.T) {
+	t.Run("should return the tools executed with its image, status and duration", func(t *testing.T) {
+		dockerMock := &docker.Mock{}
+		dockerMock.On("CreateLanguageAnalysisContainer").Return("", nil)
+		service := NewFormatterService(&horusec.Analysis{}, dockerMock, &config.Config{}, horusec.NewMonitor())
+
+		_, _ = service.ExecuteContainer(&dockerEntities.AnalysisData{Tool: tools.HorusecJava, ProjectSubPath: "api",
+			ImagePath: "docker.io/horuszup/horusec-java:v1.0.0"})
+		service.SetToolIsFinished(nil, tools.HorusecJava, "api")
+		_, _ = service.ExecuteContainer(&dockerEntities.AnalysisData{Tool: tools.GoSec,
+			ImagePath: "docker.io/horuszup/gosec:v1.0.0"})
+		service.SetToolIsFinished(errors.New("test"), tools.GoSec, "")
+		service.SetToolIsFinished(nil, tools.HorusecDockerfile, "")
+
+		manifest := service.GetScanManifest()
+		assert.Len(t, manifest.ToolsExecuted, 3)
+		assert.Equal(t, tools.GoSec, manifest.ToolsExecuted[0].Tool)
+		assert.Equal(t, horusec.ToolStatusFailed, manifest.ToolsExecuted[0].Status)
+		assert.Empty(t, manifest.ToolsExecuted[0].RulesVersion)
+		assert.Equal(t, horusec.ToolExecution{Tool: tools.HorusecDockerfile, Status: horusec.ToolStatusSuccess},
+			manifest.ToolsExecuted[1])
+		assert.Equal(t, "api", manifest.ToolsExecuted[2].ProjectSubPath)
+		assert.Equal(t, "docker.io/horuszup/horusec-java:v1.0.0", manifest.ToolsExecuted[2].Image)
+		assert.Equal(t, "v1.0.0", manifest.ToolsExecuted[2].RulesVersion)
+		assert.Equal(t, horusec.ToolStatusSuccess, manifest.ToolsExecuted[2].Status)
+		assert.Len(t, manifest.ToolsSkipped, len(tools.Values())-3)
+		assert.Nil(t, getToolSkipped(manifest, tools.GoSec))
+	})
+
+	t.Run("should return the rule packs selected as rules version", func(t *testing.T) {
+		configs := &config.Config{}
+		configs.SetRulePacks([]string{"java-core", "leaks"})
+		dockerMock := &docker.Mock{}
+		dockerMock.On("CreateLanguageAnalysisContainer").Return("", nil)
+		service := NewFormatterService(&horusec.Analysis{}, dockerMock, configs, horusec.NewMonitor())
+
+		_, _ = service.ExecuteContainer(&dockerEntities.AnalysisData{Tool: tools.HorusecJava,
+			ImagePath: "docker.io/horuszup/horusec-java:v1.0.0"})
+
+		assert.Equal(t, "java-core@1.0", service.GetScanManifest().ToolsExecuted[0].RulesVersion)
+	})
+
+	t.Run("should return the tools not finished as timeout", func(t *testing.T) {
+		dockerMock := &docker.Mock{}
+		dockerMock.On("CreateLanguageAnalysisContainer").Return("", nil)
+		service := NewFormatterService(&horusec.Analysis{}, dockerMock, &config.Config{}, horusec.NewMonitor())
+
+		_, _ = service.ExecuteContainer(&dockerEntities.AnalysisData{Tool: tools.Semgrep})
+
+		manifest := service.GetScanManifest()
+		assert.Equal(t, ToolStatusTimeout, manifest.ToolsExecuted[0].Status)
+	})
+
+	t.Run("should return the reason of the tools skipped", func(t *testing.T) {
+		configs := &config.Config{}
+		configs.SetToolsToIgnore([]string{"GoSec"})
+		configs.SetToolsConfig(map[string]interface{}{"bandit": map[string]interface{}{"istoignore": true}})
+		configs.SetTfPlanPath("./plan.json")
+		service := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, configs, horusec.NewMonitor())
+
+		assert.True(t, service.ToolIsToIgnore(tools.GoSec))
+		assert.True(t, service.ToolIsToIgnore(tools.Bandit))
+
+		manifest := service.GetScanManifest()
+		assert.Empty(t, manifest.ToolsExecuted)
+		assert.Len(t, manifest.ToolsSkipped, len(tools.Values()))
+		assert.Equal(t, ReasonIgnoredInToolsToIgnore, getToolSkipped(manifest, tools.GoSec).Reason)
+		assert.Equal(t, ReasonIgnoredInToolsConfig, getToolSkipped(manifest, tools.Bandit).Reason)
+		assert.Equal(t, ReasonGitHistoryDisabled, getToolSkipped(manifest, tools.GitLeaks).Reason)
+		assert.Equal(t, ReasonTfPlanInformed, getToolSkipped(manifest, tools.TfSec).Reason)
+		assert.Equal(t, ReasonImageScanOnly, getToolSkipped(manifest, tools.Trivy).Reason)
+		assert.Equal(t, ReasonLanguageNotFound, getToolSkipped(manifest, tools.Eslint).Reason)
+	})
+
+	t.Run("should return the tools of the dry run as skipped", func(t *testing.T) {
+		configs := &config.Config{}
+		configs.SetDryRun(true)
+		service := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, configs, horusec.NewMonitor())
+
+		_, _ = service.ExecuteContainer(&dockerEntities.AnalysisData{Tool: tools.GoSec})
+		service.SetToolIsFinished(nil, tools.GoSec, "")
+
+		manifest := service.GetScanManifest()
+		assert.Empty(t, manifest.ToolsExecuted)
+		assert.Equal(t, ReasonDryRun, getToolSkipped(manifest, tools.GoSec).Reason)
+		assert.Equal(t, ReasonTfPlanNotInformed, getToolSkipped(manifest, tools.Checkov).Reason)
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/analyser/sarif"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+)
+
+// AddSARIFResults adds the results of the sarif output of the tool, with the language of the file of each result.
+// The severity is found in the severity table of the tool by the id of the rule and by the level of the result
+func AddSARIFResults(service formatters.IService, tool tools.Tool, output string,
+	getLanguage func(file string) languages.Language) error {
+	if output == "" || output == "null" {
+		logger.LogDebugWithLevel(messages.MsgDebugOutputEmpty, logger.DebugLevel,
+			map[string]interface{}{"tool": tool.ToString()})
+		return nil
+	}
+	err := sarif.Decode(strings.NewReader(output), func(run *sarif.Run, result *sarif.Result) error {
+		rule := run.Tool.Driver.GetRule(result)
+		vulnerability := NewSARIFVulnerability(service, tool, getLanguage(result.GetFile()), rule, result)
+		AddSARIFVulnerability(service, vulnerability, rule)
+		return nil
+	})
+	if err != nil {
+		logger.LogErrorWithLevel(service.GetAnalysisIDErrorMessage(tool, output), err, logger.ErrorLevel)
+	}
+	return err
+}
+
+// NewSARIFVulnerability returns the vulnerability of the result of the rule
+func NewSARIFVulnerability(service formatters.IService, tool tools.Tool, language languages.Language,
+	rule *sarif.Rule, result *sarif.Result) *horusec.Vulnerability {
+	vulnerability := NewVulnerability(tool, language)
+	vulnerability.RuleID = result.RuleID
+	vulnerability.Details = result.Message.Text
+	vulnerability.ToolSeverity = result.GetLevel(rule)
+	vulnerability.Severity = service.GetSeverityTables().Get(tool).GetSeverity(result.RuleID, vulnerability.ToolSeverity)
+	vulnerability.Confidence = rule.GetConfidence()
+	vulnerability.File = service.RemoveSrcFolderFromPath(result.GetFile())
+	vulnerability.Line = result.GetLine()
+	vulnerability.Column = result.GetColumn()
+	vulnerability.Code = service.GetCodeWithMaxCharacters(result.GetCode(), 0)
+	vulnerability.Fingerprint = result.GetFingerprint()
+	return vulnerability
+}
+
+// AddSARIFVulnerability adds the vulnerability like AddVulnerability, the CWEs of the tags of the rule are added to
+// the details after the vulnerability hash was generated, so the hash doesn't change when the tags of the rule change
+func AddSARIFVulnerability(service formatters.IService, vulnerability *horusec.Vulnerability, rule *sarif.Rule) {
+	vulnerability = vulnhash.Bind(vulnerability)
+	var cwes []string
+	for _, cwe := range rule.GetCWEs() {
+		if !strings.Contains(vulnerability.Details, cwe) {
+			cwes = append(cwes, cwe)
+		}
+	}
+	if len(cwes) > 0 {
+		vulnerability.Details += "\nReferences: " + strings.Join(cwes, ", ")
+	}
+	appendVulnerability(service, vulnerability)
+}
@@ -0,0 +1,141 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sdk has the steps shared by the formatters of the tools, so a formatter only runs its container and maps
+// the output of its tool to vulnerabilities
+package sdk
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+)
+
+// ConfigPathInContainer is the folder of the container where the config file of the tools config is mounted
+const ConfigPathInContainer = "/horusec-tool-config"
+
+// Container is the image and the command of the container of a tool, from the config.go of the formatter. The
+// {{ARGS}} of the command is replaced by the args of the tool in the tools config, the {{PROJECTS}} and
+// {{SKIP_PROJECTS}} by its projects quoted and the {{CONFIG}} by the path of its config file in the container, or by
+// empty when the tool has no config file
+type Container struct {
+	Tool      tools.Tool
+	Language  languages.Language
+	ImageName string
+	ImageTag  string
+	ImageCmd  string
+	// CachePath is the dependencies cache of the tool in the container, where the cache volume of the tools config is
+	// mounted. Empty when the tool doesn't download dependencies
+	CachePath string
+}
+
+// Run is the StartAnalysis of the formatters. The tools ignored are not run, a panic in the analysis fails only the
+// tool and the tool is finished with the error returned by the analysis
+func Run(service formatters.IService, tool tools.Tool, projectSubPath string,
+	analysis func(projectSubPath string) error) {
+	defer service.RecoverFromPanic(tool, projectSubPath)
+	if service.ToolIsToIgnore(tool) {
+		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored+tool.ToString(), logger.DebugLevel)
+		return
+	}
+	err := analysis(projectSubPath)
+	service.SetToolIsFinished(err, tool, projectSubPath)
+	service.LogAnalysisError(err, tool, projectSubPath)
+}
+
+// NewAnalysisData returns the analysis data of the container in the project sub path, with the image path, the args,
+// the projects, the config file and the cache volume of the tools config
+func NewAnalysisData(service formatters.IService, container *Container,
+	projectSubPath string) *dockerEntities.AnalysisData {
+	toolConfig := service.GetToolsConfig()[container.Tool]
+	cmd := strings.NewReplacer("{{ARGS}}", QuoteArgs(toolConfig.Args),
+		"{{PROJECTS}}", QuoteArgs(toolConfig.Projects),
+		"{{SKIP_PROJECTS}}", QuoteArgs(toolConfig.SkipProjects)).Replace(container.ImageCmd)
+	ad := &dockerEntities.AnalysisData{
+		Language:       container.Language,
+		Tool:           container.Tool,
+		ProjectSubPath: projectSubPath,
+	}
+	cmd = setConfigMount(ad, cmd, toolConfig.ConfigPath)
+	setCacheMount(ad, container.CachePath, toolConfig.CacheVolume)
+	ad.CMD = service.AddWorkDirInCmd(cmd, projectSubPath, container.Tool)
+	ad.SetFullImagePath(toolConfig.ImagePath, container.ImageName, container.ImageTag)
+	return ad
+}
+
+// setConfigMount mounts the config file only to the tools with {{CONFIG}} in the command
+func setConfigMount(ad *dockerEntities.AnalysisData, cmd, configPath string) string {
+	if configPath == "" || !strings.Contains(cmd, "{{CONFIG}}") {
+		return strings.ReplaceAll(cmd, "{{CONFIG}}", "")
+	}
+	source, err := filepath.Abs(configPath)
+	if err != nil {
+		source = configPath
+	}
+	target := path.Join(ConfigPathInContainer, filepath.Base(source))
+	ad.Mounts = append(ad.Mounts, dockerEntities.Mount{Source: source, Target: target, ReadOnly: true})
+	return strings.ReplaceAll(cmd, "{{CONFIG}}", QuoteArgs([]string{target}))
+}
+
+// setCacheMount mounts the cache volume only to the tools with a dependencies cache
+func setCacheMount(ad *dockerEntities.AnalysisData, cachePath, cacheVolume string) {
+	if cachePath == "" || cacheVolume == "" {
+		return
+	}
+	ad.Mounts = append(ad.Mounts, dockerEntities.Mount{Source: cacheVolume, Target: cachePath, IsVolume: true})
+}
+
+// QuoteArgs quotes each arg to the shell of the container, so the args informed by the user are not run as commands
+func QuoteArgs(args []string) string {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		quoted = append(quoted, "'"+strings.ReplaceAll(arg, "'", `'\''`)+"'")
+	}
+	return strings.Join(quoted, " ")
+}
+
+// Execute runs the container of the tool and returns its output, the error of the container is set in the analysis
+func Execute(service formatters.IService, data *dockerEntities.AnalysisData) (string, error) {
+	service.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, data.Tool)
+	output, err := service.ExecuteContainer(data)
+	if err != nil {
+		service.SetAnalysisError(err)
+		return "", err
+	}
+	service.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, data.Tool)
+	return output, nil
+}
+
+// DecodeJSON decodes the json output of the tool in the value. It returns false without error when the output is
+// empty, that is when the tool found nothing, and logs the output with the analysis id when it is not a valid json
+func DecodeJSON(service formatters.IService, tool tools.Tool, output string, value interface{}) (bool, error) {
+	if output == "" || output == "null" {
+		logger.LogDebugWithLevel(messages.MsgDebugOutputEmpty, logger.DebugLevel,
+			map[string]interface{}{"tool": tool.ToString()})
+		return false, nil
+	}
+	if err := jsonUtils.ConvertStringToOutput(output, value); err != nil {
+		logger.LogErrorWithLevel(service.GetAnalysisIDErrorMessage(tool, output), err, logger.ErrorLevel)
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,279 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"errors"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/stretchr/testify/assert"
+)
+
+var testContainer = &Container{
+	Tool:      tools.GoSec,
+	Language:  languages.Go,
+	ImageName: "horuszup/gosec",
+	ImageTag:  "v1.0.0",
+	ImageCmd:  "{{WORK_DIR}} gosec ./...",
+}
+
+func newService(analysis *horusec.Analysis, dockerMock *docker.Mock) (formatters.IService, *cliConfig.Config) {
+	config := &cliConfig.Config{}
+	config.SetWorkDir(&workdir.WorkDir{})
+	return formatters.NewFormatterService(analysis, dockerMock, config, &horusec.Monitor{}), config
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Should finish the tool with the error of the analysis", func(t *testing.T) {
+		service, _ := newService(&horusec.Analysis{}, &docker.Mock{})
+
+		Run(service, tools.GoSec, "", func(projectSubPath string) error {
+			return errors.New("test")
+		})
+
+		assert.Equal(t, []tools.Tool{tools.GoSec}, service.GetToolsFailed())
+	})
+
+	t.Run("Should recover from the panic of the analysis and fail only the tool", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		service, _ := newService(analysis, &docker.Mock{})
+
+		assert.NotPanics(t, func() {
+			Run(service, tools.GoSec, "", func(projectSubPath string) error {
+				panic("test")
+			})
+		})
+		assert.Equal(t, []tools.Tool{tools.GoSec}, service.GetToolsFailed())
+		assert.NotEmpty(t, analysis.Errors)
+	})
+
+	t.Run("Should not run the analysis when the tool is ignored", func(t *testing.T) {
+		service, config := newService(&horusec.Analysis{}, &docker.Mock{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{GoSec: toolsconfig.ToolConfig{IsToIgnore: true}})
+
+		Run(service, tools.GoSec, "", func(projectSubPath string) error {
+			assert.Fail(t, "the analysis of the tool ignored was run")
+			return nil
+		})
+	})
+}
+
+func TestNewAnalysisData(t *testing.T) {
+	t.Run("Should return the default image and the command in the project sub path", func(t *testing.T) {
+		service, _ := newService(&horusec.Analysis{}, &docker.Mock{})
+
+		data := NewAnalysisData(service, testContainer, "api")
+
+		assert.Equal(t, "docker.io/horuszup/gosec:v1.0.0", data.ImagePath)
+		assert.Equal(t, "cd api gosec ./...", data.CMD)
+		assert.Equal(t, languages.Go, data.Language)
+		assert.Equal(t, "api", data.ProjectSubPath)
+	})
+
+	t.Run("Should return the image of the tools config", func(t *testing.T) {
+		service, config := newService(&horusec.Analysis{}, &docker.Mock{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{
+			GoSec: toolsconfig.ToolConfig{ImagePath: "registry.example.com/gosec:v2"}})
+
+		assert.Equal(t, "registry.example.com/gosec:v2", NewAnalysisData(service, testContainer, "").ImagePath)
+	})
+
+	t.Run("Should replace the args of the command by the quoted args of the tools config", func(t *testing.T) {
+		service, config := newService(&horusec.Analysis{}, &docker.Mock{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{
+			GoSec: toolsconfig.ToolConfig{Args: []string{"-exclude=G104", "it's; rm -rf /"}}})
+		container := *testContainer
+		container.ImageCmd = "{{WORK_DIR}} gosec {{ARGS}} ./..."
+
+		assert.Equal(t, ` gosec '-exclude=G104' 'it'\''s; rm -rf /' ./...`,
+			NewAnalysisData(service, &container, "").CMD)
+	})
+
+	t.Run("Should mount the config file of the tools config and replace the config of the command", func(t *testing.T) {
+		service, config := newService(&horusec.Analysis{}, &docker.Mock{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{
+			GoSec: toolsconfig.ToolConfig{ConfigPath: "/home/user/gosec.json"}})
+		container := *testContainer
+		container.ImageCmd = "gosec -conf={{CONFIG}} ./..."
+
+		data := NewAnalysisData(service, &container, "")
+
+		assert.Equal(t, "gosec -conf='/horusec-tool-config/gosec.json' ./...", data.CMD)
+		assert.Equal(t, []dockerEntities.Mount{{Source: "/home/user/gosec.json",
+			Target: "/horusec-tool-config/gosec.json", ReadOnly: true}}, data.Mounts)
+	})
+
+	t.Run("Should not mount the config file when the command has no config", func(t *testing.T) {
+		service, config := newService(&horusec.Analysis{}, &docker.Mock{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{
+			GoSec: toolsconfig.ToolConfig{ConfigPath: "/home/user/gosec.json"}})
+
+		assert.Empty(t, NewAnalysisData(service, testContainer, "").Mounts)
+	})
+
+	t.Run("Should remove the args of the command when the tool has no args", func(t *testing.T) {
+		service, _ := newService(&horusec.Analysis{}, &docker.Mock{})
+		container := *testContainer
+		container.ImageCmd = "gosec {{ARGS}} ./..."
+
+		assert.Equal(t, "gosec  ./...", NewAnalysisData(service, &container, "").CMD)
+	})
+
+	t.Run("Should replace the projects of the command by the quoted projects of the tools config", func(t *testing.T) {
+		service, config := newService(&horusec.Analysis{}, &docker.Mock{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{
+			GoSec: toolsconfig.ToolConfig{Projects: []string{"api", "cmd"}, SkipProjects: []string{"test"}}})
+		container := *testContainer
+		container.ImageCmd = "build {{PROJECTS}} --skip {{SKIP_PROJECTS}}"
+
+		assert.Equal(t, "build 'api' 'cmd' --skip 'test'", NewAnalysisData(service, &container, "").CMD)
+	})
+
+	t.Run("Should mount the cache volume of the tools config in the cache of the container", func(t *testing.T) {
+		service, config := newService(&horusec.Analysis{}, &docker.Mock{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{GoSec: toolsconfig.ToolConfig{CacheVolume: "go-cache"}})
+		container := *testContainer
+		container.CachePath = "/go/pkg/mod"
+
+		assert.Equal(t, []dockerEntities.Mount{{Source: "go-cache", Target: "/go/pkg/mod", IsVolume: true}},
+			NewAnalysisData(service, &container, "").Mounts)
+	})
+
+	t.Run("Should not mount the cache volume when the tool has no cache", func(t *testing.T) {
+		service, config := newService(&horusec.Analysis{}, &docker.Mock{})
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{GoSec: toolsconfig.ToolConfig{CacheVolume: "go-cache"}})
+
+		assert.Empty(t, NewAnalysisData(service, testContainer, "").Mounts)
+	})
+}
+
+func TestExecute(t *testing.T) {
+	t.Run("Should return the output of the container", func(t *testing.T) {
+		dockerMock := &docker.Mock{}
+		dockerMock.On("CreateLanguageAnalysisContainer").Return("output", nil)
+		service, _ := newService(&horusec.Analysis{}, dockerMock)
+
+		output, err := Execute(service, NewAnalysisData(service, testContainer, ""))
+		assert.NoError(t, err)
+		assert.Equal(t, "output", output)
+	})
+
+	t.Run("Should set the error of the container in the analysis", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		dockerMock := &docker.Mock{}
+		dockerMock.On("CreateLanguageAnalysisContainer").Return("", errors.New("test"))
+		service, _ := newService(analysis, dockerMock)
+
+		_, err := Execute(service, NewAnalysisData(service, testContainer, ""))
+		assert.Error(t, err)
+		assert.Contains(t, analysis.Errors, "test")
+	})
+}
+
+func TestDecodeJSON(t *testing.T) {
+	service, _ := newService(&horusec.Analysis{}, &docker.Mock{})
+
+	t.Run("Should decode the output", func(t *testing.T) {
+		var findings []engine.Finding
+		decoded, err := DecodeJSON(service, tools.HorusecJava, `[{"ID":"HS-JAVA-1"}]`, &findings)
+		assert.NoError(t, err)
+		assert.True(t, decoded)
+		assert.Equal(t, "HS-JAVA-1", findings[0].ID)
+	})
+
+	t.Run("Should not decode the empty and the null outputs", func(t *testing.T) {
+		var findings []engine.Finding
+		for _, output := range []string{"", "null"} {
+			decoded, err := DecodeJSON(service, tools.HorusecJava, output, &findings)
+			assert.NoError(t, err)
+			assert.False(t, decoded)
+		}
+	})
+
+	t.Run("Should return error when the output is not a valid json", func(t *testing.T) {
+		var findings []engine.Finding
+		decoded, err := DecodeJSON(service, tools.HorusecJava, "invalid output", &findings)
+		assert.Error(t, err)
+		assert.False(t, decoded)
+	})
+}
+
+func TestAddEngineFindings(t *testing.T) {
+	t.Run("Should add the findings with hash to the analysis", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		service, _ := newService(analysis, &docker.Mock{})
+
+		AddEngineFindings(service, tools.HorusecJava, languages.Java, []engine.Finding{{
+			ID: "HS-JAVA-1", Name: "Name", Description: "Description", Severity: "HIGH", Confidence: "LOW",
+			CodeSample:     "code",
+			SourceLocation: engine.Location{Filename: "src/Main.java", Line: 10, Column: 2},
+		}})
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, tools.HorusecJava, vulnerability.SecurityTool)
+		assert.Equal(t, languages.Java, vulnerability.Language)
+		assert.Equal(t, severity.High, vulnerability.Severity)
+		assert.Equal(t, "Name\nDescription", vulnerability.Details)
+		assert.Equal(t, "10", vulnerability.Line)
+		assert.NotEmpty(t, vulnerability.VulnHash)
+	})
+}
+
+func TestAddSARIFResults(t *testing.T) {
+	output := `{"runs":[{"tool":{"driver":{"rules":[{"id":"rule-1","properties":{"tags":["CWE-79"]}}]}},
+		"results":[{"ruleId":"rule-1","level":"error","message":{"text":"XSS"},
+		"locations":[{"physicalLocation":{"artifactLocation":{"uri":"file://index.js"},"region":{"startLine":3}}}]}]}]}`
+	getLanguage := func(file string) languages.Language {
+		return languages.Javascript
+	}
+
+	t.Run("Should add the results without the references of the rule in the hash", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		service, _ := newService(analysis, &docker.Mock{})
+
+		err := AddSARIFResults(service, tools.Semgrep, output, getLanguage)
+
+		assert.NoError(t, err)
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, "XSS\nReferences: CWE-79", vulnerability.Details)
+		assert.Equal(t, "index.js", vulnerability.File)
+		assert.Equal(t, languages.Javascript, vulnerability.Language)
+		withoutReferences := vulnerability
+		withoutReferences.Details = "XSS"
+		assert.Equal(t, vulnhash.Bind(&withoutReferences).VulnHash, vulnerability.VulnHash)
+	})
+
+	t.Run("Should return error when the output is invalid", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		service, _ := newService(analysis, &docker.Mock{})
+
+		assert.Error(t, AddSARIFResults(service, tools.Semgrep, "!!", getLanguage))
+		assert.Empty(t, analysis.AnalysisVulnerabilities)
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdk
+
+import (
+	"strconv"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+)
+
+// NewVulnerability returns a vulnerability found by the tool in the language
+func NewVulnerability(tool tools.Tool, language languages.Language) *horusec.Vulnerability {
+	return &horusec.Vulnerability{
+		SecurityTool: tool,
+		Language:     language,
+	}
+}
+
+// AddVulnerability sets the hash and the commit author of the vulnerability and adds it to the analysis
+func AddVulnerability(service formatters.IService, vulnerability *horusec.Vulnerability) {
+	appendVulnerability(service, vulnhash.Bind(vulnerability))
+}
+
+func appendVulnerability(service formatters.IService, vulnerability *horusec.Vulnerability) {
+	vulnerability = SetCommitAuthor(service, vulnerability)
+	service.GetAnalysis().AnalysisVulnerabilities = append(service.GetAnalysis().AnalysisVulnerabilities,
+		horusec.AnalysisVulnerabilities{
+			Vulnerability: *vulnerability,
+		})
+}
+
+// SetCommitAuthor sets the author of the last commit that changed the line of the vulnerability
+func SetCommitAuthor(service formatters.IService, vulnerability *horusec.Vulnerability) *horusec.Vulnerability {
+	commitAuthor := service.GetCommitAuthor(vulnerability.Line, vulnerability.File)
+	vulnerability.CommitAuthor = commitAuthor.Author
+	vulnerability.CommitEmail = commitAuthor.Email
+	vulnerability.CommitHash = commitAuthor.CommitHash
+	vulnerability.CommitMessage = commitAuthor.Message
+	vulnerability.CommitDate = commitAuthor.Date
+	return vulnerability
+}
+
+// AddEngineFindings adds the findings of the horusec engines, that have the same output in all languages
+func AddEngineFindings(service formatters.IService, tool tools.Tool, language languages.Language,
+	findings []engine.Finding) {
+	for index := range findings {
+		finding := &findings[index]
+		vulnerability := NewVulnerability(tool, language)
+		vulnerability.Line = strconv.Itoa(finding.SourceLocation.Line)
+		vulnerability.Column = strconv.Itoa(finding.SourceLocation.Column)
+		vulnerability.Confidence = finding.Confidence
+		vulnerability.File = service.RemoveSrcFolderFromPath(finding.SourceLocation.Filename)
+		vulnerability.Code = service.GetCodeWithMaxCharacters(finding.CodeSample, finding.SourceLocation.Column)
+		vulnerability.Details = finding.Name + "\n" + finding.Description
+		vulnerability.RuleID = finding.ID
+		vulnerability.Severity = severity.ParseStringToSeverity(finding.Severity)
+		AddVulnerability(service, vulnerability)
+	}
+}
@@ -19,17 +19,27 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/file"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"strings"
+	"sync"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/artifacts"
 	dockerService "github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/events"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/git"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/progress"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/secretmask"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/severitytables"
 )
 
+const maxOutputLengthInErrorMessage = 4096
+
 type IService interface {
 	LogDebugWithReplace(msg string, tool tools.Tool)
 	GetAnalysisID() string
@@ -42,49 +52,148 @@ type IService interface {
 	GetToolsConfig() map[tools.Tool]toolsconfig.ToolConfig
 	GetAnalysis() *horusec.Analysis
 	SetLanguageIsFinished()
+	SetToolIsFinished(err error, tool tools.Tool, projectSubPath string)
+	GetToolsFailed() []tools.Tool
 	LogAnalysisError(err error, tool tools.Tool, projectSubPath string)
 	SetMonitor(monitor *horusec.Monitor)
+	SetProgress(progress progress.Interface)
 	RemoveSrcFolderFromPath(filepath string) string
 	GetCodeWithMaxCharacters(code string, column int) string
 	ToolIsToIgnore(tool tools.Tool) bool
 	GetFilepathFromFilename(filename string) string
+	SetFilesByLanguage(filesByLanguage map[languages.Language][]string)
+	GetFilesByLanguage(language languages.Language) []string
+	GetBaseImageAdvisoriesPath() string
+	GetEnableLeaksEntropy() bool
+	GetLeaksEntropyAllowlistPatterns() []string
+	GetLeaksEntropyAllowlistPaths() []string
+	GetLeaksEntropyMinLength() int64
+	GetLeaksEntropyBase64Threshold() float64
+	GetLeaksEntropyHexThreshold() float64
+	GetOsvOfflineDatabasePath() string
+	GetSeverityTables() severitytables.Tables
+	GetScanManifest() *horusec.ScanManifest
+	RecoverFromPanic(tool tools.Tool, projectSubPath string)
 }
 
 type Service struct {
-	analysis   *horusec.Analysis
-	docker     dockerService.Interface
-	gitService git.IService
-	monitor    *horusec.Monitor
-	config     cliConfig.IConfig
+	analysis        *horusec.Analysis
+	docker          dockerService.Interface
+	gitService      git.IService
+	monitor         *horusec.Monitor
+	progress        progress.Interface
+	config          cliConfig.IConfig
+	filesByLanguage map[languages.Language][]string
+	toolsFailed     []tools.Tool
+	toolsExecuted   []*toolExecution
+	toolsSkipped    map[tools.Tool]string
+	artifacts       artifacts.Interface
+	severityTables  severitytables.Tables
+	mutex           sync.Mutex
+	// vulnerabilitiesPublished is how many vulnerabilities of the analysis were published as events
+	vulnerabilitiesPublished int
 }
 
 func NewFormatterService(analysis *horusec.Analysis, docker dockerService.Interface, config cliConfig.IConfig,
 	monitor *horusec.Monitor) IService {
 	return &Service{
-		analysis:   analysis,
-		docker:     docker,
-		gitService: git.NewGitService(config),
-		monitor:    monitor,
-		config:     config,
+		analysis:       analysis,
+		docker:         docker,
+		gitService:     git.NewGitService(config),
+		monitor:        monitor,
+		config:         config,
+		artifacts:      artifacts.NewArtifacts(config),
+		severityTables: loadSeverityTables(config),
+	}
+}
+
+// loadSeverityTables uses the built-in tables when the tables of the config can't be read
+func loadSeverityTables(config cliConfig.IConfig) severitytables.Tables {
+	tables, err := severitytables.Load(config.GetSeverityTablesPath())
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorLoadSeverityTables, err, logger.ErrorLevel)
+		return severitytables.Default()
 	}
+	return tables
 }
 
 func (s *Service) ExecuteContainer(data *dockerEntities.AnalysisData) (output string, err error) {
-	return s.docker.CreateLanguageAnalysisContainer(data)
+	if s.config.GetDryRun() {
+		s.logDryRun(data)
+		s.addToolSkipped(data.Tool, ReasonDryRun)
+		return "", nil
+	}
+	s.startToolExecution(data)
+	events.Publish(events.NewToolStarted(s.GetAnalysisID(), data.Tool, data.ProjectSubPath))
+	s.setToolStatus(data.Tool, data.ProjectSubPath, progress.Pulling)
+	output, err = s.docker.CreateLanguageAnalysisContainer(data)
+	s.setToolOutput(data, s.artifacts.SaveToolOutput(data.Tool, data.ProjectSubPath, output), output)
+	s.setToolStatus(data.Tool, data.ProjectSubPath, progress.Parsing)
+	return output, err
+}
+
+// logDryRun shows the container that would run, the tools handle the empty output as no vulnerabilities found
+func (s *Service) logDryRun(data *dockerEntities.AnalysisData) {
+	msg := strings.ReplaceAll(messages.MsgInfoDryRunTool, "{{0}}", data.Tool.ToString())
+	msg = strings.ReplaceAll(msg, "{{1}}", data.ImagePath)
+	msg = strings.ReplaceAll(msg, "{{2}}", data.ProjectSubPath)
+	msg = strings.ReplaceAll(msg, "{{3}}", strings.TrimSpace(data.CMD))
+	logger.LogInfoWithLevel(msg, logger.InfoLevel)
 }
 
 func (s *Service) GetAnalysisIDErrorMessage(tool tools.Tool, output string) string {
 	msg := strings.ReplaceAll(messages.MsgErrorRunToolInDocker, "{{0}}", tool.ToString())
 	msg = strings.ReplaceAll(msg, "{{1}}", s.GetAnalysisID())
-	msg = strings.ReplaceAll(msg, "{{2}}", output)
+	msg = strings.ReplaceAll(msg, "{{2}}", s.truncateOutput(s.maskOutput(output)))
 	return msg
 }
 
+// maskOutput hides the secrets printed by the tools, the message is logged and kept in the errors of the analysis
+func (s *Service) maskOutput(output string) string {
+	if s.config.GetRevealSecrets() {
+		return output
+	}
+	return secretmask.Mask(output)
+}
+
+// truncateOutput avoids logging the whole output of tools that returned hundreds of megabytes
+func (s *Service) truncateOutput(output string) string {
+	if len(output) <= maxOutputLengthInErrorMessage {
+		return output
+	}
+	return fmt.Sprintf("%s... (%d bytes truncated)",
+		output[:maxOutputLengthInErrorMessage], len(output)-maxOutputLengthInErrorMessage)
+}
+
+// RecoverFromPanic is deferred in the formatters, so a panic while parsing a malformed output fails only the tool
+// and the analysis continues with the other tools. In the strict mode the panic stops the analysis
+func (s *Service) RecoverFromPanic(tool tools.Tool, projectSubPath string) {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+	if s.config.GetStrict() {
+		panic(recovered)
+	}
+
+	err := fmt.Errorf("%s | panic -> %v",
+		s.GetAnalysisIDErrorMessage(tool, s.getOutputSnippet(tool, projectSubPath)), recovered)
+	logger.LogErrorWithLevel(messages.MsgErrorFormatterPanic, err, logger.ErrorLevel,
+		map[string]interface{}{"tool": tool.ToString(), "projectSubPath": projectSubPath})
+	s.SetAnalysisError(err)
+	if !s.isToolFinished(tool, projectSubPath) {
+		s.SetToolIsFinished(err, tool, projectSubPath)
+	}
+}
+
 func (s *Service) GetCommitAuthor(line, filePath string) (commitAuthor horusec.CommitAuthor) {
 	return s.gitService.GetCommitAuthor(line, filePath)
 }
 
 func (s *Service) GetConfigProjectPath() string {
+	if s.config.GetSourceMode() == cli.SourceReadOnly.ToString() {
+		return s.config.GetProjectPath()
+	}
 	return file.ReplacePathSeparator(
 		fmt.Sprintf(
 			"%s/%s/%s",
@@ -133,7 +242,7 @@ func (s *Service) LogAnalysisError(err error, tool tools.Tool, projectSubPath st
 		}
 
 		msg = strings.ReplaceAll(msg, "| output -> {{2}}", "")
-		logger.LogDebugWithLevel(fmt.Sprintf("%s - %s", msg, err), logger.ErrorLevel)
+		logger.LogDebugWithLevel(fmt.Sprintf("%s - %s", msg, s.maskOutput(err.Error())), logger.ErrorLevel)
 	}
 }
 
@@ -141,10 +250,51 @@ func (s *Service) SetLanguageIsFinished() {
 	s.monitor.RemoveProcess(1)
 }
 
+// SetToolIsFinished is called after the output of the tool was parsed, even when the tool failed
+func (s *Service) SetToolIsFinished(err error, tool tools.Tool, projectSubPath string) {
+	if err != nil {
+		s.setToolStatus(tool, projectSubPath, progress.Failed)
+		s.addToolFailed(tool)
+	} else {
+		s.setToolStatus(tool, projectSubPath, progress.Done)
+	}
+	s.finishToolExecution(err, tool, projectSubPath)
+	s.publishToolFinished(err, tool, projectSubPath)
+	s.SetLanguageIsFinished()
+}
+
+func (s *Service) addToolFailed(tool tools.Tool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, toolFailed := range s.toolsFailed {
+		if toolFailed == tool {
+			return
+		}
+	}
+	s.toolsFailed = append(s.toolsFailed, tool)
+}
+
+// GetToolsFailed returns the tools that finished with error in any project sub path
+func (s *Service) GetToolsFailed() []tools.Tool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]tools.Tool{}, s.toolsFailed...)
+}
+
 func (s *Service) SetMonitor(monitor *horusec.Monitor) {
 	s.monitor = monitor
 }
 
+func (s *Service) SetProgress(progress progress.Interface) {
+	s.progress = progress
+}
+
+func (s *Service) setToolStatus(tool tools.Tool, projectSubPath string, status progress.Status) {
+	if s.progress != nil {
+		s.progress.SetToolStatus(tool, projectSubPath, status)
+	}
+}
+
 func (s *Service) RemoveSrcFolderFromPath(filepath string) string {
 	if filepath == "" || len(filepath) <= 4 || !strings.Contains(filepath[:4], "src") {
 		return filepath
@@ -170,11 +320,16 @@ func (s *Service) ToolIsToIgnore(tool tools.Tool) bool {
 	for _, toolToIgnore := range s.config.GetToolsToIgnore() {
 		if strings.EqualFold(toolToIgnore, tool.ToString()) {
 			s.SetLanguageIsFinished()
+			s.addToolSkipped(tool, ReasonIgnoredInToolsToIgnore)
 			return true
 		}
 	}
 
-	return s.config.GetToolsConfig()[tool].IsToIgnore
+	if s.config.GetToolsConfig()[tool].IsToIgnore {
+		s.addToolSkipped(tool, ReasonIgnoredInToolsConfig)
+		return true
+	}
+	return false
 }
 
 func (s *Service) getAHundredCharacters(code string, column int) string {
@@ -198,3 +353,48 @@ func (s *Service) GetFilepathFromFilename(filename string) string {
 
 	return filepath
 }
+
+func (s *Service) SetFilesByLanguage(filesByLanguage map[languages.Language][]string) {
+	s.filesByLanguage = filesByLanguage
+}
+
+// GetFilesByLanguage returns the files detected by content as the language, relative to the project path
+func (s *Service) GetFilesByLanguage(language languages.Language) []string {
+	return s.filesByLanguage[language]
+}
+
+func (s *Service) GetBaseImageAdvisoriesPath() string {
+	return s.config.GetBaseImageAdvisoriesPath()
+}
+
+func (s *Service) GetEnableLeaksEntropy() bool {
+	return s.config.GetEnableLeaksEntropy()
+}
+
+func (s *Service) GetLeaksEntropyAllowlistPatterns() []string {
+	return s.config.GetLeaksEntropyAllowlistPatterns()
+}
+
+func (s *Service) GetLeaksEntropyAllowlistPaths() []string {
+	return s.config.GetLeaksEntropyAllowlistPaths()
+}
+
+func (s *Service) GetLeaksEntropyMinLength() int64 {
+	return s.config.GetLeaksEntropyMinLength()
+}
+
+func (s *Service) GetLeaksEntropyBase64Threshold() float64 {
+	return s.config.GetLeaksEntropyBase64Threshold()
+}
+
+func (s *Service) GetLeaksEntropyHexThreshold() float64 {
+	return s.config.GetLeaksEntropyHexThreshold()
+}
+
+func (s *Service) GetOsvOfflineDatabasePath() string {
+	return s.config.GetOsvOfflineDatabasePath()
+}
+
+func (s *Service) GetSeverityTables() severitytables.Tables {
+	return s.severityTables
+}
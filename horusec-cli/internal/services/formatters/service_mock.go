@@ -16,9 +16,12 @@ package formatters
 
 import (
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	utilsMock "github.com/ZupIT/horusec/development-kit/pkg/utils/mock"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/progress"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/severitytables"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -66,9 +69,19 @@ func (m *Mock) SetLanguageIsFinished() {
 func (m *Mock) LogAnalysisError(err error, tool tools.Tool, projectSubPath string) {
 	_ = m.MethodCalled("LogAnalysisError")
 }
+func (m *Mock) SetToolIsFinished(err error, tool tools.Tool, projectSubPath string) {
+	_ = m.MethodCalled("SetToolIsFinished")
+}
+func (m *Mock) GetToolsFailed() []tools.Tool {
+	args := m.MethodCalled("GetToolsFailed")
+	return args.Get(0).([]tools.Tool)
+}
 func (m *Mock) SetMonitor(monitor *horusec.Monitor) {
 	_ = m.MethodCalled("SetMonitor")
 }
+func (m *Mock) SetProgress(progress progress.Interface) {
+	_ = m.MethodCalled("SetProgress")
+}
 func (m *Mock) RemoveSrcFolderFromPath(filepath string) string {
 	args := m.MethodCalled("RemoveSrcFolderFromPath")
 	return args.Get(0).(string)
@@ -81,3 +94,54 @@ func (m *Mock) ToolIsToIgnore(tool tools.Tool) bool {
 	args := m.MethodCalled("ToolIsToIgnore")
 	return args.Get(0).(bool)
 }
+func (m *Mock) SetFilesByLanguage(filesByLanguage map[languages.Language][]string) {
+	_ = m.MethodCalled("SetFilesByLanguage")
+}
+func (m *Mock) GetFilesByLanguage(language languages.Language) []string {
+	args := m.MethodCalled("GetFilesByLanguage")
+	return args.Get(0).([]string)
+}
+func (m *Mock) GetBaseImageAdvisoriesPath() string {
+	args := m.MethodCalled("GetBaseImageAdvisoriesPath")
+	return args.Get(0).(string)
+}
+func (m *Mock) GetEnableLeaksEntropy() bool {
+	args := m.MethodCalled("GetEnableLeaksEntropy")
+	return args.Get(0).(bool)
+}
+func (m *Mock) GetLeaksEntropyAllowlistPatterns() []string {
+	args := m.MethodCalled("GetLeaksEntropyAllowlistPatterns")
+	return args.Get(0).([]string)
+}
+func (m *Mock) GetLeaksEntropyAllowlistPaths() []string {
+	args := m.MethodCalled("GetLeaksEntropyAllowlistPaths")
+	return args.Get(0).([]string)
+}
+func (m *Mock) GetLeaksEntropyMinLength() int64 {
+	args := m.MethodCalled("GetLeaksEntropyMinLength")
+	return args.Get(0).(int64)
+}
+func (m *Mock) GetLeaksEntropyBase64Threshold() float64 {
+	args := m.MethodCalled("GetLeaksEntropyBase64Threshold")
+	return args.Get(0).(float64)
+}
+func (m *Mock) GetLeaksEntropyHexThreshold() float64 {
+	args := m.MethodCalled("GetLeaksEntropyHexThreshold")
+	return args.Get(0).(float64)
+}
+func (m *Mock) GetOsvOfflineDatabasePath() string {
+	args := m.MethodCalled("GetOsvOfflineDatabasePath")
+	return args.Get(0).(string)
+}
+func (m *Mock) GetSeverityTables() severitytables.Tables {
+	args := m.MethodCalled("GetSeverityTables")
+	return args.Get(0).(severitytables.Tables)
+}
+func (m *Mock) GetScanManifest() *horusec.ScanManifest {
+	args := m.MethodCalled("GetScanManifest")
+	return args.Get(0).(*horusec.ScanManifest)
+}
+
+// RecoverFromPanic doesn't recover, so the panics of the formatters fail the tests
+func (m *Mock) RecoverFromPanic(tool tools.Tool, projectSubPath string) {
+}
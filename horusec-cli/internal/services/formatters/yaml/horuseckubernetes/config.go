@@ -16,10 +16,10 @@ package horuseckubernetes
 
 const (
 	ImageName = "horuszup/horusec-kubernetes"
-	ImageTag  = "v1.0.0"
+	ImageTag  = "v1.1.0"
 	ImageCmd  = `
 		{{WORK_DIR}}
-		horusec-kubernetes run -o="./output-ANALYSISID.json"
-		cat ./output-ANALYSISID.json
+		horusec-kubernetes run -o="/tmp/output-ANALYSISID.json"
+		cat /tmp/output-ANALYSISID.json
   `
 )
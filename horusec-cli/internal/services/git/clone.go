@@ -0,0 +1,72 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+const tokenUser = "x-access-token"
+
+type CloneOptions struct {
+	URL        string
+	Branch     string
+	Token      string
+	SSHKeyPath string
+}
+
+// Clone shallow clones the repository in a new temporary directory, that must be removed by the caller. The token
+// and the ssh key are given to git by environment variables, keeping them out of the url and of the process args
+func Clone(options *CloneOptions) (string, error) {
+	if options.SSHKeyPath != "" {
+		if _, err := os.Stat(options.SSHKeyPath); err != nil {
+			return "", err
+		}
+	}
+	clonePath, err := ioutil.TempDir("", "horusec-clone-")
+	if err != nil {
+		return "", err
+	}
+	args := []string{"clone", "--depth", "1"}
+	if options.Branch != "" {
+		args = append(args, "--branch", options.Branch)
+	}
+	cmd := exec.Command("git", append(args, "--", options.URL, clonePath)...)
+	cmd.Env = append(os.Environ(), getCloneEnv(options)...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		_ = os.RemoveAll(clonePath)
+		return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return clonePath, nil
+}
+
+func getCloneEnv(options *CloneOptions) []string {
+	env := []string{"GIT_TERMINAL_PROMPT=0"}
+	if options.Token != "" {
+		credentials := base64.StdEncoding.EncodeToString([]byte(tokenUser + ":" + options.Token))
+		env = append(env, "GIT_CONFIG_COUNT=1", "GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+credentials)
+	}
+	if options.SSHKeyPath != "" {
+		env = append(env, "GIT_SSH_COMMAND=ssh -o IdentitiesOnly=yes -i '"+
+			strings.ReplaceAll(options.SSHKeyPath, "'", `'\''`)+"'")
+	}
+	return env
+}
@@ -0,0 +1,282 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitea
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+const (
+	// Context is the name of the commit status, the status with the same context is replaced in the next analyses
+	Context = "horusec"
+	// commentMarker finds the comment of horusec in the pull request to update it instead of adding a new one
+	commentMarker = "<!-- horusec -->"
+	// maxCommentsPages is the max of pages of the comments of the pull request searched for the comment of horusec
+	maxCommentsPages = 10
+	commentsByPage   = 50
+	requestTimeout   = 30
+)
+
+const (
+	StateSuccess = "success"
+	StateWarning = "warning"
+	StateFailure = "failure"
+)
+
+type Interface interface {
+	Publish(analysis *horusec.Analysis, totalVulns int, err error)
+}
+
+type CommitStatus struct {
+	State       string `json:"state"`
+	Context     string `json:"context"`
+	Description string `json:"description"`
+	TargetURL   string `json:"target_url,omitempty"`
+}
+
+type Comment struct {
+	ID   int64  `json:"id,omitempty"`
+	Body string `json:"body"`
+}
+
+type Gitea struct {
+	config     cliConfig.IConfig
+	httpClient client.Interface
+}
+
+func NewGitea(config cliConfig.IConfig) Interface {
+	return &Gitea{
+		config:     config,
+		httpClient: client.NewHTTPClient(requestTimeout),
+	}
+}
+
+// Publish does nothing without the gitea url. It uses the repository, the commit and the pull request of the source
+// of the analysis, detected from the CI environment or informed in the flags source-*, and the errors are only
+// logged, the result of the analysis doesn't change
+func (g *Gitea) Publish(analysis *horusec.Analysis, totalVulns int, err error) {
+	if g.config.GetGiteaURL() == "" {
+		return
+	}
+	repository := g.getRepository(analysis.Source)
+	if repository == "" || (analysis.Source.CommitSHA == "" && analysis.Source.PullRequest == "") {
+		logger.LogWarnWithLevel(messages.MsgWarnGiteaWithoutSource, logger.WarnLevel)
+		return
+	}
+	if analysis.Source.CommitSHA != "" {
+		g.logError(g.setCommitStatus(repository, analysis.Source.CommitSHA, g.newCommitStatus(analysis, totalVulns, err)))
+	}
+	if analysis.Source.PullRequest != "" {
+		g.logError(g.setComment(repository, analysis.Source.PullRequest, g.getComment(analysis, totalVulns, err)))
+	}
+}
+
+func (g *Gitea) logError(err error) {
+	if err != nil {
+		logger.LogWarnWithLevel(messages.MsgWarnGiteaPublishFailed, logger.WarnLevel, err.Error())
+	}
+}
+
+// getRepository returns the owner and the name of the repository, the last two parts of its http or ssh url
+func (g *Gitea) getRepository(source *horusec.SourceContext) string {
+	if source == nil {
+		return ""
+	}
+	parts := strings.FieldsFunc(strings.TrimSuffix(strings.TrimSuffix(source.RepositoryURL, "/"), ".git"),
+		func(char rune) bool {
+			return char == '/' || char == ':'
+		})
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[len(parts)-2] + "/" + parts[len(parts)-1]
+}
+
+// getState is like the exit code of horusec: the errors of the analysis and of the gates, and the blocking
+// vulnerabilities with return-error, are failure. The vulnerabilities that don't fail the analysis are warning
+func (g *Gitea) getState(analysis *horusec.Analysis, totalVulns int, err error) string {
+	if err != nil || (g.config.GetPolicyPath() == "" && g.config.GetReturnErrorIfFoundVulnerability() &&
+		totalVulns > 0) {
+		return StateFailure
+	}
+	if len(g.getVulnerabilities(analysis)) > 0 {
+		return StateWarning
+	}
+	return StateSuccess
+}
+
+func (g *Gitea) getDescription(analysis *horusec.Analysis, totalVulns int, err error) string {
+	if err != nil {
+		return "Analysis failed: " + g.truncate(err.Error(), 100)
+	}
+	return fmt.Sprintf("%d vulnerabilities found, %d blocking", len(g.getVulnerabilities(analysis)), totalVulns)
+}
+
+func (g *Gitea) newCommitStatus(analysis *horusec.Analysis, totalVulns int, err error) *CommitStatus {
+	status := &CommitStatus{
+		State:       g.getState(analysis, totalVulns, err),
+		Context:     Context,
+		Description: g.getDescription(analysis, totalVulns, err),
+	}
+	if analysis.Source.PullRequest != "" {
+		status.TargetURL = fmt.Sprintf("%s/%s/pulls/%s", g.getBaseURL(), g.getRepository(analysis.Source),
+			analysis.Source.PullRequest)
+	}
+	return status
+}
+
+// getComment is the markdown of the totals by severity and by tool and of the gate, with the marker used to find it
+// in the next analyses
+func (g *Gitea) getComment(analysis *horusec.Analysis, totalVulns int, err error) string {
+	vulnerabilities := g.getVulnerabilities(analysis)
+	comment := &strings.Builder{}
+	comment.WriteString(commentMarker + "\n### Horusec: " + g.getDescription(analysis, totalVulns, err) + "\n\n")
+	comment.WriteString("| Severity | Total |\n|---|---|\n")
+	for _, row := range g.getTotals(vulnerabilities, func(vuln *horusec.Vulnerability) string {
+		return vuln.Severity.ToString()
+	}) {
+		comment.WriteString(row)
+	}
+	comment.WriteString("\n| Tool | Total |\n|---|---|\n")
+	for _, row := range g.getTotals(vulnerabilities, func(vuln *horusec.Vulnerability) string {
+		return vuln.SecurityTool.ToString()
+	}) {
+		comment.WriteString(row)
+	}
+	if err != nil {
+		comment.WriteString("\n**Gate:** " + err.Error() + "\n")
+	}
+	return comment.String()
+}
+
+func (g *Gitea) getTotals(vulnerabilities []*horusec.Vulnerability,
+	getKey func(vuln *horusec.Vulnerability) string) (rows []string) {
+	totals := map[string]int{}
+	for _, vuln := range vulnerabilities {
+		totals[getKey(vuln)]++
+	}
+	for key, total := range totals {
+		rows = append(rows, fmt.Sprintf("| %s | %d |\n", key, total))
+	}
+	sort.Strings(rows)
+	return rows
+}
+
+// getVulnerabilities returns the vulnerabilities of the type vulnerability, without the false positives, the risk
+// accepted and the corrected
+func (g *Gitea) getVulnerabilities(analysis *horusec.Analysis) (vulnerabilities []*horusec.Vulnerability) {
+	for index := range analysis.AnalysisVulnerabilities {
+		if vuln := &analysis.AnalysisVulnerabilities[index].Vulnerability; vuln.Type == enumHorusec.Vulnerability {
+			vulnerabilities = append(vulnerabilities, vuln)
+		}
+	}
+	return vulnerabilities
+}
+
+func (g *Gitea) setCommitStatus(repository, commitSHA string, status *CommitStatus) error {
+	content, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return g.doRequest(http.MethodPost, fmt.Sprintf("/repos/%s/statuses/%s", repository, commitSHA), content, nil)
+}
+
+// setComment updates the comment of horusec of the analyses before, so the pull request has only one comment
+func (g *Gitea) setComment(repository, pullRequest, body string) error {
+	content, err := json.Marshal(&Comment{Body: body})
+	if err != nil {
+		return err
+	}
+	commentID, err := g.findComment(repository, pullRequest)
+	if err != nil {
+		return err
+	}
+	if commentID != 0 {
+		return g.doRequest(http.MethodPatch, fmt.Sprintf("/repos/%s/issues/comments/%d", repository, commentID),
+			content, nil)
+	}
+	return g.doRequest(http.MethodPost, fmt.Sprintf("/repos/%s/issues/%s/comments", repository, pullRequest),
+		content, nil)
+}
+
+func (g *Gitea) findComment(repository, pullRequest string) (int64, error) {
+	for page := 1; page <= maxCommentsPages; page++ {
+		comments := []Comment{}
+		if err := g.doRequest(http.MethodGet, fmt.Sprintf("/repos/%s/issues/%s/comments?limit=%d&page=%d",
+			repository, pullRequest, commentsByPage, page), nil, &comments); err != nil {
+			return 0, err
+		}
+		for _, comment := range comments {
+			if strings.HasPrefix(comment.Body, commentMarker) {
+				return comment.ID, nil
+			}
+		}
+		if len(comments) < commentsByPage {
+			break
+		}
+	}
+	return 0, nil
+}
+
+func (g *Gitea) doRequest(method, path string, content []byte, result interface{}) error {
+	req, err := http.NewRequest(method, g.getBaseURL()+"/api/v1"+path, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "token "+g.config.GetGiteaToken())
+
+	response, err := g.httpClient.DoRequest(req, nil)
+	if err != nil {
+		return err
+	}
+	defer response.CloseBody()
+
+	if response.GetStatusCode() < http.StatusOK || response.GetStatusCode() >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d in %s", response.GetStatusCode(), path)
+	}
+	if result == nil {
+		return nil
+	}
+	body, err := response.GetBody()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, result)
+}
+
+func (g *Gitea) getBaseURL() string {
+	return strings.TrimSuffix(g.config.GetGiteaURL(), "/")
+}
+
+func (g *Gitea) truncate(text string, size int) string {
+	if len(text) <= size {
+		return text
+	}
+	return text[:size-3] + "..."
+}
@@ -0,0 +1,141 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitea
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type request struct {
+	method        string
+	uri           string
+	authorization string
+	body          map[string]string
+}
+
+func newFakeServer(requests *[]request, comments []Comment) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received := request{method: r.Method, uri: r.URL.RequestURI(), authorization: r.Header.Get("Authorization")}
+		_ = json.Unmarshal(body, &received.body)
+		*requests = append(*requests, received)
+		if r.Method == http.MethodGet {
+			content, _ := json.Marshal(comments)
+			_, _ = w.Write(content)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+}
+
+func newService(giteaURL string, returnError bool) *Gitea {
+	configs := &config.Config{}
+	configs.SetGiteaURL(giteaURL)
+	configs.SetGiteaToken("token")
+	configs.SetReturnErrorIfFoundVulnerability(returnError)
+	return &Gitea{config: configs, httpClient: client.NewHTTPClient(requestTimeout)}
+}
+
+func newAnalysis(source *horusec.SourceContext, total int) *horusec.Analysis {
+	analysis := &horusec.Analysis{Source: source}
+	for index := 0; index < total; index++ {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities, horusec.AnalysisVulnerabilities{
+			Vulnerability: horusec.Vulnerability{SecurityTool: tools.GoSec, Severity: severity.High,
+				Type: enumHorusec.Vulnerability},
+		})
+	}
+	return analysis
+}
+
+func TestGitea_Publish(t *testing.T) {
+	t.Run("should set the commit status and add the comment in the pull request", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests, []Comment{{ID: 1, Body: "LGTM"}})
+		defer server.Close()
+		source := &horusec.SourceContext{RepositoryURL: server.URL + "/org/api.git", CommitSHA: "8a1b2c3",
+			PullRequest: "3"}
+
+		newService(server.URL, true).Publish(newAnalysis(source, 2), 2, nil)
+
+		assert.Len(t, requests, 3)
+		assert.Equal(t, http.MethodPost, requests[0].method)
+		assert.Equal(t, "/api/v1/repos/org/api/statuses/8a1b2c3", requests[0].uri)
+		assert.Equal(t, "token token", requests[0].authorization)
+		assert.Equal(t, StateFailure, requests[0].body["state"])
+		assert.Equal(t, Context, requests[0].body["context"])
+		assert.Equal(t, "2 vulnerabilities found, 2 blocking", requests[0].body["description"])
+		assert.Equal(t, server.URL+"/org/api/pulls/3", requests[0].body["target_url"])
+		assert.Equal(t, "/api/v1/repos/org/api/issues/3/comments?limit=50&page=1", requests[1].uri)
+		assert.Equal(t, http.MethodPost, requests[2].method)
+		assert.Equal(t, "/api/v1/repos/org/api/issues/3/comments", requests[2].uri)
+		assert.Contains(t, requests[2].body["body"], commentMarker)
+		assert.Contains(t, requests[2].body["body"], "| HIGH | 2 |")
+		assert.Contains(t, requests[2].body["body"], "| GoSec | 2 |")
+	})
+
+	t.Run("should update the comment of horusec of the analysis before", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests, []Comment{{ID: 1, Body: "LGTM"}, {ID: 9, Body: commentMarker + "\nold"}})
+		defer server.Close()
+		source := &horusec.SourceContext{RepositoryURL: "git@gitea.company.com:org/api.git", PullRequest: "3"}
+
+		newService(server.URL+"/", true).Publish(newAnalysis(source, 0), 0, errors.New("policy denied"))
+
+		assert.Len(t, requests, 2)
+		assert.Equal(t, http.MethodPatch, requests[1].method)
+		assert.Equal(t, "/api/v1/repos/org/api/issues/comments/9", requests[1].uri)
+		assert.Contains(t, requests[1].body["body"], "**Gate:** policy denied")
+	})
+
+	t.Run("should set the state by the vulnerabilities and the gates", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests, nil)
+		defer server.Close()
+		source := &horusec.SourceContext{RepositoryURL: "https://gitea.company.com/org/api", CommitSHA: "8a1b2c3"}
+
+		newService(server.URL, false).Publish(newAnalysis(source, 1), 1, nil)
+		newService(server.URL, false).Publish(newAnalysis(source, 0), 0, nil)
+
+		assert.Equal(t, StateWarning, requests[0].body["state"])
+		assert.Equal(t, StateSuccess, requests[1].body["state"])
+		assert.Empty(t, requests[1].body["target_url"])
+	})
+
+	t.Run("should not publish without the gitea url or the source of the analysis", func(t *testing.T) {
+		var requests []request
+		server := newFakeServer(&requests, nil)
+		defer server.Close()
+		source := &horusec.SourceContext{RepositoryURL: "https://gitea.company.com/org/api", CommitSHA: "8a1b2c3"}
+
+		newService("", true).Publish(newAnalysis(source, 1), 1, nil)
+		newService(server.URL, true).Publish(newAnalysis(nil, 1), 1, nil)
+		newService(server.URL, true).Publish(newAnalysis(&horusec.SourceContext{CommitSHA: "8a1b2c3"}, 1), 1, nil)
+
+		assert.Empty(t, requests)
+	})
+}
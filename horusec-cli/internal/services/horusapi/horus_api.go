@@ -18,50 +18,98 @@ import (
 	"bytes"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/api"
 	"github.com/google/uuid"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
 	httpResponse "github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/response"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+var errInvalidTLSConfig = errors.New("invalid tls config")
+
+const (
+	maxRetries     = 3
+	initialBackoff = time.Second
 )
 
 type IService interface {
 	SendAnalysis(analysis *horusec.Analysis)
 	GetAnalysis(analysisID uuid.UUID) *horusec.Analysis
+	FlushQueue() (totalSent int, err error)
 }
 
 type Service struct {
 	httpUtil client.Interface
 	config   cliConfig.IConfig
+	sleep    func(time.Duration)
 }
 
 func NewHorusecAPIService(config cliConfig.IConfig) IService {
 	return &Service{
 		httpUtil: client.NewHTTPClient(10),
 		config:   config,
+		sleep:    time.Sleep,
 	}
 }
 
+// SendAnalysis retries with backoff when horusec platform is unreachable or fails, and then keeps the analysis
+// in the offline queue. After a successful send the analyses of the queue are sent too
 func (s *Service) SendAnalysis(analysis *horusec.Analysis) {
 	if s.config.IsEmptyRepositoryAuthorization() || s.config.GetIsTimeout() {
 		return
 	}
 
-	response, err := s.sendCreateAnalysisRequest(analysis)
+	payload := s.newRequestData(analysis)
+	isRetryable, err := s.sendWithRetry(payload)
 	if err != nil {
 		s.loggerSendError(err)
+		if isRetryable {
+			s.enqueue(analysis.ID, payload)
+		}
 		return
 	}
+
+	s.flushQueueAfterSend()
+}
+
+func (s *Service) sendWithRetry(payload []byte) (isRetryable bool, err error) {
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			backoff := initialBackoff * time.Duration(1<<uint(attempt-1))
+			logger.LogDebugWithLevel(messages.MsgDebugRetrySendAnalysis+backoff.String(), logger.DebugLevel)
+			s.sleep(backoff)
+		}
+		isRetryable, err = s.send(payload)
+		if err == nil || !isRetryable {
+			return isRetryable, err
+		}
+	}
+
+	return isRetryable, err
+}
+
+// send returns if the error is temporary: the platform is unreachable, overloaded or failed
+func (s *Service) send(payload []byte) (isRetryable bool, err error) {
+	response, err := s.sendCreateAnalysisRequest(payload)
+	if err != nil {
+		return !errors.Is(err, errInvalidTLSConfig), err
+	}
 	defer response.CloseBody()
 
-	s.loggerSendError(s.verifyResponseCreateAnalysis(response))
+	statusCode := response.GetStatusCode()
+	return statusCode >= http.StatusInternalServerError || statusCode == http.StatusTooManyRequests,
+		s.verifyResponseCreateAnalysis(response)
 }
 
 func (s *Service) GetAnalysis(analysisID uuid.UUID) *horusec.Analysis {
@@ -95,15 +143,15 @@ func (s *Service) sendFindAnalysisRequest(analysisID uuid.UUID) (httpResponse.In
 	return s.httpUtil.DoRequest(req, tlsConfig)
 }
 
-func (s *Service) sendCreateAnalysisRequest(analysis *horusec.Analysis) (httpResponse.Interface, error) {
-	req, err := http.NewRequest(http.MethodPost, s.getHorusecAPIURL(), bytes.NewReader(s.newRequestData(analysis)))
+func (s *Service) sendCreateAnalysisRequest(payload []byte) (httpResponse.Interface, error) {
+	req, err := http.NewRequest(http.MethodPost, s.getHorusecAPIURL(), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
 
 	tlsConfig, err := s.setTLSConfig()
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %s", errInvalidTLSConfig, err.Error())
 	}
 
 	s.addHeaders(req)
@@ -166,13 +214,30 @@ func (s *Service) setTLSConfig() (*tls.Config, error) {
 
 func (s *Service) newRequestData(analysis *horusec.Analysis) []byte {
 	analysisData := &api.AnalysisData{
-		Analysis:       analysis,
+		Analysis:       s.getAnalysisToPlatform(analysis),
 		RepositoryName: s.config.GetRepositoryName(),
 	}
 
 	return analysisData.ToBytes()
 }
 
+// getAnalysisToPlatform sends the CRITICAL vulnerabilities as HIGH, because horusec platform has no severity above
+// HIGH. The analysis of the outputs of the cli keeps the CRITICAL
+func (s *Service) getAnalysisToPlatform(analysis *horusec.Analysis) *horusec.Analysis {
+	platformAnalysis := *analysis
+	platformAnalysis.AnalysisVulnerabilities = make([]horusec.AnalysisVulnerabilities,
+		len(analysis.AnalysisVulnerabilities))
+	copy(platformAnalysis.AnalysisVulnerabilities, analysis.AnalysisVulnerabilities)
+	for index := range platformAnalysis.AnalysisVulnerabilities {
+		vulnerability := &platformAnalysis.AnalysisVulnerabilities[index].Vulnerability
+		if vulnerability.Severity == severity.Critical {
+			vulnerability.Severity = severity.High
+		}
+	}
+
+	return &platformAnalysis
+}
+
 func (s *Service) addHeaders(req *http.Request) {
 	req.Header.Add("X-Horusec-Authorization", s.config.GetRepositoryAuthorization())
 	for key, value := range s.config.GetHeaders() {
@@ -16,6 +16,7 @@ package horusapi
 
 import (
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	mockUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/mock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
@@ -32,3 +33,8 @@ func (m *Mock) GetAnalysis(analysisID uuid.UUID) *horusec.Analysis {
 	args := m.MethodCalled("GetAnalysis")
 	return args.Get(0).(*horusec.Analysis)
 }
+
+func (m *Mock) FlushQueue() (totalSent int, err error) {
+	args := m.MethodCalled("FlushQueue")
+	return args.Get(0).(int), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -16,17 +16,22 @@ package horusapi
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	http2 "github.com/ZupIT/horusec/development-kit/pkg/entities/http"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/test"
 	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/api"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
 	httpResponse "github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/response"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
@@ -99,15 +104,66 @@ func TestSendAnalysis(t *testing.T) {
 		httpMock.On("DoRequest").Return(httpResponse.NewHTTPResponse(response), errors.New("test"))
 		config := &cliConfig.Config{}
 		config.SetRepositoryAuthorization("test")
+		config.SetQueueDir(newQueueDir(t))
+		defer os.RemoveAll(config.GetQueueDir())
 
 		service := Service{
 			httpUtil: httpMock,
 			config:   config,
+			sleep:    func(time.Duration) {},
 		}
 
 		assert.NotPanics(t, func() {
 			service.SendAnalysis(analysis)
 		})
+		httpMock.AssertNumberOfCalls(t, "DoRequest", maxRetries+1)
+		assert.FileExists(t, filepath.Join(config.GetQueueDir(), analysis.ID.String()+".json"))
+	})
+
+	t.Run("should retry and send analysis when platform is back", func(t *testing.T) {
+		analysis := &horusec.Analysis{ID: uuid.New()}
+
+		httpMock := &client.Mock{}
+		httpMock.On("DoRequest").
+			Return(httpResponse.NewHTTPResponse(&http.Response{StatusCode: 503,
+				Body: ioutil.NopCloser(strings.NewReader("unavailable"))}), nil).Once()
+		httpMock.On("DoRequest").Return(httpResponse.NewHTTPResponse(&http.Response{StatusCode: 201}), nil)
+		config := &cliConfig.Config{}
+		config.SetRepositoryAuthorization("test")
+		config.SetQueueDir(newQueueDir(t))
+		defer os.RemoveAll(config.GetQueueDir())
+		var backoffs []time.Duration
+
+		service := Service{
+			httpUtil: httpMock,
+			config:   config,
+			sleep: func(backoff time.Duration) {
+				backoffs = append(backoffs, backoff)
+			},
+		}
+
+		service.SendAnalysis(analysis)
+		httpMock.AssertNumberOfCalls(t, "DoRequest", 2)
+		assert.Equal(t, []time.Duration{initialBackoff}, backoffs)
+		assert.NoFileExists(t, filepath.Join(config.GetQueueDir(), analysis.ID.String()+".json"))
+	})
+
+	t.Run("should not retry or queue when platform refuses the analysis", func(t *testing.T) {
+		analysis := &horusec.Analysis{ID: uuid.New()}
+
+		httpMock := &client.Mock{}
+		httpMock.On("DoRequest").Return(httpResponse.NewHTTPResponse(&http.Response{StatusCode: 401,
+			Body: ioutil.NopCloser(strings.NewReader("unauthorized"))}), nil)
+		config := &cliConfig.Config{}
+		config.SetRepositoryAuthorization("test")
+		config.SetQueueDir(newQueueDir(t))
+		defer os.RemoveAll(config.GetQueueDir())
+
+		service := Service{httpUtil: httpMock, config: config, sleep: func(time.Duration) {}}
+
+		service.SendAnalysis(analysis)
+		httpMock.AssertNumberOfCalls(t, "DoRequest", 1)
+		assert.NoFileExists(t, filepath.Join(config.GetQueueDir(), analysis.ID.String()+".json"))
 	})
 
 	t.Run("should return nil when no authorization token", func(t *testing.T) {
@@ -134,10 +190,13 @@ func TestSendAnalysis(t *testing.T) {
 		config.SetRepositoryAuthorization("test")
 		config.SetCertPath("./horus_api.go")
 		config.SetCertInsecureSkipVerify(true)
+		config.SetQueueDir(newQueueDir(t))
+		defer os.RemoveAll(config.GetQueueDir())
 
 		service := Service{
 			httpUtil: httpMock,
 			config:   config,
+			sleep:    func(time.Duration) {},
 		}
 
 		assert.NotPanics(t, func() {
@@ -168,6 +227,62 @@ func TestSendAnalysis(t *testing.T) {
 	})
 }
 
+func newQueueDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "horusec-queue")
+	assert.NoError(t, err)
+	return dir
+}
+
+func TestService_FlushQueue(t *testing.T) {
+	t.Run("should send the analyses of the queue of the authorization", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetQueueDir(newQueueDir(t))
+		defer os.RemoveAll(config.GetQueueDir())
+		config.SetRepositoryAuthorization("other")
+		service := Service{config: config}
+		service.enqueue(uuid.New(), []byte(`{}`))
+		config.SetRepositoryAuthorization("test")
+		service.enqueue(uuid.New(), []byte(`{}`))
+
+		httpMock := &client.Mock{}
+		httpMock.On("DoRequest").Return(httpResponse.NewHTTPResponse(&http.Response{StatusCode: 201}), nil)
+		service.httpUtil = httpMock
+
+		totalSent, err := service.FlushQueue()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, totalSent)
+		files, _ := ioutil.ReadDir(config.GetQueueDir())
+		assert.Len(t, files, 1)
+	})
+
+	t.Run("should keep the analyses in the queue when send fails", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetQueueDir(newQueueDir(t))
+		defer os.RemoveAll(config.GetQueueDir())
+		config.SetRepositoryAuthorization("test")
+		httpMock := &client.Mock{}
+		httpMock.On("DoRequest").Return(httpResponse.NewHTTPResponse(&http.Response{}), errors.New("test"))
+		service := Service{httpUtil: httpMock, config: config}
+		service.enqueue(uuid.New(), []byte(`{}`))
+
+		totalSent, err := service.FlushQueue()
+		assert.Error(t, err)
+		assert.Equal(t, 0, totalSent)
+		files, _ := ioutil.ReadDir(config.GetQueueDir())
+		assert.Len(t, files, 1)
+	})
+
+	t.Run("should return no error when queue not exists", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetQueueDir("./not-exists")
+		service := Service{config: config}
+
+		totalSent, err := service.FlushQueue()
+		assert.NoError(t, err)
+		assert.Equal(t, 0, totalSent)
+	})
+}
+
 func TestService_GetAnalysis(t *testing.T) {
 	t.Run("should get analysis with no errors", func(t *testing.T) {
 		analysisContent := test.CreateAnalysisMock()
@@ -254,3 +369,23 @@ func TestService_GetAnalysis(t *testing.T) {
 		assert.Empty(t, analysisResponse)
 	})
 }
+
+func TestService_NewRequestData(t *testing.T) {
+	t.Run("should send the critical vulnerabilities as high keeping the analysis of the cli", func(t *testing.T) {
+		analysis := &horusec.Analysis{ID: uuid.New(), AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{Severity: severity.Critical,
+				VerificationStatus: enumHorusec.VerifiedActive}},
+			{Vulnerability: horusec.Vulnerability{Severity: severity.Low}},
+		}}
+		service := Service{config: &cliConfig.Config{}}
+
+		analysisData := &api.AnalysisData{}
+		assert.NoError(t, json.Unmarshal(service.newRequestData(analysis), analysisData))
+
+		sent := analysisData.Analysis.AnalysisVulnerabilities
+		assert.Equal(t, severity.High, sent[0].Vulnerability.Severity)
+		assert.Equal(t, enumHorusec.VerifiedActive, sent[0].Vulnerability.VerificationStatus)
+		assert.Equal(t, severity.Low, sent[1].Vulnerability.Severity)
+		assert.Equal(t, severity.Critical, analysis.AnalysisVulnerabilities[0].Vulnerability.Severity)
+	})
+}
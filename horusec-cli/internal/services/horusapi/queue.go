@@ -0,0 +1,134 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+const queueFileExtension = ".json"
+
+// queueItem keeps only the hash of the authorization, so the token is not written in the disk and the
+// analyses are sent only to the repository of the token used when they were queued
+type queueItem struct {
+	AuthorizationHash string          `json:"authorizationHash"`
+	Payload           json.RawMessage `json:"payload"`
+}
+
+func (s *Service) enqueue(analysisID uuid.UUID, payload []byte) {
+	content, err := json.Marshal(&queueItem{AuthorizationHash: s.getAuthorizationHash(), Payload: payload})
+	if err == nil {
+		err = os.MkdirAll(s.config.GetQueueDir(), os.ModePerm)
+	}
+	if err == nil {
+		err = ioutil.WriteFile(s.getQueueFilePath(analysisID.String()), content, 0600)
+	}
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorSaveAnalysisInQueue, err, logger.ErrorLevel)
+		return
+	}
+
+	logger.LogWarnWithLevel(messages.MsgWarnAnalysisSavedInQueue+s.config.GetQueueDir(), logger.WarnLevel)
+}
+
+// FlushQueue sends the analyses of the queue of the current authorization, stopping in the first error
+func (s *Service) FlushQueue() (totalSent int, err error) {
+	fileNames, err := s.getQueueFileNames()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, fileName := range fileNames {
+		item, err := s.readQueueItem(fileName)
+		if err != nil {
+			return totalSent, err
+		}
+		if item.AuthorizationHash != s.getAuthorizationHash() {
+			continue
+		}
+		if _, err := s.send(item.Payload); err != nil {
+			return totalSent, err
+		}
+		if err := os.Remove(filepath.Join(s.config.GetQueueDir(), fileName)); err != nil {
+			return totalSent, err
+		}
+		totalSent++
+	}
+
+	return totalSent, nil
+}
+
+func (s *Service) flushQueueAfterSend() {
+	totalSent, err := s.FlushQueue()
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorFlushQueue, err, logger.ErrorLevel)
+	}
+	if totalSent > 0 {
+		logger.LogInfoWithLevel(messages.MsgInfoAnalysesSentFromQueue+strconv.Itoa(totalSent), logger.InfoLevel)
+	}
+}
+
+func (s *Service) getQueueFileNames() (fileNames []string, err error) {
+	files, err := ioutil.ReadDir(s.config.GetQueueDir())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), queueFileExtension) {
+			fileNames = append(fileNames, file.Name())
+		}
+	}
+
+	return fileNames, nil
+}
+
+func (s *Service) readQueueItem(fileName string) (*queueItem, error) {
+	content, err := ioutil.ReadFile(filepath.Join(s.config.GetQueueDir(), fileName))
+	if err != nil {
+		return nil, err
+	}
+
+	item := &queueItem{}
+	return item, json.Unmarshal(content, item)
+}
+
+func (s *Service) getQueueFilePath(name string) string {
+	return filepath.Join(s.config.GetQueueDir(), name+queueFileExtension)
+}
+
+func (s *Service) getAuthorizationHash() string {
+	hash := sha256.Sum256([]byte(s.config.GetRepositoryAuthorization()))
+	return hex.EncodeToString(hash[:])
+}
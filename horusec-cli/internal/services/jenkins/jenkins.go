@@ -0,0 +1,103 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jenkins
+
+import (
+	"strconv"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	horusecSeverity "github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/jenkins"
+)
+
+const (
+	Origin     = "horusec"
+	OriginName = "Horusec"
+)
+
+type Interface interface {
+	ConvertVulnerabilityDataToJenkins() jenkins.Report
+}
+
+type Jenkins struct {
+	analysis *horusecEntities.Analysis
+}
+
+func NewJenkins(analysis *horusecEntities.Analysis) Interface {
+	return &Jenkins{
+		analysis: analysis,
+	}
+}
+
+// ConvertVulnerabilityDataToJenkins writes only the vulnerabilities of the type vulnerability, the false positives,
+// risk accepted and corrected are not issues to the plugin. The hash of the vulnerability is the fingerprint used by
+// the plugin to find the new and the fixed issues of each build
+func (j *Jenkins) ConvertVulnerabilityDataToJenkins() jenkins.Report {
+	report := jenkins.Report{Issues: []jenkins.Issue{}}
+	for index := range j.analysis.AnalysisVulnerabilities {
+		vulnerability := &j.analysis.AnalysisVulnerabilities[index].Vulnerability
+		if vulnerability.Type != enumHorusec.Vulnerability {
+			continue
+		}
+		report.Issues = append(report.Issues, j.newIssue(vulnerability))
+	}
+	report.Size = len(report.Issues)
+	return report
+}
+
+func (j *Jenkins) newIssue(vulnerability *horusecEntities.Vulnerability) jenkins.Issue {
+	line, _ := strconv.Atoi(vulnerability.Line)
+	column, _ := strconv.Atoi(vulnerability.Column)
+	return jenkins.Issue{
+		FileName:    vulnerability.File,
+		LineStart:   line,
+		LineEnd:     line,
+		ColumnStart: column,
+		ColumnEnd:   column,
+		Category:    vulnerability.SecurityTool.ToString(),
+		Type:        j.getType(vulnerability),
+		Severity:    j.convertHorusecSeverityToJenkins(vulnerability.Severity),
+		Message:     vulnerability.Details,
+		Description: vulnerability.Code,
+		ModuleName:  vulnerability.Language.ToString(),
+		Origin:      Origin,
+		OriginName:  OriginName,
+		Fingerprint: vulnerability.VulnHash,
+	}
+}
+
+func (j *Jenkins) getType(vulnerability *horusecEntities.Vulnerability) string {
+	if vulnerability.RuleID != "" {
+		return vulnerability.RuleID
+	}
+	return vulnerability.SecurityTool.ToString()
+}
+
+func (j *Jenkins) convertHorusecSeverityToJenkins(severity horusecSeverity.Severity) string {
+	if jenkinsSeverity, ok := j.getJenkinsSeverityMap()[severity]; ok {
+		return jenkinsSeverity
+	}
+	return "LOW"
+}
+
+func (j *Jenkins) getJenkinsSeverityMap() map[horusecSeverity.Severity]string {
+	return map[horusecSeverity.Severity]string{
+		horusecSeverity.Critical: "ERROR",
+		horusecSeverity.High:     "HIGH",
+		horusecSeverity.Medium:   "NORMAL",
+		horusecSeverity.Low:      "LOW",
+	}
+}
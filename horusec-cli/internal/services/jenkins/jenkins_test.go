@@ -0,0 +1,92 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jenkins
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/jenkins"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysis() *horusec.Analysis {
+	return &horusec.Analysis{
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{
+				Vulnerability: horusec.Vulnerability{
+					Line:         "10",
+					Column:       "4",
+					File:         "main.go",
+					Code:         "exec.Command(input)",
+					Details:      "Command injection",
+					Severity:     severity.Critical,
+					SecurityTool: tools.GoSec,
+					Language:     languages.Go,
+					RuleID:       "G204",
+					VulnHash:     "hash-1",
+					Type:         enumHorusec.Vulnerability,
+				},
+			},
+			{
+				Vulnerability: horusec.Vulnerability{
+					File:         "package-lock.json",
+					Details:      "Prototype pollution",
+					Severity:     severity.Medium,
+					SecurityTool: tools.NpmAudit,
+					Language:     languages.Javascript,
+					VulnHash:     "hash-2",
+					Type:         enumHorusec.Vulnerability,
+				},
+			},
+			{
+				Vulnerability: horusec.Vulnerability{
+					File:         "app.py",
+					Details:      "Hardcoded password",
+					Severity:     severity.High,
+					SecurityTool: tools.Bandit,
+					VulnHash:     "hash-3",
+					Type:         enumHorusec.FalsePositive,
+				},
+			},
+		},
+	}
+}
+
+func TestConvertVulnerabilityDataToJenkins(t *testing.T) {
+	t.Run("should convert the vulnerabilities to the issues of warnings-ng", func(t *testing.T) {
+		report := NewJenkins(newAnalysis()).ConvertVulnerabilityDataToJenkins()
+
+		assert.Equal(t, 2, report.Size)
+		assert.Equal(t, jenkins.Issue{FileName: "main.go", LineStart: 10, LineEnd: 10, ColumnStart: 4, ColumnEnd: 4,
+			Category: "GoSec", Type: "G204", Severity: "ERROR", Message: "Command injection",
+			Description: "exec.Command(input)", ModuleName: "Go", Origin: Origin, OriginName: OriginName,
+			Fingerprint: "hash-1"}, report.Issues[0])
+		assert.Equal(t, "NpmAudit", report.Issues[1].Type)
+		assert.Equal(t, "NORMAL", report.Issues[1].Severity)
+		assert.Zero(t, report.Issues[1].LineStart)
+	})
+
+	t.Run("should write an empty list of issues without vulnerabilities", func(t *testing.T) {
+		report := NewJenkins(&horusec.Analysis{}).ConvertVulnerabilityDataToJenkins()
+
+		assert.NotNil(t, report.Issues)
+		assert.Zero(t, report.Size)
+	})
+}
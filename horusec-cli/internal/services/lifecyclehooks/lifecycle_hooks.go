@@ -0,0 +1,201 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecyclehooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/lifecyclehooks"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/events"
+)
+
+var ErrAborted = errors.New("{HORUSEC_CLI} analysis aborted by a lifecycle hook")
+
+type Stage string
+
+const (
+	PreAnalysis  Stage = "preAnalysis"
+	PostTool     Stage = "postTool"
+	PostAnalysis Stage = "postAnalysis"
+)
+
+const (
+	defaultTimeoutInSeconds = 30
+	subscriberName          = "lifecycle-hooks"
+)
+
+// Payload is the current state of the analysis sent to the hooks, the fields of the tool are only in the postTool
+type Payload struct {
+	Stage          Stage             `json:"stage"`
+	AnalysisID     string            `json:"analysisID"`
+	ProjectPath    string            `json:"projectPath"`
+	Tool           tools.Tool        `json:"tool,omitempty"`
+	ProjectSubPath string            `json:"projectSubPath,omitempty"`
+	Status         string            `json:"status,omitempty"`
+	Error          string            `json:"error,omitempty"`
+	Analysis       *horusec.Analysis `json:"analysis,omitempty"`
+}
+
+type Interface interface {
+	RunPreAnalysis(analysis *horusec.Analysis) error
+	StartPostTool()
+	StopPostTool()
+	IsAborted() bool
+	RunPostAnalysis(analysis *horusec.Analysis, err error) error
+}
+
+type LifecycleHooks struct {
+	config  cliConfig.IConfig
+	mutex   sync.Mutex
+	aborted bool
+}
+
+func NewLifecycleHooks(config cliConfig.IConfig) Interface {
+	return &LifecycleHooks{config: config}
+}
+
+// RunPreAnalysis runs before the project is copied, so a hook can warm caches or abort the analysis
+func (l *LifecycleHooks) RunPreAnalysis(analysis *horusec.Analysis) error {
+	payload := l.newPayload(PreAnalysis, analysis.GetIDString())
+	payload.Analysis = analysis
+	return l.run(l.config.GetLifecycleHooks().PreAnalysis, payload)
+}
+
+// StartPostTool runs the hooks when each tool finishes. The tools already running when a hook aborts the analysis
+// are stopped by the analyser, that checks IsAborted while it waits the tools
+func (l *LifecycleHooks) StartPostTool() {
+	if len(l.config.GetLifecycleHooks().PostTool) == 0 {
+		return
+	}
+	events.Subscribe(subscriberName, events.SubscriberFunc(l.handleToolFinished))
+}
+
+func (l *LifecycleHooks) StopPostTool() {
+	events.Unsubscribe(subscriberName)
+}
+
+func (l *LifecycleHooks) handleToolFinished(event *events.Event) {
+	if event.Type != events.ToolFinished || l.IsAborted() {
+		return
+	}
+	payload := l.newPayload(PostTool, event.AnalysisID)
+	payload.Tool, payload.ProjectSubPath = event.Tool, event.ProjectSubPath
+	payload.Status, payload.Error = event.Status, event.Error
+	if err := l.run(l.config.GetLifecycleHooks().PostTool, payload); err != nil {
+		l.mutex.Lock()
+		defer l.mutex.Unlock()
+		l.aborted = true
+	}
+}
+
+func (l *LifecycleHooks) IsAborted() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.aborted
+}
+
+// RunPostAnalysis has the error of the analysis, like the gates that failed. A hook that aborts fails the analysis
+func (l *LifecycleHooks) RunPostAnalysis(analysis *horusec.Analysis, err error) error {
+	payload := l.newPayload(PostAnalysis, analysis.GetIDString())
+	payload.Analysis = analysis
+	if err != nil {
+		payload.Error = err.Error()
+	}
+	return l.run(l.config.GetLifecycleHooks().PostAnalysis, payload)
+}
+
+func (l *LifecycleHooks) newPayload(stage Stage, analysisID string) *Payload {
+	return &Payload{Stage: stage, AnalysisID: analysisID, ProjectPath: l.config.GetProjectPath()}
+}
+
+// run stops in the first hook that fails with abort on failure, the other failures are only logged
+func (l *LifecycleHooks) run(hooks []lifecyclehooks.Hook, payload *Payload) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+	content, err := json.Marshal(payload)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorRunLifecycleHook+string(payload.Stage), err, logger.ErrorLevel)
+		return nil
+	}
+	for index := range hooks {
+		err := l.runHook(&hooks[index], content)
+		logger.LogErrorWithLevel(messages.MsgErrorRunLifecycleHook+string(payload.Stage), err, logger.ErrorLevel)
+		if err != nil && hooks[index].AbortOnFailure {
+			return fmt.Errorf("%w: %s %s", ErrAborted, payload.Stage, err)
+		}
+	}
+	return nil
+}
+
+func (l *LifecycleHooks) runHook(hook *lifecyclehooks.Hook, content []byte) error {
+	timeout := hook.TimeoutInSeconds
+	if timeout <= 0 {
+		timeout = defaultTimeoutInSeconds
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	defer cancel()
+	if len(hook.Command) > 0 {
+		return l.runCommand(ctx, hook, content)
+	}
+	return l.sendRequest(ctx, hook, content)
+}
+
+// runCommand writes the output of the command in the stderr, so the stdout is kept to the reports
+func (l *LifecycleHooks) runCommand(ctx context.Context, hook *lifecyclehooks.Hook, content []byte) error {
+	cmd := exec.CommandContext(ctx, hook.Command[0], hook.Command[1:]...)
+	cmd.Stdin = bytes.NewReader(content)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func (l *LifecycleHooks) sendRequest(ctx context.Context, hook *lifecyclehooks.Hook, content []byte) error {
+	method := hook.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	req, err := http.NewRequestWithContext(ctx, method, hook.URL, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range hook.Headers {
+		req.Header.Set(key, value)
+	}
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d", response.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecyclehooks
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/lifecyclehooks"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/events"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newConfig(hooks lifecyclehooks.LifecycleHooks) *cliConfig.Config {
+	config := &cliConfig.Config{}
+	config.SetProjectPath("./")
+	config.SetLifecycleHooks(hooks)
+	return config
+}
+
+func TestRunPreAnalysis(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell scripts are not available on windows")
+	}
+	dir, err := ioutil.TempDir("", "horusec-lifecycle-hooks")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	t.Run("Should send the payload in the stdin of the command", func(t *testing.T) {
+		output := filepath.Join(dir, "payload.json")
+		hooks := lifecyclehooks.LifecycleHooks{PreAnalysis: []lifecyclehooks.Hook{
+			{Command: []string{"sh", "-c", "cat > " + output}}}}
+		analysis := &horusec.Analysis{ID: uuid.New()}
+
+		assert.NoError(t, NewLifecycleHooks(newConfig(hooks)).RunPreAnalysis(analysis))
+		content, err := ioutil.ReadFile(output)
+		assert.NoError(t, err)
+		payload := &Payload{}
+		assert.NoError(t, json.Unmarshal(content, payload))
+		assert.Equal(t, PreAnalysis, payload.Stage)
+		assert.Equal(t, analysis.GetIDString(), payload.AnalysisID)
+	})
+
+	t.Run("Should abort only when the hook that failed has abort on failure", func(t *testing.T) {
+		hooks := lifecyclehooks.LifecycleHooks{PreAnalysis: []lifecyclehooks.Hook{{Command: []string{"false"}}}}
+		assert.NoError(t, NewLifecycleHooks(newConfig(hooks)).RunPreAnalysis(&horusec.Analysis{}))
+
+		hooks.PreAnalysis[0].AbortOnFailure = true
+		err := NewLifecycleHooks(newConfig(hooks)).RunPreAnalysis(&horusec.Analysis{})
+		assert.True(t, errors.Is(err, ErrAborted))
+	})
+}
+
+func TestRunPostAnalysis(t *testing.T) {
+	t.Run("Should send the payload to the url with the headers", func(t *testing.T) {
+		payload := &Payload{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPut, r.Method)
+			assert.Equal(t, "token", r.Header.Get("Authorization"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(payload))
+		}))
+		defer server.Close()
+		hooks := lifecyclehooks.LifecycleHooks{PostAnalysis: []lifecyclehooks.Hook{
+			{URL: server.URL, Method: http.MethodPut, Headers: map[string]string{"Authorization": "token"}}}}
+
+		err := NewLifecycleHooks(newConfig(hooks)).RunPostAnalysis(&horusec.Analysis{}, errors.New("gate failed"))
+		assert.NoError(t, err)
+		assert.Equal(t, PostAnalysis, payload.Stage)
+		assert.Equal(t, "gate failed", payload.Error)
+	})
+
+	t.Run("Should abort when the url returns an error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+		hooks := lifecyclehooks.LifecycleHooks{PostAnalysis: []lifecyclehooks.Hook{
+			{URL: server.URL, AbortOnFailure: true}}}
+
+		err := NewLifecycleHooks(newConfig(hooks)).RunPostAnalysis(&horusec.Analysis{}, nil)
+		assert.True(t, errors.Is(err, ErrAborted))
+	})
+}
+
+func TestStartPostTool(t *testing.T) {
+	t.Run("Should abort the analysis when the hook of a tool fails", func(t *testing.T) {
+		var payload *Payload
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			payload = &Payload{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(payload))
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		hooks := lifecyclehooks.LifecycleHooks{PostTool: []lifecyclehooks.Hook{
+			{URL: server.URL, AbortOnFailure: true}}}
+		service := NewLifecycleHooks(newConfig(hooks))
+
+		service.StartPostTool()
+		events.Publish(events.NewToolStarted("id", tools.GoSec, "api"))
+		assert.False(t, service.IsAborted())
+		events.Publish(events.NewToolFinished("id", tools.GoSec, "api", nil))
+		service.StopPostTool()
+
+		assert.True(t, service.IsAborted())
+		assert.Equal(t, PostTool, payload.Stage)
+		assert.Equal(t, tools.GoSec, payload.Tool)
+		assert.Equal(t, "api", payload.ProjectSubPath)
+	})
+}
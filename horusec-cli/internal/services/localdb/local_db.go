@@ -0,0 +1,344 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localdb
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	_ "modernc.org/sqlite" // sqlite driver of database/sql, written in go so it doesn't need cgo
+)
+
+const (
+	driverName = "sqlite"
+
+	FormatTable = "table"
+	FormatCSV   = "csv"
+	FormatJSON  = "json"
+)
+
+// Schema is created in each analysis stored, so the database file is created by the first analysis
+const Schema = `CREATE TABLE IF NOT EXISTS analyses (
+  id TEXT PRIMARY KEY,
+  repository_name TEXT,
+  status TEXT,
+  created_at TEXT,
+  finished_at TEXT,
+  duration_seconds REAL,
+  branch TEXT,
+  commit_hash TEXT,
+  risk_score INTEGER,
+  risk_grade TEXT
+);
+CREATE TABLE IF NOT EXISTS vulnerabilities (
+  id TEXT PRIMARY KEY,
+  analysis_id TEXT NOT NULL,
+  vuln_hash TEXT,
+  severity TEXT,
+  type TEXT,
+  security_tool TEXT,
+  language TEXT,
+  rule_id TEXT,
+  file TEXT,
+  line TEXT,
+  details TEXT,
+  commit_hash TEXT,
+  commit_author TEXT
+);
+CREATE TABLE IF NOT EXISTS tool_executions (
+  analysis_id TEXT NOT NULL,
+  tool TEXT,
+  project_sub_path TEXT,
+  status TEXT,
+  duration_seconds REAL
+);
+CREATE INDEX IF NOT EXISTS vulnerabilities_analysis_id ON vulnerabilities (analysis_id);
+CREATE INDEX IF NOT EXISTS vulnerabilities_vuln_hash ON vulnerabilities (vuln_hash);
+CREATE INDEX IF NOT EXISTS tool_executions_analysis_id ON tool_executions (analysis_id);
+`
+
+// historyQuery counts only the vulnerabilities of type Vulnerability, like the ones that fail the analysis.
+// The repository name is bound to both parameters, an empty name returns the analyses of all repositories
+const historyQuery = `SELECT a.created_at, a.repository_name, a.branch, a.commit_hash, a.status,
+  ROUND(a.duration_seconds) AS duration_seconds, a.risk_grade,
+  COUNT(v.id) AS vulnerabilities,
+  SUM(CASE WHEN v.severity = 'CRITICAL' THEN 1 ELSE 0 END) AS critical,
+  SUM(CASE WHEN v.severity = 'HIGH' THEN 1 ELSE 0 END) AS high,
+  SUM(CASE WHEN v.severity = 'MEDIUM' THEN 1 ELSE 0 END) AS medium,
+  SUM(CASE WHEN v.severity = 'LOW' THEN 1 ELSE 0 END) AS low
+FROM analyses a
+LEFT JOIN vulnerabilities v ON v.analysis_id = a.id AND v.type = 'Vulnerability'
+WHERE ? = '' OR a.repository_name = ?
+GROUP BY a.id
+ORDER BY a.created_at DESC
+LIMIT ?;`
+
+const (
+	insertAnalysis      = `INSERT INTO analyses VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
+	insertVulnerability = `INSERT INTO vulnerabilities VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
+	insertToolExecution = `INSERT INTO tool_executions VALUES (?, ?, ?, ?, ?);`
+)
+
+type Interface interface {
+	Store(analysis *horusec.Analysis)
+	Query(query, format string) ([]byte, error)
+	History(repositoryName, format string, limit int) ([]byte, error)
+}
+
+type LocalDB struct {
+	config cliConfig.IConfig
+}
+
+func NewLocalDB(config cliConfig.IConfig) Interface {
+	return &LocalDB{
+		config: config,
+	}
+}
+
+// Formats are the output formats of the queries
+func Formats() []string {
+	return []string{FormatTable, FormatCSV, FormatJSON}
+}
+
+// Store does nothing unless the path of the database was informed. The analysis replaces the one with the same id
+// and the errors are only logged, storing never changes the result of the analysis
+func (l *LocalDB) Store(analysis *horusec.Analysis) {
+	if l.config.GetLocalDBPath() == "" {
+		return
+	}
+	if err := l.store(analysis); err != nil {
+		logger.LogWarnWithLevel(messages.MsgWarnStoreLocalDBFailed, logger.WarnLevel, err.Error())
+		return
+	}
+	logger.LogDebugWithLevel(messages.MsgDebugAnalysisStoredInLocalDB+l.config.GetLocalDBPath(), logger.DebugLevel)
+}
+
+// Query runs the query in the database opened as read only, so the stored analyses can't be changed by mistake
+func (l *LocalDB) Query(query, format string) ([]byte, error) {
+	return l.query(query, format)
+}
+
+// History returns the last analyses with the total of vulnerabilities by severity, the newest first
+func (l *LocalDB) History(repositoryName, format string, limit int) ([]byte, error) {
+	return l.query(historyQuery, format, repositoryName, repositoryName, limit)
+}
+
+func (l *LocalDB) query(query, format string, args ...interface{}) ([]byte, error) {
+	if l.config.GetLocalDBPath() == "" {
+		return nil, errors.New(messages.MsgErrorLocalDBPathNotInformed)
+	}
+	db, err := sql.Open(driverName, l.getReadOnlyDSN())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = db.Close() }()
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	columns, values, err := l.scanRows(rows)
+	if err != nil {
+		return nil, err
+	}
+	return l.formatRows(columns, values, format)
+}
+
+// getReadOnlyDSN opens the database with the uri of sqlite, where mode=ro doesn't create the file when it doesn't
+// exist and fails each statement that changes the database
+func (l *LocalDB) getReadOnlyDSN() string {
+	return (&url.URL{Scheme: "file", Opaque: url.PathEscape(l.config.GetLocalDBPath()),
+		RawQuery: "mode=ro"}).String()
+}
+
+func (l *LocalDB) scanRows(rows *sql.Rows) (columns []string, values [][]interface{}, err error) {
+	if columns, err = rows.Columns(); err != nil {
+		return nil, nil, err
+	}
+	for rows.Next() {
+		row := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for index := range row {
+			pointers[index] = &row[index]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, nil, err
+		}
+		for index, value := range row {
+			if bytesValue, ok := value.([]byte); ok {
+				row[index] = string(bytesValue)
+			}
+		}
+		values = append(values, row)
+	}
+	return columns, values, rows.Err()
+}
+
+func (l *LocalDB) formatRows(columns []string, values [][]interface{}, format string) ([]byte, error) {
+	switch format {
+	case FormatCSV:
+		return l.formatCSV(columns, values)
+	case FormatJSON:
+		return l.formatJSON(columns, values)
+	default:
+		return l.formatTable(columns, values)
+	}
+}
+
+func (l *LocalDB) formatCSV(columns []string, values [][]interface{}) ([]byte, error) {
+	output := &bytes.Buffer{}
+	writer := csv.NewWriter(output)
+	_ = writer.Write(columns)
+	for _, row := range values {
+		_ = writer.Write(l.toStrings(row))
+	}
+	writer.Flush()
+	return output.Bytes(), writer.Error()
+}
+
+func (l *LocalDB) formatJSON(columns []string, values [][]interface{}) ([]byte, error) {
+	objects := make([]map[string]interface{}, 0, len(values))
+	for _, row := range values {
+		object := map[string]interface{}{}
+		for index, column := range columns {
+			object[column] = row[index]
+		}
+		objects = append(objects, object)
+	}
+	output, err := json.MarshalIndent(objects, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(output, '\n'), nil
+}
+
+func (l *LocalDB) formatTable(columns []string, values [][]interface{}) ([]byte, error) {
+	output := &bytes.Buffer{}
+	writer := tabwriter.NewWriter(output, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, strings.Join(columns, "\t"))
+	for _, row := range values {
+		_, _ = fmt.Fprintln(writer, strings.Join(l.toStrings(row), "\t"))
+	}
+	err := writer.Flush()
+	return output.Bytes(), err
+}
+
+func (l *LocalDB) toStrings(row []interface{}) []string {
+	values := make([]string, len(row))
+	for index, value := range row {
+		if value != nil {
+			values[index] = fmt.Sprint(value)
+		}
+	}
+	return values
+}
+
+func (l *LocalDB) store(analysis *horusec.Analysis) error {
+	db, err := sql.Open(driverName, l.config.GetLocalDBPath())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+	if _, err := db.Exec(Schema); err != nil {
+		return err
+	}
+	transaction, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := l.insertAnalysis(transaction, analysis); err != nil {
+		_ = transaction.Rollback()
+		return err
+	}
+	return transaction.Commit()
+}
+
+func (l *LocalDB) insertAnalysis(transaction *sql.Tx, analysis *horusec.Analysis) error {
+	id := analysis.ID.String()
+	for _, table := range []string{"tool_executions", "vulnerabilities", "analyses"} {
+		// the table name is one of the constants above, only the values are bound as parameters
+		query := fmt.Sprintf("DELETE FROM %s WHERE %s = ?;", table, l.getAnalysisIDColumn(table))
+		if _, err := transaction.Exec(query, id); err != nil {
+			return err
+		}
+	}
+	if _, err := transaction.Exec(insertAnalysis, l.getAnalysisValues(analysis)...); err != nil {
+		return err
+	}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		if _, err := transaction.Exec(insertVulnerability, l.getVulnerabilityValues(id, vulnerability)...); err != nil {
+			return err
+		}
+	}
+	return l.insertToolExecutions(transaction, analysis)
+}
+
+func (l *LocalDB) insertToolExecutions(transaction *sql.Tx, analysis *horusec.Analysis) error {
+	if analysis.ScanManifest == nil {
+		return nil
+	}
+	for _, execution := range analysis.ScanManifest.ToolsExecuted {
+		if _, err := transaction.Exec(insertToolExecution, analysis.ID.String(), execution.Tool.ToString(),
+			execution.ProjectSubPath, execution.Status, execution.DurationInSeconds); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *LocalDB) getAnalysisIDColumn(table string) string {
+	if table == "analyses" {
+		return "id"
+	}
+	return "analysis_id"
+}
+
+func (l *LocalDB) getAnalysisValues(analysis *horusec.Analysis) []interface{} {
+	branch, commitHash := "", ""
+	if analysis.Source != nil {
+		branch, commitHash = analysis.Source.Branch, analysis.Source.CommitSHA
+	}
+	var riskScore, riskGrade interface{}
+	if analysis.RiskScore != nil {
+		riskScore, riskGrade = analysis.RiskScore.Score, analysis.RiskScore.Grade
+	}
+	return []interface{}{analysis.ID.String(), analysis.RepositoryName, string(analysis.Status),
+		formatDate(analysis.CreatedAt), formatDate(analysis.FinishedAt),
+		analysis.FinishedAt.Sub(analysis.CreatedAt).Seconds(), branch, commitHash, riskScore, riskGrade}
+}
+
+func (l *LocalDB) getVulnerabilityValues(analysisID string, vuln *horusec.Vulnerability) []interface{} {
+	return []interface{}{vuln.VulnerabilityID.String(), analysisID, vuln.VulnHash, vuln.Severity.ToString(),
+		string(vuln.Type), vuln.SecurityTool.ToString(), vuln.Language.ToString(), vuln.RuleID, vuln.File,
+		vuln.Line, vuln.Details, vuln.CommitHash, vuln.CommitAuthor}
+}
+
+// formatDate uses the format of the date functions of sqlite, so the dates can be compared and grouped by them
+func formatDate(date time.Time) string {
+	return date.UTC().Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,39 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localdb
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Store(analysis *horusec.Analysis) {
+	_ = m.MethodCalled("Store")
+}
+
+func (m *Mock) Query(query, format string) ([]byte, error) {
+	args := m.MethodCalled("Query")
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *Mock) History(repositoryName, format string, limit int) ([]byte, error) {
+	args := m.MethodCalled("History")
+	return args.Get(0).([]byte), args.Error(1)
+}
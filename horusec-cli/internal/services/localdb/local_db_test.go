@@ -0,0 +1,185 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localdb
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLocalDB(path string) *LocalDB {
+	config := cliConfig.NewConfig()
+	config.SetLocalDBPath(path)
+	return &LocalDB{config: config}
+}
+
+func newAnalysis() *horusec.Analysis {
+	createdAt := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &horusec.Analysis{
+		ID:             uuid.New(),
+		RepositoryName: "horusec",
+		Status:         enumHorusec.Success,
+		CreatedAt:      createdAt,
+		FinishedAt:     createdAt.Add(90 * time.Second),
+		Source:         &horusec.SourceContext{Branch: "main", CommitSHA: "8a1b2c3"},
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{VulnerabilityID: uuid.New(), Severity: severity.High,
+				SecurityTool: tools.GoSec, Details: "It's a command injection", Type: enumHorusec.Vulnerability}},
+		},
+		ScanManifest: &horusec.ScanManifest{ToolsExecuted: []horusec.ToolExecution{
+			{Tool: tools.GoSec, DurationInSeconds: 12.5, Status: "success"},
+		}},
+	}
+}
+
+func queryJSON(t *testing.T, localDB *LocalDB, query string) []map[string]interface{} {
+	output, err := localDB.Query(query, FormatJSON)
+	assert.NoError(t, err)
+	var rows []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(output, &rows))
+	return rows
+}
+
+func TestLocalDB_Store(t *testing.T) {
+	t.Run("Should store the analysis, its vulnerabilities and the tools executed", func(t *testing.T) {
+		localDB := newLocalDB(filepath.Join(t.TempDir(), "horusec.db"))
+		analysis := newAnalysis()
+
+		localDB.Store(analysis)
+
+		analyses := queryJSON(t, localDB, "SELECT * FROM analyses;")
+		assert.Len(t, analyses, 1)
+		assert.Equal(t, analysis.ID.String(), analyses[0]["id"])
+		assert.Equal(t, "2021-01-02 03:05:35", analyses[0]["finished_at"])
+		assert.Equal(t, float64(90), analyses[0]["duration_seconds"])
+		assert.Nil(t, analyses[0]["risk_grade"])
+		vulnerabilities := queryJSON(t, localDB, "SELECT details FROM vulnerabilities;")
+		assert.Equal(t, []map[string]interface{}{{"details": "It's a command injection"}}, vulnerabilities)
+		executions := queryJSON(t, localDB, "SELECT tool, duration_seconds FROM tool_executions;")
+		assert.Equal(t, []map[string]interface{}{{"tool": "GoSec", "duration_seconds": 12.5}}, executions)
+	})
+
+	t.Run("Should replace the analysis with the same id", func(t *testing.T) {
+		localDB := newLocalDB(filepath.Join(t.TempDir(), "horusec.db"))
+		analysis := newAnalysis()
+
+		localDB.Store(analysis)
+		analysis.Status = enumHorusec.Error
+		localDB.Store(analysis)
+
+		assert.Equal(t, []map[string]interface{}{{"status": "error", "vulnerabilities": float64(1)}},
+			queryJSON(t, localDB, "SELECT a.status, (SELECT COUNT(*) FROM vulnerabilities) AS vulnerabilities "+
+				"FROM analyses a;"))
+	})
+
+	t.Run("Should not store when the path is not informed", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			newLocalDB("").Store(newAnalysis())
+		})
+	})
+
+	t.Run("Should not panic when the database can't be written", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			newLocalDB(filepath.Join(t.TempDir(), "not-found", "horusec.db")).Store(newAnalysis())
+		})
+	})
+}
+
+func TestLocalDB_Query(t *testing.T) {
+	t.Run("Should return the rows in each format", func(t *testing.T) {
+		localDB := newLocalDB(filepath.Join(t.TempDir(), "horusec.db"))
+		localDB.Store(newAnalysis())
+		query := "SELECT repository_name, branch, risk_grade FROM analyses;"
+
+		table, err := localDB.Query(query, FormatTable)
+		assert.NoError(t, err)
+		lines := strings.Split(string(table), "\n")
+		assert.Equal(t, "repository_name  branch  risk_grade", lines[0])
+		assert.Equal(t, "horusec          main", strings.TrimSpace(lines[1]))
+		csvOutput, err := localDB.Query(query, FormatCSV)
+		assert.NoError(t, err)
+		assert.Equal(t, "repository_name,branch,risk_grade\nhorusec,main,\n", string(csvOutput))
+	})
+
+	t.Run("Should not change the database", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "horusec.db")
+		localDB := newLocalDB(path)
+		localDB.Store(newAnalysis())
+
+		_, err := localDB.Query("DELETE FROM analyses;", FormatTable)
+
+		assert.Error(t, err)
+		assert.Len(t, queryJSON(t, localDB, "SELECT id FROM analyses;"), 1)
+	})
+
+	t.Run("Should not create the database when it doesn't exist", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "horusec.db")
+
+		_, err := newLocalDB(path).Query("SELECT 1;", FormatTable)
+
+		assert.Error(t, err)
+		_, err = os.Stat(path)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Should return error when the path is not informed", func(t *testing.T) {
+		_, err := newLocalDB("").Query("SELECT 1;", FormatTable)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestLocalDB_History(t *testing.T) {
+	t.Run("Should filter the analyses of the repository", func(t *testing.T) {
+		localDB := newLocalDB(filepath.Join(t.TempDir(), "my project #1.db"))
+		analysis := newAnalysis()
+		analysis.RepositoryName = "my'project"
+		localDB.Store(analysis)
+		localDB.Store(newAnalysis())
+
+		output, err := localDB.History("my'project", FormatJSON, 5)
+
+		assert.NoError(t, err)
+		var rows []map[string]interface{}
+		assert.NoError(t, json.Unmarshal(output, &rows))
+		assert.Len(t, rows, 1)
+		assert.Equal(t, "my'project", rows[0]["repository_name"])
+		assert.Equal(t, float64(1), rows[0]["high"])
+	})
+
+	t.Run("Should return the last analyses of all repositories", func(t *testing.T) {
+		localDB := newLocalDB(filepath.Join(t.TempDir(), "horusec.db"))
+		for index := 0; index < 3; index++ {
+			localDB.Store(newAnalysis())
+		}
+
+		output, err := localDB.History("", FormatCSV, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, strings.Split(strings.TrimSpace(string(output)), "\n"), 3)
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maxfindings
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/policy"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/testcode"
+)
+
+var ErrMaxFindingsExceeded = errors.New("{HORUSEC_CLI} analysis finished with more vulnerabilities than the " +
+	"max findings")
+
+type Interface interface {
+	GetExceeded(analysis *horusec.Analysis) []string
+}
+
+type MaxFindings struct {
+	config cliConfig.IConfig
+}
+
+func NewMaxFindings(config cliConfig.IConfig) Interface {
+	return &MaxFindings{config: config}
+}
+
+// IsValidMaxFindings checks that the keys are severities and the values are not negative integers
+func IsValidMaxFindings(key, value string) bool {
+	maxFindings, err := strconv.Atoi(strings.TrimSpace(value))
+	_, ok := severity.Map()[strings.ToUpper(strings.TrimSpace(key))]
+	return err == nil && maxFindings >= 0 && ok
+}
+
+// GetExceeded returns the severities with more vulnerabilities than their max findings, like HIGH 7/5. The
+// vulnerabilities with the same hash are counted once and, with the baseline of the policy, only the new
+// vulnerabilities are counted, so the max findings can be lowered as the vulnerabilities are fixed
+func (m *MaxFindings) GetExceeded(analysis *horusec.Analysis) (exceeded []string) {
+	if len(m.config.GetMaxFindings()) == 0 {
+		return nil
+	}
+
+	totals := m.countBySeverity(analysis)
+	for key, value := range m.config.GetMaxFindings() {
+		name := strings.ToUpper(strings.TrimSpace(key))
+		if maxFindings, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && totals[name] > maxFindings {
+			exceeded = append(exceeded, fmt.Sprintf("%s %d/%d", name, totals[name], maxFindings))
+		}
+	}
+
+	sort.Strings(exceeded)
+	return exceeded
+}
+
+func (m *MaxFindings) countBySeverity(analysis *horusec.Analysis) map[string]int {
+	baseline, err := policy.GetBaselineHashes(m.config)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadPolicyBaseline, err, logger.ErrorLevel)
+	}
+
+	totals := map[string]int{}
+	counted := map[string]bool{}
+	for index := range analysis.AnalysisVulnerabilities {
+		vuln := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		if m.isToSkip(vuln, baseline) || (vuln.VulnHash != "" && counted[vuln.VulnHash]) {
+			continue
+		}
+		counted[vuln.VulnHash] = true
+		totals[vuln.Severity.ToString()]++
+	}
+
+	return totals
+}
+
+func (m *MaxFindings) isToSkip(vuln *horusec.Vulnerability, baseline map[string]bool) bool {
+	return (vuln.Type != enumHorusec.Vulnerability && vuln.Type != "") || baseline[vuln.VulnHash] ||
+		testcode.IsExcludedFromGates(m.config, vuln)
+}
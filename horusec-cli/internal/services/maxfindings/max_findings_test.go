@@ -0,0 +1,90 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maxfindings
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysisToTest() *horusec.Analysis {
+	return &horusec.Analysis{
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{VulnHash: "1", Severity: severity.High, Type: enumHorusec.Vulnerability}},
+			{Vulnerability: horusec.Vulnerability{VulnHash: "1", Severity: severity.High, Type: enumHorusec.Vulnerability}},
+			{Vulnerability: horusec.Vulnerability{VulnHash: "2", Severity: severity.High, Type: enumHorusec.Vulnerability}},
+			{Vulnerability: horusec.Vulnerability{VulnHash: "3", Severity: severity.High,
+				Type: enumHorusec.Vulnerability, IsTestCode: true}},
+			{Vulnerability: horusec.Vulnerability{VulnHash: "4", Severity: severity.Critical,
+				Type: enumHorusec.RiskAccepted}},
+			{Vulnerability: horusec.Vulnerability{VulnHash: "5", Severity: severity.Low, Type: enumHorusec.Vulnerability}},
+		},
+	}
+}
+
+func TestGetExceeded(t *testing.T) {
+	t.Run("Should return nil without max findings", func(t *testing.T) {
+		assert.Nil(t, NewMaxFindings(&cliConfig.Config{}).GetExceeded(newAnalysisToTest()))
+	})
+
+	t.Run("Should count the duplicated hashes once and skip the accepted and the test code", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetMaxFindings(map[string]string{"critical": "0", "HIGH": "1", "low": "0"})
+		config.SetTestCodeMode(cli.TestCodeExcludeFromGates.ToString())
+
+		exceeded := NewMaxFindings(config).GetExceeded(newAnalysisToTest())
+		assert.Equal(t, []string{"HIGH 2/1", "LOW 1/0"}, exceeded)
+	})
+
+	t.Run("Should count only the vulnerabilities not found in the baseline", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "horusec-max-findings")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		baseline, _ := json.Marshal(&horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{VulnHash: "1"}},
+			{Vulnerability: horusec.Vulnerability{VulnHash: "5"}},
+		}})
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "baseline.json"), baseline, 0600))
+
+		config := &cliConfig.Config{}
+		config.SetMaxFindings(map[string]string{"HIGH": "2", "LOW": "0"})
+		config.SetPolicyBaselinePath(filepath.Join(dir, "baseline.json"))
+
+		assert.Empty(t, NewMaxFindings(config).GetExceeded(newAnalysisToTest()))
+	})
+}
+
+func TestIsValidMaxFindings(t *testing.T) {
+	t.Run("Should accept severities with not negative integers", func(t *testing.T) {
+		assert.True(t, IsValidMaxFindings("critical", "0"))
+		assert.True(t, IsValidMaxFindings(" HIGH ", " 5 "))
+	})
+
+	t.Run("Should refuse unknown severities and invalid max", func(t *testing.T) {
+		assert.False(t, IsValidMaxFindings("URGENT", "1"))
+		assert.False(t, IsValidMaxFindings("HIGH", "-1"))
+		assert.False(t, IsValidMaxFindings("HIGH", "five"))
+	})
+}
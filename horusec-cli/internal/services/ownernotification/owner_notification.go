@@ -0,0 +1,159 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ownernotification
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/codeowners"
+)
+
+const requestTimeout = 30
+
+type Interface interface {
+	Notify(analysis *horusec.Analysis)
+}
+
+// Notification is the body sent to the webhook of the owner, the text is the message shown by the incoming webhooks
+// of Slack and Microsoft Teams, the other fields are to the webhooks that handle the vulnerabilities
+type Notification struct {
+	Text                 string                            `json:"text"`
+	Owner                string                            `json:"owner"`
+	AnalysisID           uuid.UUID                         `json:"analysisID"`
+	RepositoryName       string                            `json:"repositoryName,omitempty"`
+	TotalVulnerabilities int                               `json:"totalVulnerabilities"`
+	TotalBySeverity      map[severity.Severity]int         `json:"totalBySeverity"`
+	Vulnerabilities      []horusec.AnalysisVulnerabilities `json:"vulnerabilities"`
+}
+
+type OwnerNotification struct {
+	config     cliConfig.IConfig
+	httpClient client.Interface
+}
+
+func NewOwnerNotification(config cliConfig.IConfig) Interface {
+	return &OwnerNotification{
+		config:     config,
+		httpClient: client.NewHTTPClient(requestTimeout),
+	}
+}
+
+// Notify sends to the webhook of each owner its vulnerabilities, the false positives and the risk accepted aren't
+// sent and the owners without vulnerabilities aren't notified. The errors are only logged, the notifications never
+// change the result of the analysis
+func (o *OwnerNotification) Notify(analysis *horusec.Analysis) {
+	if len(o.config.GetOwnerWebhooks()) == 0 {
+		return
+	}
+	for owner, vulnerabilities := range codeowners.GetVulnerabilitiesByOwner(analysis) {
+		webhookURL := o.getWebhookURL(owner)
+		vulnerabilities = o.getVulnerabilitiesToNotify(vulnerabilities)
+		if webhookURL == "" || len(vulnerabilities) == 0 {
+			continue
+		}
+		if err := o.send(webhookURL, o.newNotification(analysis, owner, vulnerabilities)); err != nil {
+			logger.LogWarnWithLevel(messages.MsgWarnOwnerNotificationFailed, logger.WarnLevel, owner, err.Error())
+		}
+	}
+}
+
+// getWebhookURL ignores the case of the owner, the keys of the config file are lowercase
+func (o *OwnerNotification) getWebhookURL(owner string) string {
+	for key, webhookURL := range o.config.GetOwnerWebhooks() {
+		if strings.EqualFold(key, owner) {
+			return webhookURL
+		}
+	}
+	return ""
+}
+
+func (o *OwnerNotification) getVulnerabilitiesToNotify(
+	vulnerabilities []horusec.AnalysisVulnerabilities) (toNotify []horusec.AnalysisVulnerabilities) {
+	for index := range vulnerabilities {
+		if vulnerabilities[index].Vulnerability.Type == enumHorusec.Vulnerability {
+			toNotify = append(toNotify, vulnerabilities[index])
+		}
+	}
+	return toNotify
+}
+
+func (o *OwnerNotification) newNotification(analysis *horusec.Analysis, owner string,
+	vulnerabilities []horusec.AnalysisVulnerabilities) *Notification {
+	notification := &Notification{
+		Owner:                owner,
+		AnalysisID:           analysis.ID,
+		RepositoryName:       analysis.RepositoryName,
+		TotalVulnerabilities: len(vulnerabilities),
+		TotalBySeverity:      map[severity.Severity]int{},
+		Vulnerabilities:      vulnerabilities,
+	}
+	for index := range vulnerabilities {
+		notification.TotalBySeverity[vulnerabilities[index].Vulnerability.Severity]++
+	}
+	notification.Text = o.getText(notification)
+	return notification
+}
+
+// getText is like: Horusec found 3 vulnerabilities of @org/api in horusec: CRITICAL 1, HIGH 2
+func (o *OwnerNotification) getText(notification *Notification) string {
+	var totals []string
+	for vulnSeverity, total := range notification.TotalBySeverity {
+		totals = append(totals, fmt.Sprintf("%s %d", vulnSeverity, total))
+	}
+	sort.Strings(totals)
+	text := fmt.Sprintf("Horusec found %d vulnerabilities of %s", notification.TotalVulnerabilities,
+		notification.Owner)
+	if notification.RepositoryName != "" {
+		text += " in " + notification.RepositoryName
+	}
+	return text + ": " + strings.Join(totals, ", ")
+}
+
+func (o *OwnerNotification) send(webhookURL string, notification *Notification) error {
+	content, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, webhookURL, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	response, err := o.httpClient.DoRequest(req, nil)
+	if err != nil {
+		return err
+	}
+	defer response.CloseBody()
+
+	if response.GetStatusCode() < http.StatusOK || response.GetStatusCode() >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d", response.GetStatusCode())
+	}
+	return nil
+}
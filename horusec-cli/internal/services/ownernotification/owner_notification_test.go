@@ -0,0 +1,95 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ownernotification
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+func newFakeServer(notifications map[string]*Notification, statusCode int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		notification := &Notification{}
+		_ = json.Unmarshal(body, notification)
+		notifications[r.URL.Path] = notification
+		w.WriteHeader(statusCode)
+	}))
+}
+
+func newAnalysis() *horusec.Analysis {
+	analysis := &horusec.Analysis{ID: uuid.New(), RepositoryName: "horusec"}
+	for _, vuln := range []horusec.Vulnerability{
+		{Severity: severity.High, Type: enumHorusec.Vulnerability, Owners: []string{"@org/api"}},
+		{Severity: severity.Critical, Type: enumHorusec.Vulnerability, Owners: []string{"@org/api", "@org/web"}},
+		{Severity: severity.Low, Type: enumHorusec.FalsePositive, Owners: []string{"@org/docs"}},
+		{Severity: severity.Low, Type: enumHorusec.Vulnerability},
+	} {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+			horusec.AnalysisVulnerabilities{Vulnerability: vuln})
+	}
+	return analysis
+}
+
+func TestOwnerNotification_Notify(t *testing.T) {
+	t.Run("Should notify the webhook of each owner with its vulnerabilities", func(t *testing.T) {
+		notifications := map[string]*Notification{}
+		server := newFakeServer(notifications, http.StatusOK)
+		defer server.Close()
+		configs := config.NewConfig()
+		configs.SetOwnerWebhooks(map[string]string{"@org/API": server.URL + "/api", "@org/docs": server.URL + "/docs",
+			"unowned": server.URL + "/unowned"})
+
+		NewOwnerNotification(configs).Notify(newAnalysis())
+		assert.Len(t, notifications, 2)
+		assert.Equal(t, 2, notifications["/api"].TotalVulnerabilities)
+		assert.Equal(t, "Horusec found 2 vulnerabilities of @org/api in horusec: CRITICAL 1, HIGH 1",
+			notifications["/api"].Text)
+		assert.Equal(t, 1, notifications["/unowned"].TotalBySeverity[severity.Low])
+	})
+
+	t.Run("Should not notify without webhooks", func(t *testing.T) {
+		notifications := map[string]*Notification{}
+		server := newFakeServer(notifications, http.StatusOK)
+		defer server.Close()
+
+		NewOwnerNotification(config.NewConfig()).Notify(newAnalysis())
+		assert.Empty(t, notifications)
+	})
+
+	t.Run("Should not panic when the webhook fails", func(t *testing.T) {
+		notifications := map[string]*Notification{}
+		server := newFakeServer(notifications, http.StatusInternalServerError)
+		defer server.Close()
+		configs := config.NewConfig()
+		configs.SetOwnerWebhooks(map[string]string{"@org/web": server.URL + "/web", "@org/api": "http://127.0.0.1:0"})
+
+		assert.NotPanics(t, func() {
+			NewOwnerNotification(configs).Notify(newAnalysis())
+		})
+		assert.Len(t, notifications, 1)
+	})
+}
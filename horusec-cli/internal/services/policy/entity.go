@@ -0,0 +1,91 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"encoding/json"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+)
+
+// Input is the document evaluated by the policy, available as input in the rego
+type Input struct {
+	Analysis        *horusec.Analysis `json:"analysis"`
+	Vulnerabilities []Vulnerability   `json:"vulnerabilities"`
+	Summary         Summary           `json:"summary"`
+}
+
+type Vulnerability struct {
+	VulnHash string   `json:"vulnHash"`
+	Severity string   `json:"severity"`
+	Type     string   `json:"type"`
+	File     string   `json:"file"`
+	Tool     string   `json:"tool"`
+	CWEs     []string `json:"cwes"`
+	CVEs     []string `json:"cves"`
+	IsNew    bool     `json:"isNew"`
+	// IsTestCode is true to the vulnerabilities found in tests, examples and fixtures
+	IsTestCode bool `json:"isTestCode"`
+	// ExcludedFromGates is true to the vulnerabilities of test code with the test code mode exclude-from-gates, they
+	// are not counted in the summary
+	ExcludedFromGates bool `json:"excludedFromGates"`
+}
+
+// Summary has the totals of the vulnerabilities, the vulnerabilities of type false positive, risk accepted and
+// corrected are counted only by type and the vulnerabilities excluded from gates are not counted
+type Summary struct {
+	Total      int            `json:"total"`
+	TotalNew   int            `json:"totalNew"`
+	BySeverity map[string]int `json:"bySeverity"`
+	ByType     map[string]int `json:"byType"`
+	ByCWE      map[string]int `json:"byCWE"`
+	ByFile     map[string]int `json:"byFile"`
+}
+
+type opaResult struct {
+	Result []struct {
+		Expressions []struct {
+			Value json.RawMessage `json:"value"`
+		} `json:"expressions"`
+	} `json:"result"`
+}
+
+func newSummary() Summary {
+	return Summary{
+		BySeverity: map[string]int{},
+		ByType:     map[string]int{},
+		ByCWE:      map[string]int{},
+		ByFile:     map[string]int{},
+	}
+}
+
+func (i *Input) addVulnerability(vuln Vulnerability) {
+	i.Vulnerabilities = append(i.Vulnerabilities, vuln)
+	i.Summary.ByType[vuln.Type]++
+	if vuln.Type != enumHorusec.Vulnerability.ToString() || vuln.ExcludedFromGates {
+		return
+	}
+
+	i.Summary.Total++
+	i.Summary.BySeverity[vuln.Severity]++
+	i.Summary.ByFile[vuln.File]++
+	for _, cwe := range vuln.CWEs {
+		i.Summary.ByCWE[cwe]++
+	}
+	if vuln.IsNew {
+		i.Summary.TotalNew++
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/testcode"
+)
+
+const (
+	opaBinary = "opa"
+	// Query is the rule of the policy evaluated, a set of messages explaining why the analysis was denied
+	Query = "data.horusec.deny"
+)
+
+var (
+	ErrDenied              = errors.New("{HORUSEC_CLI} analysis denied by the policy")
+	ErrDenyUndefined       = errors.New("{HORUSEC_CLI} rule deny not defined in the package horusec of the policy")
+	ErrDenyNotSetOfStrings = errors.New("{HORUSEC_CLI} rule deny of the policy is not a set of messages")
+
+	cweRegex = regexp.MustCompile(`CWE-\d+`)
+	cveRegex = regexp.MustCompile(`CVE-\d{4}-\d{4,}`)
+)
+
+type Interface interface {
+	Evaluate(analysis *horusec.Analysis) (denials []string, err error)
+}
+
+type Policy struct {
+	config cliConfig.IConfig
+	runOPA func(args []string, input []byte) ([]byte, error)
+}
+
+func NewPolicy(config cliConfig.IConfig) Interface {
+	return &Policy{
+		config: config,
+		runOPA: runOPA,
+	}
+}
+
+// Evaluate returns the denials of the policy to the analysis, that is empty when the analysis is allowed or when
+// no policy was informed
+func (p *Policy) Evaluate(analysis *horusec.Analysis) (denials []string, err error) {
+	if p.config.GetPolicyPath() == "" {
+		return nil, nil
+	}
+
+	input, err := p.newInput(analysis)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := p.runOPA([]string{"eval", "--format", "json", "--stdin-input",
+		"--data", p.config.GetPolicyPath(), Query}, content)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorEvaluatePolicy, err, logger.ErrorLevel)
+		return nil, err
+	}
+
+	return p.parseOutput(output)
+}
+
+func (p *Policy) newInput(analysis *horusec.Analysis) (*Input, error) {
+	baseline, err := GetBaselineHashes(p.config)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadPolicyBaseline, err, logger.ErrorLevel)
+		return nil, err
+	}
+
+	input := &Input{Analysis: analysis, Summary: newSummary()}
+	for index := range analysis.AnalysisVulnerabilities {
+		input.addVulnerability(p.newVulnerability(&analysis.AnalysisVulnerabilities[index].Vulnerability, baseline))
+	}
+
+	return input, nil
+}
+
+func (p *Policy) newVulnerability(vuln *horusec.Vulnerability, baseline map[string]bool) Vulnerability {
+	return Vulnerability{
+		VulnHash: vuln.VulnHash,
+		Severity: vuln.Severity.ToString(),
+		Type:     vuln.Type.ToString(),
+		File:     vuln.File,
+		Tool:     vuln.SecurityTool.ToString(),
+		CWEs:     unique(cweRegex.FindAllString(vuln.Details, -1)),
+		CVEs:     unique(cveRegex.FindAllString(vuln.Details, -1)),
+		IsNew:    baseline != nil && !baseline[vuln.VulnHash],
+
+		IsTestCode:        vuln.IsTestCode,
+		ExcludedFromGates: testcode.IsExcludedFromGates(p.config, vuln),
+	}
+}
+
+// GetBaselineHashes returns nil without baseline, then no vulnerability is considered new
+func GetBaselineHashes(config cliConfig.IConfig) (map[string]bool, error) {
+	if config.GetPolicyBaselinePath() == "" {
+		return nil, nil
+	}
+
+	content, err := ioutil.ReadFile(config.GetPolicyBaselinePath())
+	if err != nil {
+		return nil, err
+	}
+
+	baseline := &horusec.Analysis{}
+	if err := json.Unmarshal(content, baseline); err != nil {
+		return nil, err
+	}
+
+	hashes := map[string]bool{}
+	for index := range baseline.AnalysisVulnerabilities {
+		hashes[baseline.AnalysisVulnerabilities[index].Vulnerability.VulnHash] = true
+	}
+
+	return hashes, nil
+}
+
+// parseOutput fails closed, the policy without the rule deny, or with a rule deny that isn't a set of messages,
+// returns error instead of allowing the analysis
+func (p *Policy) parseOutput(output []byte) (denials []string, err error) {
+	result := &opaResult{}
+	if err := json.Unmarshal(output, result); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorEvaluatePolicy, err, logger.ErrorLevel)
+		return nil, err
+	}
+
+	expressions := 0
+	for _, item := range result.Result {
+		for _, expression := range item.Expressions {
+			values, err := p.parseDenials(expression.Value)
+			if err != nil {
+				logger.LogErrorWithLevel(messages.MsgErrorEvaluatePolicy, err, logger.ErrorLevel)
+				return nil, err
+			}
+			denials = append(denials, values...)
+			expressions++
+		}
+	}
+
+	if expressions == 0 {
+		logger.LogErrorWithLevel(messages.MsgErrorEvaluatePolicy, ErrDenyUndefined, logger.ErrorLevel)
+		return nil, ErrDenyUndefined
+	}
+	return denials, nil
+}
+
+func (p *Policy) parseDenials(value json.RawMessage) (denials []string, err error) {
+	if len(value) == 0 || string(value) == "null" {
+		return nil, ErrDenyNotSetOfStrings
+	}
+	if err := json.Unmarshal(value, &denials); err != nil {
+		return nil, ErrDenyNotSetOfStrings
+	}
+	return denials, nil
+}
+
+func runOPA(args []string, input []byte) ([]byte, error) {
+	path, err := exec.LookPath(opaBinary)
+	if err != nil {
+		return nil, errors.New(messages.MsgErrorOPANotFound)
+	}
+
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stderr = stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, errors.New(strings.TrimSpace(err.Error() + ": " + stderr.String()))
+	}
+
+	return output, nil
+}
+
+func unique(values []string) (result []string) {
+	seen := map[string]bool{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,163 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysisToTest() *horusec.Analysis {
+	return &horusec.Analysis{
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{VulnHash: "1", Severity: severity.High, File: "main.go",
+				Type: enumHorusec.Vulnerability, Details: "SQL Injection CWE-89 CWE-89"}},
+			{Vulnerability: horusec.Vulnerability{VulnHash: "2", Severity: severity.Low, File: "main.go",
+				Type: enumHorusec.FalsePositive, Details: "CVE-2021-44228"}},
+		},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Run("Should return no denials when policy is not informed", func(t *testing.T) {
+		policy := &Policy{config: &cliConfig.Config{}}
+
+		denials, err := policy.Evaluate(newAnalysisToTest())
+		assert.NoError(t, err)
+		assert.Empty(t, denials)
+	})
+
+	t.Run("Should send the input to opa and return the denials", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetPolicyPath("policy.rego")
+		input := &Input{}
+		policy := &Policy{config: config, runOPA: func(args []string, content []byte) ([]byte, error) {
+			assert.Equal(t, []string{"eval", "--format", "json", "--stdin-input", "--data", "policy.rego", Query}, args)
+			assert.NoError(t, json.Unmarshal(content, input))
+			return []byte(`{"result":[{"expressions":[{"value":["too many vulnerabilities"]}]}]}`), nil
+		}}
+
+		denials, err := policy.Evaluate(newAnalysisToTest())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"too many vulnerabilities"}, denials)
+		assert.Equal(t, 1, input.Summary.Total)
+		assert.Equal(t, 0, input.Summary.TotalNew)
+		assert.Equal(t, 1, input.Summary.BySeverity[severity.High.ToString()])
+		assert.Equal(t, 1, input.Summary.ByCWE["CWE-89"])
+		assert.Equal(t, 1, input.Summary.ByType[enumHorusec.FalsePositive.ToString()])
+		assert.Equal(t, []string{"CVE-2021-44228"}, input.Vulnerabilities[1].CVEs)
+	})
+
+	t.Run("Should mark as new the vulnerabilities not found in the baseline", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "horusec-policy")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		baseline, _ := json.Marshal(&horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{VulnHash: "2"}},
+		}})
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "baseline.json"), baseline, 0600))
+
+		config := &cliConfig.Config{}
+		config.SetPolicyPath("policy.rego")
+		config.SetPolicyBaselinePath(filepath.Join(dir, "baseline.json"))
+		input := &Input{}
+		policy := &Policy{config: config, runOPA: func(_ []string, content []byte) ([]byte, error) {
+			assert.NoError(t, json.Unmarshal(content, input))
+			return []byte(`{"result":[{"expressions":[{"value":[]}]}]}`), nil
+		}}
+
+		denials, err := policy.Evaluate(newAnalysisToTest())
+		assert.NoError(t, err)
+		assert.Empty(t, denials)
+		assert.Equal(t, 1, input.Summary.TotalNew)
+		assert.True(t, input.Vulnerabilities[0].IsNew)
+		assert.False(t, input.Vulnerabilities[1].IsNew)
+	})
+
+	t.Run("Should not count in the summary the test code excluded from gates", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetPolicyPath("policy.rego")
+		config.SetTestCodeMode(cli.TestCodeExcludeFromGates.ToString())
+		analysis := newAnalysisToTest()
+		analysis.AnalysisVulnerabilities[0].Vulnerability.IsTestCode = true
+		input := &Input{}
+		policy := &Policy{config: config, runOPA: func(_ []string, content []byte) ([]byte, error) {
+			assert.NoError(t, json.Unmarshal(content, input))
+			return []byte(`{"result":[{"expressions":[{"value":[]}]}]}`), nil
+		}}
+
+		_, err := policy.Evaluate(analysis)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, input.Summary.Total)
+		assert.True(t, input.Vulnerabilities[0].IsTestCode)
+		assert.True(t, input.Vulnerabilities[0].ExcludedFromGates)
+	})
+
+	t.Run("Should return error when baseline not exists", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetPolicyPath("policy.rego")
+		config.SetPolicyBaselinePath("./not-exists.json")
+		policy := &Policy{config: config}
+
+		_, err := policy.Evaluate(newAnalysisToTest())
+		assert.Error(t, err)
+	})
+
+	t.Run("Should return error when the rule deny is not defined", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetPolicyPath("policy.rego")
+		policy := &Policy{config: config, runOPA: func(_ []string, _ []byte) ([]byte, error) {
+			return []byte(`{}`), nil
+		}}
+
+		_, err := policy.Evaluate(newAnalysisToTest())
+		assert.Equal(t, ErrDenyUndefined, err)
+	})
+
+	t.Run("Should return error when the rule deny is not a set of messages", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetPolicyPath("policy.rego")
+		for _, value := range []string{`true`, `null`, `[{"msg":"denied"}]`} {
+			policy := &Policy{config: config, runOPA: func(_ []string, _ []byte) ([]byte, error) {
+				return []byte(`{"result":[{"expressions":[{"value":` + value + `}]}]}`), nil
+			}}
+
+			_, err := policy.Evaluate(newAnalysisToTest())
+			assert.Equal(t, ErrDenyNotSetOfStrings, err, value)
+		}
+	})
+
+	t.Run("Should return error when opa fails", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetPolicyPath("policy.rego")
+		policy := &Policy{config: config, runOPA: func(_ []string, _ []byte) ([]byte, error) {
+			return nil, errors.New("test")
+		}}
+
+		_, err := policy.Evaluate(newAnalysisToTest())
+		assert.Error(t, err)
+	})
+}
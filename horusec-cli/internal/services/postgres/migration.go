@@ -0,0 +1,81 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+// Migration is a change of the schema, applied once by its version. The migrations applied are never changed, a
+// new change of the schema is a new migration with the next version
+type Migration struct {
+	Version int
+	Up      string
+}
+
+// createMigrationsTable keeps the versions applied, the tables of horusec cli have a prefix so they can be in the
+// same database of horusec platform
+const createMigrationsTable = `CREATE TABLE IF NOT EXISTS horusec_cli_schema_migrations (
+  version INTEGER PRIMARY KEY,
+  applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+)`
+
+var Migrations = []Migration{
+	{
+		Version: 1,
+		Up: `CREATE TABLE horusec_cli_analyses (
+  id UUID PRIMARY KEY,
+  repository_name TEXT NOT NULL,
+  status TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL,
+  finished_at TIMESTAMPTZ,
+  is_partial BOOLEAN NOT NULL DEFAULT FALSE,
+  branch TEXT NOT NULL DEFAULT '',
+  commit_hash TEXT NOT NULL DEFAULT '',
+  risk_score INTEGER,
+  risk_grade TEXT,
+  tags JSONB NOT NULL DEFAULT '{}'
+);
+CREATE INDEX horusec_cli_analyses_repository_name_created_at ON horusec_cli_analyses (repository_name, created_at);
+CREATE TABLE horusec_cli_vulnerabilities (
+  id UUID PRIMARY KEY,
+  analysis_id UUID NOT NULL REFERENCES horusec_cli_analyses (id) ON DELETE CASCADE,
+  vuln_hash TEXT NOT NULL,
+  severity TEXT NOT NULL,
+  type TEXT NOT NULL,
+  security_tool TEXT NOT NULL,
+  language TEXT NOT NULL,
+  rule_id TEXT NOT NULL DEFAULT '',
+  file TEXT NOT NULL DEFAULT '',
+  line TEXT NOT NULL DEFAULT '',
+  "column" TEXT NOT NULL DEFAULT '',
+  code TEXT NOT NULL DEFAULT '',
+  details TEXT NOT NULL DEFAULT '',
+  confidence TEXT NOT NULL DEFAULT '',
+  commit_hash TEXT NOT NULL DEFAULT '',
+  commit_author TEXT NOT NULL DEFAULT '',
+  commit_email TEXT NOT NULL DEFAULT '',
+  dependency_name TEXT NOT NULL DEFAULT '',
+  dependency_version TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX horusec_cli_vulnerabilities_analysis_id ON horusec_cli_vulnerabilities (analysis_id);
+CREATE INDEX horusec_cli_vulnerabilities_vuln_hash ON horusec_cli_vulnerabilities (vuln_hash);`,
+	},
+}
+
+const insertAnalysisQuery = `INSERT INTO horusec_cli_analyses (id, repository_name, status, created_at, finished_at,
+  is_partial, branch, commit_hash, risk_score, risk_grade, tags)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+const insertVulnerabilityQuery = `INSERT INTO horusec_cli_vulnerabilities (id, analysis_id, vuln_hash, severity, type,
+  security_tool, language, rule_id, file, line, "column", code, details, confidence, commit_hash, commit_author,
+  commit_email, dependency_name, dependency_version)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)`
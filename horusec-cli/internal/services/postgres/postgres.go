@@ -0,0 +1,173 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	_ "github.com/lib/pq" // Required to open the connections of the postgres driver
+)
+
+const (
+	driverName     = "postgres"
+	requestTimeout = 60 * time.Second
+	// migrationLockID is the key of the advisory lock that serializes the migrations of the analyses run in parallel,
+	// an int64 like the key of postgres, so it doesn't overflow the int of the 32 bits builds
+	migrationLockID int64 = 4865757365
+)
+
+type Interface interface {
+	Export(analysis *horusec.Analysis)
+}
+
+type Postgres struct {
+	config cliConfig.IConfig
+}
+
+func NewPostgres(config cliConfig.IConfig) Interface {
+	return &Postgres{
+		config: config,
+	}
+}
+
+// Export does nothing unless the uri was informed. The schema is migrated before the analysis, so the first export
+// creates the tables, and the errors are only logged, exporting never changes the result of the analysis
+func (p *Postgres) Export(analysis *horusec.Analysis) {
+	if p.config.GetPostgresURI() == "" {
+		return
+	}
+	if err := p.export(analysis); err != nil {
+		logger.LogWarnWithLevel(messages.MsgWarnPostgresExportFailed, logger.WarnLevel, err.Error())
+		return
+	}
+	logger.LogDebugWithLevel(messages.MsgDebugAnalysisExportedToPostgres, logger.DebugLevel)
+}
+
+func (p *Postgres) export(analysis *horusec.Analysis) error {
+	db, err := sql.Open(driverName, p.config.GetPostgresURI())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	if err := Migrate(ctx, db); err != nil {
+		return err
+	}
+	return p.insertAnalysis(ctx, db, analysis)
+}
+
+// Migrate applies the migrations not applied yet in a transaction, locked so the analyses exported at the same time
+// don't apply the same migration
+func Migrate(ctx context.Context, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", migrationLockID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, createMigrationsTable); err != nil {
+		return err
+	}
+	for _, migration := range Migrations {
+		if err := applyMigration(ctx, tx, migration); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func applyMigration(ctx context.Context, tx *sql.Tx, migration Migration) error {
+	var applied bool
+	err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM horusec_cli_schema_migrations WHERE version = $1)",
+		migration.Version).Scan(&applied)
+	if err != nil || applied {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO horusec_cli_schema_migrations (version) VALUES ($1)",
+		migration.Version)
+	return err
+}
+
+// insertAnalysis replaces the analysis with the same id, its vulnerabilities are removed by the foreign key
+func (p *Postgres) insertAnalysis(ctx context.Context, db *sql.DB, analysis *horusec.Analysis) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if _, err := tx.ExecContext(ctx, "DELETE FROM horusec_cli_analyses WHERE id = $1", analysis.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, insertAnalysisQuery, NewAnalysisValues(analysis)...); err != nil {
+		return err
+	}
+	stmt, err := tx.PrepareContext(ctx, insertVulnerabilityQuery)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		if _, err := stmt.ExecContext(ctx, NewVulnerabilityValues(analysis, vulnerability)...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// NewAnalysisValues returns the values of the columns of insertAnalysisQuery, the tags are saved as jsonb
+func NewAnalysisValues(analysis *horusec.Analysis) []interface{} {
+	var branch, commitHash string
+	if analysis.Source != nil {
+		branch, commitHash = analysis.Source.Branch, analysis.Source.CommitSHA
+	}
+	var riskScore sql.NullInt64
+	var riskGrade sql.NullString
+	if analysis.RiskScore != nil {
+		riskScore = sql.NullInt64{Int64: int64(analysis.RiskScore.Score), Valid: true}
+		riskGrade = sql.NullString{String: analysis.RiskScore.Grade, Valid: true}
+	}
+	tags, _ := json.Marshal(analysis.Tags)
+	return []interface{}{analysis.ID, analysis.RepositoryName, string(analysis.Status), analysis.CreatedAt,
+		analysis.FinishedAt, analysis.IsPartial, branch, commitHash, riskScore, riskGrade, string(tags)}
+}
+
+// NewVulnerabilityValues returns the values of the columns of insertVulnerabilityQuery
+func NewVulnerabilityValues(analysis *horusec.Analysis, vulnerability *horusec.Vulnerability) []interface{} {
+	var dependencyName, dependencyVersion string
+	if vulnerability.Dependency != nil {
+		dependencyName, dependencyVersion = vulnerability.Dependency.Name, vulnerability.Dependency.Version
+	}
+	return []interface{}{vulnerability.VulnerabilityID, analysis.ID, vulnerability.VulnHash,
+		vulnerability.Severity.ToString(), string(vulnerability.Type), vulnerability.SecurityTool.ToString(),
+		vulnerability.Language.ToString(), vulnerability.RuleID, vulnerability.File, vulnerability.Line,
+		vulnerability.Column, vulnerability.Code, vulnerability.Details, vulnerability.Confidence,
+		vulnerability.CommitHash, vulnerability.CommitAuthor, vulnerability.CommitEmail, dependencyName,
+		dependencyVersion}
+}
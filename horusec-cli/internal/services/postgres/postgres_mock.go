@@ -12,12 +12,18 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package semgrep
+package postgres
 
-type Result struct {
-	CheckID string   `json:"check_id"`
-	Path    string   `json:"path"`
-	Start   Position `json:"start"`
-	End     Position `json:"end"`
-	Extra   Extra    `json:"extra"`
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Export(analysis *horusec.Analysis) {
+	_ = m.MethodCalled("Export")
 }
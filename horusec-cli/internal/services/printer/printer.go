@@ -0,0 +1,117 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printer
+
+import (
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+// ExecPluginPrefix is the prefix of the executables in the PATH used as printers of the output formats not
+// compiled in horusec. The executable receives the analysis as json in the stdin and its stdout is the output
+const ExecPluginPrefix = "horusec-printer-"
+
+// Printer writes the result of the analysis in the output format selected by the flag output-format
+type Printer interface {
+	Print(analysis *horusec.Analysis, configs cliConfig.IConfig) error
+}
+
+var (
+	printers = map[string]Printer{}
+	mutex    sync.RWMutex
+	// reportOutput is the original stdout in the quiet mode, where only the reports are written
+	reportOutput *os.File
+)
+
+// Register makes the printer available to the output format informed, replacing the printer registered
+// before. It is called in the init of the package of the printer, so printers compiled in with build tags
+// don't need changes in the core
+func Register(outputType string, printer Printer) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	printers[outputType] = printer
+}
+
+func Get(outputType string) (Printer, bool) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	printer, ok := printers[outputType]
+	return printer, ok
+}
+
+// OutputTypes returns the output formats registered sorted by name, without the exec plugins
+func OutputTypes() (outputTypes []string) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	for outputType := range printers {
+		outputTypes = append(outputTypes, outputType)
+	}
+	sort.Strings(outputTypes)
+	return outputTypes
+}
+
+// LookExecPlugin returns the path of the executable horusec-printer-<output type> found in the PATH
+func LookExecPlugin(outputType string) (string, bool) {
+	if outputType == "" || strings.ContainsAny(outputType, `/\`) {
+		return "", false
+	}
+	path, err := exec.LookPath(ExecPluginPrefix + outputType)
+	return path, err == nil
+}
+
+// IsAvailable checks if the output format has a printer registered or an exec plugin in the PATH
+func IsAvailable(outputType string) bool {
+	if _, ok := Get(outputType); ok {
+		return true
+	}
+	_, ok := LookExecPlugin(outputType)
+	return ok
+}
+
+// EnableQuiet replaces the stdout by the stderr, so all human output is written in the stderr and the stdout is
+// kept to the reports written with WithReportOutput. The function returned restores the stdout
+func EnableQuiet() (restore func()) {
+	stdout := os.Stdout
+	reportOutput = stdout
+	os.Stdout = os.Stderr
+	return func() {
+		os.Stdout = stdout
+		reportOutput = nil
+	}
+}
+
+// WithReportOutput runs the printer with the original stdout in the quiet mode
+func WithReportOutput(print func() error) error {
+	if reportOutput == nil {
+		return print()
+	}
+	stdout := os.Stdout
+	os.Stdout = reportOutput
+	defer func() {
+		os.Stdout = stdout
+	}()
+	return print()
+}
+
+// IsQuiet returns true between EnableQuiet and its restore
+func IsQuiet() bool {
+	return reportOutput != nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printer
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type printerTest struct{}
+
+func (p *printerTest) Print(_ *horusec.Analysis, _ cliConfig.IConfig) error {
+	return nil
+}
+
+func TestRegister(t *testing.T) {
+	t.Run("Should register and get the printer of the output type", func(t *testing.T) {
+		Register("test-register", &printerTest{})
+
+		printer, ok := Get("test-register")
+		assert.True(t, ok)
+		assert.NotNil(t, printer)
+		assert.Contains(t, OutputTypes(), "test-register")
+	})
+
+	t.Run("Should return false when output type is not registered", func(t *testing.T) {
+		_, ok := Get("not-registered")
+		assert.False(t, ok)
+	})
+}
+
+func TestLookExecPlugin(t *testing.T) {
+	t.Run("Should find the executable in the path", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "horusec-printer")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, ExecPluginPrefix+"test"), []byte("#!/bin/sh\n"), 0700))
+		oldPath := os.Getenv("PATH")
+		defer os.Setenv("PATH", oldPath)
+		_ = os.Setenv("PATH", dir)
+
+		path, ok := LookExecPlugin("test")
+		assert.True(t, ok)
+		assert.Equal(t, filepath.Join(dir, ExecPluginPrefix+"test"), path)
+		assert.True(t, IsAvailable("test"))
+	})
+
+	t.Run("Should return false when output type is a path", func(t *testing.T) {
+		_, ok := LookExecPlugin("../test")
+		assert.False(t, ok)
+		assert.False(t, IsAvailable(""))
+	})
+}
+
+func TestEnableQuiet(t *testing.T) {
+	t.Run("Should write only the report in the stdout", func(t *testing.T) {
+		stdout, stderr := os.Stdout, os.Stderr
+		reader, writer, err := os.Pipe()
+		assert.NoError(t, err)
+		os.Stdout = writer
+		defer func() {
+			os.Stdout, os.Stderr = stdout, stderr
+		}()
+		os.Stderr = stdout
+
+		restore := EnableQuiet()
+		assert.True(t, IsQuiet())
+		assert.Equal(t, stdout, os.Stdout)
+		assert.NoError(t, WithReportOutput(func() error {
+			_, err := os.Stdout.WriteString("report")
+			return err
+		}))
+		assert.Equal(t, stdout, os.Stdout)
+		restore()
+
+		assert.False(t, IsQuiet())
+		assert.Equal(t, writer, os.Stdout)
+		assert.NoError(t, writer.Close())
+		content, err := ioutil.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, "report", string(content))
+	})
+
+	t.Run("Should run the printer without quiet", func(t *testing.T) {
+		stdout := os.Stdout
+		assert.NoError(t, WithReportOutput(func() error {
+			assert.Equal(t, stdout, os.Stdout)
+			return nil
+		}))
+	})
+}
@@ -0,0 +1,334 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package progress
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+type Status string
+
+const (
+	Pulling Status = "pulling"
+	Running Status = "running"
+	Parsing Status = "parsing"
+	Done    Status = "done"
+	Failed  Status = "error"
+)
+
+const (
+	renderInterval = 100 * time.Millisecond
+	// ansi codes to move the cursor to the previous line and to clear it
+	cursorUp  = "\033[1A"
+	clearLine = "\033[2K\r"
+)
+
+var spinnerFrames = []string{"-", "\\", "|", "/"}
+
+type Interface interface {
+	Start()
+	Stop()
+	SetToolStatus(tool tools.Tool, projectSubPath string, status Status)
+	SetImagePullProgress(imagePath string, downloaded, total int64)
+	FinishImagePull(imagePath string)
+}
+
+type task struct {
+	tool           tools.Tool
+	projectSubPath string
+	status         Status
+	startedAt      time.Time
+	finishedAt     time.Time
+}
+
+// imagePull is the download of the layers of an image, the total grows while the layers are found
+type imagePull struct {
+	imagePath  string
+	downloaded int64
+	total      int64
+}
+
+type Progress struct {
+	mutex         sync.Mutex
+	config        cliConfig.IConfig
+	output        io.Writer
+	isInteractive bool
+	tasks         []*task
+	pulls         []*imagePull
+	startedAt     time.Time
+	frame         int
+	linesRendered int
+	stop          chan struct{}
+	stopped       chan struct{}
+}
+
+// NewProgress shows the status of each tool redrawing the lines in the terminal, when the output is not a
+// terminal or debug logs are enabled the progress is logged periodically
+func NewProgress(config cliConfig.IConfig) Interface {
+	return &Progress{
+		config:        config,
+		output:        os.Stderr,
+		isInteractive: isTerminal(os.Stderr) && logger.CurrentLevel < logger.DebugLevel,
+	}
+}
+
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+func (p *Progress) Start() {
+	p.startedAt = time.Now()
+	p.stop = make(chan struct{})
+	p.stopped = make(chan struct{})
+	if p.isInteractive {
+		logger.SetOutput(p, true)
+		go p.loop(renderInterval, p.render)
+		return
+	}
+	go p.loop(p.getLogInterval(), p.logProgress)
+}
+
+func (p *Progress) getLogInterval() time.Duration {
+	if p.config.GetMonitorRetryInSeconds() <= 0 {
+		return time.Second
+	}
+	return time.Duration(p.config.GetMonitorRetryInSeconds()) * time.Second
+}
+
+func (p *Progress) Stop() {
+	if p.stop == nil {
+		return
+	}
+	close(p.stop)
+	<-p.stopped
+	p.stop = nil
+	if p.isInteractive {
+		p.render()
+		logger.SetOutput(os.Stderr, false)
+	}
+}
+
+func (p *Progress) loop(interval time.Duration, show func()) {
+	defer close(p.stopped)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			show()
+		}
+	}
+}
+
+func (p *Progress) SetToolStatus(tool tools.Tool, projectSubPath string, status Status) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	current := p.getTask(tool, projectSubPath)
+	if current == nil {
+		current = &task{tool: tool, projectSubPath: projectSubPath, startedAt: time.Now()}
+		p.tasks = append(p.tasks, current)
+	}
+	current.status = status
+	if status == Done || status == Failed {
+		current.finishedAt = time.Now()
+	}
+}
+
+// SetImagePullProgress shows the bytes downloaded of the layers of the image, so the pull of a big image is not
+// seen as hung
+func (p *Progress) SetImagePullProgress(imagePath string, downloaded, total int64) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	current := p.getImagePull(imagePath)
+	if current == nil {
+		current = &imagePull{imagePath: imagePath}
+		p.pulls = append(p.pulls, current)
+	}
+	current.downloaded, current.total = downloaded, total
+}
+
+func (p *Progress) FinishImagePull(imagePath string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for index, current := range p.pulls {
+		if current.imagePath == imagePath {
+			p.pulls = append(p.pulls[:index], p.pulls[index+1:]...)
+			return
+		}
+	}
+}
+
+func (p *Progress) getImagePull(imagePath string) *imagePull {
+	for _, current := range p.pulls {
+		if current.imagePath == imagePath {
+			return current
+		}
+	}
+	return nil
+}
+
+func (p *Progress) getTask(tool tools.Tool, projectSubPath string) *task {
+	for _, current := range p.tasks {
+		if current.tool == tool && current.projectSubPath == projectSubPath {
+			return current
+		}
+	}
+	return nil
+}
+
+// Write is used as output of the logs while the progress is rendered, so the logs are written above it
+func (p *Progress) Write(content []byte) (int, error) {
+	if len(content) == 0 {
+		return 0, nil
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.clear()
+	written, err := p.output.Write(content)
+	p.draw()
+	return written, err
+}
+
+func (p *Progress) render() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.frame++
+	p.clear()
+	p.draw()
+}
+
+func (p *Progress) clear() {
+	_, _ = io.WriteString(p.output, strings.Repeat(cursorUp+clearLine, p.linesRendered))
+	p.linesRendered = 0
+}
+
+func (p *Progress) draw() {
+	lines := append(append([]string{p.getSummary()}, p.getTaskLines()...), p.getPullLines()...)
+	_, _ = io.WriteString(p.output, strings.Join(lines, "\n")+"\n")
+	p.linesRendered = len(lines)
+}
+
+func (p *Progress) getSummary() string {
+	done := p.countDone()
+	return fmt.Sprintf("%s Analyzing: %d/%d tools done (%d%%) | elapsed %s | timeout in %s",
+		spinnerFrames[p.frame%len(spinnerFrames)], done, len(p.tasks), p.getPercent(done),
+		p.formatDuration(time.Since(p.startedAt)), p.formatDuration(p.getTimeoutIn()))
+}
+
+func (p *Progress) getTaskLines() (lines []string) {
+	for _, current := range p.tasks {
+		lines = append(lines, fmt.Sprintf("  %-30s %-8s %s",
+			p.getTaskName(current), current.status, p.formatDuration(p.getTaskElapsed(current))))
+	}
+	return lines
+}
+
+func (p *Progress) getPullLines() (lines []string) {
+	for _, current := range p.pulls {
+		lines = append(lines, fmt.Sprintf("  pulling %s %s", current.imagePath, p.getPullStatus(current)))
+	}
+	return lines
+}
+
+func (p *Progress) getPullStatus(current *imagePull) string {
+	if current.total <= 0 {
+		return "waiting"
+	}
+	return fmt.Sprintf("%d%% of %s", current.downloaded*100/current.total, p.formatBytes(current.total))
+}
+
+func (p *Progress) formatBytes(size int64) string {
+	const bytesInMB = 1024 * 1024
+	if size >= 1024*bytesInMB {
+		return fmt.Sprintf("%.1fGB", float64(size)/(1024*bytesInMB))
+	}
+	return fmt.Sprintf("%.1fMB", float64(size)/bytesInMB)
+}
+
+// logProgress is used when the output is not a terminal, like in the pipelines
+func (p *Progress) logProgress() {
+	p.mutex.Lock()
+	done := p.countDone()
+	var running []string
+	for _, current := range p.tasks {
+		if current.status != Done && current.status != Failed {
+			running = append(running, fmt.Sprintf("%s %s %s", p.getTaskName(current), current.status,
+				p.formatDuration(p.getTaskElapsed(current))))
+		}
+	}
+	for _, current := range p.pulls {
+		running = append(running, fmt.Sprintf("pulling %s %s", current.imagePath, p.getPullStatus(current)))
+	}
+	msg := fmt.Sprintf("%s%s | %d/%d tools done (%d%%) | %s", messages.MsgInfoMonitorTimeoutIn,
+		p.formatDuration(p.getTimeoutIn()), done, len(p.tasks), p.getPercent(done), strings.Join(running, ", "))
+	p.mutex.Unlock()
+	logger.LogInfoWithLevel(msg, logger.InfoLevel)
+}
+
+func (p *Progress) countDone() (done int) {
+	for _, current := range p.tasks {
+		if current.status == Done || current.status == Failed {
+			done++
+		}
+	}
+	return done
+}
+
+func (p *Progress) getPercent(done int) int {
+	if len(p.tasks) == 0 {
+		return 0
+	}
+	return done * 100 / len(p.tasks)
+}
+
+func (p *Progress) getTaskName(current *task) string {
+	if current.projectSubPath == "" {
+		return current.tool.ToString()
+	}
+	return fmt.Sprintf("%s (%s)", current.tool.ToString(), current.projectSubPath)
+}
+
+func (p *Progress) getTaskElapsed(current *task) time.Duration {
+	if current.finishedAt.IsZero() {
+		return time.Since(current.startedAt)
+	}
+	return current.finishedAt.Sub(current.startedAt)
+}
+
+func (p *Progress) getTimeoutIn() time.Duration {
+	timeoutIn := time.Duration(p.config.GetTimeoutInSecondsAnalysis())*time.Second - time.Since(p.startedAt)
+	if timeoutIn < 0 {
+		return 0
+	}
+	return timeoutIn
+}
+
+func (p *Progress) formatDuration(duration time.Duration) string {
+	return duration.Truncate(time.Second).String()
+}
@@ -0,0 +1,44 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package progress
+
+import (
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/stretchr/testify/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Start() {
+	m.MethodCalled("Start")
+}
+
+func (m *Mock) Stop() {
+	m.MethodCalled("Stop")
+}
+
+func (m *Mock) SetToolStatus(tool tools.Tool, projectSubPath string, status Status) {
+	m.MethodCalled("SetToolStatus")
+}
+
+func (m *Mock) SetImagePullProgress(imagePath string, downloaded, total int64) {
+	m.MethodCalled("SetImagePullProgress")
+}
+
+func (m *Mock) FinishImagePull(imagePath string) {
+	m.MethodCalled("FinishImagePull")
+}
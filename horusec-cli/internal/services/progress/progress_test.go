@@ -0,0 +1,121 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package progress
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProgress(t *testing.T) {
+	t.Run("Should return a new progress", func(t *testing.T) {
+		assert.IsType(t, &Progress{}, NewProgress(&cliConfig.Config{}))
+	})
+}
+
+func TestProgress_SetToolStatus(t *testing.T) {
+	t.Run("Should keep a task by tool and project sub path", func(t *testing.T) {
+		progress := &Progress{config: &cliConfig.Config{}}
+
+		progress.SetToolStatus(tools.GoSec, "", Pulling)
+		progress.SetToolStatus(tools.GoSec, "api", Running)
+		progress.SetToolStatus(tools.GoSec, "", Done)
+
+		assert.Len(t, progress.tasks, 2)
+		assert.Equal(t, Done, progress.tasks[0].status)
+		assert.False(t, progress.tasks[0].finishedAt.IsZero())
+		assert.Equal(t, Running, progress.tasks[1].status)
+		assert.Equal(t, 1, progress.countDone())
+		assert.Equal(t, 50, progress.getPercent(progress.countDone()))
+	})
+}
+
+func TestProgress_SetImagePullProgress(t *testing.T) {
+	t.Run("Should keep the pull of the image until finished", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		progress := &Progress{config: &cliConfig.Config{}, output: output, isInteractive: true}
+		progress.SetImagePullProgress("horuszup/horusec-java:v1.0.0", 0, 0)
+		progress.render()
+		assert.Contains(t, output.String(), "pulling horuszup/horusec-java:v1.0.0 waiting")
+
+		progress.SetImagePullProgress("horuszup/horusec-java:v1.0.0", 600*1024*1024, 1200*1024*1024)
+		progress.render()
+		assert.Contains(t, output.String(), "pulling horuszup/horusec-java:v1.0.0 50% of 1.2GB")
+		assert.Len(t, progress.pulls, 1)
+
+		progress.FinishImagePull("horuszup/horusec-java:v1.0.0")
+		assert.Empty(t, progress.pulls)
+	})
+}
+
+func TestProgress_Render(t *testing.T) {
+	t.Run("Should redraw the status of the tools", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		progress := &Progress{config: &cliConfig.Config{}, output: output, isInteractive: true}
+		progress.SetToolStatus(tools.Semgrep, "api", Running)
+		progress.SetToolStatus(tools.GoSec, "", Done)
+
+		progress.render()
+		progress.render()
+
+		assert.Contains(t, output.String(), "1/2 tools done (50%)")
+		assert.Contains(t, output.String(), "Semgrep (api)")
+		assert.Equal(t, 3, strings.Count(output.String(), cursorUp+clearLine))
+	})
+
+	t.Run("Should write the logs above the progress", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		progress := &Progress{config: &cliConfig.Config{}, output: output, isInteractive: true}
+		progress.SetToolStatus(tools.GoSec, "", Pulling)
+		progress.render()
+		output.Reset()
+
+		_, err := progress.Write([]byte("some log\n"))
+
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(output.String(), strings.Repeat(cursorUp+clearLine, 2)+"some log\n"))
+		assert.Contains(t, output.String(), "GoSec")
+	})
+}
+
+func TestProgress_StartAndStop(t *testing.T) {
+	t.Run("Should log the progress periodically when is not interactive", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		logger.SetOutput(output, false)
+		defer logger.SetOutput(os.Stderr, false)
+		config := &cliConfig.Config{}
+		config.SetMonitorRetryInSeconds(1)
+		config.SetTimeoutInSecondsAnalysis(600)
+		progress := &Progress{config: config, output: output}
+
+		progress.Start()
+		progress.SetToolStatus(tools.HorusecJava, "", Running)
+		progress.SetImagePullProgress("horuszup/gosec:v1.0.0", 25*1024*1024, 100*1024*1024)
+		time.Sleep(1500 * time.Millisecond)
+		progress.Stop()
+
+		assert.Contains(t, output.String(), "0/1 tools done (0%)")
+		assert.Contains(t, output.String(), "HorusecJava running")
+		assert.Contains(t, output.String(), "pulling horuszup/gosec:v1.0.0 25% of 100.0MB")
+	})
+}
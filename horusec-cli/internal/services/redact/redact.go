@@ -0,0 +1,118 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/secretmask"
+)
+
+const (
+	hashPrefix  = "sha256:"
+	projectPath = "."
+	homePath    = "~"
+)
+
+type Interface interface {
+	Redact(analysis *horusec.Analysis) *horusec.Analysis
+}
+
+type Redact struct {
+	config cliConfig.IConfig
+}
+
+func NewRedact(config cliConfig.IConfig) Interface {
+	return &Redact{
+		config: config,
+	}
+}
+
+// Redact returns a copy of the analysis without the code, the secrets, the commit authors and the absolute paths,
+// to share the report outside of the company. The rules, severities, files and totals are kept, and the code is
+// replaced by its hash, so the same finding is still identified in the reports. The analysis informed is not changed
+func (r *Redact) Redact(analysis *horusec.Analysis) *horusec.Analysis {
+	if !r.config.GetRedact() {
+		return analysis
+	}
+	redacted := r.copyAnalysis(analysis)
+	for index := range redacted.AnalysisVulnerabilities {
+		r.redactVulnerability(&redacted.AnalysisVulnerabilities[index].Vulnerability)
+	}
+	redacted.Errors = r.redactText(redacted.Errors)
+	if redacted.Source != nil {
+		redacted.Source.RepositoryURL = secretmask.Mask(redacted.Source.RepositoryURL)
+	}
+	if redacted.ScanManifest != nil {
+		for index := range redacted.ScanManifest.ToolsExecuted {
+			redacted.ScanManifest.ToolsExecuted[index].RawOutputPath = ""
+		}
+	}
+	return redacted
+}
+
+func (r *Redact) redactVulnerability(vulnerability *horusec.Vulnerability) {
+	vulnerability.Code = Hash(vulnerability.Code)
+	vulnerability.CodeContext = nil
+	vulnerability.File = r.replaceAbsolutePaths(vulnerability.File)
+	vulnerability.Details = r.redactText(vulnerability.Details)
+	vulnerability.CommitAuthor = Hash(vulnerability.CommitAuthor)
+	vulnerability.CommitEmail = Hash(vulnerability.CommitEmail)
+	vulnerability.CommitMessage = ""
+	vulnerability.RawOutputPath = ""
+	if vulnerability.Triage != nil {
+		vulnerability.Triage.Justification = r.redactText(vulnerability.Triage.Justification)
+	}
+}
+
+// copyAnalysis copies the analysis by its json, so the slices and pointers of the copy are not shared
+func (r *Redact) copyAnalysis(analysis *horusec.Analysis) *horusec.Analysis {
+	redacted := &horusec.Analysis{}
+	content, _ := json.Marshal(analysis)
+	_ = json.Unmarshal(content, redacted)
+	return redacted
+}
+
+func (r *Redact) redactText(text string) string {
+	return r.replaceAbsolutePaths(secretmask.Mask(text))
+}
+
+// replaceAbsolutePaths replaces the absolute path of the project by . and of the home by ~, because they have the
+// name of the user and the layout of the machine that ran the analysis
+func (r *Redact) replaceAbsolutePaths(text string) string {
+	if project, err := filepath.Abs(r.config.GetProjectPath()); err == nil && project != string(filepath.Separator) {
+		text = strings.ReplaceAll(text, project, projectPath)
+	}
+	if home, err := os.UserHomeDir(); err == nil && home != "" && home != string(filepath.Separator) {
+		text = strings.ReplaceAll(text, home, homePath)
+	}
+	return text
+}
+
+// Hash returns the sha256 of the value, the empty values are kept empty
+func Hash(value string) string {
+	if value == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(value))
+	return hashPrefix + hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,104 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+//nolint:funlen,lll dataset is greater than 15 lines and the examples are kept in a single line
+func DefaultFixes() map[string]Fix {
+	return map[string]Fix{
+		"G101": {Guidance: "Remove the credential from the code, rotate it and read it from an environment variable or a secret manager.",
+			Example: `password := os.Getenv("DB_PASSWORD")`},
+		"G102": {Guidance: "Bind the listener to the interface used by the clients instead of all interfaces.",
+			Example: `listener, err := net.Listen("tcp", "127.0.0.1:8080")`},
+		"G103": {Guidance: "Avoid the package unsafe, use the conversions and the APIs of the standard library that are checked by the compiler."},
+		"G104": {Guidance: "Handle the returned error, at least logging it, so failures aren't silently ignored.",
+			Example: "if err := file.Close(); err != nil {\n\treturn err\n}"},
+		"G106": {Guidance: "Verify the host key of the ssh server with a known hosts file instead of ssh.InsecureIgnoreHostKey.",
+			Example: `hostKeyCallback, err := knownhosts.New(filepath.Join(home, ".ssh", "known_hosts"))`},
+		"G107": {Guidance: "Validate the url of the request against a list of allowed hosts before sending it.",
+			Example: "if !allowedHosts[parsedURL.Host] {\n\treturn errors.New(\"host not allowed\")\n}"},
+		"G108": {Guidance: "Serve the profiling endpoints of net/http/pprof in a separated server, not exposed to the users."},
+		"G109": {Guidance: "Check the bounds of the value returned by strconv.Atoi before converting it to a smaller integer type.",
+			Example: "if value < math.MinInt32 || value > math.MaxInt32 {\n\treturn errors.New(\"out of range\")\n}"},
+		"G110": {Guidance: "Limit the size of the decompressed content to avoid decompression bombs.",
+			Example: `_, err := io.Copy(destination, io.LimitReader(reader, maxSize))`},
+		"G201": {Guidance: "Use the placeholders of the database driver instead of formatting the values in the query.",
+			Example: `rows, err := db.Query("SELECT * FROM users WHERE name = ?", name)`},
+		"G202": {Guidance: "Use the placeholders of the database driver instead of concatenating the values in the query.",
+			Example: `rows, err := db.Query("SELECT * FROM users WHERE name = ?", name)`},
+		"G203": {Guidance: "Don't mark content informed by the user as safe html, let html/template escape it."},
+		"G204": {Guidance: "Run the command with fixed arguments, validating the values informed by the user against a list of allowed values and never through a shell.",
+			Example: `cmd := exec.Command("git", "checkout", "--", branch)`},
+		"G301": {Guidance: "Create the directories with permissions 0750 or less.",
+			Example: `err := os.MkdirAll(path, 0750)`},
+		"G302": {Guidance: "Open the files with permissions 0600 or less.",
+			Example: `file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0600)`},
+		"G303": {Guidance: "Create the temporary files with ioutil.TempFile, that uses a random name, instead of a predictable path.",
+			Example: `file, err := ioutil.TempFile("", "prefix")`},
+		"G304": {Guidance: "Clean the path informed by the user and check that it is inside the expected directory before reading it.",
+			Example: "path := filepath.Join(baseDir, filepath.Clean(\"/\"+name))"},
+		"G305": {Guidance: "Check that the paths of the files of the archive are inside the destination directory before extracting them.",
+			Example: "if !strings.HasPrefix(target, filepath.Clean(destination)+string(os.PathSeparator)) {\n\treturn errors.New(\"invalid path\")\n}"},
+		"G306": {Guidance: "Write the files with permissions 0600 or less.",
+			Example: `err := ioutil.WriteFile(path, content, 0600)`},
+		"G307": {Guidance: "Handle the error returned by Close of the files written, deferring a function that checks it."},
+		"G401": {Guidance: "Replace md5 and sha1 by sha256 or better, for passwords use bcrypt, scrypt or argon2.",
+			Example: `sum := sha256.Sum256(content)`},
+		"G402": {Guidance: "Keep the verification of the certificates enabled and use at least TLS 1.2.",
+			Example: `config := &tls.Config{MinVersion: tls.VersionTLS12}`},
+		"G403": {Guidance: "Generate RSA keys with at least 2048 bits.",
+			Example: `key, err := rsa.GenerateKey(rand.Reader, 2048)`},
+		"G404": {Guidance: "Use crypto/rand to generate tokens, keys and any value that must be unpredictable.",
+			Example: "token := make([]byte, 32)\n_, err := rand.Read(token)"},
+		"G501": {Guidance: "Replace the import of crypto/md5 by crypto/sha256 or better.", Example: `import "crypto/sha256"`},
+		"G502": {Guidance: "Replace the import of crypto/des by crypto/aes.", Example: `import "crypto/aes"`},
+		"G503": {Guidance: "Replace the import of crypto/rc4 by crypto/aes.", Example: `import "crypto/aes"`},
+		"G504": {Guidance: "Don't use net/http/cgi, it is vulnerable to httpoxy, serve the application with net/http."},
+		"G505": {Guidance: "Replace the import of crypto/sha1 by crypto/sha256 or better.", Example: `import "crypto/sha256"`},
+		"G601": {Guidance: "Don't keep the address of the variable of the range, copy it or use the index of the slice.",
+			Example: "for index := range items {\n\tpointers = append(pointers, &items[index])\n}"},
+		"dangerous-subprocess-use": {Guidance: "Run the command with a list of fixed arguments and shell disabled, validating the values informed by the user.",
+			Example: `subprocess.run(["git", "checkout", "--", branch], check=True)`},
+		"eval-detected": {Guidance: "Don't evaluate code built with values informed by the user, parse the data with JSON.parse or use a map of allowed functions.",
+			Example: `const data = JSON.parse(input);`},
+		"avoid-pickle": {Guidance: "Don't unpickle data that can be changed by the user, serialize it with json.",
+			Example: `data = json.loads(content)`},
+		"formatted-sql-query": {Guidance: "Use the parameters of the database driver instead of formatting the values in the query.",
+			Example: `cursor.execute("SELECT * FROM users WHERE name = %s", (name,))`},
+		"CWE-22": {Guidance: "Normalize the path informed by the user and check that it is inside the expected directory before using it."},
+		"CWE-78": {Guidance: "Don't build commands of the shell with values informed by the user, run the program with a list of fixed arguments validated against allowed values."},
+		"CWE-79": {Guidance: "Escape the values informed by the user in the context they are written, prefer the auto escaping of the template engine and avoid writing raw html."},
+		"CWE-89": {Guidance: "Use parameterized queries or the parameters of the ORM instead of concatenating values in the query.",
+			Example: `statement = connection.prepareStatement("SELECT * FROM users WHERE name = ?"); statement.setString(1, name);`},
+		"CWE-90":   {Guidance: "Escape the values of the LDAP filters informed by the user or validate them against a list of allowed characters."},
+		"CWE-94":   {Guidance: "Don't evaluate code built with values informed by the user."},
+		"CWE-200":  {Guidance: "Don't expose stack traces, internal paths and versions in the responses, log them in the server."},
+		"CWE-295":  {Guidance: "Keep the validation of the certificates and of the host names enabled, use a custom certificate authority when needed."},
+		"CWE-311":  {Guidance: "Encrypt the sensitive data in transit with TLS and at rest with the encryption of the storage."},
+		"CWE-326":  {Guidance: "Use keys of at least 2048 bits for RSA and 128 bits for AES."},
+		"CWE-327":  {Guidance: "Replace the broken algorithms, like DES, RC4, MD5 and SHA1, by AES-GCM and SHA-256 or better."},
+		"CWE-328":  {Guidance: "Replace md5 and sha1 by sha256 or better, for passwords use bcrypt, scrypt or argon2."},
+		"CWE-330":  {Guidance: "Use the secure random generator of the platform for tokens, keys and any value that must be unpredictable."},
+		"CWE-338":  {Guidance: "Use the secure random generator of the platform for tokens, keys and any value that must be unpredictable."},
+		"CWE-352":  {Guidance: "Keep the protection against CSRF of the framework enabled and set the cookies of session with SameSite."},
+		"CWE-502":  {Guidance: "Don't deserialize objects from data that can be changed by the user, use data formats like json and validate the types."},
+		"CWE-601":  {Guidance: "Redirect only to relative paths or to urls of a list of allowed hosts."},
+		"CWE-611":  {Guidance: "Disable the external entities and the DTDs in the XML parser."},
+		"CWE-614":  {Guidance: "Set the flag Secure in the cookies, so they are only sent over https."},
+		"CWE-732":  {Guidance: "Create the files and the directories with the minimal permissions needed, only readable by the owner when they are sensitive."},
+		"CWE-798":  {Guidance: "Remove the credential from the code, rotate it and read it from an environment variable or a secret manager."},
+		"CWE-918":  {Guidance: "Validate the urls requested by the server against a list of allowed hosts and block the internal addresses."},
+		"CWE-1004": {Guidance: "Set the flag HttpOnly in the cookies of session, so they can't be read by scripts."},
+	}
+}
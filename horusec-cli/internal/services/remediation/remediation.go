@@ -0,0 +1,108 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+var cweRegex = regexp.MustCompile(`CWE-\d+`)
+
+// Fix is the guidance to fix the vulnerabilities of a rule, with an optional example of the code fixed
+type Fix struct {
+	Guidance string `yaml:"guidance"`
+	Example  string `yaml:"example"`
+}
+
+func (f *Fix) ToString() string {
+	content := "Remediation: " + strings.TrimSpace(f.Guidance)
+	if example := strings.TrimSpace(f.Example); example != "" {
+		content += "\nExample:\n" + example
+	}
+	return content
+}
+
+type Interface interface {
+	SetRemediations(analysis *horusec.Analysis) error
+}
+
+type Remediation struct {
+	config cliConfig.IConfig
+}
+
+func NewRemediation(config cliConfig.IConfig) Interface {
+	return &Remediation{config: config}
+}
+
+// SetRemediations adds the fix of each vulnerability to its details, after the vulnerability hash was generated so
+// the hashes of the false positives and of the risk accepted don't change
+func (r *Remediation) SetRemediations(analysis *horusec.Analysis) error {
+	fixes, err := LoadFixes(r.config.GetRemediationPath())
+	if err != nil {
+		return err
+	}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		if fix := FindFix(fixes, vulnerability); fix != nil {
+			vulnerability.Details += "\n" + fix.ToString()
+		}
+	}
+	return nil
+}
+
+// LoadFixes reads the yaml of the path informed, a map of the rule id or the CWE to the fix, replacing the fixes of
+// the built-in dataset with the same key. The built-in guidance doesn't mention CWEs, that would be counted again by
+// the risk score and the policies
+func LoadFixes(path string) (map[string]Fix, error) {
+	fixes := DefaultFixes()
+	if path == "" {
+		return fixes, nil
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	customFixes := map[string]Fix{}
+	if err := yaml.Unmarshal(content, &customFixes); err != nil {
+		return nil, err
+	}
+	for key, fix := range customFixes {
+		fixes[key] = fix
+	}
+	return fixes, nil
+}
+
+// FindFix searches by the rule id, by the last part of the rule id of semgrep, like eval-detected of
+// javascript.browser.security.eval-detected.eval-detected, and then by the CWEs of the details in order
+func FindFix(fixes map[string]Fix, vulnerability *horusec.Vulnerability) *Fix {
+	keys := []string{vulnerability.RuleID}
+	if index := strings.LastIndex(vulnerability.RuleID, "."); index >= 0 {
+		keys = append(keys, vulnerability.RuleID[index+1:])
+	}
+	keys = append(keys, cweRegex.FindAllString(vulnerability.Details, -1)...)
+	for _, key := range keys {
+		if fix, ok := fixes[key]; ok && key != "" && strings.TrimSpace(fix.Guidance) != "" {
+			return &fix
+		}
+	}
+	return nil
+}
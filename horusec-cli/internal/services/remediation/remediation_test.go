@@ -0,0 +1,108 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+func newAnalysisWithVulnerability(vulnerability *horusec.Vulnerability) *horusec.Analysis {
+	return &horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+		{Vulnerability: *vulnerability},
+	}}
+}
+
+func writeRemediationFile(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "horusec-remediation")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	path := filepath.Join(dir, "remediation.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestSetRemediations(t *testing.T) {
+	t.Run("Should add the built-in fix of the rule to the details", func(t *testing.T) {
+		analysis := newAnalysisWithVulnerability(&horusec.Vulnerability{RuleID: "G401",
+			Details: "Use of weak cryptographic primitive"})
+
+		err := NewRemediation(cliConfig.NewConfig()).SetRemediations(analysis)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Use of weak cryptographic primitive\nRemediation: Replace md5 and sha1 by sha256 or better, "+
+			"for passwords use bcrypt, scrypt or argon2.\nExample:\nsum := sha256.Sum256(content)",
+			analysis.AnalysisVulnerabilities[0].Vulnerability.Details)
+	})
+	t.Run("Should use the fix of the remediation file before the built-in fix", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetRemediationPath(writeRemediationFile(t, `
+G401:
+  guidance: Use the hash package of the company.
+  example: |
+    sum := companyhash.Sum(content)
+`))
+		analysis := newAnalysisWithVulnerability(&horusec.Vulnerability{RuleID: "G401", Details: "weak"})
+
+		err := NewRemediation(config).SetRemediations(analysis)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "weak\nRemediation: Use the hash package of the company.\nExample:\nsum := companyhash.Sum(content)",
+			analysis.AnalysisVulnerabilities[0].Vulnerability.Details)
+	})
+	t.Run("Should return error when the remediation file is invalid", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetRemediationPath(writeRemediationFile(t, "G401: [invalid"))
+		analysis := newAnalysisWithVulnerability(&horusec.Vulnerability{RuleID: "G401", Details: "weak"})
+
+		err := NewRemediation(config).SetRemediations(analysis)
+
+		assert.Error(t, err)
+		assert.Equal(t, "weak", analysis.AnalysisVulnerabilities[0].Vulnerability.Details)
+	})
+}
+
+func TestFindFix(t *testing.T) {
+	fixes := map[string]Fix{
+		"eval-detected": {Guidance: "eval"},
+		"CWE-89":        {Guidance: "sql"},
+		"CWE-79":        {Guidance: "xss"},
+		"CWE-22":        {},
+	}
+
+	t.Run("Should find the fix by the last part of the rule id of semgrep", func(t *testing.T) {
+		fix := FindFix(fixes, &horusec.Vulnerability{RuleID: "javascript.browser.security.eval-detected.eval-detected"})
+
+		assert.Equal(t, "eval", fix.Guidance)
+	})
+	t.Run("Should find the fix by the first CWE of the details with fix", func(t *testing.T) {
+		fix := FindFix(fixes, &horusec.Vulnerability{RuleID: "unknown",
+			Details: "For more information checkout the CWE-22 and CWE-89 advisories. CWE-79"})
+
+		assert.Equal(t, "sql", fix.Guidance)
+	})
+	t.Run("Should return nil when there is no fix", func(t *testing.T) {
+		assert.Nil(t, FindFix(fixes, &horusec.Vulnerability{Details: "CWE-1"}))
+	})
+}
@@ -0,0 +1,170 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotecache
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/sigv4"
+)
+
+const (
+	defaultS3Region = "us-east-1"
+	// GCS accepts the signature version 4 with HMAC keys of a service account in its interoperability api
+	gcsEndpoint = "https://storage.googleapis.com"
+	gcsRegion   = "auto"
+)
+
+var (
+	ErrRemoteCacheMiss       = errors.New("object not found in the remote cache")
+	ErrInvalidRemoteCacheURL = errors.New("remote cache url must start with s3://, gs://, http:// or https://")
+)
+
+// Store keeps the cached objects in a storage shared by the CI runners. Only the analysis results are stored, the
+// digests of the images are read from the local docker after the pull, so they always match the images that ran
+type Store interface {
+	Get(key string) ([]byte, error)
+	Put(key string, content []byte) error
+}
+
+// httpStore reads and writes the analyses with GET and PUT requests, the S3 and GCS buckets use the same
+// requests signed with the signature version 4
+type httpStore struct {
+	httpClient client.Interface
+	baseURL    *url.URL
+	sign       func(req *http.Request, content []byte)
+}
+
+func NewStore(remoteCacheURL string, httpClient client.Interface) (Store, error) {
+	parsedURL, err := url.Parse(remoteCacheURL)
+	if err != nil || parsedURL.Host == "" {
+		return nil, ErrInvalidRemoteCacheURL
+	}
+	switch parsedURL.Scheme {
+	case "s3":
+		return newBucketStore(httpClient, parsedURL, getS3Endpoint(), getEnvOrDefault("AWS_REGION", defaultS3Region))
+	case "gs":
+		return newBucketStore(httpClient, parsedURL, gcsEndpoint, gcsRegion)
+	case "http", "https":
+		return newHTTPStore(httpClient, parsedURL), nil
+	}
+	return nil, ErrInvalidRemoteCacheURL
+}
+
+func newHTTPStore(httpClient client.Interface, parsedURL *url.URL) Store {
+	user := parsedURL.User
+	baseURL := *parsedURL
+	baseURL.User = nil
+	return &httpStore{
+		httpClient: httpClient,
+		baseURL:    &baseURL,
+		sign: func(req *http.Request, _ []byte) {
+			if user != nil {
+				password, _ := user.Password()
+				req.SetBasicAuth(user.Username(), password)
+			}
+		},
+	}
+}
+
+// newBucketStore uses the path style url, so buckets with dots in the name work with https
+func newBucketStore(httpClient client.Interface, parsedURL *url.URL, endpoint, region string) (Store, error) {
+	baseURL, err := url.Parse(fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(endpoint, "/"), parsedURL.Host,
+		strings.Trim(parsedURL.Path, "/")))
+	if err != nil {
+		return nil, ErrInvalidRemoteCacheURL
+	}
+	credentials := &sigv4.Credentials{
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	return &httpStore{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		sign: func(req *http.Request, content []byte) {
+			if credentials.AccessKeyID != "" {
+				sigv4.SignRequest(req, content, credentials, region, "s3", time.Now())
+			}
+		},
+	}, nil
+}
+
+func getS3Endpoint() string {
+	if endpoint := os.Getenv("AWS_ENDPOINT_URL"); endpoint != "" {
+		return endpoint
+	}
+	return fmt.Sprintf("https://s3.%s.amazonaws.com", getEnvOrDefault("AWS_REGION", defaultS3Region))
+}
+
+func getEnvOrDefault(name, defaultValue string) string {
+	if value := os.Getenv(name); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func (h *httpStore) Get(key string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, h.getObjectURL(key), nil)
+	if err != nil {
+		return nil, err
+	}
+	h.sign(req, nil)
+	response, err := h.httpClient.DoRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.CloseBody()
+	switch response.GetStatusCode() {
+	case http.StatusOK:
+		return response.GetBody()
+	case http.StatusNotFound:
+		return nil, ErrRemoteCacheMiss
+	}
+	return nil, fmt.Errorf("remote cache returned status code %d", response.GetStatusCode())
+}
+
+func (h *httpStore) Put(key string, content []byte) error {
+	req, err := http.NewRequest(http.MethodPut, h.getObjectURL(key), bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	h.sign(req, content)
+	response, err := h.httpClient.DoRequest(req, nil)
+	if err != nil {
+		return err
+	}
+	defer response.CloseBody()
+	if response.GetStatusCode() < http.StatusOK || response.GetStatusCode() >= http.StatusMultipleChoices {
+		body, _ := response.GetBody()
+		return fmt.Errorf("remote cache returned status code %d: %s", response.GetStatusCode(), body)
+	}
+	return nil
+}
+
+func (h *httpStore) getObjectURL(key string) string {
+	objectURL := *h.baseURL
+	objectURL.Path = strings.TrimSuffix(objectURL.Path, "/") + "/" + key
+	return objectURL.String()
+}
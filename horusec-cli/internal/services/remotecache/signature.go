@@ -0,0 +1,125 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotecache
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+var (
+	ErrInvalidSignature  = errors.New("signature of the object of the remote cache is not valid")
+	ErrInvalidPublicKey  = errors.New("public key of the remote cache is not an ed25519 public key in base64")
+	ErrInvalidPrivateKey = errors.New("private key of the remote cache is not the ed25519 private key in base64 " +
+		"of the public key")
+	ErrReadOnly = errors.New("remote cache is read only")
+)
+
+// signedStore writes the signature in the first line of each object, the signature has the key of the object, so
+// an object signed can't be copied to other key, like the analysis of other commit
+type signedStore struct {
+	store      Store
+	publicKey  ed25519.PublicKey
+	privateKey ed25519.PrivateKey
+}
+
+// NewStoreFromConfig returns nil without the remote cache url. The objects read are verified with the public key and
+// only the read-write mode signs the objects written, so a runner with write access to the storage but without the
+// private key can't change the results used by the others
+func NewStoreFromConfig(config cliConfig.IConfig) (Store, error) {
+	if config.GetRemoteCacheURL() == "" {
+		return nil, nil
+	}
+	publicKey, err := ParsePublicKey(config.GetRemoteCachePublicKey())
+	if err != nil {
+		return nil, err
+	}
+	store, err := NewStore(config.GetRemoteCacheURL(), client.NewHTTPClient(int(config.GetTimeoutInSecondsRequest())))
+	if err != nil {
+		return nil, err
+	}
+	signed := &signedStore{store: store, publicKey: publicKey}
+	if config.GetRemoteCacheMode() == cli.RemoteCacheReadWrite.ToString() {
+		if signed.privateKey, err = ParsePrivateKey(config.GetRemoteCachePrivateKey(), publicKey); err != nil {
+			return nil, err
+		}
+	}
+	return signed, nil
+}
+
+func ParsePublicKey(value string) (ed25519.PublicKey, error) {
+	publicKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return nil, ErrInvalidPublicKey
+	}
+	return publicKey, nil
+}
+
+// ParsePrivateKey accepts the private key or its seed, like the 32 bytes of the keys generated by openssl
+func ParsePrivateKey(value string, publicKey ed25519.PublicKey) (ed25519.PrivateKey, error) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	var privateKey ed25519.PrivateKey
+	switch len(decoded) {
+	case ed25519.SeedSize:
+		privateKey = ed25519.NewKeyFromSeed(decoded)
+	case ed25519.PrivateKeySize:
+		privateKey = decoded
+	default:
+		return nil, ErrInvalidPrivateKey
+	}
+	if !bytes.Equal(privateKey.Public().(ed25519.PublicKey), publicKey) {
+		return nil, ErrInvalidPrivateKey
+	}
+	return privateKey, nil
+}
+
+func (s *signedStore) Get(key string) ([]byte, error) {
+	object, err := s.store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	index := bytes.IndexByte(object, '\n')
+	if index < 0 {
+		return nil, ErrInvalidSignature
+	}
+	signature, err := base64.StdEncoding.DecodeString(string(object[:index]))
+	content := object[index+1:]
+	if err != nil || !ed25519.Verify(s.publicKey, s.getMessage(key, content), signature) {
+		return nil, ErrInvalidSignature
+	}
+	return content, nil
+}
+
+func (s *signedStore) Put(key string, content []byte) error {
+	if s.privateKey == nil {
+		return ErrReadOnly
+	}
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(s.privateKey, s.getMessage(key, content)))
+	return s.store.Put(key, append([]byte(signature+"\n"), content...))
+}
+
+func (s *signedStore) getMessage(key string, content []byte) []byte {
+	return append([]byte(key+"\n"), content...)
+}
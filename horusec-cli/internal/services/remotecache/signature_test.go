@@ -0,0 +1,117 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remotecache
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSignedConfig(t *testing.T, url string) *cliConfig.Config {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	config := &cliConfig.Config{}
+	config.SetRemoteCacheURL(url)
+	config.SetRemoteCacheMode(cli.RemoteCacheReadWrite.ToString())
+	config.SetRemoteCachePublicKey(base64.StdEncoding.EncodeToString(publicKey))
+	config.SetRemoteCachePrivateKey(base64.StdEncoding.EncodeToString(privateKey))
+	return config
+}
+
+func TestNewStoreFromConfig(t *testing.T) {
+	t.Run("Should sign the objects written and verify the objects read", func(t *testing.T) {
+		var requests []*http.Request
+		server := newFakeStorage(t, &requests)
+		defer server.Close()
+		store, err := NewStoreFromConfig(newSignedConfig(t, server.URL))
+		assert.NoError(t, err)
+
+		assert.NoError(t, store.Put("key", []byte("{}")))
+		content, err := store.Get("key")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "{}", string(content))
+	})
+
+	t.Run("Should not read the objects changed or copied to other key", func(t *testing.T) {
+		var requests []*http.Request
+		server := newFakeStorage(t, &requests)
+		defer server.Close()
+		config := newSignedConfig(t, server.URL)
+		signed, err := NewStoreFromConfig(config)
+		assert.NoError(t, err)
+		unsigned, err := NewStore(server.URL, signed.(*signedStore).store.(*httpStore).httpClient)
+		assert.NoError(t, err)
+
+		assert.NoError(t, signed.Put("key", []byte("{}")))
+		object, err := unsigned.Get("key")
+		assert.NoError(t, err)
+		assert.NoError(t, unsigned.Put("other", object))
+		assert.NoError(t, unsigned.Put("changed", append(object, ' ')))
+		assert.NoError(t, unsigned.Put("unsigned", []byte("{}")))
+
+		for _, key := range []string{"other", "changed", "unsigned"} {
+			_, err = signed.Get(key)
+			assert.Equal(t, ErrInvalidSignature, err, key)
+		}
+	})
+
+	t.Run("Should not write in the read only mode", func(t *testing.T) {
+		config := newSignedConfig(t, "https://cache.example.com")
+		config.SetRemoteCacheMode(cli.RemoteCacheReadOnly.ToString())
+		config.SetRemoteCachePrivateKey("")
+		store, err := NewStoreFromConfig(config)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ErrReadOnly, store.Put("key", []byte("{}")))
+	})
+
+	t.Run("Should return error when the keys are not valid", func(t *testing.T) {
+		config := newSignedConfig(t, "https://cache.example.com")
+		other := newSignedConfig(t, "https://cache.example.com")
+		config.SetRemoteCachePrivateKey(other.GetRemoteCachePrivateKey())
+		_, err := NewStoreFromConfig(config)
+		assert.Equal(t, ErrInvalidPrivateKey, err)
+
+		config.SetRemoteCachePublicKey("invalid")
+		_, err = NewStoreFromConfig(config)
+		assert.Equal(t, ErrInvalidPublicKey, err)
+	})
+
+	t.Run("Should return nil without the remote cache url", func(t *testing.T) {
+		store, err := NewStoreFromConfig(&cliConfig.Config{})
+		assert.NoError(t, err)
+		assert.Nil(t, store)
+	})
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	t.Run("Should accept the private key or its seed", func(t *testing.T) {
+		publicKey, privateKey, err := ed25519.GenerateKey(nil)
+		assert.NoError(t, err)
+
+		fromSeed, err := ParsePrivateKey(base64.StdEncoding.EncodeToString(privateKey.Seed()), publicKey)
+		assert.NoError(t, err)
+		assert.Equal(t, privateKey, fromSeed)
+		_, err = ParsePrivateKey(base64.StdEncoding.EncodeToString(privateKey), publicKey)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,185 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package risk
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/testcode"
+)
+
+// VerifiedSecret is the key of the weight added to the leaked credentials verified as active
+const VerifiedSecret = "VERIFIED-SECRET"
+
+var ErrGradeBelowMinimum = errors.New("{HORUSEC_CLI} analysis finished with the grade of the risk score " +
+	"worse than the minimum grade")
+
+var (
+	cweRegex    = regexp.MustCompile(`CWE-\d+`)
+	cweKeyRegex = regexp.MustCompile(`^CWE-\d+$`)
+)
+
+// Grades are sorted from the best to the worst, each one with the max score accepted
+var Grades = []Grade{
+	{Name: "A", MaxScore: 0},
+	{Name: "B", MaxScore: 10},
+	{Name: "C", MaxScore: 30},
+	{Name: "D", MaxScore: 60},
+	{Name: "E", MaxScore: 100},
+	{Name: "F", MaxScore: -1},
+}
+
+type Grade struct {
+	Name     string
+	MaxScore int
+}
+
+type Interface interface {
+	Calculate(analysis *horusec.Analysis) *horusec.RiskScore
+	IsBelowMinGrade(riskScore *horusec.RiskScore) bool
+}
+
+type Risk struct {
+	config cliConfig.IConfig
+}
+
+func NewRisk(config cliConfig.IConfig) Interface {
+	return &Risk{config: config}
+}
+
+func DefaultWeights() map[string]int {
+	return map[string]int{
+		severity.Critical.ToString(): 20,
+		severity.High.ToString():     10,
+		severity.Medium.ToString():   5,
+		severity.Low.ToString():      1,
+		VerifiedSecret:               20,
+	}
+}
+
+// Calculate sums the weights of the vulnerabilities not accepted and not ignored by severity: the weight of
+// the severity, of each CWE found in the details and of the verified secret
+func (r *Risk) Calculate(analysis *horusec.Analysis) *horusec.RiskScore {
+	weights := r.getWeights()
+	score := 0
+	for index := range analysis.AnalysisVulnerabilities {
+		vuln := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		if r.isToSkip(vuln) {
+			continue
+		}
+		score += r.getVulnerabilityWeight(vuln, weights)
+	}
+
+	return &horusec.RiskScore{Score: score, Grade: GetGrade(score)}
+}
+
+func (r *Risk) IsBelowMinGrade(riskScore *horusec.RiskScore) bool {
+	if r.config.GetMinGrade() == "" || riskScore == nil {
+		return false
+	}
+
+	return gradeIndex(riskScore.Grade) > gradeIndex(r.config.GetMinGrade())
+}
+
+func (r *Risk) getVulnerabilityWeight(vuln *horusec.Vulnerability, weights map[string]int) int {
+	weight := weights[vuln.Severity.ToString()]
+	for _, cwe := range unique(cweRegex.FindAllString(vuln.Details, -1)) {
+		weight += weights[cwe]
+	}
+	if vuln.VerificationStatus == enumHorusec.VerifiedActive {
+		weight += weights[VerifiedSecret]
+	}
+
+	return weight
+}
+
+func (r *Risk) isToSkip(vuln *horusec.Vulnerability) bool {
+	if (vuln.Type != enumHorusec.Vulnerability && vuln.Type != "") || testcode.IsExcludedFromGates(r.config, vuln) {
+		return true
+	}
+	failThreshold := r.config.GetFailThreshold()
+	if failThreshold != "" && vuln.Severity.IsBelow(severity.Severity(strings.ToUpper(failThreshold))) {
+		return true
+	}
+	for _, severityToIgnore := range r.config.GetSeveritiesToIgnore() {
+		if strings.EqualFold(strings.TrimSpace(severityToIgnore), vuln.Severity.ToString()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getWeights uses the keys in upper case, because the keys of the configuration file are read in lower case
+func (r *Risk) getWeights() map[string]int {
+	weights := DefaultWeights()
+	for key, value := range r.config.GetRiskWeights() {
+		if weight, err := strconv.Atoi(strings.TrimSpace(value)); err == nil {
+			weights[strings.ToUpper(strings.TrimSpace(key))] = weight
+		}
+	}
+
+	return weights
+}
+
+func GetGrade(score int) string {
+	for _, grade := range Grades {
+		if grade.MaxScore < 0 || score <= grade.MaxScore {
+			return grade.Name
+		}
+	}
+
+	return Grades[len(Grades)-1].Name
+}
+
+func IsValidGrade(grade string) bool {
+	return gradeIndex(grade) >= 0
+}
+
+// IsValidWeightKey accepts the severities, the CWEs and the verified secret
+func IsValidWeightKey(key string) bool {
+	key = strings.ToUpper(strings.TrimSpace(key))
+	_, isSeverity := severity.Map()[key]
+	return isSeverity || key == VerifiedSecret || cweKeyRegex.MatchString(key)
+}
+
+func gradeIndex(name string) int {
+	for index, grade := range Grades {
+		if strings.EqualFold(grade.Name, strings.TrimSpace(name)) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+func unique(values []string) (result []string) {
+	seen := map[string]bool{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,117 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package risk
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysisToTest() *horusec.Analysis {
+	return &horusec.Analysis{
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{Severity: severity.High, Type: enumHorusec.Vulnerability,
+				Details: "SQL Injection CWE-89"}},
+			{Vulnerability: horusec.Vulnerability{Severity: severity.Critical, Type: enumHorusec.Vulnerability,
+				VerificationStatus: enumHorusec.VerifiedActive}},
+			{Vulnerability: horusec.Vulnerability{Severity: severity.Low, Type: enumHorusec.Vulnerability}},
+			{Vulnerability: horusec.Vulnerability{Severity: severity.High, Type: enumHorusec.FalsePositive}},
+		},
+	}
+}
+
+func TestCalculate(t *testing.T) {
+	t.Run("Should calculate the risk score with the default weights", func(t *testing.T) {
+		riskScore := NewRisk(&cliConfig.Config{}).Calculate(newAnalysisToTest())
+		assert.Equal(t, 51, riskScore.Score)
+		assert.Equal(t, "D", riskScore.Grade)
+	})
+
+	t.Run("Should calculate the risk score with the weights of the config", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetRiskWeights(map[string]string{"high": "0", "CWE-89": "5", "verified-secret": "0"})
+		config.SetSeveritiesToIgnore([]string{"LOW"})
+
+		riskScore := NewRisk(config).Calculate(newAnalysisToTest())
+		assert.Equal(t, 25, riskScore.Score)
+		assert.Equal(t, "C", riskScore.Grade)
+	})
+
+	t.Run("Should skip the test code when it is excluded from gates", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetTestCodeMode(cli.TestCodeExcludeFromGates.ToString())
+		analysis := newAnalysisToTest()
+		analysis.AnalysisVulnerabilities[1].Vulnerability.IsTestCode = true
+
+		riskScore := NewRisk(config).Calculate(analysis)
+		assert.Equal(t, 11, riskScore.Score)
+		assert.Equal(t, "C", riskScore.Grade)
+	})
+
+	t.Run("Should skip the vulnerabilities below the fail threshold", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetFailThreshold("CRITICAL")
+
+		riskScore := NewRisk(config).Calculate(newAnalysisToTest())
+		assert.Equal(t, 40, riskScore.Score)
+	})
+
+	t.Run("Should return grade A without vulnerabilities", func(t *testing.T) {
+		riskScore := NewRisk(&cliConfig.Config{}).Calculate(&horusec.Analysis{})
+		assert.Equal(t, 0, riskScore.Score)
+		assert.Equal(t, "A", riskScore.Grade)
+	})
+}
+
+func TestIsBelowMinGrade(t *testing.T) {
+	t.Run("Should return true when the grade is worse than the min grade", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetMinGrade("b")
+
+		assert.True(t, NewRisk(config).IsBelowMinGrade(&horusec.RiskScore{Grade: "C"}))
+		assert.False(t, NewRisk(config).IsBelowMinGrade(&horusec.RiskScore{Grade: "B"}))
+		assert.False(t, NewRisk(config).IsBelowMinGrade(&horusec.RiskScore{Grade: "A"}))
+	})
+
+	t.Run("Should return false without min grade", func(t *testing.T) {
+		assert.False(t, NewRisk(&cliConfig.Config{}).IsBelowMinGrade(&horusec.RiskScore{Grade: "F"}))
+	})
+}
+
+func TestGetGrade(t *testing.T) {
+	t.Run("Should return the grade of the score", func(t *testing.T) {
+		assert.Equal(t, "A", GetGrade(0))
+		assert.Equal(t, "B", GetGrade(10))
+		assert.Equal(t, "C", GetGrade(11))
+		assert.Equal(t, "E", GetGrade(100))
+		assert.Equal(t, "F", GetGrade(101))
+	})
+}
+
+func TestIsValidWeightKey(t *testing.T) {
+	t.Run("Should accept severities, CWEs and verified secret", func(t *testing.T) {
+		assert.True(t, IsValidWeightKey("high"))
+		assert.True(t, IsValidWeightKey("CWE-79"))
+		assert.True(t, IsValidWeightKey("verified-secret"))
+		assert.False(t, IsValidWeightKey("CWE-"))
+		assert.False(t, IsValidWeightKey("test"))
+	})
+}
@@ -0,0 +1,207 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rulepacks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rulepack"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/structural"
+)
+
+// The annotation of the fixtures with the rules expected in the line of the code before it, or in the next line
+// when the annotation is alone in its line, like // expect: HS-CUSTOM-1, HS-CUSTOM-2
+var expectRegex = regexp.MustCompile(`(//|#|/\*|<!--)\s*expect:\s*([\w\-]+(\s*,\s*[\w\-]+)*)`)
+
+var ErrRulesNotFound = errors.New("{HORUSEC_CLI} no rule pack found in the directory of the rules test")
+
+// RuleResult is the result of the rule in the fixtures, with the locations as file:line
+type RuleResult struct {
+	ID         string
+	Expected   []string
+	Missing    []string
+	Unexpected []string
+}
+
+type harness struct {
+	directory string
+	ids       []string
+	rules     []engine.Rule
+	files     []text.TextFile
+	expected  map[string]map[string]bool
+}
+
+func (r *RuleResult) Passed() bool {
+	return len(r.Missing) == 0 && len(r.Unexpected) == 0
+}
+
+// Test runs the rules of the packs of the directory against the other files of the directory, the fixtures,
+// comparing the findings with the annotations of the fixtures
+func (r *RulePacks) Test(directory string) ([]RuleResult, error) {
+	h := &harness{directory: directory, expected: map[string]map[string]bool{}}
+	if err := filepath.Walk(directory, h.walk); err != nil {
+		return nil, err
+	}
+
+	if len(h.rules) == 0 {
+		return nil, ErrRulesNotFound
+	}
+
+	return h.getResults(engine.Run(structural.NewUnits(text.TextUnit{Files: h.files}), h.rules)), nil
+}
+
+func (h *harness) walk(path string, info os.FileInfo, err error) error {
+	if err != nil || info.IsDir() {
+		return err
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if isPack, err := h.addPack(path, content); isPack || err != nil {
+		return err
+	}
+
+	return h.addFixture(path, content)
+}
+
+// addPack adds the rules of the json files that are rule packs, the other json files are fixtures
+func (h *harness) addPack(path string, content []byte) (bool, error) {
+	pack := &rulepack.Pack{}
+	if filepath.Ext(path) != ".json" || json.Unmarshal(content, pack) != nil || pack.Name == "" ||
+		len(pack.Rules) == 0 {
+		return false, nil
+	}
+
+	rules, err := pack.GetEngineRules()
+	if err != nil {
+		return true, err
+	}
+
+	for index := range pack.Rules {
+		h.ids = append(h.ids, pack.Rules[index].ID)
+	}
+
+	h.rules = append(h.rules, rules...)
+	return true, nil
+}
+
+func (h *harness) addFixture(path string, content []byte) error {
+	relativePath, err := filepath.Rel(h.directory, path)
+	if err != nil {
+		return err
+	}
+
+	file, err := text.NewTextFile(relativePath, content)
+	if err != nil {
+		return err
+	}
+
+	h.files = append(h.files, file)
+	for index, line := range strings.Split(string(content), "\n") {
+		h.addExpected(relativePath, index+1, line)
+	}
+
+	return nil
+}
+
+func (h *harness) addExpected(path string, number int, line string) {
+	found := expectRegex.FindStringSubmatchIndex(line)
+	if found == nil {
+		return
+	}
+
+	if strings.TrimSpace(line[:found[0]]) == "" {
+		number++
+	}
+
+	for _, id := range strings.Split(line[found[4]:found[5]], ",") {
+		id = strings.TrimSpace(id)
+		if h.expected[id] == nil {
+			h.expected[id] = map[string]bool{}
+		}
+
+		h.expected[id][getLocation(path, number)] = true
+	}
+}
+
+func (h *harness) getResults(findings []engine.Finding) (results []RuleResult) {
+	found := map[string]map[string]bool{}
+	for index := range findings {
+		id := findings[index].ID
+		if found[id] == nil {
+			found[id] = map[string]bool{}
+		}
+
+		found[id][getLocation(findings[index].SourceLocation.Filename, findings[index].SourceLocation.Line)] = true
+	}
+
+	for _, id := range h.getIDs() {
+		results = append(results, RuleResult{ID: id, Expected: getSortedKeys(h.expected[id]),
+			Missing: getDifference(h.expected[id], found[id]), Unexpected: getDifference(found[id], h.expected[id])})
+	}
+
+	return results
+}
+
+// getIDs returns the ids of the rules, and the ids of the annotations without rules sorted in the end
+func (h *harness) getIDs() []string {
+	ids := append([]string{}, h.ids...)
+	known := map[string]bool{}
+	for _, id := range h.ids {
+		known[id] = true
+	}
+
+	var unknown []string
+	for id := range h.expected {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+
+	sort.Strings(unknown)
+	return append(ids, unknown...)
+}
+
+func getLocation(path string, line int) string {
+	return fmt.Sprintf("%s:%d", filepath.ToSlash(path), line)
+}
+
+func getDifference(values, other map[string]bool) (difference []string) {
+	for value := range values {
+		if !other[value] {
+			difference = append(difference, value)
+		}
+	}
+
+	sort.Strings(difference)
+	return difference
+}
+
+func getSortedKeys(values map[string]bool) []string {
+	return getDifference(values, nil)
+}
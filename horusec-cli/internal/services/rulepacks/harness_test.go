@@ -0,0 +1,110 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rulepacks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rulepack"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+const fixtureToTest = `const a = 1;
+// expect: HS-CUSTOM-1
+eval(input);
+eval("1 + 1"); // expect: HS-CUSTOM-1
+document.write(input);
+exec(input); # expect: HS-CUSTOM-3
+`
+
+func writeHarnessToTest(t *testing.T, directory string) {
+	pack := &rulepack.Pack{Name: "custom", Version: "1.0", Rules: []rulepack.Rule{
+		{ID: "HS-CUSTOM-1", Type: rulepack.TypeStructural, Patterns: []string{"eval($X)"},
+			PatternsNot: []string{`eval("...")`}},
+		{ID: "HS-CUSTOM-2", Type: rulepack.TypeRegular, Expressions: []string{`document\.write\(`}},
+	}}
+	content, err := json.Marshal(pack)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, "custom@1.0.json"), content, 0600))
+	assert.NoError(t, os.MkdirAll(filepath.Join(directory, "fixtures"), 0750))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, "fixtures", "main.js"), []byte(fixtureToTest), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(directory, "fixtures", "package.json"), []byte(`{}`), 0600))
+}
+
+func TestRulePacks_Test(t *testing.T) {
+	t.Run("should compare the findings with the annotations of the fixtures", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "rules-test")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		writeHarnessToTest(t, dir)
+
+		results, err := NewRulePacks(config.NewConfig()).Test(dir)
+		assert.NoError(t, err)
+		assert.Len(t, results, 3)
+
+		assert.Equal(t, "HS-CUSTOM-1", results[0].ID)
+		assert.Equal(t, []string{"fixtures/main.js:3", "fixtures/main.js:4"}, results[0].Expected)
+		assert.Equal(t, []string{"fixtures/main.js:4"}, results[0].Missing)
+		assert.Empty(t, results[0].Unexpected)
+		assert.False(t, results[0].Passed())
+
+		assert.Equal(t, "HS-CUSTOM-2", results[1].ID)
+		assert.Equal(t, []string{"fixtures/main.js:5"}, results[1].Unexpected)
+		assert.False(t, results[1].Passed())
+
+		assert.Equal(t, "HS-CUSTOM-3", results[2].ID)
+		assert.Equal(t, []string{"fixtures/main.js:6"}, results[2].Missing)
+	})
+
+	t.Run("should pass when the findings are the annotations", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "rules-test")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		writeHarnessToTest(t, dir)
+		fixture := "// expect: HS-CUSTOM-1\neval(input);\ndocument.write(input); // expect: HS-CUSTOM-2\n"
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "fixtures", "main.js"), []byte(fixture), 0600))
+
+		results, err := NewRulePacks(config.NewConfig()).Test(dir)
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		assert.True(t, results[0].Passed())
+		assert.True(t, results[1].Passed())
+	})
+
+	t.Run("should return error without rule packs", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "rules-test")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		_, err = NewRulePacks(config.NewConfig()).Test(dir)
+		assert.Equal(t, ErrRulesNotFound, err)
+	})
+
+	t.Run("should return error when the rule pack is invalid", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "rules-test")
+		assert.NoError(t, err)
+		defer os.RemoveAll(dir)
+		content := `{"name": "custom", "version": "1.0", "rules": [{"id": "HS-CUSTOM-1", "expressions": ["("]}]}`
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "custom@1.0.json"), []byte(content), 0600))
+
+		_, err = NewRulePacks(config.NewConfig()).Test(dir)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,216 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rulepacks
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rulepack"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+// Suffix of the url of the signature of the index, that is the ed25519 signature of the index in base64
+const signatureSuffix = ".sig"
+
+var (
+	ErrIndexURLNotInformed = errors.New("{HORUSEC_CLI} the url of the index of the rule packs is not informed, " +
+		"use the flag index-url")
+	ErrInvalidPublicKey = errors.New("{HORUSEC_CLI} the public key of the index of the rule packs must be an " +
+		"ed25519 public key in base64, use the flag public-key")
+	ErrInvalidSignature = errors.New("{HORUSEC_CLI} the signature of the index of the rule packs is invalid")
+	ErrInvalidChecksum  = errors.New("{HORUSEC_CLI} the sha256 of the rule pack is different of the index")
+	ErrInvalidPack      = errors.New("{HORUSEC_CLI} the rule pack is different of the index")
+)
+
+// IndexPack is the pack available in the remote index, its url can be relative to the url of the index
+type IndexPack struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Engine  string `json:"engine"`
+	URL     string `json:"url"`
+	SHA256  string `json:"sha256"`
+}
+
+type Index struct {
+	Packs []IndexPack `json:"packs"`
+}
+
+type Interface interface {
+	Update() (downloaded []string, err error)
+	Test(directory string) ([]RuleResult, error)
+}
+
+type RulePacks struct {
+	config     cliConfig.IConfig
+	httpClient client.Interface
+}
+
+func NewRulePacks(config cliConfig.IConfig) Interface {
+	return &RulePacks{
+		config:     config,
+		httpClient: client.NewHTTPClient(int(config.GetTimeoutInSecondsRequest())),
+	}
+}
+
+// Update downloads the packs of the index that aren't in the rule packs dir, the index is only used when its
+// signature is valid and each pack only when its sha256 is the same of the index
+func (r *RulePacks) Update() (downloaded []string, err error) {
+	index, err := r.getIndex()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(r.config.GetRulePacksDir(), os.ModePerm); err != nil {
+		return nil, err
+	}
+	for _, indexPack := range index.Packs {
+		reference := rulepack.Reference{Name: indexPack.Name, Version: indexPack.Version}
+		if IsDownloaded(r.config, reference) {
+			continue
+		}
+		if err := r.downloadPack(reference, indexPack); err != nil {
+			return downloaded, fmt.Errorf("%s: %w", reference.String(), err)
+		}
+		downloaded = append(downloaded, reference.String())
+	}
+	return downloaded, nil
+}
+
+func (r *RulePacks) getIndex() (*Index, error) {
+	publicKey, err := r.getPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	content, err := r.get(r.config.GetRulePacksIndexURL())
+	if err != nil {
+		return nil, err
+	}
+	signature, err := r.get(r.config.GetRulePacksIndexURL() + signatureSuffix)
+	if err != nil {
+		return nil, err
+	}
+	if !r.isValidSignature(publicKey, content, signature) {
+		return nil, ErrInvalidSignature
+	}
+	index := &Index{}
+	return index, json.Unmarshal(content, index)
+}
+
+func (r *RulePacks) getPublicKey() (ed25519.PublicKey, error) {
+	if r.config.GetRulePacksIndexURL() == "" {
+		return nil, ErrIndexURLNotInformed
+	}
+	publicKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(r.config.GetRulePacksPublicKey()))
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return nil, ErrInvalidPublicKey
+	}
+	return publicKey, nil
+}
+
+func (r *RulePacks) isValidSignature(publicKey ed25519.PublicKey, content, signature []byte) bool {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	return err == nil && ed25519.Verify(publicKey, content, decoded)
+}
+
+func (r *RulePacks) downloadPack(reference rulepack.Reference, indexPack IndexPack) error {
+	content, err := r.get(r.getPackURL(indexPack.URL))
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(content)
+	if !strings.EqualFold(hex.EncodeToString(sum[:]), indexPack.SHA256) {
+		return ErrInvalidChecksum
+	}
+	pack := &rulepack.Pack{}
+	if err := json.Unmarshal(content, pack); err != nil {
+		return err
+	}
+	if pack.Name != indexPack.Name || pack.Version != indexPack.Version || pack.Engine != indexPack.Engine {
+		return ErrInvalidPack
+	}
+	return r.writePack(reference, content)
+}
+
+// writePack renames a temporary file, so an interrupted download is never read by the engines
+func (r *RulePacks) writePack(reference rulepack.Reference, content []byte) error {
+	path := filepath.Join(r.config.GetRulePacksDir(), reference.GetFileName())
+	if err := ioutil.WriteFile(path+".tmp", content, 0600); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+func (r *RulePacks) getPackURL(packURL string) string {
+	if strings.HasPrefix(packURL, "http://") || strings.HasPrefix(packURL, "https://") {
+		return packURL
+	}
+	indexURL := r.config.GetRulePacksIndexURL()
+	return indexURL[:strings.LastIndex(indexURL, "/")+1] + strings.TrimPrefix(packURL, "/")
+}
+
+func (r *RulePacks) get(url string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := r.httpClient.DoRequest(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.CloseBody()
+	if response.GetStatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from %s", response.GetStatusCode(), url)
+	}
+	return response.GetBody()
+}
+
+// IsDownloaded returns if the pack is in the rule packs dir
+func IsDownloaded(config cliConfig.IConfig, reference rulepack.Reference) bool {
+	_, err := os.Stat(filepath.Join(config.GetRulePacksDir(), reference.GetFileName()))
+	return err == nil
+}
+
+// GetToolRulePacks returns the packs selected to the engine of the tool, the packs not downloaded are skipped
+func GetToolRulePacks(config cliConfig.IConfig, tool tools.Tool) (references []string) {
+	for _, value := range config.GetRulePacks() {
+		reference, err := rulepack.ParseReference(value)
+		if err != nil {
+			continue
+		}
+		if reference.IsBuiltin() {
+			if rulepack.GetBuiltinPacks()[tool] == reference.Name {
+				references = append(references, reference.String())
+			}
+			continue
+		}
+		if pack, err := rulepack.ReadPack(config.GetRulePacksDir(), reference); err == nil &&
+			pack.Engine == tool.ToString() {
+			references = append(references, reference.String())
+		}
+	}
+	return references
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rulepacks
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rulepack"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+type indexServer struct {
+	*httptest.Server
+	publicKey string
+	files     map[string][]byte
+}
+
+func newIndexServer(t *testing.T, packs ...*rulepack.Pack) *indexServer {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+	server := &indexServer{publicKey: base64.StdEncoding.EncodeToString(publicKey), files: map[string][]byte{}}
+	index := &Index{}
+	for _, pack := range packs {
+		content, _ := json.Marshal(pack)
+		sum := sha256.Sum256(content)
+		url := pack.Name + "@" + pack.Version + ".json"
+		server.files["/packs/"+url] = content
+		index.Packs = append(index.Packs, IndexPack{Name: pack.Name, Version: pack.Version, Engine: pack.Engine,
+			URL: url, SHA256: hex.EncodeToString(sum[:])})
+	}
+	content, _ := json.Marshal(index)
+	server.files["/packs/index.json"] = content
+	server.files["/packs/index.json.sig"] = []byte(base64.StdEncoding.EncodeToString(
+		ed25519.Sign(privateKey, content)))
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if content, ok := server.files[r.URL.Path]; ok {
+			_, _ = w.Write(content)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	return server
+}
+
+func newConfigToTest(t *testing.T, server *indexServer) cliConfig.IConfig {
+	rulePacksDir, err := ioutil.TempDir("", "horusec-rule-packs")
+	assert.NoError(t, err)
+	config := cliConfig.NewConfig()
+	config.SetRulePacksDir(rulePacksDir)
+	config.SetRulePacksIndexURL(server.URL + "/packs/index.json")
+	config.SetRulePacksPublicKey(server.publicKey)
+	return config
+}
+
+func newPackToTest(name, version string, tool tools.Tool) *rulepack.Pack {
+	return &rulepack.Pack{Name: name, Version: version, Engine: tool.ToString(),
+		Rules: []rulepack.Rule{{ID: "HS-1", Expressions: []string{"password"}}}}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("should download the packs not downloaded", func(t *testing.T) {
+		server := newIndexServer(t, newPackToTest("java-core", "1.4", tools.HorusecJava),
+			newPackToTest("leaks", "2.0", tools.HorusecLeaks))
+		defer server.Close()
+		config := newConfigToTest(t, server)
+		defer os.RemoveAll(config.GetRulePacksDir())
+
+		downloaded, err := NewRulePacks(config).Update()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"java-core@1.4", "leaks@2.0"}, downloaded)
+		assert.FileExists(t, filepath.Join(config.GetRulePacksDir(), "java-core@1.4.json"))
+
+		downloaded, err = NewRulePacks(config).Update()
+		assert.NoError(t, err)
+		assert.Empty(t, downloaded)
+	})
+
+	t.Run("should return error when the signature of the index is invalid", func(t *testing.T) {
+		server := newIndexServer(t, newPackToTest("java-core", "1.4", tools.HorusecJava))
+		defer server.Close()
+		server.files["/packs/index.json"] = []byte(`{"packs":[]}`)
+		config := newConfigToTest(t, server)
+		defer os.RemoveAll(config.GetRulePacksDir())
+
+		_, err := NewRulePacks(config).Update()
+		assert.Equal(t, ErrInvalidSignature, err)
+	})
+
+	t.Run("should return error when the pack is different of the index", func(t *testing.T) {
+		server := newIndexServer(t, newPackToTest("java-core", "1.4", tools.HorusecJava))
+		defer server.Close()
+		server.files["/packs/java-core@1.4.json"] = []byte(`{"name":"java-core"}`)
+		config := newConfigToTest(t, server)
+		defer os.RemoveAll(config.GetRulePacksDir())
+
+		_, err := NewRulePacks(config).Update()
+		assert.True(t, errors.Is(err, ErrInvalidChecksum))
+		assert.False(t, IsDownloaded(config, rulepack.Reference{Name: "java-core", Version: "1.4"}))
+	})
+
+	t.Run("should return error without index url or public key", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		_, err := NewRulePacks(config).Update()
+		assert.Equal(t, ErrIndexURLNotInformed, err)
+
+		config.SetRulePacksIndexURL("http://localhost/index.json")
+		config.SetRulePacksPublicKey("key")
+		_, err = NewRulePacks(config).Update()
+		assert.Equal(t, ErrInvalidPublicKey, err)
+	})
+}
+
+func TestGetToolRulePacks(t *testing.T) {
+	t.Run("should return the packs of the engine of the tool", func(t *testing.T) {
+		server := newIndexServer(t, newPackToTest("java-extra", "1.0", tools.HorusecJava))
+		defer server.Close()
+		config := newConfigToTest(t, server)
+		defer os.RemoveAll(config.GetRulePacksDir())
+		_, err := NewRulePacks(config).Update()
+		assert.NoError(t, err)
+		config.SetRulePacks([]string{"java-core", "java-extra@1.0", "leaks", "missing@1.0"})
+
+		assert.Equal(t, []string{"java-core@1.0", "java-extra@1.0"}, GetToolRulePacks(config, tools.HorusecJava))
+		assert.Equal(t, []string{"leaks@1.0"}, GetToolRulePacks(config, tools.HorusecLeaks))
+		assert.Empty(t, GetToolRulePacks(config, tools.GoSec))
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidCron = errors.New("{HORUSEC_CLI} invalid cron expression, " +
+	"expected the five fields \"minute hour day-of-month month day-of-week\"")
+
+var cronDescriptors = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+	"@yearly":  "0 0 1 1 *",
+}
+
+type cronBounds struct {
+	min int
+	max int
+}
+
+var cronFieldsBounds = []cronBounds{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+
+// Cron is a cron expression with the five standard fields, the day of week 7 is sunday as the 0
+type Cron struct {
+	minutes          []bool
+	hours            []bool
+	daysOfMonth      []bool
+	months           []bool
+	daysOfWeek       []bool
+	anyDayOfMonth    bool
+	anyDayOfWeek     bool
+	originExpression string
+}
+
+func ParseCron(expression string) (*Cron, error) {
+	if descriptor, ok := cronDescriptors[strings.TrimSpace(expression)]; ok {
+		expression = descriptor
+	}
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFieldsBounds) {
+		return nil, ErrInvalidCron
+	}
+	values := make([][]bool, len(fields))
+	for index, field := range fields {
+		parsed, err := parseCronField(field, cronFieldsBounds[index])
+		if err != nil {
+			return nil, err
+		}
+		values[index] = parsed
+	}
+	values[4][0] = values[4][0] || values[4][7]
+	return &Cron{minutes: values[0], hours: values[1], daysOfMonth: values[2], months: values[3],
+		daysOfWeek: values[4], anyDayOfMonth: fields[2] == "*", anyDayOfWeek: fields[4] == "*",
+		originExpression: expression}, nil
+}
+
+// Matches follows the cron rule of days: when both day of month and day of week are restricted, any of them runs
+func (c *Cron) Matches(t time.Time) bool {
+	if !c.minutes[t.Minute()] || !c.hours[t.Hour()] || !c.months[int(t.Month())] {
+		return false
+	}
+	dayOfMonth, dayOfWeek := c.daysOfMonth[t.Day()], c.daysOfWeek[int(t.Weekday())]
+	if !c.anyDayOfMonth && !c.anyDayOfWeek {
+		return dayOfMonth || dayOfWeek
+	}
+	return dayOfMonth && dayOfWeek
+}
+
+func (c *Cron) String() string {
+	return c.originExpression
+}
+
+func parseCronField(field string, bounds cronBounds) ([]bool, error) {
+	values := make([]bool, bounds.max+1)
+	for _, part := range strings.Split(field, ",") {
+		start, end, step, err := parseCronPart(part, bounds)
+		if err != nil {
+			return nil, err
+		}
+		for value := start; value <= end; value += step {
+			values[value] = true
+		}
+	}
+	return values, nil
+}
+
+func parseCronPart(part string, bounds cronBounds) (start, end, step int, err error) {
+	step = 1
+	index := strings.Index(part, "/")
+	if index >= 0 {
+		if step, err = strconv.Atoi(part[index+1:]); err != nil || step < 1 {
+			return 0, 0, 0, fmt.Errorf("%w: invalid step %q", ErrInvalidCron, part)
+		}
+		part = part[:index]
+	}
+	start, end = bounds.min, bounds.max
+	if part != "*" {
+		if start, end, err = parseCronRange(part); err != nil {
+			return 0, 0, 0, err
+		}
+		if index >= 0 && !strings.Contains(part, "-") {
+			end = bounds.max
+		}
+	}
+	if start < bounds.min || end > bounds.max || start > end {
+		return 0, 0, 0, fmt.Errorf("%w: value out of range %q", ErrInvalidCron, part)
+	}
+	return start, end, step, nil
+}
+
+func parseCronRange(part string) (start, end int, err error) {
+	bounds := strings.SplitN(part, "-", 2)
+	if start, err = strconv.Atoi(bounds[0]); err != nil {
+		return 0, 0, fmt.Errorf("%w: invalid value %q", ErrInvalidCron, part)
+	}
+	if len(bounds) == 1 {
+		return start, start, nil
+	}
+	if end, err = strconv.Atoi(bounds[1]); err != nil {
+		return 0, 0, fmt.Errorf("%w: invalid value %q", ErrInvalidCron, part)
+	}
+	return start, end, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCron(t *testing.T) {
+	t.Run("Should match the times of the cron expression", func(t *testing.T) {
+		cron, err := ParseCron("*/15 2-4 * * 1-5")
+		assert.NoError(t, err)
+
+		assert.True(t, cron.Matches(time.Date(2021, 1, 4, 2, 30, 0, 0, time.UTC)))
+		assert.False(t, cron.Matches(time.Date(2021, 1, 4, 2, 31, 0, 0, time.UTC)))
+		assert.False(t, cron.Matches(time.Date(2021, 1, 4, 5, 0, 0, 0, time.UTC)))
+		assert.False(t, cron.Matches(time.Date(2021, 1, 3, 2, 30, 0, 0, time.UTC)))
+	})
+
+	t.Run("Should match the day of month or the day of week when both are restricted", func(t *testing.T) {
+		cron, err := ParseCron("0 0 1 * 7")
+		assert.NoError(t, err)
+
+		assert.True(t, cron.Matches(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)))
+		assert.True(t, cron.Matches(time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC)))
+		assert.False(t, cron.Matches(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("Should parse the descriptors and the steps from a value", func(t *testing.T) {
+		daily, err := ParseCron("@daily")
+		assert.NoError(t, err)
+		assert.True(t, daily.Matches(time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)))
+
+		cron, err := ParseCron("5/20 * * * *")
+		assert.NoError(t, err)
+		assert.True(t, cron.Matches(time.Date(2021, 1, 4, 0, 45, 0, 0, time.UTC)))
+		assert.False(t, cron.Matches(time.Date(2021, 1, 4, 0, 40, 0, 0, time.UTC)))
+	})
+
+	t.Run("Should return error when the cron expression is invalid", func(t *testing.T) {
+		for _, expression := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "a * * * *",
+			"5-1 * * * *"} {
+			_, err := ParseCron(expression)
+			assert.True(t, errors.Is(err, ErrInvalidCron), expression)
+		}
+	})
+}
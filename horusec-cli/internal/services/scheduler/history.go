@@ -0,0 +1,114 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const reportIDLayout = "20060102T150405Z"
+
+var (
+	ErrReportNotFound = errors.New("{HORUSEC_CLI} report not found")
+	ErrInvalidName    = errors.New("{HORUSEC_CLI} invalid name, use only letters, numbers, dot, dash and underscore")
+)
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`)
+
+type Report struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// History keeps the reports of each project in a directory named as the project, with the file name as the id
+type History struct {
+	dir       string
+	retention int
+}
+
+func NewHistory(dir string, retention int) *History {
+	return &History{dir: dir, retention: retention}
+}
+
+// NewReportPath returns where the report of a new analysis must be written
+func (h *History) NewReportPath(project string, createdAt time.Time) (string, error) {
+	if !validName.MatchString(project) {
+		return "", ErrInvalidName
+	}
+	projectDir := filepath.Join(h.dir, project)
+	if err := os.MkdirAll(projectDir, os.ModePerm); err != nil {
+		return "", err
+	}
+	return filepath.Join(projectDir, createdAt.UTC().Format(reportIDLayout)+".json"), nil
+}
+
+// List returns the reports of the project from the newest to the oldest
+func (h *History) List(project string) ([]Report, error) {
+	if !validName.MatchString(project) {
+		return nil, ErrInvalidName
+	}
+	files, err := ioutil.ReadDir(filepath.Join(h.dir, project))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Report{}, nil
+		}
+		return nil, err
+	}
+	reports := []Report{}
+	for _, file := range files {
+		id := strings.TrimSuffix(file.Name(), ".json")
+		createdAt, err := time.Parse(reportIDLayout, id)
+		if file.IsDir() || err != nil {
+			continue
+		}
+		reports = append(reports, Report{ID: id, CreatedAt: createdAt})
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].CreatedAt.After(reports[j].CreatedAt)
+	})
+	return reports, nil
+}
+
+func (h *History) Get(project, id string) ([]byte, error) {
+	if !validName.MatchString(project) || !validName.MatchString(id) {
+		return nil, ErrInvalidName
+	}
+	content, err := ioutil.ReadFile(filepath.Join(h.dir, project, id+".json"))
+	if os.IsNotExist(err) {
+		return nil, ErrReportNotFound
+	}
+	return content, err
+}
+
+// Prune removes the oldest reports of the project over the retention, zero keeps all reports
+func (h *History) Prune(project string) error {
+	reports, err := h.List(project)
+	if err != nil || h.retention <= 0 || len(reports) <= h.retention {
+		return err
+	}
+	for _, report := range reports[h.retention:] {
+		if err := os.Remove(filepath.Join(h.dir, project, report.ID+".json")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
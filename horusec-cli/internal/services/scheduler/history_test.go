@@ -0,0 +1,88 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newHistoryToTest(t *testing.T, retention int) *History {
+	dir, err := ioutil.TempDir("", "history")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	return NewHistory(dir, retention)
+}
+
+func saveReportToTest(t *testing.T, history *History, createdAt time.Time) {
+	reportPath, err := history.NewReportPath("api", createdAt)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(reportPath, []byte(`{"status":"success"}`), 0600))
+}
+
+func TestHistory(t *testing.T) {
+	firstTime := time.Date(2021, 1, 4, 2, 0, 0, 0, time.UTC)
+
+	t.Run("Should list the reports from the newest and get the content", func(t *testing.T) {
+		history := newHistoryToTest(t, 0)
+		saveReportToTest(t, history, firstTime)
+		saveReportToTest(t, history, firstTime.Add(time.Hour))
+
+		reports, err := history.List("api")
+		assert.NoError(t, err)
+		assert.Equal(t, []Report{{ID: "20210104T030000Z", CreatedAt: firstTime.Add(time.Hour)},
+			{ID: "20210104T020000Z", CreatedAt: firstTime}}, reports)
+
+		content, err := history.Get("api", "20210104T020000Z")
+		assert.NoError(t, err)
+		assert.Equal(t, `{"status":"success"}`, string(content))
+	})
+
+	t.Run("Should keep only the newest reports of the retention", func(t *testing.T) {
+		history := newHistoryToTest(t, 2)
+		for hour := 0; hour < 4; hour++ {
+			saveReportToTest(t, history, firstTime.Add(time.Duration(hour)*time.Hour))
+		}
+
+		assert.NoError(t, history.Prune("api"))
+		reports, err := history.List("api")
+		assert.NoError(t, err)
+		assert.Len(t, reports, 2)
+		assert.Equal(t, "20210104T050000Z", reports[0].ID)
+	})
+
+	t.Run("Should return empty list for project without reports", func(t *testing.T) {
+		reports, err := newHistoryToTest(t, 0).List("api")
+		assert.NoError(t, err)
+		assert.Empty(t, reports)
+	})
+
+	t.Run("Should return error when the report doesn't exist or the names are invalid", func(t *testing.T) {
+		history := newHistoryToTest(t, 0)
+
+		_, err := history.Get("api", "20210104T020000Z")
+		assert.Equal(t, ErrReportNotFound, err)
+		_, err = history.Get("..", "passwd")
+		assert.Equal(t, ErrInvalidName, err)
+		_, err = history.List("../api")
+		assert.Equal(t, ErrInvalidName, err)
+	})
+}
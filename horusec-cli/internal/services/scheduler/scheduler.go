@@ -0,0 +1,187 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/git"
+)
+
+// Project is a path or a git repository analyzed on each time matched by the cron expression. The args are
+// added to the command start of each analysis, like "--min-grade=C"
+type Project struct {
+	Name          string   `json:"name"`
+	Path          string   `json:"path"`
+	RepositoryURL string   `json:"repositoryURL"`
+	Branch        string   `json:"branch"`
+	Cron          string   `json:"cron"`
+	Args          []string `json:"args"`
+	cron          *Cron
+}
+
+func LoadProjects(schedulesFilePath string) ([]*Project, error) {
+	content, err := ioutil.ReadFile(schedulesFilePath)
+	if err != nil {
+		return nil, err
+	}
+	var projects []*Project
+	if err := json.Unmarshal(content, &projects); err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for _, project := range projects {
+		if err := project.validate(names); err != nil {
+			return nil, err
+		}
+	}
+	return projects, nil
+}
+
+func (p *Project) validate(names map[string]bool) (err error) {
+	if !validName.MatchString(p.Name) || names[p.Name] {
+		return fmt.Errorf("%w or duplicated: %q", ErrInvalidName, p.Name)
+	}
+	names[p.Name] = true
+	if (p.Path == "") == (p.RepositoryURL == "") {
+		return fmt.Errorf("{HORUSEC_CLI} project %q must have the path or the repositoryURL", p.Name)
+	}
+	p.cron, err = ParseCron(p.Cron)
+	return err
+}
+
+type Scanner interface {
+	Scan(project *Project, reportPath string) error
+}
+
+// execScanner runs the analysis with the command start of the horusec binary itself, so each analysis has its
+// own configs and containers like when called by the user
+type execScanner struct {
+	executable string
+	logLevel   string
+}
+
+func NewScanner(logLevel string) (Scanner, error) {
+	executable, err := os.Executable()
+	return &execScanner{executable: executable, logLevel: logLevel}, err
+}
+
+func (e *execScanner) Scan(project *Project, reportPath string) error {
+	projectPath := project.Path
+	if project.RepositoryURL != "" {
+		clonePath, err := git.Clone(&git.CloneOptions{URL: project.RepositoryURL, Branch: project.Branch})
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(clonePath)
+		projectPath = clonePath
+	}
+	args := append([]string{"start", "-p", projectPath, "-o", "json", "-O", reportPath, "--log-level", e.logLevel},
+		project.Args...)
+	output, err := exec.Command(e.executable, args...).CombinedOutput()
+	if _, statErr := os.Stat(reportPath); statErr == nil {
+		// the report is kept even when the gates of the analysis return error
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return fmt.Errorf("{HORUSEC_CLI} report not written: %s", strings.TrimSpace(string(output)))
+}
+
+type Scheduler struct {
+	projects []*Project
+	history  *History
+	scanner  Scanner
+	running  map[string]bool
+	mutex    sync.Mutex
+	wait     sync.WaitGroup
+}
+
+func NewScheduler(projects []*Project, history *History, scanner Scanner) *Scheduler {
+	return &Scheduler{projects: projects, history: history, scanner: scanner, running: map[string]bool{}}
+}
+
+func (s *Scheduler) GetProjects() []*Project {
+	return s.projects
+}
+
+// Start checks the projects on the beginning of each minute until the stop channel is closed
+func (s *Scheduler) Start(stop <-chan struct{}) {
+	for {
+		now := time.Now()
+		next := now.Truncate(time.Minute).Add(time.Minute)
+		select {
+		case <-stop:
+			s.wait.Wait()
+			return
+		case <-time.After(next.Sub(now)):
+			s.RunDue(next)
+		}
+	}
+}
+
+// RunDue starts the analyses of the projects matched by the time, skipping the projects still running
+func (s *Scheduler) RunDue(t time.Time) {
+	for _, project := range s.projects {
+		if project.cron.Matches(t) && s.setRunning(project.Name, true) {
+			s.wait.Add(1)
+			go s.run(project, t)
+		}
+	}
+}
+
+func (s *Scheduler) setRunning(name string, running bool) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if running && s.running[name] {
+		return false
+	}
+	s.running[name] = running
+	return true
+}
+
+func (s *Scheduler) run(project *Project, t time.Time) {
+	defer s.wait.Done()
+	defer s.setRunning(project.Name, false)
+	reportPath, err := s.history.NewReportPath(project.Name, t)
+	if err == nil {
+		err = s.scanner.Scan(project, reportPath)
+	}
+	if err == nil {
+		err = s.history.Prune(project.Name)
+	}
+	if err != nil {
+		logger.LogErrorWithLevel(strings.ReplaceAll(messages.MsgErrorRunScheduledAnalysis, "{{0}}", project.Name),
+			err, logger.ErrorLevel)
+		return
+	}
+	logger.LogInfoWithLevel(strings.ReplaceAll(messages.MsgInfoScheduledAnalysisFinished, "{{0}}", project.Name)+
+		reportPath, logger.InfoLevel)
+}
+
+// Wait blocks until the analyses running finish
+func (s *Scheduler) Wait() {
+	s.wait.Wait()
+}
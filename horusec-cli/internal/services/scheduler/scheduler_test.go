@@ -0,0 +1,130 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduler
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type scannerToTest struct {
+	mutex   sync.Mutex
+	scanned []string
+	err     error
+}
+
+func (s *scannerToTest) Scan(project *Project, reportPath string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.scanned = append(s.scanned, project.Name)
+	if s.err != nil {
+		return s.err
+	}
+	return ioutil.WriteFile(reportPath, []byte("{}"), 0600)
+}
+
+func writeSchedulesToTest(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "schedules")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	schedulesFilePath := filepath.Join(dir, "schedules.json")
+	assert.NoError(t, ioutil.WriteFile(schedulesFilePath, []byte(content), 0600))
+	return schedulesFilePath
+}
+
+func TestLoadProjects(t *testing.T) {
+	t.Run("Should load the projects of the schedules file", func(t *testing.T) {
+		projects, err := LoadProjects(writeSchedulesToTest(t, `[
+			{"name": "api", "path": "/src/api", "cron": "@daily"},
+			{"name": "web", "repositoryURL": "https://github.com/ZupIT/horusec.git", "cron": "0 3 * * *"}
+		]`))
+		assert.NoError(t, err)
+		assert.Len(t, projects, 2)
+		assert.Equal(t, "0 3 * * *", projects[1].cron.String())
+	})
+
+	t.Run("Should return error when the projects are invalid", func(t *testing.T) {
+		for _, content := range []string{
+			`[{"name": "api", "path": "/src/api", "cron": "@never"}]`,
+			`[{"name": "api", "cron": "@daily"}]`,
+			`[{"name": "../api", "path": "/src/api", "cron": "@daily"}]`,
+			`[{"name": "api", "path": "/src/api", "cron": "@daily"}, {"name": "api", "path": "/src", "cron": "@daily"}]`,
+			`{}`,
+		} {
+			_, err := LoadProjects(writeSchedulesToTest(t, content))
+			assert.Error(t, err, content)
+		}
+	})
+
+	t.Run("Should return error when the schedules file doesn't exist", func(t *testing.T) {
+		_, err := LoadProjects("./not-exists.json")
+		assert.Error(t, err)
+	})
+}
+
+func TestScheduler_RunDue(t *testing.T) {
+	schedulesFilePath := writeSchedulesToTest(t, `[
+		{"name": "api", "path": "/src/api", "cron": "0 * * * *"},
+		{"name": "web", "path": "/src/web", "cron": "30 * * * *"}
+	]`)
+
+	t.Run("Should run and keep the report of the projects matched", func(t *testing.T) {
+		projects, err := LoadProjects(schedulesFilePath)
+		assert.NoError(t, err)
+		history := newHistoryToTest(t, 1)
+		scanner := &scannerToTest{}
+		scheduler := NewScheduler(projects, history, scanner)
+
+		scheduler.RunDue(time.Date(2021, 1, 4, 2, 0, 0, 0, time.UTC))
+		scheduler.Wait()
+		scheduler.RunDue(time.Date(2021, 1, 4, 3, 0, 0, 0, time.UTC))
+		scheduler.Wait()
+
+		assert.Equal(t, []string{"api", "api"}, scanner.scanned)
+		reports, err := history.List("api")
+		assert.NoError(t, err)
+		assert.Equal(t, []Report{{ID: "20210104T030000Z", CreatedAt: time.Date(2021, 1, 4, 3, 0, 0, 0, time.UTC)}},
+			reports)
+	})
+
+	t.Run("Should not keep report when the analysis fails", func(t *testing.T) {
+		projects, err := LoadProjects(schedulesFilePath)
+		assert.NoError(t, err)
+		history := newHistoryToTest(t, 0)
+		scheduler := NewScheduler(projects, history, &scannerToTest{err: errors.New("test")})
+
+		scheduler.RunDue(time.Date(2021, 1, 4, 2, 30, 0, 0, time.UTC))
+		scheduler.Wait()
+
+		reports, err := history.List("web")
+		assert.NoError(t, err)
+		assert.Empty(t, reports)
+	})
+
+	t.Run("Should stop the scheduler", func(t *testing.T) {
+		stop := make(chan struct{})
+		close(stop)
+		NewScheduler(nil, newHistoryToTest(t, 0), &scannerToTest{}).Start(stop)
+	})
+}
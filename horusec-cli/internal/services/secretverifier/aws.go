@@ -0,0 +1,84 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretverifier
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/sigv4"
+)
+
+const (
+	awsRegion  = "us-east-1"
+	awsService = "sts"
+	// separator used to join the key id and the secret in a single value to be checked
+	awsKeySeparator = ":"
+)
+
+var (
+	awsKeyIDRegex = regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)
+	// the secrets are only the values assigned to a name with secret, like aws_secret_access_key = ... or
+	// "SecretAccessKey": "...", so the other strings of 40 chars, like the hashes of the commits, aren't checked
+	awsSecretRegex = regexp.MustCompile(
+		`(?im)secret[a-z0-9_.\-]*["']?\s*(:=|=>|=|:)\s*["']?([A-Za-z0-9/+=]{40})(["'\s,;]|$)`)
+)
+
+// awsChecker calls sts:GetCallerIdentity, which needs no permission and has no side effects
+type awsChecker struct {
+	httpClient client.Interface
+	now        func() time.Time
+}
+
+func (a *awsChecker) Find(code string) (pairs []string) {
+	secrets := a.findSecrets(code)
+	for _, keyID := range unique(awsKeyIDRegex.FindAllString(code, -1)) {
+		for _, secret := range secrets {
+			pairs = append(pairs, keyID+awsKeySeparator+secret)
+		}
+	}
+	return pairs
+}
+
+func (a *awsChecker) findSecrets(code string) (secrets []string) {
+	for _, match := range awsSecretRegex.FindAllStringSubmatch(code, -1) {
+		secrets = append(secrets, match[2])
+	}
+	return unique(secrets)
+}
+
+func (a *awsChecker) IsActive(secret string) (bool, error) {
+	keys := strings.SplitN(secret, awsKeySeparator, 2)
+	if len(keys) != 2 {
+		return false, fmt.Errorf("invalid aws key pair")
+	}
+	req, err := http.NewRequest(http.MethodPost, awsSTSEndpoint, strings.NewReader(awsSTSBody))
+	if err != nil {
+		return false, err
+	}
+	a.signRequest(req, keys[0], keys[1])
+	return doStatusCheck(a.httpClient, req, http.StatusOK, http.StatusForbidden)
+}
+
+// signRequest adds the AWS signature version 4 headers to the request
+func (a *awsChecker) signRequest(req *http.Request, keyID, secretKey string) {
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	sigv4.SignRequest(req, []byte(awsSTSBody), &sigv4.Credentials{AccessKeyID: keyID, SecretAccessKey: secretKey},
+		awsRegion, awsService, a.now())
+}
@@ -0,0 +1,164 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretverifier
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+)
+
+const (
+	githubUserURL  = "https://api.github.com/user"
+	slackAuthURL   = "https://slack.com/api/auth.test"
+	awsSTSEndpoint = "https://sts.amazonaws.com/"
+	awsSTSBody     = "Action=GetCallerIdentity&Version=2011-06-15"
+)
+
+// checker finds credentials of one provider in a piece of code and checks if they are still valid.
+// All requests done by checkers must be read-only
+type checker interface {
+	Find(code string) []string
+	IsActive(secret string) (bool, error)
+}
+
+func newCheckers(httpClient client.Interface) []checker {
+	return []checker{
+		&githubChecker{httpClient: httpClient},
+		&slackTokenChecker{httpClient: httpClient},
+		&slackWebhookChecker{httpClient: httpClient},
+		&awsChecker{httpClient: httpClient, now: time.Now},
+	}
+}
+
+type githubChecker struct {
+	httpClient client.Interface
+}
+
+var githubTokenRegex = regexp.MustCompile(`(ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9]{36}`)
+
+func (g *githubChecker) Find(code string) []string {
+	return unique(githubTokenRegex.FindAllString(code, -1))
+}
+
+func (g *githubChecker) IsActive(secret string) (bool, error) {
+	req, err := http.NewRequest(http.MethodGet, githubUserURL, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Authorization", "token "+secret)
+	return doStatusCheck(g.httpClient, req, http.StatusOK, http.StatusUnauthorized)
+}
+
+type slackTokenChecker struct {
+	httpClient client.Interface
+}
+
+var slackTokenRegex = regexp.MustCompile(`xox[baprs]-[0-9A-Za-z]{10,48}(-[0-9A-Za-z]{10,48})*`)
+
+func (s *slackTokenChecker) Find(code string) []string {
+	return unique(slackTokenRegex.FindAllString(code, -1))
+}
+
+func (s *slackTokenChecker) IsActive(secret string) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, slackAuthURL, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Authorization", "Bearer "+secret)
+	response, err := s.httpClient.DoRequest(req, &tls.Config{})
+	if err != nil {
+		return false, err
+	}
+	defer response.CloseBody()
+	body, err := response.GetBody()
+	if err != nil {
+		return false, err
+	}
+	result := struct {
+		Ok bool `json:"ok"`
+	}{}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return false, err
+	}
+	return result.Ok, nil
+}
+
+type slackWebhookChecker struct {
+	httpClient client.Interface
+}
+
+var slackWebhookRegex = regexp.MustCompile(
+	`https://hooks\.slack\.com/services/T[A-Za-z0-9_]{8,12}/B[A-Za-z0-9_]{8,12}/[A-Za-z0-9_]{24}`)
+
+func (s *slackWebhookChecker) Find(code string) []string {
+	return unique(slackWebhookRegex.FindAllString(code, -1))
+}
+
+// IsActive sends an empty payload, so no message is posted. A valid webhook answers "no_text"
+func (s *slackWebhookChecker) IsActive(secret string) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, secret, strings.NewReader("{}"))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	response, err := s.httpClient.DoRequest(req, &tls.Config{})
+	if err != nil {
+		return false, err
+	}
+	defer response.CloseBody()
+	switch response.GetStatusCode() {
+	case http.StatusBadRequest:
+		body, err := response.GetBody()
+		return err == nil && strings.Contains(string(body), "no_text"), err
+	case http.StatusForbidden, http.StatusNotFound, http.StatusGone:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status code %d from slack webhook", response.GetStatusCode())
+	}
+}
+
+func doStatusCheck(httpClient client.Interface, req *http.Request, activeStatus, inactiveStatus int) (bool, error) {
+	response, err := httpClient.DoRequest(req, &tls.Config{})
+	if err != nil {
+		return false, err
+	}
+	defer response.CloseBody()
+	switch response.GetStatusCode() {
+	case activeStatus:
+		return true, nil
+	case inactiveStatus:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected status code %d from %s", response.GetStatusCode(), req.URL.Host)
+	}
+}
+
+func unique(values []string) (output []string) {
+	seen := map[string]bool{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			output = append(output, value)
+		}
+	}
+	return output
+}
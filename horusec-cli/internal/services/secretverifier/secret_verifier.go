@@ -0,0 +1,131 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretverifier
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+// Lines around the finding used to look for credentials split in more than one line, like AWS key pairs
+const surroundingLines = 5
+
+type Interface interface {
+	VerifyAnalysis(analysis *horusec.Analysis)
+}
+
+type SecretVerifier struct {
+	config   cliConfig.IConfig
+	checkers []checker
+	verified map[string]verification
+}
+
+type verification struct {
+	isActive bool
+	err      error
+}
+
+func NewSecretVerifier(config cliConfig.IConfig) Interface {
+	return &SecretVerifier{
+		config:   config,
+		checkers: newCheckers(client.NewHTTPClient(10)),
+	}
+}
+
+func (s *SecretVerifier) VerifyAnalysis(analysis *horusec.Analysis) {
+	s.verified = map[string]verification{}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		if s.isLeaksTool(vulnerability.SecurityTool) {
+			s.verifyVulnerability(vulnerability)
+		}
+	}
+}
+
+func (s *SecretVerifier) isLeaksTool(tool tools.Tool) bool {
+	return tool == tools.HorusecLeaks || tool == tools.GitLeaks
+}
+
+func (s *SecretVerifier) verifyVulnerability(vulnerability *horusec.Vulnerability) {
+	vulnerability.VerificationStatus = enumHorusec.Unverified
+	content := vulnerability.Code + "\n" + s.getSurroundingContent(vulnerability)
+	for _, check := range s.checkers {
+		for _, secret := range check.Find(content) {
+			isActive, err := s.isActive(check, secret)
+			if err != nil {
+				logger.LogDebugWithLevel(messages.MsgDebugSecretVerificationFailed, logger.DebugLevel, err.Error())
+				continue
+			}
+			if isActive {
+				s.setVerifiedActive(vulnerability)
+				return
+			}
+			vulnerability.VerificationStatus = enumHorusec.VerifiedInactive
+		}
+	}
+}
+
+// isActive checks each secret once in the analysis, the same secret is found by many tools and in many lines
+func (s *SecretVerifier) isActive(check checker, secret string) (bool, error) {
+	if result, ok := s.verified[secret]; ok {
+		return result.isActive, result.err
+	}
+	isActive, err := check.IsActive(secret)
+	s.verified[secret] = verification{isActive: isActive, err: err}
+	return isActive, err
+}
+
+// setVerifiedActive keeps the severity at least HIGH, the verification is only in the verification status, because
+// horusec platform has no severity above HIGH
+func (s *SecretVerifier) setVerifiedActive(vulnerability *horusec.Vulnerability) {
+	vulnerability.VerificationStatus = enumHorusec.VerifiedActive
+	if vulnerability.Severity.IsBelow(severity.High) {
+		vulnerability.Severity = severity.High
+	}
+}
+
+func (s *SecretVerifier) getSurroundingContent(vulnerability *horusec.Vulnerability) string {
+	line, err := strconv.Atoi(vulnerability.Line)
+	if err != nil || vulnerability.File == "" {
+		return ""
+	}
+	content, err := ioutil.ReadFile(filepath.Join(s.config.GetProjectPath(), vulnerability.File))
+	if err != nil {
+		return ""
+	}
+	lines := strings.Split(string(content), "\n")
+	start, end := line-1-surroundingLines, line+surroundingLines
+	if start < 0 {
+		start = 0
+	}
+	if end > len(lines) {
+		end = len(lines)
+	}
+	if start >= end {
+		return ""
+	}
+	return strings.Join(lines[start:end], "\n")
+}
@@ -0,0 +1,95 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package severitymapping
+
+import (
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+// Separator of the tool and of its severity in the keys of the mapping, like NpmAudit:moderate
+const keySeparator = ":"
+
+type Interface interface {
+	SetSeverities(analysis *horusec.Analysis)
+}
+
+type SeverityMapping struct {
+	config cliConfig.IConfig
+}
+
+func NewSeverityMapping(config cliConfig.IConfig) Interface {
+	return &SeverityMapping{
+		config: config,
+	}
+}
+
+func (s *SeverityMapping) SetSeverities(analysis *horusec.Analysis) {
+	mapping := s.getMapping()
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		if vulnerability.ToolSeverity == "" {
+			continue
+		}
+		if vulnSeverity, ok := mapping[getKey(vulnerability.SecurityTool.ToString(), vulnerability.ToolSeverity)]; ok {
+			vulnerability.Severity = vulnSeverity
+		}
+	}
+}
+
+// getMapping uses the keys in lower case, because the keys of the configuration file are read in lower case
+func (s *SeverityMapping) getMapping() map[string]severity.Severity {
+	mapping := map[string]severity.Severity{}
+	for key, value := range s.config.GetSeverityMapping() {
+		tool, toolSeverity := splitKey(key)
+		mapping[getKey(tool, toolSeverity)] = severity.ParseStringToSeverity(strings.ToUpper(strings.TrimSpace(value)))
+	}
+	return mapping
+}
+
+// IsValidMapping accepts only the tools of horusec and the severities of horusec
+func IsValidMapping(key, value string) bool {
+	tool, toolSeverity := splitKey(key)
+	if toolSeverity == "" || !isValidTool(tool) {
+		return false
+	}
+	_, ok := severity.Map()[strings.ToUpper(strings.TrimSpace(value))]
+	return ok
+}
+
+func isValidTool(tool string) bool {
+	for _, value := range tools.Values() {
+		if strings.EqualFold(value.ToString(), tool) {
+			return true
+		}
+	}
+	return false
+}
+
+func splitKey(key string) (tool, toolSeverity string) {
+	values := strings.SplitN(key, keySeparator, 2)
+	if len(values) != 2 {
+		return strings.TrimSpace(key), ""
+	}
+	return strings.TrimSpace(values[0]), strings.TrimSpace(values[1])
+}
+
+func getKey(tool, toolSeverity string) string {
+	return strings.ToLower(tool + keySeparator + toolSeverity)
+}
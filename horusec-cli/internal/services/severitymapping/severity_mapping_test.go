@@ -0,0 +1,70 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package severitymapping
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysis() *horusec.Analysis {
+	return &horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+		{Vulnerability: horusec.Vulnerability{SecurityTool: tools.NpmAudit, ToolSeverity: "moderate",
+			Severity: severity.Medium}},
+		{Vulnerability: horusec.Vulnerability{SecurityTool: tools.Semgrep, ToolSeverity: "WARNING",
+			Severity: severity.Medium}},
+		{Vulnerability: horusec.Vulnerability{SecurityTool: tools.HorusecLeaks, Severity: severity.High}},
+	}}
+}
+
+func TestSetSeverities(t *testing.T) {
+	t.Run("Should replace the severity of the tool severities mapped", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetSeverityMapping(map[string]string{"npmaudit:moderate": "high", "Semgrep:ERROR": "HIGH"})
+		analysis := newAnalysis()
+
+		NewSeverityMapping(config).SetSeverities(analysis)
+
+		assert.Equal(t, severity.High, analysis.AnalysisVulnerabilities[0].Vulnerability.Severity)
+		assert.Equal(t, severity.Medium, analysis.AnalysisVulnerabilities[1].Vulnerability.Severity)
+		assert.Equal(t, severity.High, analysis.AnalysisVulnerabilities[2].Vulnerability.Severity)
+	})
+
+	t.Run("Should keep the severities without mapping", func(t *testing.T) {
+		analysis := newAnalysis()
+
+		NewSeverityMapping(&cliConfig.Config{}).SetSeverities(analysis)
+
+		assert.Equal(t, newAnalysis(), analysis)
+	})
+}
+
+func TestIsValidMapping(t *testing.T) {
+	t.Run("Should be valid to the tools and severities of horusec", func(t *testing.T) {
+		assert.True(t, IsValidMapping("NpmAudit:moderate", "HIGH"))
+		assert.True(t, IsValidMapping("gosec:LOW", "medium"))
+	})
+
+	t.Run("Should be invalid to unknown tools, severities or keys without tool severity", func(t *testing.T) {
+		assert.False(t, IsValidMapping("Unknown:moderate", "HIGH"))
+		assert.False(t, IsValidMapping("NpmAudit:moderate", "SEVERE"))
+		assert.False(t, IsValidMapping("NpmAudit", "HIGH"))
+	})
+}
@@ -0,0 +1,160 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package severitytables
+
+// defaultTables are the built-in tables of the tools that don't inform the severities of horusec. The tables are
+// replaced by the yaml of the flag severity-tables-path, so the mappings can be corrected without a new release
+const defaultTables = `
+# the severities of the advisories of npm
+NpmAudit:
+  severities:
+    "info": LOW
+    "low": LOW
+    "moderate": MEDIUM
+    "high": HIGH
+    "critical": HIGH
+  default: NOSEC
+# the severities of the advisories of npm, used by yarn
+YarnAudit:
+  severities:
+    "info": LOW
+    "low": LOW
+    "moderate": MEDIUM
+    "high": HIGH
+    "critical": HIGH
+  default: NOSEC
+# the severities of the advisories, the unknown severities are audited
+Trivy:
+  severities:
+    "CRITICAL": HIGH
+    "HIGH": HIGH
+    "MEDIUM": MEDIUM
+    "LOW": LOW
+  default: AUDIT
+# the ratings of the cvss scores of the advisories, the advisories without score are audited
+OsvScanner:
+  severities:
+    "CRITICAL": HIGH
+    "HIGH": HIGH
+    "MEDIUM": MEDIUM
+    "LOW": LOW
+  default: AUDIT
+# the checks without severity, like the checks of tfsec, are high
+Checkov:
+  severities:
+    "MEDIUM": MEDIUM
+    "LOW": LOW
+    "INFO": INFO
+  default: HIGH
+# the levels of the sarif results of semgrep
+Semgrep:
+  severities:
+    "error": HIGH
+    "warning": MEDIUM
+  default: LOW
+# brakeman informs the confidence of the warnings, that is used as the severity
+Brakeman:
+  severities:
+    "High": HIGH
+    "Medium": MEDIUM
+    "Low": LOW
+  default: NOSEC
+# the risk levels of flawfinder, from 0 to 5
+Flawfinder:
+  severities:
+    "3": MEDIUM
+    "4": MEDIUM
+    "5": HIGH
+  default: LOW
+# the ranks of spotbugs, from 1, the scariest, to 20
+SpotBugs:
+  severities:
+    "1": HIGH
+    "2": HIGH
+    "3": HIGH
+    "4": HIGH
+    "5": HIGH
+    "6": HIGH
+    "7": HIGH
+    "8": HIGH
+    "9": HIGH
+    "10": MEDIUM
+    "11": MEDIUM
+    "12": MEDIUM
+    "13": MEDIUM
+    "14": MEDIUM
+  default: LOW
+# the rules of security code scan, see https://security-code-scan.github.io
+SecurityCodeScan:
+  default: NOSEC
+  rules:
+    SCS0001: {severity: HIGH, cwe: CWE-78, owasp: A03:2021}
+    SCS0002: {severity: HIGH, cwe: CWE-89, owasp: A03:2021}
+    SCS0003: {severity: HIGH, cwe: CWE-643, owasp: A03:2021}
+    SCS0004: {severity: HIGH, cwe: CWE-295, owasp: A07:2021}
+    SCS0005: {severity: MEDIUM, cwe: CWE-338, owasp: A02:2021}
+    SCS0006: {severity: MEDIUM, cwe: CWE-327, owasp: A02:2021}
+    SCS0007: {severity: HIGH, cwe: CWE-611, owasp: A05:2021}
+    SCS0008: {severity: LOW, cwe: CWE-614, owasp: A05:2021}
+    SCS0009: {severity: LOW, cwe: CWE-1004, owasp: A05:2021}
+    SCS0010: {severity: MEDIUM, cwe: CWE-327, owasp: A02:2021}
+    SCS0011: {severity: MEDIUM, cwe: CWE-327, owasp: A02:2021}
+    SCS0012: {severity: MEDIUM, cwe: CWE-327, owasp: A02:2021}
+    SCS0013: {severity: MEDIUM, cwe: CWE-327, owasp: A02:2021}
+    SCS0014: {severity: HIGH, cwe: CWE-89, owasp: A03:2021}
+    SCS0015: {severity: HIGH, cwe: CWE-259, owasp: A07:2021}
+    SCS0016: {severity: HIGH, cwe: CWE-352, owasp: A01:2021}
+    SCS0017: {severity: LOW, cwe: CWE-20, owasp: A03:2021}
+    SCS0018: {severity: MEDIUM, cwe: CWE-22, owasp: A01:2021}
+    SCS0019: {severity: LOW, cwe: CWE-524}
+    SCS0020: {severity: HIGH, cwe: CWE-89, owasp: A03:2021}
+    SCS0021: {severity: LOW, cwe: CWE-20, owasp: A03:2021}
+    SCS0022: {severity: LOW, cwe: CWE-20, owasp: A03:2021}
+    SCS0023: {severity: MEDIUM, cwe: CWE-311, owasp: A02:2021}
+    SCS0024: {severity: MEDIUM, cwe: CWE-642}
+    SCS0025: {severity: HIGH, cwe: CWE-89, owasp: A03:2021}
+    SCS0026: {severity: HIGH, cwe: CWE-89, owasp: A03:2021}
+    SCS0027: {severity: MEDIUM, cwe: CWE-601, owasp: A01:2021}
+    SCS0028: {severity: HIGH, cwe: CWE-502, owasp: A08:2021}
+    SCS0029: {severity: HIGH, cwe: CWE-79, owasp: A03:2021}
+    SCS0030: {severity: LOW, cwe: CWE-20, owasp: A03:2021}
+    SCS0031: {severity: HIGH, cwe: CWE-90, owasp: A03:2021}
+    SCS0032: {severity: MEDIUM, cwe: CWE-521, owasp: A07:2021}
+    SCS0033: {severity: MEDIUM, cwe: CWE-521, owasp: A07:2021}
+    SCS0034: {severity: MEDIUM, cwe: CWE-521, owasp: A07:2021}
+    SCS0035: {severity: HIGH, cwe: CWE-89, owasp: A03:2021}
+    SCS0036: {severity: HIGH, cwe: CWE-89, owasp: A03:2021}
+    SCS0037: {severity: HIGH, cwe: CWE-89, owasp: A03:2021}
+    SCS0038: {severity: HIGH, cwe: CWE-89, owasp: A03:2021}
+    SCS0039: {severity: HIGH, cwe: CWE-89, owasp: A03:2021}
+# bandit informs the severity of the issues, only the references of its rules are added
+Bandit:
+  rules:
+    B102: {cwe: CWE-78, owasp: A03:2021}
+    B105: {cwe: CWE-259, owasp: A07:2021}
+    B106: {cwe: CWE-259, owasp: A07:2021}
+    B107: {cwe: CWE-259, owasp: A07:2021}
+    B108: {cwe: CWE-377, owasp: A01:2021}
+    B301: {cwe: CWE-502, owasp: A08:2021}
+    B303: {cwe: CWE-327, owasp: A02:2021}
+    B307: {cwe: CWE-78, owasp: A03:2021}
+    B311: {cwe: CWE-330, owasp: A02:2021}
+    B324: {cwe: CWE-327, owasp: A02:2021}
+    B501: {cwe: CWE-295, owasp: A07:2021}
+    B506: {cwe: CWE-20, owasp: A03:2021}
+    B602: {cwe: CWE-78, owasp: A03:2021}
+    B608: {cwe: CWE-89, owasp: A03:2021}
+    B701: {cwe: CWE-94, owasp: A03:2021}
+`
@@ -0,0 +1,201 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package severitytables
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+)
+
+// Rule is the severity and the references of a rule of a tool, the empty fields are not used
+type Rule struct {
+	Severity severity.Severity `yaml:"severity"`
+	CWE      string            `yaml:"cwe"`
+	OWASP    string            `yaml:"owasp"`
+}
+
+// Table maps the severities informed by a tool and the ids of its rules to the severities of horusec
+type Table struct {
+	Severities map[string]severity.Severity `yaml:"severities"`
+	Default    severity.Severity            `yaml:"default"`
+	Rules      map[string]Rule              `yaml:"rules"`
+}
+
+// Tables are the tables of each tool
+type Tables map[tools.Tool]*Table
+
+// Load reads the built-in tables and the yaml of the path informed, that replaces the severities, the default and
+// the rules of the built-in tables with the same keys
+func Load(path string) (Tables, error) {
+	tables, err := parse([]byte(defaultTables))
+	if err != nil || path == "" {
+		return tables, err
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	customTables, err := parse(content)
+	if err != nil {
+		return nil, err
+	}
+	for tool, customTable := range customTables {
+		table := tables.Get(tool)
+		table.merge(customTable)
+		tables[tool] = table
+	}
+	return tables, nil
+}
+
+// Default returns the built-in tables, used when the tables of the path informed can't be read
+func Default() Tables {
+	tables, _ := parse([]byte(defaultTables))
+	return tables
+}
+
+func parse(content []byte) (Tables, error) {
+	tables := Tables{}
+	if err := yaml.Unmarshal(content, &tables); err != nil {
+		return nil, err
+	}
+	for tool, table := range tables {
+		tables[tool] = newTable()
+		tables[tool].merge(table)
+	}
+	return tables, tables.validate()
+}
+
+// validate accepts only the tools of horusec and the severities of horusec
+func (t Tables) validate() error {
+	for tool, table := range t {
+		if !isValidTool(tool) {
+			return errors.New("tool not supported: " + tool.ToString())
+		}
+		if err := table.validate(); err != nil {
+			return errors.New(tool.ToString() + ": " + err.Error())
+		}
+	}
+	return nil
+}
+
+func (t *Table) validate() error {
+	values := []severity.Severity{t.Default}
+	for _, value := range t.Severities {
+		values = append(values, value)
+	}
+	for _, rule := range t.Rules {
+		values = append(values, rule.Severity)
+	}
+	for _, value := range values {
+		if _, ok := severity.Map()[value.ToString()]; value != "" && !ok {
+			return errors.New("severity not supported: " + value.ToString())
+		}
+	}
+	return nil
+}
+
+func isValidTool(tool tools.Tool) bool {
+	for _, value := range tools.Values() {
+		if value == tool {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Table) merge(table *Table) {
+	if table == nil {
+		return
+	}
+	if table.Default != "" {
+		t.Default = table.Default
+	}
+	for key, value := range table.Severities {
+		for current := range t.Severities {
+			if strings.EqualFold(current, key) {
+				delete(t.Severities, current)
+			}
+		}
+		t.Severities[key] = value
+	}
+	for key, value := range table.Rules {
+		t.Rules[key] = value
+	}
+}
+
+// Get returns the table of the tool, or an empty table when the tool has no table. It doesn't change the tables, so
+// it is called by the formatters running at the same time
+func (t Tables) Get(tool tools.Tool) *Table {
+	if table := t[tool]; table != nil {
+		return table
+	}
+	return newTable()
+}
+
+func newTable() *Table {
+	return &Table{Severities: map[string]severity.Severity{}, Rules: map[string]Rule{}}
+}
+
+// GetSeverity returns the severity of the rule, then the severity mapped from the severity informed by the tool,
+// compared ignoring the case, and then the default of the table
+func (t *Table) GetSeverity(ruleID, toolSeverity string) severity.Severity {
+	if rule, ok := t.Rules[ruleID]; ok && rule.Severity != "" {
+		return rule.Severity
+	}
+	for key, value := range t.Severities {
+		if strings.EqualFold(key, strings.TrimSpace(toolSeverity)) {
+			return value
+		}
+	}
+	return t.Default
+}
+
+// SetRules sets the severity of the rules, so the rules of the tools without table in the formatter can also be
+// changed, and adds their CWE and OWASP category to the details of the vulnerabilities, after the vulnerability hash
+// was generated so the hashes of the false positives and of the risk accepted don't change. The CWE already in the
+// details is not added again
+func (t Tables) SetRules(analysis *horusec.Analysis) {
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		rule, ok := t.Get(vulnerability.SecurityTool).Rules[vulnerability.RuleID]
+		if !ok {
+			continue
+		}
+		if rule.Severity != "" {
+			vulnerability.Severity = rule.Severity
+		}
+		setReferences(vulnerability, rule)
+	}
+}
+
+func setReferences(vulnerability *horusec.Vulnerability, rule Rule) {
+	var references []string
+	if rule.CWE != "" && !strings.Contains(vulnerability.Details, rule.CWE) {
+		references = append(references, rule.CWE)
+	}
+	if rule.OWASP != "" {
+		references = append(references, "OWASP "+rule.OWASP)
+	}
+	if len(references) > 0 {
+		vulnerability.Details += "\nReferences: " + strings.Join(references, ", ")
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package severitytables
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+)
+
+func writeTablesFile(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "horusec-severity-tables")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	path := filepath.Join(dir, "severity-tables.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("Should return the built-in tables when the path is empty", func(t *testing.T) {
+		tables, err := Load("")
+
+		assert.NoError(t, err)
+		assert.Equal(t, severity.High, tables.Get(tools.NpmAudit).GetSeverity("", "critical"))
+		assert.Equal(t, severity.Medium, tables.Get(tools.Trivy).GetSeverity("", "MEDIUM"))
+		assert.Equal(t, severity.Low, tables.Get(tools.Semgrep).GetSeverity("", "INFO"))
+	})
+	t.Run("Should replace only the keys of the built-in tables that are in the file", func(t *testing.T) {
+		tables, err := Load(writeTablesFile(t, `
+Semgrep:
+  severities:
+    WARNING: HIGH
+  default: INFO
+`))
+
+		assert.NoError(t, err)
+		table := tables.Get(tools.Semgrep)
+		assert.Equal(t, severity.High, table.GetSeverity("", "WARNING"))
+		assert.Equal(t, severity.High, table.GetSeverity("", "ERROR"))
+		assert.Equal(t, severity.Info, table.GetSeverity("", "UNKNOWN"))
+	})
+	t.Run("Should add the tables of the tools without built-in table", func(t *testing.T) {
+		tables, err := Load(writeTablesFile(t, `
+GoSec:
+  rules:
+    G101:
+      severity: LOW
+`))
+
+		assert.NoError(t, err)
+		assert.Equal(t, severity.Low, tables.Get(tools.GoSec).GetSeverity("G101", "HIGH"))
+	})
+	t.Run("Should return error when the severity is not of horusec", func(t *testing.T) {
+		_, err := Load(writeTablesFile(t, "Semgrep:\n  default: URGENT\n"))
+
+		assert.EqualError(t, err, "Semgrep: severity not supported: URGENT")
+	})
+	t.Run("Should return error when the tool is not of horusec", func(t *testing.T) {
+		_, err := Load(writeTablesFile(t, "Unknown:\n  default: LOW\n"))
+
+		assert.EqualError(t, err, "tool not supported: Unknown")
+	})
+	t.Run("Should return error when the file doesn't exist", func(t *testing.T) {
+		_, err := Load("./not-exists.yaml")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGetSeverity(t *testing.T) {
+	table := &Table{
+		Severities: map[string]severity.Severity{"error": severity.High},
+		Default:    severity.Low,
+		Rules:      map[string]Rule{"R1": {Severity: severity.Medium}, "R2": {CWE: "CWE-79"}},
+	}
+
+	t.Run("Should return the severity of the rule before the severity of the tool", func(t *testing.T) {
+		assert.Equal(t, severity.Medium, table.GetSeverity("R1", "ERROR"))
+	})
+	t.Run("Should return the severity of the tool when the rule has no severity", func(t *testing.T) {
+		assert.Equal(t, severity.High, table.GetSeverity("R2", "ERROR"))
+	})
+	t.Run("Should return the default when the severity is not in the table", func(t *testing.T) {
+		assert.Equal(t, severity.Low, table.GetSeverity("", "WARNING"))
+	})
+	t.Run("Should return the default of an empty table of a tool without table", func(t *testing.T) {
+		assert.Equal(t, severity.Severity(""), Tables{}.Get(tools.GoSec).GetSeverity("G101", "HIGH"))
+	})
+}
+
+func TestSetRules(t *testing.T) {
+	t.Run("Should add the cwe and the owasp category of the rule to the details", func(t *testing.T) {
+		analysis := &horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{SecurityTool: tools.SecurityCodeScan, RuleID: "SCS0002",
+				Details: "SQL injection"}},
+			{Vulnerability: horusec.Vulnerability{SecurityTool: tools.SecurityCodeScan, RuleID: "UNKNOWN",
+				Details: "unknown"}},
+		}}
+
+		Default().SetRules(analysis)
+
+		assert.Equal(t, "SQL injection\nReferences: CWE-89, OWASP A03:2021",
+			analysis.AnalysisVulnerabilities[0].Vulnerability.Details)
+		assert.Equal(t, "unknown", analysis.AnalysisVulnerabilities[1].Vulnerability.Details)
+	})
+	t.Run("Should not add the cwe already in the details", func(t *testing.T) {
+		analysis := &horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{SecurityTool: tools.Bandit, RuleID: "B608",
+				Details: "CWE-89 SQL injection"}},
+		}}
+
+		Default().SetRules(analysis)
+
+		assert.Equal(t, "CWE-89 SQL injection\nReferences: OWASP A03:2021",
+			analysis.AnalysisVulnerabilities[0].Vulnerability.Details)
+	})
+	t.Run("Should set the severity of the rule of the tools without table in the formatter", func(t *testing.T) {
+		analysis := &horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{SecurityTool: tools.GoSec, RuleID: "G101", Severity: severity.High}},
+		}}
+		tables, err := Load(writeTablesFile(t, "GoSec:\n  rules:\n    G101:\n      severity: LOW\n"))
+		assert.NoError(t, err)
+
+		tables.SetRules(analysis)
+
+		assert.Equal(t, severity.Low, analysis.AnalysisVulnerabilities[0].Vulnerability.Severity)
+		assert.Equal(t, "", analysis.AnalysisVulnerabilities[0].Vulnerability.Details)
+	})
+}
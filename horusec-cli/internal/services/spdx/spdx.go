@@ -0,0 +1,240 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/spdx"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/version"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/dependencysubmission"
+)
+
+const (
+	Context         = "https://spdx.org/rdf/3.0.1/spdx-context.jsonld"
+	SpecVersion     = "3.0.1"
+	DataLicense     = "https://spdx.org/licenses/CC0-1.0"
+	NamespacePrefix = "https://horusec.io/spdx/"
+	DefaultProject  = "project"
+	creationInfoID  = "_:creationinfo"
+)
+
+var cvePattern = regexp.MustCompile(`CVE-\d{4}-\d{4,}`)
+
+type Interface interface {
+	ConvertVulnerabilityDataToSPDX() spdx.Document
+}
+
+type SPDX struct {
+	analysis  *horusecEntities.Analysis
+	namespace string
+	elements  []spdx.Element
+	ids       map[string]string
+}
+
+func NewSPDX(analysis *horusecEntities.Analysis) Interface {
+	return &SPDX{
+		analysis:  analysis,
+		namespace: NamespacePrefix + analysis.ID.String() + "/",
+	}
+}
+
+// ConvertVulnerabilityDataToSPDX writes the project as the root package of the sbom, the dependencies and the source
+// files of the vulnerabilities as its packages and files, and each vulnerability with a vex assessment by its type
+func (s *SPDX) ConvertVulnerabilityDataToSPDX() spdx.Document {
+	s.elements = []spdx.Element{}
+	s.ids = map[string]string{}
+	project := s.addProjectElements()
+	for index := range s.analysis.AnalysisVulnerabilities {
+		s.addVulnerability(index, &s.analysis.AnalysisVulnerabilities[index].Vulnerability, project)
+	}
+	return s.newDocument(project)
+}
+
+func (s *SPDX) addProjectElements() string {
+	name := s.analysis.RepositoryName
+	if name == "" {
+		name = DefaultProject
+	}
+	s.elements = append(s.elements,
+		spdx.Element{Type: "Organization", SpdxID: s.namespace + "agent-horusec", CreationInfo: creationInfoID,
+			Name: "ZupIT"},
+		spdx.Element{Type: "Tool", SpdxID: s.namespace + "tool-horusec", CreationInfo: creationInfoID,
+			Name: "horusec-" + version.Version},
+		spdx.Element{Type: "software_Package", SpdxID: s.namespace + "project", CreationInfo: creationInfoID,
+			Name: name})
+	return s.namespace + "project"
+}
+
+func (s *SPDX) newDocument(project string) spdx.Document {
+	graph := []spdx.Element{{
+		Type: "CreationInfo", ID: creationInfoID, SpecVersion: SpecVersion, Created: s.getCreated(),
+		CreatedBy: []string{s.namespace + "agent-horusec"}, CreatedUsing: []string{s.namespace + "tool-horusec"},
+	}}
+	elementIDs := []string{}
+	for index := range s.elements {
+		elementIDs = append(elementIDs, s.elements[index].SpdxID)
+	}
+	graph = append(graph,
+		spdx.Element{Type: "SpdxDocument", SpdxID: s.namespace + "document", CreationInfo: creationInfoID,
+			Name: "horusec-" + s.analysis.ID.String(), DataLicense: DataLicense,
+			ProfileConformance: []string{"core", "software", "security"}, RootElement: []string{s.namespace + "sbom"},
+			Element: append([]string{s.namespace + "sbom"}, elementIDs...)},
+		spdx.Element{Type: "software_Sbom", SpdxID: s.namespace + "sbom", CreationInfo: creationInfoID,
+			SbomType: []string{"analyzed"}, RootElement: []string{project}, Element: elementIDs})
+	return spdx.Document{Context: Context, Graph: append(graph, s.elements...)}
+}
+
+func (s *SPDX) getCreated() string {
+	date := s.analysis.FinishedAt
+	if date.IsZero() {
+		date = s.analysis.CreatedAt
+	}
+	if date.IsZero() {
+		date = time.Now()
+	}
+	return date.UTC().Format(time.RFC3339)
+}
+
+func (s *SPDX) addVulnerability(index int, vulnerability *horusecEntities.Vulnerability, project string) {
+	affected := s.getAffectedElement(vulnerability, project)
+	vulnerabilityID := fmt.Sprintf("%svulnerability-%d", s.namespace, index+1)
+	s.elements = append(s.elements, spdx.Element{
+		Type: "security_Vulnerability", SpdxID: vulnerabilityID, CreationInfo: creationInfoID,
+		Name: s.getName(vulnerability), Description: vulnerability.Details,
+		Comment:            s.getComment(vulnerability),
+		ExternalIdentifier: s.getExternalIdentifiers(vulnerability),
+	}, spdx.Element{
+		Type: "Relationship", SpdxID: vulnerabilityID + "-associated", CreationInfo: creationInfoID,
+		From: affected, RelationshipType: "hasAssociatedVulnerability", To: []string{vulnerabilityID},
+	}, s.newAssessment(vulnerability, vulnerabilityID, affected))
+}
+
+// getAffectedElement returns the package of the dependency, the file of the vulnerability or the project, adding
+// the package and the file only once in the sbom
+func (s *SPDX) getAffectedElement(vulnerability *horusecEntities.Vulnerability, project string) string {
+	key, element := s.newAffectedElement(vulnerability)
+	if element == nil {
+		return project
+	}
+	if spdxID, ok := s.ids[key]; ok {
+		return spdxID
+	}
+	kind := strings.ToLower(strings.TrimPrefix(element.Type, "software_"))
+	element.SpdxID = fmt.Sprintf("%s%s-%d", s.namespace, kind, len(s.ids)+1)
+	element.CreationInfo = creationInfoID
+	s.ids[key] = element.SpdxID
+	relationshipType := "contains"
+	if element.Type == "software_Package" {
+		relationshipType = "dependsOn"
+	}
+	s.elements = append(s.elements, *element, spdx.Element{
+		Type: "Relationship", SpdxID: element.SpdxID + "-relationship", CreationInfo: creationInfoID,
+		From: project, RelationshipType: relationshipType, To: []string{element.SpdxID},
+	})
+	return element.SpdxID
+}
+
+func (s *SPDX) newAffectedElement(vulnerability *horusecEntities.Vulnerability) (string, *spdx.Element) {
+	if vulnerability.Dependency != nil && vulnerability.Dependency.Name != "" {
+		purl := dependencysubmission.GetPackageURL(vulnerability)
+		key := purl
+		if key == "" {
+			key = fmt.Sprintf("dependency:%s@%s", vulnerability.Dependency.Name, vulnerability.Dependency.Version)
+		}
+		return key, &spdx.Element{Type: "software_Package", Name: vulnerability.Dependency.Name,
+			PackageVersion: vulnerability.Dependency.Version, PackageURL: purl}
+	}
+	if vulnerability.File != "" {
+		return "file:" + vulnerability.File, &spdx.Element{Type: "software_File", Name: vulnerability.File}
+	}
+	return "", nil
+}
+
+func (s *SPDX) getName(vulnerability *horusecEntities.Vulnerability) string {
+	if cve := cvePattern.FindString(vulnerability.Details); cve != "" {
+		return cve
+	}
+	if vulnerability.RuleID != "" {
+		return vulnerability.RuleID
+	}
+	return vulnerability.VulnHash
+}
+
+// getComment keeps the severity and the location of the vulnerability, the security profile has no severity
+// without a cvss score
+func (s *SPDX) getComment(vulnerability *horusecEntities.Vulnerability) string {
+	comment := fmt.Sprintf("Severity: %s. Tool: %s.", vulnerability.Severity, vulnerability.SecurityTool)
+	if vulnerability.File != "" && vulnerability.Line != "" {
+		comment += fmt.Sprintf(" Location: %s:%s.", vulnerability.File, vulnerability.Line)
+	}
+	return comment
+}
+
+func (s *SPDX) getExternalIdentifiers(vulnerability *horusecEntities.Vulnerability) []spdx.ExternalIdentifier {
+	identifiers := []spdx.ExternalIdentifier{{Type: "ExternalIdentifier", ExternalIdentifierType: "other",
+		Identifier: vulnerability.VulnHash}}
+	if cve := cvePattern.FindString(vulnerability.Details); cve != "" {
+		identifiers = append(identifiers, spdx.ExternalIdentifier{Type: "ExternalIdentifier",
+			ExternalIdentifierType: "cve", Identifier: cve,
+			IdentifierLocator: []string{"https://nvd.nist.gov/vuln/detail/" + cve}})
+	}
+	return identifiers
+}
+
+// newAssessment returns the vex assessment of the vulnerability, the false positives don't affect the element, the
+// corrected are fixed in it and the others affect it
+func (s *SPDX) newAssessment(vulnerability *horusecEntities.Vulnerability, vulnerabilityID,
+	affected string) spdx.Element {
+	assessment := spdx.Element{SpdxID: vulnerabilityID + "-assessment", CreationInfo: creationInfoID,
+		From: vulnerabilityID, To: []string{affected}}
+	switch vulnerability.Type {
+	case enumHorusec.FalsePositive:
+		assessment.Type = "security_VexNotAffectedVulnAssessmentRelationship"
+		assessment.RelationshipType = "doesNotAffect"
+		assessment.ImpactStatement = s.getJustification(vulnerability, "False positive")
+	case enumHorusec.Corrected:
+		assessment.Type = "security_VexFixedVulnAssessmentRelationship"
+		assessment.RelationshipType = "fixedIn"
+	default:
+		assessment.Type = "security_VexAffectedVulnAssessmentRelationship"
+		assessment.RelationshipType = "affects"
+		assessment.ActionStatement = s.getActionStatement(vulnerability)
+	}
+	return assessment
+}
+
+func (s *SPDX) getActionStatement(vulnerability *horusecEntities.Vulnerability) string {
+	if vulnerability.Type == enumHorusec.RiskAccepted {
+		return s.getJustification(vulnerability, "Risk accepted")
+	}
+	if vulnerability.Dependency != nil && vulnerability.Dependency.FixedVersion != "" {
+		return fmt.Sprintf("Upgrade %s to the version %s", vulnerability.Dependency.Name,
+			vulnerability.Dependency.FixedVersion)
+	}
+	return "Review and fix the vulnerability"
+}
+
+func (s *SPDX) getJustification(vulnerability *horusecEntities.Vulnerability, defaultJustification string) string {
+	if vulnerability.Triage != nil && vulnerability.Triage.Justification != "" {
+		return defaultJustification + ": " + vulnerability.Triage.Justification
+	}
+	return defaultJustification
+}
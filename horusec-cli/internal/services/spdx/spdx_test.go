@@ -0,0 +1,128 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/spdx"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysis() *horusec.Analysis {
+	return &horusec.Analysis{
+		ID:             uuid.New(),
+		RepositoryName: "my-repository",
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{
+				Vulnerability: horusec.Vulnerability{
+					Line:         "10",
+					File:         "main.go",
+					Details:      "Command injection",
+					Severity:     severity.High,
+					SecurityTool: tools.GoSec,
+					RuleID:       "G204",
+					VulnHash:     "hash-1",
+					Type:         enumHorusec.Vulnerability,
+				},
+			},
+			{
+				Vulnerability: horusec.Vulnerability{
+					File:         "package-lock.json",
+					Details:      "Prototype pollution CVE-2021-23337",
+					Severity:     severity.Critical,
+					SecurityTool: tools.NpmAudit,
+					VulnHash:     "hash-2",
+					Type:         enumHorusec.Vulnerability,
+					Dependency:   &horusec.Dependency{Name: "lodash", Version: "4.17.15", FixedVersion: "4.17.21"},
+				},
+			},
+			{
+				Vulnerability: horusec.Vulnerability{
+					File:         "package-lock.json",
+					Details:      "Regular expression denial of service",
+					Severity:     severity.Low,
+					SecurityTool: tools.NpmAudit,
+					VulnHash:     "hash-3",
+					Type:         enumHorusec.FalsePositive,
+					Dependency:   &horusec.Dependency{Name: "lodash", Version: "4.17.15"},
+					Triage:       &horusec.Triage{Justification: "not used in production"},
+				},
+			},
+		},
+	}
+}
+
+func getElements(document spdx.Document, elementType string) (elements []spdx.Element) {
+	for _, element := range document.Graph {
+		if element.Type == elementType {
+			elements = append(elements, element)
+		}
+	}
+	return elements
+}
+
+func TestConvertVulnerabilityDataToSPDX(t *testing.T) {
+	t.Run("should write the document with the sbom of the project", func(t *testing.T) {
+		analysis := newAnalysis()
+		document := NewSPDX(analysis).ConvertVulnerabilityDataToSPDX()
+
+		assert.Equal(t, Context, document.Context)
+		assert.Equal(t, SpecVersion, document.Graph[0].SpecVersion)
+		assert.Equal(t, []string{"core", "software", "security"},
+			getElements(document, "SpdxDocument")[0].ProfileConformance)
+		sbom := getElements(document, "software_Sbom")[0]
+		assert.Equal(t, []string{NamespacePrefix + analysis.ID.String() + "/project"}, sbom.RootElement)
+		assert.Len(t, getElements(document, "security_Vulnerability"), 3)
+	})
+	t.Run("should add each dependency and file once with its purl", func(t *testing.T) {
+		document := NewSPDX(newAnalysis()).ConvertVulnerabilityDataToSPDX()
+
+		packages := getElements(document, "software_Package")
+		assert.Len(t, packages, 2)
+		assert.Equal(t, "pkg:npm/lodash@4.17.15", packages[1].PackageURL)
+		assert.Len(t, getElements(document, "software_File"), 1)
+	})
+	t.Run("should link the vulnerabilities with the cve and the vex assessments", func(t *testing.T) {
+		document := NewSPDX(newAnalysis()).ConvertVulnerabilityDataToSPDX()
+
+		vulnerabilities := getElements(document, "security_Vulnerability")
+		assert.Equal(t, "G204", vulnerabilities[0].Name)
+		assert.Contains(t, vulnerabilities[0].Comment, "Location: main.go:10.")
+		assert.Equal(t, "CVE-2021-23337", vulnerabilities[1].Name)
+		assert.Equal(t, "cve", vulnerabilities[1].ExternalIdentifier[1].ExternalIdentifierType)
+		affected := getElements(document, "security_VexAffectedVulnAssessmentRelationship")
+		assert.Len(t, affected, 2)
+		assert.Equal(t, "Upgrade lodash to the version 4.17.21", affected[1].ActionStatement)
+		notAffected := getElements(document, "security_VexNotAffectedVulnAssessmentRelationship")
+		assert.Equal(t, "False positive: not used in production", notAffected[0].ImpactStatement)
+		assert.Equal(t, affected[1].To, notAffected[0].To)
+	})
+	t.Run("should associate the vulnerability without file to the project", func(t *testing.T) {
+		analysis := &horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{Severity: severity.Audit, VulnHash: "hash", Type: enumHorusec.Corrected}},
+		}}
+		document := NewSPDX(analysis).ConvertVulnerabilityDataToSPDX()
+
+		fixed := getElements(document, "security_VexFixedVulnAssessmentRelationship")
+		assert.Equal(t, []string{NamespacePrefix + analysis.ID.String() + "/project"}, fixed[0].To)
+		assert.Equal(t, DefaultProject, getElements(document, "software_Package")[0].Name)
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/env"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/version"
+)
+
+const (
+	DefaultURL = "https://telemetry.horusec.io/v1/cli/analysis"
+	// EnvTelemetryURL changes where the telemetry is sent, used to test and by companies proxying it
+	EnvTelemetryURL = "HORUSEC_CLI_TELEMETRY_URL"
+	requestTimeout  = 5
+)
+
+type Interface interface {
+	SendAnalysis(analysis *horusec.Analysis, langs []languages.Language, toolsFailed []tools.Tool)
+}
+
+// Event has only aggregate data of the analysis, nothing that identifies the user, the project or its code
+type Event struct {
+	Version           string   `json:"version"`
+	OS                string   `json:"os"`
+	Arch              string   `json:"arch"`
+	Languages         []string `json:"languages"`
+	DurationInSeconds int64    `json:"durationInSeconds"`
+	ToolsFailed       []string `json:"toolsFailed"`
+}
+
+type Telemetry struct {
+	config     cliConfig.IConfig
+	httpClient client.Interface
+	url        string
+}
+
+func NewTelemetry(config cliConfig.IConfig) Interface {
+	return &Telemetry{
+		config:     config,
+		httpClient: client.NewHTTPClient(requestTimeout),
+		url:        env.GetEnvOrDefault(EnvTelemetryURL, DefaultURL),
+	}
+}
+
+// SendAnalysis does nothing unless the telemetry was enabled. Errors are only logged, the telemetry never
+// changes the result of the analysis
+func (t *Telemetry) SendAnalysis(analysis *horusec.Analysis, langs []languages.Language, toolsFailed []tools.Tool) {
+	if !t.config.GetEnableTelemetry() {
+		return
+	}
+
+	content, err := json.Marshal(t.newEvent(analysis, langs, toolsFailed))
+	if err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugSendTelemetryFailed, logger.DebugLevel, err)
+		return
+	}
+
+	logger.LogDebugWithLevel(messages.MsgDebugSendTelemetry+string(content), logger.DebugLevel)
+	if err := t.send(content); err != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugSendTelemetryFailed, logger.DebugLevel, err)
+	}
+}
+
+func (t *Telemetry) newEvent(analysis *horusec.Analysis, langs []languages.Language,
+	toolsFailed []tools.Tool) *Event {
+	event := &Event{
+		Version:           version.Version,
+		OS:                runtime.GOOS,
+		Arch:              runtime.GOARCH,
+		Languages:         []string{},
+		DurationInSeconds: int64(analysis.FinishedAt.Sub(analysis.CreatedAt).Seconds()),
+		ToolsFailed:       []string{},
+	}
+	for _, language := range langs {
+		event.Languages = append(event.Languages, language.ToString())
+	}
+	for _, tool := range toolsFailed {
+		event.ToolsFailed = append(event.ToolsFailed, tool.ToString())
+	}
+	return event
+}
+
+func (t *Telemetry) send(content []byte) error {
+	req, err := http.NewRequest(http.MethodPost, t.url, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	response, err := t.httpClient.DoRequest(req, nil)
+	if err != nil {
+		return err
+	}
+	defer response.CloseBody()
+
+	if response.GetStatusCode() < http.StatusOK || response.GetStatusCode() >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d", response.GetStatusCode())
+	}
+	return nil
+}
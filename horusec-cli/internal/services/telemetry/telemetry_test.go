@@ -0,0 +1,91 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/http-request/client"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newFakeServer(events *[]Event) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		event := Event{}
+		_ = json.Unmarshal(body, &event)
+		*events = append(*events, event)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+func TestTelemetry_SendAnalysis(t *testing.T) {
+	analysis := &horusec.Analysis{CreatedAt: time.Now().Add(-90 * time.Second), FinishedAt: time.Now()}
+
+	t.Run("Should send only aggregate data when telemetry is enabled", func(t *testing.T) {
+		var events []Event
+		server := newFakeServer(&events)
+		defer server.Close()
+		configs := &config.Config{}
+		configs.SetEnableTelemetry(true)
+
+		service := &Telemetry{config: configs, httpClient: client.NewHTTPClient(requestTimeout), url: server.URL}
+		service.SendAnalysis(analysis, []languages.Language{languages.Go, languages.Leaks}, []tools.Tool{tools.GoSec})
+
+		assert.Len(t, events, 1)
+		assert.Equal(t, runtime.GOOS, events[0].OS)
+		assert.Equal(t, runtime.GOARCH, events[0].Arch)
+		assert.Equal(t, []string{"Go", "Leaks"}, events[0].Languages)
+		assert.Equal(t, []string{"GoSec"}, events[0].ToolsFailed)
+		assert.Equal(t, int64(90), events[0].DurationInSeconds)
+	})
+
+	t.Run("Should not send anything when telemetry is not enabled", func(t *testing.T) {
+		var events []Event
+		server := newFakeServer(&events)
+		defer server.Close()
+
+		service := &Telemetry{config: &config.Config{}, httpClient: client.NewHTTPClient(requestTimeout), url: server.URL}
+		service.SendAnalysis(analysis, []languages.Language{languages.Go}, nil)
+
+		assert.Empty(t, events)
+	})
+
+	t.Run("Should not panic when the telemetry server is not available", func(t *testing.T) {
+		configs := &config.Config{}
+		configs.SetEnableTelemetry(true)
+
+		service := &Telemetry{config: configs, httpClient: client.NewHTTPClient(1), url: "http://127.0.0.1:1"}
+		assert.NotPanics(t, func() {
+			service.SendAnalysis(analysis, nil, nil)
+		})
+	})
+}
+
+func TestNewTelemetry(t *testing.T) {
+	t.Run("Should use the default url", func(t *testing.T) {
+		assert.Equal(t, DefaultURL, NewTelemetry(&config.Config{}).(*Telemetry).url)
+	})
+}
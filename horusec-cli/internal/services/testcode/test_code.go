@@ -0,0 +1,82 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testcode
+
+import (
+	"path/filepath"
+
+	"github.com/bmatcuk/doublestar/v2"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+type Interface interface {
+	SetTestCode(analysis *horusec.Analysis)
+}
+
+type TestCode struct {
+	config cliConfig.IConfig
+}
+
+func NewTestCode(config cliConfig.IConfig) Interface {
+	return &TestCode{
+		config: config,
+	}
+}
+
+// SetTestCode flags the vulnerabilities found in tests, examples and fixtures, they are kept in the analysis and in
+// the downgrade mode their severity is lowered to LOW
+func (t *TestCode) SetTestCode(analysis *horusec.Analysis) {
+	patterns := append(cli.GetDefaultTestCodePatterns(), t.config.GetTestCodePaths()...)
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		if !IsTestCodeFile(vulnerability.File, patterns) {
+			continue
+		}
+		vulnerability.IsTestCode = true
+		if t.config.GetTestCodeMode() == cli.TestCodeDowngrade.ToString() && isToDowngrade(vulnerability.Severity) {
+			vulnerability.Severity = severity.Low
+		}
+	}
+}
+
+// IsTestCodeFile uses the path relative to the project with slashes, like in the patterns of the files to ignore
+func IsTestCodeFile(file string, patterns []string) bool {
+	if file == "" {
+		return false
+	}
+	file = filepath.ToSlash(file)
+	for _, pattern := range patterns {
+		if matched, err := doublestar.Match(pattern, file); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExcludedFromGates returns if the vulnerability must not be counted to fail the analysis, the test code
+// excluded from gates, the vulnerabilities of the tools warn only and, when enabled, the ones with open tickets
+func IsExcludedFromGates(config cliConfig.IConfig, vulnerability *horusec.Vulnerability) bool {
+	return vulnerability.IsWarnOnly ||
+		(vulnerability.IsTestCode && config.GetTestCodeMode() == cli.TestCodeExcludeFromGates.ToString()) ||
+		(vulnerability.Ticket != nil && vulnerability.Ticket.IsOpen() && config.GetTicketsExcludeFromGates())
+}
+
+func isToDowngrade(vulnSeverity severity.Severity) bool {
+	return vulnSeverity == severity.Critical || vulnSeverity == severity.High || vulnSeverity == severity.Medium
+}
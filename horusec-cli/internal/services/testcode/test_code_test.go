@@ -0,0 +1,108 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testcode
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+func newAnalysisToTest() *horusec.Analysis {
+	return &horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+		{Vulnerability: horusec.Vulnerability{File: "api/server_test.go", Severity: severity.High}},
+		{Vulnerability: horusec.Vulnerability{File: "examples/main.go", Severity: severity.Medium}},
+		{Vulnerability: horusec.Vulnerability{File: "qa/login.js", Severity: severity.Critical}},
+		{Vulnerability: horusec.Vulnerability{File: "api/server.go", Severity: severity.High}},
+	}}
+}
+
+func TestSetTestCode(t *testing.T) {
+	t.Run("should flag the test code and keep the severities", func(t *testing.T) {
+		analysis := newAnalysisToTest()
+
+		NewTestCode(&cliConfig.Config{}).SetTestCode(analysis)
+
+		assert.True(t, analysis.AnalysisVulnerabilities[0].Vulnerability.IsTestCode)
+		assert.True(t, analysis.AnalysisVulnerabilities[1].Vulnerability.IsTestCode)
+		assert.False(t, analysis.AnalysisVulnerabilities[2].Vulnerability.IsTestCode)
+		assert.False(t, analysis.AnalysisVulnerabilities[3].Vulnerability.IsTestCode)
+		assert.Equal(t, severity.High, analysis.AnalysisVulnerabilities[0].Vulnerability.Severity)
+	})
+
+	t.Run("should downgrade the test code and use the paths of the config", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetTestCodeMode(cli.TestCodeDowngrade.ToString())
+		config.SetTestCodePaths([]string{"qa/**"})
+		analysis := newAnalysisToTest()
+
+		NewTestCode(config).SetTestCode(analysis)
+
+		assert.Equal(t, severity.Low, analysis.AnalysisVulnerabilities[0].Vulnerability.Severity)
+		assert.Equal(t, severity.Low, analysis.AnalysisVulnerabilities[1].Vulnerability.Severity)
+		assert.Equal(t, severity.Low, analysis.AnalysisVulnerabilities[2].Vulnerability.Severity)
+		assert.Equal(t, severity.High, analysis.AnalysisVulnerabilities[3].Vulnerability.Severity)
+	})
+}
+
+func TestIsTestCodeFile(t *testing.T) {
+	t.Run("should match the default patterns", func(t *testing.T) {
+		patterns := cli.GetDefaultTestCodePatterns()
+
+		assert.True(t, IsTestCodeFile("main_test.go", patterns))
+		assert.True(t, IsTestCodeFile("src/app.spec.ts", patterns))
+		assert.True(t, IsTestCodeFile("app/test_views.py", patterns))
+		assert.True(t, IsTestCodeFile("src/main/UserServiceTest.java", patterns))
+		assert.True(t, IsTestCodeFile("spec/models/user_spec.rb", patterns))
+		assert.True(t, IsTestCodeFile("pkg/testdata/key.pem", patterns))
+		assert.True(t, IsTestCodeFile("__fixtures__/token.json", patterns))
+		assert.False(t, IsTestCodeFile("src/latest.go", patterns))
+		assert.False(t, IsTestCodeFile("", patterns))
+	})
+}
+
+func TestIsExcludedFromGates(t *testing.T) {
+	t.Run("should exclude only the test code in the mode exclude-from-gates", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		vulnerability := &horusec.Vulnerability{IsTestCode: true}
+
+		assert.False(t, IsExcludedFromGates(config, vulnerability))
+
+		config.SetTestCodeMode(cli.TestCodeExcludeFromGates.ToString())
+		assert.True(t, IsExcludedFromGates(config, vulnerability))
+		assert.False(t, IsExcludedFromGates(config, &horusec.Vulnerability{}))
+	})
+
+	t.Run("should exclude the vulnerabilities of the tools warn only", func(t *testing.T) {
+		assert.True(t, IsExcludedFromGates(&cliConfig.Config{}, &horusec.Vulnerability{IsWarnOnly: true}))
+	})
+
+	t.Run("should exclude the vulnerabilities with open tickets when enabled", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		openTicket := &horusec.Vulnerability{Ticket: &horusec.Ticket{URL: "https://jira/SEC-1", Status: "In Progress"}}
+		closedTicket := &horusec.Vulnerability{Ticket: &horusec.Ticket{URL: "https://jira/SEC-2", Status: "Done"}}
+
+		assert.False(t, IsExcludedFromGates(config, openTicket))
+
+		config.SetTicketsExcludeFromGates(true)
+		assert.True(t, IsExcludedFromGates(config, openTicket))
+		assert.False(t, IsExcludedFromGates(config, closedTicket))
+	})
+}
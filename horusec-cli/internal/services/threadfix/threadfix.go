@@ -0,0 +1,195 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package threadfix
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	horusecSeverity "github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/threadfix"
+)
+
+const (
+	Source         = "Horusec"
+	CollectionType = "MIXED"
+)
+
+// DefaultFieldMapping are the fields of the findings that can be mapped, with the field of the json output of the
+// vulnerability used when the field mapping doesn't inform it
+var DefaultFieldMapping = map[string]string{
+	"nativeId":              "vulnHash",
+	"nativeSeverity":        "severity",
+	"summary":               "details",
+	"description":           "details",
+	"scannerDetail":         "securityTool",
+	"scannerRecommendation": "",
+}
+
+// vulnerabilityFields are the fields of the json output of the vulnerability that can be used in the field mapping
+var vulnerabilityFields = map[string]bool{
+	"vulnerabilityID": true, "line": true, "column": true, "confidence": true, "file": true, "code": true,
+	"details": true, "securityTool": true, "language": true, "severity": true, "vulnHash": true, "type": true,
+	"commitAuthor": true, "commitEmail": true, "commitHash": true, "commitMessage": true, "commitDate": true,
+	"ruleID": true, "toolSeverity": true, "fingerprint": true,
+}
+
+var cwePattern = regexp.MustCompile(`CWE-(\d+)`)
+
+type Interface interface {
+	ConvertVulnerabilityDataToThreadFix() threadfix.Report
+}
+
+type ThreadFix struct {
+	analysis     *horusecEntities.Analysis
+	fieldMapping map[string]string
+}
+
+// NewThreadFix uses the field mapping informed over the default one, the field mapping must be valid
+func NewThreadFix(analysis *horusecEntities.Analysis, fieldMapping map[string]string) Interface {
+	mapping := map[string]string{}
+	for field, vulnerabilityField := range DefaultFieldMapping {
+		mapping[field] = vulnerabilityField
+	}
+	for field, vulnerabilityField := range fieldMapping {
+		mapping[field] = vulnerabilityField
+	}
+	return &ThreadFix{
+		analysis:     analysis,
+		fieldMapping: mapping,
+	}
+}
+
+// IsValidFieldMapping checks if the field is a field of the findings and the vulnerability field is a field of the
+// json output of the vulnerability
+func IsValidFieldMapping(field, vulnerabilityField string) bool {
+	_, ok := DefaultFieldMapping[field]
+	return ok && vulnerabilityFields[vulnerabilityField]
+}
+
+// ConvertVulnerabilityDataToThreadFix skips the false positives and the risk accepted, the format has no status to
+// them and they would be imported as open findings
+func (t *ThreadFix) ConvertVulnerabilityDataToThreadFix() threadfix.Report {
+	report := threadfix.Report{
+		Created:        t.formatDate(t.analysis.CreatedAt),
+		Exported:       t.formatDate(t.analysis.FinishedAt),
+		CollectionType: CollectionType,
+		Source:         Source,
+		Findings:       []threadfix.Finding{},
+	}
+	for index := range t.analysis.AnalysisVulnerabilities {
+		vulnerability := &t.analysis.AnalysisVulnerabilities[index].Vulnerability
+		if vulnerability.Type == enumHorusec.FalsePositive || vulnerability.Type == enumHorusec.RiskAccepted {
+			continue
+		}
+		report.Findings = append(report.Findings, t.newFinding(vulnerability))
+	}
+	return report
+}
+
+func (t *ThreadFix) formatDate(date time.Time) string {
+	if date.IsZero() {
+		return ""
+	}
+	return date.UTC().Format(time.RFC3339)
+}
+
+func (t *ThreadFix) newFinding(vulnerability *horusecEntities.Vulnerability) threadfix.Finding {
+	fields := t.getVulnerabilityFields(vulnerability)
+	finding := threadfix.Finding{
+		NativeID:              fields[t.fieldMapping["nativeId"]],
+		Severity:              t.convertHorusecSeverityToThreadFix(vulnerability.Severity),
+		NativeSeverity:        fields[t.fieldMapping["nativeSeverity"]],
+		Summary:               strings.SplitN(fields[t.fieldMapping["summary"]], "\n", 2)[0],
+		Description:           fields[t.fieldMapping["description"]],
+		ScannerDetail:         fields[t.fieldMapping["scannerDetail"]],
+		ScannerRecommendation: fields[t.fieldMapping["scannerRecommendation"]],
+		CweID:                 t.getCweID(vulnerability.Details),
+		Metadata: map[string]string{
+			"language":   vulnerability.Language.ToString(),
+			"confidence": vulnerability.Confidence,
+			"type":       string(vulnerability.Type),
+		},
+	}
+	if vulnerability.Dependency != nil {
+		finding.DependencyDetails = &threadfix.DependencyDetails{Library: vulnerability.Dependency.Name,
+			Version: vulnerability.Dependency.Version}
+		return finding
+	}
+	finding.StaticDetails = t.newStaticDetails(vulnerability)
+	return finding
+}
+
+func (t *ThreadFix) newStaticDetails(vulnerability *horusecEntities.Vulnerability) *threadfix.StaticDetails {
+	line, _ := strconv.Atoi(vulnerability.Line)
+	column, _ := strconv.Atoi(vulnerability.Column)
+	return &threadfix.StaticDetails{DataFlowElements: []threadfix.DataFlowElement{{
+		File:         vulnerability.File,
+		LineNumber:   line,
+		ColumnNumber: column,
+		LineText:     vulnerability.Code,
+		Sequence:     1,
+	}}}
+}
+
+// getVulnerabilityFields returns the fields of the json output of the vulnerability as text
+func (t *ThreadFix) getVulnerabilityFields(vulnerability *horusecEntities.Vulnerability) map[string]string {
+	fields := map[string]string{}
+	content, err := json.Marshal(vulnerability)
+	if err != nil {
+		return fields
+	}
+	values := map[string]interface{}{}
+	_ = json.Unmarshal(content, &values)
+	for field, value := range values {
+		if vulnerabilityFields[field] && value != nil {
+			fields[field] = fmt.Sprint(value)
+		}
+	}
+	return fields
+}
+
+// getCweID returns the first CWE of the details, like the ones of the references of the severity tables
+func (t *ThreadFix) getCweID(details string) int {
+	match := cwePattern.FindStringSubmatch(details)
+	if match == nil {
+		return 0
+	}
+	cweID, _ := strconv.Atoi(match[1])
+	return cweID
+}
+
+// convertHorusecSeverityToThreadFix uses Info to the severities without a ThreadFix severity, like AUDIT and NOSEC
+func (t *ThreadFix) convertHorusecSeverityToThreadFix(severity horusecSeverity.Severity) string {
+	if threadFixSeverity, ok := t.getThreadFixSeverityMap()[severity]; ok {
+		return threadFixSeverity
+	}
+	return "Info"
+}
+
+func (t *ThreadFix) getThreadFixSeverityMap() map[horusecSeverity.Severity]string {
+	return map[horusecSeverity.Severity]string{
+		horusecSeverity.Critical: "Critical",
+		horusecSeverity.High:     "High",
+		horusecSeverity.Medium:   "Medium",
+		horusecSeverity.Low:      "Low",
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package threadfix
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysis() *horusec.Analysis {
+	return &horusec.Analysis{
+		ID:        uuid.New(),
+		CreatedAt: time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC),
+		Status:    enumHorusec.Success,
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{
+				Vulnerability: horusec.Vulnerability{
+					Line:         "10",
+					Column:       "2",
+					File:         "main.go",
+					Code:         "exec.Command(input)",
+					Details:      "Command injection\nCWE-78: Improper Neutralization",
+					Severity:     severity.High,
+					SecurityTool: tools.GoSec,
+					RuleID:       "G204",
+					VulnHash:     "hash-1",
+					Type:         enumHorusec.Vulnerability,
+				},
+			},
+			{
+				Vulnerability: horusec.Vulnerability{
+					Details:  "False positive",
+					Severity: severity.Low,
+					Type:     enumHorusec.FalsePositive,
+				},
+			},
+		},
+	}
+}
+
+func TestConvertVulnerabilityDataToThreadFix(t *testing.T) {
+	t.Run("should convert the vulnerabilities with the default field mapping", func(t *testing.T) {
+		report := NewThreadFix(newAnalysis(), nil).ConvertVulnerabilityDataToThreadFix()
+
+		assert.Equal(t, "2021-01-02T03:04:05Z", report.Created)
+		assert.Len(t, report.Findings, 1)
+		finding := report.Findings[0]
+		assert.Equal(t, "hash-1", finding.NativeID)
+		assert.Equal(t, "High", finding.Severity)
+		assert.Equal(t, "Command injection", finding.Summary)
+		assert.Equal(t, tools.GoSec.ToString(), finding.ScannerDetail)
+		assert.Equal(t, 78, finding.CweID)
+		assert.Equal(t, 10, finding.StaticDetails.DataFlowElements[0].LineNumber)
+		assert.Nil(t, finding.DependencyDetails)
+	})
+	t.Run("should use the field mapping over the default one", func(t *testing.T) {
+		fieldMapping := map[string]string{"nativeId": "ruleID", "scannerRecommendation": "code"}
+		report := NewThreadFix(newAnalysis(), fieldMapping).ConvertVulnerabilityDataToThreadFix()
+
+		assert.Equal(t, "G204", report.Findings[0].NativeID)
+		assert.Equal(t, "exec.Command(input)", report.Findings[0].ScannerRecommendation)
+		assert.Equal(t, "Command injection\nCWE-78: Improper Neutralization", report.Findings[0].Description)
+	})
+	t.Run("should use info to the severities without a threadfix severity", func(t *testing.T) {
+		analysis := newAnalysis()
+		analysis.AnalysisVulnerabilities[0].Vulnerability.Severity = severity.Audit
+
+		report := NewThreadFix(analysis, nil).ConvertVulnerabilityDataToThreadFix()
+		assert.Equal(t, "Info", report.Findings[0].Severity)
+	})
+}
+
+func TestIsValidFieldMapping(t *testing.T) {
+	assert.True(t, IsValidFieldMapping("nativeId", "ruleID"))
+	assert.False(t, IsValidFieldMapping("unknown", "ruleID"))
+	assert.False(t, IsValidFieldMapping("nativeId", "unknown"))
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+// ErrTicketWithoutURL is returned when a ticket of the file has no url
+var ErrTicketWithoutURL = errors.New("the ticket of the vulnerability hash has no url")
+
+type Interface interface {
+	SetTickets(analysis *horusec.Analysis) error
+}
+
+type Tickets struct {
+	config cliConfig.IConfig
+}
+
+func NewTickets(config cliConfig.IConfig) Interface {
+	return &Tickets{config: config}
+}
+
+// SetTickets fills the ticket of the vulnerabilities found in the file and adds it to their details, so the ticket is
+// shown in all the outputs. It runs to the cached analysis too, the status of the tickets changes without changing the
+// project
+func (t *Tickets) SetTickets(analysis *horusec.Analysis) error {
+	if t.config.GetTicketsPath() == "" {
+		return nil
+	}
+	tickets, err := LoadTickets(t.config.GetTicketsPath())
+	if err != nil {
+		return err
+	}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnerability := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		if ticket, ok := tickets[vulnerability.VulnHash]; ok && vulnerability.VulnHash != "" {
+			vulnerability.Ticket = &ticket
+			vulnerability.Details += "\n" + ticket.ToString()
+		}
+	}
+	return nil
+}
+
+// LoadTickets reads the yaml of the path informed, a map of the vulnerability hash to its ticket
+func LoadTickets(path string) (map[string]horusec.Ticket, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	tickets := map[string]horusec.Ticket{}
+	if err := yaml.Unmarshal(content, &tickets); err != nil {
+		return nil, err
+	}
+	for hash, ticket := range tickets {
+		if ticket.URL == "" {
+			return nil, fmt.Errorf("%w: %s", ErrTicketWithoutURL, hash)
+		}
+	}
+	return tickets, nil
+}
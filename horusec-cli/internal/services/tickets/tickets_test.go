@@ -0,0 +1,90 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tickets
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+)
+
+const ticketsToTest = `
+hash-1:
+  url: https://jira.company.com/browse/SEC-1
+  status: open
+hash-2:
+  url: https://github.com/company/project/issues/2
+`
+
+func TestTickets_SetTickets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tickets")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "tickets.yaml")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(ticketsToTest), 0600))
+
+	t.Run("Should fill the ticket of the vulnerabilities and add it to the details", func(t *testing.T) {
+		configs := cliConfig.NewConfig()
+		configs.SetTicketsPath(path)
+		analysis := &horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{VulnHash: "hash-1", Details: "details"}},
+			{Vulnerability: horusec.Vulnerability{VulnHash: "hash-2"}},
+			{Vulnerability: horusec.Vulnerability{VulnHash: "hash-3"}},
+		}}
+
+		assert.NoError(t, NewTickets(configs).SetTickets(analysis))
+		assert.Equal(t, "https://jira.company.com/browse/SEC-1",
+			analysis.AnalysisVulnerabilities[0].Vulnerability.Ticket.URL)
+		assert.Equal(t, "details\nTicket: https://jira.company.com/browse/SEC-1 (open)",
+			analysis.AnalysisVulnerabilities[0].Vulnerability.Details)
+		assert.True(t, analysis.AnalysisVulnerabilities[1].Vulnerability.Ticket.IsOpen())
+		assert.Nil(t, analysis.AnalysisVulnerabilities[2].Vulnerability.Ticket)
+	})
+
+	t.Run("Should not change the analysis without the tickets path", func(t *testing.T) {
+		analysis := &horusec.Analysis{AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{Vulnerability: horusec.Vulnerability{VulnHash: "hash-1"}},
+		}}
+
+		assert.NoError(t, NewTickets(cliConfig.NewConfig()).SetTickets(analysis))
+		assert.Nil(t, analysis.AnalysisVulnerabilities[0].Vulnerability.Ticket)
+	})
+}
+
+func TestLoadTickets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tickets")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	t.Run("Should return error when a ticket has no url", func(t *testing.T) {
+		path := filepath.Join(dir, "without-url.yaml")
+		assert.NoError(t, ioutil.WriteFile(path, []byte("hash-1:\n  status: open\n"), 0600))
+
+		_, err := LoadTickets(path)
+		assert.True(t, errors.Is(err, ErrTicketWithoutURL))
+	})
+
+	t.Run("Should return error when the file is not found", func(t *testing.T) {
+		_, err := LoadTickets(filepath.Join(dir, "not-found.yaml"))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,224 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workdirs
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+// HorusecFolder is the folder inside of the project where the project is copied to each analysis
+const HorusecFolder = ".horusec"
+
+type Interface interface {
+	Track(path string)
+	Untrack(path string)
+	RemoveOrphans() []string
+	Clean(projectPath string, maxAge time.Duration) ([]string, error)
+}
+
+type WorkDir struct {
+	Path      string    `json:"path"`
+	CreatedAt time.Time `json:"createdAt"`
+	PID       int       `json:"pid,omitempty"`
+}
+
+type WorkDirs struct {
+	mutex  sync.Mutex
+	config cliConfig.IConfig
+}
+
+// NewWorkDirs keeps the analysis folders created inside of .horusec in the state file until they are removed, so the
+// folders left by analyses that crashed are removed in the next analyses or with the command clean
+func NewWorkDirs(config cliConfig.IConfig) Interface {
+	return &WorkDirs{
+		config: config,
+	}
+}
+
+func (w *WorkDirs) Track(path string) {
+	w.update(func(workDirs []WorkDir) []WorkDir {
+		return append(w.removeInsideOf(workDirs, path), WorkDir{Path: w.getAbsPath(path), CreatedAt: time.Now(),
+			PID: os.Getpid()})
+	})
+}
+
+// Untrack removes from the state file the path and the folders inside of it, like the analyses of a .horusec folder
+func (w *WorkDirs) Untrack(path string) {
+	w.update(func(workDirs []WorkDir) []WorkDir {
+		return w.removeInsideOf(workDirs, path)
+	})
+}
+
+// RemoveOrphans removes the tracked folders older than the max age of the config and returns the folders removed.
+// The folders of analyses still running, by the pid of the owner, are kept and the folders that don't exist anymore
+// are only untracked
+func (w *WorkDirs) RemoveOrphans() (removed []string) {
+	maxAgeInHours := w.config.GetOrphanWorkDirsMaxAgeInHours()
+	if maxAgeInHours < 0 {
+		return nil
+	}
+	maxAge := time.Duration(maxAgeInHours) * time.Hour
+	w.update(func(workDirs []WorkDir) (kept []WorkDir) {
+		for _, workDir := range workDirs {
+			if time.Since(workDir.CreatedAt) < maxAge || workDir.isOwnerRunning() {
+				kept = append(kept, workDir)
+				continue
+			}
+			if w.remove(workDir.Path) {
+				removed = append(removed, workDir.Path)
+			}
+		}
+		return kept
+	})
+	return removed
+}
+
+// Clean removes the analysis folders older than the max age of all .horusec folders inside of the project path,
+// tracked or not, and returns the folders removed. The tracked folders of analyses still running are kept
+func (w *WorkDirs) Clean(projectPath string, maxAge time.Duration) (removed []string, err error) {
+	running := w.getRunning()
+	err = filepath.Walk(projectPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() || info.Name() != HorusecFolder {
+			return err
+		}
+		removed = append(removed, w.cleanHorusecFolder(path, maxAge, running)...)
+		return filepath.SkipDir
+	})
+	w.update(func(workDirs []WorkDir) []WorkDir {
+		for _, path := range removed {
+			workDirs = w.removeInsideOf(workDirs, path)
+		}
+		return workDirs
+	})
+	return removed, err
+}
+
+func (w *WorkDirs) cleanHorusecFolder(horusecFolder string, maxAge time.Duration,
+	running map[string]bool) (removed []string) {
+	entries, err := ioutil.ReadDir(horusecFolder)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorRemoveOrphanWorkDir, err, logger.ErrorLevel)
+		return nil
+	}
+	for _, entry := range entries {
+		path := filepath.Join(horusecFolder, entry.Name())
+		if time.Since(entry.ModTime()) >= maxAge && !running[w.getAbsPath(path)] && w.remove(path) {
+			removed = append(removed, w.getAbsPath(path))
+		}
+	}
+	if entries, err = ioutil.ReadDir(horusecFolder); err == nil && len(entries) == 0 {
+		_ = os.Remove(horusecFolder)
+	}
+	return removed
+}
+
+func (w *WorkDirs) getRunning() map[string]bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	running := map[string]bool{}
+	for _, workDir := range w.read() {
+		if workDir.isOwnerRunning() {
+			running[workDir.Path] = true
+		}
+	}
+	return running
+}
+
+// isOwnerRunning checks the pid of the analysis that tracked the folder, the folders tracked by older versions have no
+// pid and are removed only by age
+func (workDir WorkDir) isOwnerRunning() bool {
+	return workDir.PID > 0 && isProcessRunning(workDir.PID)
+}
+
+func (w *WorkDirs) remove(path string) bool {
+	if _, err := os.Lstat(path); os.IsNotExist(err) {
+		return false
+	}
+	if err := os.RemoveAll(path); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorRemoveOrphanWorkDir, err, logger.ErrorLevel)
+		return false
+	}
+	logger.LogDebugWithLevel(messages.MsgDebugOrphanWorkDirRemoved, logger.DebugLevel, path)
+	return true
+}
+
+func (w *WorkDirs) removeInsideOf(workDirs []WorkDir, path string) (kept []WorkDir) {
+	path = w.getAbsPath(path)
+	for _, workDir := range workDirs {
+		if workDir.Path != path && !strings.HasPrefix(workDir.Path, path+string(os.PathSeparator)) {
+			kept = append(kept, workDir)
+		}
+	}
+	return kept
+}
+
+func (w *WorkDirs) getAbsPath(path string) string {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	return absPath
+}
+
+// update reads and writes the state file in the same lock. The analyses running in parallel in other processes can
+// still write the state file at the same time, in this case the folders of one of them are removed only by clean
+func (w *WorkDirs) update(change func(workDirs []WorkDir) []WorkDir) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	workDirs := change(w.read())
+	if err := w.write(workDirs); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWorkDirsStateFile, err, logger.ErrorLevel)
+	}
+}
+
+func (w *WorkDirs) read() (workDirs []WorkDir) {
+	content, err := ioutil.ReadFile(w.config.GetWorkDirsStateFile())
+	if err != nil {
+		return nil
+	}
+	if err := json.Unmarshal(content, &workDirs); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWorkDirsStateFile, err, logger.ErrorLevel)
+	}
+	return workDirs
+}
+
+// write replaces the state file by rename, so a crash in the middle of the write doesn't leave it broken
+func (w *WorkDirs) write(workDirs []WorkDir) error {
+	path := w.config.GetWorkDirsStateFile()
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	if workDirs == nil {
+		workDirs = []WorkDir{}
+	}
+	content, err := json.MarshalIndent(workDirs, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path+".tmp", content, 0600); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
@@ -0,0 +1,45 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workdirs
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	utilsMock "github.com/ZupIT/horusec/development-kit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Track(path string) {
+	_ = m.MethodCalled("Track", path)
+}
+
+func (m *Mock) Untrack(path string) {
+	_ = m.MethodCalled("Untrack", path)
+}
+
+func (m *Mock) RemoveOrphans() []string {
+	args := m.MethodCalled("RemoveOrphans")
+	return args.Get(0).([]string)
+}
+
+func (m *Mock) Clean(projectPath string, maxAge time.Duration) ([]string, error) {
+	args := m.MethodCalled("Clean", projectPath, maxAge)
+	return args.Get(0).([]string), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,181 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workdirs
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newConfigWithStateFile(t *testing.T) (*cliConfig.Config, string) {
+	dir, err := ioutil.TempDir("", "horusec-work-dirs")
+	assert.NoError(t, err)
+	config := &cliConfig.Config{}
+	config.SetWorkDirsStateFile(filepath.Join(dir, "state", "work-dirs.json"))
+	return config, dir
+}
+
+func readStateFile(t *testing.T, config *cliConfig.Config) (workDirs []WorkDir) {
+	content, err := ioutil.ReadFile(config.GetWorkDirsStateFile())
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(content, &workDirs))
+	return workDirs
+}
+
+func writeStateFile(t *testing.T, config *cliConfig.Config, workDirs []WorkDir) {
+	content, err := json.Marshal(workDirs)
+	assert.NoError(t, err)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(config.GetWorkDirsStateFile()), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(config.GetWorkDirsStateFile(), content, 0600))
+}
+
+func TestTrackAndUntrack(t *testing.T) {
+	t.Run("should keep the folder in the state file until untracked by a parent folder", func(t *testing.T) {
+		config, dir := newConfigWithStateFile(t)
+		defer os.RemoveAll(dir)
+		workDir := filepath.Join(dir, "project", HorusecFolder, "analysis-id")
+		workDirs := NewWorkDirs(config)
+
+		workDirs.Track(workDir)
+
+		state := readStateFile(t, config)
+		assert.Len(t, state, 1)
+		assert.Equal(t, workDir, state[0].Path)
+		assert.WithinDuration(t, time.Now(), state[0].CreatedAt, time.Minute)
+		assert.Equal(t, os.Getpid(), state[0].PID)
+
+		workDirs.Untrack(filepath.Join(dir, "project", HorusecFolder))
+		assert.Empty(t, readStateFile(t, config))
+	})
+}
+
+func TestRemoveOrphans(t *testing.T) {
+	t.Run("should remove only the tracked folders older than the max age", func(t *testing.T) {
+		config, dir := newConfigWithStateFile(t)
+		defer os.RemoveAll(dir)
+		oldWorkDir := filepath.Join(dir, "project", HorusecFolder, "old")
+		newWorkDir := filepath.Join(dir, "project", HorusecFolder, "new")
+		assert.NoError(t, os.MkdirAll(oldWorkDir, os.ModePerm))
+		assert.NoError(t, os.MkdirAll(newWorkDir, os.ModePerm))
+		writeStateFile(t, config, []WorkDir{
+			{Path: oldWorkDir, CreatedAt: time.Now().Add(-48 * time.Hour)},
+			{Path: newWorkDir, CreatedAt: time.Now()},
+		})
+
+		removed := NewWorkDirs(config).RemoveOrphans()
+
+		assert.Equal(t, []string{oldWorkDir}, removed)
+		assert.NoDirExists(t, oldWorkDir)
+		assert.DirExists(t, newWorkDir)
+		state := readStateFile(t, config)
+		assert.Len(t, state, 1)
+		assert.Equal(t, newWorkDir, state[0].Path)
+	})
+
+	t.Run("should keep the old folders of analyses still running", func(t *testing.T) {
+		config, dir := newConfigWithStateFile(t)
+		defer os.RemoveAll(dir)
+		workDir := filepath.Join(dir, "project", HorusecFolder, "running")
+		assert.NoError(t, os.MkdirAll(workDir, os.ModePerm))
+		writeStateFile(t, config, []WorkDir{
+			{Path: workDir, CreatedAt: time.Now().Add(-48 * time.Hour), PID: os.Getpid()},
+		})
+
+		assert.Empty(t, NewWorkDirs(config).RemoveOrphans())
+		assert.DirExists(t, workDir)
+		assert.Len(t, readStateFile(t, config), 1)
+	})
+
+	t.Run("should only untrack the folders that don't exist anymore", func(t *testing.T) {
+		config, dir := newConfigWithStateFile(t)
+		defer os.RemoveAll(dir)
+		writeStateFile(t, config, []WorkDir{
+			{Path: filepath.Join(dir, "removed"), CreatedAt: time.Now().Add(-48 * time.Hour)},
+		})
+
+		assert.Empty(t, NewWorkDirs(config).RemoveOrphans())
+		assert.Empty(t, readStateFile(t, config))
+	})
+
+	t.Run("should not remove anything when the max age is negative", func(t *testing.T) {
+		config, dir := newConfigWithStateFile(t)
+		defer os.RemoveAll(dir)
+		config.SetOrphanWorkDirsMaxAgeInHours(-1)
+		workDir := filepath.Join(dir, "project", HorusecFolder, "old")
+		assert.NoError(t, os.MkdirAll(workDir, os.ModePerm))
+		writeStateFile(t, config, []WorkDir{{Path: workDir, CreatedAt: time.Now().Add(-48 * time.Hour)}})
+
+		assert.Empty(t, NewWorkDirs(config).RemoveOrphans())
+		assert.DirExists(t, workDir)
+	})
+}
+
+func TestClean(t *testing.T) {
+	t.Run("should remove the old analysis folders of all .horusec folders of the project", func(t *testing.T) {
+		config, dir := newConfigWithStateFile(t)
+		defer os.RemoveAll(dir)
+		projectPath := filepath.Join(dir, "project")
+		oldWorkDir := filepath.Join(projectPath, HorusecFolder, "old")
+		nestedWorkDir := filepath.Join(projectPath, "api", HorusecFolder, "nested")
+		newWorkDir := filepath.Join(projectPath, HorusecFolder, "new")
+		for _, path := range []string{oldWorkDir, nestedWorkDir, newWorkDir} {
+			assert.NoError(t, os.MkdirAll(path, os.ModePerm))
+		}
+		oldTime := time.Now().Add(-2 * time.Hour)
+		assert.NoError(t, os.Chtimes(oldWorkDir, oldTime, oldTime))
+		assert.NoError(t, os.Chtimes(nestedWorkDir, oldTime, oldTime))
+		writeStateFile(t, config, []WorkDir{{Path: oldWorkDir, CreatedAt: oldTime}})
+
+		removed, err := NewWorkDirs(config).Clean(projectPath, time.Hour)
+
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{oldWorkDir, nestedWorkDir}, removed)
+		assert.DirExists(t, newWorkDir)
+		assert.NoDirExists(t, filepath.Join(projectPath, "api", HorusecFolder))
+		assert.Empty(t, readStateFile(t, config))
+	})
+
+	t.Run("should keep the analysis folders tracked by analyses still running", func(t *testing.T) {
+		config, dir := newConfigWithStateFile(t)
+		defer os.RemoveAll(dir)
+		projectPath := filepath.Join(dir, "project")
+		workDir := filepath.Join(projectPath, HorusecFolder, "running")
+		assert.NoError(t, os.MkdirAll(workDir, os.ModePerm))
+		oldTime := time.Now().Add(-2 * time.Hour)
+		assert.NoError(t, os.Chtimes(workDir, oldTime, oldTime))
+		writeStateFile(t, config, []WorkDir{{Path: workDir, CreatedAt: oldTime, PID: os.Getpid()}})
+
+		removed, err := NewWorkDirs(config).Clean(projectPath, time.Hour)
+
+		assert.NoError(t, err)
+		assert.Empty(t, removed)
+		assert.DirExists(t, workDir)
+	})
+
+	t.Run("should return error when the project path doesn't exist", func(t *testing.T) {
+		config, dir := newConfigWithStateFile(t)
+		defer os.RemoveAll(dir)
+
+		_, err := NewWorkDirs(config).Clean(filepath.Join(dir, "not-exists"), time.Hour)
+		assert.Error(t, err)
+	})
+}
@@ -17,15 +17,40 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rulepack"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/lifecyclehooks"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/codeowners"
+	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/encryption"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/events"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/filters"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/maxfindings"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/printer"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/remotecache"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/risk"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/rulepacks"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/severitymapping"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/severitytables"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/threadfix"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/tickets"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 )
@@ -47,6 +72,56 @@ type ConfigToValidate struct {
 	certPath                        string
 	falsePositiveHashes             []string
 	riskAcceptHashes                []string
+	symlinkMode                     string
+	testCodeMode                    string
+	printStyle                      string
+	eventHooks                      []string
+	lifecycleHooks                  lifecyclehooks.LifecycleHooks
+	sourceMode                      string
+	dockerDaemonFlavor              string
+	faultInjection                  string
+	remoteCacheURL                  string
+	remoteCacheMode                 string
+	remoteCachePublicKey            string
+	remoteCachePrivateKey           string
+	policyPath                      string
+	policyBaselinePath              string
+	riskWeights                     map[string]string
+	logModuleLevels                 map[string]string
+	timeout                         string
+	minGrade                        string
+	maxFindings                     map[string]string
+	baseImageAdvisoriesPath         string
+	tfPlanPath                      string
+	revealSecrets                   bool
+	codeContextLines                int64
+	remediationPath                 string
+	triageURL                       string
+	elasticsearchURL                string
+	elasticsearchIndex              string
+	postgresURI                     string
+	minConfidence                   string
+	filters                         []string
+	codeOwnersPath                  string
+	splitReportByOwner              bool
+	ownerMappings                   []string
+	ownerWebhooks                   map[string]string
+	ticketsPath                     string
+	giteaURL                        string
+	leaksEntropyAllowlistPatterns   []string
+	leaksEntropyMinLength           int64
+	leaksEntropyBase64Threshold     float64
+	leaksEntropyHexThreshold        float64
+	reportMinSeverity               string
+	failThreshold                   string
+	osvOfflineDatabasePath          string
+	severityMapping                 map[string]string
+	severityTablesPath              string
+	threadFixFieldMapping           map[string]string
+	rulePacks                       []string
+	signReport                      bool
+	encryptReport                   bool
+	toolsConfig                     map[tools.Tool]toolsconfig.ToolConfig
 }
 
 type UseCases struct{}
@@ -68,7 +143,7 @@ func (au *UseCases) ValidateConfigs(config cliConfig.IConfig) error {
 		validation.Field(&c.timeoutInSecondsAnalysis, validation.Required, validation.Min(10)),
 		validation.Field(&c.monitorRetryInSeconds, validation.Required, validation.Min(10)),
 		validation.Field(&c.repositoryAuthorization, validation.Required, is.UUID),
-		validation.Field(&c.printOutputType, validation.Required, au.validationOutputTypes()),
+		validation.Field(&c.printOutputType, validation.Required, validation.By(au.validationOutputTypes())),
 		validation.Field(&c.jSONOutputFilePath, validation.By(au.checkAndValidateJSONOutputFilePath(config))),
 		validation.Field(&c.severitiesToIgnore, validation.By(au.validationSeverities(config))),
 		validation.Field(&c.filesOrPathsToIgnore),
@@ -76,9 +151,60 @@ func (au *UseCases) ValidateConfigs(config cliConfig.IConfig) error {
 		validation.Field(&c.projectPath, validation.By(au.validateIfIsValidPath(config.GetProjectPath()))),
 		validation.Field(&c.workDir, validation.By(au.validateWorkDir(config.GetWorkDir(), config.GetProjectPath()))),
 		validation.Field(&c.certInsecureSkipVerify, validation.In(true, false)),
-		validation.Field(&c.certPath, validation.By(au.validateCertPath(config.GetCertPath()))),
+		validation.Field(&c.certPath, validation.By(au.validateOptionalPath(config.GetCertPath()))),
 		validation.Field(&c.falsePositiveHashes, validation.By(au.checkIfExistsDuplicatedFalsePositiveHashes(config))),
 		validation.Field(&c.riskAcceptHashes, validation.By(au.checkIfExistsDuplicatedRiskAcceptHashes(config))),
+		validation.Field(&c.symlinkMode, au.validationSymlinkModes()),
+		validation.Field(&c.testCodeMode, au.validationTestCodeModes()),
+		validation.Field(&c.printStyle, au.validationPrintStyles()),
+		validation.Field(&c.eventHooks, validation.By(au.validationEventHooks)),
+		validation.Field(&c.lifecycleHooks, validation.By(au.validationLifecycleHooks)),
+		validation.Field(&c.sourceMode, au.validationSourceModes()),
+		validation.Field(&c.dockerDaemonFlavor, au.validationDockerDaemonFlavors()),
+		validation.Field(&c.faultInjection, validation.By(au.validationFaultInjection)),
+		validation.Field(&c.remoteCacheURL, validation.By(au.validationRemoteCacheURL)),
+		validation.Field(&c.remoteCacheMode, au.validationRemoteCacheModes()),
+		validation.Field(&c.remoteCachePublicKey, validation.By(au.validationRemoteCachePublicKey(config))),
+		validation.Field(&c.remoteCachePrivateKey, validation.By(au.validationRemoteCachePrivateKey(config))),
+		validation.Field(&c.policyPath, validation.By(au.validateOptionalPath(config.GetPolicyPath()))),
+		validation.Field(&c.policyBaselinePath, validation.By(au.validateOptionalPath(config.GetPolicyBaselinePath()))),
+		validation.Field(&c.riskWeights, validation.By(au.validationRiskWeights)),
+		validation.Field(&c.logModuleLevels, validation.By(au.validationLogModuleLevels)),
+		validation.Field(&c.timeout, validation.By(au.validationTimeout)),
+		validation.Field(&c.minGrade, validation.By(au.validationMinGrade)),
+		validation.Field(&c.maxFindings, validation.By(au.validationMaxFindings)),
+		validation.Field(&c.baseImageAdvisoriesPath,
+			validation.By(au.validateOptionalPath(config.GetBaseImageAdvisoriesPath()))),
+		validation.Field(&c.tfPlanPath, validation.By(au.validateOptionalPath(config.GetTfPlanPath()))),
+		validation.Field(&c.revealSecrets, validation.By(au.validationRevealSecrets(config))),
+		validation.Field(&c.codeContextLines, validation.Min(0), validation.Max(20)),
+		validation.Field(&c.remediationPath, validation.By(au.validateOptionalPath(config.GetRemediationPath()))),
+		validation.Field(&c.triageURL, validation.By(au.validationTriageURL)),
+		validation.Field(&c.elasticsearchURL, validation.By(au.validationElasticsearchURL)),
+		validation.Field(&c.elasticsearchIndex, validation.By(au.validationElasticsearchIndex)),
+		validation.Field(&c.postgresURI, validation.By(au.validationPostgresURI)),
+		validation.Field(&c.minConfidence, validation.By(au.validationMinConfidence)),
+		validation.Field(&c.filters, validation.By(au.validationFilters)),
+		validation.Field(&c.codeOwnersPath, validation.By(au.validationCodeOwnersPath(config))),
+		validation.Field(&c.splitReportByOwner, validation.By(au.validationSplitReportByOwner(config))),
+		validation.Field(&c.ownerMappings, validation.By(au.validationOwnerMappings)),
+		validation.Field(&c.ownerWebhooks, validation.By(au.validationOwnerWebhooks)),
+		validation.Field(&c.ticketsPath, validation.By(au.validationTicketsPath)),
+		validation.Field(&c.giteaURL, validation.By(au.validationGiteaURL(config))),
+		validation.Field(&c.leaksEntropyAllowlistPatterns, validation.By(au.validationLeaksEntropyAllowlistPatterns)),
+		validation.Field(&c.leaksEntropyMinLength, validation.Min(0)),
+		validation.Field(&c.leaksEntropyBase64Threshold, validation.Min(0.0), validation.Max(6.0)),
+		validation.Field(&c.leaksEntropyHexThreshold, validation.Min(0.0), validation.Max(4.0)),
+		validation.Field(&c.reportMinSeverity, validation.By(au.validationSeverityLevel)),
+		validation.Field(&c.failThreshold, validation.By(au.validationSeverityLevel)),
+		validation.Field(&c.osvOfflineDatabasePath, validation.By(au.validationOsvOfflineDatabasePath)),
+		validation.Field(&c.severityMapping, validation.By(au.validationSeverityMapping)),
+		validation.Field(&c.severityTablesPath, validation.By(au.validationSeverityTablesPath)),
+		validation.Field(&c.threadFixFieldMapping, validation.By(au.validationThreadFixFieldMapping)),
+		validation.Field(&c.rulePacks, validation.By(au.validationRulePacks(config))),
+		validation.Field(&c.signReport, validation.By(au.validationSignReport(config))),
+		validation.Field(&c.encryptReport, validation.By(au.validationEncryptReport(config))),
+		validation.Field(&c.toolsConfig, validation.By(au.validationToolsConfig)),
 	)
 }
 
@@ -101,6 +227,56 @@ func (au *UseCases) parseConfigsToConfigValidate(config cliConfig.IConfig) Confi
 		certPath:                        config.GetCertPath(),
 		falsePositiveHashes:             config.GetFalsePositiveHashes(),
 		riskAcceptHashes:                config.GetRiskAcceptHashes(),
+		symlinkMode:                     config.GetSymlinkMode(),
+		testCodeMode:                    config.GetTestCodeMode(),
+		printStyle:                      config.GetPrintStyle(),
+		eventHooks:                      config.GetEventHooks(),
+		lifecycleHooks:                  config.GetLifecycleHooks(),
+		sourceMode:                      config.GetSourceMode(),
+		dockerDaemonFlavor:              config.GetDockerDaemonFlavor(),
+		faultInjection:                  config.GetFaultInjection(),
+		remoteCacheURL:                  config.GetRemoteCacheURL(),
+		remoteCacheMode:                 config.GetRemoteCacheMode(),
+		remoteCachePublicKey:            config.GetRemoteCachePublicKey(),
+		remoteCachePrivateKey:           config.GetRemoteCachePrivateKey(),
+		policyPath:                      config.GetPolicyPath(),
+		policyBaselinePath:              config.GetPolicyBaselinePath(),
+		riskWeights:                     config.GetRiskWeights(),
+		logModuleLevels:                 config.GetLogModuleLevels(),
+		timeout:                         config.GetTimeout(),
+		minGrade:                        config.GetMinGrade(),
+		maxFindings:                     config.GetMaxFindings(),
+		baseImageAdvisoriesPath:         config.GetBaseImageAdvisoriesPath(),
+		tfPlanPath:                      config.GetTfPlanPath(),
+		revealSecrets:                   config.GetRevealSecrets(),
+		codeContextLines:                config.GetCodeContextLines(),
+		remediationPath:                 config.GetRemediationPath(),
+		triageURL:                       config.GetTriageURL(),
+		elasticsearchURL:                config.GetElasticsearchURL(),
+		elasticsearchIndex:              config.GetElasticsearchIndex(),
+		postgresURI:                     config.GetPostgresURI(),
+		minConfidence:                   config.GetMinConfidence(),
+		filters:                         config.GetFilters(),
+		codeOwnersPath:                  config.GetCodeOwnersPath(),
+		splitReportByOwner:              config.GetSplitReportByOwner(),
+		ownerMappings:                   config.GetOwnerMappings(),
+		ownerWebhooks:                   config.GetOwnerWebhooks(),
+		ticketsPath:                     config.GetTicketsPath(),
+		giteaURL:                        config.GetGiteaURL(),
+		leaksEntropyAllowlistPatterns:   config.GetLeaksEntropyAllowlistPatterns(),
+		leaksEntropyMinLength:           config.GetLeaksEntropyMinLength(),
+		leaksEntropyBase64Threshold:     config.GetLeaksEntropyBase64Threshold(),
+		leaksEntropyHexThreshold:        config.GetLeaksEntropyHexThreshold(),
+		reportMinSeverity:               config.GetReportMinSeverity(),
+		failThreshold:                   config.GetFailThreshold(),
+		osvOfflineDatabasePath:          config.GetOsvOfflineDatabasePath(),
+		severityMapping:                 config.GetSeverityMapping(),
+		severityTablesPath:              config.GetSeverityTablesPath(),
+		threadFixFieldMapping:           config.GetThreadFixFieldMapping(),
+		rulePacks:                       config.GetRulePacks(),
+		signReport:                      config.GetSignReport(),
+		encryptReport:                   config.GetEncryptReport(),
+		toolsConfig:                     config.GetToolsConfig(),
 	}
 }
 
@@ -130,12 +306,16 @@ func (au *UseCases) checkIfExistsDuplicatedRiskAcceptHashes(config cliConfig.ICo
 	}
 }
 
+// checkAndValidateJSONOutputFilePath allows the json output file empty in the quiet mode, to write it in the stdout
 func (au *UseCases) checkAndValidateJSONOutputFilePath(config cliConfig.IConfig) func(value interface{}) error {
 	return func(value interface{}) error {
-		if config.GetPrintOutputType() == cli.JSON.ToString() || config.GetPrintOutputType() == cli.SonarQube.ToString() {
-			if err := au.validateJSONOutputFilePath(config); err != nil {
-				return err
-			}
+		if config.GetQuiet() && config.GetJSONOutputFilePath() == "" {
+			return nil
+		}
+		switch config.GetPrintOutputType() {
+		case cli.JSON.ToString(), cli.SonarQube.ToString(), cli.ThreadFix.ToString(), cli.CycloneDXVDR.ToString(),
+			cli.SPDX3.ToString(), cli.Jenkins.ToString():
+			return au.validateJSONOutputFilePath(config)
 		}
 		return nil
 	}
@@ -157,14 +337,471 @@ func (au *UseCases) validateJSONOutputFilePath(config cliConfig.IConfig) error {
 	return nil
 }
 
-func (au *UseCases) validationOutputTypes() validation.InRule {
+func (au *UseCases) validationOutputTypes() func(value interface{}) error {
+	return func(value interface{}) error {
+		outputType, _ := value.(string)
+		if err := validation.Validate(outputType, validation.In(
+			cli.JSON.ToString(), cli.SonarQube.ToString(), cli.ThreadFix.ToString(), cli.CycloneDXVDR.ToString(),
+			cli.SPDX3.ToString(), cli.Jenkins.ToString(), cli.Text.ToString())); err == nil {
+			return nil
+		}
+		if printer.IsAvailable(outputType) {
+			return nil
+		}
+		return fmt.Errorf("%s%s", messages.MsgErrorOutputTypeNotAvailable,
+			strings.Join(append(printer.OutputTypes(), printer.ExecPluginPrefix+"<name>"), ", "))
+	}
+}
+
+func (au *UseCases) validationSymlinkModes() validation.InRule {
+	return validation.In(
+		cli.SymlinkSkip.ToString(),
+		cli.SymlinkPreserve.ToString(),
+		cli.SymlinkFollowWithinRoot.ToString(),
+	)
+}
+
+func (au *UseCases) validationTestCodeModes() validation.InRule {
+	return validation.In(
+		cli.TestCodeFlag.ToString(),
+		cli.TestCodeDowngrade.ToString(),
+		cli.TestCodeExcludeFromGates.ToString(),
+	)
+}
+
+func (au *UseCases) validationPrintStyles() validation.InRule {
+	return validation.In(
+		cli.PrintStyleFull.ToString(),
+		cli.PrintStyleCompact.ToString(),
+		cli.PrintStyleGrouped.ToString(),
+	)
+}
+
+func (au *UseCases) validationSourceModes() validation.InRule {
+	return validation.In(
+		cli.SourceCopy.ToString(),
+		cli.SourceHardlink.ToString(),
+		cli.SourceReadOnly.ToString(),
+	)
+}
+
+func (au *UseCases) validationDockerDaemonFlavors() validation.InRule {
+	return validation.In(
+		cli.DockerDaemonAuto.ToString(),
+		cli.DockerDaemonLinux.ToString(),
+		cli.DockerDaemonDesktopHyperV.ToString(),
+		cli.DockerDaemonDesktopWSL2.ToString(),
+		cli.DockerDaemonWindows.ToString(),
+	)
+}
+
+// validationRemoteCachePublicKey requires the public key with the remote cache, its objects are never read unverified
+func (au *UseCases) validationRemoteCachePublicKey(config cliConfig.IConfig) func(value interface{}) error {
+	return func(value interface{}) error {
+		publicKey, _ := value.(string)
+		if config.GetRemoteCacheURL() == "" {
+			return nil
+		}
+		if _, err := remotecache.ParsePublicKey(publicKey); err != nil {
+			return errors.New(messages.MsgErrorInvalidRemoteCachePublicKey)
+		}
+		return nil
+	}
+}
+
+func (au *UseCases) validationRemoteCachePrivateKey(config cliConfig.IConfig) func(value interface{}) error {
+	return func(value interface{}) error {
+		privateKey, _ := value.(string)
+		if config.GetRemoteCacheURL() == "" || config.GetRemoteCacheMode() != cli.RemoteCacheReadWrite.ToString() {
+			return nil
+		}
+		publicKey, err := remotecache.ParsePublicKey(config.GetRemoteCachePublicKey())
+		if err != nil {
+			return nil
+		}
+		if _, err := remotecache.ParsePrivateKey(privateKey, publicKey); err != nil {
+			return errors.New(messages.MsgErrorInvalidRemoteCachePrivateKey)
+		}
+		return nil
+	}
+}
+
+func (au *UseCases) validationRemoteCacheModes() validation.InRule {
 	return validation.In(
-		cli.JSON.ToString(),
-		cli.SonarQube.ToString(),
-		cli.Text.ToString(),
+		cli.RemoteCacheReadOnly.ToString(),
+		cli.RemoteCacheReadWrite.ToString(),
 	)
 }
 
+func (au *UseCases) validationRiskWeights(value interface{}) error {
+	riskWeights, _ := value.(map[string]string)
+	for key, weight := range riskWeights {
+		if _, err := strconv.Atoi(strings.TrimSpace(weight)); err != nil || !risk.IsValidWeightKey(key) {
+			return errors.New(messages.MsgErrorInvalidRiskWeight + key + "=" + weight)
+		}
+	}
+	return nil
+}
+
+func (au *UseCases) validationMaxFindings(value interface{}) error {
+	maxFindings, _ := value.(map[string]string)
+	for key, maxValue := range maxFindings {
+		if !maxfindings.IsValidMaxFindings(key, maxValue) {
+			return errors.New(messages.MsgErrorInvalidMaxFindings + key + "=" + maxValue)
+		}
+	}
+	return nil
+}
+
+func (au *UseCases) validationLogModuleLevels(value interface{}) error {
+	logModuleLevels, _ := value.(map[string]string)
+	for module, level := range logModuleLevels {
+		if module == "" || !logger.IsValidLevel(level) {
+			return errors.New(messages.MsgErrorInvalidLogModuleLevel + module + "=" + level)
+		}
+	}
+	return nil
+}
+
+func (au *UseCases) validationTimeout(value interface{}) error {
+	timeout, _ := value.(string)
+	if timeout == "" {
+		return nil
+	}
+	if duration, err := time.ParseDuration(timeout); err != nil || duration <= 0 {
+		return errors.New(messages.MsgErrorInvalidTimeout + timeout)
+	}
+	return nil
+}
+
+func (au *UseCases) validationEventHooks(value interface{}) error {
+	eventHooks, _ := value.([]string)
+	for _, eventHook := range eventHooks {
+		if _, ok := events.LookExecHook(eventHook); !ok {
+			return errors.New(messages.MsgErrorEventHookNotFound + eventHook)
+		}
+	}
+	return nil
+}
+
+func (au *UseCases) validationLifecycleHooks(value interface{}) error {
+	lifecycleHooks, _ := value.(lifecyclehooks.LifecycleHooks)
+	return lifecycleHooks.Validate()
+}
+
+func (au *UseCases) validationFaultInjection(value interface{}) error {
+	faultInjection, _ := value.(string)
+	if faultInjection == "" {
+		return nil
+	}
+	_, err := dockerClient.ParseFaultProfile(faultInjection)
+	return err
+}
+
+func (au *UseCases) validationMinGrade(value interface{}) error {
+	minGrade, _ := value.(string)
+	if minGrade == "" || risk.IsValidGrade(minGrade) {
+		return nil
+	}
+	return errors.New(messages.MsgErrorInvalidMinGrade + minGrade)
+}
+
+// validationRevealSecrets keeps the secrets masked in the analysis sent to horusec platform
+func (au *UseCases) validationRevealSecrets(config cliConfig.IConfig) func(value interface{}) error {
+	return func(value interface{}) error {
+		if revealSecrets, _ := value.(bool); revealSecrets && !config.IsEmptyRepositoryAuthorization() {
+			return errors.New(messages.MsgErrorRevealSecretsWithAuthorization)
+		}
+		return nil
+	}
+}
+
+func (au *UseCases) validationRemoteCacheURL(value interface{}) error {
+	remoteCacheURL, _ := value.(string)
+	if remoteCacheURL == "" {
+		return nil
+	}
+	parsedURL, err := url.Parse(remoteCacheURL)
+	if err != nil || parsedURL.Host == "" {
+		return errors.New(messages.MsgErrorInvalidRemoteCacheURL)
+	}
+	switch parsedURL.Scheme {
+	case "s3", "gs", "http", "https":
+		return nil
+	}
+	return errors.New(messages.MsgErrorInvalidRemoteCacheURL)
+}
+
+func (au *UseCases) validationSeverityMapping(value interface{}) error {
+	severityMapping, _ := value.(map[string]string)
+	for key, mappedSeverity := range severityMapping {
+		if !severitymapping.IsValidMapping(key, mappedSeverity) {
+			return errors.New(messages.MsgErrorInvalidSeverityMapping + key + "=" + mappedSeverity)
+		}
+	}
+	return nil
+}
+
+func (au *UseCases) validationThreadFixFieldMapping(value interface{}) error {
+	fieldMapping, _ := value.(map[string]string)
+	for field, vulnerabilityField := range fieldMapping {
+		if !threadfix.IsValidFieldMapping(field, vulnerabilityField) {
+			return errors.New(messages.MsgErrorInvalidThreadFixFieldMapping + field + "=" + vulnerabilityField)
+		}
+	}
+	return nil
+}
+
+// validationSeverityTablesPath reads the tables, so the invalid tools and severities are found before the analysis
+func (au *UseCases) validationSeverityTablesPath(value interface{}) error {
+	severityTablesPath, _ := value.(string)
+	if severityTablesPath == "" {
+		return nil
+	}
+	if _, err := severitytables.Load(severityTablesPath); err != nil {
+		return errors.New(messages.MsgErrorInvalidSeverityTables + err.Error())
+	}
+	return nil
+}
+
+// validationToolsConfig requires that the config files of the tools exist, they are mounted in the containers
+func (au *UseCases) validationToolsConfig(value interface{}) error {
+	toolsConfig, _ := value.(map[tools.Tool]toolsconfig.ToolConfig)
+	for tool, toolConfig := range toolsConfig {
+		if _, err := time.Parse(toolsconfig.WarnOnlyUntilLayout, toolConfig.WarnOnlyUntil); err != nil &&
+			toolConfig.WarnOnlyUntil != "" {
+			return errors.New(messages.MsgErrorInvalidWarnOnlyUntil + tool.ToString() + ": " + toolConfig.WarnOnlyUntil)
+		}
+		if toolConfig.ConfigPath == "" {
+			continue
+		}
+		if _, err := os.Stat(toolConfig.ConfigPath); err != nil {
+			return errors.New(messages.MsgErrorToolConfigPathNotFound + tool.ToString() + ": " + toolConfig.ConfigPath)
+		}
+	}
+	return nil
+}
+
+func (au *UseCases) validationRulePacks(config cliConfig.IConfig) func(value interface{}) error {
+	return func(value interface{}) error {
+		rulePacks, _ := value.([]string)
+		for _, rulePack := range rulePacks {
+			reference, err := rulepack.ParseReference(rulePack)
+			if err != nil {
+				return errors.New(messages.MsgErrorInvalidRulePack + rulePack)
+			}
+			if !reference.IsBuiltin() && !rulepacks.IsDownloaded(config, reference) {
+				return errors.New(messages.MsgErrorRulePackNotFound + reference.String())
+			}
+		}
+		return nil
+	}
+}
+
+func (au *UseCases) validationMinConfidence(value interface{}) error {
+	minConfidence, _ := value.(string)
+	if minConfidence == "" || confidence.Confidence(strings.ToUpper(minConfidence)).IsValid() {
+		return nil
+	}
+	return errors.New(messages.MsgErrorInvalidMinConfidence + minConfidence)
+}
+
+func (au *UseCases) validationFilters(value interface{}) error {
+	names, _ := value.([]string)
+	for _, name := range names {
+		if !filters.IsAvailable(name) {
+			return fmt.Errorf("%s%s or %s<name> in the PATH: %s", messages.MsgErrorFilterNotAvailable,
+				strings.Join(filters.Names(), ", "), filters.ExecPluginPrefix, name)
+		}
+	}
+	return nil
+}
+
+func (au *UseCases) validationSeverityLevel(value interface{}) error {
+	level, _ := value.(string)
+	if level == "" || severity.Severity(strings.ToUpper(level)).HasLevel() {
+		return nil
+	}
+	return errors.New(messages.MsgErrorInvalidSeverityLevel + level)
+}
+
+func (au *UseCases) validationTriageURL(value interface{}) error {
+	triageURL, _ := value.(string)
+	if triageURL == "" {
+		return nil
+	}
+	parsedURL, err := url.Parse(triageURL)
+	if err != nil || parsedURL.Host == "" || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return errors.New(messages.MsgErrorInvalidTriageURL)
+	}
+	return nil
+}
+
+func (au *UseCases) validationElasticsearchURL(value interface{}) error {
+	elasticsearchURL, _ := value.(string)
+	if elasticsearchURL == "" {
+		return nil
+	}
+	parsedURL, err := url.Parse(elasticsearchURL)
+	if err != nil || parsedURL.Host == "" || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return errors.New(messages.MsgErrorInvalidElasticsearchURL)
+	}
+	return nil
+}
+
+func (au *UseCases) validationElasticsearchIndex(value interface{}) error {
+	index, _ := value.(string)
+	if index != strings.ToLower(index) || strings.ContainsAny(index, ` \/*?"<>|,#:`) ||
+		strings.IndexAny(index, "-_+") == 0 || index == "." || index == ".." {
+		return errors.New(messages.MsgErrorInvalidElasticsearchIndex)
+	}
+	return nil
+}
+
+func (au *UseCases) validationPostgresURI(value interface{}) error {
+	postgresURI, _ := value.(string)
+	if postgresURI == "" {
+		return nil
+	}
+	parsedURL, err := url.Parse(postgresURI)
+	if err != nil || parsedURL.Host == "" || (parsedURL.Scheme != "postgres" && parsedURL.Scheme != "postgresql") {
+		return errors.New(messages.MsgErrorInvalidPostgresURI)
+	}
+	return nil
+}
+
+// validationSignReport requires the json report, because it is the subject of the attestation
+func (au *UseCases) validationSignReport(config cliConfig.IConfig) func(value interface{}) error {
+	return func(value interface{}) error {
+		if signReport, _ := value.(bool); signReport && config.GetPrintOutputType() != cli.JSON.ToString() {
+			return errors.New(messages.MsgErrorSignReportWithoutJSON)
+		}
+		return nil
+	}
+}
+
+// validationEncryptReport requires the recipients and a report written in the json output file path, because the
+// output in the stdout is not encrypted, and rejects the artifacts written unencrypted with the raw outputs of the tools
+func (au *UseCases) validationEncryptReport(config cliConfig.IConfig) func(value interface{}) error {
+	return func(value interface{}) error {
+		if encryptReport, _ := value.(bool); !encryptReport {
+			return nil
+		}
+		if !encryption.IsValidRecipients(config.GetReportRecipients()) {
+			return errors.New(messages.MsgErrorInvalidReportRecipients)
+		}
+		if config.GetJSONOutputFilePath() == "" || config.GetPrintOutputType() == cli.Text.ToString() {
+			return errors.New(messages.MsgErrorEncryptReportWithoutOutputFile)
+		}
+		if config.GetArtifactsDir() != "" || au.hasToolWithRawOutput(config.GetToolsConfig()) {
+			return errors.New(messages.MsgErrorEncryptReportWithArtifacts)
+		}
+		return nil
+	}
+}
+
+func (au *UseCases) hasToolWithRawOutput(toolsConfig map[tools.Tool]toolsconfig.ToolConfig) bool {
+	for _, toolConfig := range toolsConfig {
+		if toolConfig.SaveRawOutput {
+			return true
+		}
+	}
+	return false
+}
+
+// validationCodeOwnersPath uses the path relative to the project path, like the CODEOWNERS found in the project
+func (au *UseCases) validationCodeOwnersPath(config cliConfig.IConfig) func(value interface{}) error {
+	return func(value interface{}) error {
+		codeOwnersPath, _ := value.(string)
+		if codeOwnersPath != "" && !filepath.IsAbs(codeOwnersPath) {
+			codeOwnersPath = filepath.Join(config.GetProjectPath(), codeOwnersPath)
+		}
+		return au.validateOptionalPath(codeOwnersPath)(value)
+	}
+}
+
+func (au *UseCases) validationSplitReportByOwner(config cliConfig.IConfig) func(value interface{}) error {
+	return func(value interface{}) error {
+		splitReportByOwner, _ := value.(bool)
+		if splitReportByOwner &&
+			(config.GetJSONOutputFilePath() == "" || config.GetPrintOutputType() == cli.Text.ToString()) {
+			return errors.New(messages.MsgErrorSplitReportWithoutOutputFile)
+		}
+		return nil
+	}
+}
+
+func (au *UseCases) validationOwnerMappings(value interface{}) error {
+	ownerMappings, _ := value.([]string)
+	for _, ownerMapping := range ownerMappings {
+		if !codeowners.IsValidRule(ownerMapping) {
+			return errors.New(messages.MsgErrorInvalidOwnerMapping + ownerMapping)
+		}
+	}
+	return nil
+}
+
+func (au *UseCases) validationOwnerWebhooks(value interface{}) error {
+	ownerWebhooks, _ := value.(map[string]string)
+	for owner, webhookURL := range ownerWebhooks {
+		parsedURL, err := url.Parse(webhookURL)
+		if err != nil || parsedURL.Host == "" || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+			return errors.New(messages.MsgErrorInvalidOwnerWebhook + owner)
+		}
+	}
+	return nil
+}
+
+func (au *UseCases) validationTicketsPath(value interface{}) error {
+	ticketsPath, _ := value.(string)
+	if ticketsPath == "" {
+		return nil
+	}
+	if _, err := tickets.LoadTickets(ticketsPath); err != nil {
+		return fmt.Errorf("%s%w", messages.MsgErrorInvalidTicketsFile, err)
+	}
+	return nil
+}
+
+func (au *UseCases) validationGiteaURL(config cliConfig.IConfig) func(value interface{}) error {
+	return func(value interface{}) error {
+		giteaURL, _ := value.(string)
+		if giteaURL == "" {
+			return nil
+		}
+		parsedURL, err := url.Parse(giteaURL)
+		if err != nil || parsedURL.Host == "" || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+			return errors.New(messages.MsgErrorInvalidGiteaURL)
+		}
+		if config.GetGiteaToken() == "" {
+			return errors.New(messages.MsgErrorGiteaURLWithoutToken)
+		}
+		return nil
+	}
+}
+
+func (au *UseCases) validationLeaksEntropyAllowlistPatterns(value interface{}) error {
+	patterns, _ := value.([]string)
+	for _, pattern := range patterns {
+		if _, err := regexp.Compile(strings.TrimSpace(pattern)); err != nil {
+			return fmt.Errorf("%s%w", messages.MsgErrorInvalidLeaksEntropyAllowlistPattern, err)
+		}
+	}
+	return nil
+}
+
+// validationOsvOfflineDatabasePath requires a directory, it is mounted in the container of osv-scanner
+func (au *UseCases) validationOsvOfflineDatabasePath(value interface{}) error {
+	databasePath, _ := value.(string)
+	if databasePath == "" {
+		return nil
+	}
+	if info, err := os.Stat(databasePath); err != nil || !info.IsDir() {
+		return errors.New(messages.MsgErrorInvalidOsvOfflineDatabasePath)
+	}
+	return nil
+}
+
 func (au *UseCases) validationSeverities(config cliConfig.IConfig) func(value interface{}) error {
 	return func(value interface{}) error {
 		for _, item := range config.GetSeveritiesToIgnore() {
@@ -192,6 +829,7 @@ func (au *UseCases) sliceSeverityEnable() []severity.Severity {
 		severity.Low,
 		severity.Medium,
 		severity.High,
+		severity.Critical,
 		severity.Audit,
 		severity.Info,
 	}
@@ -206,25 +844,25 @@ func (au *UseCases) validateIfIsValidPath(dir string) func(value interface{}) er
 	}
 }
 
-func (au *UseCases) validateCertPath(dir string) func(value interface{}) error {
-	if dir == "" {
+func (au *UseCases) validateOptionalPath(path string) func(value interface{}) error {
+	if path == "" {
 		return func(value interface{}) error {
 			return nil
 		}
 	}
 
-	return au.validateIfIsValidPath(dir)
+	return au.validateIfIsValidPath(path)
 }
 
+// validateWorkDir requires that the paths exist and that the globs match at least one folder of the project
 func (au *UseCases) validateWorkDir(workDir *workdir.WorkDir, projectPath string) func(value interface{}) error {
 	return func(value interface{}) error {
 		if workDir == nil {
 			return errors.New(messages.MsgErrorParseStringToWorkDir)
 		}
-		for _, pathsByLanguage := range workDir.Map() {
+		for language, pathsByLanguage := range workDir.Map() {
 			for _, projectSubPath := range pathsByLanguage {
-				err := au.validateIfExistPathInProjectToWorkDir(projectPath, projectSubPath)
-				if err != nil {
+				if err := au.validateWorkDirPath(projectPath, language, projectSubPath); err != nil {
 					return err
 				}
 			}
@@ -233,6 +871,16 @@ func (au *UseCases) validateWorkDir(workDir *workdir.WorkDir, projectPath string
 	}
 }
 
+func (au *UseCases) validateWorkDirPath(projectPath string, language languages.Language, projectSubPath string) error {
+	if !workdir.IsGlob(projectSubPath) {
+		return au.validateIfExistPathInProjectToWorkDir(projectPath, projectSubPath)
+	}
+	if len(workdir.MatchFolders(projectPath, projectSubPath)) == 0 {
+		return errors.New(messages.MsgErrorWorkDirGlobWithoutMatch + language.ToString() + ": " + projectSubPath)
+	}
+	return nil
+}
+
 func (au *UseCases) validateIfExistPathInProjectToWorkDir(projectPath, internalPath string) error {
 	projectPathAbs, _ := filepath.Abs(projectPath)
 	if internalPath != "" {
@@ -15,14 +15,31 @@
 package cli
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/cli"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/stretchr/testify/assert"
 )
 
+func newProjectWithFolders(t *testing.T, folders ...string) string {
+	projectPath, err := ioutil.TempDir("", "horusec-cli")
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(projectPath)
+	})
+	for _, folder := range folders {
+		assert.NoError(t, os.MkdirAll(filepath.Join(projectPath, folder), 0750))
+	}
+	return projectPath
+}
+
 func TestValidateConfigs(t *testing.T) {
 	useCases := NewCLIUseCases()
 
@@ -65,7 +82,7 @@ func TestValidateConfigs(t *testing.T) {
 		err := useCases.ValidateConfigs(config)
 		assert.Error(t, err)
 		assert.Equal(t, "severitiesToIgnore: Type of severity not valid:  test. "+
-			"See severities enable: [NOSEC LOW MEDIUM HIGH AUDIT INFO].", err.Error())
+			"See severities enable: [NOSEC LOW MEDIUM HIGH CRITICAL AUDIT INFO].", err.Error())
 	})
 
 	t.Run("Should return error when invalid json output file is empty", func(t *testing.T) {
@@ -81,6 +98,107 @@ func TestValidateConfigs(t *testing.T) {
 			err.Error())
 	})
 
+	t.Run("Should return not error when json output file is empty in the quiet mode", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.NewConfigsFromEnvironments()
+		config.SetPrintOutputType(cli.JSON.ToString())
+		config.SetJSONOutputFilePath("")
+		config.SetQuiet(true)
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should return error when output format is not available", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.NewConfigsFromEnvironments()
+		config.SetPrintOutputType("not-exists")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "printOutputType: output format not available")
+	})
+
+	t.Run("Should return error when risk weight is not valid", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.NewConfigsFromEnvironments()
+		config.SetRiskWeights(map[string]string{"HIGH": "ten"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "riskWeights: Risk weight is not valid")
+	})
+
+	t.Run("Should return error when log module level is not valid", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.NewConfigsFromEnvironments()
+		config.SetLogModuleLevels(map[string]string{"docker": "verbose"})
+		defer logger.SetModuleLevels(map[string]string{})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "logModuleLevels: Log module level is not valid")
+	})
+
+	t.Run("Should return error when timeout is not valid", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.NewConfigsFromEnvironments()
+		config.SetTimeout("30 minutes")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "timeout: Timeout is not valid")
+	})
+
+	t.Run("Should return error when the fault injection profile is invalid", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.NewConfigsFromEnvironments()
+		config.SetFaultInjection("fail-rate=2")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "faultInjection")
+	})
+
+	t.Run("Should return error when the event hook is not in the path", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.NewConfigsFromEnvironments()
+		config.SetEventHooks([]string{"not-found"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "eventHooks")
+	})
+
+	t.Run("Should return error when the lifecycle hook has no command and no url", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.NewConfigsFromEnvironments()
+		config.SetLifecycleHooks(map[string]interface{}{"preAnalysis": []interface{}{map[string]interface{}{}}})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "lifecycleHooks")
+	})
+
+	t.Run("Should return error when min grade is not valid", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.NewConfigsFromEnvironments()
+		config.SetMinGrade("G")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "minGrade: Min grade is not valid")
+	})
+
 	t.Run("Should return error when invalid json output file is invalid", func(t *testing.T) {
 		config := &cliConfig.Config{}
 		config.SetWorkDir(&workdir.WorkDir{})
@@ -163,4 +281,482 @@ func TestValidateConfigs(t *testing.T) {
 		err := useCases.ValidateConfigs(config)
 		assert.NoError(t, err)
 	})
+	t.Run("Should return error when remote cache url is not supported", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetRemoteCacheURL("ftp://bucket/horusec")
+		config.SetRemoteCachePublicKey("A6EHv/POEL4dcN0Y50vAmWfk1jCbpQ1fHdyGZBJVMbg=")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "remoteCacheURL: Remote cache url is not valid, it must start with s3://, gs://, http:// or https://.",
+			err.Error())
+	})
+	t.Run("Should return not error when remote cache is valid", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetRemoteCacheURL("s3://bucket/horusec")
+		config.SetRemoteCacheMode("read-only")
+		config.SetRemoteCachePublicKey("A6EHv/POEL4dcN0Y50vAmWfk1jCbpQ1fHdyGZBJVMbg=")
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+
+		config.SetRemoteCacheMode("read-write")
+		config.SetRemoteCachePrivateKey("AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8=")
+		err = useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when remote cache is used without the public key", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetRemoteCacheURL("s3://bucket/horusec")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "remoteCachePublicKey: Remote cache requires the public key")
+	})
+	t.Run("Should return error when remote cache is written without the private key of the public key", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetRemoteCacheURL("s3://bucket/horusec")
+		config.SetRemoteCacheMode("read-write")
+		config.SetRemoteCachePublicKey("A6EHv/POEL4dcN0Y50vAmWfk1jCbpQ1fHdyGZBJVMbg=")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "remoteCachePrivateKey: Remote cache in the read-write mode requires")
+
+		config.SetRemoteCachePrivateKey("AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=")
+		err = useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "remoteCachePrivateKey: Remote cache in the read-write mode requires")
+	})
+	t.Run("Should return error when rule pack is not downloaded", func(t *testing.T) {
+		rulePacksDir, err := ioutil.TempDir("", "horusec-rule-packs")
+		assert.NoError(t, err)
+		defer os.RemoveAll(rulePacksDir)
+		config := cliConfig.NewConfig()
+		config.SetRulePacksDir(rulePacksDir)
+		config.SetRulePacks([]string{"java-core", "leaks@2.0"})
+
+		err = useCases.ValidateConfigs(config)
+		assert.Equal(t, "rulePacks: Rule pack not found, download it with horusec rules update: leaks@2.0.", err.Error())
+	})
+	t.Run("Should return error when rule pack is invalid", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetRulePacks([]string{"@2.0"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "rulePacks: Rule pack is not valid, it must be informed as name@version: @2.0.", err.Error())
+	})
+	t.Run("Should return not error when rule pack is downloaded", func(t *testing.T) {
+		rulePacksDir, err := ioutil.TempDir("", "horusec-rule-packs")
+		assert.NoError(t, err)
+		defer os.RemoveAll(rulePacksDir)
+		config := cliConfig.NewConfig()
+		config.SetRulePacksDir(rulePacksDir)
+		config.SetRulePacks([]string{"leaks@2.0"})
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(rulePacksDir, "leaks@2.0.json"), []byte("{}"), 0600))
+
+		err = useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when the config file of a tool doesn't exist", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{
+			GitLeaks: toolsconfig.ToolConfig{ConfigPath: "./not-exists/gitleaks.toml"}})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "toolsConfig: Config file of the tool not found: GitLeaks: ./not-exists/gitleaks.toml.",
+			err.Error())
+	})
+	t.Run("Should return error when the end of the grace period of a tool is not a date", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetToolsConfig(toolsconfig.ToolsConfigsStruct{
+			Semgrep: toolsconfig.ToolConfig{WarnOnlyUntil: "31/12/2021"}})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "toolsConfig: Warn only until is not valid, it must be a date like 2021-12-31 of the tool "+
+			"Semgrep: 31/12/2021.", err.Error())
+	})
+	t.Run("Should return error when test code mode is invalid", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetTestCodeMode("ignore")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "testCodeMode: must be a valid value.", err.Error())
+	})
+	t.Run("Should return not error when test code mode is downgrade", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetTestCodeMode("downgrade")
+		config.SetTestCodePaths([]string{"**/qa/**"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when print style is invalid", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetPrintStyle("short")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "printStyle: must be a valid value.", err.Error())
+	})
+	t.Run("Should return not error when print style is grouped", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetPrintStyle("grouped")
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when reveal secrets in analysis sent to horusec platform", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetRevealSecrets(true)
+		config.SetRepositoryAuthorization("8881a3b2-8e5c-4e53-b5a1-9c6bbf58a3f2")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "revealSecrets: The secrets can only be revealed in local analysis, remove the flag "+
+			"reveal-secrets or the repository authorization.", err.Error())
+	})
+	t.Run("Should return not error when reveal secrets in local analysis", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetRevealSecrets(true)
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when code context lines is greater than the maximum", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetCodeContextLines(21)
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "codeContextLines: must be no greater than 20.", err.Error())
+	})
+	t.Run("Should return error when triage url is not http", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetTriageURL("localhost:11434")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "triageURL: Triage url is not valid, it must start with http:// or https://.", err.Error())
+	})
+	t.Run("Should return error when min confidence is not a level of confidence", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetMinConfidence("VERY_HIGH")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "minConfidence: Min confidence is not valid, it must be LOW, MEDIUM or HIGH: VERY_HIGH.",
+			err.Error())
+	})
+	t.Run("Should return not error when min confidence is in lower case", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetMinConfidence("medium")
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when the filter is not available", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetFilters([]string{"severity", "not-found"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "filters")
+		assert.Contains(t, err.Error(), "dedup")
+	})
+	t.Run("Should return not error when the filters are registered", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetFilters([]string{"severity", "path", "baseline", "suppressions", "dedup"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when docker daemon flavor is not valid", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetDockerDaemonFlavor("wsl")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "dockerDaemonFlavor: must be a valid value.", err.Error())
+	})
+	t.Run("Should return error when severity mapping has an unknown tool", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetSeverityMapping(map[string]string{"Unknown:moderate": "HIGH"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "severityMapping: Severity mapping is not valid, the key must be the tool and its severity "+
+			"separated by colon and the value a severity of horusec: Unknown:moderate=HIGH.", err.Error())
+	})
+	t.Run("Should return error when elasticsearch url is not an http url", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetElasticsearchURL("localhost:9200")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "elasticsearchURL: Elasticsearch url is not valid, it must start with http:// or https://.",
+			err.Error())
+	})
+	t.Run("Should return error when elasticsearch index has uppercase letters", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetElasticsearchIndex("Horusec")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "elasticsearchIndex: Elasticsearch index is not valid")
+	})
+	t.Run("Should return error when postgres uri is not a postgres url", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetPostgresURI("host=localhost dbname=horusec")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "postgresURI: PostgreSQL uri is not valid, it must start with postgres:// or postgresql://.",
+			err.Error())
+	})
+	t.Run("Should return error when threadfix field mapping has an unknown field", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetThreadFixFieldMapping(map[string]string{"nativeId": "unknown"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "threadFixFieldMapping: ThreadFix field mapping is not valid, the key must be a field of the "+
+			"findings and the value a field of the json output of the vulnerability: nativeId=unknown.", err.Error())
+	})
+	t.Run("Should return error when severity tables have an unknown severity", func(t *testing.T) {
+		projectPath := newProjectWithFolders(t)
+		severityTablesPath := filepath.Join(projectPath, "severity-tables.yaml")
+		assert.NoError(t, ioutil.WriteFile(severityTablesPath, []byte("Semgrep:\n  default: URGENT\n"), 0600))
+		config := cliConfig.NewConfig()
+		config.SetSeverityTablesPath(severityTablesPath)
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "severityTablesPath: Severity tables are not valid, the keys must be tools and the values "+
+			"severities of horusec: Semgrep: severity not supported: URGENT.", err.Error())
+	})
+	t.Run("Should return error when sign report is used without the json output", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetSignReport(true)
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "signReport: Sign report requires the output type json with the json output file path.",
+			err.Error())
+	})
+	t.Run("Should return not error when sign report is used with the json output", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetSignReport(true)
+		config.SetPrintOutputType(cli.JSON.ToString())
+		config.SetJSONOutputFilePath("./output.json")
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when the max findings is negative", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetMaxFindings(map[string]string{"critical": "0", "high": "-5"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "maxFindings: Max findings is not valid, the key must be a severity and the max a not negative "+
+			"integer: high=-5.", err.Error())
+	})
+	t.Run("Should return error when the fail threshold is not a level of severity", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetReportMinSeverity("low")
+		config.SetFailThreshold("AUDIT")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "failThreshold: Severity is not valid, it must be INFO, LOW, MEDIUM, HIGH or CRITICAL: AUDIT.",
+			err.Error())
+	})
+	t.Run("Should return error when encrypt report is used with recipients of age and gpg", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetEncryptReport(true)
+		config.SetReportRecipients([]string{"age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p",
+			"security@company.com"})
+		config.SetPrintOutputType(cli.JSON.ToString())
+		config.SetJSONOutputFilePath("./output.json")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "encryptReport: Encrypt report requires recipients, all age public keys or all gpg keys.",
+			err.Error())
+	})
+	t.Run("Should return error when encrypt report is used without the json output file path", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetEncryptReport(true)
+		config.SetReportRecipients([]string{"security@company.com"})
+		config.SetPrintOutputType(cli.SonarQube.ToString())
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "encryptReport: Encrypt report requires an output type written in the json")
+	})
+	t.Run("Should return error when encrypt report is used with the artifacts written unencrypted", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetEncryptReport(true)
+		config.SetReportRecipients([]string{"security@company.com"})
+		config.SetPrintOutputType(cli.JSON.ToString())
+		config.SetJSONOutputFilePath("./output.json")
+		config.SetArtifactsDir("./artifacts")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "encryptReport: Encrypt report can't be used with the artifacts dir")
+
+		config.SetArtifactsDir("")
+		config.SetToolsConfig(map[string]interface{}{"gosec": map[string]interface{}{"saverawoutput": true}})
+		err = useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "encryptReport: Encrypt report can't be used with the artifacts dir")
+	})
+	t.Run("Should return not error when encrypt report is used with the recipients and the output file", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetEncryptReport(true)
+		config.SetReportRecipients([]string{"age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p"})
+		config.SetPrintOutputType(cli.ThreadFix.ToString())
+		config.SetJSONOutputFilePath("./output.json")
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when split report by owner is used without the json output file path", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetSplitReportByOwner(true)
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "splitReportByOwner: Split report by owner requires an output type written")
+	})
+	t.Run("Should return not error when split report by owner is used with the json output file path", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetSplitReportByOwner(true)
+		config.SetPrintOutputType(cli.JSON.ToString())
+		config.SetJSONOutputFilePath("./output.json")
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when the owner mapping has no owners", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetOwnerMappings([]string{"services/api/ @org/api", "services/web/"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "ownerMappings: Owner mapping is not valid")
+	})
+	t.Run("Should return error when the owner webhook is not a url", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetOwnerWebhooks(map[string]string{"@org/api": "hooks.slack.com/services/T00"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "ownerWebhooks: Owner webhook is not valid")
+	})
+	t.Run("Should return not error when the owner mappings and webhooks are valid", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetOwnerMappings([]string{"services/api/ @org/api"})
+		config.SetOwnerWebhooks(map[string]string{"@org/api": "https://hooks.slack.com/services/T00/B00/XXX"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when the tickets file is not found", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetTicketsPath("./not-found-tickets.yaml")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "ticketsPath: Tickets file is not valid")
+	})
+	t.Run("Should return error when the gitea url is not valid or is informed without token", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetGiteaURL("gitea.company.com")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "giteaURL: Gitea url is not valid")
+
+		config.SetGiteaURL("https://gitea.company.com")
+		err = useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "giteaURL: Gitea url requires the gitea token")
+
+		config.SetGiteaToken("token")
+		assert.NoError(t, useCases.ValidateConfigs(config))
+	})
+	t.Run("Should return error when a leaks entropy allowlist pattern is not a valid regular expression", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetLeaksEntropyAllowlistPatterns([]string{"[a-f"})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "leaksEntropyAllowlistPatterns: Leaks entropy allowlist pattern is not a valid regular expression")
+
+		config.SetLeaksEntropyAllowlistPatterns([]string{"^[a-f0-9]{40}$"})
+		assert.NoError(t, useCases.ValidateConfigs(config))
+	})
+	t.Run("Should return error when the leaks entropy thresholds are above the max entropy", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetLeaksEntropyMinLength(24)
+		config.SetLeaksEntropyBase64Threshold(6.5)
+		config.SetLeaksEntropyHexThreshold(4.5)
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "leaksEntropyBase64Threshold: must be no greater than 6")
+		assert.Contains(t, err.Error(), "leaksEntropyHexThreshold: must be no greater than 4")
+
+		config.SetLeaksEntropyBase64Threshold(4.8)
+		config.SetLeaksEntropyHexThreshold(3.2)
+		assert.NoError(t, useCases.ValidateConfigs(config))
+	})
+	t.Run("Should return error when the code owners path is not found in the project", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetCodeOwnersPath("not-found/CODEOWNERS")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "codeOwnersPath")
+	})
+	t.Run("Should return error when the cyclonedx-vdr output is used without a json output file", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetPrintOutputType(cli.CycloneDXVDR.ToString())
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "JSON File path is required or is invalid")
+	})
+	t.Run("Should return not error when the cyclonedx-vdr output is used with a json output file", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetPrintOutputType(cli.CycloneDXVDR.ToString())
+		config.SetJSONOutputFilePath("./horusec.vdr.json")
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return not error when the spdx3 output is used with a json output file", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetPrintOutputType(cli.SPDX3.ToString())
+		config.SetJSONOutputFilePath("./horusec.spdx.json")
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when the jenkins output is used without a json output file", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetPrintOutputType(cli.Jenkins.ToString())
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "JSON File path is required or is invalid")
+
+		config.SetJSONOutputFilePath("./horusec.warnings.json")
+		assert.NoError(t, useCases.ValidateConfigs(config))
+	})
+	t.Run("Should return error when the osv offline database path is not a directory", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetOsvOfflineDatabasePath("./cli.go")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "osvOfflineDatabasePath: Osv offline database path must be a directory")
+
+		config.SetOsvOfflineDatabasePath(t.TempDir())
+		assert.NoError(t, useCases.ValidateConfigs(config))
+	})
+	t.Run("Should return error when a path of the workdir doesn't exist", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetProjectPath(newProjectWithFolders(t, "services/api"))
+		config.SetWorkDir(&workdir.WorkDir{Go: []string{"services/web"}})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Contains(t, err.Error(), "services/web: no such file or directory.")
+	})
+	t.Run("Should return error when a glob of the workdir doesn't match any folder", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetProjectPath(newProjectWithFolders(t, "services/api"))
+		config.SetWorkDir(&workdir.WorkDir{Go: []string{"apps/*/"}})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Equal(t, "workDir: Workdir glob doesn't match any folder of the project to the language Go: apps/*/.",
+			err.Error())
+	})
+	t.Run("Should return not error when a glob of the workdir matches a folder", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetProjectPath(newProjectWithFolders(t, "services/api"))
+		config.SetWorkDir(&workdir.WorkDir{Go: []string{"services/*/"}})
+
+		err := useCases.ValidateConfigs(config)
+		assert.NoError(t, err)
+	})
+
 }
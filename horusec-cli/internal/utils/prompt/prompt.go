@@ -32,7 +32,9 @@ func NewPrompt() Interface {
 			AllowEdit: true,
 			Pointer:   promptui.PipeCursor,
 		},
-		selection: &promptui.Select{},
+		selection: &promptui.Select{
+			Size: 10,
+		},
 	}
 }
 
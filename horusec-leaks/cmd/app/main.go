@@ -22,6 +22,7 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/cli_standard/cmd/version"
 	"github.com/ZupIT/horusec/development-kit/pkg/cli_standard/config"
 	"github.com/ZupIT/horusec/development-kit/pkg/engines/leaks/analysis"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/leaks/entropy"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	"github.com/spf13/cobra"
 )
@@ -37,15 +38,19 @@ var rootCmd = &cobra.Command{
 }
 
 var configs *config.Config
+var entropyConfigs *entropy.Config
 
 // nolint
 func init() {
 	configs = config.NewConfig()
+	entropyConfigs = entropy.NewConfig()
 	cmd.InitFlags(configs, rootCmd)
+	entropy.InitFlags(entropyConfigs, rootCmd)
 }
 
 func main() {
 	controller := analysis.NewAnalysis(configs)
+	controller.SetEntropyConfigs(entropyConfigs)
 	rootCmd.AddCommand(run.NewRunCommand(configs, controller).CreateCobraCmd())
 	rootCmd.AddCommand(version.NewVersionCommand().CreateCobraCmd())
 